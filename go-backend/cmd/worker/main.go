@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/di"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/telemetry"
+)
+
+func main() {
+	if err := run(); err != nil {
+		slog.Error("worker error", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	shutdown, err := telemetry.SetupOTelSDK(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := shutdown(ctx); err != nil {
+			slog.Error("failed to shutdown telemetry", "error", err)
+		}
+	}()
+
+	worker, err := di.InitializeWorker(ctx)
+	if err != nil {
+		return err
+	}
+
+	return worker.Start(ctx)
+}
@@ -5,33 +5,1029 @@ insert into users(id, email, password_hash, name, status_code, created_at) value
 select id, email, password_hash, name, status_code, created_at, updated_at from users
 where email = $1;
 
+-- name: FindUserByID :one
+select id, email, password_hash, name, status_code, created_at, updated_at from users
+where id = $1;
+
+-- name: UpdateUserStatus :execrows
+update users set status_code = $2, updated_at = now()
+where id = $1;
+
 -- name: FindAllUsers :many
-SELECT id, name, email, status_code, created_at
-FROM users
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
+SELECT u.id, u.name, u.email, u.status_code, u.created_at
+FROM users u
+JOIN organization_memberships om ON om.user_id = u.id
+WHERE om.organization_id = $1
+ORDER BY u.created_at DESC
+LIMIT $2 OFFSET $3;
 
 -- name: CountUsers :one
-SELECT COUNT(*) FROM users;
+SELECT COUNT(*) FROM organization_memberships
+WHERE organization_id = $1;
 
+-- The list queries share one optional filter: author_ids (NULL for every author), the half-open
+-- range [created_after, created_before) and a normalised hashtag. Author filters are served by
+-- posts_organization_id_user_id_created_at_id_idx for a single author and by posts_user_id_idx for
+-- several; the unfiltered feed by posts_organization_id_created_at_id_idx.
+-- They read posts through visible_posts, which holds the visibility rule, as seen by viewer_id. status
+-- selects published posts or drafts, which are thus only ever the viewer's own. Posts of users the viewer
+-- muted are left out too, unless author_ids chooses them. Comment counts follow visible_comments.
+-- Like every query that does not deal with the trash, they read posts through live_posts, or visible_posts
+-- which is built on it, and so leave out trashed posts; updates of posts state deleted_at IS NULL instead.
 -- name: FindAllPosts :many
-SELECT id, user_id, content, created_at FROM posts
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
+SELECT p.id, p.user_id, p.content, p.format, p.kind, p.original_id, p.visibility, p.status, p.publish_at,
+       p.hidden_at, p.created_at, p.updated_at, u.name AS author_name,
+       (SELECT COUNT(*) FROM post_revisions r WHERE r.post_id = p.id) AS revision_count,
+       (SELECT COUNT(*) FROM visible_comments c WHERE c.post_id = p.id AND c.viewer_id = p.viewer_id) AS comment_count,
+       (SELECT COUNT(*) FROM live_posts rp WHERE rp.original_id = p.id AND rp.kind = 'repost') AS repost_count
+FROM visible_posts p
+JOIN users u ON u.id = p.user_id
+WHERE p.organization_id = sqlc.arg(organization_id)
+  AND (sqlc.narg(author_ids)::uuid[] IS NULL OR p.user_id = ANY(sqlc.narg(author_ids)::uuid[]))
+  AND (sqlc.narg(created_after)::timestamp IS NULL OR p.created_at >= sqlc.narg(created_after)::timestamp)
+  AND (sqlc.narg(created_before)::timestamp IS NULL OR p.created_at < sqlc.narg(created_before)::timestamp)
+  AND (sqlc.narg(hashtag)::text IS NULL OR p.id IN (
+    SELECT ph.post_id FROM post_hashtags ph JOIN hashtags h ON h.id = ph.hashtag_id
+    WHERE h.organization_id = sqlc.arg(organization_id) AND h.name = sqlc.narg(hashtag)::text
+  ))
+  AND p.status = sqlc.arg(status)::varchar
+  AND p.viewer_id = sqlc.arg(viewer_id)::uuid
+  AND (NOT p.muted OR sqlc.narg(author_ids)::uuid[] IS NOT NULL)
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT sqlc.arg(page_limit) OFFSET sqlc.arg(page_offset);
+
+-- Keyset pages seek on (created_at, id), the feed order; ids are UUIDv7 so ties stay time-ordered.
+-- name: FindPostsAfter :many
+SELECT p.id, p.user_id, p.content, p.format, p.kind, p.original_id, p.visibility, p.status, p.publish_at,
+       p.hidden_at, p.created_at, p.updated_at, u.name AS author_name,
+       (SELECT COUNT(*) FROM post_revisions r WHERE r.post_id = p.id) AS revision_count,
+       (SELECT COUNT(*) FROM visible_comments c WHERE c.post_id = p.id AND c.viewer_id = p.viewer_id) AS comment_count,
+       (SELECT COUNT(*) FROM live_posts rp WHERE rp.original_id = p.id AND rp.kind = 'repost') AS repost_count
+FROM visible_posts p
+JOIN users u ON u.id = p.user_id
+WHERE p.organization_id = sqlc.arg(organization_id)
+  AND (sqlc.narg(author_ids)::uuid[] IS NULL OR p.user_id = ANY(sqlc.narg(author_ids)::uuid[]))
+  AND (sqlc.narg(created_after)::timestamp IS NULL OR p.created_at >= sqlc.narg(created_after)::timestamp)
+  AND (sqlc.narg(created_before)::timestamp IS NULL OR p.created_at < sqlc.narg(created_before)::timestamp)
+  AND (sqlc.narg(hashtag)::text IS NULL OR p.id IN (
+    SELECT ph.post_id FROM post_hashtags ph JOIN hashtags h ON h.id = ph.hashtag_id
+    WHERE h.organization_id = sqlc.arg(organization_id) AND h.name = sqlc.narg(hashtag)::text
+  ))
+  AND p.status = sqlc.arg(status)::varchar
+  AND p.viewer_id = sqlc.arg(viewer_id)::uuid
+  AND (NOT p.muted OR sqlc.narg(author_ids)::uuid[] IS NOT NULL)
+  AND (p.created_at, p.id) < (sqlc.arg(created_at)::timestamp, sqlc.arg(id)::uuid)
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- Returns the posts closest to the cursor first, i.e. in ascending order.
+-- name: FindPostsBefore :many
+SELECT p.id, p.user_id, p.content, p.format, p.kind, p.original_id, p.visibility, p.status, p.publish_at,
+       p.hidden_at, p.created_at, p.updated_at, u.name AS author_name,
+       (SELECT COUNT(*) FROM post_revisions r WHERE r.post_id = p.id) AS revision_count,
+       (SELECT COUNT(*) FROM visible_comments c WHERE c.post_id = p.id AND c.viewer_id = p.viewer_id) AS comment_count,
+       (SELECT COUNT(*) FROM live_posts rp WHERE rp.original_id = p.id AND rp.kind = 'repost') AS repost_count
+FROM visible_posts p
+JOIN users u ON u.id = p.user_id
+WHERE p.organization_id = sqlc.arg(organization_id)
+  AND (sqlc.narg(author_ids)::uuid[] IS NULL OR p.user_id = ANY(sqlc.narg(author_ids)::uuid[]))
+  AND (sqlc.narg(created_after)::timestamp IS NULL OR p.created_at >= sqlc.narg(created_after)::timestamp)
+  AND (sqlc.narg(created_before)::timestamp IS NULL OR p.created_at < sqlc.narg(created_before)::timestamp)
+  AND (sqlc.narg(hashtag)::text IS NULL OR p.id IN (
+    SELECT ph.post_id FROM post_hashtags ph JOIN hashtags h ON h.id = ph.hashtag_id
+    WHERE h.organization_id = sqlc.arg(organization_id) AND h.name = sqlc.narg(hashtag)::text
+  ))
+  AND p.status = sqlc.arg(status)::varchar
+  AND p.viewer_id = sqlc.arg(viewer_id)::uuid
+  AND (NOT p.muted OR sqlc.narg(author_ids)::uuid[] IS NOT NULL)
+  AND (p.created_at, p.id) > (sqlc.arg(created_at)::timestamp, sqlc.arg(id)::uuid)
+ORDER BY p.created_at ASC, p.id ASC
+LIMIT sqlc.arg(page_limit);
 
 -- name: CountPosts :one
-SELECT COUNT(*) FROM posts;
+SELECT COUNT(*) FROM visible_posts p
+WHERE p.organization_id = sqlc.arg(organization_id)
+  AND (sqlc.narg(author_ids)::uuid[] IS NULL OR p.user_id = ANY(sqlc.narg(author_ids)::uuid[]))
+  AND (sqlc.narg(created_after)::timestamp IS NULL OR p.created_at >= sqlc.narg(created_after)::timestamp)
+  AND (sqlc.narg(created_before)::timestamp IS NULL OR p.created_at < sqlc.narg(created_before)::timestamp)
+  AND (sqlc.narg(hashtag)::text IS NULL OR p.id IN (
+    SELECT ph.post_id FROM post_hashtags ph JOIN hashtags h ON h.id = ph.hashtag_id
+    WHERE h.organization_id = sqlc.arg(organization_id) AND h.name = sqlc.narg(hashtag)::text
+  ))
+  AND p.status = sqlc.arg(status)::varchar
+  AND p.viewer_id = sqlc.arg(viewer_id)::uuid
+  AND (NOT p.muted OR sqlc.narg(author_ids)::uuid[] IS NOT NULL);
+
+-- A user is a post author in an organization while they are a member or still have posts there.
+-- name: FindPostAuthor :one
+SELECT u.id, u.name FROM users u
+WHERE u.id = sqlc.arg(user_id)
+  AND (
+    EXISTS (SELECT 1 FROM organization_memberships m
+            WHERE m.organization_id = sqlc.arg(organization_id) AND m.user_id = u.id)
+    OR EXISTS (SELECT 1 FROM live_posts p WHERE p.organization_id = sqlc.arg(organization_id) AND p.user_id = u.id)
+  );
 
+-- A post matches when its lexemes match ts_query, or when it contains every pattern (ILIKE) for text
+-- the parser cannot split into words; anchor_pattern is the most selective pattern, repeated so that the
+-- trigram index applies. Lexeme matches rank by ts_rank_cd, substring-only matches rank 0.
+-- Only published posts visible to viewer_id, see visible_posts, are searched.
+-- name: SearchPosts :many
+SELECT p.id, p.user_id, p.content, p.format, p.kind, p.original_id, p.visibility, p.status, p.publish_at,
+       p.hidden_at, p.created_at, p.updated_at, u.name AS author_name,
+       (SELECT COUNT(*) FROM post_revisions r WHERE r.post_id = p.id) AS revision_count,
+       (SELECT COUNT(*) FROM visible_comments c WHERE c.post_id = p.id AND c.viewer_id = p.viewer_id) AS comment_count,
+       (SELECT COUNT(*) FROM live_posts rp WHERE rp.original_id = p.id AND rp.kind = 'repost') AS repost_count,
+       ts_rank_cd(p.search_vector, to_tsquery('simple', sqlc.arg(ts_query)::text), 32)::float8 AS rank
+FROM visible_posts p
+JOIN users u ON u.id = p.user_id
+WHERE p.organization_id = sqlc.arg(organization_id)
+  AND (
+    p.search_vector @@ to_tsquery('simple', sqlc.arg(ts_query)::text)
+    OR (
+      p.content ILIKE sqlc.arg(anchor_pattern)::text
+      AND NOT EXISTS (
+        SELECT 1 FROM unnest(sqlc.arg(patterns)::text[]) AS pattern(value) WHERE p.content NOT ILIKE pattern.value
+      )
+    )
+  )
+  AND p.status = 'published'
+  AND p.viewer_id = sqlc.arg(viewer_id)::uuid
+  AND NOT p.muted
+ORDER BY rank DESC, p.created_at DESC, p.id DESC
+LIMIT sqlc.arg(page_limit) OFFSET sqlc.arg(page_offset);
+
+-- Inserts nothing when original_id is set and user_id may not see the original; see visible_posts.
 -- name: CreatePost :one
-INSERT INTO posts(id, user_id, content, created_at)
+INSERT INTO posts(
+  id, organization_id, user_id, content, format, kind, original_id, visibility, status, publish_at, created_at,
+  updated_at
+)
+SELECT sqlc.arg(id)::uuid, sqlc.arg(organization_id)::uuid, sqlc.arg(user_id)::uuid, sqlc.arg(content)::text,
+       sqlc.arg(format)::varchar, sqlc.arg(kind)::varchar, sqlc.narg(original_id)::uuid,
+       sqlc.arg(visibility)::varchar, sqlc.arg(status)::varchar, sqlc.narg(publish_at)::timestamp,
+       sqlc.arg(created_at)::timestamp, sqlc.arg(created_at)::timestamp
+WHERE sqlc.narg(original_id)::uuid IS NULL OR EXISTS (
+  SELECT 1 FROM visible_posts o
+  WHERE o.id = sqlc.narg(original_id)::uuid AND o.organization_id = sqlc.arg(organization_id)::uuid
+    AND o.viewer_id = sqlc.arg(user_id)::uuid
+)
+RETURNING id, user_id, content, format, kind, original_id, visibility, status, publish_at, hidden_at, deleted_at,
+          deleted_by, created_at, updated_at;
+
+-- Stores a repost unless user_id already reposted the original (see posts_original_id_user_id_repost_idx)
+-- or may not see it (see visible_posts).
+-- name: CreateRepost :execrows
+INSERT INTO posts(id, organization_id, user_id, content, kind, original_id, visibility, created_at, updated_at)
+SELECT sqlc.arg(id)::uuid, o.organization_id, o.viewer_id, '', 'repost', o.id, 'public',
+       sqlc.arg(created_at)::timestamp, sqlc.arg(created_at)::timestamp
+FROM visible_posts o
+WHERE o.id = sqlc.arg(original_id)::uuid AND o.organization_id = sqlc.arg(organization_id)::uuid
+  AND o.viewer_id = sqlc.arg(user_id)::uuid
+ON CONFLICT (original_id, user_id) WHERE kind = 'repost' AND deleted_at IS NULL DO NOTHING;
+
+-- Deletes the repost of original_id by user_id for good, unlike the trash.
+-- name: DeleteRepost :execrows
+DELETE FROM posts
+WHERE organization_id = $1 AND user_id = $2 AND original_id = $3 AND kind = 'repost' AND deleted_at IS NULL;
+
+-- name: FindPostByID :one
+SELECT id, user_id, content, format, kind, original_id, visibility, status, publish_at, hidden_at, deleted_at,
+       deleted_by, created_at, updated_at
+FROM live_posts
+WHERE id = $1 AND organization_id = $2;
+
+-- name: FindTrashedPostByID :one
+SELECT id, user_id, content, format, kind, original_id, visibility, status, publish_at, hidden_at, deleted_at,
+       deleted_by, created_at, updated_at
+FROM posts
+WHERE id = $1 AND organization_id = $2 AND deleted_at IS NOT NULL;
+
+-- Returns nothing when viewer_id may not see the post; see visible_posts for the rule.
+-- name: FindPostDetailByID :one
+SELECT p.id, p.user_id, p.content, p.format, p.kind, p.original_id, p.visibility, p.status, p.publish_at,
+       p.hidden_at, p.created_at, p.updated_at, u.name AS author_name,
+       (SELECT COUNT(*) FROM post_revisions r WHERE r.post_id = p.id) AS revision_count,
+       (SELECT COUNT(*) FROM visible_comments c WHERE c.post_id = p.id AND c.viewer_id = p.viewer_id) AS comment_count,
+       (SELECT COUNT(*) FROM live_posts rp WHERE rp.original_id = p.id AND rp.kind = 'repost') AS repost_count
+FROM visible_posts p
+JOIN users u ON u.id = p.user_id
+WHERE p.id = sqlc.arg(id) AND p.organization_id = sqlc.arg(organization_id)
+  AND p.viewer_id = sqlc.arg(viewer_id)::uuid;
+
+-- name: UpdatePost :execrows
+UPDATE posts SET content = $3, format = $4, updated_at = $5
+WHERE id = $1 AND organization_id = $2 AND deleted_at IS NULL;
+
+-- Publishes a draft. Does nothing when the post is not a draft of the organization.
+-- name: PublishPost :execrows
+UPDATE posts SET status = 'published', publish_at = NULL, created_at = $3, updated_at = $3
+WHERE id = $1 AND organization_id = $2 AND status = 'draft' AND deleted_at IS NULL;
+
+-- Lists the drafts scheduled at or before publish_before, the longest overdue first.
+-- name: FindDuePosts :many
+SELECT id, user_id, content, format, kind, original_id, visibility, status, publish_at, hidden_at, deleted_at,
+       deleted_by, created_at, updated_at
+FROM live_posts
+WHERE organization_id = sqlc.arg(organization_id)
+  AND status = 'draft'
+  AND publish_at <= sqlc.arg(publish_before)::timestamp
+ORDER BY publish_at, id
+LIMIT sqlc.arg(page_limit);
+
+-- Counts the author's posts with exactly this content created at or after since, to detect repeated posts.
+-- Trashed posts count too, so that deleting a post does not make room for another copy.
+-- name: CountPostsWithContentSince :one
+SELECT COUNT(*) FROM posts
+WHERE organization_id = $1 AND user_id = $2 AND content = $3 AND created_at >= $4;
+
+-- name: HidePost :execrows
+UPDATE posts SET hidden_at = $3
+WHERE id = $1 AND organization_id = $2 AND hidden_at IS NULL AND deleted_at IS NULL;
+
+-- name: TrashPost :execrows
+UPDATE posts SET deleted_at = $3, deleted_by = $4
+WHERE id = $1 AND organization_id = $2 AND deleted_at IS NULL;
+
+-- name: RestorePost :execrows
+UPDATE posts SET deleted_at = NULL, deleted_by = NULL
+WHERE id = $1 AND organization_id = $2 AND deleted_at IS NOT NULL;
+
+-- The trash of user_id, most recently trashed first.
+-- name: FindTrashedPosts :many
+SELECT p.id, p.content, p.visibility, p.status, p.deleted_at::timestamp AS deleted_at, p.deleted_by,
+       p.created_at, p.updated_at
+FROM posts p
+WHERE p.organization_id = sqlc.arg(organization_id) AND p.user_id = sqlc.arg(user_id)
+  AND p.deleted_at IS NOT NULL
+ORDER BY p.deleted_at DESC, p.id DESC
+LIMIT sqlc.arg(page_limit) OFFSET sqlc.arg(page_offset);
+
+-- name: CountTrashedPosts :one
+SELECT COUNT(*) FROM posts
+WHERE organization_id = $1 AND user_id = $2 AND deleted_at IS NOT NULL;
+
+-- Deletes up to page_limit posts trashed before deleted_before, with everything that cascades from them.
+-- name: PurgeTrashedPosts :execrows
+DELETE FROM posts
+WHERE id IN (
+  SELECT t.id FROM posts t
+  WHERE t.organization_id = sqlc.arg(organization_id) AND t.deleted_at < sqlc.arg(deleted_before)::timestamp
+  ORDER BY t.deleted_at, t.id
+  LIMIT sqlc.arg(page_limit)
+);
+
+-- Locks the post row so that concurrent edits cannot pick the same number. Inserts nothing when the
+-- post does not exist in the organization.
+-- name: CreatePostRevision :one
+INSERT INTO post_revisions(id, organization_id, post_id, number, content, format, editor_id, created_at)
+SELECT sqlc.arg(id)::uuid, p.organization_id, p.id,
+       COALESCE((SELECT MAX(r.number) FROM post_revisions r WHERE r.post_id = p.id), 0) + 1,
+       sqlc.arg(content)::text, sqlc.arg(format)::varchar, sqlc.narg(editor_id)::uuid,
+       sqlc.arg(created_at)::timestamp
+FROM posts p
+WHERE p.id = sqlc.arg(post_id)::uuid AND p.organization_id = sqlc.arg(organization_id)::uuid
+  AND p.deleted_at IS NULL
+FOR UPDATE OF p
+RETURNING id, post_id, number, content, format, editor_id, created_at;
+
+-- name: CountPostRevisions :one
+SELECT COUNT(*) FROM post_revisions
+WHERE post_id = $1 AND organization_id = $2;
+
+-- name: FindPostRevisions :many
+SELECT id, number, content, format, editor_id, created_at FROM post_revisions
+WHERE post_id = $1 AND organization_id = $2
+ORDER BY number;
+
+-- name: FindPostRevisionByNumber :one
+SELECT id, number, content, format, editor_id, created_at FROM post_revisions
+WHERE post_id = $1 AND organization_id = $2 AND number = $3;
+
+-- Inserts nothing when the post does not exist in the organization or is not visible to user_id (see
+-- visible_posts), or when user_id and the author of parent_id block one another. A parent_id that is not a
+-- comment on the same post violates comments_post_id_parent_id_fkey.
+-- name: CreateComment :one
+INSERT INTO comments(id, organization_id, post_id, parent_id, user_id, content, depth, created_at)
+SELECT sqlc.arg(id)::uuid, p.organization_id, p.id, sqlc.narg(parent_id)::uuid, sqlc.arg(user_id)::uuid,
+       sqlc.arg(content)::text, sqlc.arg(depth)::integer, sqlc.arg(created_at)::timestamp
+FROM visible_posts p
+WHERE p.id = sqlc.arg(post_id)::uuid AND p.organization_id = sqlc.arg(organization_id)::uuid
+  AND p.viewer_id = sqlc.arg(user_id)::uuid
+  AND NOT EXISTS (
+    SELECT 1 FROM comments pc
+    JOIN user_exclusions x ON x.organization_id = pc.organization_id AND x.excluded_id = pc.user_id
+    WHERE pc.id = sqlc.narg(parent_id)::uuid AND x.user_id = sqlc.arg(user_id)::uuid AND x.blocked
+  )
+RETURNING id, post_id, parent_id, user_id, content, depth, created_at;
+
+-- name: FindCommentByID :one
+SELECT id, post_id, parent_id, user_id, content, depth, created_at FROM comments
+WHERE id = $1 AND organization_id = $2;
+
+-- Replies are removed by the cascading parent foreign key.
+-- name: DeleteComment :execrows
+DELETE FROM comments
+WHERE id = $1 AND organization_id = $2;
+
+-- Lists one level of a thread, oldest first: the top-level comments of a post when parent_id is NULL,
+-- otherwise the replies to parent_id. Pages seek past the (created_at, id) cursor when one is given.
+-- name: FindComments :many
+SELECT c.id, c.post_id, c.parent_id, c.user_id, u.name AS author_name, c.content, c.depth, c.created_at,
+       (SELECT COUNT(*) FROM visible_comments r
+        WHERE r.parent_id = c.id AND r.viewer_id = c.viewer_id) AS reply_count
+FROM visible_comments c
+JOIN users u ON u.id = c.user_id
+WHERE c.organization_id = sqlc.arg(organization_id)
+  AND c.post_id = sqlc.arg(post_id)
+  AND c.viewer_id = sqlc.arg(viewer_id)::uuid
+  AND (
+    c.parent_id = sqlc.narg(parent_id)::uuid
+    OR (sqlc.narg(parent_id)::uuid IS NULL AND c.parent_id IS NULL)
+  )
+  AND (
+    sqlc.narg(cursor_created_at)::timestamp IS NULL
+    OR (c.created_at, c.id) > (sqlc.narg(cursor_created_at)::timestamp, sqlc.narg(cursor_id)::uuid)
+  )
+ORDER BY c.created_at, c.id
+LIMIT sqlc.arg(page_limit);
+
+-- Returns the oldest per_parent_limit replies to each of parent_ids, oldest first.
+-- name: FindCommentReplies :many
+SELECT c.id, c.post_id, c.parent_id, c.user_id, u.name AS author_name, c.content, c.depth, c.created_at,
+       (SELECT COUNT(*) FROM visible_comments r
+        WHERE r.parent_id = c.id AND r.viewer_id = c.viewer_id) AS reply_count
+FROM (
+  SELECT r.id, r.post_id, r.parent_id, r.user_id, r.content, r.depth, r.created_at, r.viewer_id,
+         row_number() OVER (PARTITION BY r.parent_id ORDER BY r.created_at, r.id) AS position
+  FROM visible_comments r
+  WHERE r.organization_id = sqlc.arg(organization_id)
+    AND r.parent_id = ANY(sqlc.arg(parent_ids)::uuid[])
+    AND r.viewer_id = sqlc.arg(viewer_id)::uuid
+) c
+JOIN users u ON u.id = c.user_id
+WHERE c.position <= sqlc.arg(per_parent_limit)::bigint
+ORDER BY c.created_at, c.id;
+
+-- Adds nothing when the user already reacted with the type, or the post is not in the organization or
+-- not visible to the user.
+-- name: CreatePostReaction :execrows
+INSERT INTO post_reactions(organization_id, post_id, user_id, type, created_at)
+SELECT p.organization_id, p.id, sqlc.arg(user_id)::uuid, sqlc.arg(type)::varchar, sqlc.arg(created_at)::timestamp
+FROM visible_posts p
+WHERE p.id = sqlc.arg(post_id)::uuid AND p.organization_id = sqlc.arg(organization_id)::uuid
+  AND p.viewer_id = sqlc.arg(user_id)::uuid
+ON CONFLICT (post_id, user_id, type) DO NOTHING;
+
+-- name: DeletePostReaction :execrows
+DELETE FROM post_reactions
+WHERE post_id = $1 AND user_id = $2 AND type = $3 AND organization_id = $4;
+
+-- name: AddPostReactionCount :exec
+INSERT INTO post_reaction_counts(organization_id, post_id, type, shard, count)
+VALUES ($1, $2, $3, $4, sqlc.arg(delta)::bigint)
+ON CONFLICT (post_id, type, shard) DO UPDATE
+SET count = post_reaction_counts.count + EXCLUDED.count;
+
+-- name: FindPostReactionCounts :many
+SELECT post_id, type, SUM(count)::bigint AS count
+FROM post_reaction_counts
+WHERE organization_id = sqlc.arg(organization_id) AND post_id = ANY(sqlc.arg(post_ids)::uuid[])
+GROUP BY post_id, type
+HAVING SUM(count) > 0;
+
+-- name: FindUserPostReactions :many
+SELECT post_id, type
+FROM post_reactions
+WHERE organization_id = sqlc.arg(organization_id)
+  AND user_id = sqlc.arg(user_id)
+  AND post_id = ANY(sqlc.arg(post_ids)::uuid[]);
+
+-- name: CreatePostPoll :exec
+INSERT INTO post_polls(organization_id, post_id, multiple, closes_at, created_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: CreatePostPollOption :exec
+INSERT INTO post_poll_options(id, organization_id, post_id, position, label)
+VALUES ($1, $2, $3, $4, $5);
+
+-- Returns the poll of a published post; polls of drafts cannot be voted on yet.
+-- name: FindPostPoll :one
+SELECT pp.post_id, pp.multiple, pp.closes_at, pp.created_at
+FROM post_polls pp
+JOIN live_posts p ON p.id = pp.post_id
+WHERE pp.organization_id = $1 AND pp.post_id = $2 AND p.status = 'published';
+
+-- name: FindPostPollOptions :many
+SELECT id, label FROM post_poll_options
+WHERE organization_id = $1 AND post_id = $2
+ORDER BY position;
+
+-- Inserts nothing when the user already voted in the poll.
+-- name: CreatePostPollBallot :execrows
+INSERT INTO post_poll_ballots(organization_id, post_id, user_id, created_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (post_id, user_id) DO NOTHING;
+
+-- name: CreatePostPollVotes :exec
+INSERT INTO post_poll_votes(organization_id, post_id, user_id, option_id)
+SELECT sqlc.arg(organization_id)::uuid, sqlc.arg(post_id)::uuid, sqlc.arg(user_id)::uuid, o.id
+FROM unnest(sqlc.arg(option_ids)::uuid[]) AS o(id);
+
+-- Counts a new ballot and its votes, in the ballot's transaction so that the tallies move with the votes.
+-- name: IncrementPostPollVoterCount :exec
+UPDATE post_polls SET voter_count = voter_count + 1
+WHERE organization_id = $1 AND post_id = $2;
+
+-- name: IncrementPostPollVoteCounts :exec
+UPDATE post_poll_options SET vote_count = vote_count + 1
+WHERE organization_id = sqlc.arg(organization_id) AND post_id = sqlc.arg(post_id)
+  AND id = ANY(sqlc.arg(option_ids)::uuid[]);
+
+-- name: FindPostPolls :many
+SELECT post_id, multiple, closes_at, voter_count FROM post_polls
+WHERE organization_id = sqlc.arg(organization_id) AND post_id = ANY(sqlc.arg(post_ids)::uuid[]);
+
+-- name: FindPostPollOptionTallies :many
+SELECT post_id, id, label, vote_count FROM post_poll_options
+WHERE organization_id = sqlc.arg(organization_id) AND post_id = ANY(sqlc.arg(post_ids)::uuid[])
+ORDER BY post_id, position;
+
+-- name: FindUserPostPollVotes :many
+SELECT post_id, option_id FROM post_poll_votes
+WHERE organization_id = sqlc.arg(organization_id)
+  AND user_id = sqlc.arg(user_id)
+  AND post_id = ANY(sqlc.arg(post_ids)::uuid[]);
+
+-- Adds nothing when the follower already follows the followee, or either blocks the other.
+-- name: CreateFollow :execrows
+INSERT INTO follows(organization_id, follower_id, followee_id, created_at)
+SELECT sqlc.arg(organization_id)::uuid, sqlc.arg(follower_id)::uuid, sqlc.arg(followee_id)::uuid,
+       sqlc.arg(created_at)::timestamp
+WHERE NOT EXISTS (
+  SELECT 1 FROM user_exclusions x
+  WHERE x.organization_id = sqlc.arg(organization_id)::uuid AND x.user_id = sqlc.arg(follower_id)::uuid
+    AND x.excluded_id = sqlc.arg(followee_id)::uuid AND x.blocked
+)
+ON CONFLICT (organization_id, follower_id, followee_id) DO NOTHING;
+
+-- name: DeleteFollow :execrows
+DELETE FROM follows
+WHERE organization_id = $1 AND follower_id = $2 AND followee_id = $3;
+
+-- name: CountFollowers :one
+SELECT COUNT(*) FROM follows
+WHERE organization_id = $1 AND followee_id = $2;
+
+-- Lists the followers of user_id, most recent follow first; pages seek past the (created_at, id) cursor
+-- when one is given.
+-- name: FindFollowers :many
+SELECT u.id, u.name, f.created_at
+FROM follows f
+JOIN users u ON u.id = f.follower_id
+WHERE f.organization_id = sqlc.arg(organization_id)
+  AND f.followee_id = sqlc.arg(user_id)
+  AND (
+    sqlc.narg(cursor_created_at)::timestamp IS NULL
+    OR (f.created_at, f.follower_id) < (sqlc.narg(cursor_created_at)::timestamp, sqlc.narg(cursor_id)::uuid)
+  )
+ORDER BY f.created_at DESC, f.follower_id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- Lists the users user_id follows, most recent follow first, paged like FindFollowers.
+-- name: FindFollowing :many
+SELECT u.id, u.name, f.created_at
+FROM follows f
+JOIN users u ON u.id = f.followee_id
+WHERE f.organization_id = sqlc.arg(organization_id)
+  AND f.follower_id = sqlc.arg(user_id)
+  AND (
+    sqlc.narg(cursor_created_at)::timestamp IS NULL
+    OR (f.created_at, f.followee_id) < (sqlc.narg(cursor_created_at)::timestamp, sqlc.narg(cursor_id)::uuid)
+  )
+ORDER BY f.created_at DESC, f.followee_id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- name: CreateBlock :execrows
+INSERT INTO user_blocks(organization_id, blocker_id, blocked_id, created_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (organization_id, blocker_id, blocked_id) DO NOTHING;
+
+-- name: DeleteBlock :execrows
+DELETE FROM user_blocks
+WHERE organization_id = $1 AND blocker_id = $2 AND blocked_id = $3;
+
+-- Lists the users user_id blocked, most recent block first, paged like FindFollowers.
+-- name: FindBlocks :many
+SELECT u.id, u.name, b.created_at
+FROM user_blocks b
+JOIN users u ON u.id = b.blocked_id
+WHERE b.organization_id = sqlc.arg(organization_id)
+  AND b.blocker_id = sqlc.arg(user_id)
+  AND (
+    sqlc.narg(cursor_created_at)::timestamp IS NULL
+    OR (b.created_at, b.blocked_id) < (sqlc.narg(cursor_created_at)::timestamp, sqlc.narg(cursor_id)::uuid)
+  )
+ORDER BY b.created_at DESC, b.blocked_id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- name: CreateMute :execrows
+INSERT INTO user_mutes(organization_id, muter_id, muted_id, created_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (organization_id, muter_id, muted_id) DO NOTHING;
+
+-- name: DeleteMute :execrows
+DELETE FROM user_mutes
+WHERE organization_id = $1 AND muter_id = $2 AND muted_id = $3;
+
+-- Lists the users user_id muted, most recent mute first, paged like FindFollowers.
+-- name: FindMutes :many
+SELECT u.id, u.name, m.created_at
+FROM user_mutes m
+JOIN users u ON u.id = m.muted_id
+WHERE m.organization_id = sqlc.arg(organization_id)
+  AND m.muter_id = sqlc.arg(user_id)
+  AND (
+    sqlc.narg(cursor_created_at)::timestamp IS NULL
+    OR (m.created_at, m.muted_id) < (sqlc.narg(cursor_created_at)::timestamp, sqlc.narg(cursor_id)::uuid)
+  )
+ORDER BY m.created_at DESC, m.muted_id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- The users whose content is kept from user_id, as aggregate.UserRelationshipAggregate loads them: blocked
+-- is true for the users it blocked or that block it, and false for those it only muted.
+-- name: FindRelationships :many
+SELECT x.excluded_id AS user_id, bool_or(x.blocked)::boolean AS blocked FROM user_exclusions x
+WHERE x.organization_id = sqlc.arg(organization_id) AND x.user_id = sqlc.arg(user_id)
+GROUP BY x.excluded_id;
+
+-- Serialises the timeline writes concerning one author until the transaction ends. Without it, a post
+-- fanned out while a new follower backfills could reach neither the follower's entries nor, having
+-- entries, the follower's fan-out-on-read.
+-- name: LockTimelineAuthor :exec
+SELECT pg_advisory_xact_lock(hashtextextended(sqlc.arg(author_id)::uuid::text, 0));
+
+-- Writes the post to the timeline of every follower of its author.
+-- name: CreateTimelineEntries :execrows
+INSERT INTO timeline_entries(organization_id, user_id, post_id, author_id, created_at)
+SELECT f.organization_id, f.follower_id, sqlc.arg(post_id)::uuid, f.followee_id, sqlc.arg(created_at)::timestamp
+FROM follows f
+WHERE f.organization_id = sqlc.arg(organization_id) AND f.followee_id = sqlc.arg(author_id)
+ON CONFLICT (user_id, post_id) DO NOTHING;
+
+-- Copies the author's fanned-out posts, i.e. those with entries, to the timeline of user_id.
+-- name: BackfillTimelineEntries :execrows
+INSERT INTO timeline_entries(organization_id, user_id, post_id, author_id, created_at)
+SELECT p.organization_id, sqlc.arg(user_id)::uuid, p.id, p.user_id, p.created_at
+FROM live_posts p
+WHERE p.organization_id = sqlc.arg(organization_id)
+  AND p.user_id = sqlc.arg(author_id)
+  AND EXISTS (SELECT 1 FROM timeline_entries e WHERE e.post_id = p.id)
+ON CONFLICT (user_id, post_id) DO NOTHING;
+
+-- name: DeleteTimelineEntriesByAuthor :exec
+DELETE FROM timeline_entries
+WHERE organization_id = $1 AND user_id = $2 AND author_id = $3;
+
+-- The published posts of the organization that are not hidden, oldest first, starting after the cursor.
+-- Reposts have no content of their own to export.
+-- name: FindPostsForExport :many
+SELECT id, user_id, content, format, visibility, created_at
+FROM live_posts
+WHERE organization_id = sqlc.arg(organization_id)
+  AND status = 'published'
+  AND hidden_at IS NULL
+  AND kind <> 'repost'
+  AND (
+    sqlc.narg(cursor_created_at)::timestamp IS NULL
+    OR (created_at, id) > (sqlc.narg(cursor_created_at)::timestamp, sqlc.narg(cursor_id)::uuid)
+  )
+ORDER BY created_at, id
+LIMIT sqlc.arg(page_limit);
+
+-- The home timeline of user_id, newest first: the fanned-out posts from its entries merged with the other
+-- posts of followed authors, which are read through posts_user_id_idx. Each branch is cut to the page
+-- size before merging, so neither reads further back than the page needs. Followers see the posts of the
+-- authors they follow that visible_posts lets them see, i.e. the published public and followers-only
+-- ones; only such posts are fanned out. Posts hidden by a moderator or trashed keep their entries but are
+-- skipped, as are the posts of the users user_exclusions keeps away from user_id.
+-- name: FindTimeline :many
+WITH candidates AS (
+  (
+    SELECT p.id
+    FROM visible_posts p
+    WHERE p.organization_id = sqlc.arg(organization_id)
+      AND p.viewer_id = sqlc.arg(user_id)::uuid
+      AND p.user_id IN (
+        SELECT f.followee_id FROM follows f
+        WHERE f.organization_id = sqlc.arg(organization_id) AND f.follower_id = sqlc.arg(user_id)
+      )
+      AND NOT EXISTS (SELECT 1 FROM timeline_entries e WHERE e.post_id = p.id)
+      AND NOT p.muted
+      AND (
+        sqlc.narg(cursor_created_at)::timestamp IS NULL
+        OR (p.created_at, p.id) < (sqlc.narg(cursor_created_at)::timestamp, sqlc.narg(cursor_id)::uuid)
+      )
+    ORDER BY p.created_at DESC, p.id DESC
+    LIMIT sqlc.arg(page_limit)
+  )
+  UNION ALL
+  (
+    SELECT e.post_id AS id
+    FROM timeline_entries e
+    WHERE e.organization_id = sqlc.arg(organization_id)
+      AND e.user_id = sqlc.arg(user_id)
+      AND NOT EXISTS (
+        SELECT 1 FROM user_exclusions x
+        WHERE x.organization_id = e.organization_id AND x.user_id = e.user_id AND x.excluded_id = e.author_id
+      )
+      AND NOT EXISTS (
+        SELECT 1 FROM posts h WHERE h.id = e.post_id AND (h.hidden_at IS NOT NULL OR h.deleted_at IS NOT NULL)
+      )
+      AND (
+        sqlc.narg(cursor_created_at)::timestamp IS NULL
+        OR (e.created_at, e.post_id) < (sqlc.narg(cursor_created_at)::timestamp, sqlc.narg(cursor_id)::uuid)
+      )
+    ORDER BY e.created_at DESC, e.post_id DESC
+    LIMIT sqlc.arg(page_limit)
+  )
+)
+SELECT p.id, p.user_id, p.content, p.format, p.kind, p.original_id, p.visibility, p.status, p.publish_at,
+       p.hidden_at, p.created_at, p.updated_at, u.name AS author_name,
+       (SELECT COUNT(*) FROM post_revisions r WHERE r.post_id = p.id) AS revision_count,
+       (SELECT COUNT(*) FROM visible_comments c
+        WHERE c.post_id = p.id AND c.viewer_id = sqlc.arg(user_id)) AS comment_count,
+       (SELECT COUNT(*) FROM live_posts rp WHERE rp.original_id = p.id AND rp.kind = 'repost') AS repost_count
+FROM candidates
+JOIN live_posts p ON p.id = candidates.id
+JOIN users u ON u.id = p.user_id
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- name: CreateHashtags :exec
+INSERT INTO hashtags(organization_id, name)
+SELECT sqlc.arg(organization_id), name FROM unnest(sqlc.arg(names)::text[]) AS name
+ON CONFLICT (organization_id, name) DO NOTHING;
+
+-- name: DeletePostHashtags :exec
+DELETE FROM post_hashtags WHERE organization_id = $1 AND post_id = $2;
+
+-- name: CreatePostHashtags :exec
+INSERT INTO post_hashtags(organization_id, hashtag_id, post_id)
+SELECT h.organization_id, h.id, sqlc.arg(post_id)
+FROM hashtags h
+WHERE h.organization_id = sqlc.arg(organization_id) AND h.name = ANY(sqlc.arg(names)::text[]);
+
+-- name: DeletePostMentions :exec
+DELETE FROM post_mentions WHERE organization_id = $1 AND post_id = $2;
+
+-- A handle mentions the member whose name, normalised like the handle (NFKC, lower case), equals it.
+-- Handles that match no member, or several, are not stored, nor are those of users who block author_id or
+-- whom it blocks.
+-- name: CreatePostMentions :exec
+INSERT INTO post_mentions(organization_id, post_id, handle, user_id)
+SELECT m.organization_id, sqlc.arg(post_id), lower(normalize(u.name, NFKC)), (array_agg(u.id))[1]
+FROM organization_memberships m
+JOIN users u ON u.id = m.user_id
+WHERE m.organization_id = sqlc.arg(organization_id)
+  AND lower(normalize(u.name, NFKC)) = ANY(sqlc.arg(handles)::text[])
+GROUP BY m.organization_id, lower(normalize(u.name, NFKC))
+HAVING COUNT(*) = 1 AND NOT EXISTS (
+  SELECT 1 FROM user_exclusions x
+  WHERE x.organization_id = m.organization_id AND x.user_id = sqlc.arg(author_id)::uuid
+    AND x.excluded_id = (array_agg(u.id))[1] AND x.blocked
+);
+
+-- name: FindPostMentions :many
+SELECT post_id, handle, user_id FROM post_mentions
+WHERE organization_id = sqlc.arg(organization_id) AND post_id = ANY(sqlc.arg(post_ids)::uuid[]);
+
+-- name: CreateAttachment :exec
+INSERT INTO attachments(id, organization_id, uploader_id, file_name, content_type, size_bytes, width, height,
+                        storage_key, thumbnail_key, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+
+-- name: FindAttachmentsByIDs :many
+SELECT id, uploader_id, post_id, position, file_name, content_type, size_bytes, width, height, storage_key,
+       thumbnail_key, created_at
+FROM attachments
+WHERE organization_id = sqlc.arg(organization_id) AND id = ANY(sqlc.arg(ids)::uuid[]);
+
+-- Only attaches uploads that are still unattached, so an upload never ends up on two posts.
+-- name: AttachAttachment :execrows
+UPDATE attachments SET post_id = $3, position = $4
+WHERE id = $1 AND organization_id = $2 AND post_id IS NULL;
+
+-- Orphans are uploads without a post: never attached, or attached to a post that was deleted.
+-- name: FindOrphanedAttachments :many
+SELECT id, uploader_id, post_id, position, file_name, content_type, size_bytes, width, height, storage_key,
+       thumbnail_key, created_at
+FROM attachments
+WHERE organization_id = sqlc.arg(organization_id) AND post_id IS NULL
+  AND created_at < sqlc.arg(created_before)
+ORDER BY created_at, id
+LIMIT sqlc.arg(page_limit);
+
+-- name: DeleteAttachments :exec
+DELETE FROM attachments
+WHERE organization_id = sqlc.arg(organization_id) AND id = ANY(sqlc.arg(ids)::uuid[]);
+
+-- name: FindAttachmentByID :one
+SELECT id, uploader_id, post_id, position, file_name, content_type, size_bytes, width, height, storage_key,
+       thumbnail_key, created_at
+FROM attachments
+WHERE id = $1 AND organization_id = $2;
+
+-- name: FindPostAttachments :many
+SELECT id, post_id, file_name, content_type, size_bytes, width, height, (thumbnail_key IS NOT NULL)::boolean AS has_thumbnail
+FROM attachments
+WHERE organization_id = sqlc.arg(organization_id) AND post_id = ANY(sqlc.arg(post_ids)::uuid[])
+ORDER BY post_id, position;
+
+-- Inserts nothing when the post does not exist in the organization or is not visible to reporter_id; see
+-- visible_posts. A second open report by the same user violates post_reports_post_id_reporter_id_idx.
+-- name: CreatePostReport :execrows
+INSERT INTO post_reports(id, organization_id, post_id, reporter_id, reason, details, created_at)
+SELECT sqlc.arg(id)::uuid, p.organization_id, p.id, sqlc.arg(reporter_id)::uuid, sqlc.arg(reason)::varchar,
+       sqlc.arg(details)::text, sqlc.arg(created_at)::timestamp
+FROM visible_posts p
+WHERE p.id = sqlc.arg(post_id)::uuid AND p.organization_id = sqlc.arg(organization_id)::uuid
+  AND p.viewer_id = sqlc.arg(reporter_id)::uuid;
+
+-- A report filed by the content filters; the post was just created in the same transaction.
+-- name: CreateFlaggedPostReport :exec
+INSERT INTO post_reports(id, organization_id, post_id, reason, details, created_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ResolvePostReports :execrows
+UPDATE post_reports SET resolved_at = $3, resolved_by = $4
+WHERE post_id = $1 AND organization_id = $2 AND resolved_at IS NULL;
+
+-- name: CreateModerationAction :exec
+INSERT INTO moderation_actions(id, organization_id, moderator_id, post_id, target_user_id, action, reason, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- The moderation queue: posts with open reports, the longest waiting first. Reports of trashed posts stay
+-- open but leave the queue until the post is restored.
+-- name: FindModerationQueue :many
+SELECT p.id, p.user_id, u.name AS author_name, p.content, p.hidden_at, p.created_at,
+       q.report_count, q.first_reported_at::timestamp AS first_reported_at,
+       q.last_reported_at::timestamp AS last_reported_at
+FROM (
+  SELECT r.post_id, COUNT(*) AS report_count, MIN(r.created_at) AS first_reported_at,
+         MAX(r.created_at) AS last_reported_at
+  FROM post_reports r
+  WHERE r.organization_id = sqlc.arg(organization_id) AND r.resolved_at IS NULL
+  GROUP BY r.post_id
+) q
+JOIN live_posts p ON p.id = q.post_id
+JOIN users u ON u.id = p.user_id
+ORDER BY q.first_reported_at, p.id
+LIMIT sqlc.arg(page_limit) OFFSET sqlc.arg(page_offset);
+
+-- name: CountModerationQueue :one
+SELECT COUNT(DISTINCT r.post_id) FROM post_reports r
+JOIN live_posts p ON p.id = r.post_id
+WHERE r.organization_id = $1 AND r.resolved_at IS NULL;
+
+-- name: FindOpenPostReports :many
+SELECT id, post_id, reporter_id, reason, details, created_at FROM post_reports
+WHERE organization_id = sqlc.arg(organization_id) AND post_id = ANY(sqlc.arg(post_ids)::uuid[])
+  AND resolved_at IS NULL
+ORDER BY post_id, created_at, id;
+
+-- Tells whether viewer_id may see the post; see visible_posts for the rule.
+-- name: IsPostVisible :one
+SELECT EXISTS (
+  SELECT 1 FROM visible_posts p
+  WHERE p.id = sqlc.arg(id) AND p.organization_id = sqlc.arg(organization_id)
+    AND p.viewer_id = sqlc.arg(viewer_id)::uuid
+);
+
+-- Returns the posts among ids that viewer_id may see, in no particular order; see visible_posts for the rule.
+-- name: FindVisiblePostsByIDs :many
+SELECT p.id, p.user_id, p.content, p.format, p.kind, p.original_id, p.visibility, p.status, p.publish_at,
+       p.hidden_at, p.created_at, p.updated_at, u.name AS author_name,
+       (SELECT COUNT(*) FROM post_revisions r WHERE r.post_id = p.id) AS revision_count,
+       (SELECT COUNT(*) FROM visible_comments c WHERE c.post_id = p.id AND c.viewer_id = p.viewer_id) AS comment_count,
+       (SELECT COUNT(*) FROM live_posts rp WHERE rp.original_id = p.id AND rp.kind = 'repost') AS repost_count
+FROM visible_posts p
+JOIN users u ON u.id = p.user_id
+WHERE p.organization_id = sqlc.arg(organization_id) AND p.id = ANY(sqlc.arg(ids)::uuid[])
+  AND p.viewer_id = sqlc.arg(viewer_id)::uuid;
+
+-- Serialises the pins of one user until the transaction ends, so that concurrent pins cannot exceed the
+-- limit. The seed keeps the lock apart from LockTimelineAuthor.
+-- name: LockPinnedPosts :exec
+SELECT pg_advisory_xact_lock(hashtextextended(sqlc.arg(user_id)::uuid::text, 1));
+
+-- name: CreatePinnedPost :execrows
+INSERT INTO pinned_posts(organization_id, user_id, post_id, pinned_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, post_id) DO NOTHING;
+
+-- Pins of posts in the trash count as well, so restoring a post cannot take a user past the limit.
+-- name: CountPinnedPosts :one
+SELECT COUNT(*) FROM pinned_posts
+WHERE organization_id = $1 AND user_id = $2;
+
+-- name: DeletePinnedPost :execrows
+DELETE FROM pinned_posts
+WHERE organization_id = $1 AND user_id = $2 AND post_id = $3;
+
+-- Lists the pins of user_id, most recent first. Pins of posts that are gone are listed as well.
+-- name: FindPinnedPosts :many
+SELECT post_id, pinned_at FROM pinned_posts
+WHERE organization_id = $1 AND user_id = $2
+ORDER BY pinned_at DESC, post_id DESC;
+
+-- name: CreateBookmark :execrows
+INSERT INTO bookmarks(organization_id, user_id, post_id, created_at)
 VALUES ($1, $2, $3, $4)
-RETURNING id, user_id, content, created_at;
+ON CONFLICT (user_id, post_id) DO NOTHING;
 
+-- name: DeleteBookmark :execrows
+DELETE FROM bookmarks
+WHERE organization_id = $1 AND user_id = $2 AND post_id = $3;
+
+-- Lists the bookmarks of user_id, most recent first, paged like FindFollowers. Bookmarks of posts that are
+-- gone are listed as well.
+-- name: FindBookmarks :many
+SELECT post_id, created_at FROM bookmarks
+WHERE organization_id = sqlc.arg(organization_id)
+  AND user_id = sqlc.arg(user_id)
+  AND (
+    sqlc.narg(cursor_created_at)::timestamp IS NULL
+    OR (created_at, post_id) < (sqlc.narg(cursor_created_at)::timestamp, sqlc.narg(cursor_id)::uuid)
+  )
+ORDER BY created_at DESC, post_id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- name: CreateCollection :exec
+INSERT INTO collections(id, organization_id, user_id, name, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: FindCollectionByID :one
+SELECT id, user_id, name, created_at, updated_at FROM collections
+WHERE id = $1 AND organization_id = $2;
+
+-- name: FindCollectionsByUserID :many
+SELECT id, user_id, name, created_at, updated_at FROM collections
+WHERE organization_id = $1 AND user_id = $2
+ORDER BY created_at, id;
+
+-- name: CountCollectionsByUserID :one
+SELECT COUNT(*) FROM collections
+WHERE organization_id = $1 AND user_id = $2;
+
+-- name: UpdateCollection :execrows
+UPDATE collections SET name = $3, updated_at = $4
+WHERE id = $1 AND organization_id = $2;
+
+-- name: DeleteCollection :execrows
+DELETE FROM collections
+WHERE id = $1 AND organization_id = $2;
+
+-- name: CreateCollectionPost :execrows
+INSERT INTO collection_posts(organization_id, collection_id, post_id, added_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (collection_id, post_id) DO NOTHING;
+
+-- name: DeleteCollectionPost :execrows
+DELETE FROM collection_posts
+WHERE organization_id = $1 AND collection_id = $2 AND post_id = $3;
+
+-- Lists the posts of a collection in the order they were added; pages seek past the (added_at, post_id)
+-- cursor when one is given. Posts that are gone are listed as well.
+-- name: FindCollectionPosts :many
+SELECT post_id, added_at FROM collection_posts
+WHERE organization_id = sqlc.arg(organization_id)
+  AND collection_id = sqlc.arg(collection_id)
+  AND (
+    sqlc.narg(cursor_added_at)::timestamp IS NULL
+    OR (added_at, post_id) > (sqlc.narg(cursor_added_at)::timestamp, sqlc.narg(cursor_id)::uuid)
+  )
+ORDER BY added_at, post_id
+LIMIT sqlc.arg(page_limit);
+
+-- Permissions come from the user's active platform-wide roles plus, when an organization is
+-- active, the role held in that organization.
 -- name: FindUserPermissionSnapshot :many
 SELECT u.id, u.email, u.password_hash, u.name, u.status_code, u.created_at, u.updated_at,
        p.code AS permission_code
 FROM users u
-LEFT JOIN user_roles ur ON ur.user_id = u.id
-LEFT JOIN role_permissions rp ON rp.role_id = ur.role_id
+LEFT JOIN (
+  SELECT ur.user_id, ur.role_id FROM user_roles ur
+  WHERE ur.expires_at IS NULL OR ur.expires_at > now()
+  UNION
+  SELECT om.user_id, om.role_id FROM organization_memberships om
+  WHERE om.organization_id = sqlc.narg(organization_id)
+) r ON r.user_id = u.id
+LEFT JOIN role_permissions rp ON rp.role_id = r.role_id
 LEFT JOIN permissions p ON p.id = rp.permission_id
-WHERE u.id = $1;
+WHERE u.id = @user_id;
+
+-- name: UpsertUserRole :one
+INSERT INTO user_roles(user_id, role_id, granted_by, granted_at, expires_at, reason)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (user_id, role_id) DO UPDATE
+SET granted_by = EXCLUDED.granted_by,
+    granted_at = EXCLUDED.granted_at,
+    expires_at = EXCLUDED.expires_at,
+    reason = EXCLUDED.reason
+RETURNING user_id, role_id, granted_by, granted_at, expires_at, reason;
+
+-- name: CreateUserRoleHistory :exec
+INSERT INTO user_role_histories(user_id, role_id, action, actor_id, reason, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: DeleteExpiredUserRoles :many
+DELETE FROM user_roles
+WHERE expires_at IS NOT NULL AND expires_at <= now()
+RETURNING user_id, role_id, granted_by, granted_at, expires_at, reason;
+
+-- name: FindActiveUserRolesByUserID :many
+SELECT ur.user_id, ur.role_id, r.name AS role_name, ur.granted_by, ur.granted_at, ur.expires_at, ur.reason
+FROM user_roles ur
+JOIN roles r ON r.id = ur.role_id
+WHERE ur.user_id = $1 AND (ur.expires_at IS NULL OR ur.expires_at > now())
+ORDER BY ur.granted_at DESC;
+
+-- name: FindUserRoleHistoriesByUserID :many
+SELECT h.id, h.role_id, r.name AS role_name, h.action, h.actor_id, h.reason, h.expires_at, h.created_at
+FROM user_role_histories h
+JOIN roles r ON r.id = h.role_id
+WHERE h.user_id = $1
+ORDER BY h.created_at DESC, h.id DESC;
+
+-- name: CreateImpersonationAuditLog :exec
+INSERT INTO impersonation_audit_logs(id, actor_id, subject_id, action, method, path, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: CreateOrganization :exec
+INSERT INTO organizations(id, name, created_at) VALUES ($1, $2, $3);
+
+-- name: CreateOrganizationMembership :exec
+INSERT INTO organization_memberships(organization_id, user_id, role_id, created_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: FindAllOrganizationIDs :many
+SELECT id FROM organizations ORDER BY id;
+
+-- name: FindOrganizationMembership :one
+SELECT organization_id, user_id, role_id, created_at FROM organization_memberships
+WHERE organization_id = $1 AND user_id = $2;
+
+-- name: FindOrganizationMembershipsByUserID :many
+SELECT organization_id, user_id, role_id, created_at FROM organization_memberships
+WHERE user_id = $1
+ORDER BY created_at, organization_id;
+
+-- name: UpsertUserProfile :exec
+INSERT INTO user_profiles(user_id, bio, avatar_url, updated_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id) DO UPDATE
+SET bio = excluded.bio, avatar_url = excluded.avatar_url, updated_at = excluded.updated_at;
+
+-- Returns nothing unless the user is a member of the organization, or when the user and viewer_id block one
+-- another, as if they were not members. Only public fields are selected.
+-- name: FindUserProfile :one
+SELECT u.id, u.name, COALESCE(up.bio, '')::text AS bio, up.avatar_url, m.created_at AS joined_at,
+       (SELECT COUNT(*) FROM follows f
+        WHERE f.organization_id = m.organization_id AND f.followee_id = u.id) AS follower_count
+FROM organization_memberships m
+JOIN users u ON u.id = m.user_id
+LEFT JOIN user_profiles up ON up.user_id = u.id
+WHERE m.organization_id = sqlc.arg(organization_id) AND m.user_id = sqlc.arg(user_id)
+  AND NOT EXISTS (
+    SELECT 1 FROM user_exclusions x
+    WHERE x.organization_id = m.organization_id AND x.user_id = sqlc.arg(viewer_id)::uuid
+      AND x.excluded_id = u.id AND x.blocked
+  );
+
+-- name: FindOrganizationMemberIDs :many
+SELECT user_id FROM organization_memberships
+WHERE organization_id = sqlc.arg(organization_id) AND user_id = ANY(sqlc.arg(user_ids)::uuid[]);
+
+-- name: CreateInvitation :exec
+INSERT INTO invitations(id, email, token_hash, invited_by, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: CreateInvitationRole :exec
+INSERT INTO invitation_roles(invitation_id, role_id) VALUES ($1, $2);
+
+-- name: FindInvitationByTokenHash :one
+SELECT i.id, i.email, i.token_hash, i.invited_by, i.expires_at, i.accepted_at, i.accepted_by, i.created_at,
+       COALESCE(array_agg(ir.role_id) FILTER (WHERE ir.role_id IS NOT NULL), '{}')::uuid[] AS role_ids
+FROM invitations i
+LEFT JOIN invitation_roles ir ON ir.invitation_id = i.id
+WHERE i.token_hash = $1
+GROUP BY i.id;
+
+-- name: AcceptInvitation :execrows
+UPDATE invitations SET accepted_at = $2, accepted_by = $3
+WHERE id = $1 AND accepted_at IS NULL;
+
+-- name: FindPendingInvitationByTokenHash :one
+SELECT email, expires_at FROM invitations
+WHERE token_hash = $1 AND accepted_at IS NULL AND expires_at > now();
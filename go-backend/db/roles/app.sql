@@ -0,0 +1,21 @@
+-- The role the application connects as. It is neither a superuser nor BYPASSRLS, so the row-level
+-- security policies in db/schema/schema.sql apply to every statement it runs. Migrations keep
+-- running as the owner; apply this file after them so the grants cover new tables and views.
+do $$
+begin
+  create role app login password 'app';
+exception
+  -- Another session created the role first.
+  when duplicate_object or unique_violation then null;
+end
+$$;
+
+alter role app nosuperuser nobypassrls;
+
+do $$
+begin
+  execute format('grant usage on schema %I to app', current_schema());
+  execute format('grant select, insert, update, delete on all tables in schema %I to app', current_schema());
+  execute format('grant usage, select on all sequences in schema %I to app', current_schema());
+end
+$$;
@@ -1,3 +1,6 @@
+-- pg_trgm lives in public so that every schema (e.g. one per integration test package) shares it.
+create extension if not exists pg_trgm with schema public;
+
 create table user_statuses (
   code varchar(32) primary key,
   display_name varchar(64) not null,
@@ -17,6 +20,15 @@ create table users (
   updated_at timestamp not null default now()
 );
 
+-- The public profile of a user. Profiles are not tenant-scoped: every organization the user is a member of
+-- shows the same one. Users without a row have an empty profile.
+create table user_profiles (
+  user_id uuid primary key references users(id) on delete cascade,
+  bio varchar(160) not null default '',
+  avatar_url varchar(2048),
+  updated_at timestamp not null default now()
+);
+
 create table roles (
   id uuid primary key,
   name varchar(64) not null unique,
@@ -41,15 +53,666 @@ create table role_permissions (
 create table user_roles (
   user_id uuid not null references users(id),
   role_id uuid not null references roles(id),
+  granted_by uuid references users(id) on delete set null,
+  granted_at timestamp not null default now(),
+  expires_at timestamp,
+  reason text,
   primary key (user_id, role_id)
 );
 
+create index user_roles_expires_at_idx on user_roles(expires_at) where expires_at is not null;
+
+create table user_role_histories (
+  id uuid primary key default gen_random_uuid(),
+  user_id uuid not null references users(id) on delete cascade,
+  role_id uuid not null references roles(id),
+  action varchar(32) not null,
+  actor_id uuid references users(id) on delete set null,
+  reason text,
+  expires_at timestamp,
+  created_at timestamp not null default now()
+);
+
+create index user_role_histories_user_id_created_at_idx on user_role_histories(user_id, created_at);
+
+create table organizations (
+  id uuid primary key,
+  name varchar(256) not null,
+  created_at timestamp not null default now(),
+  updated_at timestamp not null default now()
+);
+
+-- role_id grants the role's permissions only while the member acts inside the organization.
+create table organization_memberships (
+  organization_id uuid not null references organizations(id) on delete cascade,
+  user_id uuid not null references users(id) on delete cascade,
+  role_id uuid not null references roles(id),
+  created_at timestamp not null default now(),
+  primary key (organization_id, user_id)
+);
+
+create index organization_memberships_user_id_idx on organization_memberships(user_id, created_at);
+
 create table posts (
   id uuid primary key default gen_random_uuid(),
+  organization_id uuid not null references organizations(id) on delete cascade,
   user_id uuid not null references users(id) on delete cascade,
   content text not null,
+  -- The markup content is written in. Content is stored as written and rendered to HTML when it is read.
+  format varchar(16) not null default 'plain' check (format in ('plain', 'markdown')),
+  -- A repost shares original_id as it is and has no content of its own; a quote shares it below its own
+  -- content. original_id becomes null once the original is purged, and the share then shows a placeholder.
+  kind varchar(16) not null default 'post' check (kind in ('post', 'repost', 'quote')),
+  original_id uuid references posts(id) on delete set null,
+  check (kind <> 'post' or original_id is null),
+  check (kind <> 'repost' or content = ''),
   created_at timestamp not null default now(),
-  updated_at timestamp not null default now()
+  updated_at timestamp not null default now(),
+  visibility varchar(16) not null default 'public' check (visibility in ('public', 'followers', 'private')),
+  -- Drafts are only visible to their author. publish_at schedules a draft; publishing sets created_at to
+  -- the publication time and clears publish_at.
+  status varchar(16) not null default 'published' check (status in ('draft', 'published')),
+  publish_at timestamp,
+  check (publish_at is null or status = 'draft'),
+  -- Set when a moderator hides the post; hidden posts are only visible to their author.
+  hidden_at timestamp,
+  -- Set when the post is moved to the trash, by its author or a moderator (deleted_by). Trashed posts are
+  -- only listed in their author's trash until they are restored, or purged once the retention window ends.
+  deleted_at timestamp,
+  deleted_by uuid references users(id) on delete set null,
+  check (deleted_by is null or deleted_at is not null),
+  -- The simple configuration neither stems nor drops stop words, so it treats every language alike.
+  search_vector tsvector generated always as (to_tsvector('simple', content)) stored
 );
 
 create index posts_user_id_idx on posts(user_id);
+create index posts_organization_id_created_at_id_idx on posts(organization_id, created_at desc, id desc);
+create index posts_organization_id_user_id_created_at_id_idx
+  on posts(organization_id, user_id, created_at desc, id desc);
+-- Full-text search. The parser only splits on spaces and punctuation, so text in scripts written without
+-- spaces (Japanese, Chinese) becomes a few long lexemes; substring matches on such text use the trigram
+-- index instead.
+create index posts_search_vector_idx on posts using gin (search_vector);
+create index posts_content_trgm_idx on posts using gin (content public.gin_trgm_ops);
+-- Scheduled drafts, read by the publisher in publish_at order.
+create index posts_organization_id_publish_at_idx on posts(organization_id, publish_at, id)
+  where status = 'draft' and publish_at is not null;
+-- The trash of each author, most recently trashed first, and the purge of expired trash.
+create index posts_organization_id_user_id_deleted_at_id_idx
+  on posts(organization_id, user_id, deleted_at desc, id desc) where deleted_at is not null;
+create index posts_organization_id_deleted_at_idx on posts(organization_id, deleted_at)
+  where deleted_at is not null;
+
+-- The reposts and quotes of a post, for its repost count. A user reposts a post at most once; a trashed
+-- repost does not count, so that the post can be reposted again.
+create index posts_original_id_idx on posts(original_id) where original_id is not null;
+create unique index posts_original_id_user_id_repost_idx on posts(original_id, user_id)
+  where kind = 'repost' and deleted_at is null;
+
+-- The posts that are not in the trash. Queries read posts through this view unless they deal with the
+-- trash itself; it runs with the caller's privileges so that row level security still applies.
+create view live_posts with (security_invoker = true) as
+  select * from posts where deleted_at is null;
+
+-- Every version of a post's content, starting with the original at number 1. Rows are append-only;
+-- number is assigned while the post row is locked, so it is gapless per post.
+create table post_revisions (
+  id uuid primary key,
+  organization_id uuid not null references organizations(id) on delete cascade,
+  post_id uuid not null references posts(id) on delete cascade,
+  number integer not null,
+  content text not null,
+  format varchar(16) not null default 'plain' check (format in ('plain', 'markdown')),
+  editor_id uuid references users(id) on delete set null,
+  created_at timestamp not null default now(),
+  unique (post_id, number)
+);
+
+-- Comments on posts. A reply points at its parent through (post_id, parent_id), so it always stays on
+-- the parent's post; deleting a post or a comment deletes every reply below it.
+create table comments (
+  id uuid primary key,
+  organization_id uuid not null references organizations(id) on delete cascade,
+  post_id uuid not null references posts(id) on delete cascade,
+  parent_id uuid,
+  user_id uuid not null references users(id) on delete cascade,
+  content text not null,
+  depth integer not null default 0,
+  created_at timestamp not null default now(),
+  unique (post_id, id),
+  foreign key (post_id, parent_id) references comments(post_id, id) on delete cascade
+);
+
+-- Threads are read one level at a time in (created_at, id) order; the partial index serves the
+-- top-level comments, for which parent_id is NULL.
+create index comments_parent_id_created_at_id_idx on comments(parent_id, created_at, id);
+create index comments_post_id_created_at_id_idx on comments(post_id, created_at, id) where parent_id is null;
+create index comments_user_id_idx on comments(user_id);
+
+-- One row per user, post and reaction type; the set of types is fixed by the application.
+create table post_reactions (
+  organization_id uuid not null references organizations(id) on delete cascade,
+  post_id uuid not null references posts(id) on delete cascade,
+  user_id uuid not null references users(id) on delete cascade,
+  type varchar(16) not null check (type in ('like', 'love', 'laugh', 'wow', 'sad', 'angry')),
+  created_at timestamp not null default now(),
+  primary key (post_id, user_id, type)
+);
+
+create index post_reactions_user_id_idx on post_reactions(user_id);
+
+-- Per-post reaction counters, split into shards so that concurrent reactions to a popular post update
+-- different rows. A user's reactions always land on the same shard, so no shard goes negative; the count
+-- of a type is the sum over its shards.
+create table post_reaction_counts (
+  organization_id uuid not null references organizations(id) on delete cascade,
+  post_id uuid not null references posts(id) on delete cascade,
+  type varchar(16) not null,
+  shard smallint not null,
+  count bigint not null default 0,
+  primary key (post_id, type, shard)
+);
+
+-- Who follows whom inside an organization. Follows are scoped to the organization like posts are, so a
+-- user who belongs to several organizations has a separate follow graph in each.
+create table follows (
+  organization_id uuid not null references organizations(id) on delete cascade,
+  follower_id uuid not null references users(id) on delete cascade,
+  followee_id uuid not null references users(id) on delete cascade,
+  created_at timestamp not null default now(),
+  primary key (organization_id, follower_id, followee_id),
+  check (follower_id <> followee_id)
+);
+
+-- Follower and following lists are read newest first; the followee index also serves follower counts.
+create index follows_follower_id_created_at_idx
+  on follows(organization_id, follower_id, created_at desc, followee_id desc);
+create index follows_followee_id_created_at_idx
+  on follows(organization_id, followee_id, created_at desc, follower_id desc);
+
+-- Blocks and mutes inside an organization, scoped like follows. A block hides the two users from each
+-- other and keeps them from interacting; a mute only hides the muted user from the muter.
+create table user_blocks (
+  organization_id uuid not null references organizations(id) on delete cascade,
+  blocker_id uuid not null references users(id) on delete cascade,
+  blocked_id uuid not null references users(id) on delete cascade,
+  created_at timestamp not null default now(),
+  primary key (organization_id, blocker_id, blocked_id),
+  check (blocker_id <> blocked_id)
+);
+
+-- Block lists are read newest first; the blocked index finds the users who block a viewer.
+create index user_blocks_blocker_id_created_at_idx
+  on user_blocks(organization_id, blocker_id, created_at desc, blocked_id desc);
+create index user_blocks_blocked_id_idx on user_blocks(organization_id, blocked_id);
+
+create table user_mutes (
+  organization_id uuid not null references organizations(id) on delete cascade,
+  muter_id uuid not null references users(id) on delete cascade,
+  muted_id uuid not null references users(id) on delete cascade,
+  created_at timestamp not null default now(),
+  primary key (organization_id, muter_id, muted_id),
+  check (muter_id <> muted_id)
+);
+
+create index user_mutes_muter_id_created_at_idx
+  on user_mutes(organization_id, muter_id, created_at desc, muted_id desc);
+
+-- The users kept away from each user (user_id) by blocks and mutes, one row per reason. blocked is true for
+-- the users it blocked or that block it, who are hidden from it everywhere and may not interact with it,
+-- and false for those it muted, who are only left out of its feeds and threads. This is the one definition
+-- of what blocks and mutes hide; visible_posts and visible_comments apply it to every read.
+create view user_exclusions with (security_invoker = true) as
+  select organization_id, blocker_id as user_id, blocked_id as excluded_id, true as blocked from user_blocks
+  union all
+  select organization_id, blocked_id, blocker_id, true from user_blocks
+  union all
+  select organization_id, muter_id, muted_id, false from user_mutes;
+
+-- The live posts each user may see, one row per viewer (viewer_id) and post: their own posts, and the
+-- published posts of others that are public, or followers-only when the viewer follows the author, unless
+-- a moderator hid them or the two users block one another. This is the one definition of the rule;
+-- queries that read or act on posts for a user filter on viewer_id instead of repeating it. The filter
+-- makes the join a lookup of a single user, so it adds no rows. muted tells that the viewer muted the
+-- author: feeds leave such posts out, while lookups by ID and lists of chosen authors keep them.
+create view visible_posts with (security_invoker = true) as
+  select v.id as viewer_id,
+         exists (
+           select 1 from user_exclusions x
+           where x.organization_id = p.organization_id and x.user_id = v.id and x.excluded_id = p.user_id
+             and not x.blocked
+         ) as muted,
+         p.*
+  from live_posts p
+  cross join users v
+  where (
+      p.user_id = v.id
+      or (p.status = 'published' and p.hidden_at is null and (
+        p.visibility = 'public'
+        or (p.visibility = 'followers' and exists (
+          select 1 from follows f
+          where f.organization_id = p.organization_id and f.followee_id = p.user_id and f.follower_id = v.id
+        ))
+      ))
+    )
+    and not exists (
+      select 1 from user_exclusions x
+      where x.organization_id = p.organization_id and x.user_id = v.id and x.excluded_id = p.user_id
+        and x.blocked
+    );
+
+-- The comments each user may see in threads, one row per viewer (viewer_id) and comment: every comment but
+-- those of the users user_exclusions keeps away from the viewer. Comment and reply counts read this view
+-- too, so that they match the threads.
+create view visible_comments with (security_invoker = true) as
+  select v.id as viewer_id, c.*
+  from comments c
+  cross join users v
+  where not exists (
+    select 1 from user_exclusions x
+    where x.organization_id = c.organization_id and x.user_id = v.id and x.excluded_id = c.user_id
+  );
+
+-- Materialised home timelines. A post by an author with many followers is written here once per follower
+-- when it is created (fan-out on write); the posts of every other author are read from posts when a
+-- timeline is requested (fan-out on read). A post with entries is read from here only, so a timeline
+-- never shows a post twice.
+create table timeline_entries (
+  organization_id uuid not null references organizations(id) on delete cascade,
+  user_id uuid not null references users(id) on delete cascade,
+  post_id uuid not null references posts(id) on delete cascade,
+  author_id uuid not null references users(id) on delete cascade,
+  created_at timestamp not null,
+  primary key (user_id, post_id)
+);
+
+create index timeline_entries_user_id_created_at_post_id_idx
+  on timeline_entries(organization_id, user_id, created_at desc, post_id desc);
+create index timeline_entries_post_id_idx on timeline_entries(post_id);
+
+-- Hashtags used in an organization, by their normalised text (NFKC, lower case, without the #).
+create table hashtags (
+  id uuid primary key default gen_random_uuid(),
+  organization_id uuid not null references organizations(id) on delete cascade,
+  name varchar(100) not null,
+  unique (organization_id, name)
+);
+
+-- The hashtags and mentions of the current content of each post. Both are replaced whenever the content
+-- changes; a mention is only stored when its handle named exactly one member when the post was saved.
+create table post_hashtags (
+  organization_id uuid not null references organizations(id) on delete cascade,
+  hashtag_id uuid not null references hashtags(id) on delete cascade,
+  post_id uuid not null references posts(id) on delete cascade,
+  primary key (hashtag_id, post_id)
+);
+
+create index post_hashtags_post_id_idx on post_hashtags(post_id);
+
+create table post_mentions (
+  organization_id uuid not null references organizations(id) on delete cascade,
+  post_id uuid not null references posts(id) on delete cascade,
+  handle varchar(64) not null,
+  user_id uuid not null references users(id) on delete cascade,
+  primary key (post_id, handle)
+);
+
+create index post_mentions_user_id_idx on post_mentions(user_id);
+
+-- Files uploaded for posts. The content lives in blob storage under storage_key (and thumbnail_key for
+-- images); this table only holds its metadata. An upload is attached to at most one post, at position, when
+-- the post is created. Uploads that were never attached, or whose post was deleted, are orphans: a worker
+-- job deletes them and their blobs once they are old enough.
+create table attachments (
+  id uuid primary key,
+  organization_id uuid not null references organizations(id) on delete cascade,
+  uploader_id uuid not null references users(id) on delete cascade,
+  post_id uuid references posts(id) on delete set null,
+  position smallint,
+  file_name varchar(255) not null,
+  content_type varchar(100) not null,
+  size_bytes bigint not null,
+  width integer,
+  height integer,
+  storage_key text not null,
+  thumbnail_key text,
+  created_at timestamp not null default now()
+);
+
+create index attachments_post_id_position_idx on attachments(post_id, position);
+create index attachments_orphans_created_at_idx on attachments(organization_id, created_at) where post_id is null;
+
+-- Reports of abusive posts. A report stays open until a moderator acts on its post; a user has at most one
+-- open report per post. Reports filed by the content filters have no reporter.
+create table post_reports (
+  id uuid primary key,
+  organization_id uuid not null references organizations(id) on delete cascade,
+  post_id uuid not null references posts(id) on delete cascade,
+  reporter_id uuid references users(id) on delete cascade,
+  reason varchar(32) not null
+    check (reason in ('spam', 'harassment', 'hate', 'violence', 'sexual', 'misinformation', 'other')),
+  details text not null default '',
+  created_at timestamp not null default now(),
+  resolved_at timestamp,
+  resolved_by uuid references users(id) on delete set null
+);
+
+create unique index post_reports_post_id_reporter_id_idx on post_reports(post_id, reporter_id)
+  where resolved_at is null;
+-- The moderation queue: open reports, oldest first.
+create index post_reports_open_created_at_idx on post_reports(organization_id, created_at, post_id)
+  where resolved_at is null;
+
+-- Every moderator action, kept after the post is deleted; post_id therefore has no foreign key.
+create table moderation_actions (
+  id uuid primary key,
+  organization_id uuid not null references organizations(id) on delete cascade,
+  moderator_id uuid references users(id) on delete set null,
+  post_id uuid not null,
+  target_user_id uuid not null references users(id) on delete cascade,
+  action varchar(16) not null check (action in ('hide', 'delete', 'warn', 'freeze', 'dismiss')),
+  reason text not null,
+  created_at timestamp not null default now()
+);
+
+create index moderation_actions_target_user_id_created_at_idx
+  on moderation_actions(organization_id, target_user_id, created_at);
+
+-- Posts users saved to read later, private to each user. Bookmarks outlive their posts so that a deleted
+-- post shows up as a tombstone in the list instead of silently disappearing; post_id therefore has no
+-- foreign key.
+create table bookmarks (
+  organization_id uuid not null references organizations(id) on delete cascade,
+  user_id uuid not null references users(id) on delete cascade,
+  post_id uuid not null,
+  created_at timestamp not null default now(),
+  primary key (user_id, post_id)
+);
+
+-- Bookmarks are read newest first.
+create index bookmarks_user_id_created_at_post_id_idx
+  on bookmarks(organization_id, user_id, created_at desc, post_id desc);
+
+-- Named lists of posts a user put together, private to the user.
+create table collections (
+  id uuid primary key,
+  organization_id uuid not null references organizations(id) on delete cascade,
+  user_id uuid not null references users(id) on delete cascade,
+  name varchar(100) not null,
+  created_at timestamp not null default now(),
+  updated_at timestamp not null default now()
+);
+
+create index collections_user_id_created_at_id_idx on collections(organization_id, user_id, created_at, id);
+
+-- The posts of each collection, read in the order they were added. Like bookmarks, entries outlive their
+-- posts.
+create table collection_posts (
+  organization_id uuid not null references organizations(id) on delete cascade,
+  collection_id uuid not null references collections(id) on delete cascade,
+  post_id uuid not null,
+  added_at timestamp not null default now(),
+  primary key (collection_id, post_id)
+);
+
+create index collection_posts_collection_id_added_at_post_id_idx
+  on collection_posts(collection_id, added_at, post_id);
+
+-- A poll on a post, identified by the post. Voting ends at closes_at, and votes are final.
+create table post_polls (
+  organization_id uuid not null references organizations(id) on delete cascade,
+  post_id uuid primary key references posts(id) on delete cascade,
+  multiple boolean not null default false,
+  closes_at timestamp not null,
+  -- The tallies move with the ballots and votes in the same transaction; see post_poll_ballots.
+  voter_count bigint not null default 0,
+  created_at timestamp not null default now()
+);
+
+create table post_poll_options (
+  id uuid primary key,
+  organization_id uuid not null references organizations(id) on delete cascade,
+  post_id uuid not null references post_polls(post_id) on delete cascade,
+  position smallint not null,
+  label varchar(100) not null,
+  vote_count bigint not null default 0,
+  unique (post_id, position)
+);
+
+-- One ballot per user and poll: the primary key is what lets each user vote once. A ballot holds one vote,
+-- or several in polls with multiple choice. A vote is only counted when its ballot is new, so concurrent
+-- ballots of the same user cannot count twice, and the row locks of the counters serialise the increments.
+create table post_poll_ballots (
+  organization_id uuid not null references organizations(id) on delete cascade,
+  post_id uuid not null references post_polls(post_id) on delete cascade,
+  user_id uuid not null references users(id) on delete cascade,
+  created_at timestamp not null default now(),
+  primary key (post_id, user_id)
+);
+
+create table post_poll_votes (
+  organization_id uuid not null references organizations(id) on delete cascade,
+  post_id uuid not null,
+  user_id uuid not null,
+  option_id uuid not null references post_poll_options(id) on delete cascade,
+  primary key (post_id, user_id, option_id),
+  foreign key (post_id, user_id) references post_poll_ballots(post_id, user_id) on delete cascade
+);
+
+-- Posts pinned by their author to their profile, at most three per user and organization; see LockPinnedPosts.
+create table pinned_posts (
+  organization_id uuid not null references organizations(id) on delete cascade,
+  user_id uuid not null references users(id) on delete cascade,
+  post_id uuid not null references posts(id) on delete cascade,
+  pinned_at timestamp not null,
+  primary key (user_id, post_id)
+);
+
+create index pinned_posts_user_id_pinned_at_idx on pinned_posts(user_id, pinned_at);
+
+create table impersonation_audit_logs (
+  id uuid primary key,
+  actor_id uuid not null references users(id),
+  subject_id uuid not null references users(id),
+  action varchar(32) not null,
+  method varchar(16) not null,
+  path text not null,
+  created_at timestamp not null default now()
+);
+
+create index impersonation_audit_logs_actor_id_created_at_idx on impersonation_audit_logs(actor_id, created_at);
+create index impersonation_audit_logs_subject_id_created_at_idx on impersonation_audit_logs(subject_id, created_at);
+
+-- token_hash is the SHA-256 of the token sent to the invitee; the token itself is never stored.
+create table invitations (
+  id uuid primary key,
+  email varchar(256) not null,
+  token_hash bytea not null unique,
+  invited_by uuid references users(id) on delete set null,
+  expires_at timestamp not null,
+  accepted_at timestamp,
+  accepted_by uuid references users(id) on delete set null,
+  created_at timestamp not null default now()
+);
+
+create table invitation_roles (
+  invitation_id uuid not null references invitations(id) on delete cascade,
+  role_id uuid not null references roles(id),
+  primary key (invitation_id, role_id)
+);
+
+-- Row-level security: tenant-owned rows are only visible to sessions that set app.tenant_id
+-- (and, for memberships, to the member identified by app.user_id). The application sets both per
+-- transaction; see internal/infrastructure/db. Policies are forced so they also apply to the
+-- table owner; only roles with BYPASSRLS (e.g. superusers used for migrations) skip them. The
+-- application connects as the app role from db/roles/app.sql, which has neither.
+alter table posts enable row level security;
+alter table posts force row level security;
+
+create policy posts_tenant_isolation on posts
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table post_revisions enable row level security;
+alter table post_revisions force row level security;
+
+create policy post_revisions_tenant_isolation on post_revisions
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table comments enable row level security;
+alter table comments force row level security;
+
+create policy comments_tenant_isolation on comments
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table post_reactions enable row level security;
+alter table post_reactions force row level security;
+
+create policy post_reactions_tenant_isolation on post_reactions
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table post_reaction_counts enable row level security;
+alter table post_reaction_counts force row level security;
+
+create policy post_reaction_counts_tenant_isolation on post_reaction_counts
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table follows enable row level security;
+alter table follows force row level security;
+
+create policy follows_tenant_isolation on follows
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table user_blocks enable row level security;
+alter table user_blocks force row level security;
+
+create policy user_blocks_tenant_isolation on user_blocks
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table user_mutes enable row level security;
+alter table user_mutes force row level security;
+
+create policy user_mutes_tenant_isolation on user_mutes
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table timeline_entries enable row level security;
+alter table timeline_entries force row level security;
+
+create policy timeline_entries_tenant_isolation on timeline_entries
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table hashtags enable row level security;
+alter table hashtags force row level security;
+
+create policy hashtags_tenant_isolation on hashtags
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table post_hashtags enable row level security;
+alter table post_hashtags force row level security;
+
+create policy post_hashtags_tenant_isolation on post_hashtags
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table post_mentions enable row level security;
+alter table post_mentions force row level security;
+
+create policy post_mentions_tenant_isolation on post_mentions
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table attachments enable row level security;
+alter table attachments force row level security;
+
+create policy attachments_tenant_isolation on attachments
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table post_reports enable row level security;
+alter table post_reports force row level security;
+
+create policy post_reports_tenant_isolation on post_reports
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table moderation_actions enable row level security;
+alter table moderation_actions force row level security;
+
+create policy moderation_actions_tenant_isolation on moderation_actions
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table bookmarks enable row level security;
+alter table bookmarks force row level security;
+
+create policy bookmarks_tenant_isolation on bookmarks
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table collections enable row level security;
+alter table collections force row level security;
+
+create policy collections_tenant_isolation on collections
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table collection_posts enable row level security;
+alter table collection_posts force row level security;
+
+create policy collection_posts_tenant_isolation on collection_posts
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table post_polls enable row level security;
+alter table post_polls force row level security;
+
+create policy post_polls_tenant_isolation on post_polls
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table post_poll_options enable row level security;
+alter table post_poll_options force row level security;
+
+create policy post_poll_options_tenant_isolation on post_poll_options
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table post_poll_ballots enable row level security;
+alter table post_poll_ballots force row level security;
+
+create policy post_poll_ballots_tenant_isolation on post_poll_ballots
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table post_poll_votes enable row level security;
+alter table post_poll_votes force row level security;
+
+create policy post_poll_votes_tenant_isolation on post_poll_votes
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table pinned_posts enable row level security;
+alter table pinned_posts force row level security;
+
+create policy pinned_posts_tenant_isolation on pinned_posts
+  using (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid)
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
+
+alter table organization_memberships enable row level security;
+alter table organization_memberships force row level security;
+
+create policy organization_memberships_tenant_isolation on organization_memberships
+  using (
+    organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid
+    or user_id = nullif(current_setting('app.user_id', true), '')::uuid
+  )
+  with check (organization_id = nullif(current_setting('app.tenant_id', true), '')::uuid);
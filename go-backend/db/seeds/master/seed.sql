@@ -7,13 +7,44 @@ insert into user_statuses (code, display_name, description, sort_order) values
 -- roles master data
 insert into roles (id, name, description) values
   ('00000000-0000-0000-0000-000000000001', 'admin', 'Full access to all resources'),
-  ('00000000-0000-0000-0000-000000000002', 'viewer', 'Read-only access') ON CONFLICT DO NOTHING;
+  ('00000000-0000-0000-0000-000000000002', 'viewer', 'Read-only access'),
+  ('00000000-0000-0000-0000-000000000003', 'owner', 'Manages the members of an organization') ON CONFLICT DO NOTHING;
 
 -- permissions master data
 insert into permissions (id, code, description) values
-  ('00000000-0000-0000-0001-000000000001', 'users:list', 'List users') ON CONFLICT DO NOTHING;
+  ('00000000-0000-0000-0001-000000000001', 'users:list', 'List users'),
+  ('00000000-0000-0000-0001-000000000002', 'roles:list', 'List role assignments of any user'),
+  ('00000000-0000-0000-0001-000000000003', 'roles:assign', 'Grant roles to users'),
+  ('00000000-0000-0000-0001-000000000004', 'users:impersonate', 'Act as another user for support'),
+  ('00000000-0000-0000-0001-000000000005', 'members:add', 'Add users to an organization'),
+  ('00000000-0000-0000-0001-000000000006', 'users:invite', 'Invite users to sign up'),
+  ('00000000-0000-0000-0001-000000000007', 'posts:moderate', 'Edit or delete posts written by others'),
+  ('00000000-0000-0000-0001-000000000008', 'posts:import', 'Import posts in bulk on behalf of members'),
+  ('00000000-0000-0000-0001-000000000009', 'posts:export', 'Export every post of an organization') ON CONFLICT DO NOTHING;
 
 -- role_permissions: admin and viewer both get users:list
 insert into role_permissions (role_id, permission_id) values
   ('00000000-0000-0000-0000-000000000001', '00000000-0000-0000-0001-000000000001'),
   ('00000000-0000-0000-0000-000000000002', '00000000-0000-0000-0001-000000000001') ON CONFLICT DO NOTHING;
+
+-- role_permissions: only admin may inspect and grant roles, impersonate users, and invite users
+insert into role_permissions (role_id, permission_id) values
+  ('00000000-0000-0000-0000-000000000001', '00000000-0000-0000-0001-000000000002'),
+  ('00000000-0000-0000-0000-000000000001', '00000000-0000-0000-0001-000000000003'),
+  ('00000000-0000-0000-0000-000000000001', '00000000-0000-0000-0001-000000000004'),
+  ('00000000-0000-0000-0000-000000000001', '00000000-0000-0000-0001-000000000006') ON CONFLICT DO NOTHING;
+
+-- role_permissions: organization owners (a per-organization role) may add members
+insert into role_permissions (role_id, permission_id) values
+  ('00000000-0000-0000-0000-000000000003', '00000000-0000-0000-0001-000000000005') ON CONFLICT DO NOTHING;
+
+-- role_permissions: admins and organization owners may moderate posts
+insert into role_permissions (role_id, permission_id) values
+  ('00000000-0000-0000-0000-000000000001', '00000000-0000-0000-0001-000000000007'),
+  ('00000000-0000-0000-0000-000000000003', '00000000-0000-0000-0001-000000000007') ON CONFLICT DO NOTHING;
+
+-- role_permissions: only admins may import posts; admins and organization owners may export them
+insert into role_permissions (role_id, permission_id) values
+  ('00000000-0000-0000-0000-000000000001', '00000000-0000-0000-0001-000000000008'),
+  ('00000000-0000-0000-0000-000000000001', '00000000-0000-0000-0001-000000000009'),
+  ('00000000-0000-0000-0000-000000000003', '00000000-0000-0000-0001-000000000009') ON CONFLICT DO NOTHING;
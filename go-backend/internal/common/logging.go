@@ -34,14 +34,26 @@ func (l *loggerImpl) Error(ctx context.Context, msg string, args ...any) {
 	l.logger.ErrorContext(ctx, msg, withUserID(ctx, args)...)
 }
 
-// withUserID prepends "userID" to args when a user ID is present in the context.
+// withUserID prepends "userID" to args when a user ID is present in the context,
+// "actorID" as well when the request is made under impersonation, and "tenantID"
+// when an organization is active.
 func withUserID(ctx context.Context, args []any) []any {
 	userID := UserIDFromContext(ctx)
 	if userID == "" {
 		return args
 	}
 
-	return append([]any{"userID", userID}, args...)
+	prefix := []any{"userID", userID}
+
+	if actorID := ActorIDFromContext(ctx); actorID != "" {
+		prefix = append(prefix, "actorID", actorID)
+	}
+
+	if tenantID := TenantIDFromContext(ctx); tenantID != "" {
+		prefix = append(prefix, "tenantID", tenantID)
+	}
+
+	return append(prefix, args...)
 }
 
 func NewLogger() Logger {
@@ -16,3 +16,34 @@ func UserIDFromContext(ctx context.Context) string {
 
 	return id
 }
+
+type actorIDContextKey struct{}
+
+// WithActorID returns a new context carrying the ID of the admin acting on behalf of the user
+// stored by WithUserID (impersonation).
+func WithActorID(ctx context.Context, actorID string) context.Context {
+	return context.WithValue(ctx, actorIDContextKey{}, actorID)
+}
+
+// ActorIDFromContext extracts the actor ID stored by WithActorID.
+// Returns an empty string when the request is not impersonated.
+func ActorIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(actorIDContextKey{}).(string)
+
+	return id
+}
+
+type tenantIDContextKey struct{}
+
+// WithTenantID returns a new context carrying the ID of the organization the request acts in.
+func WithTenantID(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantIDContextKey{}, tenantID)
+}
+
+// TenantIDFromContext extracts the tenant (organization) ID stored by WithTenantID.
+// Returns an empty string if no tenant is active.
+func TenantIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(tenantIDContextKey{}).(string)
+
+	return id
+}
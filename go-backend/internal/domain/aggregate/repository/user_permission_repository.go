@@ -10,6 +10,7 @@ import (
 )
 
 // UserPermissionRepository is the port for fetching a user's effective permission aggregate.
+// Effective permissions are the user's platform-wide roles plus the role held in the active tenant.
 type UserPermissionRepository interface {
 	FindByUserID(ctx context.Context, userID uuid.UUID) (*aggregate.UserPermissionAggregate, error)
 }
@@ -0,0 +1,16 @@
+//go:generate mockgen -source=user_relationship_repository.go -destination=../../../../test/mock/domain/aggregate/repository/mock_user_relationship_repository.go
+
+package repository
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate"
+	"github.com/google/uuid"
+)
+
+// UserRelationshipRepository is the port for fetching the blocks and mutes concerning a user in the active
+// tenant in ctx.
+type UserRelationshipRepository interface {
+	FindByUserID(ctx context.Context, userID uuid.UUID) (*aggregate.UserRelationshipAggregate, error)
+}
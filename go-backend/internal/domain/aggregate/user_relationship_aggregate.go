@@ -0,0 +1,29 @@
+package aggregate
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// UserRelationshipAggregate holds the blocks and mutes that keep content away from a user: a block, in
+// either direction, hides the two users from each other everywhere and keeps them from interacting, while a
+// mute only hides the muted user from the muter's feeds and threads. The queries apply the same rule through
+// the user_exclusions view; the aggregate serves the use cases that check it before they write.
+type UserRelationshipAggregate struct {
+	UserID uuid.UUID
+	// BlockedIDs are the users UserID blocked or is blocked by.
+	BlockedIDs []uuid.UUID
+	// MutedIDs are the users UserID muted.
+	MutedIDs []uuid.UUID
+}
+
+// Blocks reports whether UserID and userID block one another, in either direction.
+func (a *UserRelationshipAggregate) Blocks(userID uuid.UUID) bool {
+	return slices.Contains(a.BlockedIDs, userID)
+}
+
+// Hides reports whether the content of userID is kept out of the feeds and threads of UserID.
+func (a *UserRelationshipAggregate) Hides(userID uuid.UUID) bool {
+	return a.Blocks(userID) || slices.Contains(a.MutedIDs, userID)
+}
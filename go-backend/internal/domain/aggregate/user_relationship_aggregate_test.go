@@ -0,0 +1,45 @@
+package aggregate_test
+
+import (
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserRelationshipAggregate(t *testing.T) {
+	blocked, muted, both, other := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	relationships := &aggregate.UserRelationshipAggregate{
+		UserID:     uuid.New(),
+		BlockedIDs: []uuid.UUID{blocked, both},
+		MutedIDs:   []uuid.UUID{muted, both},
+	}
+
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		blocks bool
+		hides  bool
+	}{
+		{name: "blocked user", userID: blocked, blocks: true, hides: true},
+		{name: "muted user", userID: muted, blocks: false, hides: true},
+		{name: "blocked and muted user", userID: both, blocks: true, hides: true},
+		{name: "unrelated user", userID: other, blocks: false, hides: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.blocks, relationships.Blocks(tt.userID))
+			assert.Equal(t, tt.hides, relationships.Hides(tt.userID))
+		})
+	}
+}
+
+func TestUserRelationshipAggregate_Empty(t *testing.T) {
+	relationships := &aggregate.UserRelationshipAggregate{UserID: uuid.New()}
+
+	assert.False(t, relationships.Blocks(uuid.New()))
+	assert.False(t, relationships.Hides(uuid.New()))
+}
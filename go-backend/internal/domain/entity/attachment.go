@@ -0,0 +1,233 @@
+//go:generate mockgen -source=attachment.go -destination=../../../test/mock/domain/entity/mock_attachment.go
+
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxAttachmentSize is the largest file, in bytes, that can be uploaded.
+	MaxAttachmentSize = 10 << 20
+	// MaxPostAttachments is the number of attachments a post can carry.
+	MaxPostAttachments = 4
+)
+
+var (
+	errIllegalAttachmentSize = errors.New("illegal attachment size")
+	errAttachmentAttached    = errors.New("attachment is already attached")
+	errNotAnImage            = errors.New("attachment is not an image")
+	errTooManyAttachments    = errors.New("too many attachments")
+	errDuplicateAttachment   = errors.New("duplicate attachment")
+)
+
+// Attachment is a file uploaded for a post. The bytes live in blob storage under StorageKey; images also
+// have a thumbnail under ThumbnailKey. An attachment is uploaded first and attached to a post when the post
+// is created; until then only the uploader can see it.
+type Attachment interface {
+	ID() uuid.UUID
+	UploaderID() uuid.UUID
+	// PostID is nil until the attachment is attached.
+	PostID() *uuid.UUID
+	// Position orders the attachments of one post, starting at 0.
+	Position() int
+	FileName() string
+	ContentType() vo.AttachmentContentType
+	Size() int64
+	// Width and Height are the pixel dimensions of images with a thumbnail, and 0 otherwise.
+	Width() int
+	Height() int
+	StorageKey() string
+	// ThumbnailKey is empty unless the attachment has a thumbnail.
+	ThumbnailKey() string
+	CreatedAt() time.Time
+	// AddThumbnail records that the image, of the given dimensions, has a thumbnail.
+	AddThumbnail(width, height int) error
+	// AttachTo attaches the attachment to postID at position. An attachment is attached at most once.
+	AttachTo(postID uuid.UUID, position int) error
+}
+
+type attachmentImpl struct {
+	id           uuid.UUID
+	uploaderID   uuid.UUID
+	postID       *uuid.UUID
+	position     int
+	fileName     string
+	contentType  vo.AttachmentContentType
+	size         int64
+	width        int
+	height       int
+	storageKey   string
+	thumbnailKey string
+	createdAt    time.Time
+}
+
+func (a *attachmentImpl) ID() uuid.UUID {
+	return a.id
+}
+
+func (a *attachmentImpl) UploaderID() uuid.UUID {
+	return a.uploaderID
+}
+
+func (a *attachmentImpl) PostID() *uuid.UUID {
+	return a.postID
+}
+
+func (a *attachmentImpl) Position() int {
+	return a.position
+}
+
+func (a *attachmentImpl) FileName() string {
+	return a.fileName
+}
+
+func (a *attachmentImpl) ContentType() vo.AttachmentContentType {
+	return a.contentType
+}
+
+func (a *attachmentImpl) Size() int64 {
+	return a.size
+}
+
+func (a *attachmentImpl) Width() int {
+	return a.width
+}
+
+func (a *attachmentImpl) Height() int {
+	return a.height
+}
+
+func (a *attachmentImpl) StorageKey() string {
+	return a.storageKey
+}
+
+func (a *attachmentImpl) ThumbnailKey() string {
+	return a.thumbnailKey
+}
+
+func (a *attachmentImpl) CreatedAt() time.Time {
+	return a.createdAt
+}
+
+func (a *attachmentImpl) AddThumbnail(width, height int) error {
+	if !a.contentType.IsImage() {
+		return fmt.Errorf("%w: %s", errNotAnImage, a.contentType)
+	}
+
+	a.width = width
+	a.height = height
+	a.thumbnailKey = attachmentStorageKeyPrefix(a.id) + "thumbnail"
+
+	return nil
+}
+
+func (a *attachmentImpl) AttachTo(postID uuid.UUID, position int) error {
+	if a.postID != nil {
+		return vo.NewValidationError("attachment is already attached to a post", map[string]any{
+			"attachment_id": a.id,
+		}, errAttachmentAttached)
+	}
+
+	a.postID = &postID
+	a.position = position
+
+	return nil
+}
+
+// ValidateAttachmentIDs checks the attachments chosen for a new post: at most MaxPostAttachments of them,
+// none repeated.
+func ValidateAttachmentIDs(ids []uuid.UUID) error {
+	if len(ids) > MaxPostAttachments {
+		return vo.NewValidationError(
+			fmt.Sprintf("a post can have at most %d attachments", MaxPostAttachments),
+			map[string]any{"max_attachments": MaxPostAttachments},
+			errTooManyAttachments,
+		)
+	}
+
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return vo.NewValidationError("attachments must not repeat", map[string]any{
+				"attachment_id": id.String(),
+			}, errDuplicateAttachment)
+		}
+
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// NewAttachment creates an unattached attachment with a generated UUID, validating the file name and that
+// size is between 1 byte and MaxAttachmentSize.
+func NewAttachment(
+	uploaderID uuid.UUID, fileName string, contentType vo.AttachmentContentType, size int64, createdAt time.Time,
+) (Attachment, error) {
+	if size <= 0 || size > MaxAttachmentSize {
+		return nil, vo.NewValidationError(
+			fmt.Sprintf("file must be between 1 byte and %d bytes", MaxAttachmentSize),
+			map[string]any{"max_bytes": MaxAttachmentSize},
+			errIllegalAttachmentSize,
+		)
+	}
+
+	name, err := vo.NewFileName(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return &attachmentImpl{
+		id:          id,
+		uploaderID:  uploaderID,
+		fileName:    name.String(),
+		contentType: contentType,
+		size:        size,
+		storageKey:  attachmentStorageKeyPrefix(id) + "original",
+		createdAt:   createdAt,
+	}, nil
+}
+
+// ReconstructAttachment rebuilds an Attachment from persisted values without validation.
+func ReconstructAttachment(
+	id, uploaderID uuid.UUID,
+	postID *uuid.UUID,
+	position int,
+	fileName string,
+	contentType vo.AttachmentContentType,
+	size int64,
+	width, height int,
+	storageKey, thumbnailKey string,
+	createdAt time.Time,
+) Attachment {
+	return &attachmentImpl{
+		id:           id,
+		uploaderID:   uploaderID,
+		postID:       postID,
+		position:     position,
+		fileName:     fileName,
+		contentType:  contentType,
+		size:         size,
+		width:        width,
+		height:       height,
+		storageKey:   storageKey,
+		thumbnailKey: thumbnailKey,
+		createdAt:    createdAt,
+	}
+}
+
+// attachmentStorageKeyPrefix groups the blobs of one attachment.
+func attachmentStorageKeyPrefix(id uuid.UUID) string {
+	return "attachments/" + id.String() + "/"
+}
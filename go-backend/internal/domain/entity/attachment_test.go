@@ -0,0 +1,116 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAttachment_HappyCase(t *testing.T) {
+	uploaderID := uuid.New()
+	now := time.Now()
+
+	attachment, err := entity.NewAttachment(uploaderID, `C:\fakepath\photo.png`, vo.AttachmentContentTypePNG, 1024, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, uploaderID, attachment.UploaderID())
+	assert.Nil(t, attachment.PostID())
+	assert.Equal(t, "photo.png", attachment.FileName())
+	assert.Equal(t, vo.AttachmentContentTypePNG, attachment.ContentType())
+	assert.Equal(t, int64(1024), attachment.Size())
+	assert.Equal(t, "attachments/"+attachment.ID().String()+"/original", attachment.StorageKey())
+	assert.Empty(t, attachment.ThumbnailKey())
+	assert.Equal(t, now, attachment.CreatedAt())
+
+	require.NoError(t, attachment.AddThumbnail(640, 480))
+	assert.Equal(t, "attachments/"+attachment.ID().String()+"/thumbnail", attachment.ThumbnailKey())
+	assert.Equal(t, 640, attachment.Width())
+	assert.Equal(t, 480, attachment.Height())
+}
+
+func TestNewAttachment_FailureCase(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		size     int64
+	}{
+		{name: "empty file", fileName: "a.txt", size: 0},
+		{name: "too large", fileName: "a.txt", size: entity.MaxAttachmentSize + 1},
+		{name: "no file name", fileName: " ", size: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attachment, err := entity.NewAttachment(uuid.New(), tt.fileName, vo.AttachmentContentTypeText, tt.size,
+				time.Now())
+
+			require.Error(t, err)
+			assert.Nil(t, attachment)
+
+			var domainErr vo.Error
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, vo.ValidationErrorCode, domainErr.Code())
+		})
+	}
+}
+
+func TestAttachment_AddThumbnail_NotAnImage(t *testing.T) {
+	attachment, err := entity.NewAttachment(uuid.New(), "doc.pdf", vo.AttachmentContentTypePDF, 10, time.Now())
+	require.NoError(t, err)
+
+	require.Error(t, attachment.AddThumbnail(1, 1))
+	assert.Empty(t, attachment.ThumbnailKey())
+}
+
+func TestAttachment_AttachTo(t *testing.T) {
+	attachment, err := entity.NewAttachment(uuid.New(), "a.txt", vo.AttachmentContentTypeText, 10, time.Now())
+	require.NoError(t, err)
+
+	postID := uuid.New()
+	require.NoError(t, attachment.AttachTo(postID, 2))
+	assert.Equal(t, &postID, attachment.PostID())
+	assert.Equal(t, 2, attachment.Position())
+
+	// An attachment stays on its first post.
+	err = attachment.AttachTo(uuid.New(), 0)
+
+	var domainErr vo.Error
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, vo.ValidationErrorCode, domainErr.Code())
+	assert.Equal(t, &postID, attachment.PostID())
+}
+
+func TestValidateAttachmentIDs(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name    string
+		ids     []uuid.UUID
+		wantErr bool
+	}{
+		{name: "none", ids: nil},
+		{name: "up to the limit", ids: []uuid.UUID{a, b, uuid.New(), uuid.New()}},
+		{name: "over the limit", ids: []uuid.UUID{a, b, uuid.New(), uuid.New(), uuid.New()}, wantErr: true},
+		{name: "repeated", ids: []uuid.UUID{a, b, a}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := entity.ValidateAttachmentIDs(tt.ids)
+			if !tt.wantErr {
+				require.NoError(t, err)
+
+				return
+			}
+
+			var domainErr vo.Error
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, vo.ValidationErrorCode, domainErr.Code())
+		})
+	}
+}
@@ -0,0 +1,53 @@
+//go:generate mockgen -source=block.go -destination=../../../test/mock/domain/entity/mock_block.go
+
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+var errSelfBlock = errors.New("user cannot block themselves")
+
+// Block is a user blocking another user inside the active organization, so that the two neither see nor
+// interact with each other; see aggregate.UserRelationshipAggregate. The blocker and the blocked user
+// identify a block.
+type Block interface {
+	BlockerID() uuid.UUID
+	BlockedID() uuid.UUID
+	CreatedAt() time.Time
+}
+
+type blockImpl struct {
+	blockerID uuid.UUID
+	blockedID uuid.UUID
+	createdAt time.Time
+}
+
+func (b *blockImpl) BlockerID() uuid.UUID {
+	return b.blockerID
+}
+
+func (b *blockImpl) BlockedID() uuid.UUID {
+	return b.blockedID
+}
+
+func (b *blockImpl) CreatedAt() time.Time {
+	return b.createdAt
+}
+
+// NewBlock creates a block of blockedID by blockerID, validating that a user does not block themselves.
+func NewBlock(blockerID, blockedID uuid.UUID, createdAt time.Time) (Block, error) {
+	if blockerID == blockedID {
+		return nil, vo.NewValidationError("users cannot block themselves", nil, errSelfBlock)
+	}
+
+	return &blockImpl{
+		blockerID: blockerID,
+		blockedID: blockedID,
+		createdAt: createdAt,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBlock_HappyCase(t *testing.T) {
+	blockerID, blockedID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	block, err := entity.NewBlock(blockerID, blockedID, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, blockerID, block.BlockerID())
+	assert.Equal(t, blockedID, block.BlockedID())
+	assert.Equal(t, now, block.CreatedAt())
+}
+
+func TestNewBlock_Self(t *testing.T) {
+	userID := uuid.New()
+
+	block, err := entity.NewBlock(userID, userID, time.Now())
+
+	var voErr vo.Error
+	require.ErrorAs(t, err, &voErr)
+	assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+	assert.Nil(t, block)
+}
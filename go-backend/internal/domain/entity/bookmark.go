@@ -0,0 +1,44 @@
+//go:generate mockgen -source=bookmark.go -destination=../../../test/mock/domain/entity/mock_bookmark.go
+
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Bookmark is a post a user saved to read later. Bookmarks are private to the user; the post and the user
+// identify a bookmark.
+type Bookmark interface {
+	PostID() uuid.UUID
+	UserID() uuid.UUID
+	CreatedAt() time.Time
+}
+
+type bookmarkImpl struct {
+	postID    uuid.UUID
+	userID    uuid.UUID
+	createdAt time.Time
+}
+
+func (b *bookmarkImpl) PostID() uuid.UUID {
+	return b.postID
+}
+
+func (b *bookmarkImpl) UserID() uuid.UUID {
+	return b.userID
+}
+
+func (b *bookmarkImpl) CreatedAt() time.Time {
+	return b.createdAt
+}
+
+// NewBookmark creates a bookmark of postID by userID.
+func NewBookmark(postID, userID uuid.UUID, createdAt time.Time) Bookmark {
+	return &bookmarkImpl{
+		postID:    postID,
+		userID:    userID,
+		createdAt: createdAt,
+	}
+}
@@ -0,0 +1,94 @@
+//go:generate mockgen -source=collection.go -destination=../../../test/mock/domain/entity/mock_collection.go
+
+package entity
+
+import (
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// Collection is a named list of posts a user put together, in the order the posts were added. Collections
+// are private to the user who created them.
+type Collection interface {
+	ID() uuid.UUID
+	UserID() uuid.UUID
+	Name() string
+	CreatedAt() time.Time
+	UpdatedAt() time.Time
+	// Rename validates and applies a new name, moving UpdatedAt to now.
+	Rename(name string, now time.Time) error
+}
+
+type collectionImpl struct {
+	id        uuid.UUID
+	userID    uuid.UUID
+	name      string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func (c *collectionImpl) ID() uuid.UUID {
+	return c.id
+}
+
+func (c *collectionImpl) UserID() uuid.UUID {
+	return c.userID
+}
+
+func (c *collectionImpl) Name() string {
+	return c.name
+}
+
+func (c *collectionImpl) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *collectionImpl) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+func (c *collectionImpl) Rename(name string, now time.Time) error {
+	n, err := vo.NewCollectionName(name)
+	if err != nil {
+		return err
+	}
+
+	c.name = n.String()
+	c.updatedAt = now
+
+	return nil
+}
+
+// NewCollection creates an empty collection of userID with a generated UUID, validating its name.
+func NewCollection(userID uuid.UUID, name string, createdAt time.Time) (Collection, error) {
+	n, err := vo.NewCollectionName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return &collectionImpl{
+		id:        id,
+		userID:    userID,
+		name:      n.String(),
+		createdAt: createdAt,
+		updatedAt: createdAt,
+	}, nil
+}
+
+// ReconstructCollection rebuilds a Collection from persisted values without validation.
+func ReconstructCollection(id, userID uuid.UUID, name string, createdAt, updatedAt time.Time) Collection {
+	return &collectionImpl{
+		id:        id,
+		userID:    userID,
+		name:      name,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
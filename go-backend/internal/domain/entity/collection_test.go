@@ -0,0 +1,60 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCollection_HappyCase(t *testing.T) {
+	userID := uuid.New()
+	now := time.Now()
+
+	collection, err := entity.NewCollection(userID, "  Read later ", now)
+
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, collection.ID())
+	assert.Equal(t, userID, collection.UserID())
+	assert.Equal(t, "Read later", collection.Name())
+	assert.Equal(t, now, collection.CreatedAt())
+	assert.Equal(t, now, collection.UpdatedAt())
+}
+
+func TestNewCollection_InvalidName(t *testing.T) {
+	for name, input := range map[string]string{
+		"empty name": " ",
+		"too long":   strings.Repeat("a", 101),
+	} {
+		t.Run(name, func(t *testing.T) {
+			collection, err := entity.NewCollection(uuid.New(), input, time.Now())
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+			assert.Nil(t, collection)
+		})
+	}
+}
+
+func TestCollection_Rename(t *testing.T) {
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	renamedAt := createdAt.Add(time.Hour)
+	collection := entity.ReconstructCollection(uuid.New(), uuid.New(), "Recipes", createdAt, createdAt)
+
+	require.NoError(t, collection.Rename("Dinner recipes", renamedAt))
+	assert.Equal(t, "Dinner recipes", collection.Name())
+	assert.Equal(t, renamedAt, collection.UpdatedAt())
+
+	err := collection.Rename("", renamedAt.Add(time.Hour))
+
+	var voErr vo.Error
+	require.ErrorAs(t, err, &voErr)
+	assert.Equal(t, "Dinner recipes", collection.Name())
+	assert.Equal(t, renamedAt, collection.UpdatedAt())
+}
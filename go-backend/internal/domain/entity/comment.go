@@ -0,0 +1,130 @@
+//go:generate mockgen -source=comment.go -destination=../../../test/mock/domain/entity/mock_comment.go
+
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// maxCommentDepth is the depth of the deepest reply; top-level comments have depth 0.
+const maxCommentDepth = 8
+
+var (
+	errCommentParentOnOtherPost = errors.New("parent comment belongs to another post")
+	errCommentTooDeep           = errors.New("comment thread is too deep")
+)
+
+// Comment is a comment on a post. A comment either answers the post directly or replies to another
+// comment on the same post, which makes the comments of a post a forest of threads.
+type Comment interface {
+	ID() uuid.UUID
+	PostID() uuid.UUID
+	// ParentID is nil for a top-level comment.
+	ParentID() *uuid.UUID
+	UserID() uuid.UUID
+	Content() string
+	// Depth is the number of ancestors: 0 for a top-level comment, parent depth + 1 for a reply.
+	Depth() int
+	CreatedAt() time.Time
+}
+
+type commentImpl struct {
+	id        uuid.UUID
+	postID    uuid.UUID
+	parentID  *uuid.UUID
+	userID    uuid.UUID
+	content   string
+	depth     int
+	createdAt time.Time
+}
+
+func (c *commentImpl) ID() uuid.UUID {
+	return c.id
+}
+
+func (c *commentImpl) PostID() uuid.UUID {
+	return c.postID
+}
+
+func (c *commentImpl) ParentID() *uuid.UUID {
+	return c.parentID
+}
+
+func (c *commentImpl) UserID() uuid.UUID {
+	return c.userID
+}
+
+func (c *commentImpl) Content() string {
+	return c.content
+}
+
+func (c *commentImpl) Depth() int {
+	return c.depth
+}
+
+func (c *commentImpl) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// NewComment creates a comment by userID on postID with a generated UUID, validating the content like a
+// post's. parent is nil for a top-level comment; otherwise it must be a comment on the same post whose
+// depth is below maxCommentDepth.
+func NewComment(postID, userID uuid.UUID, parent Comment, content string, createdAt time.Time) (Comment, error) {
+	c, err := vo.NewContent(content)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &commentImpl{
+		postID:    postID,
+		userID:    userID,
+		content:   c.String(),
+		createdAt: createdAt,
+	}
+
+	if parent != nil {
+		if parent.PostID() != postID {
+			return nil, vo.NewValidationError("parent comment does not belong to this post", nil,
+				errCommentParentOnOtherPost)
+		}
+
+		if parent.Depth() >= maxCommentDepth {
+			return nil, vo.NewValidationError(
+				fmt.Sprintf("replies can be nested at most %d levels deep", maxCommentDepth),
+				map[string]any{"max_depth": maxCommentDepth},
+				errCommentTooDeep,
+			)
+		}
+
+		parentID := parent.ID()
+		comment.parentID = &parentID
+		comment.depth = parent.Depth() + 1
+	}
+
+	comment.id, err = uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// ReconstructComment rebuilds a Comment from persisted values without validation.
+func ReconstructComment(
+	id, postID uuid.UUID, parentID *uuid.UUID, userID uuid.UUID, content string, depth int, createdAt time.Time,
+) Comment {
+	return &commentImpl{
+		id:        id,
+		postID:    postID,
+		parentID:  parentID,
+		userID:    userID,
+		content:   content,
+		depth:     depth,
+		createdAt: createdAt,
+	}
+}
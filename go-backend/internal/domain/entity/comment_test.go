@@ -0,0 +1,82 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewComment_HappyCase(t *testing.T) {
+	postID, userID := uuid.New(), uuid.New()
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	parentID := uuid.New()
+	parent := entity.ReconstructComment(parentID, postID, nil, uuid.New(), "parent", 2, createdAt)
+
+	tests := []struct {
+		name         string
+		parent       entity.Comment
+		wantParentID *uuid.UUID
+		wantDepth    int
+	}{
+		{name: "top-level comment has no parent and depth 0", parent: nil, wantParentID: nil, wantDepth: 0},
+		{name: "reply is one level below its parent", parent: parent, wantParentID: &parentID, wantDepth: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comment, err := entity.NewComment(postID, userID, tt.parent, "  nice post  ", createdAt)
+
+			require.NoError(t, err)
+			assert.Equal(t, uuid.Version(7), comment.ID().Version())
+			assert.Equal(t, postID, comment.PostID())
+			assert.Equal(t, tt.wantParentID, comment.ParentID())
+			assert.Equal(t, userID, comment.UserID())
+			assert.Equal(t, "nice post", comment.Content())
+			assert.Equal(t, tt.wantDepth, comment.Depth())
+			assert.Equal(t, createdAt, comment.CreatedAt())
+		})
+	}
+}
+
+func TestNewComment_FailureCase(t *testing.T) {
+	postID := uuid.New()
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		parent  entity.Comment
+		content string
+	}{
+		{name: "empty content", content: "   "},
+		{name: "content too long", content: strings.Repeat("a", 10001)},
+		{
+			name:    "parent on another post",
+			parent:  entity.ReconstructComment(uuid.New(), uuid.New(), nil, uuid.New(), "parent", 0, now),
+			content: "reply",
+		},
+		{
+			name:    "parent at the maximum depth",
+			parent:  entity.ReconstructComment(uuid.New(), postID, nil, uuid.New(), "parent", 8, now),
+			content: "reply",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comment, err := entity.NewComment(postID, uuid.New(), tt.parent, tt.content, now)
+
+			require.Error(t, err)
+			assert.Nil(t, comment)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
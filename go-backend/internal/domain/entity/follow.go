@@ -0,0 +1,52 @@
+//go:generate mockgen -source=follow.go -destination=../../../test/mock/domain/entity/mock_follow.go
+
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+var errSelfFollow = errors.New("user cannot follow themselves")
+
+// Follow is a user following another user inside the active organization, so that the followee's posts
+// appear on the follower's home timeline. The follower and the followee identify a follow.
+type Follow interface {
+	FollowerID() uuid.UUID
+	FolloweeID() uuid.UUID
+	CreatedAt() time.Time
+}
+
+type followImpl struct {
+	followerID uuid.UUID
+	followeeID uuid.UUID
+	createdAt  time.Time
+}
+
+func (f *followImpl) FollowerID() uuid.UUID {
+	return f.followerID
+}
+
+func (f *followImpl) FolloweeID() uuid.UUID {
+	return f.followeeID
+}
+
+func (f *followImpl) CreatedAt() time.Time {
+	return f.createdAt
+}
+
+// NewFollow creates a follow of followeeID by followerID, validating that a user does not follow themselves.
+func NewFollow(followerID, followeeID uuid.UUID, createdAt time.Time) (Follow, error) {
+	if followerID == followeeID {
+		return nil, vo.NewValidationError("users cannot follow themselves", nil, errSelfFollow)
+	}
+
+	return &followImpl{
+		followerID: followerID,
+		followeeID: followeeID,
+		createdAt:  createdAt,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFollow_HappyCase(t *testing.T) {
+	followerID, followeeID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	follow, err := entity.NewFollow(followerID, followeeID, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, followerID, follow.FollowerID())
+	assert.Equal(t, followeeID, follow.FolloweeID())
+	assert.Equal(t, now, follow.CreatedAt())
+}
+
+func TestNewFollow_Self(t *testing.T) {
+	userID := uuid.New()
+
+	follow, err := entity.NewFollow(userID, userID, time.Now())
+
+	var voErr vo.Error
+	require.ErrorAs(t, err, &voErr)
+	assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+	assert.Nil(t, follow)
+}
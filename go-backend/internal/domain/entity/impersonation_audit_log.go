@@ -0,0 +1,85 @@
+//go:generate mockgen -source=impersonation_audit_log.go -destination=../../../test/mock/domain/entity/mock_impersonation_audit_log.go
+
+package entity
+
+import (
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// ImpersonationAuditLog is an append-only record of an admin acting as another user.
+type ImpersonationAuditLog interface {
+	ID() uuid.UUID
+	// ActorID is the admin who impersonates.
+	ActorID() uuid.UUID
+	// SubjectID is the impersonated user.
+	SubjectID() uuid.UUID
+	Action() vo.ImpersonationAuditAction
+	// Method and Path describe the HTTP request that produced the entry.
+	Method() string
+	Path() string
+	CreatedAt() time.Time
+}
+
+type impersonationAuditLogImpl struct {
+	id        uuid.UUID
+	actorID   uuid.UUID
+	subjectID uuid.UUID
+	action    vo.ImpersonationAuditAction
+	method    string
+	path      string
+	createdAt time.Time
+}
+
+func (l *impersonationAuditLogImpl) ID() uuid.UUID {
+	return l.id
+}
+
+func (l *impersonationAuditLogImpl) ActorID() uuid.UUID {
+	return l.actorID
+}
+
+func (l *impersonationAuditLogImpl) SubjectID() uuid.UUID {
+	return l.subjectID
+}
+
+func (l *impersonationAuditLogImpl) Action() vo.ImpersonationAuditAction {
+	return l.action
+}
+
+func (l *impersonationAuditLogImpl) Method() string {
+	return l.method
+}
+
+func (l *impersonationAuditLogImpl) Path() string {
+	return l.path
+}
+
+func (l *impersonationAuditLogImpl) CreatedAt() time.Time {
+	return l.createdAt
+}
+
+// NewImpersonationAuditLog creates a new ImpersonationAuditLog with a generated UUID.
+func NewImpersonationAuditLog(
+	actorID, subjectID uuid.UUID,
+	action vo.ImpersonationAuditAction,
+	method, path string,
+	createdAt time.Time,
+) (ImpersonationAuditLog, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return &impersonationAuditLogImpl{
+		id:        id,
+		actorID:   actorID,
+		subjectID: subjectID,
+		action:    action,
+		method:    method,
+		path:      path,
+		createdAt: createdAt,
+	}, nil
+}
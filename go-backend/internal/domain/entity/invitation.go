@@ -0,0 +1,181 @@
+//go:generate mockgen -source=invitation.go -destination=../../../test/mock/domain/entity/mock_invitation.go
+
+package entity
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+var (
+	errIllegalInvitationExpiry = errors.New("invitation expires_at must be after created_at")
+	errIllegalInvitationRole   = errors.New("invitation role id is required")
+	errInvitationExpired       = errors.New("invitation has expired")
+	errInvitationAccepted      = errors.New("invitation has already been accepted")
+	errInvitationEmailMismatch = errors.New("email does not match the invitation")
+)
+
+// Invitation lets one specific email address sign up, optionally with roles granted on acceptance.
+type Invitation interface {
+	ID() uuid.UUID
+	// Email is the only address that may accept the invitation.
+	Email() string
+	// RoleIDs are granted to the new user when the invitation is accepted.
+	RoleIDs() []uuid.UUID
+	// InvitedBy returns the inviting admin; uuid.Nil once that user has been deleted.
+	InvitedBy() uuid.UUID
+	// TokenHash is the stored digest of the token sent to the invitee.
+	TokenHash() []byte
+	ExpiresAt() time.Time
+	// AcceptedAt returns nil while the invitation is pending.
+	AcceptedAt() *time.Time
+	// AcceptedBy returns the user created from the invitation; uuid.Nil while it is pending.
+	AcceptedBy() uuid.UUID
+	CreatedAt() time.Time
+	// Accept marks the invitation as used by the user with the given email at now. It fails when the
+	// invitation has expired, was already accepted, or is addressed to a different email.
+	Accept(userID uuid.UUID, email string, now time.Time) error
+}
+
+type invitationImpl struct {
+	id         uuid.UUID
+	email      string
+	roleIDs    []uuid.UUID
+	invitedBy  uuid.UUID
+	tokenHash  []byte
+	expiresAt  time.Time
+	acceptedAt *time.Time
+	acceptedBy uuid.UUID
+	createdAt  time.Time
+}
+
+func (i *invitationImpl) ID() uuid.UUID {
+	return i.id
+}
+
+func (i *invitationImpl) Email() string {
+	return i.email
+}
+
+func (i *invitationImpl) RoleIDs() []uuid.UUID {
+	return slices.Clone(i.roleIDs)
+}
+
+func (i *invitationImpl) InvitedBy() uuid.UUID {
+	return i.invitedBy
+}
+
+func (i *invitationImpl) TokenHash() []byte {
+	return slices.Clone(i.tokenHash)
+}
+
+func (i *invitationImpl) ExpiresAt() time.Time {
+	return i.expiresAt
+}
+
+func (i *invitationImpl) AcceptedAt() *time.Time {
+	return i.acceptedAt
+}
+
+func (i *invitationImpl) AcceptedBy() uuid.UUID {
+	return i.acceptedBy
+}
+
+func (i *invitationImpl) CreatedAt() time.Time {
+	return i.createdAt
+}
+
+func (i *invitationImpl) Accept(userID uuid.UUID, email string, now time.Time) error {
+	if i.acceptedAt != nil {
+		return vo.NewValidationError("invitation has already been accepted", nil, errInvitationAccepted)
+	}
+
+	if !now.Before(i.expiresAt) {
+		return vo.NewValidationError("invitation has expired", nil, errInvitationExpired)
+	}
+
+	// vo.Email lowercases only the domain; invitations are matched case-insensitively throughout.
+	if !strings.EqualFold(i.email, email) {
+		return vo.NewValidationError("email does not match the invitation", nil, errInvitationEmailMismatch)
+	}
+
+	i.acceptedAt = &now
+	i.acceptedBy = userID
+
+	return nil
+}
+
+// NewInvitation creates a pending Invitation with a generated UUID. Duplicate role IDs are collapsed.
+func NewInvitation(
+	email string,
+	roleIDs []uuid.UUID,
+	invitedBy uuid.UUID,
+	token vo.InvitationToken,
+	expiresAt, createdAt time.Time,
+) (Invitation, error) {
+	e, err := vo.NewEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	if !expiresAt.After(createdAt) {
+		return nil, vo.NewValidationError("expiresAt must be in the future", nil, errIllegalInvitationExpiry)
+	}
+
+	roles := make([]uuid.UUID, 0, len(roleIDs))
+
+	for _, roleID := range roleIDs {
+		if roleID == uuid.Nil {
+			return nil, vo.NewValidationError("roleIds must not contain an empty id", nil, errIllegalInvitationRole)
+		}
+
+		if !slices.Contains(roles, roleID) {
+			roles = append(roles, roleID)
+		}
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return &invitationImpl{
+		id:        id,
+		email:     e.String(),
+		roleIDs:   roles,
+		invitedBy: invitedBy,
+		tokenHash: token.Hash(),
+		expiresAt: expiresAt,
+		createdAt: createdAt,
+	}, nil
+}
+
+// ReconstructInvitation rebuilds an Invitation from persisted values without validation.
+func ReconstructInvitation(
+	id uuid.UUID,
+	email string,
+	roleIDs []uuid.UUID,
+	invitedBy uuid.UUID,
+	tokenHash []byte,
+	expiresAt time.Time,
+	acceptedAt *time.Time,
+	acceptedBy uuid.UUID,
+	createdAt time.Time,
+) Invitation {
+	return &invitationImpl{
+		id:         id,
+		email:      email,
+		roleIDs:    roleIDs,
+		invitedBy:  invitedBy,
+		tokenHash:  tokenHash,
+		expiresAt:  expiresAt,
+		acceptedAt: acceptedAt,
+		acceptedBy: acceptedBy,
+		createdAt:  createdAt,
+	}
+}
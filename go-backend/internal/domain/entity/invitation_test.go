@@ -0,0 +1,136 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInvitation_HappyCase(t *testing.T) {
+	createdAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := createdAt.Add(7 * 24 * time.Hour)
+	roleA, roleB := uuid.New(), uuid.New()
+	inviterID := uuid.New()
+	token := vo.InvitationToken("token")
+
+	tests := []struct {
+		name      string
+		roleIDs   []uuid.UUID
+		wantRoles []uuid.UUID
+	}{
+		{name: "without roles", roleIDs: nil, wantRoles: []uuid.UUID{}},
+		{
+			name:      "duplicate roles are collapsed",
+			roleIDs:   []uuid.UUID{roleA, roleB, roleA},
+			wantRoles: []uuid.UUID{roleA, roleB},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invitation, err := entity.NewInvitation("invitee@Example.com", tt.roleIDs, inviterID, token, expiresAt, createdAt)
+
+			require.NoError(t, err)
+			assert.NotEqual(t, uuid.Nil, invitation.ID())
+			assert.Equal(t, "invitee@example.com", invitation.Email())
+			assert.Equal(t, tt.wantRoles, invitation.RoleIDs())
+			assert.Equal(t, inviterID, invitation.InvitedBy())
+			assert.Equal(t, token.Hash(), invitation.TokenHash())
+			assert.Equal(t, expiresAt, invitation.ExpiresAt())
+			assert.Equal(t, createdAt, invitation.CreatedAt())
+			assert.Nil(t, invitation.AcceptedAt())
+			assert.Equal(t, uuid.Nil, invitation.AcceptedBy())
+		})
+	}
+}
+
+func TestNewInvitation_FailureCase(t *testing.T) {
+	createdAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		email     string
+		roleIDs   []uuid.UUID
+		expiresAt time.Time
+	}{
+		{name: "invalid email", email: "not-an-email", expiresAt: createdAt.Add(time.Hour)},
+		{name: "expiresAt equal to createdAt", email: "a@example.com", expiresAt: createdAt},
+		{name: "expiresAt before createdAt", email: "a@example.com", expiresAt: createdAt.Add(-time.Hour)},
+		{
+			name:      "nil role id",
+			email:     "a@example.com",
+			roleIDs:   []uuid.UUID{uuid.Nil},
+			expiresAt: createdAt.Add(time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invitation, err := entity.NewInvitation(
+				tt.email, tt.roleIDs, uuid.New(), vo.InvitationToken("token"), tt.expiresAt, createdAt,
+			)
+
+			require.Error(t, err)
+			assert.Nil(t, invitation)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
+
+func TestInvitation_Accept_HappyCase(t *testing.T) {
+	createdAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	now := createdAt.Add(time.Hour)
+	userID := uuid.New()
+
+	invitation := entity.ReconstructInvitation(
+		uuid.New(), "invitee@example.com", nil, uuid.New(), nil,
+		createdAt.Add(24*time.Hour), nil, uuid.Nil, createdAt,
+	)
+
+	err := invitation.Accept(userID, "Invitee@example.com", now)
+
+	require.NoError(t, err)
+	assert.Equal(t, &now, invitation.AcceptedAt())
+	assert.Equal(t, userID, invitation.AcceptedBy())
+}
+
+func TestInvitation_Accept_FailureCase(t *testing.T) {
+	createdAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := createdAt.Add(24 * time.Hour)
+	acceptedAt := createdAt.Add(time.Minute)
+
+	tests := []struct {
+		name       string
+		email      string
+		acceptedAt *time.Time
+		now        time.Time
+	}{
+		{name: "already accepted", email: "invitee@example.com", acceptedAt: &acceptedAt, now: createdAt.Add(time.Hour)},
+		{name: "expired", email: "invitee@example.com", now: expiresAt},
+		{name: "email mismatch", email: "other@example.com", now: createdAt.Add(time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invitation := entity.ReconstructInvitation(
+				uuid.New(), "invitee@example.com", nil, uuid.New(), nil,
+				expiresAt, tt.acceptedAt, uuid.Nil, createdAt,
+			)
+
+			err := invitation.Accept(uuid.New(), tt.email, tt.now)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+			assert.Equal(t, tt.acceptedAt, invitation.AcceptedAt())
+		})
+	}
+}
@@ -0,0 +1,92 @@
+//go:generate mockgen -source=moderation_action.go -destination=../../../test/mock/domain/entity/mock_moderation_action.go
+
+package entity
+
+import (
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// ModerationAction is an append-only record of what a moderator did about a post and why. It outlives the
+// post, so a deleted post keeps its record.
+type ModerationAction interface {
+	ID() uuid.UUID
+	ModeratorID() uuid.UUID
+	PostID() uuid.UUID
+	// TargetUserID is the author of the post.
+	TargetUserID() uuid.UUID
+	Type() vo.ModerationActionType
+	Reason() vo.ModerationReason
+	CreatedAt() time.Time
+}
+
+type moderationActionImpl struct {
+	id           uuid.UUID
+	moderatorID  uuid.UUID
+	postID       uuid.UUID
+	targetUserID uuid.UUID
+	actionType   vo.ModerationActionType
+	reason       vo.ModerationReason
+	createdAt    time.Time
+}
+
+func (a *moderationActionImpl) ID() uuid.UUID {
+	return a.id
+}
+
+func (a *moderationActionImpl) ModeratorID() uuid.UUID {
+	return a.moderatorID
+}
+
+func (a *moderationActionImpl) PostID() uuid.UUID {
+	return a.postID
+}
+
+func (a *moderationActionImpl) TargetUserID() uuid.UUID {
+	return a.targetUserID
+}
+
+func (a *moderationActionImpl) Type() vo.ModerationActionType {
+	return a.actionType
+}
+
+func (a *moderationActionImpl) Reason() vo.ModerationReason {
+	return a.reason
+}
+
+func (a *moderationActionImpl) CreatedAt() time.Time {
+	return a.createdAt
+}
+
+// NewModerationAction records an action of moderatorID on post with a generated UUID, validating the action
+// type and the reason.
+func NewModerationAction(
+	moderatorID uuid.UUID, post Post, rawType, rawReason string, createdAt time.Time,
+) (ModerationAction, error) {
+	actionType, err := vo.ModerationActionTypeFromString(rawType)
+	if err != nil {
+		return nil, err
+	}
+
+	reason, err := vo.NewModerationReason(rawReason)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return &moderationActionImpl{
+		id:           id,
+		moderatorID:  moderatorID,
+		postID:       post.ID(),
+		targetUserID: post.UserID(),
+		actionType:   actionType,
+		reason:       *reason,
+		createdAt:    createdAt,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewModerationAction_HappyCase(t *testing.T) {
+	moderatorID := uuid.New()
+	now := time.Now()
+	post := entity.ReconstructPost(
+		uuid.New(), uuid.New(), "abuse", vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityPublic,
+		vo.PostStatusPublished, nil, nil, nil, nil, now, now,
+	)
+
+	action, err := entity.NewModerationAction(moderatorID, post, "hide", "  insults other members  ", now)
+
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Version(7), action.ID().Version())
+	assert.Equal(t, moderatorID, action.ModeratorID())
+	assert.Equal(t, post.ID(), action.PostID())
+	assert.Equal(t, post.UserID(), action.TargetUserID())
+	assert.Equal(t, vo.ModerationActionHide, action.Type())
+	assert.Equal(t, "insults other members", action.Reason().String())
+	assert.Equal(t, now, action.CreatedAt())
+}
+
+func TestNewModerationAction_FailureCase(t *testing.T) {
+	now := time.Now()
+	post := entity.ReconstructPost(
+		uuid.New(), uuid.New(), "abuse", vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityPublic,
+		vo.PostStatusPublished, nil, nil, nil, nil, now, now,
+	)
+
+	tests := []struct {
+		name       string
+		actionType string
+		reason     string
+	}{
+		{name: "unknown action", actionType: "ban", reason: "spam"},
+		{name: "missing reason", actionType: "warn", reason: "   "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, err := entity.NewModerationAction(uuid.New(), post, tt.actionType, tt.reason, now)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+			assert.Nil(t, action)
+		})
+	}
+}
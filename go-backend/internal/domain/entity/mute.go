@@ -0,0 +1,53 @@
+//go:generate mockgen -source=mute.go -destination=../../../test/mock/domain/entity/mock_mute.go
+
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+var errSelfMute = errors.New("user cannot mute themselves")
+
+// Mute is a user muting another user inside the active organization, so that the muted user's content is
+// left out of the muter's feeds and threads. Unlike a block, the muted user notices nothing. The muter and
+// the muted user identify a mute.
+type Mute interface {
+	MuterID() uuid.UUID
+	MutedID() uuid.UUID
+	CreatedAt() time.Time
+}
+
+type muteImpl struct {
+	muterID   uuid.UUID
+	mutedID   uuid.UUID
+	createdAt time.Time
+}
+
+func (m *muteImpl) MuterID() uuid.UUID {
+	return m.muterID
+}
+
+func (m *muteImpl) MutedID() uuid.UUID {
+	return m.mutedID
+}
+
+func (m *muteImpl) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+// NewMute creates a mute of mutedID by muterID, validating that a user does not mute themselves.
+func NewMute(muterID, mutedID uuid.UUID, createdAt time.Time) (Mute, error) {
+	if muterID == mutedID {
+		return nil, vo.NewValidationError("users cannot mute themselves", nil, errSelfMute)
+	}
+
+	return &muteImpl{
+		muterID:   muterID,
+		mutedID:   mutedID,
+		createdAt: createdAt,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMute_HappyCase(t *testing.T) {
+	muterID, mutedID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	mute, err := entity.NewMute(muterID, mutedID, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, muterID, mute.MuterID())
+	assert.Equal(t, mutedID, mute.MutedID())
+	assert.Equal(t, now, mute.CreatedAt())
+}
+
+func TestNewMute_Self(t *testing.T) {
+	userID := uuid.New()
+
+	mute, err := entity.NewMute(userID, userID, time.Now())
+
+	var voErr vo.Error
+	require.ErrorAs(t, err, &voErr)
+	assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+	assert.Nil(t, mute)
+}
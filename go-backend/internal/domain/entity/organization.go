@@ -0,0 +1,133 @@
+//go:generate mockgen -source=organization.go -destination=../../../test/mock/domain/entity/mock_organization.go
+
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+var errIllegalMembership = errors.New("illegal organization membership")
+
+// OrganizationOwnerRoleID is the seeded per-organization role held by whoever creates an organization.
+var OrganizationOwnerRoleID = uuid.MustParse("00000000-0000-0000-0000-000000000003")
+
+// Organization is a tenant. Every tenant-owned record (posts, memberships, ...) belongs to exactly one
+// organization, and users act within one organization at a time.
+type Organization interface {
+	ID() uuid.UUID
+	Name() string
+	CreatedAt() time.Time
+}
+
+// OrganizationMembership ties a user to an organization with a role that applies only inside it.
+type OrganizationMembership interface {
+	OrganizationID() uuid.UUID
+	UserID() uuid.UUID
+	RoleID() uuid.UUID
+	JoinedAt() time.Time
+}
+
+type organizationImpl struct {
+	id        uuid.UUID
+	name      string
+	createdAt time.Time
+}
+
+func (o *organizationImpl) ID() uuid.UUID {
+	return o.id
+}
+
+func (o *organizationImpl) Name() string {
+	return o.name
+}
+
+func (o *organizationImpl) CreatedAt() time.Time {
+	return o.createdAt
+}
+
+type organizationMembershipImpl struct {
+	organizationID uuid.UUID
+	userID         uuid.UUID
+	roleID         uuid.UUID
+	joinedAt       time.Time
+}
+
+func (m *organizationMembershipImpl) OrganizationID() uuid.UUID {
+	return m.organizationID
+}
+
+func (m *organizationMembershipImpl) UserID() uuid.UUID {
+	return m.userID
+}
+
+func (m *organizationMembershipImpl) RoleID() uuid.UUID {
+	return m.roleID
+}
+
+func (m *organizationMembershipImpl) JoinedAt() time.Time {
+	return m.joinedAt
+}
+
+// NewOrganization creates an Organization with a fresh ID, validating the name.
+func NewOrganization(name string, createdAt time.Time) (Organization, error) {
+	n, err := vo.NewName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return &organizationImpl{
+		id:        id,
+		name:      n.String(),
+		createdAt: createdAt,
+	}, nil
+}
+
+// ReconstructOrganization rebuilds an Organization from persisted values without validation.
+func ReconstructOrganization(id uuid.UUID, name string, createdAt time.Time) Organization {
+	return &organizationImpl{
+		id:        id,
+		name:      name,
+		createdAt: createdAt,
+	}
+}
+
+// NewOrganizationMembership makes userID a member of organizationID holding roleID within it.
+func NewOrganizationMembership(
+	organizationID, userID, roleID uuid.UUID, joinedAt time.Time,
+) (OrganizationMembership, error) {
+	if userID == uuid.Nil {
+		return nil, vo.NewValidationError("userId is required", nil, errIllegalMembership)
+	}
+
+	if roleID == uuid.Nil {
+		return nil, vo.NewValidationError("roleId is required", nil, errIllegalMembership)
+	}
+
+	return &organizationMembershipImpl{
+		organizationID: organizationID,
+		userID:         userID,
+		roleID:         roleID,
+		joinedAt:       joinedAt,
+	}, nil
+}
+
+// ReconstructOrganizationMembership rebuilds an OrganizationMembership from persisted values.
+func ReconstructOrganizationMembership(
+	organizationID, userID, roleID uuid.UUID, joinedAt time.Time,
+) OrganizationMembership {
+	return &organizationMembershipImpl{
+		organizationID: organizationID,
+		userID:         userID,
+		roleID:         roleID,
+		joinedAt:       joinedAt,
+	}
+}
@@ -0,0 +1,98 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOrganization_HappyCase(t *testing.T) {
+	createdAt := time.Date(2026, 1, 18, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "plain name", input: "Acme", expected: "Acme"},
+		{name: "surrounding whitespace is trimmed", input: "  Acme Inc.  ", expected: "Acme Inc."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			org, err := entity.NewOrganization(tt.input, createdAt)
+
+			require.NoError(t, err)
+			assert.NotEqual(t, uuid.Nil, org.ID())
+			assert.Equal(t, tt.expected, org.Name())
+			assert.Equal(t, createdAt, org.CreatedAt())
+		})
+	}
+}
+
+func TestNewOrganization_FailureCase(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "empty name", input: ""},
+		{name: "whitespace only", input: "   "},
+		{name: "too long", input: strings.Repeat("a", 257)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			org, err := entity.NewOrganization(tt.input, time.Now())
+
+			require.Error(t, err)
+			assert.Nil(t, org)
+
+			var domainErr vo.Error
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, vo.ValidationErrorCode, domainErr.Code())
+		})
+	}
+}
+
+func TestNewOrganizationMembership_HappyCase(t *testing.T) {
+	orgID, userID, roleID := uuid.New(), uuid.New(), uuid.New()
+	joinedAt := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	m, err := entity.NewOrganizationMembership(orgID, userID, roleID, joinedAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, orgID, m.OrganizationID())
+	assert.Equal(t, userID, m.UserID())
+	assert.Equal(t, roleID, m.RoleID())
+	assert.Equal(t, joinedAt, m.JoinedAt())
+}
+
+func TestNewOrganizationMembership_FailureCase(t *testing.T) {
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		roleID uuid.UUID
+	}{
+		{name: "missing user", userID: uuid.Nil, roleID: uuid.New()},
+		{name: "missing role", userID: uuid.New(), roleID: uuid.Nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := entity.NewOrganizationMembership(uuid.New(), tt.userID, tt.roleID, time.Now())
+
+			require.Error(t, err)
+			assert.Nil(t, m)
+
+			var domainErr vo.Error
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, vo.ValidationErrorCode, domainErr.Code())
+		})
+	}
+}
@@ -0,0 +1,69 @@
+//go:generate mockgen -source=pinned_post.go -destination=../../../test/mock/domain/entity/mock_pinned_post.go
+
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// MaxPinnedPosts is how many posts a user can pin to their profile in one organization.
+const MaxPinnedPosts = 3
+
+var (
+	errPinOthersPost   = errors.New("post of another user")
+	errPinNotPublished = errors.New("post is not published")
+	errPinHidden       = errors.New("post is hidden")
+	errPinRepost       = errors.New("reposts cannot be pinned")
+)
+
+// PinnedPost is a post its author pinned to their profile. The post and the user identify a pin.
+type PinnedPost interface {
+	PostID() uuid.UUID
+	UserID() uuid.UUID
+	PinnedAt() time.Time
+}
+
+type pinnedPostImpl struct {
+	postID   uuid.UUID
+	userID   uuid.UUID
+	pinnedAt time.Time
+}
+
+func (p *pinnedPostImpl) PostID() uuid.UUID {
+	return p.postID
+}
+
+func (p *pinnedPostImpl) UserID() uuid.UUID {
+	return p.userID
+}
+
+func (p *pinnedPostImpl) PinnedAt() time.Time {
+	return p.pinnedAt
+}
+
+// NewPinnedPost pins post to the profile of userID. Users can only pin their own published posts that no
+// moderator hid; reposts cannot be pinned.
+func NewPinnedPost(userID uuid.UUID, post Post, pinnedAt time.Time) (PinnedPost, error) {
+	details := map[string]any{"post_id": post.ID().String()}
+
+	switch {
+	case post.UserID() != userID:
+		return nil, vo.NewValidationError("only your own posts can be pinned", details, errPinOthersPost)
+	case post.Status() != vo.PostStatusPublished:
+		return nil, vo.NewValidationError("drafts cannot be pinned", details, errPinNotPublished)
+	case post.HiddenAt() != nil:
+		return nil, vo.NewValidationError("hidden posts cannot be pinned", details, errPinHidden)
+	case post.Kind() == vo.PostKindRepost:
+		return nil, vo.NewValidationError("reposts cannot be pinned", details, errPinRepost)
+	}
+
+	return &pinnedPostImpl{
+		postID:   post.ID(),
+		userID:   userID,
+		pinnedAt: pinnedAt,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPinnedPost_HappyCase(t *testing.T) {
+	pinnedAt := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+
+	// Any published post of the author can be pinned, whoever may see it.
+	for _, post := range []entity.Post{
+		newOriginalPost(vo.PostKindPost, vo.PostVisibilityPrivate, vo.PostStatusPublished, false),
+		newOriginalPost(vo.PostKindQuote, vo.PostVisibilityPublic, vo.PostStatusPublished, false),
+	} {
+		pin, err := entity.NewPinnedPost(post.UserID(), post, pinnedAt)
+
+		require.NoError(t, err)
+		assert.Equal(t, post.ID(), pin.PostID())
+		assert.Equal(t, post.UserID(), pin.UserID())
+		assert.Equal(t, pinnedAt, pin.PinnedAt())
+	}
+}
+
+func TestNewPinnedPost_FailureCase(t *testing.T) {
+	tests := []struct {
+		name      string
+		post      entity.Post
+		otherUser bool
+	}{
+		{
+			name:      "post of another user",
+			post:      newOriginalPost(vo.PostKindPost, vo.PostVisibilityPublic, vo.PostStatusPublished, false),
+			otherUser: true,
+		},
+		{name: "draft", post: newOriginalPost(vo.PostKindPost, vo.PostVisibilityPublic, vo.PostStatusDraft, false)},
+		{name: "hidden", post: newOriginalPost(vo.PostKindPost, vo.PostVisibilityPublic, vo.PostStatusPublished, true)},
+		{
+			name: "repost",
+			post: newOriginalPost(vo.PostKindRepost, vo.PostVisibilityPublic, vo.PostStatusPublished, false),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID := tt.post.UserID()
+			if tt.otherUser {
+				userID = uuid.New()
+			}
+
+			pin, err := entity.NewPinnedPost(userID, tt.post, time.Now())
+
+			require.Error(t, err)
+			assert.Nil(t, pin)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,240 @@
+//go:generate mockgen -source=poll.go -destination=../../../test/mock/domain/entity/mock_poll.go
+
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+const (
+	// MinPollOptions and MaxPollOptions bound the number of options of a poll.
+	MinPollOptions = 2
+	MaxPollOptions = 10
+)
+
+var (
+	errPollOptionCount       = errors.New("illegal number of poll options")
+	errDuplicatePollOption   = errors.New("duplicate poll option")
+	errPollClosesAtNotAfter  = errors.New("poll closes_at is not after the publication")
+	errPollClosed            = errors.New("poll is closed")
+	errPollVoteEmpty         = errors.New("poll vote chooses no option")
+	errPollVoteMultiple      = errors.New("poll vote chooses several options")
+	errPollVoteDuplicate     = errors.New("poll vote repeats an option")
+	errPollVoteUnknownOption = errors.New("poll vote chooses an unknown option")
+)
+
+// Poll is a poll attached to a post, which identifies it. Voters choose one of its options, or any number of
+// them when Multiple is set, until ClosesAt. Votes are final.
+type Poll interface {
+	PostID() uuid.UUID
+	// Options are in display order.
+	Options() []PollOption
+	Multiple() bool
+	ClosesAt() time.Time
+	CreatedAt() time.Time
+	// Vote casts the ballot of userID for optionIDs as of now. Fails once the poll is closed, for options of
+	// other polls or repeated ones, and for more than one option unless Multiple is set.
+	Vote(userID uuid.UUID, optionIDs []uuid.UUID, now time.Time) (PollVote, error)
+}
+
+// PollOption is one of the choices of a poll.
+type PollOption interface {
+	ID() uuid.UUID
+	Label() string
+}
+
+// PollVote is the ballot of a user in a poll: the options they chose.
+type PollVote interface {
+	PostID() uuid.UUID
+	UserID() uuid.UUID
+	OptionIDs() []uuid.UUID
+	CreatedAt() time.Time
+}
+
+type pollImpl struct {
+	postID    uuid.UUID
+	options   []PollOption
+	multiple  bool
+	closesAt  time.Time
+	createdAt time.Time
+}
+
+func (p *pollImpl) PostID() uuid.UUID {
+	return p.postID
+}
+
+func (p *pollImpl) Options() []PollOption {
+	return p.options
+}
+
+func (p *pollImpl) Multiple() bool {
+	return p.multiple
+}
+
+func (p *pollImpl) ClosesAt() time.Time {
+	return p.closesAt
+}
+
+func (p *pollImpl) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *pollImpl) Vote(userID uuid.UUID, optionIDs []uuid.UUID, now time.Time) (PollVote, error) {
+	if !now.Before(p.closesAt) {
+		return nil, vo.NewValidationError("poll is closed", map[string]any{
+			"post_id": p.postID.String(),
+		}, errPollClosed)
+	}
+
+	if len(optionIDs) == 0 {
+		return nil, vo.NewValidationError("choose at least one option", nil, errPollVoteEmpty)
+	}
+
+	if !p.multiple && len(optionIDs) > 1 {
+		return nil, vo.NewValidationError("only one option can be chosen in this poll", nil, errPollVoteMultiple)
+	}
+
+	known := make(map[uuid.UUID]bool, len(p.options))
+	for _, option := range p.options {
+		known[option.ID()] = true
+	}
+
+	seen := make(map[uuid.UUID]bool, len(optionIDs))
+
+	for _, id := range optionIDs {
+		if !known[id] {
+			return nil, vo.NewValidationError("poll option not found", map[string]any{
+				"option_id": id.String(),
+			}, errPollVoteUnknownOption)
+		}
+
+		if seen[id] {
+			return nil, vo.NewValidationError("options must not repeat", map[string]any{
+				"option_id": id.String(),
+			}, errPollVoteDuplicate)
+		}
+
+		seen[id] = true
+	}
+
+	return &pollVoteImpl{
+		postID:    p.postID,
+		userID:    userID,
+		optionIDs: optionIDs,
+		createdAt: now,
+	}, nil
+}
+
+type pollOptionImpl struct {
+	id    uuid.UUID
+	label string
+}
+
+func (o *pollOptionImpl) ID() uuid.UUID {
+	return o.id
+}
+
+func (o *pollOptionImpl) Label() string {
+	return o.label
+}
+
+type pollVoteImpl struct {
+	postID    uuid.UUID
+	userID    uuid.UUID
+	optionIDs []uuid.UUID
+	createdAt time.Time
+}
+
+func (v *pollVoteImpl) PostID() uuid.UUID {
+	return v.postID
+}
+
+func (v *pollVoteImpl) UserID() uuid.UUID {
+	return v.userID
+}
+
+func (v *pollVoteImpl) OptionIDs() []uuid.UUID {
+	return v.optionIDs
+}
+
+func (v *pollVoteImpl) CreatedAt() time.Time {
+	return v.createdAt
+}
+
+// NewPoll creates a poll on post with options labelled labels, in that order, validating each label and
+// their number, MinPollOptions to MaxPollOptions, without repeats. closesAt must lie after the post is
+// published, at its PublishAt when it is scheduled and at its creation otherwise.
+func NewPoll(post Post, labels []string, multiple bool, closesAt time.Time) (Poll, error) {
+	if len(labels) < MinPollOptions || len(labels) > MaxPollOptions {
+		return nil, vo.NewValidationError(
+			fmt.Sprintf("a poll must have %d to %d options", MinPollOptions, MaxPollOptions),
+			map[string]any{"min_options": MinPollOptions, "max_options": MaxPollOptions},
+			errPollOptionCount,
+		)
+	}
+
+	opensAt := post.CreatedAt()
+	if post.PublishAt() != nil {
+		opensAt = *post.PublishAt()
+	}
+
+	if !closesAt.After(opensAt) {
+		return nil, vo.NewValidationError("poll closes_at must be after the post is published", map[string]any{
+			"closes_at": closesAt.Format(time.RFC3339),
+		}, errPollClosesAtNotAfter)
+	}
+
+	options := make([]PollOption, len(labels))
+	seen := make(map[string]bool, len(labels))
+
+	for i, raw := range labels {
+		label, err := vo.NewPollOptionLabel(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[label.String()] {
+			return nil, vo.NewValidationError("poll options must not repeat", map[string]any{
+				"option": label.String(),
+			}, errDuplicatePollOption)
+		}
+
+		seen[label.String()] = true
+
+		id, err := uuid.NewV7()
+		if err != nil {
+			return nil, err
+		}
+
+		options[i] = &pollOptionImpl{id: id, label: label.String()}
+	}
+
+	return &pollImpl{
+		postID:    post.ID(),
+		options:   options,
+		multiple:  multiple,
+		closesAt:  closesAt,
+		createdAt: post.CreatedAt(),
+	}, nil
+}
+
+// ReconstructPoll rebuilds a Poll from persisted values without validation.
+func ReconstructPoll(postID uuid.UUID, options []PollOption, multiple bool, closesAt, createdAt time.Time) Poll {
+	return &pollImpl{
+		postID:    postID,
+		options:   options,
+		multiple:  multiple,
+		closesAt:  closesAt,
+		createdAt: createdAt,
+	}
+}
+
+// ReconstructPollOption rebuilds a PollOption from persisted values without validation.
+func ReconstructPollOption(id uuid.UUID, label string) PollOption {
+	return &pollOptionImpl{id: id, label: label}
+}
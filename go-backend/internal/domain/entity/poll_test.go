@@ -0,0 +1,157 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var pollCreatedAt = time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+
+func newPollPost(t *testing.T, publishAt *time.Time) entity.Post {
+	t.Helper()
+
+	post, err := entity.NewPost(entity.PostParams{
+		UserID:    uuid.New(),
+		Content:   "Which day?",
+		PublishAt: publishAt,
+		CreatedAt: pollCreatedAt,
+	})
+	require.NoError(t, err)
+
+	return post
+}
+
+func newTestPoll(t *testing.T, multiple bool) entity.Poll {
+	t.Helper()
+
+	poll, err := entity.NewPoll(newPollPost(t, nil), []string{"Tue", "Wed", "Thu"}, multiple, pollCreatedAt.Add(time.Hour))
+	require.NoError(t, err)
+
+	return poll
+}
+
+func TestNewPoll_HappyCase(t *testing.T) {
+	post := newPollPost(t, nil)
+	closesAt := pollCreatedAt.Add(24 * time.Hour)
+
+	poll, err := entity.NewPoll(post, []string{"  Tuesday ", "Wednesday"}, true, closesAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, post.ID(), poll.PostID())
+	assert.True(t, poll.Multiple())
+	assert.Equal(t, closesAt, poll.ClosesAt())
+	require.Len(t, poll.Options(), 2)
+	assert.Equal(t, "Tuesday", poll.Options()[0].Label())
+	assert.Equal(t, "Wednesday", poll.Options()[1].Label())
+	assert.NotEqual(t, poll.Options()[0].ID(), poll.Options()[1].ID())
+}
+
+func TestNewPoll_FailureCase(t *testing.T) {
+	publishAt := pollCreatedAt.Add(2 * time.Hour)
+
+	tests := []struct {
+		name      string
+		publishAt *time.Time
+		labels    []string
+		closesAt  time.Time
+	}{
+		{name: "one option", labels: []string{"a"}, closesAt: pollCreatedAt.Add(time.Hour)},
+		{
+			name:     "eleven options",
+			labels:   strings.Split("a b c d e f g h i j k", " "),
+			closesAt: pollCreatedAt.Add(time.Hour),
+		},
+		{name: "empty option", labels: []string{"a", " "}, closesAt: pollCreatedAt.Add(time.Hour)},
+		{name: "repeated option", labels: []string{"a", " a"}, closesAt: pollCreatedAt.Add(time.Hour)},
+		{name: "closes at creation", labels: []string{"a", "b"}, closesAt: pollCreatedAt},
+		{
+			name:      "closes before a scheduled publication",
+			publishAt: &publishAt,
+			labels:    []string{"a", "b"},
+			closesAt:  pollCreatedAt.Add(time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			poll, err := entity.NewPoll(newPollPost(t, tt.publishAt), tt.labels, false, tt.closesAt)
+
+			assert.Nil(t, poll)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
+
+func TestPoll_Vote_HappyCase(t *testing.T) {
+	userID := uuid.New()
+	now := pollCreatedAt.Add(time.Minute)
+
+	for name, multiple := range map[string]bool{"single choice": false, "multiple choice": true} {
+		t.Run(name, func(t *testing.T) {
+			poll := newTestPoll(t, multiple)
+			optionIDs := []uuid.UUID{poll.Options()[2].ID()}
+
+			if multiple {
+				optionIDs = append(optionIDs, poll.Options()[0].ID())
+			}
+
+			vote, err := poll.Vote(userID, optionIDs, now)
+
+			require.NoError(t, err)
+			assert.Equal(t, poll.PostID(), vote.PostID())
+			assert.Equal(t, userID, vote.UserID())
+			assert.Equal(t, optionIDs, vote.OptionIDs())
+			assert.Equal(t, now, vote.CreatedAt())
+		})
+	}
+}
+
+func TestPoll_Vote_FailureCase(t *testing.T) {
+	single, multiple := newTestPoll(t, false), newTestPoll(t, true)
+	open := pollCreatedAt.Add(time.Minute)
+
+	tests := []struct {
+		name      string
+		poll      entity.Poll
+		optionIDs []uuid.UUID
+		now       time.Time
+	}{
+		{name: "closed poll", poll: single, optionIDs: []uuid.UUID{single.Options()[0].ID()}, now: single.ClosesAt()},
+		{name: "no option", poll: multiple, optionIDs: nil, now: open},
+		{
+			name:      "several options of a single-choice poll",
+			poll:      single,
+			optionIDs: []uuid.UUID{single.Options()[0].ID(), single.Options()[1].ID()},
+			now:       open,
+		},
+		{
+			name:      "repeated option",
+			poll:      multiple,
+			optionIDs: []uuid.UUID{multiple.Options()[0].ID(), multiple.Options()[0].ID()},
+			now:       open,
+		},
+		{name: "option of another poll", poll: single, optionIDs: []uuid.UUID{multiple.Options()[0].ID()}, now: open},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vote, err := tt.poll.Vote(uuid.New(), tt.optionIDs, tt.now)
+
+			assert.Nil(t, vote)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
@@ -3,6 +3,7 @@
 package entity
 
 import (
+	"errors"
 	"time"
 
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
@@ -14,14 +15,71 @@ type Post interface {
 	ID() uuid.UUID
 	UserID() uuid.UUID
 	Content() string
+	// Format is the markup Content is written in.
+	Format() vo.ContentFormat
+	// Kind tells whether the post shares another post, as a repost or a quote.
+	Kind() vo.PostKind
+	// OriginalID is the post a repost or quote shares; nil for other posts, and once the original was purged.
+	OriginalID() *uuid.UUID
 	CreatedAt() time.Time
+	UpdatedAt() time.Time
+	Visibility() vo.PostVisibility
+	Status() vo.PostStatus
+	// PublishAt is when a scheduled draft is due to be published; nil for other posts.
+	PublishAt() *time.Time
+	// Edit replaces the content and its format, re-validating them, and records now as the update time. An
+	// empty format keeps the current one. Content and format that are unchanged after normalisation leave
+	// the post untouched; see Post.UpdatedAt. Fails for reposts, which have no content of their own.
+	Edit(content, format string, now time.Time) error
+	// Publish publishes a draft, making now its creation time so that it enters feeds as a new post, and
+	// clears PublishAt. Fails for posts that are already published.
+	Publish(now time.Time) error
+	// HiddenAt is when a moderator hid the post from everyone but its author; nil for visible posts.
+	HiddenAt() *time.Time
+	// Hide hides the post as of now. Fails for posts that are already hidden.
+	Hide(now time.Time) error
+	// DeletedAt is when the post was moved to the trash; nil for posts that are not in the trash.
+	DeletedAt() *time.Time
+	// DeletedBy is who moved the post to the trash, its author or a moderator; nil for posts that are not in
+	// the trash, or when that user no longer exists.
+	DeletedBy() *uuid.UUID
+	// Trash moves the post to the trash as of now on behalf of actorID. Fails for posts already in the trash.
+	Trash(actorID uuid.UUID, now time.Time) error
+	// Restore takes the post out of the trash. Fails for posts that are not in the trash or whose
+	// PostTrashRetention has run out by now.
+	Restore(now time.Time) error
 }
 
+// PostTrashRetention is how long a post stays in the trash, restorable, before it is purged for good.
+const PostTrashRetention = 30 * 24 * time.Hour
+
+var (
+	errPublishAtNotInFuture = errors.New("publish_at is not in the future")
+	errPostAlreadyPublished = errors.New("post is already published")
+	errPostAlreadyHidden    = errors.New("post is already hidden")
+	errPostAlreadyTrashed   = errors.New("post is already in the trash")
+	errPostNotTrashed       = errors.New("post is not in the trash")
+	errPostTrashExpired     = errors.New("post trash retention has run out")
+	errRepostEdited         = errors.New("reposts cannot be edited")
+	errRepostShared         = errors.New("reposts cannot be shared")
+	errPostNotShareable     = errors.New("post is not published publicly")
+)
+
 type postImpl struct {
-	id        uuid.UUID
-	userId    uuid.UUID
-	content   string
-	createdAt time.Time
+	id         uuid.UUID
+	userId     uuid.UUID
+	content    string
+	format     vo.ContentFormat
+	kind       vo.PostKind
+	originalID *uuid.UUID
+	visibility vo.PostVisibility
+	status     vo.PostStatus
+	publishAt  *time.Time
+	hiddenAt   *time.Time
+	deletedAt  *time.Time
+	deletedBy  *uuid.UUID
+	createdAt  time.Time
+	updatedAt  time.Time
 }
 
 func (p *postImpl) ID() uuid.UUID {
@@ -36,36 +94,301 @@ func (p *postImpl) Content() string {
 	return p.content
 }
 
+func (p *postImpl) Format() vo.ContentFormat {
+	return p.format
+}
+
+func (p *postImpl) Kind() vo.PostKind {
+	return p.kind
+}
+
+func (p *postImpl) OriginalID() *uuid.UUID {
+	return p.originalID
+}
+
 func (p *postImpl) CreatedAt() time.Time {
 	return p.createdAt
 }
 
-// NewPost creates a new Post with a generated UUID, validating the content.
-func NewPost(userID uuid.UUID, content string, createdAt time.Time) (Post, error) {
+func (p *postImpl) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+func (p *postImpl) Visibility() vo.PostVisibility {
+	return p.visibility
+}
+
+func (p *postImpl) Status() vo.PostStatus {
+	return p.status
+}
+
+func (p *postImpl) PublishAt() *time.Time {
+	return p.publishAt
+}
+
+func (p *postImpl) HiddenAt() *time.Time {
+	return p.hiddenAt
+}
+
+func (p *postImpl) DeletedAt() *time.Time {
+	return p.deletedAt
+}
+
+func (p *postImpl) DeletedBy() *uuid.UUID {
+	return p.deletedBy
+}
+
+func (p *postImpl) Edit(content, format string, now time.Time) error {
+	if p.kind == vo.PostKindRepost {
+		return vo.NewValidationError("reposts cannot be edited", map[string]any{
+			"post_id": p.id.String(),
+		}, errRepostEdited)
+	}
+
+	c, err := vo.NewContent(content)
+	if err != nil {
+		return err
+	}
+
+	f := p.format
+	if format != "" {
+		if f, err = vo.ContentFormatFromString(format); err != nil {
+			return err
+		}
+	}
+
+	if c.String() == p.content && f == p.format {
+		return nil
+	}
+
+	p.content = c.String()
+	p.format = f
+	p.updatedAt = now
+
+	return nil
+}
+
+func (p *postImpl) Publish(now time.Time) error {
+	if !p.status.IsDraft() {
+		return vo.NewValidationError("post is already published", map[string]any{
+			"post_id": p.id.String(),
+		}, errPostAlreadyPublished)
+	}
+
+	p.status = vo.PostStatusPublished
+	p.publishAt = nil
+	p.createdAt = now
+	p.updatedAt = now
+
+	return nil
+}
+
+func (p *postImpl) Hide(now time.Time) error {
+	if p.hiddenAt != nil {
+		return vo.NewValidationError("post is already hidden", map[string]any{
+			"post_id": p.id.String(),
+		}, errPostAlreadyHidden)
+	}
+
+	p.hiddenAt = &now
+
+	return nil
+}
+
+func (p *postImpl) Trash(actorID uuid.UUID, now time.Time) error {
+	if p.deletedAt != nil {
+		return vo.NewValidationError("post is already in the trash", map[string]any{
+			"post_id": p.id.String(),
+		}, errPostAlreadyTrashed)
+	}
+
+	p.deletedAt = &now
+	p.deletedBy = &actorID
+
+	return nil
+}
+
+func (p *postImpl) Restore(now time.Time) error {
+	if p.deletedAt == nil {
+		return vo.NewValidationError("post is not in the trash", map[string]any{
+			"post_id": p.id.String(),
+		}, errPostNotTrashed)
+	}
+
+	if now.After(p.deletedAt.Add(PostTrashRetention)) {
+		return vo.NewValidationError("post can no longer be restored", map[string]any{
+			"post_id": p.id.String(),
+		}, errPostTrashExpired)
+	}
+
+	p.deletedAt = nil
+	p.deletedBy = nil
+
+	return nil
+}
+
+// PostParams holds what the author chooses for a new post; see NewPost and NewQuotePost.
+type PostParams struct {
+	UserID  uuid.UUID
+	Content string
+	// Format is the format of Content; the empty string means plain.
+	Format string
+	// Visibility is who may read the post; the empty string means public.
+	Visibility string
+	// Draft keeps the post unpublished. PublishAt schedules it, which makes it a draft as well, and must lie
+	// after CreatedAt.
+	Draft     bool
+	PublishAt *time.Time
+	CreatedAt time.Time
+}
+
+// NewPost creates a new Post with a generated UUID, validating the content, its format and the visibility.
+func NewPost(params PostParams) (Post, error) {
+	post, err := newPost(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return post, nil
+}
+
+func newPost(params PostParams) (*postImpl, error) {
 	id, err := uuid.NewV7()
 	if err != nil {
 		return nil, err
 	}
 
-	c, err := vo.NewContent(content)
+	c, err := vo.NewContent(params.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := vo.ContentFormatFromString(params.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := vo.PostVisibilityFromString(params.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	status := vo.PostStatusPublished
+	if params.Draft || params.PublishAt != nil {
+		status = vo.PostStatusDraft
+	}
+
+	if params.PublishAt != nil && !params.PublishAt.After(params.CreatedAt) {
+		return nil, vo.NewValidationError("publish_at must be in the future", map[string]any{
+			"publish_at": params.PublishAt.Format(time.RFC3339),
+		}, errPublishAtNotInFuture)
+	}
+
+	return &postImpl{
+		id:         id,
+		userId:     params.UserID,
+		content:    string(*c),
+		format:     f,
+		kind:       vo.PostKindPost,
+		visibility: v,
+		status:     status,
+		publishAt:  params.PublishAt,
+		createdAt:  params.CreatedAt,
+		updatedAt:  params.CreatedAt,
+	}, nil
+}
+
+// NewRepost creates a published repost of original by userID: a public post without content of its own that
+// shares original as it is. See checkShareable for the posts that can be shared.
+func NewRepost(userID uuid.UUID, original Post, createdAt time.Time) (Post, error) {
+	if err := checkShareable(original); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
 	if err != nil {
 		return nil, err
 	}
 
+	originalID := original.ID()
+
 	return &postImpl{
-		id:        id,
-		userId:    userID,
-		content:   string(*c),
-		createdAt: createdAt,
+		id:         id,
+		userId:     userID,
+		format:     vo.ContentFormatPlain,
+		kind:       vo.PostKindRepost,
+		originalID: &originalID,
+		visibility: vo.PostVisibilityPublic,
+		status:     vo.PostStatusPublished,
+		createdAt:  createdAt,
+		updatedAt:  createdAt,
 	}, nil
 }
 
+// NewQuotePost creates a post that shares original below content of its own, validating the rest like NewPost.
+// See checkShareable for the posts that can be shared.
+func NewQuotePost(original Post, params PostParams) (Post, error) {
+	if err := checkShareable(original); err != nil {
+		return nil, err
+	}
+
+	post, err := newPost(params)
+	if err != nil {
+		return nil, err
+	}
+
+	originalID := original.ID()
+	post.kind = vo.PostKindQuote
+	post.originalID = &originalID
+
+	return post, nil
+}
+
+// checkShareable lets only published public posts that no moderator hid be shared, so that sharing never
+// shows a post to more people than its author chose. Reposts themselves are not shared; their original is.
+func checkShareable(original Post) error {
+	if original.Kind() == vo.PostKindRepost {
+		return vo.NewValidationError("reposts cannot be shared; share the original post instead", map[string]any{
+			"post_id": original.ID().String(),
+		}, errRepostShared)
+	}
+
+	if original.Status().IsDraft() || original.HiddenAt() != nil || original.Visibility() != vo.PostVisibilityPublic {
+		return vo.NewValidationError("only published public posts can be shared", map[string]any{
+			"post_id": original.ID().String(),
+		}, errPostNotShareable)
+	}
+
+	return nil
+}
+
 // ReconstructPost rebuilds a Post from persisted values without validation.
-func ReconstructPost(id, userID uuid.UUID, content string, createdAt time.Time) Post {
+func ReconstructPost(
+	id, userID uuid.UUID,
+	content string,
+	format vo.ContentFormat,
+	kind vo.PostKind,
+	originalID *uuid.UUID,
+	visibility vo.PostVisibility,
+	status vo.PostStatus,
+	publishAt, hiddenAt, deletedAt *time.Time,
+	deletedBy *uuid.UUID,
+	createdAt, updatedAt time.Time,
+) Post {
 	return &postImpl{
-		id:        id,
-		userId:    userID,
-		content:   content,
-		createdAt: createdAt,
+		id:         id,
+		userId:     userID,
+		content:    content,
+		format:     format,
+		kind:       kind,
+		originalID: originalID,
+		visibility: visibility,
+		status:     status,
+		publishAt:  publishAt,
+		hiddenAt:   hiddenAt,
+		deletedAt:  deletedAt,
+		deletedBy:  deletedBy,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
 	}
 }
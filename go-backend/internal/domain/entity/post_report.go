@@ -0,0 +1,120 @@
+//go:generate mockgen -source=post_report.go -destination=../../../test/mock/domain/entity/mock_post_report.go
+
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// maxReportDetailsLength is a business rule; the DB column is text.
+const maxReportDetailsLength = 1000
+
+var errReportDetailsTooLong = errors.New("report details are too long")
+
+// PostReport is a report of a post to the moderators, by a user or by the content filters. A report stays
+// open until a moderator acts on the post.
+type PostReport interface {
+	ID() uuid.UUID
+	PostID() uuid.UUID
+	// ReporterID is nil for reports filed by the content filters.
+	ReporterID() *uuid.UUID
+	Reason() vo.ReportReason
+	// Details is the reporter's optional explanation; empty when none was given.
+	Details() string
+	CreatedAt() time.Time
+}
+
+type postReportImpl struct {
+	id         uuid.UUID
+	postID     uuid.UUID
+	reporterID *uuid.UUID
+	reason     vo.ReportReason
+	details    string
+	createdAt  time.Time
+}
+
+func (r *postReportImpl) ID() uuid.UUID {
+	return r.id
+}
+
+func (r *postReportImpl) PostID() uuid.UUID {
+	return r.postID
+}
+
+func (r *postReportImpl) ReporterID() *uuid.UUID {
+	return r.reporterID
+}
+
+func (r *postReportImpl) Reason() vo.ReportReason {
+	return r.reason
+}
+
+func (r *postReportImpl) Details() string {
+	return r.details
+}
+
+func (r *postReportImpl) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// NewPostReport creates a report of postID by reporterID with a generated UUID, validating the reason and
+// trimming the details to at most maxReportDetailsLength characters.
+func NewPostReport(postID, reporterID uuid.UUID, rawReason, details string, createdAt time.Time) (PostReport, error) {
+	reason, err := vo.ReportReasonFromString(rawReason)
+	if err != nil {
+		return nil, err
+	}
+
+	details = strings.TrimSpace(details)
+	if utf8.RuneCountInString(details) > maxReportDetailsLength {
+		return nil, vo.NewValidationError(
+			fmt.Sprintf("details must be at most %d characters long", maxReportDetailsLength),
+			map[string]any{"max_length": maxReportDetailsLength},
+			errReportDetailsTooLong,
+		)
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return &postReportImpl{
+		id:         id,
+		postID:     postID,
+		reporterID: &reporterID,
+		reason:     reason,
+		details:    details,
+		createdAt:  createdAt,
+	}, nil
+}
+
+// NewFlaggedPostReport creates a report of postID filed by the content filters, with a generated UUID.
+// Details longer than maxReportDetailsLength characters are cut short.
+func NewFlaggedPostReport(
+	postID uuid.UUID, reason vo.ReportReason, details string, createdAt time.Time,
+) (PostReport, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	if runes := []rune(details); len(runes) > maxReportDetailsLength {
+		details = string(runes[:maxReportDetailsLength])
+	}
+
+	return &postReportImpl{
+		id:        id,
+		postID:    postID,
+		reason:    reason,
+		details:   details,
+		createdAt: createdAt,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPostReport_HappyCase(t *testing.T) {
+	postID, reporterID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		details     string
+		wantDetails string
+	}{
+		{name: "with details", details: "  repeated ads  ", wantDetails: "repeated ads"},
+		{name: "without details", details: "", wantDetails: ""},
+		{name: "details at the limit", details: strings.Repeat("a", 1000), wantDetails: strings.Repeat("a", 1000)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := entity.NewPostReport(postID, reporterID, "spam", tt.details, now)
+
+			require.NoError(t, err)
+			assert.Equal(t, uuid.Version(7), report.ID().Version())
+			assert.Equal(t, postID, report.PostID())
+			assert.Equal(t, &reporterID, report.ReporterID())
+			assert.Equal(t, vo.ReportReasonSpam, report.Reason())
+			assert.Equal(t, tt.wantDetails, report.Details())
+			assert.Equal(t, now, report.CreatedAt())
+		})
+	}
+}
+
+func TestNewPostReport_FailureCase(t *testing.T) {
+	tests := []struct {
+		name    string
+		reason  string
+		details string
+	}{
+		{name: "unknown reason", reason: "boring"},
+		{name: "empty reason", reason: ""},
+		{name: "details too long", reason: "other", details: strings.Repeat("a", 1001)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := entity.NewPostReport(uuid.New(), uuid.New(), tt.reason, tt.details, time.Now())
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+			assert.Nil(t, report)
+		})
+	}
+}
+
+func TestNewFlaggedPostReport(t *testing.T) {
+	postID := uuid.New()
+	now := time.Now()
+
+	report, err := entity.NewFlaggedPostReport(postID, vo.ReportReasonSpam, strings.Repeat("あ", 1001), now)
+
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Version(7), report.ID().Version())
+	assert.Equal(t, postID, report.PostID())
+	assert.Nil(t, report.ReporterID())
+	assert.Equal(t, vo.ReportReasonSpam, report.Reason())
+	assert.Equal(t, strings.Repeat("あ", 1000), report.Details())
+	assert.Equal(t, now, report.CreatedAt())
+}
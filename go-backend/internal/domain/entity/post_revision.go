@@ -0,0 +1,100 @@
+//go:generate mockgen -source=post_revision.go -destination=../../../test/mock/domain/entity/mock_post_revision.go
+
+package entity
+
+import (
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// PostRevision is an immutable snapshot of a post's content and its format. The original content is
+// revision 1 and every edit that changes the content or the format appends the next number.
+type PostRevision interface {
+	ID() uuid.UUID
+	PostID() uuid.UUID
+	// Number is assigned when the revision is stored; it is 0 on a revision that has not been persisted yet.
+	Number() int
+	Content() string
+	Format() vo.ContentFormat
+	// EditorID is the user who wrote this version: the author for revision 1, the editor afterwards.
+	// It is uuid.Nil once that user has been deleted.
+	EditorID() uuid.UUID
+	CreatedAt() time.Time
+}
+
+type postRevisionImpl struct {
+	id        uuid.UUID
+	postID    uuid.UUID
+	number    int
+	content   string
+	format    vo.ContentFormat
+	editorID  uuid.UUID
+	createdAt time.Time
+}
+
+func (r *postRevisionImpl) ID() uuid.UUID {
+	return r.id
+}
+
+func (r *postRevisionImpl) PostID() uuid.UUID {
+	return r.postID
+}
+
+func (r *postRevisionImpl) Number() int {
+	return r.number
+}
+
+func (r *postRevisionImpl) Content() string {
+	return r.content
+}
+
+func (r *postRevisionImpl) Format() vo.ContentFormat {
+	return r.format
+}
+
+func (r *postRevisionImpl) EditorID() uuid.UUID {
+	return r.editorID
+}
+
+func (r *postRevisionImpl) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// NewPostRevision snapshots the current content and format of post as written by editorID at post.UpdatedAt().
+func NewPostRevision(post Post, editorID uuid.UUID) (PostRevision, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return &postRevisionImpl{
+		id:        id,
+		postID:    post.ID(),
+		content:   post.Content(),
+		format:    post.Format(),
+		editorID:  editorID,
+		createdAt: post.UpdatedAt(),
+	}, nil
+}
+
+// ReconstructPostRevision rebuilds a PostRevision from persisted values without validation.
+func ReconstructPostRevision(
+	id, postID uuid.UUID,
+	number int,
+	content string,
+	format vo.ContentFormat,
+	editorID uuid.UUID,
+	createdAt time.Time,
+) PostRevision {
+	return &postRevisionImpl{
+		id:        id,
+		postID:    postID,
+		number:    number,
+		content:   content,
+		format:    format,
+		editorID:  editorID,
+		createdAt: createdAt,
+	}
+}
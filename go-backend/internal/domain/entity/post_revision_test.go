@@ -0,0 +1,80 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPostRevision_HappyCase(t *testing.T) {
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	editedAt := createdAt.Add(time.Hour)
+	editorID := uuid.New()
+
+	tests := []struct {
+		name string
+		post entity.Post
+		want time.Time
+	}{
+		{
+			name: "original post snapshots its creation time",
+			post: entity.ReconstructPost(
+				uuid.New(),
+				uuid.New(),
+				"hello",
+				vo.ContentFormatPlain,
+				vo.PostKindPost,
+				nil,
+				vo.PostVisibilityPublic,
+				vo.PostStatusPublished,
+				nil,
+				nil,
+				nil,
+				nil,
+				createdAt,
+				createdAt,
+			),
+			want: createdAt,
+		},
+		{
+			name: "edited post snapshots its update time and format",
+			post: entity.ReconstructPost(
+				uuid.New(),
+				uuid.New(),
+				"hello",
+				vo.ContentFormatMarkdown,
+				vo.PostKindPost,
+				nil,
+				vo.PostVisibilityPublic,
+				vo.PostStatusPublished,
+				nil,
+				nil,
+				nil,
+				nil,
+				createdAt,
+				editedAt,
+			),
+			want: editedAt,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revision, err := entity.NewPostRevision(tt.post, editorID)
+
+			require.NoError(t, err)
+			assert.Equal(t, uuid.Version(7), revision.ID().Version())
+			assert.Equal(t, tt.post.ID(), revision.PostID())
+			assert.Equal(t, 0, revision.Number())
+			assert.Equal(t, "hello", revision.Content())
+			assert.Equal(t, tt.post.Format(), revision.Format())
+			assert.Equal(t, editorID, revision.EditorID())
+			assert.Equal(t, tt.want, revision.CreatedAt())
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package entity_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,37 +29,134 @@ func TestNewPost_HappyCase(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			post, err := entity.NewPost(tt.userID, tt.content, tt.createdAt)
+			post, err := entity.NewPost(entity.PostParams{UserID: tt.userID, Content: tt.content, CreatedAt: tt.createdAt})
 
 			require.NoError(t, err)
 			assert.NotEqual(t, uuid.Nil, post.ID())
 			assert.Equal(t, tt.userID, post.UserID())
 			assert.Equal(t, tt.content, post.Content())
 			assert.Equal(t, tt.createdAt, post.CreatedAt())
+			assert.Equal(t, tt.createdAt, post.UpdatedAt())
 		})
 	}
 }
 
+func TestNewPost_Visibility(t *testing.T) {
+	tests := []struct {
+		visibility string
+		want       vo.PostVisibility
+	}{
+		{visibility: "", want: vo.PostVisibilityPublic},
+		{visibility: "followers", want: vo.PostVisibilityFollowers},
+		{visibility: "private", want: vo.PostVisibilityPrivate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.visibility, func(t *testing.T) {
+			post, err := entity.NewPost(entity.PostParams{
+				UserID:     uuid.New(),
+				Content:    "content",
+				Visibility: tt.visibility,
+				CreatedAt:  time.Now(),
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, post.Visibility())
+			assert.Equal(t, vo.PostStatusPublished, post.Status())
+			assert.Nil(t, post.PublishAt())
+		})
+	}
+}
+
+func TestNewPost_Format(t *testing.T) {
+	tests := []struct {
+		format string
+		want   vo.ContentFormat
+	}{
+		{format: "", want: vo.ContentFormatPlain},
+		{format: "plain", want: vo.ContentFormatPlain},
+		{format: "markdown", want: vo.ContentFormatMarkdown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			post, err := entity.NewPost(entity.PostParams{
+				UserID:    uuid.New(),
+				Content:   "**content**",
+				Format:    tt.format,
+				CreatedAt: time.Now(),
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, post.Format())
+			assert.Equal(t, "**content**", post.Content())
+		})
+	}
+}
+
+func TestNewPost_Draft(t *testing.T) {
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	publishAt := createdAt.Add(time.Hour)
+
+	t.Run("draft without a schedule", func(t *testing.T) {
+		post, err := entity.NewPost(entity.PostParams{
+			UserID:    uuid.New(),
+			Content:   "content",
+			Draft:     true,
+			CreatedAt: createdAt,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, vo.PostStatusDraft, post.Status())
+		assert.Nil(t, post.PublishAt())
+	})
+
+	t.Run("publishAt implies draft", func(t *testing.T) {
+		post, err := entity.NewPost(entity.PostParams{
+			UserID:    uuid.New(),
+			Content:   "content",
+			PublishAt: &publishAt,
+			CreatedAt: createdAt,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, vo.PostStatusDraft, post.Status())
+		assert.Equal(t, &publishAt, post.PublishAt())
+	})
+}
+
 func TestNewPost_FailureCase(t *testing.T) {
+	createdAt := time.Date(2026, 1, 18, 0, 0, 0, 0, time.UTC)
+	past := createdAt.Add(-time.Minute)
+
 	tests := []struct {
-		name      string
-		userID    uuid.UUID
-		content   string
-		createdAt time.Time
+		name       string
+		content    string
+		format     string
+		visibility string
+		publishAt  *time.Time
 	}{
-		{
-			name:      "empty content returns error",
-			userID:    uuid.New(),
-			content:   "",
-			createdAt: time.Date(2026, 1, 18, 0, 0, 0, 0, time.UTC),
-		},
+		{name: "empty content returns error", content: ""},
+		{name: "unknown format returns error", content: "content", format: "html"},
+		{name: "unknown visibility returns error", content: "content", visibility: "friends"},
+		{name: "publishAt in the past returns error", content: "content", publishAt: &past},
+		{name: "publishAt at creation returns error", content: "content", publishAt: &createdAt},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			post, err := entity.NewPost(tt.userID, tt.content, tt.createdAt)
+			post, err := entity.NewPost(entity.PostParams{
+				UserID:     uuid.New(),
+				Content:    tt.content,
+				Format:     tt.format,
+				Visibility: tt.visibility,
+				PublishAt:  tt.publishAt,
+				CreatedAt:  createdAt,
+			})
 
-			require.Error(t, err)
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
 			assert.Nil(t, post)
 		})
 	}
@@ -66,7 +165,7 @@ func TestNewPost_FailureCase(t *testing.T) {
 func TestNewPost_GeneratesUuidV7(t *testing.T) {
 	t.Run("generated id has UUID version 7", func(t *testing.T) {
 		userID := uuid.New()
-		post, err := entity.NewPost(userID, "some content", time.Now())
+		post, err := entity.NewPost(entity.PostParams{UserID: userID, Content: "some content", CreatedAt: time.Now()})
 
 		require.NoError(t, err)
 		assert.Equal(t, uuid.Version(7), post.ID().Version())
@@ -74,8 +173,8 @@ func TestNewPost_GeneratesUuidV7(t *testing.T) {
 
 	t.Run("consecutively generated ids are time-ordered", func(t *testing.T) {
 		userID := uuid.New()
-		post1, err1 := entity.NewPost(userID, "first post", time.Now())
-		post2, err2 := entity.NewPost(userID, "second post", time.Now())
+		post1, err1 := entity.NewPost(entity.PostParams{UserID: userID, Content: "first post", CreatedAt: time.Now()})
+		post2, err2 := entity.NewPost(entity.PostParams{UserID: userID, Content: "second post", CreatedAt: time.Now()})
 
 		require.NoError(t, err1)
 		require.NoError(t, err2)
@@ -85,31 +184,497 @@ func TestNewPost_GeneratesUuidV7(t *testing.T) {
 	})
 }
 
+func newOriginalPost(kind vo.PostKind, visibility vo.PostVisibility, status vo.PostStatus, hidden bool) entity.Post {
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+
+	var hiddenAt *time.Time
+	if hidden {
+		hiddenAt = &createdAt
+	}
+
+	return entity.ReconstructPost(
+		uuid.New(), uuid.New(), "original", vo.ContentFormatMarkdown, kind, nil, visibility, status, nil, hiddenAt,
+		nil, nil, createdAt, createdAt,
+	)
+}
+
+func TestNewRepost_HappyCase(t *testing.T) {
+	userID := uuid.New()
+	createdAt := time.Date(2026, 3, 9, 12, 0, 0, 0, time.UTC)
+	original := newOriginalPost(vo.PostKindQuote, vo.PostVisibilityPublic, vo.PostStatusPublished, false)
+
+	repost, err := entity.NewRepost(userID, original, createdAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, userID, repost.UserID())
+	assert.Equal(t, vo.PostKindRepost, repost.Kind())
+	require.NotNil(t, repost.OriginalID())
+	assert.Equal(t, original.ID(), *repost.OriginalID())
+	assert.Empty(t, repost.Content())
+	assert.Equal(t, vo.ContentFormatPlain, repost.Format())
+	assert.Equal(t, vo.PostVisibilityPublic, repost.Visibility())
+	assert.Equal(t, vo.PostStatusPublished, repost.Status())
+	assert.Equal(t, createdAt, repost.CreatedAt())
+}
+
+func TestNewQuotePost_HappyCase(t *testing.T) {
+	userID := uuid.New()
+	createdAt := time.Date(2026, 3, 9, 12, 0, 0, 0, time.UTC)
+	original := newOriginalPost(vo.PostKindPost, vo.PostVisibilityPublic, vo.PostStatusPublished, false)
+
+	quote, err := entity.NewQuotePost(original, entity.PostParams{
+		UserID:     userID,
+		Content:    "  my take  ",
+		Visibility: "followers",
+		Draft:      true,
+		CreatedAt:  createdAt,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, vo.PostKindQuote, quote.Kind())
+	require.NotNil(t, quote.OriginalID())
+	assert.Equal(t, original.ID(), *quote.OriginalID())
+	assert.Equal(t, "my take", quote.Content())
+	assert.Equal(t, vo.PostVisibilityFollowers, quote.Visibility())
+	assert.Equal(t, vo.PostStatusDraft, quote.Status())
+}
+
+func TestNewRepost_NewQuotePost_FailureCase(t *testing.T) {
+	tests := []struct {
+		name     string
+		original entity.Post
+	}{
+		{
+			name:     "repost",
+			original: newOriginalPost(vo.PostKindRepost, vo.PostVisibilityPublic, vo.PostStatusPublished, false),
+		},
+		{
+			name:     "draft",
+			original: newOriginalPost(vo.PostKindPost, vo.PostVisibilityPublic, vo.PostStatusDraft, false),
+		},
+		{
+			name:     "hidden post",
+			original: newOriginalPost(vo.PostKindPost, vo.PostVisibilityPublic, vo.PostStatusPublished, true),
+		},
+		{
+			name:     "followers-only post",
+			original: newOriginalPost(vo.PostKindPost, vo.PostVisibilityFollowers, vo.PostStatusPublished, false),
+		},
+		{
+			name:     "private post",
+			original: newOriginalPost(vo.PostKindPost, vo.PostVisibilityPrivate, vo.PostStatusPublished, false),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			createdAt := time.Date(2026, 3, 9, 12, 0, 0, 0, time.UTC)
+
+			_, repostErr := entity.NewRepost(uuid.New(), tt.original, createdAt)
+			_, quoteErr := entity.NewQuotePost(tt.original, entity.PostParams{
+				UserID:    uuid.New(),
+				Content:   "my take",
+				CreatedAt: createdAt,
+			})
+
+			for _, err := range []error{repostErr, quoteErr} {
+				var voErr vo.Error
+				require.ErrorAs(t, err, &voErr)
+				assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+			}
+		})
+	}
+}
+
+func TestNewQuotePost_EmptyContent(t *testing.T) {
+	original := newOriginalPost(vo.PostKindPost, vo.PostVisibilityPublic, vo.PostStatusPublished, false)
+
+	_, err := entity.NewQuotePost(original, entity.PostParams{UserID: uuid.New(), Content: " ", CreatedAt: time.Now()})
+
+	var voErr vo.Error
+	require.ErrorAs(t, err, &voErr)
+	assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+}
+
 func TestReconstructPost_HappyCase(t *testing.T) {
+	publishAt := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
 	tests := []struct {
-		name      string
-		id        uuid.UUID
-		userID    uuid.UUID
-		content   string
-		createdAt time.Time
+		name       string
+		id         uuid.UUID
+		userID     uuid.UUID
+		content    string
+		visibility vo.PostVisibility
+		status     vo.PostStatus
+		publishAt  *time.Time
+		hiddenAt   *time.Time
+		createdAt  time.Time
+		updatedAt  time.Time
 	}{
 		{
-			name:      "reconstructs a Post with exact field values",
-			id:        uuid.MustParse("11111111-1111-1111-1111-111111111111"),
-			userID:    uuid.MustParse("22222222-2222-2222-2222-222222222222"),
-			content:   "Reconstructed post content.",
-			createdAt: time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC),
+			name:       "reconstructs a Post with exact field values",
+			id:         uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+			userID:     uuid.MustParse("22222222-2222-2222-2222-222222222222"),
+			content:    "Reconstructed post content.",
+			visibility: vo.PostVisibilityPublic,
+			status:     vo.PostStatusPublished,
+			createdAt:  time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC),
+			updatedAt:  time.Date(2026, 3, 9, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "reconstructs a scheduled draft",
+			id:         uuid.MustParse("33333333-3333-3333-3333-333333333333"),
+			userID:     uuid.MustParse("22222222-2222-2222-2222-222222222222"),
+			content:    "Scheduled post content.",
+			visibility: vo.PostVisibilityPrivate,
+			status:     vo.PostStatusDraft,
+			publishAt:  &publishAt,
+			createdAt:  time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC),
+			updatedAt:  time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "reconstructs a hidden post",
+			id:         uuid.MustParse("44444444-4444-4444-4444-444444444444"),
+			userID:     uuid.MustParse("22222222-2222-2222-2222-222222222222"),
+			content:    "Hidden post content.",
+			visibility: vo.PostVisibilityPublic,
+			status:     vo.PostStatusPublished,
+			hiddenAt:   &publishAt,
+			createdAt:  time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC),
+			updatedAt:  time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC),
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			post := entity.ReconstructPost(tt.id, tt.userID, tt.content, tt.createdAt)
+			post := entity.ReconstructPost(
+				tt.id,
+				tt.userID,
+				tt.content,
+				vo.ContentFormatPlain,
+				vo.PostKindPost,
+				nil,
+				tt.visibility,
+				tt.status,
+				tt.publishAt,
+				tt.hiddenAt,
+				nil,
+				nil,
+				tt.createdAt,
+				tt.updatedAt,
+			)
 
 			assert.Equal(t, tt.id, post.ID())
 			assert.Equal(t, tt.userID, post.UserID())
 			assert.Equal(t, tt.content, post.Content())
+			assert.Equal(t, tt.visibility, post.Visibility())
+			assert.Equal(t, tt.status, post.Status())
+			assert.Equal(t, tt.publishAt, post.PublishAt())
+			assert.Equal(t, tt.hiddenAt, post.HiddenAt())
 			assert.Equal(t, tt.createdAt, post.CreatedAt())
+			assert.Equal(t, tt.updatedAt, post.UpdatedAt())
+		})
+	}
+}
+
+func TestPost_Edit_HappyCase(t *testing.T) {
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	editedAt := createdAt.Add(time.Hour)
+
+	post := entity.ReconstructPost(
+		uuid.New(),
+		uuid.New(),
+		"typo'd content",
+		vo.ContentFormatPlain,
+		vo.PostKindPost,
+		nil,
+		vo.PostVisibilityPublic,
+		vo.PostStatusPublished,
+		nil,
+		nil,
+		nil,
+		nil,
+		createdAt,
+		createdAt,
+	)
+
+	err := post.Edit("  fixed content  ", "", editedAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, "fixed content", post.Content())
+	assert.Equal(t, createdAt, post.CreatedAt())
+	assert.Equal(t, editedAt, post.UpdatedAt())
+}
+
+func TestPost_Edit_UnchangedContent(t *testing.T) {
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+
+	post := entity.ReconstructPost(
+		uuid.New(),
+		uuid.New(),
+		"same",
+		vo.ContentFormatPlain,
+		vo.PostKindPost,
+		nil,
+		vo.PostVisibilityPublic,
+		vo.PostStatusPublished,
+		nil,
+		nil,
+		nil,
+		nil,
+		createdAt,
+		createdAt,
+	)
+
+	err := post.Edit("  same ", "", createdAt.Add(time.Hour))
+
+	require.NoError(t, err)
+	assert.Equal(t, "same", post.Content())
+	assert.Equal(t, createdAt, post.UpdatedAt())
+}
+
+func TestPost_Edit_Format(t *testing.T) {
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	editedAt := createdAt.Add(time.Hour)
+
+	post := entity.ReconstructPost(
+		uuid.New(),
+		uuid.New(),
+		"*same*",
+		vo.ContentFormatPlain,
+		vo.PostKindPost,
+		nil,
+		vo.PostVisibilityPublic,
+		vo.PostStatusPublished,
+		nil,
+		nil,
+		nil,
+		nil,
+		createdAt,
+		createdAt,
+	)
+
+	// Changing only the format is an edit.
+	require.NoError(t, post.Edit("*same*", "markdown", editedAt))
+	assert.Equal(t, vo.ContentFormatMarkdown, post.Format())
+	assert.Equal(t, editedAt, post.UpdatedAt())
+
+	// An empty format keeps the current one.
+	require.NoError(t, post.Edit("*changed*", "", editedAt.Add(time.Hour)))
+	assert.Equal(t, vo.ContentFormatMarkdown, post.Format())
+	assert.Equal(t, "*changed*", post.Content())
+
+	var voErr vo.Error
+	require.ErrorAs(t, post.Edit("*changed*", "html", editedAt.Add(2*time.Hour)), &voErr)
+	assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+	assert.Equal(t, vo.ContentFormatMarkdown, post.Format())
+}
+
+func TestPost_Edit_FailureCase(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "empty content", content: ""},
+		{name: "whitespace only", content: "   "},
+		{name: "too long", content: strings.Repeat("a", 10001)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+			post := entity.ReconstructPost(
+				uuid.New(),
+				uuid.New(),
+				"original",
+				vo.ContentFormatPlain,
+				vo.PostKindPost,
+				nil,
+				vo.PostVisibilityPublic,
+				vo.PostStatusPublished,
+				nil,
+				nil,
+				nil,
+				nil,
+				createdAt,
+				createdAt,
+			)
+
+			err := post.Edit(tt.content, "", createdAt.Add(time.Hour))
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+			assert.Equal(t, "original", post.Content())
+			assert.Equal(t, createdAt, post.UpdatedAt())
+		})
+	}
+}
+
+func TestPost_Edit_Repost(t *testing.T) {
+	original := newOriginalPost(vo.PostKindPost, vo.PostVisibilityPublic, vo.PostStatusPublished, false)
+	repost, err := entity.NewRepost(uuid.New(), original, time.Date(2026, 3, 9, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	err = repost.Edit("my take", "", time.Date(2026, 3, 9, 13, 0, 0, 0, time.UTC))
+
+	var voErr vo.Error
+	require.ErrorAs(t, err, &voErr)
+	assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+	assert.Empty(t, repost.Content())
+}
+
+func TestPost_Publish(t *testing.T) {
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	publishAt := createdAt.Add(time.Hour)
+	publishedAt := publishAt.Add(time.Minute)
+
+	post := entity.ReconstructPost(
+		uuid.New(),
+		uuid.New(),
+		"scheduled",
+		vo.ContentFormatPlain,
+		vo.PostKindPost,
+		nil,
+		vo.PostVisibilityFollowers,
+		vo.PostStatusDraft,
+		&publishAt,
+		nil,
+		nil,
+		nil,
+		createdAt,
+		createdAt,
+	)
+
+	err := post.Publish(publishedAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, vo.PostStatusPublished, post.Status())
+	assert.Equal(t, vo.PostVisibilityFollowers, post.Visibility())
+	assert.Nil(t, post.PublishAt())
+	assert.Equal(t, publishedAt, post.CreatedAt())
+	assert.Equal(t, publishedAt, post.UpdatedAt())
+}
+
+func TestPost_Publish_AlreadyPublished(t *testing.T) {
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	post := entity.ReconstructPost(
+		uuid.New(), uuid.New(), "live", vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityPublic,
+		vo.PostStatusPublished, nil, nil, nil, nil, createdAt, createdAt,
+	)
+
+	err := post.Publish(createdAt.Add(time.Hour))
+
+	var voErr vo.Error
+	require.ErrorAs(t, err, &voErr)
+	assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+	assert.Equal(t, createdAt, post.CreatedAt())
+}
+
+func TestPost_Hide(t *testing.T) {
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	hiddenAt := createdAt.Add(time.Hour)
+	post := entity.ReconstructPost(
+		uuid.New(), uuid.New(), "abuse", vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityPublic,
+		vo.PostStatusPublished, nil, nil, nil, nil, createdAt, createdAt,
+	)
+
+	err := post.Hide(hiddenAt)
+
+	require.NoError(t, err)
+	require.NotNil(t, post.HiddenAt())
+	assert.Equal(t, hiddenAt, *post.HiddenAt())
+	assert.Equal(t, createdAt, post.UpdatedAt())
+}
+
+func TestPost_Hide_AlreadyHidden(t *testing.T) {
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	hiddenAt := createdAt.Add(time.Hour)
+	post := entity.ReconstructPost(
+		uuid.New(), uuid.New(), "abuse", vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityPublic,
+		vo.PostStatusPublished, nil, &hiddenAt, nil, nil, createdAt, createdAt,
+	)
+
+	err := post.Hide(hiddenAt.Add(time.Hour))
+
+	var voErr vo.Error
+	require.ErrorAs(t, err, &voErr)
+	assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+	assert.Equal(t, hiddenAt, *post.HiddenAt())
+}
+
+func TestPost_Trash(t *testing.T) {
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	deletedAt := createdAt.Add(time.Hour)
+	actorID := uuid.New()
+	post := entity.ReconstructPost(
+		uuid.New(), uuid.New(), "oops", vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityPublic,
+		vo.PostStatusPublished, nil, nil, nil, nil, createdAt, createdAt,
+	)
+
+	err := post.Trash(actorID, deletedAt)
+
+	require.NoError(t, err)
+	require.NotNil(t, post.DeletedAt())
+	assert.Equal(t, deletedAt, *post.DeletedAt())
+	assert.Equal(t, &actorID, post.DeletedBy())
+	assert.Equal(t, createdAt, post.UpdatedAt())
+
+	// A post already in the trash keeps the first deletion.
+	err = post.Trash(uuid.New(), deletedAt.Add(time.Hour))
+
+	var voErr vo.Error
+	require.ErrorAs(t, err, &voErr)
+	assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+	assert.Equal(t, deletedAt, *post.DeletedAt())
+	assert.Equal(t, &actorID, post.DeletedBy())
+}
+
+func TestPost_Restore(t *testing.T) {
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	deletedAt := createdAt.Add(time.Hour)
+	deletedBy := uuid.New()
+
+	tests := []struct {
+		name      string
+		deletedAt *time.Time
+		now       time.Time
+		wantErr   bool
+	}{
+		{name: "within the retention", deletedAt: &deletedAt, now: deletedAt.Add(entity.PostTrashRetention)},
+		{name: "not in the trash", now: deletedAt, wantErr: true},
+		{
+			name: "retention has run out", deletedAt: &deletedAt,
+			now: deletedAt.Add(entity.PostTrashRetention + time.Second), wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var by *uuid.UUID
+			if tt.deletedAt != nil {
+				by = &deletedBy
+			}
+
+			post := entity.ReconstructPost(
+				uuid.New(), uuid.New(), "oops", vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityPublic,
+				vo.PostStatusPublished, nil, nil, tt.deletedAt, by, createdAt, createdAt,
+			)
+
+			err := post.Restore(tt.now)
+
+			if tt.wantErr {
+				var voErr vo.Error
+				require.ErrorAs(t, err, &voErr)
+				assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+				assert.Equal(t, tt.deletedAt, post.DeletedAt())
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Nil(t, post.DeletedAt())
+			assert.Nil(t, post.DeletedBy())
 		})
 	}
 }
@@ -0,0 +1,57 @@
+//go:generate mockgen -source=reaction.go -destination=../../../test/mock/domain/entity/mock_reaction.go
+
+package entity
+
+import (
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// Reaction is a user's reaction of one type to a post. A user reacts at most once per type to a post, so
+// the post, the user and the type identify a reaction.
+type Reaction interface {
+	PostID() uuid.UUID
+	UserID() uuid.UUID
+	Type() vo.ReactionType
+	CreatedAt() time.Time
+}
+
+type reactionImpl struct {
+	postID       uuid.UUID
+	userID       uuid.UUID
+	reactionType vo.ReactionType
+	createdAt    time.Time
+}
+
+func (r *reactionImpl) PostID() uuid.UUID {
+	return r.postID
+}
+
+func (r *reactionImpl) UserID() uuid.UUID {
+	return r.userID
+}
+
+func (r *reactionImpl) Type() vo.ReactionType {
+	return r.reactionType
+}
+
+func (r *reactionImpl) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// NewReaction creates a reaction by userID to postID, validating that rawType is a known reaction type.
+func NewReaction(postID, userID uuid.UUID, rawType string, createdAt time.Time) (Reaction, error) {
+	reactionType, err := vo.ReactionTypeFromString(rawType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reactionImpl{
+		postID:       postID,
+		userID:       userID,
+		reactionType: reactionType,
+		createdAt:    createdAt,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReaction_HappyCase(t *testing.T) {
+	postID, userID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	reaction, err := entity.NewReaction(postID, userID, "love", now)
+
+	require.NoError(t, err)
+	assert.Equal(t, postID, reaction.PostID())
+	assert.Equal(t, userID, reaction.UserID())
+	assert.Equal(t, vo.ReactionTypeLove, reaction.Type())
+	assert.Equal(t, now, reaction.CreatedAt())
+}
+
+func TestNewReaction_UnknownType(t *testing.T) {
+	reaction, err := entity.NewReaction(uuid.New(), uuid.New(), "dislike", time.Now())
+
+	require.Error(t, err)
+	assert.Nil(t, reaction)
+}
@@ -0,0 +1,30 @@
+//go:generate mockgen -source=attachment_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_attachment_repository.go
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+var ErrAttachmentAlreadyAttached = errors.New("attachment is already attached")
+
+// AttachmentRepository persists attachment metadata; the content is kept in blob storage. Every method only
+// sees attachments of the active tenant in ctx.
+type AttachmentRepository interface {
+	Create(ctx context.Context, attachment entity.Attachment) error
+	// FindByIDs returns the attachments among ids that exist, in no particular order.
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.Attachment, error)
+	// Attach stores the post and position of an attachment that AttachTo was called on. Returns
+	// ErrAttachmentAlreadyAttached when it has been attached to a post in the meantime.
+	Attach(ctx context.Context, attachment entity.Attachment) error
+	// FindOrphans returns up to limit attachments without a post that were uploaded before uploadedBefore,
+	// oldest first.
+	FindOrphans(ctx context.Context, uploadedBefore time.Time, limit int) ([]entity.Attachment, error)
+	// Delete removes the metadata of the attachments; deleting their blobs is up to the caller.
+	Delete(ctx context.Context, ids []uuid.UUID) error
+}
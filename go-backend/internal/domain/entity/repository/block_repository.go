@@ -0,0 +1,22 @@
+//go:generate mockgen -source=block_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_block_repository.go
+
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+var ErrBlockedUserNotFound = errors.New("blocked user is not a member of the organization")
+
+// BlockRepository persists blocks. Every method only sees blocks of the active tenant in ctx.
+type BlockRepository interface {
+	// Add stores the block. It reports false, and changes nothing, when the blocker already blocks the
+	// user, and returns ErrBlockedUserNotFound when the blocked user is not a member of the active tenant.
+	Add(ctx context.Context, block entity.Block) (bool, error)
+	// Remove deletes the block. It reports false when there was no such block.
+	Remove(ctx context.Context, blockerID, blockedID uuid.UUID) (bool, error)
+}
@@ -0,0 +1,19 @@
+//go:generate mockgen -source=bookmark_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_bookmark_repository.go
+
+package repository
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// BookmarkRepository persists bookmarks. Every method only sees bookmarks of the active tenant in ctx.
+type BookmarkRepository interface {
+	// Add stores the bookmark. It reports false, and changes nothing, when the user has already bookmarked
+	// the post, and returns ErrPostNotFound when the user may not see the post in the active tenant.
+	Add(ctx context.Context, bookmark entity.Bookmark) (bool, error)
+	// Remove deletes the bookmark. It reports false when there was no such bookmark.
+	Remove(ctx context.Context, userID, postID uuid.UUID) (bool, error)
+}
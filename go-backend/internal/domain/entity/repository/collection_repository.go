@@ -0,0 +1,35 @@
+//go:generate mockgen -source=collection_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_collection_repository.go
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+var ErrCollectionNotFound = errors.New("collection not found")
+
+// CollectionRepository persists collections and the posts in them. Every method only sees collections of
+// the active tenant in ctx.
+type CollectionRepository interface {
+	Create(ctx context.Context, collection entity.Collection) (entity.Collection, error)
+	// FindByID returns ErrCollectionNotFound when the collection does not exist in the active tenant.
+	FindByID(ctx context.Context, id uuid.UUID) (entity.Collection, error)
+	// CountByUserID returns the number of collections the user has in the active tenant.
+	CountByUserID(ctx context.Context, userID uuid.UUID) (int, error)
+	// Update saves the name of the collection. Returns ErrCollectionNotFound when it no longer exists.
+	Update(ctx context.Context, collection entity.Collection) error
+	// Delete removes the collection and its list of posts. Returns ErrCollectionNotFound when it no longer
+	// exists.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// AddPost appends the post to the collection. It reports false, and changes nothing, when the post is
+	// already in the collection, and returns ErrPostNotFound when the owner of the collection may not see
+	// the post in the active tenant.
+	AddPost(ctx context.Context, collection entity.Collection, postID uuid.UUID, addedAt time.Time) (bool, error)
+	// RemovePost takes the post out of the collection. It reports false when the post was not in it.
+	RemovePost(ctx context.Context, collectionID, postID uuid.UUID) (bool, error)
+}
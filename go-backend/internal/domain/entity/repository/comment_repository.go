@@ -0,0 +1,26 @@
+//go:generate mockgen -source=comment_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_comment_repository.go
+
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+var ErrCommentNotFound = errors.New("comment not found")
+
+// CommentRepository persists comments. Every method only sees comments of the active tenant in ctx.
+type CommentRepository interface {
+	// Create stores the comment. Returns ErrPostNotFound when the post does not exist in the active tenant or
+	// the commenter and the author of the post or of the parent comment block one another, and
+	// ErrCommentNotFound when the parent comment no longer exists.
+	Create(ctx context.Context, comment entity.Comment) (entity.Comment, error)
+	// FindByID returns ErrCommentNotFound when the comment does not exist in the active tenant.
+	FindByID(ctx context.Context, id uuid.UUID) (entity.Comment, error)
+	// Delete removes the comment together with all replies below it. Returns ErrCommentNotFound when it
+	// no longer exists.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,23 @@
+//go:generate mockgen -source=follow_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_follow_repository.go
+
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+var ErrFolloweeNotFound = errors.New("followee is not a member of the organization")
+
+// FollowRepository persists the follow graph. Every method only sees follows of the active tenant in ctx.
+type FollowRepository interface {
+	// Add stores the follow. It reports false, and changes nothing, when the follower already follows the
+	// followee or either blocks the other, and returns ErrFolloweeNotFound when the followee is not a member of
+	// the active tenant.
+	Add(ctx context.Context, follow entity.Follow) (bool, error)
+	// Remove deletes the follow. It reports false when there was no such follow.
+	Remove(ctx context.Context, followerID, followeeID uuid.UUID) (bool, error)
+}
@@ -0,0 +1,14 @@
+//go:generate mockgen -source=impersonation_audit_log_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_impersonation_audit_log_repository.go
+
+package repository
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+)
+
+// ImpersonationAuditLogRepository appends impersonation audit entries. Entries are never updated or deleted.
+type ImpersonationAuditLogRepository interface {
+	Create(ctx context.Context, log entity.ImpersonationAuditLog) error
+}
@@ -0,0 +1,26 @@
+//go:generate mockgen -source=invitation_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_invitation_repository.go
+
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+)
+
+var (
+	ErrInvitationNotFound        = errors.New("invitation not found")
+	ErrInvitationAlreadyAccepted = errors.New("invitation has already been accepted")
+)
+
+// InvitationRepository persists invitations and the roles they pre-assign.
+type InvitationRepository interface {
+	// Create stores the invitation together with its roles.
+	Create(ctx context.Context, invitation entity.Invitation) error
+	// FindByTokenHash returns ErrInvitationNotFound when no invitation was issued for the token.
+	FindByTokenHash(ctx context.Context, tokenHash []byte) (entity.Invitation, error)
+	// MarkAccepted records the acceptance. It returns ErrInvitationAlreadyAccepted when a concurrent
+	// signup accepted the invitation first, so one invitation can never create two accounts.
+	MarkAccepted(ctx context.Context, invitation entity.Invitation) error
+}
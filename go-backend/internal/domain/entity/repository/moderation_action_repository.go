@@ -0,0 +1,15 @@
+//go:generate mockgen -source=moderation_action_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_moderation_action_repository.go
+
+package repository
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+)
+
+// ModerationActionRepository appends moderator actions of the active tenant in ctx. Actions are never
+// updated or deleted.
+type ModerationActionRepository interface {
+	Create(ctx context.Context, action entity.ModerationAction) error
+}
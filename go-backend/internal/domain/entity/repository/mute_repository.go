@@ -0,0 +1,22 @@
+//go:generate mockgen -source=mute_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_mute_repository.go
+
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+var ErrMutedUserNotFound = errors.New("muted user is not a member of the organization")
+
+// MuteRepository persists mutes. Every method only sees mutes of the active tenant in ctx.
+type MuteRepository interface {
+	// Add stores the mute. It reports false, and changes nothing, when the muter already mutes the user,
+	// and returns ErrMutedUserNotFound when the muted user is not a member of the active tenant.
+	Add(ctx context.Context, mute entity.Mute) (bool, error)
+	// Remove deletes the mute. It reports false when there was no such mute.
+	Remove(ctx context.Context, muterID, mutedID uuid.UUID) (bool, error)
+}
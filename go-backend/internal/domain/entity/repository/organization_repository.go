@@ -0,0 +1,34 @@
+//go:generate mockgen -source=organization_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_organization_repository.go
+
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrMembershipNotFound      = errors.New("membership not found")
+	ErrMembershipAlreadyExists = errors.New("user is already a member of the organization")
+)
+
+// OrganizationRepository persists organizations and their memberships.
+// Membership lookups only see rows of the active tenant, or rows of the user in the context.
+type OrganizationRepository interface {
+	// Create stores the organization together with its founding membership in one step.
+	Create(ctx context.Context, org entity.Organization, founder entity.OrganizationMembership) error
+	// AddMembership adds a member to the active tenant. Returns ErrMembershipAlreadyExists when
+	// the user already belongs to it.
+	AddMembership(ctx context.Context, membership entity.OrganizationMembership) error
+	// FindMembership returns ErrMembershipNotFound when userID is not a member of organizationID.
+	FindMembership(ctx context.Context, organizationID, userID uuid.UUID) (entity.OrganizationMembership, error)
+	// FindMembershipsByUserID lists the user's memberships, oldest first.
+	FindMembershipsByUserID(ctx context.Context, userID uuid.UUID) ([]entity.OrganizationMembership, error)
+	// FindMemberIDs returns those of userIDs that are members of the active tenant, in no particular order.
+	FindMemberIDs(ctx context.Context, userIDs []uuid.UUID) ([]uuid.UUID, error)
+	// FindAllIDs lists every organization, for background jobs that visit each tenant in turn.
+	FindAllIDs(ctx context.Context) ([]uuid.UUID, error)
+}
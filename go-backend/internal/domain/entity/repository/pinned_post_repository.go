@@ -0,0 +1,24 @@
+//go:generate mockgen -source=pinned_post_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_pinned_post_repository.go
+
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+var ErrTooManyPinnedPosts = errors.New("too many pinned posts")
+
+// PinnedPostRepository persists the posts users pin to their profiles. Every method only sees pins of the
+// active tenant in ctx.
+type PinnedPostRepository interface {
+	// Pin stores the pin. It reports false, and changes nothing, when the post is already pinned, and returns
+	// ErrTooManyPinnedPosts when the user already has entity.MaxPinnedPosts pinned posts that are not in the
+	// trash. Callers must run it in a transaction so that concurrent pins cannot exceed the limit.
+	Pin(ctx context.Context, pin entity.PinnedPost) (bool, error)
+	// Unpin deletes the pin. It reports false when the post was not pinned by the user.
+	Unpin(ctx context.Context, userID, postID uuid.UUID) (bool, error)
+}
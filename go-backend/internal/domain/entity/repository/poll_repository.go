@@ -0,0 +1,26 @@
+//go:generate mockgen -source=poll_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_poll_repository.go
+
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+var ErrPollNotFound = errors.New("poll not found")
+
+// PollRepository persists the polls of posts together with their votes and tallies. Every method only sees
+// polls of the active tenant in ctx.
+type PollRepository interface {
+	// Create stores a new poll with its options.
+	Create(ctx context.Context, poll entity.Poll) error
+	// FindByPostID returns the poll of a published post that viewerID may see. Returns ErrPostNotFound when
+	// the viewer may not see the post, and ErrPollNotFound when it has no poll or is a draft.
+	FindByPostID(ctx context.Context, postID, viewerID uuid.UUID) (entity.Poll, error)
+	// Vote stores the ballot and counts its votes. It reports false, and changes nothing, when the user has
+	// already voted in the poll. Callers must run it in a transaction so that the tallies move with the votes.
+	Vote(ctx context.Context, vote entity.PollVote) (bool, error)
+}
@@ -0,0 +1,18 @@
+//go:generate mockgen -source=post_entity_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_post_entity_repository.go
+
+package repository
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+)
+
+// PostEntityRepository stores the hashtags and mentions found in the content of posts of the active tenant
+// in ctx. Callers must run Replace in the transaction that saves the content.
+type PostEntityRepository interface {
+	// Replace stores the hashtags and mentions among entities as those of the post, dropping the ones of
+	// its earlier content. Mentions whose handle does not name exactly one member of the tenant are dropped.
+	Replace(ctx context.Context, post entity.Post, entities vo.ContentEntities) error
+}
@@ -0,0 +1,25 @@
+//go:generate mockgen -source=post_report_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_post_report_repository.go
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+var ErrPostAlreadyReported = errors.New("post is already reported by the user")
+
+// PostReportRepository persists reports of posts. Every method only sees reports of the active tenant in ctx.
+type PostReportRepository interface {
+	// Create stores the report. Returns ErrPostNotFound when the post does not exist in the active tenant or
+	// is not visible to the reporter, and ErrPostAlreadyReported when the reporter has an open report of it.
+	// Reports without a reporter, filed by the content filters, are only checked for the post's existence.
+	Create(ctx context.Context, report entity.PostReport) error
+	// ResolveByPostID closes every open report of the post as resolved by moderatorID at at, and returns
+	// how many it closed.
+	ResolveByPostID(ctx context.Context, postID, moderatorID uuid.UUID, at time.Time) (int, error)
+}
@@ -4,10 +4,57 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/google/uuid"
 )
 
+var (
+	ErrPostNotFound = errors.New("post not found")
+	// ErrDuplicateRepost reports a repost of a post its author already reposted.
+	ErrDuplicateRepost = errors.New("post already reposted")
+)
+
+// PostRepository persists posts. Every method only sees posts of the active tenant in ctx, and only those
+// that are not in the trash unless it says otherwise.
 type PostRepository interface {
+	// Create stores a new post. Returns ErrPostNotFound when it quotes a post its author may not see, such as
+	// one of a user blocked either way.
 	Create(ctx context.Context, post entity.Post) (entity.Post, error)
+	// CreateMany stores new posts in bulk, for imports. Unlike Create it does not return the stored posts.
+	CreateMany(ctx context.Context, posts []entity.Post) error
+	// CreateRepost stores a repost unless its author already reposted the original or may not see it, such as
+	// when either blocks the other, and tells whether it did.
+	CreateRepost(ctx context.Context, repost entity.Post) (bool, error)
+	// DeleteRepost deletes the repost of originalID by userID for good, skipping the trash, and tells whether
+	// there was one.
+	DeleteRepost(ctx context.Context, userID, originalID uuid.UUID) (bool, error)
+	// FindByID returns ErrPostNotFound when the post does not exist in the active tenant.
+	FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error)
+	// Update stores the post's content and updated_at. Returns ErrPostNotFound when it no longer exists.
+	Update(ctx context.Context, post entity.Post) error
+	// Publish stores the publication of a draft: its status, creation and update times. Returns
+	// ErrPostNotFound when it no longer exists as a draft.
+	Publish(ctx context.Context, post entity.Post) error
+	// FindDue returns up to limit drafts scheduled at or before now, the longest overdue first.
+	FindDue(ctx context.Context, now time.Time, limit int) ([]entity.Post, error)
+	// CountWithContentSince counts the user's posts, drafts included, whose content is exactly content and
+	// that were created at or after since.
+	CountWithContentSince(ctx context.Context, userID uuid.UUID, content string, since time.Time) (int, error)
+	// Hide stores the post's hidden_at. Returns ErrPostNotFound when it no longer exists or is already hidden.
+	Hide(ctx context.Context, post entity.Post) error
+	// FindTrashedByID returns a post in the trash. Returns ErrPostNotFound when it is not in the trash of the
+	// active tenant.
+	FindTrashedByID(ctx context.Context, id uuid.UUID) (entity.Post, error)
+	// Trash stores the post's deleted_at and deleted_by. Returns ErrPostNotFound when it no longer exists or is
+	// already in the trash.
+	Trash(ctx context.Context, post entity.Post) error
+	// Restore takes the post out of the trash. Returns ErrPostNotFound when it is no longer in the trash, and
+	// ErrDuplicateRepost when it is a repost and its author has reposted the original again since.
+	Restore(ctx context.Context, post entity.Post) error
+	// PurgeTrashed deletes up to limit posts trashed before trashedBefore for good, with their revisions,
+	// comments, reactions and reports, and returns how many it deleted. Their attachments become orphans.
+	PurgeTrashed(ctx context.Context, trashedBefore time.Time, limit int) (int, error)
 }
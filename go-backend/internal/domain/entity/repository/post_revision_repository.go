@@ -0,0 +1,22 @@
+//go:generate mockgen -source=post_revision_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_post_revision_repository.go
+
+package repository
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// PostRevisionRepository appends post revisions of the active tenant in ctx. Revisions are never
+// updated; they are only removed together with their post.
+type PostRevisionRepository interface {
+	// Create stores the revision with the next number for its post and returns it with that number.
+	// Returns ErrPostNotFound when the post does not exist in the active tenant.
+	Create(ctx context.Context, revision entity.PostRevision) (entity.PostRevision, error)
+	// CreateInitial stores the first revisions of posts that have none yet, in bulk, for imports.
+	CreateInitial(ctx context.Context, revisions []entity.PostRevision) error
+	// CountByPostID returns how many revisions the post has.
+	CountByPostID(ctx context.Context, postID uuid.UUID) (int, error)
+}
@@ -0,0 +1,23 @@
+//go:generate mockgen -source=reaction_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_reaction_repository.go
+
+package repository
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// ReactionRepository persists reactions to posts together with the per-post reaction counts. Every method
+// only sees reactions of the active tenant in ctx. Callers must run Add and Remove in a transaction so that
+// the counts move with the reactions.
+type ReactionRepository interface {
+	// Add stores the reaction and counts it. It reports false, and changes nothing, when the user has
+	// already reacted to the post with that type, the post does not exist in the active tenant, or the user
+	// and the post's author block one another.
+	Add(ctx context.Context, reaction entity.Reaction) (bool, error)
+	// Remove deletes the reaction and uncounts it. It reports false when there was no such reaction.
+	Remove(ctx context.Context, postID, userID uuid.UUID, reactionType vo.ReactionType) (bool, error)
+}
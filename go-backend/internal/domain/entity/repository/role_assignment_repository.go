@@ -0,0 +1,20 @@
+//go:generate mockgen -source=role_assignment_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_role_assignment_repository.go
+
+package repository
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+)
+
+// RoleAssignmentRepository persists role assignments together with their audit history.
+// Implementations must write the history row in the same transaction as the assignment change.
+type RoleAssignmentRepository interface {
+	// Grant creates the assignment, or replaces grantor/expiry/reason when the user already holds the role,
+	// and records a GRANTED history entry.
+	Grant(ctx context.Context, assignment entity.RoleAssignment) (entity.RoleAssignment, error)
+	// DeleteExpired removes every assignment whose expiry has passed, records an EXPIRED history entry
+	// for each, and returns the removed assignments.
+	DeleteExpired(ctx context.Context) ([]entity.RoleAssignment, error)
+}
@@ -0,0 +1,25 @@
+//go:generate mockgen -source=timeline_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_timeline_repository.go
+
+package repository
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// TimelineRepository maintains the materialised home timelines of the active tenant in ctx. A post is
+// materialised (fanned out) to every follower of its author, or to none; the posts of other authors are
+// read from the follow graph instead. Callers must run each method in the transaction that changes the
+// post or the follow it reacts to.
+type TimelineRepository interface {
+	// FanOut materialises the post on the timeline of every follower of its author when the author has
+	// at least minFollowers followers. It returns the number of timelines written, 0 when the author has
+	// fewer followers.
+	FanOut(ctx context.Context, post entity.Post, minFollowers int) (int, error)
+	// Backfill materialises the followee's fanned-out posts on the timeline of a new follower.
+	Backfill(ctx context.Context, followerID, followeeID uuid.UUID) error
+	// Prune removes the followee's posts from the timeline of a former follower.
+	Prune(ctx context.Context, followerID, followeeID uuid.UUID) error
+}
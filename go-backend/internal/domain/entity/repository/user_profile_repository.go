@@ -0,0 +1,16 @@
+//go:generate mockgen -source=user_profile_repository.go -destination=../../../../test/mock/domain/entity/repository/mock_user_profile_repository.go
+
+package repository
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+)
+
+// UserProfileRepository persists user profiles. Profiles belong to the user rather than to a tenant: the same
+// profile is shown in every organization the user is a member of.
+type UserProfileRepository interface {
+	// Save creates or replaces the profile of its user.
+	Save(ctx context.Context, profile entity.UserProfile) error
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/google/uuid"
 )
 
 var ErrUserNotFound = errors.New("user not found")
@@ -14,4 +15,8 @@ var ErrUserNotFound = errors.New("user not found")
 type UserRepository interface {
 	Create(ctx context.Context, user entity.User) (entity.User, error)
 	FindByEmail(ctx context.Context, email string) (entity.User, error)
+	// FindByID returns ErrUserNotFound when the user does not exist.
+	FindByID(ctx context.Context, id uuid.UUID) (entity.User, error)
+	// UpdateStatus stores the user's status. Returns ErrUserNotFound when the user no longer exists.
+	UpdateStatus(ctx context.Context, user entity.User) error
 }
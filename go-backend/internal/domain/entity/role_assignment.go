@@ -0,0 +1,104 @@
+//go:generate mockgen -source=role_assignment.go -destination=../../../test/mock/domain/entity/mock_role_assignment.go
+
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+var errIllegalExpiresAt = errors.New("expires_at must be after granted_at")
+
+// RoleAssignment represents a role granted to a user, together with who granted it, when, why,
+// and optionally when it stops being effective.
+type RoleAssignment interface {
+	UserID() uuid.UUID
+	RoleID() uuid.UUID
+	// GrantedBy returns the actor who granted the role; uuid.Nil for system-managed grants
+	// such as the default role attached at signup.
+	GrantedBy() uuid.UUID
+	GrantedAt() time.Time
+	// ExpiresAt returns nil for grants that never expire.
+	ExpiresAt() *time.Time
+	Reason() string
+	// IsActiveAt reports whether the assignment is still effective at t.
+	IsActiveAt(t time.Time) bool
+}
+
+type roleAssignmentImpl struct {
+	userID    uuid.UUID
+	roleID    uuid.UUID
+	grantedBy uuid.UUID
+	grantedAt time.Time
+	expiresAt *time.Time
+	reason    string
+}
+
+func (r *roleAssignmentImpl) UserID() uuid.UUID {
+	return r.userID
+}
+
+func (r *roleAssignmentImpl) RoleID() uuid.UUID {
+	return r.roleID
+}
+
+func (r *roleAssignmentImpl) GrantedBy() uuid.UUID {
+	return r.grantedBy
+}
+
+func (r *roleAssignmentImpl) GrantedAt() time.Time {
+	return r.grantedAt
+}
+
+func (r *roleAssignmentImpl) ExpiresAt() *time.Time {
+	return r.expiresAt
+}
+
+func (r *roleAssignmentImpl) Reason() string {
+	return r.reason
+}
+
+func (r *roleAssignmentImpl) IsActiveAt(t time.Time) bool {
+	return r.expiresAt == nil || r.expiresAt.After(t)
+}
+
+// NewRoleAssignment creates a RoleAssignment, validating the reason and that expiresAt (when set)
+// lies after grantedAt.
+func NewRoleAssignment(
+	userID, roleID, grantedBy uuid.UUID, grantedAt time.Time, expiresAt *time.Time, reason string,
+) (RoleAssignment, error) {
+	r, err := vo.NewGrantReason(reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if expiresAt != nil && !expiresAt.After(grantedAt) {
+		return nil, vo.NewValidationError("expiresAt must be in the future", nil, errIllegalExpiresAt)
+	}
+
+	return &roleAssignmentImpl{
+		userID:    userID,
+		roleID:    roleID,
+		grantedBy: grantedBy,
+		grantedAt: grantedAt,
+		expiresAt: expiresAt,
+		reason:    r.String(),
+	}, nil
+}
+
+// ReconstructRoleAssignment rebuilds a RoleAssignment from persisted values without validation.
+func ReconstructRoleAssignment(
+	userID, roleID, grantedBy uuid.UUID, grantedAt time.Time, expiresAt *time.Time, reason string,
+) RoleAssignment {
+	return &roleAssignmentImpl{
+		userID:    userID,
+		roleID:    roleID,
+		grantedBy: grantedBy,
+		grantedAt: grantedAt,
+		expiresAt: expiresAt,
+		reason:    reason,
+	}
+}
@@ -0,0 +1,105 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRoleAssignment_HappyCase(t *testing.T) {
+	grantedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := grantedAt.Add(24 * time.Hour)
+
+	tests := []struct {
+		name      string
+		expiresAt *time.Time
+		reason    string
+	}{
+		{
+			name:      "permanent grant",
+			expiresAt: nil,
+			reason:    "team lead",
+		},
+		{
+			name:      "time-bound grant",
+			expiresAt: &expiresAt,
+			reason:    "incident response",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID, roleID, actorID := uuid.New(), uuid.New(), uuid.New()
+
+			assignment, err := entity.NewRoleAssignment(userID, roleID, actorID, grantedAt, tt.expiresAt, tt.reason)
+
+			require.NoError(t, err)
+			assert.Equal(t, userID, assignment.UserID())
+			assert.Equal(t, roleID, assignment.RoleID())
+			assert.Equal(t, actorID, assignment.GrantedBy())
+			assert.Equal(t, grantedAt, assignment.GrantedAt())
+			assert.Equal(t, tt.expiresAt, assignment.ExpiresAt())
+			assert.Equal(t, tt.reason, assignment.Reason())
+		})
+	}
+}
+
+func TestNewRoleAssignment_FailureCase(t *testing.T) {
+	grantedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	past := grantedAt.Add(-time.Minute)
+
+	tests := []struct {
+		name      string
+		expiresAt *time.Time
+		reason    string
+	}{
+		{
+			name:      "empty reason",
+			expiresAt: nil,
+			reason:    "",
+		},
+		{
+			name:      "expiresAt equal to grantedAt",
+			expiresAt: &grantedAt,
+			reason:    "temporary",
+		},
+		{
+			name:      "expiresAt before grantedAt",
+			expiresAt: &past,
+			reason:    "temporary",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assignment, err := entity.NewRoleAssignment(
+				uuid.New(), uuid.New(), uuid.New(), grantedAt, tt.expiresAt, tt.reason,
+			)
+
+			require.Error(t, err)
+			assert.Nil(t, assignment)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
+
+func TestRoleAssignment_IsActiveAt(t *testing.T) {
+	grantedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := grantedAt.Add(time.Hour)
+
+	permanent := entity.ReconstructRoleAssignment(uuid.New(), uuid.New(), uuid.Nil, grantedAt, nil, "")
+	timeBound := entity.ReconstructRoleAssignment(uuid.New(), uuid.New(), uuid.New(), grantedAt, &expiresAt, "x")
+
+	assert.True(t, permanent.IsActiveAt(grantedAt.Add(1000*time.Hour)))
+	assert.True(t, timeBound.IsActiveAt(expiresAt.Add(-time.Nanosecond)))
+	assert.False(t, timeBound.IsActiveAt(expiresAt))
+	assert.False(t, timeBound.IsActiveAt(expiresAt.Add(time.Minute)))
+}
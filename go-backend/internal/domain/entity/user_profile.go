@@ -0,0 +1,65 @@
+//go:generate mockgen -source=user_profile.go -destination=../../../test/mock/domain/entity/mock_user_profile.go
+
+package entity
+
+import (
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// UserProfile holds what a user tells others about themselves beyond their name. Unlike the rest of the
+// user, every field of the profile is public.
+type UserProfile interface {
+	UserID() uuid.UUID
+	Bio() vo.Bio
+	// AvatarURL is nil when the user has no avatar.
+	AvatarURL() *vo.AvatarURL
+	UpdatedAt() time.Time
+}
+
+type userProfileImpl struct {
+	userID    uuid.UUID
+	bio       vo.Bio
+	avatarURL *vo.AvatarURL
+	updatedAt time.Time
+}
+
+func (p *userProfileImpl) UserID() uuid.UUID {
+	return p.userID
+}
+
+func (p *userProfileImpl) Bio() vo.Bio {
+	return p.bio
+}
+
+func (p *userProfileImpl) AvatarURL() *vo.AvatarURL {
+	return p.avatarURL
+}
+
+func (p *userProfileImpl) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// NewUserProfile validates the profile of userID. An empty bio or avatarURL leaves that field blank.
+func NewUserProfile(userID uuid.UUID, bio, avatarURL string, updatedAt time.Time) (UserProfile, error) {
+	b, err := vo.NewBio(bio)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &userProfileImpl{
+		userID:    userID,
+		bio:       *b,
+		updatedAt: updatedAt,
+	}
+
+	if avatarURL != "" {
+		if profile.avatarURL, err = vo.NewAvatarURL(avatarURL); err != nil {
+			return nil, err
+		}
+	}
+
+	return profile, nil
+}
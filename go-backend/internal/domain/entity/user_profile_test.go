@@ -0,0 +1,53 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUserProfile_HappyCase(t *testing.T) {
+	userID := uuid.New()
+	updatedAt := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+
+	profile, err := entity.NewUserProfile(userID, " Gopher ", "https://example.com/a.png", updatedAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, userID, profile.UserID())
+	assert.Equal(t, vo.Bio("Gopher"), profile.Bio())
+	require.NotNil(t, profile.AvatarURL())
+	assert.Equal(t, "https://example.com/a.png", profile.AvatarURL().String())
+	assert.Equal(t, updatedAt, profile.UpdatedAt())
+
+	// Blank fields clear the profile.
+	profile, err = entity.NewUserProfile(userID, "", "", updatedAt)
+
+	require.NoError(t, err)
+	assert.Empty(t, profile.Bio())
+	assert.Nil(t, profile.AvatarURL())
+}
+
+func TestNewUserProfile_FailureCase(t *testing.T) {
+	for name, tt := range map[string]struct{ bio, avatarURL string }{
+		"bio too long":     {bio: strings.Repeat("a", 161)},
+		"avatar not https": {avatarURL: "http://example.com/a.png"},
+		"avatar not a URL": {avatarURL: "not a url"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			profile, err := entity.NewUserProfile(uuid.New(), tt.bio, tt.avatarURL, time.Now())
+
+			require.Error(t, err)
+			assert.Nil(t, profile)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package vo
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+)
+
+// AttachmentContentType is the media type of an attachment, as determined from its bytes.
+type AttachmentContentType string
+
+const (
+	AttachmentContentTypePNG  AttachmentContentType = "image/png"
+	AttachmentContentTypeJPEG AttachmentContentType = "image/jpeg"
+	AttachmentContentTypeGIF  AttachmentContentType = "image/gif"
+	AttachmentContentTypeWebP AttachmentContentType = "image/webp"
+	AttachmentContentTypePDF  AttachmentContentType = "application/pdf"
+	AttachmentContentTypeText AttachmentContentType = "text/plain"
+)
+
+var errUnsupportedContentType = errors.New("unsupported attachment content type")
+
+// AttachmentContentTypes returns every accepted content type.
+func AttachmentContentTypes() []AttachmentContentType {
+	return []AttachmentContentType{
+		AttachmentContentTypePNG, AttachmentContentTypeJPEG, AttachmentContentTypeGIF, AttachmentContentTypeWebP,
+		AttachmentContentTypePDF, AttachmentContentTypeText,
+	}
+}
+
+func (t AttachmentContentType) String() string {
+	return string(t)
+}
+
+// IsImage reports whether attachments of this type are images that get a thumbnail.
+func (t AttachmentContentType) IsImage() bool {
+	switch t {
+	case AttachmentContentTypePNG, AttachmentContentTypeJPEG, AttachmentContentTypeGIF, AttachmentContentTypeWebP:
+		return true
+	default:
+		return false
+	}
+}
+
+// SniffAttachmentContentType determines the content type from the first bytes of a file (see
+// http.DetectContentType; 512 bytes are enough) rather than trusting the name or the type a client claims.
+// Returns a ValidationError unless the file is of an accepted type.
+func SniffAttachmentContentType(head []byte) (AttachmentContentType, error) {
+	sniffed, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err == nil {
+		for _, t := range AttachmentContentTypes() {
+			if sniffed == string(t) {
+				return t, nil
+			}
+		}
+	}
+
+	accepted := make([]string, 0, len(AttachmentContentTypes()))
+	for _, t := range AttachmentContentTypes() {
+		accepted = append(accepted, t.String())
+	}
+
+	return "", NewValidationError("unsupported file type", map[string]any{
+		"content_type": sniffed,
+		"accepted":     accepted,
+	}, errUnsupportedContentType)
+}
@@ -0,0 +1,49 @@
+package vo_test
+
+import (
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSniffAttachmentContentType(t *testing.T) {
+	tests := []struct {
+		name    string
+		head    []byte
+		want    vo.AttachmentContentType
+		wantErr bool
+	}{
+		{name: "png", head: []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"), want: vo.AttachmentContentTypePNG},
+		{name: "jpeg", head: []byte("\xff\xd8\xff\xe0\x00\x10JFIF"), want: vo.AttachmentContentTypeJPEG},
+		{name: "gif", head: []byte("GIF89a\x01\x00\x01\x00"), want: vo.AttachmentContentTypeGIF},
+		{name: "webp", head: []byte("RIFF\x24\x00\x00\x00WEBPVP8 "), want: vo.AttachmentContentTypeWebP},
+		{name: "pdf", head: []byte("%PDF-1.7\n"), want: vo.AttachmentContentTypePDF},
+		{name: "text drops the charset", head: []byte("hello, world\n"), want: vo.AttachmentContentTypeText},
+		{name: "html is rejected", head: []byte("<!DOCTYPE html><html></html>"), wantErr: true},
+		{name: "zip is rejected", head: []byte("PK\x03\x04\x14\x00"), wantErr: true},
+		{name: "unknown binary is rejected", head: []byte{0x00, 0x01, 0x02, 0x03}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := vo.SniffAttachmentContentType(tt.head)
+			if tt.wantErr {
+				var domainErr vo.Error
+				require.ErrorAs(t, err, &domainErr)
+				assert.Equal(t, vo.ValidationErrorCode, domainErr.Code())
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAttachmentContentType_IsImage(t *testing.T) {
+	assert.True(t, vo.AttachmentContentTypeWebP.IsImage())
+	assert.False(t, vo.AttachmentContentTypePDF.IsImage())
+}
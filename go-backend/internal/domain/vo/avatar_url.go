@@ -0,0 +1,48 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// AvatarURL is the address of the image shown on a user's profile. Only absolute https URLs are accepted so
+// that clients never load an avatar over an insecure or local scheme.
+type AvatarURL string
+
+// maxAvatarURLLength matches the DB column.
+const maxAvatarURLLength = 2048
+
+var errIllegalAvatarURL = errors.New("illegal avatar URL")
+
+// NewAvatarURL validates raw and returns an AvatarURL value object.
+// Leading/trailing whitespace is trimmed before validation.
+func NewAvatarURL(raw string) (*AvatarURL, error) {
+	trimmed := strings.TrimSpace(raw)
+
+	if trimmed == "" {
+		return nil, NewValidationError("avatar URL is required", nil, errIllegalAvatarURL)
+	}
+
+	if len(trimmed) > maxAvatarURLLength {
+		return nil, NewValidationError(
+			fmt.Sprintf("avatar URL must be at most %d bytes long", maxAvatarURLLength),
+			map[string]any{"max_length": maxAvatarURLLength},
+			errIllegalAvatarURL,
+		)
+	}
+
+	parsed, err := url.Parse(trimmed)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" || parsed.User != nil {
+		return nil, NewValidationError("avatar URL must be an absolute https URL", nil, errIllegalAvatarURL)
+	}
+
+	avatarURL := AvatarURL(trimmed)
+
+	return &avatarURL, nil
+}
+
+func (a AvatarURL) String() string {
+	return string(a)
+}
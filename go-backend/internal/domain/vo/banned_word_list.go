@@ -0,0 +1,104 @@
+package vo
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// BannedWordList finds banned words in text. Text and words are compared after folding width and
+// compatibility variants (NFKC) and case and after dropping invisible format characters such as zero-width
+// spaces, so that ＢＡＤ, BAD and a "bad" split by a zero-width space are the same word.
+//
+// Words written in scripts that separate words with spaces only match whole words, so that a banned "ass"
+// does not match "class". Words in Han, Hiragana or Katakana match anywhere, as such text has no spaces.
+type BannedWordList struct {
+	words []string
+}
+
+// NewBannedWordList builds a BannedWordList. Blank and repeated words are ignored.
+func NewBannedWordList(words []string) BannedWordList {
+	seen := make(map[string]bool, len(words))
+	normalized := make([]string, 0, len(words))
+
+	for _, raw := range words {
+		word := normalizeFilterText(strings.TrimSpace(raw))
+		if word == "" || seen[word] {
+			continue
+		}
+
+		seen[word] = true
+		normalized = append(normalized, word)
+	}
+
+	return BannedWordList{words: normalized}
+}
+
+// Len returns the number of distinct banned words.
+func (l BannedWordList) Len() int {
+	return len(l.words)
+}
+
+// Find returns the first banned word, in list order and normalized, that text contains.
+func (l BannedWordList) Find(text string) (string, bool) {
+	if len(l.words) == 0 {
+		return "", false
+	}
+
+	normalized := normalizeFilterText(text)
+
+	for _, word := range l.words {
+		if containsWord(normalized, word) {
+			return word, true
+		}
+	}
+
+	return "", false
+}
+
+func containsWord(text, word string) bool {
+	first, _ := utf8.DecodeRuneInString(word)
+	last, _ := utf8.DecodeLastRuneInString(word)
+
+	for offset := 0; offset < len(text); {
+		i := strings.Index(text[offset:], word)
+		if i < 0 {
+			return false
+		}
+
+		start, end := offset+i, offset+i+len(word)
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+
+		if !(spacedWordRune(first) && spacedWordRune(before)) && !(spacedWordRune(last) && spacedWordRune(after)) {
+			return true
+		}
+
+		_, size := utf8.DecodeRuneInString(text[start:])
+		offset = start + size
+	}
+
+	return false
+}
+
+// spacedWordRune reports whether r is part of a word in a script that separates words with spaces.
+func spacedWordRune(r rune) bool {
+	if r == utf8.RuneError || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+		return false
+	}
+
+	return !unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
+
+// normalizeFilterText folds width, compatibility variants and case and drops invisible format characters.
+func normalizeFilterText(text string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Cf, r) {
+			return -1
+		}
+
+		return r
+	}, strings.ToLower(norm.NFKC.String(text)))
+}
@@ -0,0 +1,46 @@
+package vo_test
+
+import (
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBannedWordList_Find(t *testing.T) {
+	list := vo.NewBannedWordList([]string{"  ", "Scam", "scam", "ばか", "スパム", "free money"})
+
+	tests := []struct {
+		name  string
+		text  string
+		want  string
+		found bool
+	}{
+		{name: "case is folded", text: "What a SCAM this is", want: "scam", found: true},
+		{name: "full-width letters", text: "ｓｃａｍ!", want: "scam", found: true},
+		{name: "zero-width space inside the word", text: "sc\u200bam", want: "scam", found: true},
+		{name: "phrase", text: "get Free  money now, free money", want: "free money", found: true},
+		{name: "part of a longer word", text: "scampi for dinner", found: false},
+		{name: "suffix of a longer word", text: "antiscam", found: false},
+		{name: "japanese matches inside text", text: "このばかが", want: "ばか", found: true},
+		{name: "half-width katakana", text: "ｽﾊﾟﾑです", want: "スパム", found: true},
+		{name: "clean text", text: "hello world", found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			word, found := list.Find(tt.text)
+
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.want, word)
+		})
+	}
+
+	assert.Equal(t, 4, list.Len())
+}
+
+func TestBannedWordList_Find_Empty(t *testing.T) {
+	_, found := vo.NewBannedWordList(nil).Find("anything")
+
+	assert.False(t, found)
+}
@@ -0,0 +1,38 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Bio is the short self-introduction on a user's profile. An empty bio is valid: the profile shows none.
+type Bio string
+
+// maxBioLength matches the DB column.
+const maxBioLength = 160
+
+var errIllegalBio = errors.New("illegal bio")
+
+// NewBio validates raw and returns a Bio value object.
+// Leading/trailing whitespace is trimmed before validation.
+func NewBio(raw string) (*Bio, error) {
+	trimmed := strings.TrimSpace(raw)
+
+	if utf8.RuneCountInString(trimmed) > maxBioLength {
+		return nil, NewValidationError(
+			fmt.Sprintf("bio must be at most %d characters long", maxBioLength),
+			map[string]any{"max_length": maxBioLength},
+			errIllegalBio,
+		)
+	}
+
+	bio := Bio(trimmed)
+
+	return &bio, nil
+}
+
+func (b Bio) String() string {
+	return string(b)
+}
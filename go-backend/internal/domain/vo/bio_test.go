@@ -0,0 +1,47 @@
+package vo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBio_HappyCase(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantBio string
+	}{
+		{name: "non-empty bio", input: "Gopher and tea drinker", wantBio: "Gopher and tea drinker"},
+		{name: "surrounding whitespace is trimmed", input: "  Gopher  ", wantBio: "Gopher"},
+		{name: "empty bio", input: "   ", wantBio: ""},
+		{
+			name:    "boundary: exactly 160-char Unicode bio",
+			input:   strings.Repeat("あ", 160),
+			wantBio: strings.Repeat("あ", 160),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bio, err := vo.NewBio(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBio, bio.String())
+		})
+	}
+}
+
+func TestBio_FailureCase(t *testing.T) {
+	bio, err := vo.NewBio(strings.Repeat("a", 161))
+
+	require.Error(t, err)
+	assert.Nil(t, bio)
+
+	var voErr vo.Error
+	require.ErrorAs(t, err, &voErr)
+	assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+}
@@ -0,0 +1,42 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// CollectionName is the name a user gives one of their post collections.
+type CollectionName string
+
+// maxCollectionNameLength matches the DB column.
+const maxCollectionNameLength = 100
+
+var errIllegalCollectionName = errors.New("illegal collection name")
+
+// NewCollectionName validates raw and returns a CollectionName value object.
+// Leading/trailing whitespace is trimmed before validation.
+func NewCollectionName(raw string) (*CollectionName, error) {
+	trimmed := strings.TrimSpace(raw)
+
+	if trimmed == "" {
+		return nil, NewValidationError("name is required", nil, errIllegalCollectionName)
+	}
+
+	if utf8.RuneCountInString(trimmed) > maxCollectionNameLength {
+		return nil, NewValidationError(
+			fmt.Sprintf("name must be at most %d characters long", maxCollectionNameLength),
+			map[string]any{"max_length": maxCollectionNameLength},
+			errIllegalCollectionName,
+		)
+	}
+
+	name := CollectionName(trimmed)
+
+	return &name, nil
+}
+
+func (n CollectionName) String() string {
+	return string(n)
+}
@@ -0,0 +1,54 @@
+package vo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectionName_HappyCase(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantName string
+	}{
+		{name: "non-empty name", input: "Read later", wantName: "Read later"},
+		{name: "surrounding whitespace is trimmed", input: "  Recipes  ", wantName: "Recipes"},
+		{
+			name:     "boundary: exactly 100-char Unicode name",
+			input:    strings.Repeat("あ", 100),
+			wantName: strings.Repeat("あ", 100),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, err := vo.NewCollectionName(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, name.String())
+		})
+	}
+}
+
+func TestCollectionName_FailureCase(t *testing.T) {
+	for name, input := range map[string]string{
+		"empty string":    "",
+		"whitespace only": "   ",
+		"too long":        strings.Repeat("a", 101),
+	} {
+		t.Run(name, func(t *testing.T) {
+			collectionName, err := vo.NewCollectionName(input)
+
+			require.Error(t, err)
+			assert.Nil(t, collectionName)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ContentEntityType is the kind of a ContentEntity.
+type ContentEntityType string
+
+const (
+	ContentEntityTypeHashtag ContentEntityType = "hashtag"
+	ContentEntityTypeMention ContentEntityType = "mention"
+)
+
+const (
+	// maxHashtagLength and maxMentionLength bound the runes after the # or @; longer runs are not entities.
+	maxHashtagLength = 100
+	maxMentionLength = 64
+)
+
+var errIllegalHashtag = errors.New("illegal hashtag")
+
+// ContentEntity is a hashtag or mention in a text. Start and End are offsets in Unicode code points, End
+// exclusive, and include the leading # or @. Value is the normalised tag or handle without it.
+type ContentEntity struct {
+	Type  ContentEntityType
+	Start int
+	End   int
+	Value string
+}
+
+// ContentEntities are the entities of one text in order of appearance.
+type ContentEntities []ContentEntity
+
+// Values returns the distinct values of the entities of type t in order of first appearance.
+func (e ContentEntities) Values(t ContentEntityType) []string {
+	values := []string{}
+	seen := map[string]bool{}
+
+	for _, entity := range e {
+		if entity.Type == t && !seen[entity.Value] {
+			seen[entity.Value] = true
+			values = append(values, entity.Value)
+		}
+	}
+
+	return values
+}
+
+// ParseContentEntities finds the #hashtags and @mentions in text. The full-width ＃ and ＠ count as well.
+// An entity runs from its marker over letters, marks, digits, underscores and the katakana middle dot, so
+// it ends at whitespace or punctuation, including Japanese punctuation such as 、 and 。. A marker directly
+// after such a character, other than kana and kanji, or after another marker starts no entity (as in a@b
+// or ##), nor does a run that is too long, a hashtag made of digits only, or a mention directly followed by
+// another @.
+func ParseContentEntities(text string) ContentEntities {
+	runes := []rune(text)
+	entities := ContentEntities{}
+
+	for i := 0; i < len(runes); i++ {
+		t, ok := entityTypeOf(runes[i])
+		if !ok || i > 0 && blocksEntity(runes[i-1]) {
+			continue
+		}
+
+		end := i + 1
+		for end < len(runes) && isEntityRune(runes[end]) {
+			end++
+		}
+
+		if entity, ok := newContentEntity(t, runes, i, end); ok {
+			entities = append(entities, entity)
+		}
+
+		i = end - 1
+	}
+
+	return entities
+}
+
+// newContentEntity makes the entity of type t spanning runes[start:end], marker included, unless the run
+// is not a valid entity.
+func newContentEntity(t ContentEntityType, runes []rune, start, end int) (ContentEntity, bool) {
+	body := runes[start+1 : end]
+	if !validEntityBody(t, body) || t == ContentEntityTypeMention && end < len(runes) && isMention(runes[end]) {
+		return ContentEntity{}, false
+	}
+
+	return ContentEntity{Type: t, Start: start, End: end, Value: normalizeEntity(body)}, true
+}
+
+// Hashtag is a normalised tag, without the leading #.
+type Hashtag string
+
+// NewHashtag normalises raw, with or without a leading #, the way ParseContentEntities normalises the
+// hashtags it finds. Returns a ValidationError unless raw is a hashtag that ParseContentEntities finds.
+func NewHashtag(raw string) (*Hashtag, error) {
+	body := []rune(strings.TrimSpace(raw))
+	if len(body) > 0 && (body[0] == '#' || body[0] == '＃') {
+		body = body[1:]
+	}
+
+	for _, r := range body {
+		if !isEntityRune(r) {
+			return nil, NewValidationError(
+				"hashtag may only contain letters, digits and underscores", nil, errIllegalHashtag,
+			)
+		}
+	}
+
+	if !validEntityBody(ContentEntityTypeHashtag, body) {
+		return nil, NewValidationError(
+			fmt.Sprintf("hashtag must be 1 to %d characters long and not only digits", maxHashtagLength),
+			map[string]any{"max_length": maxHashtagLength},
+			errIllegalHashtag,
+		)
+	}
+
+	hashtag := Hashtag(normalizeEntity(body))
+
+	return &hashtag, nil
+}
+
+func (h Hashtag) String() string {
+	return string(h)
+}
+
+func entityTypeOf(r rune) (ContentEntityType, bool) {
+	switch r {
+	case '#', '＃':
+		return ContentEntityTypeHashtag, true
+	case '@', '＠':
+		return ContentEntityTypeMention, true
+	default:
+		return "", false
+	}
+}
+
+func isMention(r rune) bool {
+	t, ok := entityTypeOf(r)
+
+	return ok && t == ContentEntityTypeMention
+}
+
+// blocksEntity reports whether a marker right after r is part of a word (or an HTML entity such as &#39;).
+// Japanese is written without spaces, so a marker may follow kana and kanji directly.
+func blocksEntity(r rune) bool {
+	_, marker := entityTypeOf(r)
+
+	return marker || r == '&' || isEntityRune(r) && !unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
+
+func isEntityRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r) || r == '_' || r == '・' || r == '･'
+}
+
+func validEntityBody(t ContentEntityType, body []rune) bool {
+	if len(body) == 0 {
+		return false
+	}
+
+	if t == ContentEntityTypeMention {
+		return len(body) <= maxMentionLength
+	}
+
+	if len(body) > maxHashtagLength {
+		return false
+	}
+
+	for _, r := range body {
+		if !unicode.IsDigit(r) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeEntity folds width and compatibility variants (NFKC) and case, so that #Ｇｏ and #go are the
+// same tag.
+func normalizeEntity(body []rune) string {
+	return strings.ToLower(norm.NFKC.String(string(body)))
+}
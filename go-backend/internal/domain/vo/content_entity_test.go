@@ -0,0 +1,115 @@
+package vo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:gosmopolitan // Japanese text is under test
+func TestParseContentEntities(t *testing.T) {
+	hashtag := func(start, end int, value string) vo.ContentEntity {
+		return vo.ContentEntity{Type: vo.ContentEntityTypeHashtag, Start: start, End: end, Value: value}
+	}
+	mention := func(start, end int, value string) vo.ContentEntity {
+		return vo.ContentEntity{Type: vo.ContentEntityTypeMention, Start: start, End: end, Value: value}
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  vo.ContentEntities
+	}{
+		{
+			name:  "hashtags and mentions with code point offsets",
+			input: "Hi @Alice, see #GoLang!",
+			want:  vo.ContentEntities{mention(3, 9, "alice"), hashtag(15, 22, "golang")},
+		},
+		{
+			name:  "Japanese ends at Japanese punctuation",
+			input: "今日は#東京タワー、@たろう。",
+			want:  vo.ContentEntities{hashtag(3, 9, "東京タワー"), mention(10, 14, "たろう")},
+		},
+		{
+			name:  "full-width markers and letters are normalised",
+			input: "＃Ｇｏ言語 ＠Ｂｏｂ",
+			want:  vo.ContentEntities{hashtag(0, 5, "go言語"), mention(6, 10, "bob")},
+		},
+		{
+			name:  "katakana middle dot stays inside a tag",
+			input: "#ミドル・ドット",
+			want:  vo.ContentEntities{hashtag(0, 8, "ミドル・ドット")},
+		},
+		{
+			name:  "markers inside words, repeated or in HTML entities are ignored",
+			input: "mail a@example.com, ##twice, &#39; x#y",
+			want:  vo.ContentEntities{},
+		},
+		{
+			name:  "digit-only hashtags and chained mentions are ignored",
+			input: "#2026 #2026年 @user@host",
+			want:  vo.ContentEntities{hashtag(6, 12, "2026年")},
+		},
+		{
+			name:  "bare markers are ignored",
+			input: "# @ #!",
+			want:  vo.ContentEntities{},
+		},
+		{
+			name:  "runs longer than the limit are ignored",
+			input: "#" + strings.Repeat("a", 101) + " #" + strings.Repeat("b", 100),
+			want:  vo.ContentEntities{hashtag(103, 204, strings.Repeat("b", 100))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, vo.ParseContentEntities(tt.input))
+		})
+	}
+}
+
+func TestContentEntities_Values(t *testing.T) {
+	entities := vo.ParseContentEntities("#Go #rust #go @alice")
+
+	assert.Equal(t, []string{"go", "rust"}, entities.Values(vo.ContentEntityTypeHashtag))
+	assert.Equal(t, []string{"alice"}, entities.Values(vo.ContentEntityTypeMention))
+	assert.Empty(t, vo.ParseContentEntities("plain").Values(vo.ContentEntityTypeHashtag))
+}
+
+//nolint:gosmopolitan // Japanese text is under test
+func TestNewHashtag(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "plain tag is lower-cased", input: "GoLang", want: "golang"},
+		{name: "leading marker is dropped", input: "#東京", want: "東京"},
+		{name: "full-width tag is normalised", input: "＃Ｇｏ", want: "go"},
+		{name: "empty", input: "#", wantErr: true},
+		{name: "digits only", input: "123", wantErr: true},
+		{name: "punctuation", input: "go-lang", wantErr: true},
+		{name: "too long", input: strings.Repeat("a", 101), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := vo.NewHashtag(tt.input)
+			if tt.wantErr {
+				var domainErr vo.Error
+				require.ErrorAs(t, err, &domainErr)
+				assert.Equal(t, vo.ValidationErrorCode, domainErr.Code())
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package vo
+
+import "errors"
+
+// ContentFormat is the markup the content of a post is written in. The content is stored as written;
+// clients receive it rendered to sanitised HTML as well.
+type ContentFormat string
+
+const (
+	// ContentFormatPlain content is text without markup.
+	ContentFormatPlain ContentFormat = "plain"
+	// ContentFormatMarkdown content is written in the subset of Markdown the server renders.
+	ContentFormatMarkdown ContentFormat = "markdown"
+)
+
+var errInvalidContentFormat = errors.New("invalid content format")
+
+func (f ContentFormat) String() string {
+	return string(f)
+}
+
+// ContentFormatFromString parses raw, which must match one of the formats exactly. The empty string stands
+// for ContentFormatPlain.
+func ContentFormatFromString(raw string) (ContentFormat, error) {
+	switch ContentFormat(raw) {
+	case "", ContentFormatPlain:
+		return ContentFormatPlain, nil
+	case ContentFormatMarkdown:
+		return ContentFormatMarkdown, nil
+	default:
+		return "", NewValidationError("invalid content format", map[string]any{
+			"format": raw,
+		}, errInvalidContentFormat)
+	}
+}
@@ -0,0 +1,41 @@
+package vo_test
+
+import (
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentFormatFromString(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want vo.ContentFormat
+	}{
+		{raw: "", want: vo.ContentFormatPlain},
+		{raw: "plain", want: vo.ContentFormatPlain},
+		{raw: "markdown", want: vo.ContentFormatMarkdown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := vo.ContentFormatFromString(tt.raw)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestContentFormatFromString_Failure(t *testing.T) {
+	for _, raw := range []string{"Markdown", " plain", "html"} {
+		t.Run(raw, func(t *testing.T) {
+			_, err := vo.ContentFormatFromString(raw)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package vo
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var errInvalidBlockedDomain = errors.New("invalid blocked domain")
+
+// linkPattern matches links that start with a scheme or "www.", up to the first space or delimiter.
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"'()\[\]{}]+`)
+
+// DomainBlocklist finds links to blocked domains in text. A blocked domain also blocks its subdomains.
+// Links are recognised after folding width and compatibility variants (NFKC), so that a full-width
+// ｈｔｔｐｓ：／／ link is found too.
+type DomainBlocklist struct {
+	domains map[string]struct{}
+}
+
+// NewDomainBlocklist builds a DomainBlocklist. Domains are compared case-insensitively and may be given
+// with a leading "*." or "."; blank entries are ignored.
+func NewDomainBlocklist(domains []string) (DomainBlocklist, error) {
+	blocked := make(map[string]struct{}, len(domains))
+
+	for _, raw := range domains {
+		domain := strings.TrimSuffix(strings.TrimLeft(
+			strings.TrimPrefix(normalizeFilterText(strings.TrimSpace(raw)), "*"), ".",
+		), ".")
+		if domain == "" {
+			continue
+		}
+
+		if strings.ContainsAny(domain, "/:@?# \t") {
+			return DomainBlocklist{}, NewValidationError("invalid blocked domain", map[string]any{
+				"domain": raw,
+			}, errInvalidBlockedDomain)
+		}
+
+		blocked[domain] = struct{}{}
+	}
+
+	return DomainBlocklist{domains: blocked}, nil
+}
+
+// Len returns the number of distinct blocked domains.
+func (l DomainBlocklist) Len() int {
+	return len(l.domains)
+}
+
+// Find returns the host of the first link in text to a blocked domain or one of its subdomains.
+func (l DomainBlocklist) Find(text string) (string, bool) {
+	if len(l.domains) == 0 {
+		return "", false
+	}
+
+	for _, link := range linkPattern.FindAllString(normalizeFilterText(text), -1) {
+		if !strings.Contains(link, "://") {
+			link = "http://" + link
+		}
+
+		parsed, err := url.Parse(link)
+		if err != nil {
+			continue
+		}
+
+		host := strings.TrimSuffix(parsed.Hostname(), ".")
+		for domain := host; domain != ""; {
+			if _, ok := l.domains[domain]; ok {
+				return host, true
+			}
+
+			_, parent, found := strings.Cut(domain, ".")
+			if !found {
+				break
+			}
+
+			domain = parent
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,49 @@
+package vo_test
+
+import (
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDomainBlocklist_Find(t *testing.T) {
+	list, err := vo.NewDomainBlocklist([]string{"Spam.Example", "*.tracker.test", ".bad.test.", ""})
+	require.NoError(t, err)
+	assert.Equal(t, 3, list.Len())
+
+	tests := []struct {
+		name  string
+		text  string
+		want  string
+		found bool
+	}{
+		{name: "exact domain", text: "see https://spam.example/offer", want: "spam.example", found: true},
+		{name: "subdomain", text: "http://a.b.SPAM.example:8080/x?y", want: "a.b.spam.example", found: true},
+		{name: "www link without scheme", text: "visit www.bad.test today", want: "www.bad.test", found: true},
+		{name: "wildcard entry", text: "https://tracker.test", want: "tracker.test", found: true},
+		{name: "full-width link", text: "ｈｔｔｐｓ：／／ｓｐａｍ．ｅｘａｍｐｌｅ", want: "spam.example", found: true},
+		{name: "second link is blocked", text: "https://ok.test and https://spam.example", want: "spam.example", found: true},
+		{name: "lookalike domain", text: "https://notspam.example", found: false},
+		{name: "domain in the path", text: "https://ok.test/spam.example", found: false},
+		{name: "bare domain is not a link", text: "spam.example", found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, found := list.Find(tt.text)
+
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.want, host)
+		})
+	}
+}
+
+func TestNewDomainBlocklist_Invalid(t *testing.T) {
+	_, err := vo.NewDomainBlocklist([]string{"https://spam.example"})
+
+	var voErr vo.Error
+	require.ErrorAs(t, err, &voErr)
+	assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+}
@@ -7,6 +7,7 @@ const (
 	InvalidCredentialErrorCode = ErrorCode("INVALID_CREDENTIAL")
 	UnauthorizedErrorCode      = ErrorCode("UNAUTHORIZED")
 	ForbiddenErrorCode         = ErrorCode("FORBIDDEN")
+	NotFoundErrorCode          = ErrorCode("NOT_FOUND")
 	InternalErrorCode          = ErrorCode("INTERNAL_ERROR")
 	DuplicateEmailErrorCode    = ErrorCode("DUPLICATE_EMAIL")
 )
@@ -21,6 +22,8 @@ func (c ErrorCode) Title() string {
 		return "Unauthorized"
 	case ForbiddenErrorCode:
 		return "forbidden"
+	case NotFoundErrorCode:
+		return "not found"
 	case InternalErrorCode:
 		return "internal server error"
 	case DuplicateEmailErrorCode:
@@ -96,6 +99,16 @@ func NewForbiddenError(message string, details map[string]any, err error) error
 	}
 }
 
+func NewNotFoundError(message string, details map[string]any, err error) error {
+	return &baseError{
+		status:  404,
+		code:    NotFoundErrorCode,
+		message: message,
+		err:     err,
+		details: details,
+	}
+}
+
 func NewDuplicateEmailError(err error) error {
 	return &baseError{
 		status:  409,
@@ -45,6 +45,18 @@ func TestNewForbiddenError(t *testing.T) {
 	}
 }
 
+func TestNewNotFoundError(t *testing.T) {
+	err := vo.NewNotFoundError("post not found", nil, errors.New("base"))
+
+	var baseErr vo.Error
+	if assert.ErrorAs(t, err, &baseErr) {
+		assert.Equal(t, 404, baseErr.Status())
+		assert.Equal(t, vo.NotFoundErrorCode, baseErr.Code())
+		assert.Equal(t, "post not found", baseErr.Message())
+		assert.Nil(t, baseErr.Details())
+	}
+}
+
 func TestErrorCode_Title(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -76,6 +88,11 @@ func TestErrorCode_Title(t *testing.T) {
 			code:     vo.ForbiddenErrorCode,
 			expected: "forbidden",
 		},
+		{
+			name:     "not found",
+			code:     vo.NotFoundErrorCode,
+			expected: "not found",
+		},
 		{
 			name:     "unknown",
 			code:     vo.ErrorCode("UNKNOWN"),
@@ -0,0 +1,53 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const maxFileNameLength = 255
+
+var errIllegalFileName = errors.New("illegal file name")
+
+// FileName is the name under which an uploaded file is offered for download. It is only ever used as a
+// label, never as a path.
+type FileName string
+
+// NewFileName keeps the last element of raw, as some clients send the full path of the file, and drops
+// control characters. Returns a ValidationError when nothing is left or the name is too long.
+func NewFileName(raw string) (*FileName, error) {
+	if i := strings.LastIndexAny(raw, `/\`); i >= 0 {
+		raw = raw[i+1:]
+	}
+
+	trimmed := strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+
+		return r
+	}, raw))
+
+	if trimmed == "" || trimmed == "." || trimmed == ".." {
+		return nil, NewValidationError("file name is required", nil, errIllegalFileName)
+	}
+
+	if utf8.RuneCountInString(trimmed) > maxFileNameLength {
+		return nil, NewValidationError(
+			fmt.Sprintf("file name must be at most %d characters long", maxFileNameLength),
+			map[string]any{"max_length": maxFileNameLength},
+			errIllegalFileName,
+		)
+	}
+
+	name := FileName(trimmed)
+
+	return &name, nil
+}
+
+func (n FileName) String() string {
+	return string(n)
+}
@@ -0,0 +1,44 @@
+package vo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFileName(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "plain name", input: "photo.png", want: "photo.png"},
+		{name: "unix path", input: "/home/me/photo.png", want: "photo.png"},
+		{name: "windows path", input: `C:\fakepath\report.pdf`, want: "report.pdf"},
+		{name: "control characters and spaces are dropped", input: " a\x00b\n.txt ", want: "ab.txt"},
+		{name: "empty", input: "  ", wantErr: true},
+		{name: "directory only", input: "dir/", wantErr: true},
+		{name: "dot dot", input: "..", wantErr: true},
+		{name: "too long", input: strings.Repeat("a", 256), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := vo.NewFileName(tt.input)
+			if tt.wantErr {
+				var domainErr vo.Error
+				require.ErrorAs(t, err, &domainErr)
+				assert.Equal(t, vo.ValidationErrorCode, domainErr.Code())
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
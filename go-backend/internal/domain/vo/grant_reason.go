@@ -0,0 +1,42 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// GrantReason is the free-text justification recorded when a role is granted to a user.
+type GrantReason string
+
+// maxGrantReasonLength is a business rule; the DB column is text.
+const maxGrantReasonLength = 512
+
+var errIllegalGrantReason = errors.New("illegal grant reason")
+
+// NewGrantReason validates raw and returns a GrantReason value object.
+// Leading/trailing whitespace is trimmed before validation.
+func NewGrantReason(raw string) (*GrantReason, error) {
+	trimmed := strings.TrimSpace(raw)
+
+	if trimmed == "" {
+		return nil, NewValidationError("reason is required", nil, errIllegalGrantReason)
+	}
+
+	if utf8.RuneCountInString(trimmed) > maxGrantReasonLength {
+		return nil, NewValidationError(
+			fmt.Sprintf("reason must be at most %d characters long", maxGrantReasonLength),
+			map[string]any{"max_length": maxGrantReasonLength},
+			errIllegalGrantReason,
+		)
+	}
+
+	reason := GrantReason(trimmed)
+
+	return &reason, nil
+}
+
+func (r GrantReason) String() string {
+	return string(r)
+}
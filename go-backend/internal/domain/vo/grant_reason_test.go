@@ -0,0 +1,82 @@
+package vo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrantReason_HappyCase(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantReason string
+	}{
+		{
+			name:       "non-empty reason",
+			input:      "on-call rotation",
+			wantReason: "on-call rotation",
+		},
+		{
+			name:       "surrounding whitespace is trimmed",
+			input:      "  incident #42  ",
+			wantReason: "incident #42",
+		},
+		{
+			name:       "boundary: exactly 512-char Unicode reason",
+			input:      strings.Repeat("あ", 512),
+			wantReason: strings.Repeat("あ", 512),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, err := vo.NewGrantReason(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, vo.GrantReason(tt.wantReason), *reason)
+			assert.Equal(t, tt.wantReason, reason.String())
+		})
+	}
+}
+
+func TestGrantReason_FailureCase(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		wantDetails map[string]any
+	}{
+		{
+			name:        "empty string",
+			input:       "",
+			wantDetails: nil,
+		},
+		{
+			name:        "whitespace only",
+			input:       "   ",
+			wantDetails: nil,
+		},
+		{
+			name:        "too long: 513 runes",
+			input:       strings.Repeat("a", 513),
+			wantDetails: map[string]any{"max_length": 512},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, err := vo.NewGrantReason(tt.input)
+
+			require.Error(t, err)
+			assert.Nil(t, reason)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+			assert.Equal(t, tt.wantDetails, voErr.Details())
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package vo
+
+// ImpersonationAuditAction identifies the kind of event recorded in the impersonation audit log.
+type ImpersonationAuditAction string
+
+const (
+	// ImpersonationAuditActionStarted records that an admin obtained an impersonation token.
+	ImpersonationAuditActionStarted ImpersonationAuditAction = "STARTED"
+	// ImpersonationAuditActionRequest records one API request made with an impersonation token.
+	ImpersonationAuditActionRequest ImpersonationAuditAction = "REQUEST"
+)
+
+func (a ImpersonationAuditAction) String() string {
+	return string(a)
+}
@@ -0,0 +1,49 @@
+package vo
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+// invitationTokenBytes is the amount of randomness in a token: 256 bits, encoded as 43 base64url characters.
+const invitationTokenBytes = 32
+
+var errIllegalInvitationToken = errors.New("illegal invitation token")
+
+// InvitationToken is the secret handed to an invitee. Only its hash is stored, so a leaked
+// database dump cannot be used to accept invitations.
+type InvitationToken string
+
+// GenerateInvitationToken returns a new random token.
+func GenerateInvitationToken() (InvitationToken, error) {
+	buf := make([]byte, invitationTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return InvitationToken(base64.RawURLEncoding.EncodeToString(buf)), nil
+}
+
+// NewInvitationToken validates a token presented by a client.
+func NewInvitationToken(raw string) (InvitationToken, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return "", NewValidationError("invitation token is required", nil, errIllegalInvitationToken)
+	}
+
+	return InvitationToken(trimmed), nil
+}
+
+// Hash returns the SHA-256 digest under which the token is stored.
+func (t InvitationToken) Hash() []byte {
+	sum := sha256.Sum256([]byte(t))
+
+	return sum[:]
+}
+
+func (t InvitationToken) String() string {
+	return string(t)
+}
@@ -0,0 +1,51 @@
+package vo_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateInvitationToken(t *testing.T) {
+	first, err := vo.GenerateInvitationToken()
+	require.NoError(t, err)
+
+	second, err := vo.GenerateInvitationToken()
+	require.NoError(t, err)
+
+	assert.Len(t, first.String(), 43)
+	assert.NotEqual(t, first, second)
+}
+
+func TestNewInvitationToken_HappyCase(t *testing.T) {
+	token, err := vo.NewInvitationToken("  abc-DEF_123  ")
+
+	require.NoError(t, err)
+	assert.Equal(t, "abc-DEF_123", token.String())
+
+	want := sha256.Sum256([]byte("abc-DEF_123"))
+	assert.Equal(t, want[:], token.Hash())
+}
+
+func TestNewInvitationToken_FailureCase(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "empty string", input: ""},
+		{name: "whitespace only", input: "   "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := vo.NewInvitationToken(tt.input)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package vo
+
+import (
+	"slices"
+	"strings"
+)
+
+// DiffOperation says how a line of a LineDiff relates the old text to the new one.
+type DiffOperation string
+
+const (
+	// DiffOperationEqual marks a line present in both texts.
+	DiffOperationEqual DiffOperation = "EQUAL"
+	// DiffOperationDelete marks a line only present in the old text.
+	DiffOperationDelete DiffOperation = "DELETE"
+	// DiffOperationInsert marks a line only present in the new text.
+	DiffOperationInsert DiffOperation = "INSERT"
+)
+
+func (o DiffOperation) String() string {
+	return string(o)
+}
+
+// DiffLine is one line of a LineDiff.
+type DiffLine struct {
+	Operation DiffOperation
+	Text      string
+}
+
+// maxDiffCells bounds the LCS table built by DiffLines (rows × columns of the changed region).
+// Content is capped at maxContentLength runes, but a pathological pair of texts with thousands of
+// short lines would still need hundreds of MB; beyond the bound the changed region is reported as a
+// whole-block replacement, which is still a correct (if not minimal) diff.
+const maxDiffCells = 1 << 20
+
+// DiffLines returns a line-based diff that turns from into to. Deleted lines precede inserted ones
+// within each changed block.
+func DiffLines(from, to string) []DiffLine {
+	a := splitLines(from)
+	b := splitLines(to)
+
+	// Common prefix and suffix are cheap to strip and cover the typical small edit.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	diff := make([]DiffLine, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		diff = append(diff, DiffLine{Operation: DiffOperationEqual, Text: line})
+	}
+
+	diff = append(diff, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+
+	for _, line := range a[len(a)-suffix:] {
+		diff = append(diff, DiffLine{Operation: DiffOperationEqual, Text: line})
+	}
+
+	return diff
+}
+
+// diffMiddle diffs the region between the common prefix and suffix using a longest common subsequence.
+func diffMiddle(a, b []string) []DiffLine {
+	if len(a)*len(b) > maxDiffCells {
+		return replaceBlock(a, b)
+	}
+
+	lcs := lcsTable(a, b)
+
+	diff := make([]DiffLine, 0, len(a)+len(b))
+	i, j := 0, 0
+
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			diff = append(diff, DiffLine{Operation: DiffOperationEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, DiffLine{Operation: DiffOperationDelete, Text: a[i]})
+			i++
+		default:
+			diff = append(diff, DiffLine{Operation: DiffOperationInsert, Text: b[j]})
+			j++
+		}
+	}
+
+	return append(diff, replaceBlock(a[i:], b[j:])...)
+}
+
+// lcsTable returns a table whose [i][j] cell is the LCS length of a[i:] and b[j:].
+func lcsTable(a, b []string) [][]int {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i, lineA := range slices.Backward(a) {
+		for j, lineB := range slices.Backward(b) {
+			if lineA == lineB {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	return lcs
+}
+
+func replaceBlock(a, b []string) []DiffLine {
+	diff := make([]DiffLine, 0, len(a)+len(b))
+	for _, line := range a {
+		diff = append(diff, DiffLine{Operation: DiffOperationDelete, Text: line})
+	}
+
+	for _, line := range b {
+		diff = append(diff, DiffLine{Operation: DiffOperationInsert, Text: line})
+	}
+
+	return diff
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
@@ -0,0 +1,83 @@
+package vo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffLines(t *testing.T) {
+	eq := func(s string) vo.DiffLine { return vo.DiffLine{Operation: vo.DiffOperationEqual, Text: s} }
+	del := func(s string) vo.DiffLine { return vo.DiffLine{Operation: vo.DiffOperationDelete, Text: s} }
+	ins := func(s string) vo.DiffLine { return vo.DiffLine{Operation: vo.DiffOperationInsert, Text: s} }
+
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want []vo.DiffLine
+	}{
+		{
+			name: "identical texts",
+			from: "a\nb",
+			to:   "a\nb",
+			want: []vo.DiffLine{eq("a"), eq("b")},
+		},
+		{
+			name: "changed middle line",
+			from: "a\nb\nc",
+			to:   "a\nB\nc",
+			want: []vo.DiffLine{eq("a"), del("b"), ins("B"), eq("c")},
+		},
+		{
+			name: "appended line",
+			from: "a",
+			to:   "a\nb",
+			want: []vo.DiffLine{eq("a"), ins("b")},
+		},
+		{
+			name: "removed first line",
+			from: "a\nb",
+			to:   "b",
+			want: []vo.DiffLine{del("a"), eq("b")},
+		},
+		{
+			name: "common lines inside the changed region are kept",
+			from: "x\na\ny\nb",
+			to:   "a\nz\nb\nw",
+			want: []vo.DiffLine{del("x"), eq("a"), del("y"), ins("z"), eq("b"), ins("w")},
+		},
+		{
+			name: "CRLF is treated as LF",
+			from: "a\r\nb",
+			to:   "a\nb",
+			want: []vo.DiffLine{eq("a"), eq("b")},
+		},
+		{
+			name: "from empty",
+			from: "",
+			to:   "a",
+			want: []vo.DiffLine{ins("a")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, vo.DiffLines(tt.from, tt.to))
+		})
+	}
+}
+
+func TestDiffLines_LargeInputFallsBackToBlockReplacement(t *testing.T) {
+	from := strings.Repeat("a\n", 2000) + "end"
+	to := strings.Repeat("b\n", 2000) + "end"
+
+	diff := vo.DiffLines(from, to)
+
+	assert.Len(t, diff, 4001)
+	assert.Equal(t, vo.DiffLine{Operation: vo.DiffOperationDelete, Text: "a"}, diff[0])
+	assert.Equal(t, vo.DiffLine{Operation: vo.DiffOperationInsert, Text: "b"}, diff[2000])
+	assert.Equal(t, vo.DiffLine{Operation: vo.DiffOperationEqual, Text: "end"}, diff[4000])
+}
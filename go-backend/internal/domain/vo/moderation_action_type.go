@@ -0,0 +1,39 @@
+package vo
+
+import "errors"
+
+// ModerationActionType is what a moderator does about a reported post. Every type resolves the open
+// reports of the post.
+type ModerationActionType string
+
+const (
+	// ModerationActionHide hides the post from everyone but its author.
+	ModerationActionHide ModerationActionType = "hide"
+	// ModerationActionDelete moves the post to the trash; only a moderator may restore it.
+	ModerationActionDelete ModerationActionType = "delete"
+	// ModerationActionWarn records a warning to the author and leaves the post as it is.
+	ModerationActionWarn ModerationActionType = "warn"
+	// ModerationActionFreeze freezes the author's account, which can then no longer log in.
+	ModerationActionFreeze ModerationActionType = "freeze"
+	// ModerationActionDismiss closes the reports without acting on the post.
+	ModerationActionDismiss ModerationActionType = "dismiss"
+)
+
+var errInvalidModerationActionType = errors.New("invalid moderation action")
+
+func (t ModerationActionType) String() string {
+	return string(t)
+}
+
+// ModerationActionTypeFromString parses raw, which must match one of the action types exactly.
+func ModerationActionTypeFromString(raw string) (ModerationActionType, error) {
+	switch t := ModerationActionType(raw); t {
+	case ModerationActionHide, ModerationActionDelete, ModerationActionWarn, ModerationActionFreeze,
+		ModerationActionDismiss:
+		return t, nil
+	default:
+		return "", NewValidationError("invalid moderation action", map[string]any{
+			"action": raw,
+		}, errInvalidModerationActionType)
+	}
+}
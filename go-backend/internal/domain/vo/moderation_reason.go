@@ -0,0 +1,42 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ModerationReason is the free-text justification a moderator records with an action.
+type ModerationReason string
+
+// maxModerationReasonLength is a business rule; the DB column is text.
+const maxModerationReasonLength = 1000
+
+var errIllegalModerationReason = errors.New("illegal moderation reason")
+
+// NewModerationReason validates raw and returns a ModerationReason value object.
+// Leading/trailing whitespace is trimmed before validation.
+func NewModerationReason(raw string) (*ModerationReason, error) {
+	trimmed := strings.TrimSpace(raw)
+
+	if trimmed == "" {
+		return nil, NewValidationError("reason is required", nil, errIllegalModerationReason)
+	}
+
+	if utf8.RuneCountInString(trimmed) > maxModerationReasonLength {
+		return nil, NewValidationError(
+			fmt.Sprintf("reason must be at most %d characters long", maxModerationReasonLength),
+			map[string]any{"max_length": maxModerationReasonLength},
+			errIllegalModerationReason,
+		)
+	}
+
+	reason := ModerationReason(trimmed)
+
+	return &reason, nil
+}
+
+func (r ModerationReason) String() string {
+	return string(r)
+}
@@ -0,0 +1,54 @@
+package vo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModerationReason_HappyCase(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantReason string
+	}{
+		{name: "non-empty reason", input: "spam links", wantReason: "spam links"},
+		{name: "surrounding whitespace is trimmed", input: "  repeated insults  ", wantReason: "repeated insults"},
+		{
+			name:       "boundary: exactly 1000-char Unicode reason",
+			input:      strings.Repeat("あ", 1000),
+			wantReason: strings.Repeat("あ", 1000),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, err := vo.NewModerationReason(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantReason, reason.String())
+		})
+	}
+}
+
+func TestModerationReason_FailureCase(t *testing.T) {
+	for name, input := range map[string]string{
+		"empty string":    "",
+		"whitespace only": "   ",
+		"too long":        strings.Repeat("a", 1001),
+	} {
+		t.Run(name, func(t *testing.T) {
+			reason, err := vo.NewModerationReason(input)
+
+			require.Error(t, err)
+			assert.Nil(t, reason)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
@@ -11,8 +11,16 @@ import (
 type Permission string
 
 const (
-	PermissionUsersList   Permission = "users:list"
-	PermissionUsersCreate Permission = "users:create"
+	PermissionUsersList        Permission = "users:list"
+	PermissionUsersCreate      Permission = "users:create"
+	PermissionUsersImpersonate Permission = "users:impersonate"
+	PermissionUsersInvite      Permission = "users:invite"
+	PermissionRolesList        Permission = "roles:list"
+	PermissionRolesAssign      Permission = "roles:assign"
+	PermissionMembersAdd       Permission = "members:add"
+	PermissionPostsModerate    Permission = "posts:moderate"
+	PermissionPostsImport      Permission = "posts:import"
+	PermissionPostsExport      Permission = "posts:export"
 
 	// maxPermissionLength corresponds to the DB schema: permissions.code varchar(128).
 	maxPermissionLength = 128
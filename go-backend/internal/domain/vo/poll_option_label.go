@@ -0,0 +1,42 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// PollOptionLabel is the text of one option of a poll.
+type PollOptionLabel string
+
+// maxPollOptionLabelLength matches the DB column.
+const maxPollOptionLabelLength = 100
+
+var errIllegalPollOptionLabel = errors.New("illegal poll option label")
+
+// NewPollOptionLabel validates raw and returns a PollOptionLabel value object.
+// Leading/trailing whitespace is trimmed before validation.
+func NewPollOptionLabel(raw string) (*PollOptionLabel, error) {
+	trimmed := strings.TrimSpace(raw)
+
+	if trimmed == "" {
+		return nil, NewValidationError("poll options must not be empty", nil, errIllegalPollOptionLabel)
+	}
+
+	if utf8.RuneCountInString(trimmed) > maxPollOptionLabelLength {
+		return nil, NewValidationError(
+			fmt.Sprintf("poll options must be at most %d characters long", maxPollOptionLabelLength),
+			map[string]any{"max_length": maxPollOptionLabelLength},
+			errIllegalPollOptionLabel,
+		)
+	}
+
+	label := PollOptionLabel(trimmed)
+
+	return &label, nil
+}
+
+func (l PollOptionLabel) String() string {
+	return string(l)
+}
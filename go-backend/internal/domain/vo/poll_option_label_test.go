@@ -0,0 +1,54 @@
+package vo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPollOptionLabel_HappyCase(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantLabel string
+	}{
+		{name: "non-empty label", input: "Tuesday", wantLabel: "Tuesday"},
+		{name: "surrounding whitespace is trimmed", input: "  Friday  ", wantLabel: "Friday"},
+		{
+			name:      "boundary: exactly 100-char Unicode label",
+			input:     strings.Repeat("あ", 100),
+			wantLabel: strings.Repeat("あ", 100),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			label, err := vo.NewPollOptionLabel(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLabel, label.String())
+		})
+	}
+}
+
+func TestPollOptionLabel_FailureCase(t *testing.T) {
+	for name, input := range map[string]string{
+		"empty string":    "",
+		"whitespace only": "   ",
+		"too long":        strings.Repeat("a", 101),
+	} {
+		t.Run(name, func(t *testing.T) {
+			label, err := vo.NewPollOptionLabel(input)
+
+			require.Error(t, err)
+			assert.Nil(t, label)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
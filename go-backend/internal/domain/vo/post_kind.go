@@ -0,0 +1,17 @@
+package vo
+
+// PostKind tells the posts an author writes apart from those that share another post.
+type PostKind string
+
+const (
+	// PostKindPost posts hold content of their author's own only.
+	PostKindPost PostKind = "post"
+	// PostKindRepost posts share another post as it is, without content of their own.
+	PostKindRepost PostKind = "repost"
+	// PostKindQuote posts share another post below content of their author's own.
+	PostKindQuote PostKind = "quote"
+)
+
+func (k PostKind) String() string {
+	return string(k)
+}
@@ -0,0 +1,17 @@
+package vo
+
+// PostStatus is the publication state of a post. Drafts are visible to their author only.
+type PostStatus string
+
+const (
+	PostStatusDraft     PostStatus = "draft"
+	PostStatusPublished PostStatus = "published"
+)
+
+func (s PostStatus) String() string {
+	return string(s)
+}
+
+func (s PostStatus) IsDraft() bool {
+	return s == PostStatusDraft
+}
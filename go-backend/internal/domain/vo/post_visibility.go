@@ -0,0 +1,38 @@
+package vo
+
+import "errors"
+
+// PostVisibility decides who besides the author can see a published post.
+type PostVisibility string
+
+const (
+	// PostVisibilityPublic posts are visible to every member of the organization.
+	PostVisibilityPublic PostVisibility = "public"
+	// PostVisibilityFollowers posts are visible to the followers of the author.
+	PostVisibilityFollowers PostVisibility = "followers"
+	// PostVisibilityPrivate posts are visible to the author only.
+	PostVisibilityPrivate PostVisibility = "private"
+)
+
+var errInvalidPostVisibility = errors.New("invalid post visibility")
+
+func (v PostVisibility) String() string {
+	return string(v)
+}
+
+// PostVisibilityFromString parses raw, which must match one of the visibilities exactly. The empty string
+// stands for PostVisibilityPublic.
+func PostVisibilityFromString(raw string) (PostVisibility, error) {
+	switch PostVisibility(raw) {
+	case "", PostVisibilityPublic:
+		return PostVisibilityPublic, nil
+	case PostVisibilityFollowers:
+		return PostVisibilityFollowers, nil
+	case PostVisibilityPrivate:
+		return PostVisibilityPrivate, nil
+	default:
+		return "", NewValidationError("invalid post visibility", map[string]any{
+			"visibility": raw,
+		}, errInvalidPostVisibility)
+	}
+}
@@ -0,0 +1,42 @@
+package vo_test
+
+import (
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostVisibilityFromString(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want vo.PostVisibility
+	}{
+		{raw: "", want: vo.PostVisibilityPublic},
+		{raw: "public", want: vo.PostVisibilityPublic},
+		{raw: "followers", want: vo.PostVisibilityFollowers},
+		{raw: "private", want: vo.PostVisibilityPrivate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := vo.PostVisibilityFromString(tt.raw)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPostVisibilityFromString_Failure(t *testing.T) {
+	for _, raw := range []string{"PUBLIC", " private", "friends"} {
+		t.Run(raw, func(t *testing.T) {
+			_, err := vo.PostVisibilityFromString(raw)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
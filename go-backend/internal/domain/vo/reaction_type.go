@@ -0,0 +1,41 @@
+package vo
+
+import "errors"
+
+// ReactionType is one of the fixed kinds of reaction a user can leave on a post.
+type ReactionType string
+
+const (
+	ReactionTypeLike  ReactionType = "like"
+	ReactionTypeLove  ReactionType = "love"
+	ReactionTypeLaugh ReactionType = "laugh"
+	ReactionTypeWow   ReactionType = "wow"
+	ReactionTypeSad   ReactionType = "sad"
+	ReactionTypeAngry ReactionType = "angry"
+)
+
+var errInvalidReactionType = errors.New("invalid reaction type")
+
+// ReactionTypes returns every reaction type in display order.
+func ReactionTypes() []ReactionType {
+	return []ReactionType{
+		ReactionTypeLike, ReactionTypeLove, ReactionTypeLaugh, ReactionTypeWow, ReactionTypeSad, ReactionTypeAngry,
+	}
+}
+
+func (t ReactionType) String() string {
+	return string(t)
+}
+
+// ReactionTypeFromString parses raw, which must match one of the reaction types exactly.
+func ReactionTypeFromString(raw string) (ReactionType, error) {
+	for _, t := range ReactionTypes() {
+		if raw == string(t) {
+			return t, nil
+		}
+	}
+
+	return "", NewValidationError("invalid reaction type", map[string]any{
+		"type": raw,
+	}, errInvalidReactionType)
+}
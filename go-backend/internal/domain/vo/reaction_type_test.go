@@ -0,0 +1,32 @@
+package vo_test
+
+import (
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReactionTypeFromString(t *testing.T) {
+	for _, want := range vo.ReactionTypes() {
+		t.Run(want.String(), func(t *testing.T) {
+			got, err := vo.ReactionTypeFromString(want.String())
+
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestReactionTypeFromString_Failure(t *testing.T) {
+	for _, raw := range []string{"", "LIKE", " like", "dislike"} {
+		t.Run(raw, func(t *testing.T) {
+			_, err := vo.ReactionTypeFromString(raw)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
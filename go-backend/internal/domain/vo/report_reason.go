@@ -0,0 +1,43 @@
+package vo
+
+import "errors"
+
+// ReportReason is the category a user picks when reporting a post.
+type ReportReason string
+
+const (
+	ReportReasonSpam           ReportReason = "spam"
+	ReportReasonHarassment     ReportReason = "harassment"
+	ReportReasonHate           ReportReason = "hate"
+	ReportReasonViolence       ReportReason = "violence"
+	ReportReasonSexual         ReportReason = "sexual"
+	ReportReasonMisinformation ReportReason = "misinformation"
+	ReportReasonOther          ReportReason = "other"
+)
+
+var errInvalidReportReason = errors.New("invalid report reason")
+
+// ReportReasons returns every report reason in display order.
+func ReportReasons() []ReportReason {
+	return []ReportReason{
+		ReportReasonSpam, ReportReasonHarassment, ReportReasonHate, ReportReasonViolence, ReportReasonSexual,
+		ReportReasonMisinformation, ReportReasonOther,
+	}
+}
+
+func (r ReportReason) String() string {
+	return string(r)
+}
+
+// ReportReasonFromString parses raw, which must match one of the report reasons exactly.
+func ReportReasonFromString(raw string) (ReportReason, error) {
+	for _, r := range ReportReasons() {
+		if raw == string(r) {
+			return r, nil
+		}
+	}
+
+	return "", NewValidationError("invalid report reason", map[string]any{
+		"reason": raw,
+	}, errInvalidReportReason)
+}
@@ -0,0 +1,53 @@
+package vo_test
+
+import (
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportReasonFromString(t *testing.T) {
+	for _, want := range vo.ReportReasons() {
+		t.Run(want.String(), func(t *testing.T) {
+			got, err := vo.ReportReasonFromString(want.String())
+
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestReportReasonFromString_Failure(t *testing.T) {
+	for _, raw := range []string{"", "SPAM", " other", "rude"} {
+		t.Run(raw, func(t *testing.T) {
+			_, err := vo.ReportReasonFromString(raw)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
+
+func TestModerationActionTypeFromString(t *testing.T) {
+	for _, raw := range []string{"hide", "delete", "warn", "freeze", "dismiss"} {
+		t.Run(raw, func(t *testing.T) {
+			got, err := vo.ModerationActionTypeFromString(raw)
+
+			require.NoError(t, err)
+			assert.Equal(t, raw, got.String())
+		})
+	}
+
+	for _, raw := range []string{"", "HIDE", "ban"} {
+		t.Run("invalid "+raw, func(t *testing.T) {
+			_, err := vo.ModerationActionTypeFromString(raw)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package vo
+
+// RoleAssignmentAction identifies an event in the audit history of a user's role assignments.
+type RoleAssignmentAction string
+
+const (
+	// RoleAssignmentActionGranted records that a role was granted (or re-granted) to a user.
+	RoleAssignmentActionGranted RoleAssignmentAction = "GRANTED"
+	// RoleAssignmentActionExpired records that a time-bound grant passed its expiry and was removed.
+	RoleAssignmentActionExpired RoleAssignmentAction = "EXPIRED"
+)
+
+func (a RoleAssignmentAction) String() string {
+	return string(a)
+}
@@ -0,0 +1,107 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// maxSearchQueryLength is the maximum number of runes of a raw search query.
+	maxSearchQueryLength = 200
+	// maxSearchTerms bounds the work of a single search; every term becomes a condition on every post.
+	maxSearchTerms = 10
+)
+
+var errIllegalSearchQuery = errors.New("illegal search query")
+
+// SearchTerm is one condition of a SearchQuery: a single word, a quoted phrase of consecutive words,
+// or a word prefix (written with a trailing "*").
+type SearchTerm struct {
+	Words  []string
+	Prefix bool
+}
+
+// Text returns the term as it should appear in the searched text.
+func (t SearchTerm) Text() string {
+	return strings.Join(t.Words, " ")
+}
+
+// SearchQuery is a parsed full-text search query. A post matches when it matches every term.
+type SearchQuery struct {
+	raw   string
+	terms []SearchTerm
+}
+
+// NewSearchQuery parses raw into terms. Text in double quotes is a phrase (an unterminated quote runs
+// to the end), a word ending in "*" is a prefix, and everything else is split on whitespace.
+// Returns a ValidationError if raw has no terms, is longer than maxSearchQueryLength runes or has more
+// than maxSearchTerms terms.
+func NewSearchQuery(raw string) (*SearchQuery, error) {
+	trimmed := strings.TrimSpace(raw)
+
+	if utf8.RuneCountInString(trimmed) > maxSearchQueryLength {
+		return nil, NewValidationError(
+			fmt.Sprintf("search query must be at most %d characters long", maxSearchQueryLength),
+			map[string]any{"max_length": maxSearchQueryLength},
+			errIllegalSearchQuery,
+		)
+	}
+
+	terms := parseSearchTerms(trimmed)
+
+	if len(terms) == 0 {
+		return nil, NewValidationError("search query is required", nil, errIllegalSearchQuery)
+	}
+
+	if len(terms) > maxSearchTerms {
+		return nil, NewValidationError(
+			fmt.Sprintf("search query must have at most %d terms", maxSearchTerms),
+			map[string]any{"max_terms": maxSearchTerms},
+			errIllegalSearchQuery,
+		)
+	}
+
+	return &SearchQuery{raw: trimmed, terms: terms}, nil
+}
+
+func parseSearchTerms(raw string) []SearchTerm {
+	var terms []SearchTerm
+
+	for i, part := range strings.Split(raw, `"`) {
+		// Odd parts were enclosed in quotes.
+		if i%2 == 1 {
+			if words := strings.Fields(part); len(words) > 0 {
+				terms = append(terms, SearchTerm{Words: words})
+			}
+
+			continue
+		}
+
+		for word := range strings.FieldsSeq(part) {
+			stem := strings.TrimRight(word, "*")
+			if !containsLetterOrDigit(stem) {
+				continue
+			}
+
+			terms = append(terms, SearchTerm{Words: []string{stem}, Prefix: stem != word})
+		}
+	}
+
+	return terms
+}
+
+func containsLetterOrDigit(s string) bool {
+	return strings.IndexFunc(s, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) >= 0
+}
+
+// Terms returns the parsed terms in query order.
+func (q SearchQuery) Terms() []SearchTerm {
+	return q.terms
+}
+
+func (q SearchQuery) String() string {
+	return q.raw
+}
@@ -0,0 +1,83 @@
+package vo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSearchQuery_HappyCase(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []vo.SearchTerm
+	}{
+		{
+			name:  "words",
+			input: "  hello   world ",
+			want:  []vo.SearchTerm{{Words: []string{"hello"}}, {Words: []string{"world"}}},
+		},
+		{
+			name:  "phrase and prefix",
+			input: `"release notes" deploy*`,
+			want:  []vo.SearchTerm{{Words: []string{"release", "notes"}}, {Words: []string{"deploy"}, Prefix: true}},
+		},
+		{
+			name:  "unterminated quote runs to the end",
+			input: `go "clean architecture`,
+			want:  []vo.SearchTerm{{Words: []string{"go"}}, {Words: []string{"clean", "architecture"}}},
+		},
+		{
+			name:  "punctuation-only words and empty phrases are dropped",
+			input: `* - "" とうきょう`,
+			want:  []vo.SearchTerm{{Words: []string{"とうきょう"}}},
+		},
+		{
+			name:  "boundary: exactly 200 runes",
+			input: strings.Repeat("あ", 200),
+			want:  []vo.SearchTerm{{Words: []string{strings.Repeat("あ", 200)}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := vo.NewSearchQuery(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, q.Terms())
+			assert.Equal(t, strings.TrimSpace(tt.input), q.String())
+		})
+	}
+}
+
+func TestNewSearchQuery_FailureCase(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "empty", input: ""},
+		{name: "no words", input: ` "" * `},
+		{name: "boundary: 201 runes", input: strings.Repeat("あ", 201)},
+		{name: "too many terms", input: strings.Repeat("a ", 11)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := vo.NewSearchQuery(tt.input)
+
+			require.Error(t, err)
+			assert.Nil(t, q)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
+
+func TestSearchTerm_Text(t *testing.T) {
+	assert.Equal(t, "release notes", vo.SearchTerm{Words: []string{"release", "notes"}}.Text())
+}
@@ -0,0 +1,92 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+)
+
+// SignupMode controls who may create an account without an invitation.
+type SignupMode string
+
+const (
+	// SignupModeOpen lets anyone sign up.
+	SignupModeOpen SignupMode = "open"
+	// SignupModeInviteOnly requires every signup to present an invitation.
+	SignupModeInviteOnly SignupMode = "invite_only"
+	// SignupModeAllowedDomains lets addresses in the allowed domains sign up; others need an invitation.
+	SignupModeAllowedDomains SignupMode = "allowed_domains"
+)
+
+var (
+	errInvalidSignupMode = errors.New("invalid signup mode")
+	errNoAllowedDomains  = errors.New("allowed_domains signup mode requires at least one domain")
+)
+
+func (m SignupMode) String() string {
+	return string(m)
+}
+
+func SignupModeFromString(raw string) (SignupMode, error) {
+	switch strings.ToLower(raw) {
+	case string(SignupModeOpen):
+		return SignupModeOpen, nil
+	case string(SignupModeInviteOnly):
+		return SignupModeInviteOnly, nil
+	case string(SignupModeAllowedDomains):
+		return SignupModeAllowedDomains, nil
+	default:
+		return "", NewValidationError("invalid signup mode", map[string]any{
+			"mode": raw,
+		}, errInvalidSignupMode)
+	}
+}
+
+// SignupPolicy decides whether an uninvited email address may sign up. Invited signups are always admitted.
+type SignupPolicy struct {
+	mode           SignupMode
+	allowedDomains map[string]struct{}
+}
+
+// NewSignupPolicy builds a SignupPolicy. Domains are compared case-insensitively and may be given
+// with or without a leading "@"; they are ignored unless mode is SignupModeAllowedDomains.
+func NewSignupPolicy(mode SignupMode, allowedDomains []string) (SignupPolicy, error) {
+	domains := make(map[string]struct{}, len(allowedDomains))
+
+	for _, raw := range allowedDomains {
+		domain := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(raw), "@"))
+		if domain != "" {
+			domains[domain] = struct{}{}
+		}
+	}
+
+	if mode == SignupModeAllowedDomains && len(domains) == 0 {
+		return SignupPolicy{}, NewValidationError("at least one allowed domain is required", nil, errNoAllowedDomains)
+	}
+
+	return SignupPolicy{mode: mode, allowedDomains: domains}, nil
+}
+
+func (p SignupPolicy) Mode() SignupMode {
+	return p.mode
+}
+
+// AdmitsUninvited reports whether email may sign up without an invitation.
+func (p SignupPolicy) AdmitsUninvited(email Email) bool {
+	switch p.mode {
+	case SignupModeOpen:
+		return true
+	case SignupModeAllowedDomains:
+		_, domain, found := strings.Cut(email.String(), "@")
+		if !found {
+			return false
+		}
+
+		_, ok := p.allowedDomains[strings.ToLower(domain)]
+
+		return ok
+	case SignupModeInviteOnly:
+		return false
+	default:
+		return false
+	}
+}
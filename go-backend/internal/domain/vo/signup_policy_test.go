@@ -0,0 +1,112 @@
+package vo_test
+
+import (
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignupModeFromString(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    vo.SignupMode
+		wantErr bool
+	}{
+		{name: "open", input: "open", want: vo.SignupModeOpen},
+		{name: "invite only, case-insensitive", input: "INVITE_ONLY", want: vo.SignupModeInviteOnly},
+		{name: "allowed domains", input: "allowed_domains", want: vo.SignupModeAllowedDomains},
+		{name: "unknown mode", input: "closed", wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mode, err := vo.SignupModeFromString(tt.input)
+
+			if tt.wantErr {
+				var voErr vo.Error
+				require.ErrorAs(t, err, &voErr)
+				assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, mode)
+		})
+	}
+}
+
+func TestSignupPolicy_AdmitsUninvited(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    vo.SignupMode
+		domains []string
+		email   vo.Email
+		want    bool
+	}{
+		{name: "open admits anyone", mode: vo.SignupModeOpen, email: "a@example.com", want: true},
+		{name: "invite only admits nobody", mode: vo.SignupModeInviteOnly, email: "a@example.com", want: false},
+		{
+			name:    "allowed domain",
+			mode:    vo.SignupModeAllowedDomains,
+			domains: []string{"example.com", "corp.example"},
+			email:   "a@corp.example",
+			want:    true,
+		},
+		{
+			name:    "domains are normalised",
+			mode:    vo.SignupModeAllowedDomains,
+			domains: []string{" @Example.COM "},
+			email:   "a@example.com",
+			want:    true,
+		},
+		{
+			name:    "subdomains are not implied",
+			mode:    vo.SignupModeAllowedDomains,
+			domains: []string{"example.com"},
+			email:   "a@mail.example.com",
+			want:    false,
+		},
+		{
+			name:    "other domain",
+			mode:    vo.SignupModeAllowedDomains,
+			domains: []string{"example.com"},
+			email:   "a@other.com",
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := vo.NewSignupPolicy(tt.mode, tt.domains)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.mode, policy.Mode())
+			assert.Equal(t, tt.want, policy.AdmitsUninvited(tt.email))
+		})
+	}
+}
+
+func TestNewSignupPolicy_FailureCase(t *testing.T) {
+	tests := []struct {
+		name    string
+		domains []string
+	}{
+		{name: "no domains", domains: nil},
+		{name: "blank domains only", domains: []string{" ", "@"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := vo.NewSignupPolicy(vo.SignupModeAllowedDomains, tt.domains)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
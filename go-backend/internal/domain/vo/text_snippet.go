@@ -0,0 +1,155 @@
+package vo
+
+import (
+	"slices"
+	"unicode"
+)
+
+const (
+	// snippetLength is the number of runes of text a Snippet shows, not counting ellipses.
+	snippetLength = 160
+	// snippetLeadingContext is how many runes a Snippet shows before the first match.
+	snippetLeadingContext = 40
+	snippetEllipsis       = "…"
+)
+
+// TextSegment is a piece of a snippet. Highlighted segments are occurrences of a search term.
+type TextSegment struct {
+	Text        string
+	Highlighted bool
+}
+
+// match is a half-open rune range [start, end).
+type match struct {
+	start, end int
+}
+
+// Snippet returns an excerpt of text starting shortly before the first occurrence of any term, split
+// into segments so that every occurrence inside the excerpt is highlighted. Terms match
+// case-insensitively anywhere in the text, which also works for scripts written without spaces; the
+// words of a phrase are highlighted on their own where the phrase does not occur verbatim. Cut-off ends
+// are marked with an ellipsis. Text without any occurrence yields its beginning.
+func Snippet(text string, terms []SearchTerm) []TextSegment {
+	runes := []rune(text)
+	matches := findMatches(runes, snippetNeedles(terms))
+	start, end := snippetWindow(len(runes), matches)
+
+	var segments segmentList
+
+	if start > 0 {
+		segments.add(snippetEllipsis, false)
+	}
+
+	pos := start
+
+	for _, m := range matches {
+		if m.end <= start {
+			continue
+		}
+
+		if m.start >= end {
+			break
+		}
+
+		segments.add(string(runes[pos:max(pos, m.start)]), false)
+		segments.add(string(runes[max(pos, m.start):min(end, m.end)]), true)
+		pos = min(end, m.end)
+	}
+
+	segments.add(string(runes[pos:end]), false)
+
+	if end < len(runes) {
+		segments.add(snippetEllipsis, false)
+	}
+
+	if segments == nil {
+		return []TextSegment{}
+	}
+
+	return segments
+}
+
+// snippetWindow returns the rune range shown for a text of n runes.
+func snippetWindow(n int, matches []match) (int, int) {
+	first := 0
+	if len(matches) > 0 {
+		first = matches[0].start
+	}
+
+	end := min(n, max(0, first-snippetLeadingContext)+snippetLength)
+
+	return max(0, end-snippetLength), end
+}
+
+// segmentList merges adjacent segments of the same kind and skips empty ones.
+type segmentList []TextSegment
+
+func (l *segmentList) add(text string, highlighted bool) {
+	if text == "" {
+		return
+	}
+
+	if n := len(*l); n > 0 && (*l)[n-1].Highlighted == highlighted {
+		(*l)[n-1].Text += text
+
+		return
+	}
+
+	*l = append(*l, TextSegment{Text: text, Highlighted: highlighted})
+}
+
+// snippetNeedles returns the case-folded texts to look for: every term, and the words of every phrase.
+func snippetNeedles(terms []SearchTerm) [][]rune {
+	needles := make([][]rune, 0, len(terms))
+
+	for _, term := range terms {
+		needles = append(needles, foldRunes([]rune(term.Text())))
+
+		if len(term.Words) > 1 {
+			for _, word := range term.Words {
+				needles = append(needles, foldRunes([]rune(word)))
+			}
+		}
+	}
+
+	return needles
+}
+
+// findMatches returns the non-overlapping occurrences of needles in text from left to right, preferring
+// the longest needle at each position.
+func findMatches(text []rune, needles [][]rune) []match {
+	folded := foldRunes(text)
+
+	var matches []match
+
+	for i := 0; i < len(folded); {
+		longest := 0
+
+		for _, needle := range needles {
+			if len(needle) > longest && len(needle) <= len(folded)-i && slices.Equal(folded[i:i+len(needle)], needle) {
+				longest = len(needle)
+			}
+		}
+
+		if longest == 0 {
+			i++
+
+			continue
+		}
+
+		matches = append(matches, match{start: i, end: i + longest})
+		i += longest
+	}
+
+	return matches
+}
+
+// foldRunes lower-cases rune by rune, so that indexes into the result are indexes into the input.
+func foldRunes(runes []rune) []rune {
+	folded := make([]rune, len(runes))
+	for i, r := range runes {
+		folded[i] = unicode.ToLower(r)
+	}
+
+	return folded
+}
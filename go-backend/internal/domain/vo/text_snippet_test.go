@@ -0,0 +1,71 @@
+package vo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnippet(t *testing.T) {
+	word := func(w string) vo.SearchTerm { return vo.SearchTerm{Words: []string{w}} }
+	plain := func(s string) vo.TextSegment { return vo.TextSegment{Text: s} }
+	mark := func(s string) vo.TextSegment { return vo.TextSegment{Text: s, Highlighted: true} }
+
+	long := strings.Repeat("x", 100) + " needle " + strings.Repeat("y", 200)
+
+	tests := []struct {
+		name  string
+		text  string
+		terms []vo.SearchTerm
+		want  []vo.TextSegment
+	}{
+		{
+			name:  "every occurrence is highlighted case-insensitively",
+			text:  "Go is fun; go on",
+			terms: []vo.SearchTerm{word("go")},
+			want:  []vo.TextSegment{mark("Go"), plain(" is fun; "), mark("go"), plain(" on")},
+		},
+		{
+			name:  "text without spaces",
+			text:  "きょうはとうきょうでかいぎ",
+			terms: []vo.SearchTerm{word("とうきょう")},
+			want:  []vo.TextSegment{plain("きょうは"), mark("とうきょう"), plain("でかいぎ")},
+		},
+		{
+			name:  "a phrase is highlighted whole, or word by word where it does not occur verbatim",
+			text:  "release notes; notes, release",
+			terms: []vo.SearchTerm{{Words: []string{"release", "notes"}}},
+			want:  []vo.TextSegment{mark("release notes"), plain("; "), mark("notes"), plain(", "), mark("release")},
+		},
+		{
+			name:  "long text is cut around the first occurrence",
+			text:  long,
+			terms: []vo.SearchTerm{word("needle")},
+			want: []vo.TextSegment{
+				plain("…" + strings.Repeat("x", 39) + " "),
+				mark("needle"),
+				plain(" " + strings.Repeat("y", 113) + "…"),
+			},
+		},
+		{
+			name:  "without occurrences the beginning is shown",
+			text:  strings.Repeat("z", 200),
+			terms: []vo.SearchTerm{word("needle")},
+			want:  []vo.TextSegment{plain(strings.Repeat("z", 160) + "…")},
+		},
+		{
+			name:  "empty text",
+			text:  "",
+			terms: []vo.SearchTerm{word("needle")},
+			want:  []vo.TextSegment{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, vo.Snippet(tt.text, tt.terms))
+		})
+	}
+}
@@ -18,6 +18,7 @@ var (
 
 type DbManager interface {
 	QueriesFunc(ctx context.Context, fn func(ctx context.Context, queries sqlc.Queries) error) error
+	TxFunc(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error
 	PoolFunc(ctx context.Context, fn func(ctx context.Context, conn *pgxpool.Conn) error) error
 }
 
@@ -25,6 +26,9 @@ type dbManagerImpl struct {
 	pool *pgxpool.Pool
 }
 
+// QueriesFunc runs fn with queries bound to the transaction in ctx, or to a pooled connection
+// when there is none. The tenant and user in ctx are applied to the row-level security policies
+// first; outside a transaction that requires a short transaction of its own.
 func (m *dbManagerImpl) QueriesFunc(
 	ctx context.Context,
 	fn func(ctx context.Context, queries sqlc.Queries) error) error {
@@ -36,9 +40,19 @@ func (m *dbManagerImpl) QueriesFunc(
 		}
 		defer conn.Release()
 
-		queries := sqlc.New(conn)
+		if !hasSessionScope(ctx) {
+			queries := sqlc.New(conn)
 
-		return fn(ctx, *queries)
+			return fn(ctx, *queries)
+		}
+
+		return pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+			if err := applySessionScope(ctx, tx); err != nil {
+				return err
+			}
+
+			return fn(ctx, *sqlc.New(tx))
+		})
 	}
 
 	tx, ok := val.(pgx.Tx)
@@ -46,11 +60,49 @@ func (m *dbManagerImpl) QueriesFunc(
 		return ErrIllegalTx
 	}
 
+	// Always re-apply: an earlier call in the same transaction may have run with another scope.
+	if err := applySessionScope(ctx, tx); err != nil {
+		return err
+	}
+
 	queries := sqlc.New(tx)
 
 	return fn(ctx, *queries)
 }
 
+// TxFunc runs fn with the transaction in ctx, or with a short transaction of its own when there is none,
+// with the tenant and user in ctx applied to the row-level security policies. It is meant for statements
+// sqlc cannot express, such as COPY into a temporary table.
+func (m *dbManagerImpl) TxFunc(ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) error {
+	val := ctx.Value(txKey)
+	if val == nil {
+		conn, err := m.pool.Acquire(ctx)
+		if err != nil {
+			return err
+		}
+		defer conn.Release()
+
+		return pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+			if err := applySessionScope(ctx, tx); err != nil {
+				return err
+			}
+
+			return fn(ctx, tx)
+		})
+	}
+
+	tx, ok := val.(pgx.Tx)
+	if !ok {
+		return ErrIllegalTx
+	}
+
+	if err := applySessionScope(ctx, tx); err != nil {
+		return err
+	}
+
+	return fn(ctx, tx)
+}
+
 func (m *dbManagerImpl) PoolFunc(ctx context.Context, fn func(ctx context.Context, conn *pgxpool.Conn) error) error {
 	conn, err := m.pool.Acquire(ctx)
 	if err != nil {
@@ -12,7 +12,8 @@ import (
 // BuildDSN returns the database DSN to use for connecting.
 // In CI/production: DATABASE_DSN is set directly and used as-is.
 // In local development: DB_PORT (and optionally DB_HOST, DB_USER, DB_PASSWORD, DB_NAME)
-// override individual components. Defaults: host=localhost, port=55432, user/pass=postgres, db=backend.
+// override individual components. Defaults: host=localhost, port=55432, user/pass=app, db=backend;
+// app is the role from db/roles/app.sql, which row-level security applies to.
 func BuildDSN() string {
 	if dsn := os.Getenv("DATABASE_DSN"); dsn != "" {
 		return dsn
@@ -20,8 +21,8 @@ func BuildDSN() string {
 
 	host := envOr("DB_HOST", "localhost")
 	port := envOr("DB_PORT", "55432")
-	user := envOr("DB_USER", "postgres")
-	pass := envOr("DB_PASSWORD", "postgres")
+	user := envOr("DB_USER", "app")
+	pass := envOr("DB_PASSWORD", "app")
 	name := envOr("DB_NAME", "backend")
 
 	return fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", user, pass, net.JoinHostPort(host, port), name)
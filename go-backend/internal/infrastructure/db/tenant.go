@@ -0,0 +1,46 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var ErrNoActiveTenant = errors.New("no active tenant in context")
+
+// setSessionScopeSQL publishes the tenant and user of the request to the row-level security
+// policies in db/schema/schema.sql. is_local=true keeps the settings inside the current
+// transaction so they never leak to the next user of a pooled connection.
+const setSessionScopeSQL = "select set_config('app.tenant_id', $1, true), set_config('app.user_id', $2, true)"
+
+// TenantID returns the organization the request acts in, as stored by common.WithTenantID.
+// Repositories and query services use it to scope every statement; callers cannot pass a tenant
+// of their choosing.
+func TenantID(ctx context.Context) (pgtype.UUID, error) {
+	raw := common.TenantIDFromContext(ctx)
+	if raw == "" {
+		return pgtype.UUID{}, ErrNoActiveTenant
+	}
+
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return pgtype.UUID{}, fmt.Errorf("%w: %w", ErrNoActiveTenant, err)
+	}
+
+	return pgtype.UUID{Bytes: id, Valid: true}, nil
+}
+
+func hasSessionScope(ctx context.Context) bool {
+	return common.TenantIDFromContext(ctx) != "" || common.UserIDFromContext(ctx) != ""
+}
+
+func applySessionScope(ctx context.Context, tx pgx.Tx) error {
+	_, err := tx.Exec(ctx, setSessionScopeSQL, common.TenantIDFromContext(ctx), common.UserIDFromContext(ctx))
+
+	return err
+}
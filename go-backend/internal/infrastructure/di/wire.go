@@ -12,6 +12,9 @@ import (
 	infraquery "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/query"
 	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
 	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/service"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/worker"
+	commandadmin "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/admin"
+	commandorganization "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/organization"
 	commandpost "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
 	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
 	querypost "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
@@ -22,24 +25,132 @@ import (
 var repositorySet = wire.NewSet(
 	repository.NewUserRepository,
 	repository.NewPostRepository,
+	repository.NewPostRevisionRepository,
+	repository.NewCommentRepository,
+	repository.NewReactionRepository,
+	repository.NewFollowRepository,
+	repository.NewBlockRepository,
+	repository.NewMuteRepository,
+	repository.NewUserRelationshipRepository,
+	repository.NewTimelineRepository,
+	repository.NewPostEntityRepository,
+	repository.NewRoleAssignmentRepository,
+	repository.NewImpersonationAuditLogRepository,
+	repository.NewOrganizationRepository,
+	repository.NewInvitationRepository,
+	repository.NewAttachmentRepository,
+	repository.NewPostReportRepository,
+	repository.NewPollRepository,
+	repository.NewPinnedPostRepository,
+	repository.NewUserProfileRepository,
+	repository.NewModerationActionRepository,
+	repository.NewBookmarkRepository,
+	repository.NewCollectionRepository,
 )
 
 var authSet = wire.NewSet(
 	service.NewJwtService,
+	service.NewSignupPolicy,
+	service.NewCursorCodec,
+)
+
+var blobStorageSet = wire.NewSet(
+	service.NewBlobStorage,
+	service.NewImageThumbnailer,
+)
+
+var contentFilterSet = wire.NewSet(
+	service.NewContentFilter,
+	service.NewContentRenderer,
 )
 
 var usecaseSet = wire.NewSet(
 	user.NewSignupUseCase,
 	user.NewLoginUseCase,
+	user.NewGrantRoleUseCase,
+	commandadmin.NewImpersonateUseCase,
+	commandadmin.NewRecordImpersonatedRequestUseCase,
+	commandorganization.NewCreateOrganizationUseCase,
+	commandorganization.NewAddMemberUseCase,
+	commandadmin.NewCreateInvitationUseCase,
+	commandadmin.NewImportPostsUseCase,
+	user.NewSweepExpiredRoleAssignmentsUseCase,
 	commandpost.NewCreatePostUseCase,
+	commandpost.NewUpdatePostUseCase,
+	commandpost.NewDeletePostUseCase,
+	commandpost.NewPublishPostUseCase,
+	commandpost.NewPublishDuePostsUseCase,
+	commandpost.NewCreateCommentUseCase,
+	commandpost.NewDeleteCommentUseCase,
+	commandpost.NewAddReactionUseCase,
+	commandpost.NewRemoveReactionUseCase,
+	commandpost.NewReportPostUseCase,
+	commandpost.NewModeratePostUseCase,
+	commandpost.NewUploadAttachmentUseCase,
+	commandpost.NewSweepOrphanedAttachmentsUseCase,
+	commandpost.NewPurgeTrashedPostsUseCase,
+	commandpost.NewRestorePostUseCase,
+	commandpost.NewAddBookmarkUseCase,
+	commandpost.NewRemoveBookmarkUseCase,
+	commandpost.NewRepostUseCase,
+	commandpost.NewRemoveRepostUseCase,
+	commandpost.NewVotePollUseCase,
+	commandpost.NewPinPostUseCase,
+	commandpost.NewUnpinPostUseCase,
+	commandpost.NewCreateCollectionUseCase,
+	commandpost.NewRenameCollectionUseCase,
+	commandpost.NewDeleteCollectionUseCase,
+	commandpost.NewAddCollectionPostUseCase,
+	commandpost.NewRemoveCollectionPostUseCase,
+	user.NewFollowUserUseCase,
+	user.NewUpdateProfileUseCase,
+	user.NewUnfollowUserUseCase,
+	user.NewBlockUserUseCase,
+	user.NewUnblockUserUseCase,
+	user.NewMuteUserUseCase,
+	user.NewUnmuteUserUseCase,
 )
 
 var querySet = wire.NewSet(
 	infraquery.NewUserQueryService,
 	infraquery.NewPostQueryService,
+	infraquery.NewPostSearchService,
+	infraquery.NewCommentQueryService,
+	infraquery.NewReactionQueryService,
+	infraquery.NewFollowQueryService,
+	infraquery.NewRelationshipQueryService,
+	infraquery.NewRoleAssignmentQueryService,
+	infraquery.NewInvitationQueryService,
+	infraquery.NewAttachmentQueryService,
+	infraquery.NewModerationQueryService,
+	infraquery.NewTrashQueryService,
+	infraquery.NewSavedPostQueryService,
+	infraquery.NewProfileQueryService,
+	infraquery.NewCollectionQueryService,
+	infraquery.NewPostExportQueryService,
 	repository.NewUserPermissionRepository,
 	queryuser.NewListUsersUseCase,
+	queryuser.NewListRoleAssignmentsUseCase,
+	queryuser.NewGetInvitationUseCase,
 	querypost.NewListPostsUseCase,
+	querypost.NewListUserPostsUseCase,
+	querypost.NewListHashtagPostsUseCase,
+	querypost.NewSearchPostsUseCase,
+	querypost.NewGetPostUseCase,
+	querypost.NewListPostRevisionsUseCase,
+	querypost.NewDiffPostRevisionsUseCase,
+	querypost.NewListCommentsUseCase,
+	querypost.NewListTimelineUseCase,
+	querypost.NewGetAttachmentContentUseCase,
+	querypost.NewListModerationQueueUseCase,
+	querypost.NewListTrashUseCase,
+	querypost.NewListBookmarksUseCase,
+	querypost.NewListCollectionsUseCase,
+	querypost.NewExportPostsUseCase,
+	querypost.NewListCollectionPostsUseCase,
+	queryuser.NewListFollowsUseCase,
+	queryuser.NewListRelationshipsUseCase,
+	queryuser.NewGetUserProfileUseCase,
 )
 
 var dbSet = wire.NewSet(
@@ -51,11 +162,16 @@ var dbSet = wire.NewSet(
 
 var httpSet = wire.NewSet(
 	http.NewRouter,
+	wire.Struct(new(http.UseCases), "*"),
 	http.NewEchoConfig,
 	http.NewServer,
 	wire.Struct(new(http.Server), "*"),
 )
 
+var workerSet = wire.NewSet(
+	worker.NewWorker,
+)
+
 var connectRPCSet = wire.NewSet(
 	crpchandler.NewHealthHandler,
 	connectrpc.NewServer,
@@ -66,6 +182,8 @@ func InitializeServer(ctx context.Context) (*http.Server, error) {
 	wire.Build(
 		repositorySet,
 		authSet,
+		blobStorageSet,
+		contentFilterSet,
 		usecaseSet,
 		querySet,
 		dbSet,
@@ -82,3 +200,16 @@ func InitializeGRPCServer(ctx context.Context) (*connectrpc.Server, error) {
 
 	return nil, nil
 }
+
+// InitializeWorker initialises the background job worker.
+func InitializeWorker(ctx context.Context) (*worker.Worker, error) {
+	wire.Build(
+		repositorySet,
+		blobStorageSet,
+		usecaseSet,
+		dbSet,
+		workerSet,
+	)
+
+	return nil, nil
+}
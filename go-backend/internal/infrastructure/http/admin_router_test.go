@@ -0,0 +1,160 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpersonate_HappyCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	adminToken, adminID := signupAndGetToken(t, "admin@example.com", adminRoleID)
+	_, subjectID := signupAndGetToken(t, "subject@example.com", "")
+	joinOrganization(t, organizationOf(t, adminID), subjectID, viewerRoleID)
+
+	resp, err := newTestClient().PostV1AdminImpersonateUserIdWithResponse(ctx, uuid.MustParse(subjectID),
+		withBearerToken(adminToken),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+	require.NotNil(t, resp.JSON200)
+	assert.Equal(t, subjectID, resp.JSON200.SubjectId.String())
+	assert.Equal(t, adminID, resp.JSON200.ActorId.String())
+	assert.NotEmpty(t, resp.JSON200.Token)
+
+	// The impersonation token acts as the subject for ordinary requests.
+	postResp, err := newTestClient().PostV1PostsWithResponse(ctx,
+		clientgen.CreatePostRequest{Content: "written while impersonating"},
+		withBearerToken(resp.JSON200.Token),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, postResp.StatusCode())
+	require.NotNil(t, postResp.JSON201)
+	assert.Equal(t, subjectID, postResp.JSON201.UserId.String())
+
+	rows, err := testDb.Pool().Query(ctx,
+		`SELECT action, method, path FROM impersonation_audit_logs
+		 WHERE actor_id = $1 AND subject_id = $2 ORDER BY created_at, id`,
+		adminID, subjectID,
+	)
+	require.NoError(t, err)
+
+	defer rows.Close()
+
+	type auditRow struct{ action, method, path string }
+
+	var got []auditRow
+
+	for rows.Next() {
+		var r auditRow
+		require.NoError(t, rows.Scan(&r.action, &r.method, &r.path))
+
+		got = append(got, r)
+	}
+
+	require.NoError(t, rows.Err())
+	assert.Equal(t, []auditRow{
+		{action: "STARTED", method: http.MethodPost, path: "/v1/admin/impersonate/" + subjectID},
+		{action: "REQUEST", method: http.MethodPost, path: "/v1/posts"},
+	}, got)
+}
+
+func TestImpersonate_FailureCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	adminToken, adminID := signupAndGetToken(t, "admin@example.com", adminRoleID)
+	viewerToken, _ := signupAndGetToken(t, "viewer@example.com", viewerRoleID)
+	_, subjectID := signupAndGetToken(t, "subject@example.com", "")
+	_, outsiderID := signupAndGetToken(t, "outsider@example.com", "")
+	joinOrganization(t, organizationOf(t, adminID), subjectID, viewerRoleID)
+
+	tests := []struct {
+		name         string
+		token        string
+		targetID     string
+		responseCode int
+	}{
+		{
+			name:         "lacks users:impersonate",
+			token:        viewerToken,
+			targetID:     subjectID,
+			responseCode: http.StatusForbidden,
+		},
+		{
+			name:         "impersonating oneself",
+			token:        adminToken,
+			targetID:     adminID,
+			responseCode: http.StatusBadRequest,
+		},
+		{
+			name:         "unknown subject",
+			token:        adminToken,
+			targetID:     uuid.NewString(),
+			responseCode: http.StatusBadRequest,
+		},
+		{
+			name:         "subject outside the active organization",
+			token:        adminToken,
+			targetID:     outsiderID,
+			responseCode: http.StatusBadRequest,
+		},
+		{
+			name:         "no token",
+			token:        "",
+			targetID:     subjectID,
+			responseCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := newTestClient().PostV1AdminImpersonateUserIdWithResponse(ctx, uuid.MustParse(tt.targetID),
+				withBearerToken(tt.token),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tt.responseCode, resp.StatusCode())
+		})
+	}
+}
+
+func TestImpersonate_SensitiveActionsRejected(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	adminToken, adminID := signupAndGetToken(t, "admin@example.com", adminRoleID)
+	_, subjectID := signupAndGetToken(t, "subject@example.com", "")
+	_, otherID := signupAndGetToken(t, "other@example.com", "")
+	joinOrganization(t, organizationOf(t, adminID), subjectID, viewerRoleID)
+
+	resp, err := newTestClient().PostV1AdminImpersonateUserIdWithResponse(ctx, uuid.MustParse(subjectID),
+		withBearerToken(adminToken),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+	require.NotNil(t, resp.JSON200)
+
+	impersonationToken := resp.JSON200.Token
+
+	grantResp, err := newTestClient().PostV1UsersUserIdRolesWithResponse(ctx, uuid.MustParse(otherID),
+		clientgen.GrantRoleRequest{RoleId: uuid.MustParse(viewerRoleID), Reason: "while impersonating"},
+		withBearerToken(impersonationToken),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, grantResp.StatusCode())
+
+	nestedResp, err := newTestClient().PostV1AdminImpersonateUserIdWithResponse(ctx, uuid.MustParse(otherID),
+		withBearerToken(impersonationToken),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, nestedResp.StatusCode())
+}
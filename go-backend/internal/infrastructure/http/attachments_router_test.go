@@ -0,0 +1,181 @@
+//go:build integration
+
+package http_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"testing"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// uploadAttachment posts content as the file part of a multipart upload.
+func uploadAttachment(t *testing.T, token, fileName string, content []byte) *clientgen.PostV1AttachmentsResponse {
+	t.Helper()
+
+	var body bytes.Buffer
+
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", fileName)
+	require.NoError(t, err)
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, form.Close())
+
+	resp, err := newTestClient().PostV1AttachmentsWithBodyWithResponse(context.Background(),
+		form.FormDataContentType(), &body, withBearerToken(token))
+	require.NoError(t, err)
+
+	return resp
+}
+
+func testPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+
+	return buf.Bytes()
+}
+
+func TestAttachments(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+
+	ownerToken, ownerID := signupAndGetToken(t, "owner@example.com", "")
+	_, memberID := signupAndGetToken(t, "member@example.com", "")
+	outsiderToken, _ := signupAndGetToken(t, "outsider@example.com", "")
+
+	orgID := organizationOf(t, ownerID)
+	joinOrganization(t, orgID, memberID, viewerRoleID)
+	memberToken := loginToOrganization(t, "member@example.com", orgID)
+
+	photo := testPNG(t, 800, 400)
+
+	uploaded := uploadAttachment(t, ownerToken, "photo.png", photo)
+	require.Equal(t, http.StatusCreated, uploaded.StatusCode())
+	picture := *uploaded.JSON201
+
+	uploaded = uploadAttachment(t, ownerToken, "notes.txt", []byte("meeting notes"))
+	require.Equal(t, http.StatusCreated, uploaded.StatusCode())
+	notes := *uploaded.JSON201
+
+	download := func(t *testing.T, token string, id uuid.UUID) (int, string, []byte) {
+		t.Helper()
+
+		resp, err := c.GetV1AttachmentsAttachmentIdWithResponse(ctx, id, withBearerToken(token))
+		require.NoError(t, err)
+
+		return resp.StatusCode(), resp.HTTPResponse.Header.Get("Content-Type"), resp.Body
+	}
+
+	t.Run("uploads are described by their content", func(t *testing.T) {
+		assert.Equal(t, "photo.png", picture.FileName)
+		assert.Equal(t, "image/png", picture.ContentType)
+		assert.Equal(t, int64(len(photo)), picture.Size)
+		assert.True(t, picture.HasThumbnail)
+		require.NotNil(t, picture.Width)
+		assert.Equal(t, 800, *picture.Width)
+		assert.Equal(t, 400, *picture.Height)
+
+		assert.Equal(t, "text/plain", notes.ContentType)
+		assert.False(t, notes.HasThumbnail)
+		assert.Nil(t, notes.Width)
+	})
+
+	t.Run("unattached uploads are only visible to the uploader", func(t *testing.T) {
+		status, contentType, body := download(t, ownerToken, picture.Id)
+		require.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "image/png", contentType)
+		assert.Equal(t, photo, body)
+
+		status, _, _ = download(t, memberToken, picture.Id)
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+
+	t.Run("posts embed their attachments in order", func(t *testing.T) {
+		created, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{
+			Content:       "with files",
+			AttachmentIds: &[]uuid.UUID{notes.Id, picture.Id},
+		}, withBearerToken(ownerToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, created.StatusCode())
+		require.NotNil(t, created.JSON201.Attachments)
+		assert.Equal(t, []clientgen.AttachmentResponse{notes, picture}, *created.JSON201.Attachments)
+
+		got, err := c.GetV1PostsPostIdWithResponse(ctx, created.JSON201.Id, withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, got.StatusCode())
+		require.NotNil(t, got.JSON200.Attachments)
+		assert.Equal(t, []clientgen.AttachmentResponse{notes, picture}, *got.JSON200.Attachments)
+
+		// An upload can only be attached once.
+		again, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{
+			Content:       "again",
+			AttachmentIds: &[]uuid.UUID{picture.Id},
+		}, withBearerToken(ownerToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, again.StatusCode())
+	})
+
+	t.Run("attached files are visible to the organization", func(t *testing.T) {
+		status, _, body := download(t, memberToken, notes.Id)
+		require.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "meeting notes", string(body))
+
+		thumbnail, err := c.GetV1AttachmentsAttachmentIdThumbnailWithResponse(ctx, picture.Id,
+			withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, thumbnail.StatusCode())
+		assert.Equal(t, "image/png", thumbnail.HTTPResponse.Header.Get("Content-Type"))
+
+		decoded, err := png.Decode(bytes.NewReader(thumbnail.Body))
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 320, 160), decoded.Bounds())
+
+		// Other files are offered for download rather than displayed.
+		resp, err := c.GetV1AttachmentsAttachmentId(ctx, notes.Id, withBearerToken(memberToken))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		_, _ = io.Copy(io.Discard, resp.Body)
+		assert.Equal(t, `attachment; filename=notes.txt`, resp.Header.Get("Content-Disposition"))
+
+		// Files of another organization do not exist for the caller.
+		status, _, _ = download(t, outsiderToken, notes.Id)
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+
+	t.Run("files without thumbnail", func(t *testing.T) {
+		resp, err := c.GetV1AttachmentsAttachmentIdThumbnailWithResponse(ctx, notes.Id, withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+
+	t.Run("invalid uploads are rejected", func(t *testing.T) {
+		for name, content := range map[string][]byte{
+			"archive.zip": []byte("PK\x03\x04\x14\x00"),
+			"empty.txt":   {},
+			"broken.png":  photo[:64],
+			"huge.txt":    bytes.Repeat([]byte("a"), 10<<20+1),
+		} {
+			assert.Equal(t, http.StatusBadRequest, uploadAttachment(t, ownerToken, name, content).StatusCode(), name)
+		}
+	})
+
+	t.Run("missing token returns 401", func(t *testing.T) {
+		resp, err := c.GetV1AttachmentsAttachmentIdWithResponse(ctx, notes.Id)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	})
+}
@@ -0,0 +1,177 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlocksAndMutes(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+
+	ownerToken, ownerID := signupAndGetToken(t, "owner@example.com", "")
+	_, memberID := signupAndGetToken(t, "member@example.com", "")
+	_, outsiderID := signupAndGetToken(t, "outsider@example.com", "")
+
+	orgID := organizationOf(t, ownerID)
+	joinOrganization(t, orgID, memberID, viewerRoleID)
+	memberToken := loginToOrganization(t, "member@example.com", orgID)
+
+	created, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{Content: "owner post"},
+		withBearerToken(ownerToken))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, created.StatusCode())
+
+	postID := created.JSON201.Id
+
+	feedOf := func(t *testing.T, token string) []string {
+		t.Helper()
+
+		resp, err := c.GetV1PostsWithResponse(ctx, nil, withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+
+		contents := make([]string, len(resp.JSON200.Posts))
+		for i, p := range resp.JSON200.Posts {
+			contents[i] = p.Content
+		}
+
+		return contents
+	}
+
+	detailStatus := func(t *testing.T, token string) int {
+		t.Helper()
+
+		resp, err := c.GetV1PostsPostIdWithResponse(ctx, postID, withBearerToken(token))
+		require.NoError(t, err)
+
+		return resp.StatusCode()
+	}
+
+	t.Run("muting hides the user's posts from feeds only", func(t *testing.T) {
+		resp, err := c.PutV1UsersUserIdMuteWithResponse(ctx, uuid.MustParse(ownerID), withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, resp.StatusCode())
+
+		assert.Empty(t, feedOf(t, memberToken))
+		assert.Equal(t, http.StatusOK, detailStatus(t, memberToken))
+		// A mute is one-way: the muted user still sees the muter's feed.
+		assert.Equal(t, []string{"owner post"}, feedOf(t, ownerToken))
+
+		mutes, err := c.GetV1MutesWithResponse(ctx, nil, withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, mutes.StatusCode())
+		require.Len(t, mutes.JSON200.Users, 1)
+		assert.Equal(t, uuid.MustParse(ownerID), mutes.JSON200.Users[0].Id)
+
+		unmuted, err := c.DeleteV1UsersUserIdMuteWithResponse(ctx, uuid.MustParse(ownerID), withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, unmuted.StatusCode())
+		assert.Equal(t, []string{"owner post"}, feedOf(t, memberToken))
+	})
+
+	t.Run("blocking hides both users from each other and stops interactions", func(t *testing.T) {
+		followed, err := c.PutV1UsersUserIdFollowWithResponse(ctx, uuid.MustParse(ownerID), withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, followed.StatusCode())
+
+		for range 2 {
+			resp, err := c.PutV1UsersUserIdBlockWithResponse(ctx, uuid.MustParse(memberID), withBearerToken(ownerToken))
+			require.NoError(t, err)
+			require.Equal(t, http.StatusNoContent, resp.StatusCode())
+		}
+
+		// The blocked member sees nothing of the owner, whose block they cannot see either.
+		assert.Empty(t, feedOf(t, memberToken))
+		assert.Equal(t, http.StatusNotFound, detailStatus(t, memberToken))
+
+		reacted, err := c.PutV1PostsPostIdReactionsTypeWithResponse(ctx, postID, clientgen.Like,
+			withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, reacted.StatusCode())
+
+		commented, err := c.PostV1PostsPostIdCommentsWithResponse(ctx, postID,
+			clientgen.CreateCommentRequest{Content: "hi"}, withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, commented.StatusCode())
+
+		// The block ended the follow and keeps the member from following again.
+		followers, err := c.GetV1UsersUserIdFollowersWithResponse(ctx, uuid.MustParse(ownerID), nil,
+			withBearerToken(ownerToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, followers.StatusCode())
+		assert.Empty(t, followers.JSON200.Users)
+
+		refollowed, err := c.PutV1UsersUserIdFollowWithResponse(ctx, uuid.MustParse(ownerID),
+			withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, refollowed.StatusCode())
+
+		blocks, err := c.GetV1BlocksWithResponse(ctx, nil, withBearerToken(ownerToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, blocks.StatusCode())
+		require.Len(t, blocks.JSON200.Users, 1)
+		assert.Equal(t, uuid.MustParse(memberID), blocks.JSON200.Users[0].Id)
+		assert.Nil(t, blocks.JSON200.NextCursor)
+
+		// The lists are private to the actor.
+		memberBlocks, err := c.GetV1BlocksWithResponse(ctx, nil, withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, memberBlocks.StatusCode())
+		assert.Empty(t, memberBlocks.JSON200.Users)
+	})
+
+	t.Run("unblocking shows the posts again", func(t *testing.T) {
+		for range 2 {
+			resp, err := c.DeleteV1UsersUserIdBlockWithResponse(ctx, uuid.MustParse(memberID), withBearerToken(ownerToken))
+			require.NoError(t, err)
+			require.Equal(t, http.StatusNoContent, resp.StatusCode())
+		}
+
+		assert.Equal(t, []string{"owner post"}, feedOf(t, memberToken))
+		assert.Equal(t, http.StatusOK, detailStatus(t, memberToken))
+	})
+
+	t.Run("invalid blocks and mutes are rejected", func(t *testing.T) {
+		blockSelf, err := c.PutV1UsersUserIdBlockWithResponse(ctx, uuid.MustParse(memberID), withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, blockSelf.StatusCode())
+
+		// Users of another organization do not exist for the caller.
+		blockOutsider, err := c.PutV1UsersUserIdBlockWithResponse(ctx, uuid.MustParse(outsiderID),
+			withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, blockOutsider.StatusCode())
+
+		muteOutsider, err := c.PutV1UsersUserIdMuteWithResponse(ctx, uuid.MustParse(outsiderID),
+			withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, muteOutsider.StatusCode())
+
+		limit := 101
+		mutes, err := c.GetV1MutesWithResponse(ctx, &clientgen.GetV1MutesParams{Limit: &limit},
+			withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, mutes.StatusCode())
+	})
+
+	t.Run("missing token returns 401", func(t *testing.T) {
+		resp, err := c.PutV1UsersUserIdBlockWithResponse(ctx, uuid.MustParse(ownerID))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+
+		blocks, err := c.GetV1BlocksWithResponse(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, blocks.StatusCode())
+	})
+}
@@ -0,0 +1,232 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookmarks(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+
+	ownerToken, ownerID := signupAndGetToken(t, "owner@example.com", "")
+	_, memberID := signupAndGetToken(t, "member@example.com", "")
+
+	orgID := organizationOf(t, ownerID)
+	joinOrganization(t, orgID, memberID, viewerRoleID)
+	memberToken := loginToOrganization(t, "member@example.com", orgID)
+
+	createPost := func(t *testing.T, content string, visibility clientgen.PostVisibility) uuid.UUID {
+		t.Helper()
+
+		resp, err := c.PostV1PostsWithResponse(ctx,
+			clientgen.CreatePostRequest{Content: content, Visibility: &visibility}, withBearerToken(ownerToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+
+		return resp.JSON201.Id
+	}
+
+	kept := createPost(t, "kept", clientgen.Public)
+	deleted := createPost(t, "deleted", clientgen.Public)
+	private := createPost(t, "private", clientgen.Private)
+
+	bookmark := func(t *testing.T, token string, postID uuid.UUID) int {
+		t.Helper()
+
+		resp, err := c.PutV1PostsPostIdBookmarkWithResponse(ctx, postID, withBearerToken(token))
+		require.NoError(t, err)
+
+		return resp.StatusCode()
+	}
+
+	bookmarks := func(t *testing.T, token string, params *clientgen.GetV1BookmarksParams) clientgen.SavedPostListResponse {
+		t.Helper()
+
+		resp, err := c.GetV1BookmarksWithResponse(ctx, params, withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+
+		return *resp.JSON200
+	}
+
+	t.Run("bookmarking is idempotent and private", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, bookmark(t, memberToken, kept))
+		assert.Equal(t, http.StatusNoContent, bookmark(t, memberToken, deleted))
+		assert.Equal(t, http.StatusNoContent, bookmark(t, memberToken, deleted))
+
+		// Posts the caller may not see cannot be bookmarked.
+		assert.Equal(t, http.StatusNotFound, bookmark(t, memberToken, private))
+		assert.Equal(t, http.StatusNotFound, bookmark(t, memberToken, uuid.New()))
+
+		page := bookmarks(t, memberToken, nil)
+		require.Len(t, page.Posts, 2)
+		assert.Equal(t, deleted, page.Posts[0].PostId)
+		assert.Equal(t, kept, page.Posts[1].PostId)
+		require.NotNil(t, page.Posts[1].Post)
+		assert.Equal(t, "kept", page.Posts[1].Post.Content)
+		assert.NotNil(t, page.Posts[1].Post.Reactions)
+
+		assert.Empty(t, bookmarks(t, ownerToken, nil).Posts)
+	})
+
+	t.Run("pages with a cursor", func(t *testing.T) {
+		limit := 1
+		first := bookmarks(t, memberToken, &clientgen.GetV1BookmarksParams{Limit: &limit})
+		require.Len(t, first.Posts, 1)
+		require.NotNil(t, first.NextCursor)
+
+		second := bookmarks(t, memberToken, &clientgen.GetV1BookmarksParams{Limit: &limit, After: first.NextCursor})
+		require.Len(t, second.Posts, 1)
+		assert.Equal(t, kept, second.Posts[0].PostId)
+		assert.Nil(t, second.NextCursor)
+	})
+
+	t.Run("deleted posts become tombstones", func(t *testing.T) {
+		resp, err := c.DeleteV1PostsPostIdWithResponse(ctx, deleted, withBearerToken(ownerToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, resp.StatusCode())
+
+		page := bookmarks(t, memberToken, nil)
+		require.Len(t, page.Posts, 2)
+		assert.Equal(t, deleted, page.Posts[0].PostId)
+		assert.Nil(t, page.Posts[0].Post)
+
+		// A tombstone can still be removed.
+		removed, err := c.DeleteV1PostsPostIdBookmarkWithResponse(ctx, deleted, withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, removed.StatusCode())
+
+		removed, err = c.DeleteV1PostsPostIdBookmarkWithResponse(ctx, deleted, withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, removed.StatusCode())
+
+		assert.Len(t, bookmarks(t, memberToken, nil).Posts, 1)
+	})
+}
+
+func TestCollections(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+
+	ownerToken, ownerID := signupAndGetToken(t, "owner@example.com", "")
+	_, memberID := signupAndGetToken(t, "member@example.com", "")
+
+	orgID := organizationOf(t, ownerID)
+	joinOrganization(t, orgID, memberID, viewerRoleID)
+	memberToken := loginToOrganization(t, "member@example.com", orgID)
+
+	postIDs := make([]uuid.UUID, 3)
+	for i := range postIDs {
+		resp, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{Content: "post"},
+			withBearerToken(ownerToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+
+		postIDs[i] = resp.JSON201.Id
+	}
+
+	created, err := c.PostV1CollectionsWithResponse(ctx, clientgen.CreateCollectionRequest{Name: " Reading list "},
+		withBearerToken(memberToken))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, created.StatusCode())
+	assert.Equal(t, "Reading list", created.JSON201.Name)
+
+	collectionID := created.JSON201.Id
+
+	addPost := func(t *testing.T, token string, postID uuid.UUID) int {
+		t.Helper()
+
+		resp, err := c.PutV1CollectionsCollectionIdPostsPostIdWithResponse(ctx, collectionID, postID,
+			withBearerToken(token))
+		require.NoError(t, err)
+
+		return resp.StatusCode()
+	}
+
+	t.Run("keeps posts in the order they were added", func(t *testing.T) {
+		for _, i := range []int{2, 0, 1, 0} {
+			assert.Equal(t, http.StatusNoContent, addPost(t, memberToken, postIDs[i]))
+		}
+
+		assert.Equal(t, http.StatusNotFound, addPost(t, memberToken, uuid.New()))
+
+		resp, err := c.GetV1CollectionsCollectionIdPostsWithResponse(ctx, collectionID, nil,
+			withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Len(t, resp.JSON200.Posts, 3)
+		assert.Equal(t, postIDs[2], resp.JSON200.Posts[0].PostId)
+		assert.Equal(t, postIDs[0], resp.JSON200.Posts[1].PostId)
+		assert.Equal(t, postIDs[1], resp.JSON200.Posts[2].PostId)
+	})
+
+	t.Run("renames and lists collections", func(t *testing.T) {
+		renamed, err := c.PatchV1CollectionsCollectionIdWithResponse(ctx, collectionID,
+			clientgen.UpdateCollectionRequest{Name: "Favourites"}, withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, renamed.StatusCode())
+		assert.Equal(t, "Favourites", renamed.JSON200.Name)
+
+		invalid, err := c.PatchV1CollectionsCollectionIdWithResponse(ctx, collectionID,
+			clientgen.UpdateCollectionRequest{Name: " "}, withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, invalid.StatusCode())
+
+		list, err := c.GetV1CollectionsWithResponse(ctx, withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, list.StatusCode())
+		require.Len(t, list.JSON200.Collections, 1)
+		assert.Equal(t, "Favourites", list.JSON200.Collections[0].Name)
+	})
+
+	t.Run("collections are private to their owner", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, addPost(t, ownerToken, postIDs[0]))
+
+		posts, err := c.GetV1CollectionsCollectionIdPostsWithResponse(ctx, collectionID, nil,
+			withBearerToken(ownerToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, posts.StatusCode())
+
+		deleted, err := c.DeleteV1CollectionsCollectionIdWithResponse(ctx, collectionID, withBearerToken(ownerToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, deleted.StatusCode())
+
+		list, err := c.GetV1CollectionsWithResponse(ctx, withBearerToken(ownerToken))
+		require.NoError(t, err)
+		assert.Empty(t, list.JSON200.Collections)
+	})
+
+	t.Run("removes posts and deletes the collection", func(t *testing.T) {
+		removed, err := c.DeleteV1CollectionsCollectionIdPostsPostIdWithResponse(ctx, collectionID, postIDs[0],
+			withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, removed.StatusCode())
+
+		posts, err := c.GetV1CollectionsCollectionIdPostsWithResponse(ctx, collectionID, nil,
+			withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Len(t, posts.JSON200.Posts, 2)
+
+		deleted, err := c.DeleteV1CollectionsCollectionIdWithResponse(ctx, collectionID, withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, deleted.StatusCode())
+
+		gone, err := c.GetV1CollectionsCollectionIdPostsWithResponse(ctx, collectionID, nil,
+			withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, gone.StatusCode())
+	})
+}
@@ -0,0 +1,191 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComments(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+
+	// The owner moderates the organization; the author and the commenter are viewers.
+	ownerToken, ownerID := signupAndGetToken(t, "owner@example.com", "")
+	_, authorID := signupAndGetToken(t, "author@example.com", "")
+	_, commenterID := signupAndGetToken(t, "commenter@example.com", "")
+	outsiderToken, _ := signupAndGetToken(t, "outsider@example.com", "")
+
+	orgID := organizationOf(t, ownerID)
+	joinOrganization(t, orgID, authorID, viewerRoleID)
+	joinOrganization(t, orgID, commenterID, viewerRoleID)
+	authorToken := loginToOrganization(t, "author@example.com", orgID)
+	commenterToken := loginToOrganization(t, "commenter@example.com", orgID)
+
+	created, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{Content: "post"},
+		withBearerToken(authorToken))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, created.StatusCode())
+
+	postID := created.JSON201.Id
+
+	comment := func(t *testing.T, token string, parentID *uuid.UUID, content string) clientgen.CommentResponse {
+		t.Helper()
+
+		resp, err := c.PostV1PostsPostIdCommentsWithResponse(ctx, postID,
+			clientgen.CreateCommentRequest{Content: content, ParentId: parentID}, withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+		require.NotNil(t, resp.JSON201)
+
+		return *resp.JSON201
+	}
+
+	list := func(t *testing.T, params clientgen.GetV1PostsPostIdCommentsParams) clientgen.CommentListResponse {
+		t.Helper()
+
+		resp, err := c.GetV1PostsPostIdCommentsWithResponse(ctx, postID, &params, withBearerToken(authorToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+
+		return *resp.JSON200
+	}
+
+	first := comment(t, commenterToken, nil, "first")
+	second := comment(t, authorToken, nil, "second")
+	reply := comment(t, authorToken, &first.Id, "reply")
+	nested := comment(t, commenterToken, &reply.Id, "nested")
+
+	t.Run("replies are one level below their parent", func(t *testing.T) {
+		assert.Equal(t, 0, first.Depth)
+		assert.Nil(t, first.ParentId)
+		assert.Equal(t, 1, reply.Depth)
+		require.NotNil(t, reply.ParentId)
+		assert.Equal(t, first.Id, *reply.ParentId)
+		assert.Equal(t, 2, nested.Depth)
+	})
+
+	t.Run("the post counts every comment", func(t *testing.T) {
+		resp, err := c.GetV1PostsPostIdWithResponse(ctx, postID, withBearerToken(authorToken))
+		require.NoError(t, err)
+		require.NotNil(t, resp.JSON200)
+		require.NotNil(t, resp.JSON200.CommentCount)
+		assert.Equal(t, 4, *resp.JSON200.CommentCount)
+	})
+
+	t.Run("listing embeds replies down to the requested depth", func(t *testing.T) {
+		depth := 1
+		page := list(t, clientgen.GetV1PostsPostIdCommentsParams{Depth: &depth})
+
+		require.Len(t, page.Comments, 2)
+		assert.Equal(t, first.Id, page.Comments[0].Id)
+		assert.Equal(t, second.Id, page.Comments[1].Id)
+		require.NotNil(t, page.Comments[0].Author)
+		assert.Equal(t, "Test User", page.Comments[0].Author.Name)
+		require.NotNil(t, page.Comments[0].ReplyCount)
+		assert.Equal(t, 1, *page.Comments[0].ReplyCount)
+		require.NotNil(t, page.Comments[0].Replies)
+
+		replies := *page.Comments[0].Replies
+		require.Len(t, replies, 1)
+		assert.Equal(t, reply.Id, replies[0].Id)
+		assert.Equal(t, 1, *replies[0].ReplyCount)
+		assert.Nil(t, replies[0].Replies)
+	})
+
+	t.Run("replies of a comment are paged with a cursor", func(t *testing.T) {
+		limit := 1
+		page := list(t, clientgen.GetV1PostsPostIdCommentsParams{Limit: &limit})
+
+		require.Len(t, page.Comments, 1)
+		assert.Equal(t, first.Id, page.Comments[0].Id)
+		require.NotNil(t, page.NextCursor)
+
+		next := list(t, clientgen.GetV1PostsPostIdCommentsParams{Limit: &limit, After: page.NextCursor})
+		require.Len(t, next.Comments, 1)
+		assert.Equal(t, second.Id, next.Comments[0].Id)
+		assert.Nil(t, next.NextCursor)
+
+		thread := list(t, clientgen.GetV1PostsPostIdCommentsParams{ParentId: &reply.Id})
+		require.Len(t, thread.Comments, 1)
+		assert.Equal(t, nested.Id, thread.Comments[0].Id)
+	})
+
+	t.Run("invalid comments are rejected", func(t *testing.T) {
+		empty, err := c.PostV1PostsPostIdCommentsWithResponse(ctx, postID,
+			clientgen.CreateCommentRequest{Content: "   "}, withBearerToken(authorToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, empty.StatusCode())
+
+		unknownParent := uuid.New()
+		orphan, err := c.PostV1PostsPostIdCommentsWithResponse(ctx, postID,
+			clientgen.CreateCommentRequest{Content: "reply", ParentId: &unknownParent}, withBearerToken(authorToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, orphan.StatusCode())
+
+		unknownPost, err := c.PostV1PostsPostIdCommentsWithResponse(ctx, uuid.New(),
+			clientgen.CreateCommentRequest{Content: "comment"}, withBearerToken(authorToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, unknownPost.StatusCode())
+
+		hidden, err := c.GetV1PostsPostIdCommentsWithResponse(ctx, postID, nil, withBearerToken(outsiderToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, hidden.StatusCode())
+	})
+
+	t.Run("only the author or a moderator deletes a comment", func(t *testing.T) {
+		forbidden, err := c.DeleteV1PostsPostIdCommentsCommentIdWithResponse(ctx, postID, reply.Id,
+			withBearerToken(commenterToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, forbidden.StatusCode())
+
+		deleted, err := c.DeleteV1PostsPostIdCommentsCommentIdWithResponse(ctx, postID, first.Id,
+			withBearerToken(ownerToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, deleted.StatusCode())
+
+		// The replies went with it.
+		page := list(t, clientgen.GetV1PostsPostIdCommentsParams{})
+		require.Len(t, page.Comments, 1)
+		assert.Equal(t, second.Id, page.Comments[0].Id)
+
+		gone, err := c.DeleteV1PostsPostIdCommentsCommentIdWithResponse(ctx, postID, reply.Id,
+			withBearerToken(authorToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, gone.StatusCode())
+	})
+
+	t.Run("trashing the post hides its comments", func(t *testing.T) {
+		resp, err := c.DeleteV1PostsPostIdWithResponse(ctx, postID, withBearerToken(authorToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, resp.StatusCode())
+
+		comments, err := c.GetV1PostsPostIdCommentsWithResponse(ctx, postID, nil, withBearerToken(authorToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, comments.StatusCode())
+
+		// The comments are kept until the post is purged from the trash.
+		var count int
+		inOrganization(t, orgID, func(ctx context.Context, tx pgx.Tx) error {
+			return tx.QueryRow(ctx, "SELECT COUNT(*) FROM comments").Scan(&count)
+		})
+		assert.NotZero(t, count)
+	})
+
+	t.Run("missing token returns 401", func(t *testing.T) {
+		resp, err := c.GetV1PostsPostIdCommentsWithResponse(ctx, postID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	})
+}
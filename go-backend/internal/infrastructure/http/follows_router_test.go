@@ -0,0 +1,130 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFollowsAndTimeline(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+
+	ownerToken, ownerID := signupAndGetToken(t, "owner@example.com", "")
+	_, memberID := signupAndGetToken(t, "member@example.com", "")
+	_, outsiderID := signupAndGetToken(t, "outsider@example.com", "")
+
+	orgID := organizationOf(t, ownerID)
+	joinOrganization(t, orgID, memberID, viewerRoleID)
+	memberToken := loginToOrganization(t, "member@example.com", orgID)
+
+	follow := func(t *testing.T, token, userID string) int {
+		t.Helper()
+
+		resp, err := c.PutV1UsersUserIdFollowWithResponse(ctx, uuid.MustParse(userID), withBearerToken(token))
+		require.NoError(t, err)
+
+		return resp.StatusCode()
+	}
+
+	unfollow := func(t *testing.T, token, userID string) int {
+		t.Helper()
+
+		resp, err := c.DeleteV1UsersUserIdFollowWithResponse(ctx, uuid.MustParse(userID), withBearerToken(token))
+		require.NoError(t, err)
+
+		return resp.StatusCode()
+	}
+
+	timelineOf := func(t *testing.T, token string, params *clientgen.GetV1TimelineParams) clientgen.TimelineResponse {
+		t.Helper()
+
+		resp, err := c.GetV1TimelineWithResponse(ctx, params, withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+
+		return *resp.JSON200
+	}
+
+	for _, content := range []string{"first", "second", "third"} {
+		created, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{Content: content},
+			withBearerToken(ownerToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, created.StatusCode())
+	}
+
+	t.Run("following is idempotent", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, follow(t, memberToken, ownerID))
+		assert.Equal(t, http.StatusNoContent, follow(t, memberToken, ownerID))
+	})
+
+	t.Run("follow lists show both sides", func(t *testing.T) {
+		followers, err := c.GetV1UsersUserIdFollowersWithResponse(ctx, uuid.MustParse(ownerID), nil,
+			withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, followers.StatusCode())
+		require.Len(t, followers.JSON200.Users, 1)
+		assert.Equal(t, uuid.MustParse(memberID), followers.JSON200.Users[0].Id)
+		assert.Nil(t, followers.JSON200.NextCursor)
+
+		following, err := c.GetV1UsersUserIdFollowingWithResponse(ctx, uuid.MustParse(memberID), nil,
+			withBearerToken(ownerToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, following.StatusCode())
+		require.Len(t, following.JSON200.Users, 1)
+		assert.Equal(t, uuid.MustParse(ownerID), following.JSON200.Users[0].Id)
+	})
+
+	t.Run("the timeline pages through followed authors' posts", func(t *testing.T) {
+		limit := 2
+		page := timelineOf(t, memberToken, &clientgen.GetV1TimelineParams{Limit: &limit})
+		require.Len(t, page.Posts, 2)
+		assert.Equal(t, "third", page.Posts[0].Content)
+		assert.Equal(t, "second", page.Posts[1].Content)
+		require.NotNil(t, page.NextCursor)
+
+		rest := timelineOf(t, memberToken, &clientgen.GetV1TimelineParams{Limit: &limit, After: page.NextCursor})
+		require.Len(t, rest.Posts, 1)
+		assert.Equal(t, "first", rest.Posts[0].Content)
+		assert.Nil(t, rest.NextCursor)
+
+		// The owner follows nobody.
+		assert.Empty(t, timelineOf(t, ownerToken, nil).Posts)
+	})
+
+	t.Run("unfollowing empties the timeline", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, unfollow(t, memberToken, ownerID))
+		assert.Equal(t, http.StatusNoContent, unfollow(t, memberToken, ownerID))
+		assert.Empty(t, timelineOf(t, memberToken, nil).Posts)
+	})
+
+	t.Run("invalid follows are rejected", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, follow(t, memberToken, memberID))
+		// Users of another organization do not exist for the caller.
+		assert.Equal(t, http.StatusNotFound, follow(t, memberToken, outsiderID))
+
+		resp, err := c.GetV1UsersUserIdFollowersWithResponse(ctx, uuid.MustParse(outsiderID), nil,
+			withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+
+	t.Run("missing token returns 401", func(t *testing.T) {
+		resp, err := c.PutV1UsersUserIdFollowWithResponse(ctx, uuid.MustParse(ownerID))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+
+		timeline, err := c.GetV1TimelineWithResponse(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, timeline.StatusCode())
+	})
+}
@@ -1,53 +1,120 @@
 package http
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/Haya372/web-app-template/go-backend/internal/common"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
 	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
+	commandadmin "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/admin"
+	commandorganization "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/organization"
 	commandpost "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
 	commanduser "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
 	querypost "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
 	queryuser "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 )
 
+// UseCases holds the use cases behind the REST handlers. Wire fills in every field.
+type UseCases struct {
+	SignupUseCase               commanduser.SingupUseCase
+	LoginUseCase                commanduser.LoginUseCase
+	ListUsersUseCase            queryuser.ListUsersUseCase
+	GrantRoleUseCase            commanduser.GrantRoleUseCase
+	ListRoleAssignmentsUseCase  queryuser.ListRoleAssignmentsUseCase
+	CreatePostUseCase           commandpost.CreatePostUseCase
+	UpdatePostUseCase           commandpost.UpdatePostUseCase
+	DeletePostUseCase           commandpost.DeletePostUseCase
+	RestorePostUseCase          commandpost.RestorePostUseCase
+	ListTrashUseCase            querypost.ListTrashUseCase
+	PublishPostUseCase          commandpost.PublishPostUseCase
+	UploadAttachmentUseCase     commandpost.UploadAttachmentUseCase
+	GetAttachmentContentUseCase querypost.GetAttachmentContentUseCase
+	ListPostsUseCase            querypost.ListPostsUseCase
+	ListUserPostsUseCase        querypost.ListUserPostsUseCase
+	ListHashtagPostsUseCase     querypost.ListHashtagPostsUseCase
+	SearchPostsUseCase          querypost.SearchPostsUseCase
+	GetPostUseCase              querypost.GetPostUseCase
+	ListPostRevisionsUseCase    querypost.ListPostRevisionsUseCase
+	DiffPostRevisionsUseCase    querypost.DiffPostRevisionsUseCase
+	CreateCommentUseCase        commandpost.CreateCommentUseCase
+	DeleteCommentUseCase        commandpost.DeleteCommentUseCase
+	ListCommentsUseCase         querypost.ListCommentsUseCase
+	AddReactionUseCase          commandpost.AddReactionUseCase
+	RemoveReactionUseCase       commandpost.RemoveReactionUseCase
+	AddBookmarkUseCase          commandpost.AddBookmarkUseCase
+	RemoveBookmarkUseCase       commandpost.RemoveBookmarkUseCase
+	ListBookmarksUseCase        querypost.ListBookmarksUseCase
+	RepostUseCase               commandpost.RepostUseCase
+	RemoveRepostUseCase         commandpost.RemoveRepostUseCase
+	VotePollUseCase             commandpost.VotePollUseCase
+	PinPostUseCase              commandpost.PinPostUseCase
+	UnpinPostUseCase            commandpost.UnpinPostUseCase
+	CreateCollectionUseCase     commandpost.CreateCollectionUseCase
+	RenameCollectionUseCase     commandpost.RenameCollectionUseCase
+	DeleteCollectionUseCase     commandpost.DeleteCollectionUseCase
+	AddCollectionPostUseCase    commandpost.AddCollectionPostUseCase
+	RemoveCollectionPostUseCase commandpost.RemoveCollectionPostUseCase
+	ListCollectionsUseCase      querypost.ListCollectionsUseCase
+	ExportPostsUseCase          querypost.ExportPostsUseCase
+	ListCollectionPostsUseCase  querypost.ListCollectionPostsUseCase
+	ReportPostUseCase           commandpost.ReportPostUseCase
+	ModeratePostUseCase         commandpost.ModeratePostUseCase
+	ListModerationQueueUseCase  querypost.ListModerationQueueUseCase
+	FollowUserUseCase           commanduser.FollowUserUseCase
+	UnfollowUserUseCase         commanduser.UnfollowUserUseCase
+	ListFollowsUseCase          queryuser.ListFollowsUseCase
+	BlockUserUseCase            commanduser.BlockUserUseCase
+	UnblockUserUseCase          commanduser.UnblockUserUseCase
+	MuteUserUseCase             commanduser.MuteUserUseCase
+	UnmuteUserUseCase           commanduser.UnmuteUserUseCase
+	ListRelationshipsUseCase    queryuser.ListRelationshipsUseCase
+	GetUserProfileUseCase       queryuser.GetUserProfileUseCase
+	UpdateProfileUseCase        commanduser.UpdateProfileUseCase
+	ListTimelineUseCase         querypost.ListTimelineUseCase
+	ImpersonateUseCase          commandadmin.ImpersonateUseCase
+	CreateOrganizationUseCase   commandorganization.CreateOrganizationUseCase
+	AddMemberUseCase            commandorganization.AddMemberUseCase
+	CreateInvitationUseCase     commandadmin.CreateInvitationUseCase
+	ImportPostsUseCase          commandadmin.ImportPostsUseCase
+	GetInvitationUseCase        queryuser.GetInvitationUseCase
+}
+
 // serverHandler implements generated.StrictServerInterface and contains all
 // HTTP handler logic for the API. It delegates business operations to use cases
 // and maps domain errors to typed OpenAPI response objects.
 type serverHandler struct {
-	logger            common.Logger
-	tracer            trace.Tracer
-	signupUseCase     commanduser.SingupUseCase
-	loginUseCase      commanduser.LoginUseCase
-	listUsersUseCase  queryuser.ListUsersUseCase
-	createPostUseCase commandpost.CreatePostUseCase
-	listPostsUseCase  querypost.ListPostsUseCase
+	UseCases
+
+	logger common.Logger
+	tracer trace.Tracer
 }
 
 // Compile-time assertion that serverHandler satisfies the generated interface.
 var _ generated.StrictServerInterface = (*serverHandler)(nil)
 
-func newServerHandler(
-	signupUseCase commanduser.SingupUseCase,
-	loginUseCase commanduser.LoginUseCase,
-	listUsersUseCase queryuser.ListUsersUseCase,
-	createPostUseCase commandpost.CreatePostUseCase,
-	listPostsUseCase querypost.ListPostsUseCase,
-) *serverHandler {
+func newServerHandler(useCases UseCases) *serverHandler {
 	return &serverHandler{
-		logger:            common.NewLogger(),
-		tracer:            otel.Tracer("server"),
-		signupUseCase:     signupUseCase,
-		loginUseCase:      loginUseCase,
-		listUsersUseCase:  listUsersUseCase,
-		createPostUseCase: createPostUseCase,
-		listPostsUseCase:  listPostsUseCase,
+		UseCases: useCases,
+		logger:   common.NewLogger(),
+		tracer:   otel.Tracer("server"),
 	}
 }
 
+// tenantIDFromContext returns the active organization set by JWTMiddleware. Routes that need it are
+// guarded by RequireTenantMiddleware, so a failure here is reported as a missing organization.
+func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	tenantID, err := uuid.Parse(common.TenantIDFromContext(ctx))
+	if err != nil {
+		return uuid.Nil, vo.NewForbiddenError("no active organization", nil, err)
+	}
+
+	return tenantID, nil
+}
+
 // --- problem detail builders ---
 
 func domainErrToProblem(e vo.Error) generated.ProblemDetails {
@@ -103,6 +170,7 @@ func validationProblemFromDomain(e vo.Error) generated.ProblemDetails {
 	return p
 }
 
+//nolint:unparam // every current caller reports a bad token subject; detail stays a parameter for other checks.
 func validationProblem(detail string, errors map[string][]string) generated.ProblemDetails {
 	p := generated.ProblemDetails{
 		Type:   string(vo.ValidationErrorCode),
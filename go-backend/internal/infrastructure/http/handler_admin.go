@@ -0,0 +1,193 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
+	commandadmin "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/admin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// PostV1AdminImpersonateUserId handles POST /v1/admin/impersonate/{userId} (requires JWT and users:impersonate).
+func (h *serverHandler) PostV1AdminImpersonateUserId(
+	ctx context.Context,
+	req generated.PostV1AdminImpersonateUserIdRequestObject,
+) (generated.PostV1AdminImpersonateUserIdResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "impersonate")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PostV1AdminImpersonateUserId401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PostV1AdminImpersonateUserId400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapImpersonateError(err), nil
+	}
+
+	output, err := h.ImpersonateUseCase.Execute(ctx, commandadmin.ImpersonateInput{
+		ActorID:   actorID,
+		SubjectID: req.UserId,
+		TenantID:  tenantID,
+		Method:    http.MethodPost,
+		Path:      "/v1/admin/impersonate/" + req.UserId.String(),
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapImpersonateError(err), nil
+	}
+
+	return generated.PostV1AdminImpersonateUserId200JSONResponse{
+		Token:     output.Token,
+		ExpiresAt: output.ExpiresAt,
+		SubjectId: output.SubjectID,
+		ActorId:   output.ActorID,
+	}, nil
+}
+
+func mapImpersonateError(err error) generated.PostV1AdminImpersonateUserIdResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PostV1AdminImpersonateUserId400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.UnauthorizedErrorCode, vo.InvalidCredentialErrorCode:
+			return generated.PostV1AdminImpersonateUserId401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.ForbiddenErrorCode:
+			return generated.PostV1AdminImpersonateUserId403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: generated.ForbiddenApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PostV1AdminImpersonateUserId500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+// PostV1AdminPostsImport handles POST /v1/admin/posts:import (requires JWT and posts:import).
+func (h *serverHandler) PostV1AdminPostsImport(
+	ctx context.Context,
+	req generated.PostV1AdminPostsImportRequestObject,
+) (generated.PostV1AdminPostsImportResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "importPosts")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PostV1AdminPostsImport401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PostV1AdminPostsImport400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	output, err := h.ImportPostsUseCase.Execute(ctx, commandadmin.ImportPostsInput{
+		ActorID: actorID,
+		Lines:   req.Body,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapImportPostsError(err), nil
+	}
+
+	errs := make([]generated.ImportPostError, len(output.Errors))
+	for i, lineErr := range output.Errors {
+		errs[i] = generated.ImportPostError{Line: lineErr.Line, Message: lineErr.Message}
+	}
+
+	return generated.PostV1AdminPostsImport200JSONResponse{
+		Imported: output.Imported,
+		Failed:   len(output.Errors),
+		Errors:   errs,
+	}, nil
+}
+
+func mapImportPostsError(err error) generated.PostV1AdminPostsImportResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PostV1AdminPostsImport400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.ForbiddenErrorCode:
+			return generated.PostV1AdminPostsImport403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: generated.ForbiddenApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PostV1AdminPostsImport500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
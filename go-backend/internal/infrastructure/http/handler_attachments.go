@@ -0,0 +1,301 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
+	commandpost "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	querypost "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// attachmentFileField is the multipart form field that carries the uploaded file.
+const attachmentFileField = "file"
+
+var errMissingAttachmentFile = errors.New("missing file part")
+
+// PostV1Attachments handles POST /v1/attachments (requires JWT).
+func (h *serverHandler) PostV1Attachments(
+	ctx context.Context,
+	req generated.PostV1AttachmentsRequestObject,
+) (generated.PostV1AttachmentsResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "uploadAttachment")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PostV1Attachments401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	uploaderID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PostV1Attachments400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	fileName, content, err := nextAttachmentFile(req.Body)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapUploadAttachmentError(err), nil
+	}
+
+	output, err := h.UploadAttachmentUseCase.Execute(ctx, commandpost.UploadAttachmentInput{
+		UploaderID: uploaderID,
+		FileName:   fileName,
+		Content:    content,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapUploadAttachmentError(err), nil
+	}
+
+	return generated.PostV1Attachments201JSONResponse(toAttachmentResponse(*output)), nil
+}
+
+// nextAttachmentFile skips to the file part of an upload, which is then streamed to the use case rather
+// than buffered by a multipart form parser.
+func nextAttachmentFile(body *multipart.Reader) (string, io.Reader, error) {
+	for {
+		part, err := body.NextPart()
+		if errors.Is(err, io.EOF) {
+			return "", nil, vo.NewValidationError("the file is missing", map[string]any{
+				attachmentFileField: "is required",
+			}, errMissingAttachmentFile)
+		}
+
+		if err != nil {
+			return "", nil, vo.NewValidationError("malformed multipart body", nil, err)
+		}
+
+		if part.FormName() == attachmentFileField {
+			return part.FileName(), part, nil
+		}
+	}
+}
+
+// GetV1AttachmentsAttachmentId handles GET /v1/attachments/{attachmentId} (requires JWT).
+func (h *serverHandler) GetV1AttachmentsAttachmentId(
+	ctx context.Context,
+	req generated.GetV1AttachmentsAttachmentIdRequestObject,
+) (generated.GetV1AttachmentsAttachmentIdResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "getAttachment")
+	defer span.End()
+
+	output, err := h.getAttachmentContent(ctx, req.AttachmentId, false)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapGetAttachmentError(err), nil
+	}
+
+	return generated.GetV1AttachmentsAttachmentId200AsteriskResponse{
+		AttachmentContentAsteriskResponse: toAttachmentContentResponse(output),
+	}, nil
+}
+
+// GetV1AttachmentsAttachmentIdThumbnail handles GET /v1/attachments/{attachmentId}/thumbnail (requires JWT).
+func (h *serverHandler) GetV1AttachmentsAttachmentIdThumbnail(
+	ctx context.Context,
+	req generated.GetV1AttachmentsAttachmentIdThumbnailRequestObject,
+) (generated.GetV1AttachmentsAttachmentIdThumbnailResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "getAttachmentThumbnail")
+	defer span.End()
+
+	output, err := h.getAttachmentContent(ctx, req.AttachmentId, true)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapGetAttachmentThumbnailError(err), nil
+	}
+
+	return generated.GetV1AttachmentsAttachmentIdThumbnail200AsteriskResponse{
+		AttachmentContentAsteriskResponse: toAttachmentContentResponse(output),
+	}, nil
+}
+
+// getAttachmentContent reports a missing or malformed user ID as an unauthorized error.
+func (h *serverHandler) getAttachmentContent(
+	ctx context.Context, attachmentID uuid.UUID, thumbnail bool,
+) (*querypost.GetAttachmentContentOutput, error) {
+	userID, err := uuid.Parse(common.UserIDFromContext(ctx))
+	if err != nil {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+
+		return nil, vo.NewUnauthorizedError("missing user ID in context", nil, err)
+	}
+
+	return h.GetAttachmentContentUseCase.Execute(ctx, querypost.GetAttachmentContentInput{
+		AttachmentID: attachmentID,
+		UserID:       userID,
+		Thumbnail:    thumbnail,
+	})
+}
+
+// toAttachmentContentResponse lets browsers display images, while other files are offered for download
+// so that they are never rendered in the context of the API's origin.
+func toAttachmentContentResponse(output *querypost.GetAttachmentContentOutput) generated.AttachmentContentAsteriskResponse {
+	disposition := "attachment"
+	if strings.HasPrefix(output.ContentType, "image/") {
+		disposition = "inline"
+	}
+
+	return generated.AttachmentContentAsteriskResponse{
+		Body: output.Content,
+		Headers: generated.AttachmentContentResponseHeaders{
+			ContentDisposition: mime.FormatMediaType(disposition, map[string]string{"filename": output.FileName}),
+		},
+		ContentType:   output.ContentType,
+		ContentLength: output.Size,
+	}
+}
+
+func toAttachmentResponse(a commandpost.AttachmentOutput) generated.AttachmentResponse {
+	resp := generated.AttachmentResponse{
+		Id:           a.ID,
+		FileName:     a.FileName,
+		ContentType:  a.ContentType,
+		Size:         a.Size,
+		HasThumbnail: a.HasThumbnail,
+	}
+
+	if a.HasThumbnail {
+		resp.Width = &a.Width
+		resp.Height = &a.Height
+	}
+
+	return resp
+}
+
+// toPostAttachments returns nil for posts whose attachments were not loaded.
+func toPostAttachments(attachments []querypost.PostAttachmentDto) *[]generated.AttachmentResponse {
+	if attachments == nil {
+		return nil
+	}
+
+	resp := make([]generated.AttachmentResponse, len(attachments))
+	for i, a := range attachments {
+		resp[i] = toAttachmentResponse(commandpost.AttachmentOutput{
+			ID:           a.ID,
+			FileName:     a.FileName,
+			ContentType:  a.ContentType,
+			Size:         a.Size,
+			Width:        a.Width,
+			Height:       a.Height,
+			HasThumbnail: a.HasThumbnail,
+		})
+	}
+
+	return &resp
+}
+
+func toCreatedPostAttachments(attachments []commandpost.AttachmentOutput) *[]generated.AttachmentResponse {
+	resp := make([]generated.AttachmentResponse, len(attachments))
+	for i, a := range attachments {
+		resp[i] = toAttachmentResponse(a)
+	}
+
+	return &resp
+}
+
+func mapUploadAttachmentError(err error) generated.PostV1AttachmentsResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PostV1Attachments400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PostV1Attachments500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapGetAttachmentError(err error) generated.GetV1AttachmentsAttachmentIdResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.UnauthorizedErrorCode:
+			return generated.GetV1AttachmentsAttachmentId401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+					unauthorizedProblem(),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.GetV1AttachmentsAttachmentId404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1AttachmentsAttachmentId500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapGetAttachmentThumbnailError(err error) generated.GetV1AttachmentsAttachmentIdThumbnailResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.UnauthorizedErrorCode:
+			return generated.GetV1AttachmentsAttachmentIdThumbnail401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+					unauthorizedProblem(),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.GetV1AttachmentsAttachmentIdThumbnail404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1AttachmentsAttachmentIdThumbnail500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
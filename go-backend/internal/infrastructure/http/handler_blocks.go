@@ -0,0 +1,415 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
+	commanduser "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
+	queryuser "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// defaultRelationshipListLimit is the page size of block and mute lists when the request has no limit.
+const defaultRelationshipListLimit = 20
+
+// PutV1UsersUserIdBlock handles PUT /v1/users/{userId}/block (requires JWT).
+func (h *serverHandler) PutV1UsersUserIdBlock(
+	ctx context.Context,
+	req generated.PutV1UsersUserIdBlockRequestObject,
+) (generated.PutV1UsersUserIdBlockResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "blockUser")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PutV1UsersUserIdBlock401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	input := commanduser.BlockUserInput{UserID: req.UserId}
+
+	var err error
+	if input.ActorID, err = uuid.Parse(userIDStr); err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PutV1UsersUserIdBlock400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	if err = h.BlockUserUseCase.Execute(ctx, input); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapBlockUserError(err), nil
+	}
+
+	return generated.PutV1UsersUserIdBlock204Response{}, nil
+}
+
+// DeleteV1UsersUserIdBlock handles DELETE /v1/users/{userId}/block (requires JWT).
+func (h *serverHandler) DeleteV1UsersUserIdBlock(
+	ctx context.Context,
+	req generated.DeleteV1UsersUserIdBlockRequestObject,
+) (generated.DeleteV1UsersUserIdBlockResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "unblockUser")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.DeleteV1UsersUserIdBlock401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	input := commanduser.UnblockUserInput{UserID: req.UserId}
+
+	var err error
+	if input.ActorID, err = uuid.Parse(userIDStr); err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.DeleteV1UsersUserIdBlock400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	if err = h.UnblockUserUseCase.Execute(ctx, input); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+		return generated.DeleteV1UsersUserIdBlock500ApplicationProblemPlusJSONResponse{
+			InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+		}, nil
+	}
+
+	return generated.DeleteV1UsersUserIdBlock204Response{}, nil
+}
+
+// PutV1UsersUserIdMute handles PUT /v1/users/{userId}/mute (requires JWT).
+func (h *serverHandler) PutV1UsersUserIdMute(
+	ctx context.Context,
+	req generated.PutV1UsersUserIdMuteRequestObject,
+) (generated.PutV1UsersUserIdMuteResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "muteUser")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PutV1UsersUserIdMute401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	input := commanduser.MuteUserInput{UserID: req.UserId}
+
+	var err error
+	if input.ActorID, err = uuid.Parse(userIDStr); err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PutV1UsersUserIdMute400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	if err = h.MuteUserUseCase.Execute(ctx, input); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapMuteUserError(err), nil
+	}
+
+	return generated.PutV1UsersUserIdMute204Response{}, nil
+}
+
+// DeleteV1UsersUserIdMute handles DELETE /v1/users/{userId}/mute (requires JWT).
+func (h *serverHandler) DeleteV1UsersUserIdMute(
+	ctx context.Context,
+	req generated.DeleteV1UsersUserIdMuteRequestObject,
+) (generated.DeleteV1UsersUserIdMuteResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "unmuteUser")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.DeleteV1UsersUserIdMute401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	input := commanduser.UnmuteUserInput{UserID: req.UserId}
+
+	var err error
+	if input.ActorID, err = uuid.Parse(userIDStr); err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.DeleteV1UsersUserIdMute400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	if err = h.UnmuteUserUseCase.Execute(ctx, input); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+		return generated.DeleteV1UsersUserIdMute500ApplicationProblemPlusJSONResponse{
+			InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+		}, nil
+	}
+
+	return generated.DeleteV1UsersUserIdMute204Response{}, nil
+}
+
+// GetV1Blocks handles GET /v1/blocks (requires JWT).
+func (h *serverHandler) GetV1Blocks(
+	ctx context.Context,
+	req generated.GetV1BlocksRequestObject,
+) (generated.GetV1BlocksResponseObject, error) {
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+
+		return generated.GetV1Blocks401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return generated.GetV1Blocks400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	resp, err := h.listRelationships(ctx, toListRelationshipsInput(
+		actorID, queryuser.RelationshipKindBlocks, req.Params.Limit, req.Params.After,
+	))
+	if err != nil {
+		return mapListBlocksError(err), nil
+	}
+
+	return generated.GetV1Blocks200JSONResponse(resp), nil
+}
+
+// GetV1Mutes handles GET /v1/mutes (requires JWT).
+func (h *serverHandler) GetV1Mutes(
+	ctx context.Context,
+	req generated.GetV1MutesRequestObject,
+) (generated.GetV1MutesResponseObject, error) {
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+
+		return generated.GetV1Mutes401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return generated.GetV1Mutes400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	resp, err := h.listRelationships(ctx, toListRelationshipsInput(
+		actorID, queryuser.RelationshipKindMutes, req.Params.Limit, req.Params.After,
+	))
+	if err != nil {
+		return mapListMutesError(err), nil
+	}
+
+	return generated.GetV1Mutes200JSONResponse(resp), nil
+}
+
+// listRelationships runs the list-relationships use case for both the block and the mute list inside one
+// span.
+func (h *serverHandler) listRelationships(
+	ctx context.Context, input queryuser.ListRelationshipsInput,
+) (generated.RelationshipListResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "listRelationships")
+	defer span.End()
+
+	output, err := h.ListRelationshipsUseCase.Execute(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return generated.RelationshipListResponse{}, err
+	}
+
+	users := make([]generated.RelationshipResponse, len(output.Relationships))
+	for i, r := range output.Relationships {
+		users[i] = generated.RelationshipResponse{Id: r.UserID, Name: r.Name, CreatedAt: r.CreatedAt}
+	}
+
+	return generated.RelationshipListResponse{
+		Users:      users,
+		Limit:      input.Limit,
+		NextCursor: optionalString(output.NextCursor),
+	}, nil
+}
+
+// toListRelationshipsInput applies the defaults of the block- and mute-list parameters.
+func toListRelationshipsInput(
+	actorID uuid.UUID, kind queryuser.RelationshipKind, limit *int, after *string,
+) queryuser.ListRelationshipsInput {
+	input := queryuser.ListRelationshipsInput{ActorID: actorID, Kind: kind, Limit: defaultRelationshipListLimit}
+	if limit != nil {
+		input.Limit = *limit
+	}
+
+	if after != nil {
+		input.After = *after
+	}
+
+	return input
+}
+
+func mapBlockUserError(err error) generated.PutV1UsersUserIdBlockResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PutV1UsersUserIdBlock400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.PutV1UsersUserIdBlock404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PutV1UsersUserIdBlock500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapMuteUserError(err error) generated.PutV1UsersUserIdMuteResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PutV1UsersUserIdMute400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.PutV1UsersUserIdMute404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PutV1UsersUserIdMute500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapListBlocksError(err error) generated.GetV1BlocksResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.GetV1Blocks400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					validationProblemFromDomain(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1Blocks500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapListMutesError(err error) generated.GetV1MutesResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.GetV1Mutes400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					validationProblemFromDomain(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1Mutes500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
@@ -0,0 +1,249 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
+	commandpost "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	querypost "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// PutV1PostsPostIdBookmark handles PUT /v1/posts/{postId}/bookmark (requires JWT).
+func (h *serverHandler) PutV1PostsPostIdBookmark(
+	ctx context.Context,
+	req generated.PutV1PostsPostIdBookmarkRequestObject,
+) (generated.PutV1PostsPostIdBookmarkResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "addBookmark")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PutV1PostsPostIdBookmark401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PutV1PostsPostIdBookmark400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	err = h.AddBookmarkUseCase.Execute(ctx, commandpost.AddBookmarkInput{
+		ActorID: actorID,
+		PostID:  req.PostId,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapAddBookmarkError(err), nil
+	}
+
+	return generated.PutV1PostsPostIdBookmark204Response{}, nil
+}
+
+// DeleteV1PostsPostIdBookmark handles DELETE /v1/posts/{postId}/bookmark (requires JWT).
+func (h *serverHandler) DeleteV1PostsPostIdBookmark(
+	ctx context.Context,
+	req generated.DeleteV1PostsPostIdBookmarkRequestObject,
+) (generated.DeleteV1PostsPostIdBookmarkResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "removeBookmark")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.DeleteV1PostsPostIdBookmark401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.DeleteV1PostsPostIdBookmark400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	err = h.RemoveBookmarkUseCase.Execute(ctx, commandpost.RemoveBookmarkInput{
+		ActorID: actorID,
+		PostID:  req.PostId,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapRemoveBookmarkError(err), nil
+	}
+
+	return generated.DeleteV1PostsPostIdBookmark204Response{}, nil
+}
+
+// GetV1Bookmarks handles GET /v1/bookmarks (requires JWT).
+func (h *serverHandler) GetV1Bookmarks(
+	ctx context.Context,
+	req generated.GetV1BookmarksRequestObject,
+) (generated.GetV1BookmarksResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "listBookmarks")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.GetV1Bookmarks401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.GetV1Bookmarks400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	input := querypost.ListBookmarksInput{UserID: actorID, Limit: defaultPostListLimit}
+	if req.Params.Limit != nil {
+		input.Limit = *req.Params.Limit
+	}
+
+	if req.Params.After != nil {
+		input.After = *req.Params.After
+	}
+
+	output, err := h.ListBookmarksUseCase.Execute(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapListBookmarksError(err), nil
+	}
+
+	return generated.GetV1Bookmarks200JSONResponse(toSavedPostListResponse(output, input.Limit)), nil
+}
+
+// toSavedPostListResponse leaves out the post of tombstones.
+func toSavedPostListResponse(output *querypost.ListSavedPostsOutput, limit int) generated.SavedPostListResponse {
+	posts := make([]generated.SavedPostResponse, len(output.Posts))
+	for i, saved := range output.Posts {
+		posts[i] = generated.SavedPostResponse{PostId: saved.PostID, SavedAt: saved.SavedAt}
+
+		if saved.Post != nil {
+			post := toPostResponse(*saved.Post)
+			posts[i].Post = &post
+		}
+	}
+
+	return generated.SavedPostListResponse{
+		Posts:      posts,
+		Limit:      limit,
+		NextCursor: optionalString(output.NextCursor),
+	}
+}
+
+func mapAddBookmarkError(err error) generated.PutV1PostsPostIdBookmarkResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PutV1PostsPostIdBookmark400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.PutV1PostsPostIdBookmark404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PutV1PostsPostIdBookmark500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapRemoveBookmarkError(err error) generated.DeleteV1PostsPostIdBookmarkResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.DeleteV1PostsPostIdBookmark400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.DeleteV1PostsPostIdBookmark500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapListBookmarksError(err error) generated.GetV1BookmarksResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.GetV1Bookmarks400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1Bookmarks500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
@@ -0,0 +1,533 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
+	commandpost "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	querypost "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// GetV1Collections handles GET /v1/collections (requires JWT).
+func (h *serverHandler) GetV1Collections(
+	ctx context.Context,
+	_ generated.GetV1CollectionsRequestObject,
+) (generated.GetV1CollectionsResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "listCollections")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.GetV1Collections401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.GetV1Collections400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	output, err := h.ListCollectionsUseCase.Execute(ctx, querypost.ListCollectionsInput{UserID: actorID})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+		return generated.GetV1Collections500ApplicationProblemPlusJSONResponse{
+			InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+		}, nil
+	}
+
+	collections := make([]generated.CollectionResponse, len(output.Collections))
+	for i, c := range output.Collections {
+		collections[i] = generated.CollectionResponse{
+			Id:        c.ID,
+			Name:      c.Name,
+			CreatedAt: c.CreatedAt,
+			UpdatedAt: c.UpdatedAt,
+		}
+	}
+
+	return generated.GetV1Collections200JSONResponse{Collections: collections}, nil
+}
+
+// PostV1Collections handles POST /v1/collections (requires JWT).
+func (h *serverHandler) PostV1Collections(
+	ctx context.Context,
+	req generated.PostV1CollectionsRequestObject,
+) (generated.PostV1CollectionsResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "createCollection")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PostV1Collections401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PostV1Collections400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	output, err := h.CreateCollectionUseCase.Execute(ctx, commandpost.CreateCollectionInput{
+		ActorID: actorID,
+		Name:    req.Body.Name,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapCreateCollectionError(err), nil
+	}
+
+	return generated.PostV1Collections201JSONResponse(toCollectionResponse(output)), nil
+}
+
+// PatchV1CollectionsCollectionId handles PATCH /v1/collections/{collectionId} (requires JWT; owner only).
+func (h *serverHandler) PatchV1CollectionsCollectionId(
+	ctx context.Context,
+	req generated.PatchV1CollectionsCollectionIdRequestObject,
+) (generated.PatchV1CollectionsCollectionIdResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "renameCollection")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PatchV1CollectionsCollectionId401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PatchV1CollectionsCollectionId400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	output, err := h.RenameCollectionUseCase.Execute(ctx, commandpost.RenameCollectionInput{
+		ActorID:      actorID,
+		CollectionID: req.CollectionId,
+		Name:         req.Body.Name,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapRenameCollectionError(err), nil
+	}
+
+	return generated.PatchV1CollectionsCollectionId200JSONResponse(toCollectionResponse(output)), nil
+}
+
+// DeleteV1CollectionsCollectionId handles DELETE /v1/collections/{collectionId} (requires JWT; owner only).
+func (h *serverHandler) DeleteV1CollectionsCollectionId(
+	ctx context.Context,
+	req generated.DeleteV1CollectionsCollectionIdRequestObject,
+) (generated.DeleteV1CollectionsCollectionIdResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "deleteCollection")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.DeleteV1CollectionsCollectionId401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.DeleteV1CollectionsCollectionId400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	err = h.DeleteCollectionUseCase.Execute(ctx, commandpost.DeleteCollectionInput{
+		ActorID:      actorID,
+		CollectionID: req.CollectionId,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapDeleteCollectionError(err), nil
+	}
+
+	return generated.DeleteV1CollectionsCollectionId204Response{}, nil
+}
+
+// GetV1CollectionsCollectionIdPosts handles GET /v1/collections/{collectionId}/posts (requires JWT; owner only).
+func (h *serverHandler) GetV1CollectionsCollectionIdPosts(
+	ctx context.Context,
+	req generated.GetV1CollectionsCollectionIdPostsRequestObject,
+) (generated.GetV1CollectionsCollectionIdPostsResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "listCollectionPosts")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.GetV1CollectionsCollectionIdPosts401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.GetV1CollectionsCollectionIdPosts400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	input := querypost.ListCollectionPostsInput{
+		UserID:       actorID,
+		CollectionID: req.CollectionId,
+		Limit:        defaultPostListLimit,
+	}
+	if req.Params.Limit != nil {
+		input.Limit = *req.Params.Limit
+	}
+
+	if req.Params.After != nil {
+		input.After = *req.Params.After
+	}
+
+	output, err := h.ListCollectionPostsUseCase.Execute(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapListCollectionPostsError(err), nil
+	}
+
+	return generated.GetV1CollectionsCollectionIdPosts200JSONResponse(toSavedPostListResponse(output, input.Limit)), nil
+}
+
+// PutV1CollectionsCollectionIdPostsPostId handles PUT /v1/collections/{collectionId}/posts/{postId}
+// (requires JWT; owner only).
+func (h *serverHandler) PutV1CollectionsCollectionIdPostsPostId(
+	ctx context.Context,
+	req generated.PutV1CollectionsCollectionIdPostsPostIdRequestObject,
+) (generated.PutV1CollectionsCollectionIdPostsPostIdResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "addCollectionPost")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PutV1CollectionsCollectionIdPostsPostId401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PutV1CollectionsCollectionIdPostsPostId400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	err = h.AddCollectionPostUseCase.Execute(ctx, commandpost.AddCollectionPostInput{
+		ActorID:      actorID,
+		CollectionID: req.CollectionId,
+		PostID:       req.PostId,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapAddCollectionPostError(err), nil
+	}
+
+	return generated.PutV1CollectionsCollectionIdPostsPostId204Response{}, nil
+}
+
+// DeleteV1CollectionsCollectionIdPostsPostId handles DELETE /v1/collections/{collectionId}/posts/{postId}
+// (requires JWT; owner only).
+func (h *serverHandler) DeleteV1CollectionsCollectionIdPostsPostId(
+	ctx context.Context,
+	req generated.DeleteV1CollectionsCollectionIdPostsPostIdRequestObject,
+) (generated.DeleteV1CollectionsCollectionIdPostsPostIdResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "removeCollectionPost")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.DeleteV1CollectionsCollectionIdPostsPostId401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.DeleteV1CollectionsCollectionIdPostsPostId400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	err = h.RemoveCollectionPostUseCase.Execute(ctx, commandpost.RemoveCollectionPostInput{
+		ActorID:      actorID,
+		CollectionID: req.CollectionId,
+		PostID:       req.PostId,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapRemoveCollectionPostError(err), nil
+	}
+
+	return generated.DeleteV1CollectionsCollectionIdPostsPostId204Response{}, nil
+}
+
+func toCollectionResponse(output *commandpost.CollectionOutput) generated.CollectionResponse {
+	return generated.CollectionResponse{
+		Id:        output.ID,
+		Name:      output.Name,
+		CreatedAt: output.CreatedAt,
+		UpdatedAt: output.UpdatedAt,
+	}
+}
+
+func mapCreateCollectionError(err error) generated.PostV1CollectionsResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PostV1Collections400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PostV1Collections500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapRenameCollectionError(err error) generated.PatchV1CollectionsCollectionIdResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PatchV1CollectionsCollectionId400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.PatchV1CollectionsCollectionId404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PatchV1CollectionsCollectionId500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapDeleteCollectionError(err error) generated.DeleteV1CollectionsCollectionIdResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.DeleteV1CollectionsCollectionId400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.DeleteV1CollectionsCollectionId404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.DeleteV1CollectionsCollectionId500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapListCollectionPostsError(err error) generated.GetV1CollectionsCollectionIdPostsResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.GetV1CollectionsCollectionIdPosts400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.GetV1CollectionsCollectionIdPosts404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1CollectionsCollectionIdPosts500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapAddCollectionPostError(err error) generated.PutV1CollectionsCollectionIdPostsPostIdResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PutV1CollectionsCollectionIdPostsPostId400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.PutV1CollectionsCollectionIdPostsPostId404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PutV1CollectionsCollectionIdPostsPostId500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapRemoveCollectionPostError(err error) generated.DeleteV1CollectionsCollectionIdPostsPostIdResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.DeleteV1CollectionsCollectionIdPostsPostId400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.DeleteV1CollectionsCollectionIdPostsPostId404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.DeleteV1CollectionsCollectionIdPostsPostId500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
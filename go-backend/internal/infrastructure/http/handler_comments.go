@@ -0,0 +1,305 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
+	commandpost "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	querypost "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const (
+	// defaultCommentListLimit is the page size of comment lists when the request has no limit.
+	defaultCommentListLimit = 20
+	// defaultCommentReplyDepth is how many levels of replies a comment list embeds by default.
+	defaultCommentReplyDepth = 1
+)
+
+// GetV1PostsPostIdComments handles GET /v1/posts/{postId}/comments (requires JWT).
+func (h *serverHandler) GetV1PostsPostIdComments(
+	ctx context.Context,
+	req generated.GetV1PostsPostIdCommentsRequestObject,
+) (generated.GetV1PostsPostIdCommentsResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "listComments")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.GetV1PostsPostIdComments401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	viewerID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.GetV1PostsPostIdComments400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	input := querypost.ListCommentsInput{
+		PostID:   req.PostId,
+		ViewerID: viewerID,
+		ParentID: req.Params.ParentId,
+		Limit:    defaultCommentListLimit,
+		Depth:    defaultCommentReplyDepth,
+	}
+
+	if req.Params.Limit != nil {
+		input.Limit = *req.Params.Limit
+	}
+
+	if req.Params.After != nil {
+		input.After = *req.Params.After
+	}
+
+	if req.Params.Depth != nil {
+		input.Depth = *req.Params.Depth
+	}
+
+	output, err := h.ListCommentsUseCase.Execute(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapListCommentsError(err), nil
+	}
+
+	resp := generated.GetV1PostsPostIdComments200JSONResponse{
+		Comments: toCommentResponses(output.Comments),
+		Limit:    input.Limit,
+	}
+
+	if output.NextCursor != "" {
+		resp.NextCursor = &output.NextCursor
+	}
+
+	return resp, nil
+}
+
+// PostV1PostsPostIdComments handles POST /v1/posts/{postId}/comments (requires JWT).
+func (h *serverHandler) PostV1PostsPostIdComments(
+	ctx context.Context,
+	req generated.PostV1PostsPostIdCommentsRequestObject,
+) (generated.PostV1PostsPostIdCommentsResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "createComment")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PostV1PostsPostIdComments401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PostV1PostsPostIdComments400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	output, err := h.CreateCommentUseCase.Execute(ctx, commandpost.CreateCommentInput{
+		ActorID:  actorID,
+		PostID:   req.PostId,
+		ParentID: req.Body.ParentId,
+		Content:  req.Body.Content,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapCreateCommentError(err), nil
+	}
+
+	return generated.PostV1PostsPostIdComments201JSONResponse{
+		Id:        output.ID,
+		PostId:    output.PostID,
+		ParentId:  output.ParentID,
+		UserId:    output.UserID,
+		Content:   output.Content,
+		Depth:     output.Depth,
+		CreatedAt: output.CreatedAt,
+	}, nil
+}
+
+// DeleteV1PostsPostIdCommentsCommentId handles DELETE /v1/posts/{postId}/comments/{commentId}
+// (requires JWT; author or posts:moderate).
+func (h *serverHandler) DeleteV1PostsPostIdCommentsCommentId(
+	ctx context.Context,
+	req generated.DeleteV1PostsPostIdCommentsCommentIdRequestObject,
+) (generated.DeleteV1PostsPostIdCommentsCommentIdResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "deleteComment")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.DeleteV1PostsPostIdCommentsCommentId401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.DeleteV1PostsPostIdCommentsCommentId400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	err = h.DeleteCommentUseCase.Execute(ctx, commandpost.DeleteCommentInput{
+		ActorID:   actorID,
+		PostID:    req.PostId,
+		CommentID: req.CommentId,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapDeleteCommentError(err), nil
+	}
+
+	return generated.DeleteV1PostsPostIdCommentsCommentId204Response{}, nil
+}
+
+func toCommentResponses(comments []querypost.CommentDto) []generated.CommentResponse {
+	resp := make([]generated.CommentResponse, len(comments))
+	for i, c := range comments {
+		resp[i] = generated.CommentResponse{
+			Id:         c.ID,
+			PostId:     c.PostID,
+			ParentId:   c.ParentID,
+			UserId:     c.UserID,
+			Content:    c.Content,
+			Depth:      c.Depth,
+			CreatedAt:  c.CreatedAt,
+			Author:     &generated.PostAuthor{Id: c.UserID, Name: c.AuthorName},
+			ReplyCount: &c.ReplyCount,
+		}
+
+		if c.Replies != nil {
+			replies := toCommentResponses(c.Replies)
+			resp[i].Replies = &replies
+		}
+	}
+
+	return resp
+}
+
+func mapListCommentsError(err error) generated.GetV1PostsPostIdCommentsResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.GetV1PostsPostIdComments400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					validationProblemFromDomain(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.GetV1PostsPostIdComments404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1PostsPostIdComments500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapCreateCommentError(err error) generated.PostV1PostsPostIdCommentsResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PostV1PostsPostIdComments400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					validationProblemFromDomain(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.PostV1PostsPostIdComments404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PostV1PostsPostIdComments500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapDeleteCommentError(err error) generated.DeleteV1PostsPostIdCommentsCommentIdResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ForbiddenErrorCode:
+			return generated.DeleteV1PostsPostIdCommentsCommentId403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: generated.ForbiddenApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.DeleteV1PostsPostIdCommentsCommentId404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.DeleteV1PostsPostIdCommentsCommentId500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
@@ -0,0 +1,285 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
+	commanduser "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
+	queryuser "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// defaultFollowListLimit is the page size of follow lists when the request has no limit.
+const defaultFollowListLimit = 20
+
+// PutV1UsersUserIdFollow handles PUT /v1/users/{userId}/follow (requires JWT).
+func (h *serverHandler) PutV1UsersUserIdFollow(
+	ctx context.Context,
+	req generated.PutV1UsersUserIdFollowRequestObject,
+) (generated.PutV1UsersUserIdFollowResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "followUser")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PutV1UsersUserIdFollow401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	input := commanduser.FollowUserInput{UserID: req.UserId}
+
+	var err error
+	if input.ActorID, err = uuid.Parse(userIDStr); err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PutV1UsersUserIdFollow400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	if err = h.FollowUserUseCase.Execute(ctx, input); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapFollowUserError(err), nil
+	}
+
+	return generated.PutV1UsersUserIdFollow204Response{}, nil
+}
+
+// DeleteV1UsersUserIdFollow handles DELETE /v1/users/{userId}/follow (requires JWT).
+func (h *serverHandler) DeleteV1UsersUserIdFollow(
+	ctx context.Context,
+	req generated.DeleteV1UsersUserIdFollowRequestObject,
+) (generated.DeleteV1UsersUserIdFollowResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "unfollowUser")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.DeleteV1UsersUserIdFollow401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	input := commanduser.UnfollowUserInput{UserID: req.UserId}
+
+	var err error
+	if input.ActorID, err = uuid.Parse(userIDStr); err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.DeleteV1UsersUserIdFollow400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	if err = h.UnfollowUserUseCase.Execute(ctx, input); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+		return generated.DeleteV1UsersUserIdFollow500ApplicationProblemPlusJSONResponse{
+			InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+		}, nil
+	}
+
+	return generated.DeleteV1UsersUserIdFollow204Response{}, nil
+}
+
+// GetV1UsersUserIdFollowers handles GET /v1/users/{userId}/followers (requires JWT).
+func (h *serverHandler) GetV1UsersUserIdFollowers(
+	ctx context.Context,
+	req generated.GetV1UsersUserIdFollowersRequestObject,
+) (generated.GetV1UsersUserIdFollowersResponseObject, error) {
+	if common.UserIDFromContext(ctx) == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+
+		return generated.GetV1UsersUserIdFollowers401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	resp, err := h.listFollows(ctx, toListFollowsInput(
+		req.UserId, queryuser.FollowDirectionFollowers, req.Params.Limit, req.Params.After,
+	))
+	if err != nil {
+		return mapListFollowersError(err), nil
+	}
+
+	return generated.GetV1UsersUserIdFollowers200JSONResponse(resp), nil
+}
+
+// GetV1UsersUserIdFollowing handles GET /v1/users/{userId}/following (requires JWT).
+func (h *serverHandler) GetV1UsersUserIdFollowing(
+	ctx context.Context,
+	req generated.GetV1UsersUserIdFollowingRequestObject,
+) (generated.GetV1UsersUserIdFollowingResponseObject, error) {
+	if common.UserIDFromContext(ctx) == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+
+		return generated.GetV1UsersUserIdFollowing401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	resp, err := h.listFollows(ctx, toListFollowsInput(
+		req.UserId, queryuser.FollowDirectionFollowing, req.Params.Limit, req.Params.After,
+	))
+	if err != nil {
+		return mapListFollowingError(err), nil
+	}
+
+	return generated.GetV1UsersUserIdFollowing200JSONResponse(resp), nil
+}
+
+// listFollows runs the list-follows use case for both follow-list endpoints inside one span.
+func (h *serverHandler) listFollows(
+	ctx context.Context, input queryuser.ListFollowsInput,
+) (generated.FollowListResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "listFollows")
+	defer span.End()
+
+	output, err := h.ListFollowsUseCase.Execute(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return generated.FollowListResponse{}, err
+	}
+
+	users := make([]generated.FollowResponse, len(output.Follows))
+	for i, f := range output.Follows {
+		users[i] = generated.FollowResponse{Id: f.UserID, Name: f.Name, FollowedAt: f.FollowedAt}
+	}
+
+	return generated.FollowListResponse{
+		Users:      users,
+		Limit:      input.Limit,
+		NextCursor: optionalString(output.NextCursor),
+	}, nil
+}
+
+// toListFollowsInput applies the defaults of the follow-list parameters.
+func toListFollowsInput(
+	userID uuid.UUID, direction queryuser.FollowDirection, limit *int, after *string,
+) queryuser.ListFollowsInput {
+	input := queryuser.ListFollowsInput{UserID: userID, Direction: direction, Limit: defaultFollowListLimit}
+	if limit != nil {
+		input.Limit = *limit
+	}
+
+	if after != nil {
+		input.After = *after
+	}
+
+	return input
+}
+
+func mapFollowUserError(err error) generated.PutV1UsersUserIdFollowResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PutV1UsersUserIdFollow400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.PutV1UsersUserIdFollow404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PutV1UsersUserIdFollow500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapListFollowersError(err error) generated.GetV1UsersUserIdFollowersResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.GetV1UsersUserIdFollowers400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					validationProblemFromDomain(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.GetV1UsersUserIdFollowers404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1UsersUserIdFollowers500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapListFollowingError(err error) generated.GetV1UsersUserIdFollowingResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.GetV1UsersUserIdFollowing400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					validationProblemFromDomain(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.GetV1UsersUserIdFollowing404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1UsersUserIdFollowing500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
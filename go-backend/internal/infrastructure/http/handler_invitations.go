@@ -0,0 +1,147 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
+	commandadmin "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/admin"
+	queryuser "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// PostV1Invitations handles POST /v1/invitations (requires JWT and users:invite permission).
+func (h *serverHandler) PostV1Invitations(
+	ctx context.Context,
+	req generated.PostV1InvitationsRequestObject,
+) (generated.PostV1InvitationsResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "createInvitation")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PostV1Invitations401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PostV1Invitations400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	var roleIDs []uuid.UUID
+	if req.Body.RoleIds != nil {
+		roleIDs = *req.Body.RoleIds
+	}
+
+	output, err := h.CreateInvitationUseCase.Execute(ctx, commandadmin.CreateInvitationInput{
+		ActorID:   actorID,
+		Email:     string(req.Body.Email),
+		RoleIDs:   roleIDs,
+		ExpiresAt: req.Body.ExpiresAt,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapCreateInvitationError(err), nil
+	}
+
+	return generated.PostV1Invitations201JSONResponse{
+		Id:        output.ID,
+		Email:     openapi_types.Email(output.Email),
+		RoleIds:   output.RoleIDs,
+		ExpiresAt: output.ExpiresAt,
+		Token:     output.Token,
+	}, nil
+}
+
+// GetV1InvitationsToken handles GET /v1/invitations/{token} (public; the token is the credential).
+func (h *serverHandler) GetV1InvitationsToken(
+	ctx context.Context,
+	req generated.GetV1InvitationsTokenRequestObject,
+) (generated.GetV1InvitationsTokenResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "getInvitation")
+	defer span.End()
+
+	output, err := h.GetInvitationUseCase.Execute(ctx, queryuser.GetInvitationInput{Token: req.Token})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapGetInvitationError(err), nil
+	}
+
+	return generated.GetV1InvitationsToken200JSONResponse{
+		Email:     openapi_types.Email(output.Email),
+		ExpiresAt: output.ExpiresAt,
+	}, nil
+}
+
+func mapCreateInvitationError(err error) generated.PostV1InvitationsResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PostV1Invitations400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.UnauthorizedErrorCode, vo.InvalidCredentialErrorCode:
+			return generated.PostV1Invitations401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.ForbiddenErrorCode:
+			return generated.PostV1Invitations403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: generated.ForbiddenApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PostV1Invitations500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapGetInvitationError(err error) generated.GetV1InvitationsTokenResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) && domainErr.Code() == vo.ValidationErrorCode {
+		return generated.GetV1InvitationsToken400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				domainErrToProblem(domainErr),
+			),
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1InvitationsToken500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
@@ -0,0 +1,313 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
+	commandpost "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	querypost "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// PostV1PostsPostIdReports handles POST /v1/posts/{postId}/reports (requires JWT).
+func (h *serverHandler) PostV1PostsPostIdReports(
+	ctx context.Context,
+	req generated.PostV1PostsPostIdReportsRequestObject,
+) (generated.PostV1PostsPostIdReportsResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "reportPost")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PostV1PostsPostIdReports401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	reporterID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PostV1PostsPostIdReports400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	input := commandpost.ReportPostInput{
+		ReporterID: reporterID,
+		PostID:     req.PostId,
+		Reason:     string(req.Body.Reason),
+	}
+	if req.Body.Details != nil {
+		input.Details = *req.Body.Details
+	}
+
+	output, err := h.ReportPostUseCase.Execute(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapReportPostError(err), nil
+	}
+
+	return generated.PostV1PostsPostIdReports201JSONResponse{
+		Id:        output.ID,
+		PostId:    output.PostID,
+		Reason:    generated.ReportReason(output.Reason),
+		Details:   output.Details,
+		CreatedAt: output.CreatedAt,
+	}, nil
+}
+
+// GetV1ModerationQueue handles GET /v1/moderation/queue (requires JWT and posts:moderate permission).
+func (h *serverHandler) GetV1ModerationQueue(
+	ctx context.Context,
+	req generated.GetV1ModerationQueueRequestObject,
+) (generated.GetV1ModerationQueueResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "listModerationQueue")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.GetV1ModerationQueue401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	moderatorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.GetV1ModerationQueue400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	limit := 20
+	if req.Params.Limit != nil {
+		limit = *req.Params.Limit
+	}
+
+	offset := 0
+	if req.Params.Offset != nil {
+		offset = *req.Params.Offset
+	}
+
+	output, err := h.ListModerationQueueUseCase.Execute(ctx, querypost.ListModerationQueueInput{
+		ModeratorID: moderatorID,
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapListModerationQueueError(err), nil
+	}
+
+	items := make([]generated.ModerationQueueItem, len(output.Items))
+	for i, item := range output.Items {
+		items[i] = toModerationQueueItem(item)
+	}
+
+	return generated.GetV1ModerationQueue200JSONResponse{
+		Items:  items,
+		Total:  output.Total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+// PostV1ModerationPostsPostIdActions handles POST /v1/moderation/posts/{postId}/actions (requires JWT and
+// posts:moderate permission; refuses impersonation).
+func (h *serverHandler) PostV1ModerationPostsPostIdActions(
+	ctx context.Context,
+	req generated.PostV1ModerationPostsPostIdActionsRequestObject,
+) (generated.PostV1ModerationPostsPostIdActionsResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "moderatePost")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PostV1ModerationPostsPostIdActions401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	moderatorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PostV1ModerationPostsPostIdActions400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	output, err := h.ModeratePostUseCase.Execute(ctx, commandpost.ModeratePostInput{
+		ModeratorID: moderatorID,
+		PostID:      req.PostId,
+		Action:      string(req.Body.Action),
+		Reason:      req.Body.Reason,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapModeratePostError(err), nil
+	}
+
+	return generated.PostV1ModerationPostsPostIdActions201JSONResponse{
+		Id:              output.ID,
+		PostId:          output.PostID,
+		TargetUserId:    output.TargetUserID,
+		Action:          generated.ModerationActionType(output.Action),
+		Reason:          output.Reason,
+		ResolvedReports: output.ResolvedReports,
+		CreatedAt:       output.CreatedAt,
+	}, nil
+}
+
+func toModerationQueueItem(item querypost.ModerationQueueItemDto) generated.ModerationQueueItem {
+	reports := make([]generated.PostReportSummary, len(item.Reports))
+	for i, r := range item.Reports {
+		reports[i] = generated.PostReportSummary{
+			Id:         r.ID,
+			ReporterId: r.ReporterID,
+			Reason:     generated.ReportReason(r.Reason),
+			Details:    r.Details,
+			CreatedAt:  r.CreatedAt,
+		}
+	}
+
+	return generated.ModerationQueueItem{
+		Post: generated.ModerationQueuePost{
+			Id:        item.PostID,
+			Author:    generated.PostAuthor{Id: item.AuthorID, Name: item.AuthorName},
+			Content:   item.Content,
+			Hidden:    item.Hidden,
+			CreatedAt: item.CreatedAt,
+		},
+		ReportCount:     item.ReportCount,
+		FirstReportedAt: item.FirstReportedAt,
+		LastReportedAt:  item.LastReportedAt,
+		Reports:         reports,
+	}
+}
+
+func mapReportPostError(err error) generated.PostV1PostsPostIdReportsResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PostV1PostsPostIdReports400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.PostV1PostsPostIdReports404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PostV1PostsPostIdReports500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapListModerationQueueError(err error) generated.GetV1ModerationQueueResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.GetV1ModerationQueue400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					validationProblemFromDomain(domainErr),
+				),
+			}
+		case vo.ForbiddenErrorCode:
+			return generated.GetV1ModerationQueue403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: generated.ForbiddenApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1ModerationQueue500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapModeratePostError(err error) generated.PostV1ModerationPostsPostIdActionsResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PostV1ModerationPostsPostIdActions400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.ForbiddenErrorCode:
+			return generated.PostV1ModerationPostsPostIdActions403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: generated.ForbiddenApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.PostV1ModerationPostsPostIdActions404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PostV1ModerationPostsPostIdActions500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
@@ -0,0 +1,188 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
+	commandorganization "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/organization"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// PostV1Organizations handles POST /v1/organizations (requires JWT).
+func (h *serverHandler) PostV1Organizations(
+	ctx context.Context,
+	req generated.PostV1OrganizationsRequestObject,
+) (generated.PostV1OrganizationsResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "createOrganization")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PostV1Organizations401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PostV1Organizations400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	output, err := h.CreateOrganizationUseCase.Execute(ctx, commandorganization.CreateOrganizationInput{
+		UserID: userID,
+		Name:   req.Body.Name,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapCreateOrganizationError(err), nil
+	}
+
+	return generated.PostV1Organizations201JSONResponse{
+		Id:        output.ID,
+		Name:      output.Name,
+		CreatedAt: output.CreatedAt,
+	}, nil
+}
+
+// PostV1OrganizationsOrganizationIdMembers handles POST /v1/organizations/{organizationId}/members
+// (requires JWT, the organization to be active, and members:add permission in it).
+func (h *serverHandler) PostV1OrganizationsOrganizationIdMembers(
+	ctx context.Context,
+	req generated.PostV1OrganizationsOrganizationIdMembersRequestObject,
+) (generated.PostV1OrganizationsOrganizationIdMembersResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "addMember")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PostV1OrganizationsOrganizationIdMembers401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PostV1OrganizationsOrganizationIdMembers400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapAddMemberError(err), nil
+	}
+
+	output, err := h.AddMemberUseCase.Execute(ctx, commandorganization.AddMemberInput{
+		ActorID:        actorID,
+		TenantID:       tenantID,
+		OrganizationID: req.OrganizationId,
+		UserID:         req.Body.UserId,
+		RoleID:         req.Body.RoleId,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapAddMemberError(err), nil
+	}
+
+	return generated.PostV1OrganizationsOrganizationIdMembers201JSONResponse{
+		OrganizationId: output.OrganizationID,
+		UserId:         output.UserID,
+		RoleId:         output.RoleID,
+		JoinedAt:       output.JoinedAt,
+	}, nil
+}
+
+func mapCreateOrganizationError(err error) generated.PostV1OrganizationsResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PostV1Organizations400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					validationProblemFromDomain(domainErr),
+				),
+			}
+		case vo.UnauthorizedErrorCode, vo.InvalidCredentialErrorCode:
+			return generated.PostV1Organizations401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PostV1Organizations500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapAddMemberError(err error) generated.PostV1OrganizationsOrganizationIdMembersResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PostV1OrganizationsOrganizationIdMembers400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.UnauthorizedErrorCode, vo.InvalidCredentialErrorCode:
+			return generated.PostV1OrganizationsOrganizationIdMembers401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.ForbiddenErrorCode:
+			return generated.PostV1OrganizationsOrganizationIdMembers403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: generated.ForbiddenApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PostV1OrganizationsOrganizationIdMembers500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
@@ -0,0 +1,123 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"iter"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
+	querypost "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// GetV1PostsExport handles GET /v1/posts:export (requires JWT and posts:export).
+func (h *serverHandler) GetV1PostsExport(
+	ctx context.Context,
+	_ generated.GetV1PostsExportRequestObject,
+) (generated.GetV1PostsExportResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "exportPosts")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.GetV1PostsExport401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.GetV1PostsExport400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	output, err := h.ExportPostsUseCase.Execute(ctx, querypost.ExportPostsInput{ActorID: actorID})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapExportPostsError(err), nil
+	}
+
+	return generated.GetV1PostsExport200ApplicationxNdjsonResponse{
+		Body: h.exportedPostLines(ctx, output.Posts),
+	}, nil
+}
+
+// exportedPostLines encodes the posts as NDJSON while the response is being written. The status has been
+// sent by the time a page fails to load, so the error can only cut the response short; the generated
+// response closes the reader when it is done, which also stops the encoding when the client goes away.
+func (h *serverHandler) exportedPostLines(
+	ctx context.Context, posts iter.Seq2[querypost.ExportedPostDto, error],
+) io.ReadCloser {
+	reader, writer := io.Pipe()
+
+	go func() {
+		encoder := json.NewEncoder(writer)
+
+		for post, err := range posts {
+			if err == nil {
+				err = encoder.Encode(toExportedPost(post))
+			}
+
+			if err != nil {
+				if !errors.Is(err, io.ErrClosedPipe) {
+					h.logger.Error(ctx, "post export ended early", "error", err)
+				}
+
+				writer.CloseWithError(err)
+
+				return
+			}
+		}
+
+		writer.Close()
+	}()
+
+	return reader
+}
+
+func toExportedPost(post querypost.ExportedPostDto) generated.ExportedPost {
+	return generated.ExportedPost{
+		Id:         post.ID,
+		UserId:     post.UserID,
+		Content:    post.Content,
+		Format:     generated.ContentFormat(post.Format),
+		Visibility: generated.PostVisibility(post.Visibility),
+		CreatedAt:  post.CreatedAt,
+	}
+}
+
+func mapExportPostsError(err error) generated.GetV1PostsExportResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) && domainErr.Code() == vo.ForbiddenErrorCode {
+		return generated.GetV1PostsExport403ApplicationProblemPlusJSONResponse{
+			ForbiddenApplicationProblemPlusJSONResponse: generated.ForbiddenApplicationProblemPlusJSONResponse(
+				domainErrToProblem(domainErr),
+			),
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1PostsExport500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
@@ -13,12 +13,689 @@ import (
 	"go.opentelemetry.io/otel/codes"
 )
 
+// defaultPostListLimit is the page size of post lists when the request has no limit.
+const defaultPostListLimit = 20
+
 // PostV1Posts handles POST /v1/posts (requires JWT).
 func (h *serverHandler) PostV1Posts(
 	ctx context.Context,
-	req generated.PostV1PostsRequestObject,
-) (generated.PostV1PostsResponseObject, error) {
-	ctx, span := h.tracer.Start(ctx, "createPost")
+	req generated.PostV1PostsRequestObject,
+) (generated.PostV1PostsResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "createPost")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PostV1Posts401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PostV1Posts400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	input := commandpost.CreatePostInput{
+		UserID:    userID,
+		Content:   req.Body.Content,
+		PublishAt: req.Body.PublishAt,
+		// A quote is created like any other post, below which the quoted post is shared.
+		QuotedPostID: req.Body.QuotedPostId,
+	}
+
+	if req.Body.Format != nil {
+		input.Format = string(*req.Body.Format)
+	}
+
+	if req.Body.AttachmentIds != nil {
+		input.AttachmentIDs = *req.Body.AttachmentIds
+	}
+
+	if req.Body.Visibility != nil {
+		input.Visibility = string(*req.Body.Visibility)
+	}
+
+	if req.Body.Draft != nil {
+		input.Draft = *req.Body.Draft
+	}
+
+	if poll := req.Body.Poll; poll != nil {
+		input.Poll = &commandpost.PollInput{Options: poll.Options, ClosesAt: poll.ClosesAt}
+		if poll.Multiple != nil {
+			input.Poll.Multiple = *poll.Multiple
+		}
+	}
+
+	output, err := h.CreatePostUseCase.Execute(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapCreatePostError(err), nil
+	}
+
+	kind := generated.PostKind(output.Kind)
+
+	return generated.PostV1Posts201JSONResponse{
+		Id:            output.ID,
+		UserId:        output.UserID,
+		Content:       output.Content,
+		Format:        generated.ContentFormat(output.Format),
+		ContentHtml:   output.ContentHTML,
+		Visibility:    generated.PostVisibility(output.Visibility),
+		Status:        generated.PostStatus(output.Status),
+		PublishAt:     output.PublishAt,
+		CreatedAt:     output.CreatedAt,
+		UpdatedAt:     output.UpdatedAt,
+		Edited:        false,
+		RevisionCount: output.RevisionCount,
+		Kind:          &kind,
+		Attachments:   toCreatedPostAttachments(output.Attachments),
+		Poll:          toCreatedPostPoll(output.Poll),
+	}, nil
+}
+
+// toCreatedPostPoll shows a new poll as any caller who has not voted sees it: without counts.
+func toCreatedPostPoll(poll *commandpost.PollOutput) *generated.Poll {
+	if poll == nil {
+		return nil
+	}
+
+	options := make([]generated.PollOption, len(poll.Options))
+	for i, option := range poll.Options {
+		options[i] = generated.PollOption{Id: option.ID, Label: option.Label}
+	}
+
+	return &generated.Poll{
+		Multiple:      poll.Multiple,
+		ClosesAt:      poll.ClosesAt,
+		ResultsHidden: true,
+		Options:       options,
+		MyVotes:       []uuid.UUID{},
+	}
+}
+
+// GetV1Posts handles GET /v1/posts (requires JWT).
+func (h *serverHandler) GetV1Posts(
+	ctx context.Context,
+	req generated.GetV1PostsRequestObject,
+) (generated.GetV1PostsResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "listPosts")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.GetV1Posts401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	viewerID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.GetV1Posts400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	input := toListPostsInput(req.Params)
+	input.ViewerID = viewerID
+
+	if req.Params.AuthorId != nil {
+		input.Filter.AuthorIDs = *req.Params.AuthorId
+	}
+
+	output, err := h.ListPostsUseCase.Execute(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapListPostsError(err), nil
+	}
+
+	return generated.GetV1Posts200JSONResponse(toPostListResponse(output, input)), nil
+}
+
+// GetV1UsersUserIdPosts handles GET /v1/users/{userId}/posts (requires JWT).
+func (h *serverHandler) GetV1UsersUserIdPosts(
+	ctx context.Context,
+	req generated.GetV1UsersUserIdPostsRequestObject,
+) (generated.GetV1UsersUserIdPostsResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "listUserPosts")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.GetV1UsersUserIdPosts401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	viewerID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.GetV1UsersUserIdPosts400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	// The user timeline accepts the same paging parameters as GET /v1/posts.
+	page := toListPostsInput(generated.GetV1PostsParams{
+		Limit:         req.Params.Limit,
+		Offset:        req.Params.Offset,
+		After:         req.Params.After,
+		Before:        req.Params.Before,
+		IncludeTotal:  req.Params.IncludeTotal,
+		CreatedAfter:  req.Params.CreatedAfter,
+		CreatedBefore: req.Params.CreatedBefore,
+	})
+	page.ViewerID = viewerID
+
+	output, err := h.ListUserPostsUseCase.Execute(ctx, querypost.ListUserPostsInput{
+		AuthorID: req.UserId,
+		Page:     page,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapListUserPostsError(err), nil
+	}
+
+	return generated.GetV1UsersUserIdPosts200JSONResponse(toPostListResponse(output, page)), nil
+}
+
+// GetV1HashtagsTagPosts handles GET /v1/hashtags/{tag}/posts (requires JWT).
+func (h *serverHandler) GetV1HashtagsTagPosts(
+	ctx context.Context,
+	req generated.GetV1HashtagsTagPostsRequestObject,
+) (generated.GetV1HashtagsTagPostsResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "listHashtagPosts")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.GetV1HashtagsTagPosts401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	// The hashtag listing accepts the same paging parameters as GET /v1/posts.
+	input := querypost.ListHashtagPostsInput{
+		Hashtag: req.Tag,
+		Page: toListPostsInput(generated.GetV1PostsParams{
+			Limit:         req.Params.Limit,
+			Offset:        req.Params.Offset,
+			After:         req.Params.After,
+			Before:        req.Params.Before,
+			IncludeTotal:  req.Params.IncludeTotal,
+			CreatedAfter:  req.Params.CreatedAfter,
+			CreatedBefore: req.Params.CreatedBefore,
+		}),
+	}
+
+	var err error
+	if input.Page.ViewerID, err = uuid.Parse(userIDStr); err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.GetV1HashtagsTagPosts400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	output, err := h.ListHashtagPostsUseCase.Execute(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapListHashtagPostsError(err), nil
+	}
+
+	return generated.GetV1HashtagsTagPosts200JSONResponse(toPostListResponse(output, input.Page)), nil
+}
+
+// toListPostsInput applies the defaults of the list parameters; the author filter is left to the caller.
+func toListPostsInput(params generated.GetV1PostsParams) querypost.ListPostsInput {
+	input := querypost.ListPostsInput{
+		Limit:        defaultPostListLimit,
+		IncludeTotal: params.IncludeTotal,
+		Filter: querypost.PostFilter{
+			CreatedAfter:  params.CreatedAfter,
+			CreatedBefore: params.CreatedBefore,
+			Drafts:        params.Status != nil && *params.Status == generated.Draft,
+		},
+	}
+	if params.Limit != nil {
+		input.Limit = *params.Limit
+	}
+
+	if params.Offset != nil {
+		input.Offset = *params.Offset
+	}
+
+	if params.After != nil {
+		input.After = *params.After
+	}
+
+	if params.Before != nil {
+		input.Before = *params.Before
+	}
+
+	return input
+}
+
+func toPostListResponse(output *querypost.ListPostsOutput, input querypost.ListPostsInput) generated.PostListResponse {
+	posts := make([]generated.PostResponse, len(output.Posts))
+	for i, p := range output.Posts {
+		posts[i] = toPostResponse(p)
+	}
+
+	return generated.PostListResponse{
+		Posts:      posts,
+		Total:      output.Total,
+		Limit:      input.Limit,
+		Offset:     input.Offset,
+		NextCursor: optionalString(output.NextCursor),
+		PrevCursor: optionalString(output.PrevCursor),
+	}
+}
+
+// GetV1Timeline handles GET /v1/timeline (requires JWT).
+func (h *serverHandler) GetV1Timeline(
+	ctx context.Context,
+	req generated.GetV1TimelineRequestObject,
+) (generated.GetV1TimelineResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "listTimeline")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.GetV1Timeline401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	input := querypost.ListTimelineInput{Limit: defaultPostListLimit}
+	if req.Params.Limit != nil {
+		input.Limit = *req.Params.Limit
+	}
+
+	if req.Params.After != nil {
+		input.After = *req.Params.After
+	}
+
+	var err error
+	if input.ViewerID, err = uuid.Parse(userIDStr); err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.GetV1Timeline400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	output, err := h.ListTimelineUseCase.Execute(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapListTimelineError(err), nil
+	}
+
+	posts := make([]generated.PostResponse, len(output.Posts))
+	for i, p := range output.Posts {
+		posts[i] = toPostResponse(p)
+	}
+
+	return generated.GetV1Timeline200JSONResponse{
+		Posts:      posts,
+		Limit:      input.Limit,
+		NextCursor: optionalString(output.NextCursor),
+	}, nil
+}
+
+// GetV1PostsSearch handles GET /v1/posts/search (requires JWT).
+func (h *serverHandler) GetV1PostsSearch(
+	ctx context.Context,
+	req generated.GetV1PostsSearchRequestObject,
+) (generated.GetV1PostsSearchResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "searchPosts")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.GetV1PostsSearch401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	viewerID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.GetV1PostsSearch400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	input := querypost.SearchPostsInput{Query: req.Params.Q, Limit: defaultPostListLimit, ViewerID: viewerID}
+	if req.Params.Limit != nil {
+		input.Limit = *req.Params.Limit
+	}
+
+	if req.Params.Offset != nil {
+		input.Offset = *req.Params.Offset
+	}
+
+	output, err := h.SearchPostsUseCase.Execute(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapSearchPostsError(err), nil
+	}
+
+	results := make([]generated.PostSearchResult, len(output.Results))
+	for i, result := range output.Results {
+		snippet := make([]generated.SnippetSegment, len(result.Snippet))
+		for j, segment := range result.Snippet {
+			snippet[j] = generated.SnippetSegment{Text: segment.Text, Highlighted: segment.Highlighted}
+		}
+
+		results[i] = generated.PostSearchResult{Post: toPostResponse(result.Post), Rank: result.Rank, Snippet: snippet}
+	}
+
+	return generated.GetV1PostsSearch200JSONResponse{
+		Results: results,
+		HasMore: output.HasMore,
+		Limit:   input.Limit,
+		Offset:  input.Offset,
+	}, nil
+}
+
+// GetV1PostsPostId handles GET /v1/posts/{postId} (requires JWT).
+func (h *serverHandler) GetV1PostsPostId(
+	ctx context.Context,
+	req generated.GetV1PostsPostIdRequestObject,
+) (generated.GetV1PostsPostIdResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "getPost")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.GetV1PostsPostId401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	viewerID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.GetV1PostsPostId400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	output, err := h.GetPostUseCase.Execute(ctx, querypost.GetPostInput{PostID: req.PostId, ViewerID: viewerID})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapGetPostError(err), nil
+	}
+
+	return generated.GetV1PostsPostId200JSONResponse(toPostResponse(*output)), nil
+}
+
+// PatchV1PostsPostId handles PATCH /v1/posts/{postId} (requires JWT; author or posts:moderate).
+func (h *serverHandler) PatchV1PostsPostId(
+	ctx context.Context,
+	req generated.PatchV1PostsPostIdRequestObject,
+) (generated.PatchV1PostsPostIdResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "updatePost")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PatchV1PostsPostId401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PatchV1PostsPostId400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	input := commandpost.UpdatePostInput{
+		ActorID: actorID,
+		PostID:  req.PostId,
+		Content: req.Body.Content,
+	}
+
+	if req.Body.Format != nil {
+		input.Format = string(*req.Body.Format)
+	}
+
+	output, err := h.UpdatePostUseCase.Execute(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapUpdatePostError(err), nil
+	}
+
+	return generated.PatchV1PostsPostId200JSONResponse{
+		Id:            output.ID,
+		UserId:        output.UserID,
+		Content:       output.Content,
+		Format:        generated.ContentFormat(output.Format),
+		ContentHtml:   output.ContentHTML,
+		Visibility:    generated.PostVisibility(output.Visibility),
+		Status:        generated.PostStatus(output.Status),
+		PublishAt:     output.PublishAt,
+		CreatedAt:     output.CreatedAt,
+		UpdatedAt:     output.UpdatedAt,
+		Edited:        output.RevisionCount > 1,
+		RevisionCount: output.RevisionCount,
+	}, nil
+}
+
+// DeleteV1PostsPostId handles DELETE /v1/posts/{postId} (requires JWT; author or posts:moderate).
+func (h *serverHandler) DeleteV1PostsPostId(
+	ctx context.Context,
+	req generated.DeleteV1PostsPostIdRequestObject,
+) (generated.DeleteV1PostsPostIdResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "deletePost")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.DeleteV1PostsPostId401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.DeleteV1PostsPostId400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	err = h.DeletePostUseCase.Execute(ctx, commandpost.DeletePostInput{
+		ActorID: actorID,
+		PostID:  req.PostId,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapDeletePostError(err), nil
+	}
+
+	return generated.DeleteV1PostsPostId204Response{}, nil
+}
+
+// PostV1PostsPostIdPublish handles POST /v1/posts/{postId}/publish (requires JWT; author only).
+func (h *serverHandler) PostV1PostsPostIdPublish(
+	ctx context.Context,
+	req generated.PostV1PostsPostIdPublishRequestObject,
+) (generated.PostV1PostsPostIdPublishResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "publishPost")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PostV1PostsPostIdPublish401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PostV1PostsPostIdPublish400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	output, err := h.PublishPostUseCase.Execute(ctx, commandpost.PublishPostInput{
+		ActorID: actorID,
+		PostID:  req.PostId,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapPublishPostError(err), nil
+	}
+
+	return generated.PostV1PostsPostIdPublish200JSONResponse{
+		Id:            output.ID,
+		UserId:        output.UserID,
+		Content:       output.Content,
+		Format:        generated.ContentFormat(output.Format),
+		ContentHtml:   output.ContentHTML,
+		Visibility:    generated.PostVisibility(output.Visibility),
+		Status:        generated.PostStatus(output.Status),
+		CreatedAt:     output.CreatedAt,
+		UpdatedAt:     output.UpdatedAt,
+		Edited:        output.RevisionCount > 1,
+		RevisionCount: output.RevisionCount,
+	}, nil
+}
+
+// GetV1PostsPostIdRevisions handles GET /v1/posts/{postId}/revisions (requires JWT).
+func (h *serverHandler) GetV1PostsPostIdRevisions(
+	ctx context.Context,
+	req generated.GetV1PostsPostIdRevisionsRequestObject,
+) (generated.GetV1PostsPostIdRevisionsResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "listPostRevisions")
 	defer span.End()
 
 	userIDStr := common.UserIDFromContext(ctx)
@@ -26,103 +703,216 @@ func (h *serverHandler) PostV1Posts(
 		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
 		span.SetStatus(codes.Error, "missing user ID in context")
 
-		return generated.PostV1Posts401ApplicationProblemPlusJSONResponse{
+		return generated.GetV1PostsPostIdRevisions401ApplicationProblemPlusJSONResponse{
 			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
 				unauthorizedProblem(),
 			),
 		}, nil
 	}
 
-	userID, err := uuid.Parse(userIDStr)
+	viewerID, err := uuid.Parse(userIDStr)
 	if err != nil {
 		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
 		span.RecordError(parseErr)
 		span.SetStatus(codes.Error, parseErr.Error())
 
-		return generated.PostV1Posts400ApplicationProblemPlusJSONResponse{
+		return generated.GetV1PostsPostIdRevisions400ApplicationProblemPlusJSONResponse{
 			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
 				validationProblem("invalid user ID in token", nil),
 			),
 		}, nil
 	}
 
-	output, err := h.createPostUseCase.Execute(ctx, commandpost.CreatePostInput{
-		UserID:  userID,
-		Content: req.Body.Content,
+	output, err := h.ListPostRevisionsUseCase.Execute(ctx, querypost.ListPostRevisionsInput{
+		PostID:   req.PostId,
+		ViewerID: viewerID,
 	})
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 
-		return mapCreatePostError(err), nil
+		return mapListPostRevisionsError(err), nil
 	}
 
-	return generated.PostV1Posts201JSONResponse{
-		Id:        output.ID,
-		UserId:    output.UserID,
-		Content:   output.Content,
-		CreatedAt: output.CreatedAt,
-	}, nil
+	revisions := make([]generated.PostRevisionResponse, len(output))
+	for i, r := range output {
+		revisions[i] = toPostRevisionResponse(r)
+	}
+
+	return generated.GetV1PostsPostIdRevisions200JSONResponse{Revisions: revisions}, nil
 }
 
-// GetV1Posts handles GET /v1/posts (requires JWT).
-func (h *serverHandler) GetV1Posts(
+// GetV1PostsPostIdRevisionsDiff handles GET /v1/posts/{postId}/revisions/diff (requires JWT).
+func (h *serverHandler) GetV1PostsPostIdRevisionsDiff(
 	ctx context.Context,
-	req generated.GetV1PostsRequestObject,
-) (generated.GetV1PostsResponseObject, error) {
-	ctx, span := h.tracer.Start(ctx, "listPosts")
+	req generated.GetV1PostsPostIdRevisionsDiffRequestObject,
+) (generated.GetV1PostsPostIdRevisionsDiffResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "diffPostRevisions")
 	defer span.End()
 
-	if common.UserIDFromContext(ctx) == "" {
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
 		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
 		span.SetStatus(codes.Error, "missing user ID in context")
 
-		return generated.GetV1Posts401ApplicationProblemPlusJSONResponse{
+		return generated.GetV1PostsPostIdRevisionsDiff401ApplicationProblemPlusJSONResponse{
 			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
 				unauthorizedProblem(),
 			),
 		}, nil
 	}
 
-	limit := 20
-	if req.Params.Limit != nil {
-		limit = *req.Params.Limit
-	}
+	viewerID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
 
-	offset := 0
-	if req.Params.Offset != nil {
-		offset = *req.Params.Offset
+		return generated.GetV1PostsPostIdRevisionsDiff400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
 	}
 
-	output, err := h.listPostsUseCase.Execute(ctx, querypost.ListPostsInput{
-		Limit:  limit,
-		Offset: offset,
+	output, err := h.DiffPostRevisionsUseCase.Execute(ctx, querypost.DiffPostRevisionsInput{
+		PostID:   req.PostId,
+		From:     req.Params.From,
+		To:       req.Params.To,
+		ViewerID: viewerID,
 	})
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 
-		return mapListPostsError(err), nil
+		return mapDiffPostRevisionsError(err), nil
 	}
 
-	posts := make([]generated.PostResponse, len(output.Posts))
-	for i, p := range output.Posts {
-		posts[i] = generated.PostResponse{
-			Id:        p.ID,
-			UserId:    p.UserID,
-			Content:   p.Content,
-			CreatedAt: p.CreatedAt,
-		}
+	lines := make([]generated.PostRevisionDiffLine, len(output.Lines))
+	for i, l := range output.Lines {
+		lines[i] = generated.PostRevisionDiffLine{Operation: l.Operation.String(), Text: l.Text}
 	}
 
-	return generated.GetV1Posts200JSONResponse{
-		Posts:  posts,
-		Total:  output.Total,
-		Limit:  limit,
-		Offset: offset,
+	return generated.GetV1PostsPostIdRevisionsDiff200JSONResponse{
+		From:  toPostRevisionResponse(output.From),
+		To:    toPostRevisionResponse(output.To),
+		Lines: lines,
 	}, nil
 }
 
+func toPostResponse(p querypost.PostDto) generated.PostResponse {
+	kind := generated.PostKind(p.Kind)
+
+	return generated.PostResponse{
+		Id:            p.ID,
+		UserId:        p.UserID,
+		Content:       p.Content,
+		Format:        generated.ContentFormat(p.Format),
+		ContentHtml:   p.ContentHTML,
+		Visibility:    generated.PostVisibility(p.Visibility),
+		Status:        generated.PostStatus(p.Status),
+		PublishAt:     p.PublishAt,
+		CreatedAt:     p.CreatedAt,
+		UpdatedAt:     p.UpdatedAt,
+		Edited:        p.Edited,
+		RevisionCount: p.RevisionCount,
+		CommentCount:  &p.CommentCount,
+		Kind:          &kind,
+		Original:      toOriginalPost(p.Original),
+		RepostCount:   &p.RepostCount,
+		Poll:          toPoll(p.Poll),
+		Author:        &generated.PostAuthor{Id: p.UserID, Name: p.AuthorName},
+		Reactions:     toPostReactions(p.Reactions),
+		Entities:      toPostEntities(p.Entities),
+		Attachments:   toPostAttachments(p.Attachments),
+		Hidden:        &p.Hidden,
+	}
+}
+
+// toOriginalPost leaves out everything but available for placeholders.
+func toOriginalPost(o *querypost.OriginalPostDto) *generated.OriginalPost {
+	if o == nil {
+		return nil
+	}
+
+	if !o.Available {
+		return &generated.OriginalPost{Available: false}
+	}
+
+	format := generated.ContentFormat(o.Format)
+
+	return &generated.OriginalPost{
+		Available:   true,
+		Id:          &o.ID,
+		UserId:      &o.UserID,
+		AuthorName:  &o.AuthorName,
+		Content:     &o.Content,
+		Format:      &format,
+		ContentHtml: &o.ContentHTML,
+		CreatedAt:   &o.CreatedAt,
+	}
+}
+
+// toPoll leaves out the counts while they are hidden from the caller.
+func toPoll(p *querypost.PostPollDto) *generated.Poll {
+	if p == nil {
+		return nil
+	}
+
+	options := make([]generated.PollOption, len(p.Options))
+	for i, o := range p.Options {
+		options[i] = generated.PollOption{Id: o.ID, Label: o.Label}
+		if !p.ResultsHidden {
+			options[i].VoteCount = &p.Options[i].VoteCount
+		}
+	}
+
+	resp := &generated.Poll{
+		Multiple:      p.Multiple,
+		ClosesAt:      p.ClosesAt,
+		Closed:        p.Closed,
+		ResultsHidden: p.ResultsHidden,
+		Options:       options,
+		MyVotes:       p.MyVotes,
+	}
+	if !p.ResultsHidden {
+		resp.VoterCount = &p.VoterCount
+	}
+
+	return resp
+}
+
+func toPostEntities(entities []querypost.PostEntityDto) *[]generated.PostEntity {
+	if entities == nil {
+		return nil
+	}
+
+	resp := make([]generated.PostEntity, len(entities))
+	for i, e := range entities {
+		resp[i] = generated.PostEntity{
+			Type:   generated.PostEntityType(e.Type),
+			Start:  e.Start,
+			End:    e.End,
+			Value:  e.Value,
+			UserId: e.UserID,
+		}
+	}
+
+	return &resp
+}
+
+func toPostRevisionResponse(r querypost.PostRevisionDto) generated.PostRevisionResponse {
+	return generated.PostRevisionResponse{
+		Id:          r.ID,
+		Number:      r.Number,
+		Content:     r.Content,
+		Format:      generated.ContentFormat(r.Format),
+		ContentHtml: r.ContentHTML,
+		EditorId:    r.EditorID,
+		CreatedAt:   r.CreatedAt,
+	}
+}
+
 func mapListPostsError(err error) generated.GetV1PostsResponseObject {
 	var domainErr vo.Error
 	if errors.As(err, &domainErr) {
@@ -146,6 +936,88 @@ func mapListPostsError(err error) generated.GetV1PostsResponseObject {
 	}
 }
 
+func mapListTimelineError(err error) generated.GetV1TimelineResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) && domainErr.Code() == vo.ValidationErrorCode {
+		return generated.GetV1Timeline400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblemFromDomain(domainErr),
+			),
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1Timeline500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapListUserPostsError(err error) generated.GetV1UsersUserIdPostsResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.GetV1UsersUserIdPosts400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					validationProblemFromDomain(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.GetV1UsersUserIdPosts404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1UsersUserIdPosts500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapListHashtagPostsError(err error) generated.GetV1HashtagsTagPostsResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) && domainErr.Code() == vo.ValidationErrorCode {
+		return generated.GetV1HashtagsTagPosts400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblemFromDomain(domainErr),
+			),
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1HashtagsTagPosts500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapSearchPostsError(err error) generated.GetV1PostsSearchResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.GetV1PostsSearch400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					validationProblemFromDomain(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1PostsSearch500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
 func mapCreatePostError(err error) generated.PostV1PostsResponseObject {
 	var domainErr vo.Error
 	if errors.As(err, &domainErr) {
@@ -162,6 +1034,12 @@ func mapCreatePostError(err error) generated.PostV1PostsResponseObject {
 					domainErrToProblem(domainErr),
 				),
 			}
+		case vo.NotFoundErrorCode:
+			return generated.PostV1Posts404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
 		default:
 		}
 	}
@@ -172,3 +1050,159 @@ func mapCreatePostError(err error) generated.PostV1PostsResponseObject {
 		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
 	}
 }
+
+func mapGetPostError(err error) generated.GetV1PostsPostIdResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.NotFoundErrorCode:
+			return generated.GetV1PostsPostId404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1PostsPostId500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapUpdatePostError(err error) generated.PatchV1PostsPostIdResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PatchV1PostsPostId400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					validationProblemFromDomain(domainErr),
+				),
+			}
+		case vo.ForbiddenErrorCode:
+			return generated.PatchV1PostsPostId403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: generated.ForbiddenApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.PatchV1PostsPostId404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PatchV1PostsPostId500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapDeletePostError(err error) generated.DeleteV1PostsPostIdResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ForbiddenErrorCode:
+			return generated.DeleteV1PostsPostId403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: generated.ForbiddenApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.DeleteV1PostsPostId404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.DeleteV1PostsPostId500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapPublishPostError(err error) generated.PostV1PostsPostIdPublishResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PostV1PostsPostIdPublish400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					validationProblemFromDomain(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.PostV1PostsPostIdPublish404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PostV1PostsPostIdPublish500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapListPostRevisionsError(err error) generated.GetV1PostsPostIdRevisionsResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.NotFoundErrorCode:
+			return generated.GetV1PostsPostIdRevisions404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1PostsPostIdRevisions500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapDiffPostRevisionsError(err error) generated.GetV1PostsPostIdRevisionsDiffResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.GetV1PostsPostIdRevisionsDiff400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					validationProblemFromDomain(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.GetV1PostsPostIdRevisionsDiff404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1PostsPostIdRevisionsDiff500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
@@ -0,0 +1,186 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
+	commanduser "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
+	queryuser "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// GetV1UsersUserIdProfile handles GET /v1/users/{userId}/profile (requires JWT).
+func (h *serverHandler) GetV1UsersUserIdProfile(
+	ctx context.Context,
+	req generated.GetV1UsersUserIdProfileRequestObject,
+) (generated.GetV1UsersUserIdProfileResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "getUserProfile")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.GetV1UsersUserIdProfile401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	viewerID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.GetV1UsersUserIdProfile400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	output, err := h.GetUserProfileUseCase.Execute(ctx, queryuser.GetUserProfileInput{
+		UserID:   req.UserId,
+		ViewerID: viewerID,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapGetUserProfileError(err), nil
+	}
+
+	return generated.GetV1UsersUserIdProfile200JSONResponse(toUserProfileResponse(*output)), nil
+}
+
+// PutV1UsersUserIdProfile handles PUT /v1/users/{userId}/profile (requires JWT; the user themself).
+func (h *serverHandler) PutV1UsersUserIdProfile(
+	ctx context.Context,
+	req generated.PutV1UsersUserIdProfileRequestObject,
+) (generated.PutV1UsersUserIdProfileResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "updateProfile")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PutV1UsersUserIdProfile401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PutV1UsersUserIdProfile400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	input := commanduser.UpdateProfileInput{ActorID: actorID, UserID: req.UserId}
+	if req.Body.Bio != nil {
+		input.Bio = *req.Body.Bio
+	}
+
+	if req.Body.AvatarUrl != nil {
+		input.AvatarURL = *req.Body.AvatarUrl
+	}
+
+	if err = h.UpdateProfileUseCase.Execute(ctx, input); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapUpdateProfileError(err), nil
+	}
+
+	return generated.PutV1UsersUserIdProfile204Response{}, nil
+}
+
+// toUserProfileResponse maps the public profile; UserProfileDto has no private fields to leave out.
+func toUserProfileResponse(p queryuser.UserProfileDto) generated.UserProfileResponse {
+	pinned := make([]generated.PostResponse, len(p.PinnedPosts))
+	for i, post := range p.PinnedPosts {
+		pinned[i] = toPostResponse(post)
+	}
+
+	return generated.UserProfileResponse{
+		Id:            p.ID,
+		Name:          p.Name,
+		Bio:           p.Bio,
+		AvatarUrl:     optionalString(p.AvatarURL),
+		JoinedAt:      p.JoinedAt,
+		FollowerCount: p.FollowerCount,
+		PostCount:     p.PostCount,
+		PinnedPosts:   pinned,
+	}
+}
+
+func mapGetUserProfileError(err error) generated.GetV1UsersUserIdProfileResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.GetV1UsersUserIdProfile400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.GetV1UsersUserIdProfile404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1UsersUserIdProfile500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapUpdateProfileError(err error) generated.PutV1UsersUserIdProfileResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PutV1UsersUserIdProfile400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.ForbiddenErrorCode:
+			return generated.PutV1UsersUserIdProfile403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: generated.ForbiddenApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PutV1UsersUserIdProfile500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
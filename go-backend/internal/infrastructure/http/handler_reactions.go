@@ -0,0 +1,180 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
+	commandpost "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	querypost "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// PutV1PostsPostIdReactionsType handles PUT /v1/posts/{postId}/reactions/{type} (requires JWT).
+func (h *serverHandler) PutV1PostsPostIdReactionsType(
+	ctx context.Context,
+	req generated.PutV1PostsPostIdReactionsTypeRequestObject,
+) (generated.PutV1PostsPostIdReactionsTypeResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "addReaction")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PutV1PostsPostIdReactionsType401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PutV1PostsPostIdReactionsType400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	err = h.AddReactionUseCase.Execute(ctx, commandpost.AddReactionInput{
+		ActorID: actorID,
+		PostID:  req.PostId,
+		Type:    string(req.Type),
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapAddReactionError(err), nil
+	}
+
+	return generated.PutV1PostsPostIdReactionsType204Response{}, nil
+}
+
+// DeleteV1PostsPostIdReactionsType handles DELETE /v1/posts/{postId}/reactions/{type} (requires JWT).
+func (h *serverHandler) DeleteV1PostsPostIdReactionsType(
+	ctx context.Context,
+	req generated.DeleteV1PostsPostIdReactionsTypeRequestObject,
+) (generated.DeleteV1PostsPostIdReactionsTypeResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "removeReaction")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.DeleteV1PostsPostIdReactionsType401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	input := commandpost.RemoveReactionInput{PostID: req.PostId, Type: string(req.Type)}
+
+	var err error
+	if input.ActorID, err = uuid.Parse(userIDStr); err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.DeleteV1PostsPostIdReactionsType400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	if err = h.RemoveReactionUseCase.Execute(ctx, input); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapRemoveReactionError(err), nil
+	}
+
+	return generated.DeleteV1PostsPostIdReactionsType204Response{}, nil
+}
+
+// toPostReactions returns nil for posts whose reactions were not loaded.
+func toPostReactions(r *querypost.PostReactionsDto) *generated.PostReactions {
+	if r == nil {
+		return nil
+	}
+
+	counts := make(map[string]int, len(r.Counts))
+	for t, n := range r.Counts {
+		counts[t.String()] = n
+	}
+
+	mine := make([]generated.ReactionType, len(r.Mine))
+	for i, t := range r.Mine {
+		mine[i] = generated.ReactionType(t)
+	}
+
+	return &generated.PostReactions{Counts: counts, Mine: mine}
+}
+
+func mapAddReactionError(err error) generated.PutV1PostsPostIdReactionsTypeResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PutV1PostsPostIdReactionsType400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.PutV1PostsPostIdReactionsType404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PutV1PostsPostIdReactionsType500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapRemoveReactionError(err error) generated.DeleteV1PostsPostIdReactionsTypeResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.DeleteV1PostsPostIdReactionsType400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.DeleteV1PostsPostIdReactionsType404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.DeleteV1PostsPostIdReactionsType500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
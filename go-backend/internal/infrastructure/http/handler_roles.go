@@ -0,0 +1,218 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
+	commanduser "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
+	queryuser "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// GetV1UsersUserIdRoles handles GET /v1/users/{userId}/roles (requires JWT; own roles or roles:list permission).
+func (h *serverHandler) GetV1UsersUserIdRoles(
+	ctx context.Context,
+	req generated.GetV1UsersUserIdRolesRequestObject,
+) (generated.GetV1UsersUserIdRolesResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "listRoleAssignments")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.GetV1UsersUserIdRoles401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	requesterID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.GetV1UsersUserIdRoles400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	output, err := h.ListRoleAssignmentsUseCase.Execute(ctx, queryuser.ListRoleAssignmentsInput{
+		RequesterID:  requesterID,
+		TargetUserID: req.UserId,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapListRoleAssignmentsError(err), nil
+	}
+
+	assignments := make([]generated.RoleAssignmentSummary, 0, len(output.Assignments))
+	for _, a := range output.Assignments {
+		assignments = append(assignments, generated.RoleAssignmentSummary{
+			RoleId:    a.RoleID,
+			RoleName:  a.RoleName,
+			GrantedBy: a.GrantedBy,
+			GrantedAt: a.GrantedAt,
+			ExpiresAt: a.ExpiresAt,
+			Reason:    a.Reason,
+		})
+	}
+
+	history := make([]generated.RoleAssignmentHistoryResponse, 0, len(output.History))
+	for _, e := range output.History {
+		history = append(history, generated.RoleAssignmentHistoryResponse{
+			Id:        e.ID,
+			RoleId:    e.RoleID,
+			RoleName:  e.RoleName,
+			Action:    e.Action,
+			ActorId:   e.ActorID,
+			Reason:    e.Reason,
+			ExpiresAt: e.ExpiresAt,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+
+	return generated.GetV1UsersUserIdRoles200JSONResponse{
+		Assignments: assignments,
+		History:     history,
+	}, nil
+}
+
+// PostV1UsersUserIdRoles handles POST /v1/users/{userId}/roles (requires JWT and roles:assign permission).
+func (h *serverHandler) PostV1UsersUserIdRoles(
+	ctx context.Context,
+	req generated.PostV1UsersUserIdRolesRequestObject,
+) (generated.PostV1UsersUserIdRolesResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "grantRole")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PostV1UsersUserIdRoles401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PostV1UsersUserIdRoles400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	output, err := h.GrantRoleUseCase.Execute(ctx, commanduser.GrantRoleInput{
+		ActorID:   actorID,
+		UserID:    req.UserId,
+		RoleID:    req.Body.RoleId,
+		ExpiresAt: req.Body.ExpiresAt,
+		Reason:    req.Body.Reason,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapGrantRoleError(err), nil
+	}
+
+	resp := generated.PostV1UsersUserIdRoles201JSONResponse{
+		UserId:    output.UserID,
+		RoleId:    output.RoleID,
+		GrantedAt: output.GrantedAt,
+		ExpiresAt: output.ExpiresAt,
+		Reason:    &output.Reason,
+	}
+
+	if output.GrantedBy != uuid.Nil {
+		resp.GrantedBy = &output.GrantedBy
+	}
+
+	return resp, nil
+}
+
+func mapListRoleAssignmentsError(err error) generated.GetV1UsersUserIdRolesResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.GetV1UsersUserIdRoles400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					validationProblemFromDomain(domainErr),
+				),
+			}
+		case vo.UnauthorizedErrorCode, vo.InvalidCredentialErrorCode:
+			return generated.GetV1UsersUserIdRoles401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.ForbiddenErrorCode:
+			return generated.GetV1UsersUserIdRoles403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: generated.ForbiddenApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1UsersUserIdRoles500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapGrantRoleError(err error) generated.PostV1UsersUserIdRolesResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PostV1UsersUserIdRoles400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.UnauthorizedErrorCode, vo.InvalidCredentialErrorCode:
+			return generated.PostV1UsersUserIdRoles401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.ForbiddenErrorCode:
+			return generated.PostV1UsersUserIdRoles403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: generated.ForbiddenApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PostV1UsersUserIdRoles500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
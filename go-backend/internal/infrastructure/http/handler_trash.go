@@ -0,0 +1,203 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
+	commandpost "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	querypost "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// GetV1TrashPosts handles GET /v1/trash/posts (requires JWT).
+func (h *serverHandler) GetV1TrashPosts(
+	ctx context.Context,
+	req generated.GetV1TrashPostsRequestObject,
+) (generated.GetV1TrashPostsResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "listTrash")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.GetV1TrashPosts401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.GetV1TrashPosts400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	limit := 20
+	if req.Params.Limit != nil {
+		limit = *req.Params.Limit
+	}
+
+	offset := 0
+	if req.Params.Offset != nil {
+		offset = *req.Params.Offset
+	}
+
+	output, err := h.ListTrashUseCase.Execute(ctx, querypost.ListTrashInput{
+		UserID: userID,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapListTrashError(err), nil
+	}
+
+	items := make([]generated.TrashedPost, len(output.Items))
+	for i, item := range output.Items {
+		items[i] = generated.TrashedPost{
+			Id:         item.ID,
+			Content:    item.Content,
+			Visibility: generated.PostVisibility(item.Visibility),
+			Status:     generated.PostStatus(item.Status),
+			CreatedAt:  item.CreatedAt,
+			UpdatedAt:  item.UpdatedAt,
+			DeletedAt:  item.DeletedAt,
+			DeletedBy:  item.DeletedBy,
+			PurgeAt:    item.PurgeAt,
+		}
+	}
+
+	return generated.GetV1TrashPosts200JSONResponse{
+		Items:  items,
+		Total:  output.Total,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+// PostV1TrashPostsPostIdRestore handles POST /v1/trash/posts/{postId}/restore (requires JWT; the author who
+// trashed the post, or posts:moderate permission).
+func (h *serverHandler) PostV1TrashPostsPostIdRestore(
+	ctx context.Context,
+	req generated.PostV1TrashPostsPostIdRestoreRequestObject,
+) (generated.PostV1TrashPostsPostIdRestoreResponseObject, error) {
+	ctx, span := h.tracer.Start(ctx, "restorePost")
+	defer span.End()
+
+	userIDStr := common.UserIDFromContext(ctx)
+	if userIDStr == "" {
+		h.logger.Error(ctx, "user ID missing from context — JWT middleware may not be applied")
+		span.SetStatus(codes.Error, "missing user ID in context")
+
+		return generated.PostV1TrashPostsPostIdRestore401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: generated.UnauthorizedApplicationProblemPlusJSONResponse(
+				unauthorizedProblem(),
+			),
+		}, nil
+	}
+
+	actorID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		parseErr := vo.NewValidationError("invalid user ID in token", nil, err)
+		span.RecordError(parseErr)
+		span.SetStatus(codes.Error, parseErr.Error())
+
+		return generated.PostV1TrashPostsPostIdRestore400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblem("invalid user ID in token", nil),
+			),
+		}, nil
+	}
+
+	output, err := h.RestorePostUseCase.Execute(ctx, commandpost.RestorePostInput{
+		ActorID: actorID,
+		PostID:  req.PostId,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapRestorePostError(err), nil
+	}
+
+	return generated.PostV1TrashPostsPostIdRestore200JSONResponse{
+		Id:            output.ID,
+		UserId:        output.UserID,
+		Content:       output.Content,
+		Format:        generated.ContentFormat(output.Format),
+		ContentHtml:   output.ContentHTML,
+		Visibility:    generated.PostVisibility(output.Visibility),
+		Status:        generated.PostStatus(output.Status),
+		PublishAt:     output.PublishAt,
+		CreatedAt:     output.CreatedAt,
+		UpdatedAt:     output.UpdatedAt,
+		Edited:        output.RevisionCount > 1,
+		RevisionCount: output.RevisionCount,
+	}, nil
+}
+
+func mapListTrashError(err error) generated.GetV1TrashPostsResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) && domainErr.Code() == vo.ValidationErrorCode {
+		return generated.GetV1TrashPosts400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+				validationProblemFromDomain(domainErr),
+			),
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.GetV1TrashPosts500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
+
+func mapRestorePostError(err error) generated.PostV1TrashPostsPostIdRestoreResponseObject {
+	var domainErr vo.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code() {
+		case vo.ValidationErrorCode:
+			return generated.PostV1TrashPostsPostIdRestore400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: generated.BadRequestApplicationProblemPlusJSONResponse(
+					validationProblemFromDomain(domainErr),
+				),
+			}
+		case vo.ForbiddenErrorCode:
+			return generated.PostV1TrashPostsPostIdRestore403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: generated.ForbiddenApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		case vo.NotFoundErrorCode:
+			return generated.PostV1TrashPostsPostIdRestore404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: generated.NotFoundApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
+		default:
+		}
+	}
+
+	internalResp := generated.InternalServerErrorApplicationProblemPlusJSONResponse(internalProblem())
+
+	return generated.PostV1TrashPostsPostIdRestore500ApplicationProblemPlusJSONResponse{
+		InternalServerErrorApplicationProblemPlusJSONResponse: internalResp,
+	}
+}
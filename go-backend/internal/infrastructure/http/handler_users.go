@@ -22,11 +22,16 @@ func (h *serverHandler) PostV1UsersSignup(
 	ctx, span := h.tracer.Start(ctx, "signup")
 	defer span.End()
 
-	output, err := h.signupUseCase.Execute(ctx, commanduser.SignupInput{
+	input := commanduser.SignupInput{
 		Email:    string(req.Body.Email),
 		Password: req.Body.Password,
 		Name:     req.Body.Name,
-	})
+	}
+	if req.Body.InvitationToken != nil {
+		input.InvitationToken = *req.Body.InvitationToken
+	}
+
+	output, err := h.SignupUseCase.Execute(ctx, input)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -51,9 +56,10 @@ func (h *serverHandler) PostV1UsersLogin(
 	ctx, span := h.tracer.Start(ctx, "login")
 	defer span.End()
 
-	output, err := h.loginUseCase.Execute(ctx, commanduser.LoginInput{
-		Email:    string(req.Body.Email),
-		Password: req.Body.Password,
+	output, err := h.LoginUseCase.Execute(ctx, commanduser.LoginInput{
+		Email:          string(req.Body.Email),
+		Password:       req.Body.Password,
+		OrganizationID: req.Body.OrganizationId,
 	})
 	if err != nil {
 		span.RecordError(err)
@@ -63,8 +69,9 @@ func (h *serverHandler) PostV1UsersLogin(
 	}
 
 	return generated.PostV1UsersLogin200JSONResponse{
-		Token:     output.Token,
-		ExpiresAt: output.ExpiresAt,
+		Token:          output.Token,
+		ExpiresAt:      output.ExpiresAt,
+		OrganizationId: output.OrganizationID,
 		User: struct {
 			Email openapi_types.Email `json:"email"`
 			Id    string              `json:"id"`
@@ -120,7 +127,7 @@ func (h *serverHandler) GetV1Users(
 		offset = *req.Params.Offset
 	}
 
-	output, err := h.listUsersUseCase.Execute(ctx, queryuser.ListUsersInput{
+	output, err := h.ListUsersUseCase.Execute(ctx, queryuser.ListUsersInput{
 		UserID: userID,
 		Limit:  limit,
 		Offset: offset,
@@ -161,6 +168,12 @@ func mapSignupError(err error) generated.PostV1UsersSignupResponseObject {
 					validationProblemFromDomain(domainErr),
 				),
 			}
+		case vo.ForbiddenErrorCode:
+			return generated.PostV1UsersSignup403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: generated.ForbiddenApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
 		case vo.DuplicateEmailErrorCode:
 			return generated.PostV1UsersSignup409ApplicationProblemPlusJSONResponse{
 				ConflictApplicationProblemPlusJSONResponse: generated.ConflictApplicationProblemPlusJSONResponse(
@@ -194,6 +207,12 @@ func mapLoginError(err error) generated.PostV1UsersLoginResponseObject {
 					domainErrToProblem(domainErr),
 				),
 			}
+		case vo.ForbiddenErrorCode:
+			return generated.PostV1UsersLogin403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: generated.ForbiddenApplicationProblemPlusJSONResponse(
+					domainErrToProblem(domainErr),
+				),
+			}
 		default:
 		}
 	}
@@ -0,0 +1,83 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashtagPosts(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+
+	token, _ := signupAndGetToken(t, "owner@example.com", "")
+
+	for _, content := range []string{"first #Go", "no tags @nobody", "second #go and #rust"} {
+		resp, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{Content: content},
+			withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+	}
+
+	t.Run("lists the tagged posts with their entities", func(t *testing.T) {
+		// The tag is matched with or without # and regardless of case and width.
+		for _, tag := range []string{"go", "#GO", "Ｇｏ"} {
+			resp, err := c.GetV1HashtagsTagPostsWithResponse(ctx, tag, nil, withBearerToken(token))
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode(), tag)
+			require.Len(t, resp.JSON200.Posts, 2)
+			assert.Equal(t, "second #go and #rust", resp.JSON200.Posts[0].Content)
+			assert.Equal(t, "first #Go", resp.JSON200.Posts[1].Content)
+			require.NotNil(t, resp.JSON200.Total)
+			assert.Equal(t, 2, *resp.JSON200.Total)
+		}
+
+		resp, err := c.GetV1HashtagsTagPostsWithResponse(ctx, "rust", nil, withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Len(t, resp.JSON200.Posts, 1)
+		require.NotNil(t, resp.JSON200.Posts[0].Entities)
+		assert.Equal(t, []clientgen.PostEntity{
+			{Type: clientgen.Hashtag, Start: 7, End: 10, Value: "go"},
+			{Type: clientgen.Hashtag, Start: 15, End: 20, Value: "rust"},
+		}, *resp.JSON200.Posts[0].Entities)
+	})
+
+	t.Run("unresolved mentions are not reported", func(t *testing.T) {
+		resp, err := c.GetV1PostsWithResponse(ctx, nil, withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Len(t, resp.JSON200.Posts, 3)
+		require.NotNil(t, resp.JSON200.Posts[1].Entities)
+		assert.Empty(t, *resp.JSON200.Posts[1].Entities)
+	})
+
+	t.Run("unknown tags list nothing", func(t *testing.T) {
+		resp, err := c.GetV1HashtagsTagPostsWithResponse(ctx, "python", nil, withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		assert.Empty(t, resp.JSON200.Posts)
+	})
+
+	t.Run("invalid tags return 400", func(t *testing.T) {
+		for _, tag := range []string{"2026", "go-lang"} {
+			resp, err := c.GetV1HashtagsTagPostsWithResponse(ctx, tag, nil, withBearerToken(token))
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode(), tag)
+		}
+	})
+
+	t.Run("missing token returns 401", func(t *testing.T) {
+		resp, err := c.GetV1HashtagsTagPostsWithResponse(ctx, "go", nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	})
+}
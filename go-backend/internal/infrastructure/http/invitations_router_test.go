@@ -0,0 +1,140 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createInvitation invites email with the given roles as the admin behind token and returns the secret.
+func createInvitation(t *testing.T, token, email string, roleIDs ...string) string {
+	t.Helper()
+
+	roles := make([]openapi_types.UUID, 0, len(roleIDs))
+	for _, roleID := range roleIDs {
+		roles = append(roles, uuid.MustParse(roleID))
+	}
+
+	resp, err := newTestClient().PostV1InvitationsWithResponse(context.Background(),
+		clientgen.CreateInvitationRequest{Email: openapi_types.Email(email), RoleIds: &roles},
+		withBearerToken(token),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode())
+	require.NotNil(t, resp.JSON201)
+
+	return resp.JSON201.Token
+}
+
+func signupWithInvitation(t *testing.T, email, invitationToken string) int {
+	t.Helper()
+
+	resp, err := newTestClient().PostV1UsersSignupWithResponse(context.Background(), clientgen.SignupRequest{
+		Name:            "Invitee",
+		Email:           openapi_types.Email(email),
+		Password:        "password",
+		InvitationToken: &invitationToken,
+	})
+	require.NoError(t, err)
+
+	return resp.StatusCode()
+}
+
+func TestInvitation_HappyCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	adminToken, adminID := signupAndGetToken(t, "admin@example.com", adminRoleID)
+	invitationToken := createInvitation(t, adminToken, "invitee@example.com", viewerRoleID)
+
+	preview, err := newTestClient().GetV1InvitationsTokenWithResponse(ctx, invitationToken)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, preview.StatusCode())
+	require.NotNil(t, preview.JSON200)
+	assert.Equal(t, openapi_types.Email("invitee@example.com"), preview.JSON200.Email)
+
+	require.Equal(t, http.StatusCreated, signupWithInvitation(t, "invitee@example.com", invitationToken))
+
+	// The invited role is granted in the signup transaction and attributed to the inviting admin.
+	var grantedBy string
+
+	err = testDb.Pool().QueryRow(ctx,
+		"SELECT ur.granted_by FROM user_roles ur JOIN users u ON u.id = ur.user_id "+
+			"WHERE u.email = $1 AND ur.role_id = $2",
+		"invitee@example.com", viewerRoleID,
+	).Scan(&grantedBy)
+	require.NoError(t, err)
+	assert.Equal(t, adminID, grantedBy)
+
+	// A used invitation can no longer be previewed.
+	preview, err = newTestClient().GetV1InvitationsTokenWithResponse(ctx, invitationToken)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, preview.StatusCode())
+}
+
+func TestInvitation_SignupFailureCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	adminToken, _ := signupAndGetToken(t, "admin@example.com", adminRoleID)
+	usedToken := createInvitation(t, adminToken, "first@example.com")
+	require.Equal(t, http.StatusCreated, signupWithInvitation(t, "first@example.com", usedToken))
+
+	pendingToken := createInvitation(t, adminToken, "invitee@example.com", viewerRoleID)
+
+	tests := []struct {
+		name            string
+		email           string
+		invitationToken string
+	}{
+		{name: "unknown token", email: "invitee@example.com", invitationToken: "unknown"},
+		{name: "token already used", email: "second@example.com", invitationToken: usedToken},
+		{name: "email does not match", email: "intruder@example.com", invitationToken: pendingToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, http.StatusBadRequest, signupWithInvitation(t, tt.email, tt.invitationToken))
+		})
+	}
+
+	// Rejected signups roll back entirely, so the invitee can still use the pending invitation.
+	assert.Equal(t, http.StatusCreated, signupWithInvitation(t, "invitee@example.com", pendingToken))
+}
+
+func TestCreateInvitation_FailureCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	adminToken, _ := signupAndGetToken(t, "admin@example.com", adminRoleID)
+	viewerToken, _ := signupAndGetToken(t, "viewer@example.com", viewerRoleID)
+	unknownRole := []openapi_types.UUID{uuid.New()}
+
+	tests := []struct {
+		name         string
+		token        string
+		roleIDs      *[]openapi_types.UUID
+		responseCode int
+	}{
+		{name: "no token", token: "", responseCode: http.StatusUnauthorized},
+		{name: "lacks users:invite", token: viewerToken, responseCode: http.StatusForbidden},
+		{name: "unknown role", token: adminToken, roleIDs: &unknownRole, responseCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := newTestClient().PostV1InvitationsWithResponse(context.Background(),
+				clientgen.CreateInvitationRequest{Email: "invitee@example.com", RoleIds: tt.roleIDs},
+				withBearerToken(tt.token),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tt.responseCode, resp.StatusCode())
+		})
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"github.com/Haya372/web-app-template/go-backend/internal/common"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
 	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/admin"
 	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v5"
 )
 
@@ -35,6 +37,19 @@ func JWTMiddleware(jwtService service.JwtService) echo.MiddlewareFunc {
 			// context so it is available to use cases for logging.
 			c.Set("userID", claims.UserID)
 			ctx := common.WithUserID(c.Request().Context(), claims.UserID)
+
+			// Impersonation tokens also carry the admin acting as the user.
+			if claims.ActorID != "" {
+				c.Set("actorID", claims.ActorID)
+				ctx = common.WithActorID(ctx, claims.ActorID)
+			}
+
+			// The organization the token acts in scopes every tenant-owned query.
+			if claims.TenantID != "" {
+				c.Set("tenantID", claims.TenantID)
+				ctx = common.WithTenantID(ctx, claims.TenantID)
+			}
+
 			c.SetRequest(c.Request().WithContext(ctx))
 
 			return next(c)
@@ -42,12 +57,84 @@ func JWTMiddleware(jwtService service.JwtService) echo.MiddlewareFunc {
 	}
 }
 
+// ImpersonationAuditMiddleware returns an Echo middleware that records every request
+// made with an impersonation token before it reaches the handler. It must run after
+// JWTMiddleware. If the audit entry cannot be written the request is rejected, so no
+// impersonated action goes unrecorded.
+func ImpersonationAuditMiddleware(uc admin.RecordImpersonatedRequestUseCase) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c *echo.Context) error {
+			ctx := c.Request().Context()
+
+			actorIDStr := common.ActorIDFromContext(ctx)
+			if actorIDStr == "" {
+				return next(c)
+			}
+
+			actorID, err := uuid.Parse(actorIDStr)
+			if err != nil {
+				return writeUnauthorized(c)
+			}
+
+			subjectID, err := uuid.Parse(common.UserIDFromContext(ctx))
+			if err != nil {
+				return writeUnauthorized(c)
+			}
+
+			if err := uc.Execute(ctx, admin.RecordImpersonatedRequestInput{
+				ActorID:   actorID,
+				SubjectID: subjectID,
+				Method:    c.Request().Method,
+				Path:      c.Request().URL.Path,
+			}); err != nil {
+				return writeProblem(c, http.StatusInternalServerError, vo.InternalErrorCode)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// RejectImpersonationMiddleware returns an Echo middleware that blocks sensitive
+// operations (role grants, starting another impersonation, credential changes) when
+// the request carries an impersonation token. It must run after JWTMiddleware.
+func RejectImpersonationMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c *echo.Context) error {
+			if common.ActorIDFromContext(c.Request().Context()) != "" {
+				return writeProblem(c, http.StatusForbidden, vo.ForbiddenErrorCode)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// RequireTenantMiddleware returns an Echo middleware that rejects requests without an active
+// organization with 403 Forbidden. It must run after JWTMiddleware and guards every route that
+// reads or writes tenant-owned data.
+func RequireTenantMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c *echo.Context) error {
+			if common.TenantIDFromContext(c.Request().Context()) == "" {
+				return writeProblem(c, http.StatusForbidden, vo.ForbiddenErrorCode)
+			}
+
+			return next(c)
+		}
+	}
+}
+
 func writeUnauthorized(c *echo.Context) error {
+	return writeProblem(c, http.StatusUnauthorized, vo.UnauthorizedErrorCode)
+}
+
+func writeProblem(c *echo.Context, status int, code vo.ErrorCode) error {
 	c.Response().Header().Set(echo.HeaderContentType, problemContentType)
 
-	return c.JSON(http.StatusUnauthorized, generated.ProblemDetails{
-		Type:   string(vo.UnauthorizedErrorCode),
-		Title:  vo.UnauthorizedErrorCode.Title(),
-		Status: http.StatusUnauthorized,
+	return c.JSON(status, generated.ProblemDetails{
+		Type:   string(code),
+		Title:  code.Title(),
+		Status: status,
 	})
 }
@@ -0,0 +1,164 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportsAndModeration(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+
+	// The owner of the organization holds posts:moderate there; the other members are viewers.
+	ownerToken, ownerID := signupAndGetToken(t, "owner@example.com", "")
+	_, authorID := signupAndGetToken(t, "author@example.com", "")
+	_, reporterID := signupAndGetToken(t, "reporter@example.com", "")
+
+	orgID := organizationOf(t, ownerID)
+	joinOrganization(t, orgID, authorID, viewerRoleID)
+	joinOrganization(t, orgID, reporterID, viewerRoleID)
+	authorToken := loginToOrganization(t, "author@example.com", orgID)
+	reporterToken := loginToOrganization(t, "reporter@example.com", orgID)
+
+	createPost := func(t *testing.T, content string) uuid.UUID {
+		t.Helper()
+
+		resp, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{Content: content},
+			withBearerToken(authorToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+
+		return resp.JSON201.Id
+	}
+
+	report := func(t *testing.T, token string, postID uuid.UUID, reason clientgen.ReportReason) int {
+		t.Helper()
+
+		resp, err := c.PostV1PostsPostIdReportsWithResponse(ctx, postID,
+			clientgen.CreatePostReportRequest{Reason: reason}, withBearerToken(token))
+		require.NoError(t, err)
+
+		return resp.StatusCode()
+	}
+
+	moderate := func(
+		t *testing.T, token string, postID uuid.UUID, action clientgen.ModerationActionType,
+	) *clientgen.PostV1ModerationPostsPostIdActionsResponse {
+		t.Helper()
+
+		resp, err := c.PostV1ModerationPostsPostIdActionsWithResponse(ctx, postID,
+			clientgen.ModeratePostRequest{Action: action, Reason: "breaks the community rules"},
+			withBearerToken(token))
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	abusive := createPost(t, "abusive")
+	spam := createPost(t, "spam")
+
+	t.Run("members report posts once", func(t *testing.T) {
+		details := "insults everyone"
+		resp, err := c.PostV1PostsPostIdReportsWithResponse(ctx, abusive,
+			clientgen.CreatePostReportRequest{Reason: clientgen.Harassment, Details: &details},
+			withBearerToken(reporterToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+		require.NotNil(t, resp.JSON201)
+		assert.Equal(t, abusive, resp.JSON201.PostId)
+		assert.Equal(t, clientgen.Harassment, resp.JSON201.Reason)
+
+		assert.Equal(t, http.StatusBadRequest, report(t, reporterToken, abusive, clientgen.Spam))
+		assert.Equal(t, http.StatusCreated, report(t, ownerToken, abusive, clientgen.Hate))
+		assert.Equal(t, http.StatusCreated, report(t, reporterToken, spam, clientgen.Spam))
+	})
+
+	t.Run("reports are rejected for own or unknown posts", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, report(t, authorToken, abusive, clientgen.Spam))
+		assert.Equal(t, http.StatusNotFound, report(t, reporterToken, uuid.New(), clientgen.Spam))
+		assert.Equal(t, http.StatusUnauthorized, report(t, "", abusive, clientgen.Spam))
+	})
+
+	t.Run("only moderators see the queue", func(t *testing.T) {
+		denied, err := c.GetV1ModerationQueueWithResponse(ctx, nil, withBearerToken(reporterToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, denied.StatusCode())
+
+		resp, err := c.GetV1ModerationQueueWithResponse(ctx, nil, withBearerToken(ownerToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, 2, resp.JSON200.Total)
+		require.Len(t, resp.JSON200.Items, 2)
+		assert.Equal(t, abusive, resp.JSON200.Items[0].Post.Id)
+		assert.Equal(t, uuid.MustParse(authorID), resp.JSON200.Items[0].Post.Author.Id)
+		assert.Equal(t, 2, resp.JSON200.Items[0].ReportCount)
+		require.Len(t, resp.JSON200.Items[0].Reports, 2)
+		assert.Equal(t, clientgen.Harassment, resp.JSON200.Items[0].Reports[0].Reason)
+	})
+
+	t.Run("only moderators act on posts", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, moderate(t, reporterToken, abusive, clientgen.Hide).StatusCode())
+		assert.Equal(t, http.StatusNotFound, moderate(t, ownerToken, uuid.New(), clientgen.Hide).StatusCode())
+	})
+
+	t.Run("hidden posts are only visible to their author", func(t *testing.T) {
+		resp := moderate(t, ownerToken, abusive, clientgen.Hide)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+		require.NotNil(t, resp.JSON201)
+		assert.Equal(t, uuid.MustParse(authorID), resp.JSON201.TargetUserId)
+		assert.Equal(t, 2, resp.JSON201.ResolvedReports)
+
+		assert.Equal(t, http.StatusBadRequest, moderate(t, ownerToken, abusive, clientgen.Hide).StatusCode())
+
+		hidden, err := c.GetV1PostsPostIdWithResponse(ctx, abusive, withBearerToken(reporterToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, hidden.StatusCode())
+
+		own, err := c.GetV1PostsPostIdWithResponse(ctx, abusive, withBearerToken(authorToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, own.StatusCode())
+		require.NotNil(t, own.JSON200.Hidden)
+		assert.True(t, *own.JSON200.Hidden)
+
+		queue, err := c.GetV1ModerationQueueWithResponse(ctx, nil, withBearerToken(ownerToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, queue.StatusCode())
+		require.Len(t, queue.JSON200.Items, 1)
+		assert.Equal(t, spam, queue.JSON200.Items[0].Post.Id)
+	})
+
+	t.Run("frozen authors can no longer log in", func(t *testing.T) {
+		resp := moderate(t, ownerToken, spam, clientgen.Freeze)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+		assert.Equal(t, 1, resp.JSON201.ResolvedReports)
+
+		login, err := c.PostV1UsersLoginWithResponse(ctx, clientgen.LoginRequest{
+			Email:    openapi_types.Email("author@example.com"),
+			Password: "password",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, login.StatusCode())
+
+		var count int
+		inOrganization(t, orgID, func(ctx context.Context, tx pgx.Tx) error {
+			return tx.QueryRow(ctx,
+				"SELECT COUNT(*) FROM moderation_actions WHERE moderator_id = $1 AND target_user_id = $2",
+				ownerID, authorID,
+			).Scan(&count)
+		})
+		assert.Equal(t, 2, count)
+	})
+}
@@ -0,0 +1,229 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ownerRoleID is the seeded organization owner role, which holds members:add.
+const ownerRoleID = "00000000-0000-0000-0000-000000000003"
+
+func TestCreateOrganization_HappyCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	token, userID := signupAndGetToken(t, "founder@example.com", "")
+
+	resp, err := newTestClient().PostV1OrganizationsWithResponse(ctx,
+		clientgen.CreateOrganizationRequest{Name: "Acme"},
+		withBearerToken(token),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode())
+	require.NotNil(t, resp.JSON201)
+	assert.Equal(t, "Acme", resp.JSON201.Name)
+
+	var roleID string
+
+	inOrganization(t, resp.JSON201.Id.String(), func(ctx context.Context, tx pgx.Tx) error {
+		return tx.QueryRow(ctx,
+			"SELECT role_id FROM organization_memberships WHERE organization_id = $1 AND user_id = $2",
+			resp.JSON201.Id, userID,
+		).Scan(&roleID)
+	})
+	assert.Equal(t, ownerRoleID, roleID)
+
+	// The founder can switch into the new organization at login.
+	assert.NotEmpty(t, loginToOrganization(t, "founder@example.com", resp.JSON201.Id.String()))
+}
+
+func TestCreateOrganization_FailureCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	token, _ := signupAndGetToken(t, "founder@example.com", "")
+
+	tests := []struct {
+		name         string
+		token        string
+		orgName      string
+		responseCode int
+	}{
+		{
+			name:         "empty name",
+			token:        token,
+			orgName:      "",
+			responseCode: http.StatusBadRequest,
+		},
+		{
+			name:         "no token",
+			token:        "",
+			orgName:      "Acme",
+			responseCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := newTestClient().PostV1OrganizationsWithResponse(context.Background(),
+				clientgen.CreateOrganizationRequest{Name: tt.orgName},
+				withBearerToken(tt.token),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tt.responseCode, resp.StatusCode())
+		})
+	}
+}
+
+func TestAddMember_HappyCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	ownerToken, ownerID := signupAndGetToken(t, "owner@example.com", "")
+	_, memberID := signupAndGetToken(t, "member@example.com", "")
+	orgID := organizationOf(t, ownerID)
+
+	resp, err := newTestClient().PostV1OrganizationsOrganizationIdMembersWithResponse(ctx, uuid.MustParse(orgID),
+		clientgen.AddMemberRequest{UserId: uuid.MustParse(memberID), RoleId: uuid.MustParse(viewerRoleID)},
+		withBearerToken(ownerToken),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode())
+	require.NotNil(t, resp.JSON201)
+	assert.Equal(t, orgID, resp.JSON201.OrganizationId.String())
+	assert.Equal(t, memberID, resp.JSON201.UserId.String())
+
+	// The new member can now act in the organization.
+	assert.NotEmpty(t, loginToOrganization(t, "member@example.com", orgID))
+}
+
+func TestAddMember_FailureCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ownerToken, ownerID := signupAndGetToken(t, "owner@example.com", "")
+	_, memberID := signupAndGetToken(t, "member@example.com", "")
+	_, otherOwnerID := signupAndGetToken(t, "other-owner@example.com", "")
+	_, candidateID := signupAndGetToken(t, "candidate@example.com", "")
+
+	orgID := organizationOf(t, ownerID)
+	joinOrganization(t, orgID, memberID, viewerRoleID)
+	memberToken := loginToOrganization(t, "member@example.com", orgID)
+
+	tests := []struct {
+		name         string
+		token        string
+		orgID        string
+		userID       string
+		roleID       string
+		responseCode int
+	}{
+		{
+			name:         "actor lacks members:add in the organization",
+			token:        memberToken,
+			orgID:        orgID,
+			userID:       candidateID,
+			roleID:       viewerRoleID,
+			responseCode: http.StatusForbidden,
+		},
+		{
+			name:         "organization is not the active tenant",
+			token:        ownerToken,
+			orgID:        organizationOf(t, otherOwnerID),
+			userID:       candidateID,
+			roleID:       viewerRoleID,
+			responseCode: http.StatusForbidden,
+		},
+		{
+			name:         "already a member",
+			token:        ownerToken,
+			orgID:        orgID,
+			userID:       memberID,
+			roleID:       viewerRoleID,
+			responseCode: http.StatusBadRequest,
+		},
+		{
+			name:         "unknown role",
+			token:        ownerToken,
+			orgID:        orgID,
+			userID:       candidateID,
+			roleID:       uuid.NewString(),
+			responseCode: http.StatusBadRequest,
+		},
+		{
+			name:         "no token",
+			token:        "",
+			orgID:        orgID,
+			userID:       candidateID,
+			roleID:       viewerRoleID,
+			responseCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := newTestClient().PostV1OrganizationsOrganizationIdMembersWithResponse(
+				context.Background(),
+				uuid.MustParse(tt.orgID),
+				clientgen.AddMemberRequest{UserId: uuid.MustParse(tt.userID), RoleId: uuid.MustParse(tt.roleID)},
+				withBearerToken(tt.token),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tt.responseCode, resp.StatusCode())
+		})
+	}
+}
+
+func TestLogin_OrganizationWithoutMembershipIsForbidden(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	_, ownerID := signupAndGetToken(t, "owner@example.com", "")
+	signupAndGetToken(t, "outsider@example.com", "")
+
+	orgID := uuid.MustParse(organizationOf(t, ownerID))
+
+	resp, err := newTestClient().PostV1UsersLoginWithResponse(context.Background(), clientgen.LoginRequest{
+		Email:          "outsider@example.com",
+		Password:       "password",
+		OrganizationId: &orgID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode())
+}
+
+func TestPosts_IsolatedBetweenOrganizations(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	tokenA, _ := signupAndGetToken(t, "tenant-a@example.com", "")
+	tokenB, _ := signupAndGetToken(t, "tenant-b@example.com", "")
+
+	createResp, err := newTestClient().PostV1PostsWithResponse(ctx,
+		clientgen.CreatePostRequest{Content: "only for organization A"},
+		withBearerToken(tokenA),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, createResp.StatusCode())
+
+	respA, err := newTestClient().GetV1PostsWithResponse(ctx, nil, withBearerToken(tokenA))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, respA.StatusCode())
+	require.NotNil(t, respA.JSON200)
+	require.NotNil(t, respA.JSON200.Total)
+	assert.Equal(t, 1, *respA.JSON200.Total)
+
+	respB, err := newTestClient().GetV1PostsWithResponse(ctx, nil, withBearerToken(tokenB))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, respB.StatusCode())
+	require.NotNil(t, respB.JSON200)
+	require.NotNil(t, respB.JSON200.Total)
+	assert.Equal(t, 0, *respB.JSON200.Total)
+	assert.Empty(t, respB.JSON200.Posts)
+}
@@ -0,0 +1,116 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolls(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+
+	ownerToken, ownerID := signupAndGetToken(t, "owner@example.com", "")
+	_, memberIDStr := signupAndGetToken(t, "member@example.com", "")
+
+	orgID := organizationOf(t, ownerID)
+	joinOrganization(t, orgID, memberIDStr, viewerRoleID)
+	memberToken := loginToOrganization(t, "member@example.com", orgID)
+
+	created, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{
+		Content: "Which day works?",
+		Poll: &clientgen.CreatePollRequest{
+			Options:  []string{"Tue", "Wed", "Thu"},
+			ClosesAt: time.Now().Add(time.Hour),
+		},
+	}, withBearerToken(ownerToken))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, created.StatusCode())
+	require.NotNil(t, created.JSON201.Poll)
+	require.Len(t, created.JSON201.Poll.Options, 3)
+
+	postID := created.JSON201.Id
+	options := created.JSON201.Poll.Options
+
+	vote := func(t *testing.T, token string, optionIDs ...uuid.UUID) int {
+		t.Helper()
+
+		resp, err := c.PostV1PostsPostIdPollVotesWithResponse(ctx, postID,
+			clientgen.VotePollRequest{OptionIds: optionIDs}, withBearerToken(token))
+		require.NoError(t, err)
+
+		return resp.StatusCode()
+	}
+
+	getPoll := func(t *testing.T, token string) clientgen.Poll {
+		t.Helper()
+
+		resp, err := c.GetV1PostsPostIdWithResponse(ctx, postID, withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200.Poll)
+
+		return *resp.JSON200.Poll
+	}
+
+	t.Run("invalid polls are rejected", func(t *testing.T) {
+		resp, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{
+			Content: "Which day works?",
+			Poll: &clientgen.CreatePollRequest{
+				Options:  []string{"Tue", "Tue"},
+				ClosesAt: time.Now().Add(time.Hour),
+			},
+		}, withBearerToken(ownerToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+
+	t.Run("results stay hidden until the caller votes", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, vote(t, ownerToken, options[1].Id))
+
+		poll := getPoll(t, memberToken)
+		assert.True(t, poll.ResultsHidden)
+		assert.False(t, poll.Closed)
+		assert.Nil(t, poll.VoterCount)
+		assert.Nil(t, poll.Options[1].VoteCount)
+		assert.Empty(t, poll.MyVotes)
+
+		poll = getPoll(t, ownerToken)
+		assert.False(t, poll.ResultsHidden)
+		require.NotNil(t, poll.VoterCount)
+		assert.Equal(t, 1, *poll.VoterCount)
+		assert.Equal(t, 1, *poll.Options[1].VoteCount)
+		assert.Equal(t, []uuid.UUID{options[1].Id}, poll.MyVotes)
+	})
+
+	t.Run("votes are final and checked", func(t *testing.T) {
+		// A single-choice poll takes one option, and only its own.
+		assert.Equal(t, http.StatusBadRequest, vote(t, memberToken, options[0].Id, options[1].Id))
+		assert.Equal(t, http.StatusBadRequest, vote(t, memberToken, uuid.New()))
+
+		assert.Equal(t, http.StatusNoContent, vote(t, memberToken, options[0].Id))
+		assert.Equal(t, http.StatusBadRequest, vote(t, memberToken, options[2].Id))
+
+		poll := getPoll(t, memberToken)
+		assert.Equal(t, 2, *poll.VoterCount)
+		assert.Equal(t, 1, *poll.Options[0].VoteCount)
+		assert.Equal(t, 0, *poll.Options[2].VoteCount)
+	})
+
+	t.Run("posts without a poll cannot be voted on", func(t *testing.T) {
+		resp, err := c.PostV1PostsPostIdPollVotesWithResponse(ctx, uuid.New(),
+			clientgen.VotePollRequest{OptionIds: []uuid.UUID{options[0].Id}}, withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+}
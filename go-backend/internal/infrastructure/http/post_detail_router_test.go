@@ -0,0 +1,115 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostDetail(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+
+	// The owner of the organization holds posts:moderate there; the other members are viewers.
+	ownerToken, ownerID := signupAndGetToken(t, "owner@example.com", "")
+	_, authorID := signupAndGetToken(t, "author@example.com", "")
+	_, memberID := signupAndGetToken(t, "member@example.com", "")
+	outsiderToken, _ := signupAndGetToken(t, "outsider@example.com", "")
+
+	orgID := organizationOf(t, ownerID)
+	joinOrganization(t, orgID, authorID, viewerRoleID)
+	joinOrganization(t, orgID, memberID, viewerRoleID)
+	authorToken := loginToOrganization(t, "author@example.com", orgID)
+	memberToken := loginToOrganization(t, "member@example.com", orgID)
+
+	created, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{Content: "typo"},
+		withBearerToken(authorToken))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, created.StatusCode())
+	require.NotNil(t, created.JSON201)
+
+	postID := created.JSON201.Id
+	assert.Equal(t, created.JSON201.CreatedAt, created.JSON201.UpdatedAt)
+
+	t.Run("members of the organization can read the post", func(t *testing.T) {
+		resp, err := c.GetV1PostsPostIdWithResponse(ctx, postID, withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, "typo", resp.JSON200.Content)
+	})
+
+	t.Run("other organizations see 404", func(t *testing.T) {
+		resp, err := c.GetV1PostsPostIdWithResponse(ctx, postID, withBearerToken(outsiderToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+		require.NotNil(t, resp.ApplicationproblemJSON404)
+		assert.Equal(t, "NOT_FOUND", resp.ApplicationproblemJSON404.Type)
+	})
+
+	t.Run("members other than the author cannot edit", func(t *testing.T) {
+		resp, err := c.PatchV1PostsPostIdWithResponse(ctx, postID,
+			clientgen.UpdatePostRequest{Content: "vandalised"}, withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode())
+	})
+
+	t.Run("empty content is rejected", func(t *testing.T) {
+		resp, err := c.PatchV1PostsPostIdWithResponse(ctx, postID,
+			clientgen.UpdatePostRequest{Content: " "}, withBearerToken(authorToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+
+	t.Run("the author can edit", func(t *testing.T) {
+		resp, err := c.PatchV1PostsPostIdWithResponse(ctx, postID,
+			clientgen.UpdatePostRequest{Content: "fixed"}, withBearerToken(authorToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, "fixed", resp.JSON200.Content)
+		assert.True(t, resp.JSON200.UpdatedAt.After(resp.JSON200.CreatedAt))
+	})
+
+	t.Run("members other than the author cannot delete", func(t *testing.T) {
+		resp, err := c.DeleteV1PostsPostIdWithResponse(ctx, postID, withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode())
+	})
+
+	t.Run("a moderator can delete", func(t *testing.T) {
+		resp, err := c.DeleteV1PostsPostIdWithResponse(ctx, postID, withBearerToken(ownerToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode())
+
+		get, err := c.GetV1PostsPostIdWithResponse(ctx, postID, withBearerToken(authorToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, get.StatusCode())
+	})
+
+	t.Run("unknown posts return 404", func(t *testing.T) {
+		patch, err := c.PatchV1PostsPostIdWithResponse(ctx, uuid.New(),
+			clientgen.UpdatePostRequest{Content: "fixed"}, withBearerToken(authorToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, patch.StatusCode())
+
+		del, err := c.DeleteV1PostsPostIdWithResponse(ctx, postID, withBearerToken(authorToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, del.StatusCode())
+	})
+
+	t.Run("missing token returns 401", func(t *testing.T) {
+		resp, err := c.GetV1PostsPostIdWithResponse(ctx, postID)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	})
+}
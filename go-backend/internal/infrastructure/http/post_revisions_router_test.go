@@ -0,0 +1,142 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostRevisions(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+
+	// The owner moderates the organization; the author is a viewer.
+	ownerToken, ownerID := signupAndGetToken(t, "owner@example.com", "")
+	_, authorID := signupAndGetToken(t, "author@example.com", "")
+	outsiderToken, _ := signupAndGetToken(t, "outsider@example.com", "")
+
+	orgID := organizationOf(t, ownerID)
+	joinOrganization(t, orgID, authorID, viewerRoleID)
+	authorToken := loginToOrganization(t, "author@example.com", orgID)
+
+	created, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{Content: "hello\ntypo"},
+		withBearerToken(authorToken))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, created.StatusCode())
+	require.NotNil(t, created.JSON201)
+	assert.False(t, created.JSON201.Edited)
+	assert.Equal(t, 1, created.JSON201.RevisionCount)
+
+	postID := created.JSON201.Id
+
+	edit := func(t *testing.T, token, content string) *clientgen.PostResponse {
+		t.Helper()
+
+		resp, err := c.PatchV1PostsPostIdWithResponse(ctx, postID,
+			clientgen.UpdatePostRequest{Content: content}, withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+
+		return resp.JSON200
+	}
+
+	t.Run("every edit adds a revision", func(t *testing.T) {
+		first := edit(t, authorToken, "hello\nfixed")
+		assert.True(t, first.Edited)
+		assert.Equal(t, 2, first.RevisionCount)
+
+		second := edit(t, ownerToken, "hello\nfixed\nmoderated")
+		assert.Equal(t, 3, second.RevisionCount)
+	})
+
+	t.Run("saving identical content adds no revision", func(t *testing.T) {
+		same := edit(t, authorToken, "hello\nfixed\nmoderated")
+		assert.Equal(t, 3, same.RevisionCount)
+	})
+
+	t.Run("the post exposes the edited flag and revision count", func(t *testing.T) {
+		resp, err := c.GetV1PostsPostIdWithResponse(ctx, postID, withBearerToken(authorToken))
+		require.NoError(t, err)
+		require.NotNil(t, resp.JSON200)
+		assert.True(t, resp.JSON200.Edited)
+		assert.Equal(t, 3, resp.JSON200.RevisionCount)
+	})
+
+	t.Run("the history lists every version with its editor", func(t *testing.T) {
+		resp, err := c.GetV1PostsPostIdRevisionsWithResponse(ctx, postID, withBearerToken(authorToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+
+		revisions := resp.JSON200.Revisions
+		require.Len(t, revisions, 3)
+		assert.Equal(t, []int{1, 2, 3}, []int{revisions[0].Number, revisions[1].Number, revisions[2].Number})
+		assert.Equal(t, "hello\ntypo", revisions[0].Content)
+		assert.Equal(t, "hello\nfixed\nmoderated", revisions[2].Content)
+		require.NotNil(t, revisions[0].EditorId)
+		assert.Equal(t, authorID, revisions[0].EditorId.String())
+		require.NotNil(t, revisions[2].EditorId)
+		assert.Equal(t, ownerID, revisions[2].EditorId.String())
+	})
+
+	t.Run("two revisions can be diffed", func(t *testing.T) {
+		resp, err := c.GetV1PostsPostIdRevisionsDiffWithResponse(ctx, postID,
+			&clientgen.GetV1PostsPostIdRevisionsDiffParams{From: 1, To: 3}, withBearerToken(authorToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, 1, resp.JSON200.From.Number)
+		assert.Equal(t, 3, resp.JSON200.To.Number)
+		assert.Equal(t, []clientgen.PostRevisionDiffLine{
+			{Operation: "EQUAL", Text: "hello"},
+			{Operation: "DELETE", Text: "typo"},
+			{Operation: "INSERT", Text: "fixed"},
+			{Operation: "INSERT", Text: "moderated"},
+		}, resp.JSON200.Lines)
+	})
+
+	t.Run("unknown revision numbers return 404", func(t *testing.T) {
+		resp, err := c.GetV1PostsPostIdRevisionsDiffWithResponse(ctx, postID,
+			&clientgen.GetV1PostsPostIdRevisionsDiffParams{From: 1, To: 4}, withBearerToken(authorToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+
+	t.Run("revision numbers below 1 return 400", func(t *testing.T) {
+		resp, err := c.GetV1PostsPostIdRevisionsDiffWithResponse(ctx, postID,
+			&clientgen.GetV1PostsPostIdRevisionsDiffParams{From: 0, To: 1}, withBearerToken(authorToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+
+	t.Run("other organizations and unknown posts see 404", func(t *testing.T) {
+		hidden, err := c.GetV1PostsPostIdRevisionsWithResponse(ctx, postID, withBearerToken(outsiderToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, hidden.StatusCode())
+
+		hiddenDiff, err := c.GetV1PostsPostIdRevisionsDiffWithResponse(ctx, postID,
+			&clientgen.GetV1PostsPostIdRevisionsDiffParams{From: 1, To: 2}, withBearerToken(outsiderToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, hiddenDiff.StatusCode())
+
+		unknown, err := c.GetV1PostsPostIdRevisionsWithResponse(ctx, uuid.New(), withBearerToken(authorToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, unknown.StatusCode())
+	})
+
+	t.Run("missing token returns 401", func(t *testing.T) {
+		resp, err := c.GetV1PostsPostIdRevisionsWithResponse(ctx, postID)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	})
+}
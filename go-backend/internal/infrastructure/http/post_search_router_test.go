@@ -0,0 +1,79 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostSearch(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+	token, _ := signupAndGetToken(t, "search@example.com", "")
+	outsiderToken, _ := signupAndGetToken(t, "search-outsider@example.com", "")
+
+	for _, content := range []string{"あしたはとうきょうでべんきょうかい", "Weekly release notes", "Lunch menu"} {
+		resp, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{Content: content},
+			withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+	}
+
+	t.Run("returns ranked results with highlighted snippets", func(t *testing.T) {
+		resp, err := c.GetV1PostsSearchWithResponse(ctx, &clientgen.GetV1PostsSearchParams{Q: "release"},
+			withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		require.Len(t, resp.JSON200.Results, 1)
+		assert.False(t, resp.JSON200.HasMore)
+
+		result := resp.JSON200.Results[0]
+		assert.Equal(t, "Weekly release notes", result.Post.Content)
+		assert.Equal(t, []clientgen.SnippetSegment{
+			{Text: "Weekly ", Highlighted: false},
+			{Text: "release", Highlighted: true},
+			{Text: " notes", Highlighted: false},
+		}, result.Snippet)
+	})
+
+	t.Run("matches Japanese text", func(t *testing.T) {
+		resp, err := c.GetV1PostsSearchWithResponse(ctx, &clientgen.GetV1PostsSearchParams{Q: "とうきょう"},
+			withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		require.Len(t, resp.JSON200.Results, 1)
+		assert.Contains(t, resp.JSON200.Results[0].Snippet, clientgen.SnippetSegment{Text: "とうきょう", Highlighted: true})
+	})
+
+	t.Run("other organizations find nothing", func(t *testing.T) {
+		resp, err := c.GetV1PostsSearchWithResponse(ctx, &clientgen.GetV1PostsSearchParams{Q: "release"},
+			withBearerToken(outsiderToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		assert.Empty(t, resp.JSON200.Results)
+	})
+
+	t.Run("a query without words returns 400", func(t *testing.T) {
+		resp, err := c.GetV1PostsSearchWithResponse(ctx, &clientgen.GetV1PostsSearchParams{Q: `""`},
+			withBearerToken(token))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+
+	t.Run("missing token returns 401", func(t *testing.T) {
+		resp, err := c.GetV1PostsSearchWithResponse(ctx, &clientgen.GetV1PostsSearchParams{Q: "release"})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	})
+}
@@ -0,0 +1,169 @@
+//go:build integration
+
+package http_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ndjsonContentType = "application/x-ndjson"
+
+func exportPosts(t *testing.T, token string) []clientgen.ExportedPost {
+	t.Helper()
+
+	resp, err := newTestClient().GetV1PostsExportWithResponse(context.Background(), withBearerToken(token))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.Equal(t, ndjsonContentType, resp.HTTPResponse.Header.Get("Content-Type"))
+
+	var posts []clientgen.ExportedPost
+
+	scanner := bufio.NewScanner(bytes.NewReader(resp.Body))
+	for scanner.Scan() {
+		var post clientgen.ExportedPost
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &post))
+
+		posts = append(posts, post)
+	}
+
+	require.NoError(t, scanner.Err())
+
+	return posts
+}
+
+func TestImportAndExportPosts(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	adminToken, adminID := signupAndGetToken(t, "admin@example.com", adminRoleID)
+	_, memberID := signupAndGetToken(t, "member@example.com", "")
+	_, outsiderID := signupAndGetToken(t, "outsider@example.com", "")
+	orgID := organizationOf(t, adminID)
+	joinOrganization(t, orgID, memberID, viewerRoleID)
+
+	// A draft is neither exported nor importable, so it must not show up below.
+	draft := true
+	draftResp, err := newTestClient().PostV1PostsWithResponse(ctx,
+		clientgen.CreatePostRequest{Content: "draft", Draft: &draft}, withBearerToken(adminToken),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, draftResp.StatusCode())
+
+	lines := strings.Join([]string{
+		fmt.Sprintf(`{"userId":%q,"content":"old #news","createdAt":"2020-01-01T00:00:00Z"}`, memberID),
+		fmt.Sprintf(`{"userId":%q,"content":"newer","visibility":"followers","createdAt":"2021-01-01T00:00:00Z"}`,
+			adminID),
+		fmt.Sprintf(`{"userId":%q,"content":"not a member"}`, outsiderID),
+		`{"content":"no author"}`,
+	}, "\n")
+
+	resp, err := newTestClient().PostV1AdminPostsImportWithBodyWithResponse(ctx, ndjsonContentType,
+		strings.NewReader(lines), withBearerToken(adminToken),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+	require.NotNil(t, resp.JSON200)
+	assert.Equal(t, 2, resp.JSON200.Imported)
+	assert.Equal(t, 2, resp.JSON200.Failed)
+	assert.Equal(t, []clientgen.ImportPostError{
+		{Line: 3, Message: "userId is not a member of the organization"},
+		{Line: 4, Message: "userId must be a UUID"},
+	}, resp.JSON200.Errors)
+
+	exported := exportPosts(t, adminToken)
+	require.Len(t, exported, 2)
+	assert.Equal(t, memberID, exported[0].UserId.String())
+	assert.Equal(t, "old #news", exported[0].Content)
+	assert.True(t, exported[0].CreatedAt.Equal(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, adminID, exported[1].UserId.String())
+	assert.Equal(t, clientgen.Followers, exported[1].Visibility)
+
+	// Imported posts get their first revision and their hashtags like any other post.
+	var revisions int
+	inOrganization(t, orgID, func(ctx context.Context, tx pgx.Tx) error {
+		return tx.QueryRow(ctx,
+			"SELECT COUNT(*) FROM post_revisions WHERE post_id = $1 AND number = 1", exported[0].Id,
+		).Scan(&revisions)
+	})
+	assert.Equal(t, 1, revisions)
+
+	tagResp, err := newTestClient().GetV1HashtagsTagPostsWithResponse(ctx, "news", nil, withBearerToken(adminToken))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, tagResp.StatusCode())
+	require.NotNil(t, tagResp.JSON200)
+	require.Len(t, tagResp.JSON200.Posts, 1)
+	assert.Equal(t, exported[0].Id, tagResp.JSON200.Posts[0].Id)
+
+	// An export can be imported again as it is.
+	var body bytes.Buffer
+	for _, post := range exported {
+		require.NoError(t, json.NewEncoder(&body).Encode(post))
+	}
+
+	resp, err = newTestClient().PostV1AdminPostsImportWithBodyWithResponse(ctx, ndjsonContentType, &body,
+		withBearerToken(adminToken),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+	require.NotNil(t, resp.JSON200)
+	assert.Equal(t, 2, resp.JSON200.Imported)
+	assert.Empty(t, resp.JSON200.Errors)
+	assert.Len(t, exportPosts(t, adminToken), 4)
+}
+
+func TestImportAndExportPosts_FailureCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	adminToken, adminID := signupAndGetToken(t, "admin@example.com", adminRoleID)
+	viewerToken, _ := signupAndGetToken(t, "viewer@example.com", viewerRoleID)
+	validLine := fmt.Sprintf(`{"userId":%q,"content":"imported"}`, adminID)
+
+	tests := []struct {
+		name         string
+		token        string
+		body         string
+		responseCode int
+	}{
+		{name: "lacks posts:import", token: viewerToken, body: validLine, responseCode: http.StatusForbidden},
+		{
+			name:         "line too long",
+			token:        adminToken,
+			body:         validLine + "\n" + strings.Repeat("x", 1<<20+1),
+			responseCode: http.StatusBadRequest,
+		},
+		{name: "no token", token: "", body: validLine, responseCode: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run("import: "+tt.name, func(t *testing.T) {
+			resp, err := newTestClient().PostV1AdminPostsImportWithBodyWithResponse(ctx, ndjsonContentType,
+				strings.NewReader(tt.body), withBearerToken(tt.token),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tt.responseCode, resp.StatusCode())
+		})
+	}
+
+	// Nothing was imported by the failed requests, not even the valid line before the long one.
+	assert.Empty(t, exportPosts(t, adminToken))
+
+	t.Run("export: lacks posts:export", func(t *testing.T) {
+		resp, err := newTestClient().GetV1PostsExportWithResponse(ctx, withBearerToken(viewerToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode())
+	})
+}
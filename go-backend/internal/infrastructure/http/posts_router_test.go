@@ -6,6 +6,7 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
 	"github.com/stretchr/testify/assert"
@@ -39,7 +40,8 @@ func TestListPosts(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, resp.StatusCode())
 		require.NotNil(t, resp.JSON200)
-		assert.Equal(t, 0, resp.JSON200.Total)
+		require.NotNil(t, resp.JSON200.Total)
+		assert.Equal(t, 0, *resp.JSON200.Total)
 		assert.NotNil(t, resp.JSON200.Posts)
 		assert.Empty(t, resp.JSON200.Posts)
 		assert.Equal(t, 20, resp.JSON200.Limit)
@@ -72,7 +74,8 @@ func TestListPosts(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, resp.StatusCode())
 		require.NotNil(t, resp.JSON200)
-		assert.Equal(t, 1, resp.JSON200.Total)
+		require.NotNil(t, resp.JSON200.Total)
+		assert.Equal(t, 1, *resp.JSON200.Total)
 		assert.Len(t, resp.JSON200.Posts, 1)
 		assert.Equal(t, limit, resp.JSON200.Limit)
 		assert.Equal(t, offset, resp.JSON200.Offset)
@@ -120,9 +123,13 @@ func TestListPosts(t *testing.T) {
 		require.NoError(t, err)
 	})
 
-	t.Run("all posts appear regardless of authenticated user", func(t *testing.T) {
-		tokenA, _ := signupAndGetToken(t, "multiuser-a@example.com", "")
-		tokenB, _ := signupAndGetToken(t, "multiuser-b@example.com", "")
+	t.Run("all posts in the organization appear regardless of authenticated user", func(t *testing.T) {
+		tokenA, userAID := signupAndGetToken(t, "multiuser-a@example.com", "")
+		_, userBID := signupAndGetToken(t, "multiuser-b@example.com", "")
+
+		orgID := organizationOf(t, userAID)
+		joinOrganization(t, orgID, userBID, viewerRoleID)
+		tokenB := loginToOrganization(t, "multiuser-b@example.com", orgID)
 		c := newTestClient()
 
 		// User A creates a post
@@ -135,12 +142,13 @@ func TestListPosts(t *testing.T) {
 		require.Equal(t, http.StatusCreated, createResp.StatusCode())
 		postID := createResp.JSON201.Id
 
-		// User B fetches all posts — should include User A's post (no user filtering, Issue #58)
+		// User B fetches all posts of the organization — should include User A's post (no user filtering, Issue #58)
 		resp, err := c.GetV1PostsWithResponse(context.Background(), nil, withBearerToken(tokenB))
 		require.NoError(t, err)
 		require.Equal(t, http.StatusOK, resp.StatusCode())
 		require.NotNil(t, resp.JSON200)
-		require.GreaterOrEqual(t, resp.JSON200.Total, 1)
+		require.NotNil(t, resp.JSON200.Total)
+		require.GreaterOrEqual(t, *resp.JSON200.Total, 1)
 
 		found := false
 		for _, p := range resp.JSON200.Posts {
@@ -178,7 +186,8 @@ func TestListPosts(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, http.StatusOK, resp.StatusCode())
 		require.NotNil(t, resp.JSON200)
-		assert.Equal(t, 3, resp.JSON200.Total)
+		require.NotNil(t, resp.JSON200.Total)
+		assert.Equal(t, 3, *resp.JSON200.Total)
 		assert.Len(t, resp.JSON200.Posts, 1)
 
 		err = testDb.Cleanup()
@@ -186,6 +195,87 @@ func TestListPosts(t *testing.T) {
 	})
 }
 
+func TestListPostsCursorPaging(t *testing.T) {
+	t.Run("cursors page through the feed without offsets", func(t *testing.T) {
+		token, _ := signupAndGetToken(t, "cursor-user@example.com", "")
+		c := newTestClient()
+
+		for _, content := range []string{"first", "second", "third"} {
+			_, err := c.PostV1PostsWithResponse(
+				context.Background(),
+				clientgen.CreatePostRequest{Content: content},
+				withBearerToken(token),
+			)
+			require.NoError(t, err)
+		}
+
+		limit := 2
+		first, err := c.GetV1PostsWithResponse(
+			context.Background(), &clientgen.GetV1PostsParams{Limit: &limit}, withBearerToken(token),
+		)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, first.StatusCode())
+		require.NotNil(t, first.JSON200)
+		require.Len(t, first.JSON200.Posts, 2)
+		assert.Equal(t, "third", first.JSON200.Posts[0].Content)
+		assert.Nil(t, first.JSON200.PrevCursor)
+		require.NotNil(t, first.JSON200.NextCursor)
+
+		second, err := c.GetV1PostsWithResponse(
+			context.Background(),
+			&clientgen.GetV1PostsParams{Limit: &limit, After: first.JSON200.NextCursor},
+			withBearerToken(token),
+		)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, second.StatusCode())
+		require.NotNil(t, second.JSON200)
+		require.Len(t, second.JSON200.Posts, 1)
+		assert.Equal(t, "first", second.JSON200.Posts[0].Content)
+		// Cursor pages skip the count unless it is asked for.
+		assert.Nil(t, second.JSON200.Total)
+		assert.Nil(t, second.JSON200.NextCursor)
+		require.NotNil(t, second.JSON200.PrevCursor)
+
+		includeTotal := true
+		back, err := c.GetV1PostsWithResponse(
+			context.Background(),
+			&clientgen.GetV1PostsParams{Limit: &limit, Before: second.JSON200.PrevCursor, IncludeTotal: &includeTotal},
+			withBearerToken(token),
+		)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, back.StatusCode())
+		require.NotNil(t, back.JSON200)
+		assert.Equal(t, first.JSON200.Posts, back.JSON200.Posts)
+		require.NotNil(t, back.JSON200.Total)
+		assert.Equal(t, 3, *back.JSON200.Total)
+
+		err = testDb.Cleanup()
+		require.NoError(t, err)
+	})
+
+	t.Run("tampered or conflicting cursors return 400", func(t *testing.T) {
+		token, _ := signupAndGetToken(t, "cursor-invalid@example.com", "")
+		c := newTestClient()
+
+		tampered := "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA.AAAA"
+		offset := 1
+
+		for _, params := range []clientgen.GetV1PostsParams{
+			{After: &tampered},
+			{After: &tampered, Before: &tampered},
+			{Before: &tampered, Offset: &offset},
+		} {
+			resp, err := c.GetV1PostsWithResponse(context.Background(), &params, withBearerToken(token))
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+			require.NotNil(t, resp.ApplicationproblemJSON400)
+			assert.Equal(t, "VALIDATION_ERROR", resp.ApplicationproblemJSON400.Type)
+		}
+
+		require.NoError(t, testDb.Cleanup())
+	})
+}
+
 func TestCreatePost(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -290,3 +380,161 @@ func TestCreatePost(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestMarkdownPosts(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+	token, _ := signupAndGetToken(t, "markdown@example.com", "")
+
+	markdown := clientgen.Markdown
+	created, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{
+		Content: "**bold** [site](https://example.com) [bad](javascript:alert(1)) <script>x</script>",
+		Format:  &markdown,
+	}, withBearerToken(token))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, created.StatusCode())
+	require.NotNil(t, created.JSON201)
+
+	postID := created.JSON201.Id
+
+	t.Run("the source is kept and the rendering is sanitised", func(t *testing.T) {
+		assert.Equal(t, clientgen.Markdown, created.JSON201.Format)
+		assert.Contains(t, created.JSON201.Content, "**bold**")
+		assert.Contains(t, created.JSON201.ContentHtml, "<strong>bold</strong>")
+		assert.Contains(t, created.JSON201.ContentHtml, `<a href="https://example.com" rel="nofollow">site</a>`)
+		assert.NotContains(t, created.JSON201.ContentHtml, "javascript:")
+		assert.NotContains(t, created.JSON201.ContentHtml, "<script>")
+	})
+
+	t.Run("posts without a format are plain text", func(t *testing.T) {
+		resp, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{Content: "**not bold**"},
+			withBearerToken(token))
+		require.NoError(t, err)
+		require.NotNil(t, resp.JSON201)
+		assert.Equal(t, clientgen.Plain, resp.JSON201.Format)
+		assert.Equal(t, "<p>**not bold**</p>\n", resp.JSON201.ContentHtml)
+	})
+
+	t.Run("changing only the format adds a revision", func(t *testing.T) {
+		plain := clientgen.Plain
+		resp, err := c.PatchV1PostsPostIdWithResponse(ctx, postID, clientgen.UpdatePostRequest{
+			Content: created.JSON201.Content,
+			Format:  &plain,
+		}, withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, clientgen.Plain, resp.JSON200.Format)
+		assert.Equal(t, 2, resp.JSON200.RevisionCount)
+		assert.Contains(t, resp.JSON200.ContentHtml, "**bold**")
+		assert.Contains(t, resp.JSON200.ContentHtml, "&lt;script&gt;")
+	})
+
+	t.Run("every revision is rendered in its own format", func(t *testing.T) {
+		resp, err := c.GetV1PostsPostIdRevisionsWithResponse(ctx, postID, withBearerToken(token))
+		require.NoError(t, err)
+		require.NotNil(t, resp.JSON200)
+
+		revisions := resp.JSON200.Revisions
+		require.Len(t, revisions, 2)
+		assert.Equal(t, clientgen.Markdown, revisions[0].Format)
+		assert.Contains(t, revisions[0].ContentHtml, "<strong>bold</strong>")
+		assert.Equal(t, clientgen.Plain, revisions[1].Format)
+		assert.NotContains(t, revisions[1].ContentHtml, "<strong>")
+	})
+
+	t.Run("unknown formats return 400", func(t *testing.T) {
+		unknown := clientgen.ContentFormat("html")
+		resp, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{Content: "x", Format: &unknown},
+			withBearerToken(token))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+}
+
+func TestPostVisibilityAndDrafts(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	authorToken, authorID := signupAndGetToken(t, "drafts-author@example.com", "")
+	_, otherID := signupAndGetToken(t, "drafts-other@example.com", "")
+	orgID := organizationOf(t, authorID)
+	joinOrganization(t, orgID, otherID, viewerRoleID)
+	otherToken := loginToOrganization(t, "drafts-other@example.com", orgID)
+	c := newTestClient()
+
+	draft := true
+	private := clientgen.Private
+	draftResp, err := c.PostV1PostsWithResponse(
+		ctx, clientgen.CreatePostRequest{Content: "not yet", Draft: &draft}, withBearerToken(authorToken),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, draftResp.StatusCode())
+	assert.Equal(t, clientgen.Draft, draftResp.JSON201.Status)
+	assert.Equal(t, clientgen.Public, draftResp.JSON201.Visibility)
+
+	privateResp, err := c.PostV1PostsWithResponse(
+		ctx, clientgen.CreatePostRequest{Content: "diary", Visibility: &private}, withBearerToken(authorToken),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, privateResp.StatusCode())
+
+	listStatus := func(t *testing.T, token string, status *clientgen.PostStatus) []string {
+		t.Helper()
+
+		resp, err := c.GetV1PostsWithResponse(ctx, &clientgen.GetV1PostsParams{Status: status}, withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+
+		contents := make([]string, len(resp.JSON200.Posts))
+		for i, p := range resp.JSON200.Posts {
+			contents[i] = p.Content
+		}
+
+		return contents
+	}
+	drafts := clientgen.Draft
+
+	t.Run("drafts and private posts of other users never leak", func(t *testing.T) {
+		assert.Empty(t, listStatus(t, otherToken, nil))
+		assert.Empty(t, listStatus(t, otherToken, &drafts))
+
+		resp, err := c.GetV1PostsPostIdWithResponse(ctx, privateResp.JSON201.Id, withBearerToken(otherToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+
+	t.Run("the author sees their own drafts and private posts", func(t *testing.T) {
+		assert.Equal(t, []string{"diary"}, listStatus(t, authorToken, nil))
+		assert.Equal(t, []string{"not yet"}, listStatus(t, authorToken, &drafts))
+	})
+
+	t.Run("only the author publishes a draft", func(t *testing.T) {
+		resp, err := c.PostV1PostsPostIdPublishWithResponse(ctx, draftResp.JSON201.Id, withBearerToken(otherToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+
+		resp, err = c.PostV1PostsPostIdPublishWithResponse(ctx, draftResp.JSON201.Id, withBearerToken(authorToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		assert.Equal(t, clientgen.Published, resp.JSON200.Status)
+
+		assert.Equal(t, []string{"not yet"}, listStatus(t, otherToken, nil))
+
+		// Publishing twice is rejected.
+		resp, err = c.PostV1PostsPostIdPublishWithResponse(ctx, draftResp.JSON201.Id, withBearerToken(authorToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+
+	t.Run("a publication time in the past is rejected", func(t *testing.T) {
+		past := time.Now().Add(-time.Hour)
+		resp, err := c.PostV1PostsWithResponse(
+			ctx, clientgen.CreatePostRequest{Content: "late", PublishAt: &past}, withBearerToken(authorToken),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+}
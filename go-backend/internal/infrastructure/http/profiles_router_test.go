@@ -0,0 +1,119 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfilesAndPins(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+
+	ownerToken, ownerIDStr := signupAndGetToken(t, "owner@example.com", "")
+	_, memberIDStr := signupAndGetToken(t, "member@example.com", "")
+	_, outsiderIDStr := signupAndGetToken(t, "outsider@example.com", "")
+
+	orgID := organizationOf(t, ownerIDStr)
+	joinOrganization(t, orgID, memberIDStr, viewerRoleID)
+	memberToken := loginToOrganization(t, "member@example.com", orgID)
+	ownerID, memberID := uuid.MustParse(ownerIDStr), uuid.MustParse(memberIDStr)
+
+	pin := func(t *testing.T, token string, postID uuid.UUID) int {
+		t.Helper()
+
+		resp, err := c.PutV1PostsPostIdPinWithResponse(ctx, postID, withBearerToken(token))
+		require.NoError(t, err)
+
+		return resp.StatusCode()
+	}
+
+	postIDs := make([]uuid.UUID, 4)
+	for i := range postIDs {
+		created, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{Content: "post"},
+			withBearerToken(ownerToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, created.StatusCode())
+
+		postIDs[i] = created.JSON201.Id
+	}
+
+	t.Run("only the user themself can update their profile", func(t *testing.T) {
+		bio, avatarURL := "Gopher", "https://example.com/a.png"
+
+		resp, err := c.PutV1UsersUserIdProfileWithResponse(ctx, ownerID,
+			clientgen.UpdateProfileRequest{Bio: &bio, AvatarUrl: &avatarURL}, withBearerToken(ownerToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode())
+
+		resp, err = c.PutV1UsersUserIdProfileWithResponse(ctx, ownerID,
+			clientgen.UpdateProfileRequest{Bio: &bio}, withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode())
+
+		insecure := "http://example.com/a.png"
+		resp, err = c.PutV1UsersUserIdProfileWithResponse(ctx, memberID,
+			clientgen.UpdateProfileRequest{AvatarUrl: &insecure}, withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+
+	t.Run("pins are limited to the author's own posts", func(t *testing.T) {
+		for _, id := range postIDs[:3] {
+			assert.Equal(t, http.StatusNoContent, pin(t, ownerToken, id))
+		}
+
+		assert.Equal(t, http.StatusNoContent, pin(t, ownerToken, postIDs[0]), "pinning again changes nothing")
+		assert.Equal(t, http.StatusBadRequest, pin(t, ownerToken, postIDs[3]))
+		assert.Equal(t, http.StatusBadRequest, pin(t, memberToken, postIDs[3]))
+		assert.Equal(t, http.StatusNotFound, pin(t, ownerToken, uuid.New()))
+
+		resp, err := c.DeleteV1PostsPostIdPinWithResponse(ctx, postIDs[1], withBearerToken(ownerToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode())
+
+		assert.Equal(t, http.StatusNoContent, pin(t, ownerToken, postIDs[3]))
+	})
+
+	t.Run("profiles show public fields only", func(t *testing.T) {
+		resp, err := c.GetV1UsersUserIdProfileWithResponse(ctx, ownerID, withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+
+		profile := resp.JSON200
+		assert.Equal(t, "Gopher", profile.Bio)
+		require.NotNil(t, profile.AvatarUrl)
+		assert.Equal(t, "https://example.com/a.png", *profile.AvatarUrl)
+		assert.Equal(t, 4, profile.PostCount)
+		assert.Zero(t, profile.FollowerCount)
+
+		pinned := make([]uuid.UUID, len(profile.PinnedPosts))
+		for i, p := range profile.PinnedPosts {
+			pinned[i] = p.Id
+		}
+
+		assert.Equal(t, []uuid.UUID{postIDs[3], postIDs[2], postIDs[0]}, pinned)
+
+		var fields map[string]any
+		require.NoError(t, json.Unmarshal(resp.Body, &fields))
+		assert.NotContains(t, fields, "email")
+		assert.NotContains(t, fields, "status")
+	})
+
+	t.Run("users of other organizations have no profile here", func(t *testing.T) {
+		resp, err := c.GetV1UsersUserIdProfileWithResponse(ctx, uuid.MustParse(outsiderIDStr),
+			withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+}
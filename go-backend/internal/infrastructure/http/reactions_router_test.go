@@ -0,0 +1,119 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReactions(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+
+	ownerToken, ownerID := signupAndGetToken(t, "owner@example.com", "")
+	_, memberID := signupAndGetToken(t, "member@example.com", "")
+	outsiderToken, _ := signupAndGetToken(t, "outsider@example.com", "")
+
+	orgID := organizationOf(t, ownerID)
+	joinOrganization(t, orgID, memberID, viewerRoleID)
+	memberToken := loginToOrganization(t, "member@example.com", orgID)
+
+	created, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{Content: "post"},
+		withBearerToken(ownerToken))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, created.StatusCode())
+
+	postID := created.JSON201.Id
+
+	react := func(t *testing.T, token string, reactionType clientgen.ReactionType) int {
+		t.Helper()
+
+		resp, err := c.PutV1PostsPostIdReactionsTypeWithResponse(ctx, postID, reactionType, withBearerToken(token))
+		require.NoError(t, err)
+
+		return resp.StatusCode()
+	}
+
+	unreact := func(t *testing.T, token string, reactionType clientgen.ReactionType) int {
+		t.Helper()
+
+		resp, err := c.DeleteV1PostsPostIdReactionsTypeWithResponse(ctx, postID, reactionType, withBearerToken(token))
+		require.NoError(t, err)
+
+		return resp.StatusCode()
+	}
+
+	reactionsSeenBy := func(t *testing.T, token string) clientgen.PostReactions {
+		t.Helper()
+
+		resp, err := c.GetV1PostsWithResponse(ctx, nil, withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Len(t, resp.JSON200.Posts, 1)
+		require.NotNil(t, resp.JSON200.Posts[0].Reactions)
+
+		return *resp.JSON200.Posts[0].Reactions
+	}
+
+	t.Run("reacting is idempotent", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, react(t, memberToken, clientgen.Like))
+		assert.Equal(t, http.StatusNoContent, react(t, memberToken, clientgen.Like))
+		assert.Equal(t, http.StatusNoContent, react(t, memberToken, clientgen.Wow))
+		assert.Equal(t, http.StatusNoContent, react(t, ownerToken, clientgen.Like))
+	})
+
+	t.Run("the list reports counts and the caller's reactions", func(t *testing.T) {
+		member := reactionsSeenBy(t, memberToken)
+		assert.Equal(t, map[string]int{"like": 2, "wow": 1}, member.Counts)
+		assert.Equal(t, []clientgen.ReactionType{clientgen.Like, clientgen.Wow}, member.Mine)
+
+		owner := reactionsSeenBy(t, ownerToken)
+		assert.Equal(t, member.Counts, owner.Counts)
+		assert.Equal(t, []clientgen.ReactionType{clientgen.Like}, owner.Mine)
+
+		byUser, err := c.GetV1UsersUserIdPostsWithResponse(ctx, uuid.MustParse(ownerID), nil,
+			withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Len(t, byUser.JSON200.Posts, 1)
+		require.NotNil(t, byUser.JSON200.Posts[0].Reactions)
+		assert.Equal(t, member, *byUser.JSON200.Posts[0].Reactions)
+	})
+
+	t.Run("withdrawing is idempotent", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, unreact(t, memberToken, clientgen.Like))
+		assert.Equal(t, http.StatusNoContent, unreact(t, memberToken, clientgen.Like))
+		assert.Equal(t, http.StatusNoContent, unreact(t, memberToken, clientgen.Sad))
+
+		member := reactionsSeenBy(t, memberToken)
+		assert.Equal(t, map[string]int{"like": 1, "wow": 1}, member.Counts)
+		assert.Equal(t, []clientgen.ReactionType{clientgen.Wow}, member.Mine)
+	})
+
+	t.Run("invalid reactions are rejected", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, react(t, memberToken, clientgen.ReactionType("dislike")))
+		assert.Equal(t, http.StatusBadRequest, unreact(t, memberToken, clientgen.ReactionType("dislike")))
+
+		missing, err := c.PutV1PostsPostIdReactionsTypeWithResponse(ctx, uuid.New(), clientgen.Like,
+			withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, missing.StatusCode())
+
+		// Posts of another organization do not exist for the caller.
+		assert.Equal(t, http.StatusNotFound, react(t, outsiderToken, clientgen.Like))
+	})
+
+	t.Run("missing token returns 401", func(t *testing.T) {
+		resp, err := c.PutV1PostsPostIdReactionsTypeWithResponse(ctx, postID, clientgen.Like)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	})
+}
@@ -0,0 +1,136 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReposts(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+
+	ownerToken, ownerID := signupAndGetToken(t, "owner@example.com", "")
+	_, memberIDStr := signupAndGetToken(t, "member@example.com", "")
+	memberID := uuid.MustParse(memberIDStr)
+
+	orgID := organizationOf(t, ownerID)
+	joinOrganization(t, orgID, memberIDStr, viewerRoleID)
+	memberToken := loginToOrganization(t, "member@example.com", orgID)
+
+	createPost := func(t *testing.T, token string, body clientgen.CreatePostRequest) *clientgen.PostV1PostsResponse {
+		t.Helper()
+
+		resp, err := c.PostV1PostsWithResponse(ctx, body, withBearerToken(token))
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	visibility := clientgen.Private
+	original := createPost(t, ownerToken, clientgen.CreatePostRequest{Content: "original"}).JSON201.Id
+	private := createPost(t, ownerToken,
+		clientgen.CreatePostRequest{Content: "private", Visibility: &visibility}).JSON201.Id
+
+	repost := func(t *testing.T, token string, postID uuid.UUID) int {
+		t.Helper()
+
+		resp, err := c.PutV1PostsPostIdRepostWithResponse(ctx, postID, withBearerToken(token))
+		require.NoError(t, err)
+
+		return resp.StatusCode()
+	}
+
+	getPost := func(t *testing.T, token string, postID uuid.UUID) clientgen.PostResponse {
+		t.Helper()
+
+		resp, err := c.GetV1PostsPostIdWithResponse(ctx, postID, withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+
+		return *resp.JSON200
+	}
+
+	t.Run("reposting is idempotent and counted", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, repost(t, memberToken, original))
+		assert.Equal(t, http.StatusNoContent, repost(t, memberToken, original))
+
+		// Posts the caller may not see cannot be reposted.
+		assert.Equal(t, http.StatusNotFound, repost(t, memberToken, private))
+		assert.Equal(t, http.StatusNotFound, repost(t, memberToken, uuid.New()))
+
+		p := getPost(t, memberToken, original)
+		require.NotNil(t, p.RepostCount)
+		assert.Equal(t, 1, *p.RepostCount)
+	})
+
+	t.Run("listings attribute reposts to the reposter", func(t *testing.T) {
+		resp, err := c.GetV1PostsWithResponse(ctx,
+			&clientgen.GetV1PostsParams{AuthorId: &[]uuid.UUID{memberID}}, withBearerToken(ownerToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Len(t, resp.JSON200.Posts, 1)
+
+		shared := resp.JSON200.Posts[0]
+		assert.Equal(t, memberID, shared.UserId)
+		assert.Equal(t, clientgen.Repost, *shared.Kind)
+		require.NotNil(t, shared.Original)
+		assert.True(t, shared.Original.Available)
+		assert.Equal(t, original, *shared.Original.Id)
+		assert.Equal(t, "original", *shared.Original.Content)
+	})
+
+	t.Run("quotes share the original below their content", func(t *testing.T) {
+		resp := createPost(t, memberToken, clientgen.CreatePostRequest{Content: "look", QuotedPostId: &original})
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+		assert.Equal(t, clientgen.Quote, *resp.JSON201.Kind)
+
+		quote := getPost(t, memberToken, resp.JSON201.Id)
+		assert.Equal(t, "look", quote.Content)
+		require.NotNil(t, quote.Original)
+		assert.Equal(t, original, *quote.Original.Id)
+
+		resp = createPost(t, memberToken, clientgen.CreatePostRequest{Content: "look", QuotedPostId: &private})
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+
+	t.Run("deleted originals degrade to a placeholder", func(t *testing.T) {
+		resp, err := c.DeleteV1PostsPostIdWithResponse(ctx, original, withBearerToken(ownerToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, resp.StatusCode())
+
+		list, err := c.GetV1PostsWithResponse(ctx,
+			&clientgen.GetV1PostsParams{AuthorId: &[]uuid.UUID{memberID}}, withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Len(t, list.JSON200.Posts, 2)
+
+		for _, shared := range list.JSON200.Posts {
+			require.NotNil(t, shared.Original)
+			assert.False(t, shared.Original.Available)
+			assert.Nil(t, shared.Original.Content)
+		}
+	})
+
+	t.Run("undoing a repost is idempotent", func(t *testing.T) {
+		for range 2 {
+			resp, err := c.DeleteV1PostsPostIdRepostWithResponse(ctx, original, withBearerToken(memberToken))
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusNoContent, resp.StatusCode())
+		}
+
+		list, err := c.GetV1PostsWithResponse(ctx,
+			&clientgen.GetV1PostsParams{AuthorId: &[]uuid.UUID{memberID}}, withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Len(t, list.JSON200.Posts, 1)
+		assert.Equal(t, clientgen.Quote, *list.JSON200.Posts[0].Kind)
+	})
+}
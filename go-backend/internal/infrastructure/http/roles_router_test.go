@@ -0,0 +1,153 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// viewerRoleID is the seeded read-only role.
+const viewerRoleID = "00000000-0000-0000-0000-000000000002"
+
+func TestGrantRole_HappyCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	adminToken, adminID := signupAndGetToken(t, "admin@example.com", adminRoleID)
+	_, targetID := signupAndGetToken(t, "target@example.com", "")
+
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	resp, err := newTestClient().PostV1UsersUserIdRolesWithResponse(ctx, uuid.MustParse(targetID),
+		clientgen.GrantRoleRequest{
+			RoleId:    uuid.MustParse(viewerRoleID),
+			Reason:    "temporary read access",
+			ExpiresAt: &expiresAt,
+		},
+		withBearerToken(adminToken),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode())
+	require.NotNil(t, resp.JSON201)
+	assert.Equal(t, targetID, resp.JSON201.UserId.String())
+	require.NotNil(t, resp.JSON201.GrantedBy)
+	assert.Equal(t, adminID, resp.JSON201.GrantedBy.String())
+	require.NotNil(t, resp.JSON201.ExpiresAt)
+	assert.True(t, expiresAt.Equal(*resp.JSON201.ExpiresAt))
+
+	listResp, err := newTestClient().GetV1UsersUserIdRolesWithResponse(ctx, uuid.MustParse(targetID),
+		withBearerToken(adminToken),
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, listResp.StatusCode())
+	require.NotNil(t, listResp.JSON200)
+	require.Len(t, listResp.JSON200.Assignments, 1)
+	assert.Equal(t, "viewer", listResp.JSON200.Assignments[0].RoleName)
+	require.Len(t, listResp.JSON200.History, 1)
+	assert.Equal(t, "GRANTED", listResp.JSON200.History[0].Action)
+	require.NotNil(t, listResp.JSON200.History[0].Reason)
+	assert.Equal(t, "temporary read access", *listResp.JSON200.History[0].Reason)
+}
+
+func TestGrantRole_FailureCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	adminToken, _ := signupAndGetToken(t, "admin@example.com", adminRoleID)
+	viewerToken, targetID := signupAndGetToken(t, "viewer@example.com", viewerRoleID)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name         string
+		token        string
+		request      clientgen.GrantRoleRequest
+		responseCode int
+	}{
+		{
+			name:         "lacks roles:assign",
+			token:        viewerToken,
+			request:      clientgen.GrantRoleRequest{RoleId: uuid.MustParse(adminRoleID), Reason: "self promotion"},
+			responseCode: http.StatusForbidden,
+		},
+		{
+			name:         "unknown role",
+			token:        adminToken,
+			request:      clientgen.GrantRoleRequest{RoleId: uuid.New(), Reason: "typo"},
+			responseCode: http.StatusBadRequest,
+		},
+		{
+			name:         "blank reason",
+			token:        adminToken,
+			request:      clientgen.GrantRoleRequest{RoleId: uuid.MustParse(viewerRoleID), Reason: "   "},
+			responseCode: http.StatusBadRequest,
+		},
+		{
+			name:  "expiry in the past",
+			token: adminToken,
+			request: clientgen.GrantRoleRequest{
+				RoleId: uuid.MustParse(viewerRoleID), Reason: "late", ExpiresAt: &past,
+			},
+			responseCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := newTestClient().PostV1UsersUserIdRolesWithResponse(
+				ctx, uuid.MustParse(targetID), tt.request, withBearerToken(tt.token),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tt.responseCode, resp.StatusCode())
+		})
+	}
+}
+
+func TestListRoleAssignments_Authorization(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	viewerToken, viewerID := signupAndGetToken(t, "viewer@example.com", viewerRoleID)
+	_, otherID := signupAndGetToken(t, "other@example.com", "")
+
+	own, err := newTestClient().GetV1UsersUserIdRolesWithResponse(ctx, uuid.MustParse(viewerID),
+		withBearerToken(viewerToken),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, own.StatusCode())
+
+	other, err := newTestClient().GetV1UsersUserIdRolesWithResponse(ctx, uuid.MustParse(otherID),
+		withBearerToken(viewerToken),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, other.StatusCode())
+
+	unauthenticated, err := newTestClient().GetV1UsersUserIdRolesWithResponse(ctx, uuid.MustParse(viewerID))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, unauthenticated.StatusCode())
+}
+
+func TestExpiredRole_NoLongerGrantsPermission(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	token, userID := signupAndGetToken(t, "expiring@example.com", adminRoleID)
+
+	_, err := testDb.Pool().Exec(ctx,
+		"UPDATE user_roles SET expires_at = now() - interval '1 second' WHERE user_id = $1", userID,
+	)
+	require.NoError(t, err)
+
+	resp, err := newTestClient().GetV1UsersWithResponse(ctx, &clientgen.GetV1UsersParams{},
+		withBearerToken(token),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode())
+}
@@ -1,14 +1,14 @@
 package http
 
 import (
+	"context"
 	stdhttp "net/http"
+	"slices"
 
 	generated "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/http/generated"
-	commandpost "github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
-	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
-	querypost "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
-	queryuser "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/admin"
 	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/go-chi/chi/v5"
 	"github.com/labstack/echo/v5"
 )
 
@@ -18,8 +18,9 @@ type Router interface {
 }
 
 type routerImpl struct {
-	handler    *serverHandler
-	jwtService service.JwtService
+	handler                          *serverHandler
+	jwtService                       service.JwtService
+	recordImpersonatedRequestUseCase admin.RecordImpersonatedRequestUseCase
 }
 
 func (r *routerImpl) AddRoute(e *echo.Echo) {
@@ -52,7 +53,7 @@ func (r *routerImpl) AddRoute(e *echo.Echo) {
 
 	wrap := func(h func(stdhttp.ResponseWriter, *stdhttp.Request)) echo.HandlerFunc {
 		return func(c *echo.Context) error {
-			h(c.Response(), c.Request())
+			h(c.Response(), withChiURLParams(c))
 
 			return nil
 		}
@@ -61,11 +62,102 @@ func (r *routerImpl) AddRoute(e *echo.Echo) {
 	// Public routes.
 	e.POST("/v1/users/signup", wrap(siw.PostV1UsersSignup))
 	e.POST("/v1/users/login", wrap(siw.PostV1UsersLogin))
+	e.GET("/v1/invitations/:token", wrap(siw.GetV1InvitationsToken))
 
-	// Protected routes — JWT validation is enforced by the middleware.
-	e.GET("/v1/users", wrap(siw.GetV1Users), JWTMiddleware(r.jwtService))
-	e.GET("/v1/posts", wrap(siw.GetV1Posts), JWTMiddleware(r.jwtService))
-	e.POST("/v1/posts", wrap(siw.PostV1Posts), JWTMiddleware(r.jwtService))
+	// Protected routes — JWT validation is enforced by the middleware, and requests
+	// made with an impersonation token are written to the audit log.
+	authenticated := []echo.MiddlewareFunc{
+		JWTMiddleware(r.jwtService),
+		ImpersonationAuditMiddleware(r.recordImpersonatedRequestUseCase),
+	}
+	// Sensitive routes additionally refuse impersonation tokens.
+	sensitive := append(slices.Clone(authenticated), RejectImpersonationMiddleware())
+	// Tenant-scoped routes need an organization to act in.
+	tenant := append(slices.Clone(authenticated), RequireTenantMiddleware())
+	sensitiveTenant := append(slices.Clone(sensitive), RequireTenantMiddleware())
+
+	e.GET("/v1/users", wrap(siw.GetV1Users), tenant...)
+	e.GET("/v1/users/:userId/roles", wrap(siw.GetV1UsersUserIdRoles), authenticated...)
+	e.GET("/v1/users/:userId/posts", wrap(siw.GetV1UsersUserIdPosts), tenant...)
+	e.PUT("/v1/users/:userId/follow", wrap(siw.PutV1UsersUserIdFollow), tenant...)
+	e.DELETE("/v1/users/:userId/follow", wrap(siw.DeleteV1UsersUserIdFollow), tenant...)
+	e.GET("/v1/users/:userId/followers", wrap(siw.GetV1UsersUserIdFollowers), tenant...)
+	e.GET("/v1/users/:userId/following", wrap(siw.GetV1UsersUserIdFollowing), tenant...)
+	e.PUT("/v1/users/:userId/block", wrap(siw.PutV1UsersUserIdBlock), tenant...)
+	e.DELETE("/v1/users/:userId/block", wrap(siw.DeleteV1UsersUserIdBlock), tenant...)
+	e.PUT("/v1/users/:userId/mute", wrap(siw.PutV1UsersUserIdMute), tenant...)
+	e.DELETE("/v1/users/:userId/mute", wrap(siw.DeleteV1UsersUserIdMute), tenant...)
+	e.GET("/v1/blocks", wrap(siw.GetV1Blocks), tenant...)
+	e.GET("/v1/mutes", wrap(siw.GetV1Mutes), tenant...)
+	e.GET("/v1/users/:userId/profile", wrap(siw.GetV1UsersUserIdProfile), tenant...)
+	e.PUT("/v1/users/:userId/profile", wrap(siw.PutV1UsersUserIdProfile), tenant...)
+	e.GET("/v1/timeline", wrap(siw.GetV1Timeline), tenant...)
+	e.POST("/v1/users/:userId/roles", wrap(siw.PostV1UsersUserIdRoles), sensitive...)
+	e.POST("/v1/admin/impersonate/:userId", wrap(siw.PostV1AdminImpersonateUserId), sensitiveTenant...)
+	// The colons of the custom methods are escaped so that echo does not read them as path parameters.
+	e.POST("/v1/admin/posts\\:import", wrap(siw.PostV1AdminPostsImport), sensitiveTenant...)
+	e.POST("/v1/invitations", wrap(siw.PostV1Invitations), sensitive...)
+	e.POST("/v1/organizations", wrap(siw.PostV1Organizations), sensitive...)
+	e.POST("/v1/organizations/:organizationId/members",
+		wrap(siw.PostV1OrganizationsOrganizationIdMembers), sensitiveTenant...)
+	e.GET("/v1/posts", wrap(siw.GetV1Posts), tenant...)
+	e.POST("/v1/posts", wrap(siw.PostV1Posts), tenant...)
+	e.GET("/v1/posts\\:export", wrap(siw.GetV1PostsExport), tenant...)
+	e.GET("/v1/posts/search", wrap(siw.GetV1PostsSearch), tenant...)
+	e.GET("/v1/hashtags/:tag/posts", wrap(siw.GetV1HashtagsTagPosts), tenant...)
+	e.GET("/v1/posts/:postId", wrap(siw.GetV1PostsPostId), tenant...)
+	e.PATCH("/v1/posts/:postId", wrap(siw.PatchV1PostsPostId), tenant...)
+	e.DELETE("/v1/posts/:postId", wrap(siw.DeleteV1PostsPostId), tenant...)
+	e.POST("/v1/posts/:postId/publish", wrap(siw.PostV1PostsPostIdPublish), tenant...)
+	e.GET("/v1/posts/:postId/revisions", wrap(siw.GetV1PostsPostIdRevisions), tenant...)
+	e.GET("/v1/posts/:postId/revisions/diff", wrap(siw.GetV1PostsPostIdRevisionsDiff), tenant...)
+	e.GET("/v1/posts/:postId/comments", wrap(siw.GetV1PostsPostIdComments), tenant...)
+	e.POST("/v1/posts/:postId/comments", wrap(siw.PostV1PostsPostIdComments), tenant...)
+	e.DELETE("/v1/posts/:postId/comments/:commentId", wrap(siw.DeleteV1PostsPostIdCommentsCommentId), tenant...)
+	e.PUT("/v1/posts/:postId/reactions/:type", wrap(siw.PutV1PostsPostIdReactionsType), tenant...)
+	e.DELETE("/v1/posts/:postId/reactions/:type", wrap(siw.DeleteV1PostsPostIdReactionsType), tenant...)
+	e.POST("/v1/posts/:postId/reports", wrap(siw.PostV1PostsPostIdReports), tenant...)
+	e.PUT("/v1/posts/:postId/bookmark", wrap(siw.PutV1PostsPostIdBookmark), tenant...)
+	e.DELETE("/v1/posts/:postId/bookmark", wrap(siw.DeleteV1PostsPostIdBookmark), tenant...)
+	e.GET("/v1/bookmarks", wrap(siw.GetV1Bookmarks), tenant...)
+	e.PUT("/v1/posts/:postId/repost", wrap(siw.PutV1PostsPostIdRepost), tenant...)
+	e.DELETE("/v1/posts/:postId/repost", wrap(siw.DeleteV1PostsPostIdRepost), tenant...)
+	e.PUT("/v1/posts/:postId/pin", wrap(siw.PutV1PostsPostIdPin), tenant...)
+	e.DELETE("/v1/posts/:postId/pin", wrap(siw.DeleteV1PostsPostIdPin), tenant...)
+	e.POST("/v1/posts/:postId/poll/votes", wrap(siw.PostV1PostsPostIdPollVotes), tenant...)
+	e.GET("/v1/collections", wrap(siw.GetV1Collections), tenant...)
+	e.POST("/v1/collections", wrap(siw.PostV1Collections), tenant...)
+	e.PATCH("/v1/collections/:collectionId", wrap(siw.PatchV1CollectionsCollectionId), tenant...)
+	e.DELETE("/v1/collections/:collectionId", wrap(siw.DeleteV1CollectionsCollectionId), tenant...)
+	e.GET("/v1/collections/:collectionId/posts", wrap(siw.GetV1CollectionsCollectionIdPosts), tenant...)
+	e.PUT("/v1/collections/:collectionId/posts/:postId",
+		wrap(siw.PutV1CollectionsCollectionIdPostsPostId), tenant...)
+	e.DELETE("/v1/collections/:collectionId/posts/:postId",
+		wrap(siw.DeleteV1CollectionsCollectionIdPostsPostId), tenant...)
+	e.GET("/v1/trash/posts", wrap(siw.GetV1TrashPosts), tenant...)
+	e.POST("/v1/trash/posts/:postId/restore", wrap(siw.PostV1TrashPostsPostIdRestore), tenant...)
+	e.GET("/v1/moderation/queue", wrap(siw.GetV1ModerationQueue), tenant...)
+	e.POST("/v1/moderation/posts/:postId/actions",
+		wrap(siw.PostV1ModerationPostsPostIdActions), sensitiveTenant...)
+	e.POST("/v1/attachments", wrap(siw.PostV1Attachments), tenant...)
+	e.GET("/v1/attachments/:attachmentId", wrap(siw.GetV1AttachmentsAttachmentId), tenant...)
+	e.GET("/v1/attachments/:attachmentId/thumbnail", wrap(siw.GetV1AttachmentsAttachmentIdThumbnail), tenant...)
+}
+
+// withChiURLParams copies Echo's path parameters into a chi route context, because the
+// generated chi-server wrapper reads path parameters with chi.URLParam.
+func withChiURLParams(c *echo.Context) *stdhttp.Request {
+	pathValues := c.PathValues()
+	if len(pathValues) == 0 {
+		return c.Request()
+	}
+
+	rctx := chi.NewRouteContext()
+	for _, pv := range pathValues {
+		rctx.URLParams.Add(pv.Name, pv.Value)
+	}
+
+	return c.Request().WithContext(context.WithValue(c.Request().Context(), chi.RouteCtxKey, rctx))
 }
 
 // apiErrorHandler writes a problem+json error response for request-parse failures
@@ -79,21 +171,13 @@ func apiErrorHandler(w stdhttp.ResponseWriter, _ *stdhttp.Request, err error) {
 
 // NewRouter constructs a Router backed by the generated StrictServerInterface.
 func NewRouter(
-	signupUseCase user.SingupUseCase,
-	loginUseCase user.LoginUseCase,
-	listUsersUseCase queryuser.ListUsersUseCase,
-	createPostUseCase commandpost.CreatePostUseCase,
-	listPostsUseCase querypost.ListPostsUseCase,
+	useCases UseCases,
+	recordImpersonatedRequestUseCase admin.RecordImpersonatedRequestUseCase,
 	jwtService service.JwtService,
 ) Router {
 	return &routerImpl{
-		handler: newServerHandler(
-			signupUseCase,
-			loginUseCase,
-			listUsersUseCase,
-			createPostUseCase,
-			listPostsUseCase,
-		),
-		jwtService: jwtService,
+		handler:                          newServerHandler(useCases),
+		jwtService:                       jwtService,
+		recordImpersonatedRequestUseCase: recordImpersonatedRequestUseCase,
 	}
 }
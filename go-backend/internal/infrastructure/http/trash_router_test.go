@@ -0,0 +1,130 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrash(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+
+	// The owner of the organization holds posts:moderate there; the other members are viewers.
+	ownerToken, ownerID := signupAndGetToken(t, "owner@example.com", "")
+	_, authorID := signupAndGetToken(t, "author@example.com", "")
+	_, memberID := signupAndGetToken(t, "member@example.com", "")
+
+	orgID := organizationOf(t, ownerID)
+	joinOrganization(t, orgID, authorID, viewerRoleID)
+	joinOrganization(t, orgID, memberID, viewerRoleID)
+	authorToken := loginToOrganization(t, "author@example.com", orgID)
+	memberToken := loginToOrganization(t, "member@example.com", orgID)
+
+	createPost := func(t *testing.T, content string) uuid.UUID {
+		t.Helper()
+
+		resp, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{Content: content},
+			withBearerToken(authorToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+
+		return resp.JSON201.Id
+	}
+
+	deletePost := func(t *testing.T, token string, postID uuid.UUID) {
+		t.Helper()
+
+		resp, err := c.DeleteV1PostsPostIdWithResponse(ctx, postID, withBearerToken(token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, resp.StatusCode())
+	}
+
+	restore := func(t *testing.T, token string, postID uuid.UUID) *clientgen.PostV1TrashPostsPostIdRestoreResponse {
+		t.Helper()
+
+		resp, err := c.PostV1TrashPostsPostIdRestoreWithResponse(ctx, postID, withBearerToken(token))
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	own := createPost(t, "regret")
+	moderated := createPost(t, "off topic")
+	createPost(t, "keeper")
+
+	deletePost(t, authorToken, own)
+	deletePost(t, ownerToken, moderated)
+
+	t.Run("deleted posts drop out of the listings", func(t *testing.T) {
+		resp, err := c.GetV1PostsWithResponse(ctx, nil, withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Len(t, resp.JSON200.Posts, 1)
+		assert.Equal(t, "keeper", resp.JSON200.Posts[0].Content)
+	})
+
+	t.Run("authors see their trash", func(t *testing.T) {
+		resp, err := c.GetV1TrashPostsWithResponse(ctx, nil, withBearerToken(authorToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, 2, resp.JSON200.Total)
+		require.Len(t, resp.JSON200.Items, 2)
+		assert.Equal(t, moderated, resp.JSON200.Items[0].Id)
+		assert.Equal(t, uuid.MustParse(ownerID), *resp.JSON200.Items[0].DeletedBy)
+		assert.Equal(t, own, resp.JSON200.Items[1].Id)
+		assert.True(t, resp.JSON200.Items[1].PurgeAt.After(resp.JSON200.Items[1].DeletedAt))
+
+		others, err := c.GetV1TrashPostsWithResponse(ctx, nil, withBearerToken(memberToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, others.StatusCode())
+		assert.Empty(t, others.JSON200.Items)
+
+		limit := 0
+		invalid, err := c.GetV1TrashPostsWithResponse(ctx, &clientgen.GetV1TrashPostsParams{Limit: &limit},
+			withBearerToken(authorToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, invalid.StatusCode())
+	})
+
+	t.Run("posts trashed by a moderator take a moderator to restore", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, restore(t, authorToken, moderated).StatusCode())
+		assert.Equal(t, http.StatusForbidden, restore(t, memberToken, own).StatusCode())
+
+		resp := restore(t, ownerToken, moderated)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		assert.Equal(t, "off topic", resp.JSON200.Content)
+	})
+
+	t.Run("authors restore what they trashed", func(t *testing.T) {
+		resp := restore(t, authorToken, own)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, own, resp.JSON200.Id)
+
+		get, err := c.GetV1PostsPostIdWithResponse(ctx, own, withBearerToken(memberToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, get.StatusCode())
+
+		assert.Equal(t, http.StatusNotFound, restore(t, authorToken, own).StatusCode())
+		assert.Equal(t, http.StatusNotFound, restore(t, authorToken, uuid.New()).StatusCode())
+	})
+
+	t.Run("missing token returns 401", func(t *testing.T) {
+		resp, err := c.GetV1TrashPostsWithResponse(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+
+		assert.Equal(t, http.StatusUnauthorized, restore(t, "", own).StatusCode())
+	})
+}
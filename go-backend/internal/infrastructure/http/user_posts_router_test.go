@@ -0,0 +1,107 @@
+//go:build integration
+
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserPosts(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	c := newTestClient()
+
+	ownerToken, ownerID := signupAndGetToken(t, "owner@example.com", "")
+	_, authorID := signupAndGetToken(t, "author@example.com", "")
+	outsiderToken, outsiderID := signupAndGetToken(t, "outsider@example.com", "")
+
+	orgID := organizationOf(t, ownerID)
+	joinOrganization(t, orgID, authorID, viewerRoleID)
+	authorToken := loginToOrganization(t, "author@example.com", orgID)
+
+	for _, post := range []struct {
+		token   string
+		content string
+	}{
+		{authorToken, "author first"},
+		{ownerToken, "owner post"},
+		{authorToken, "author second"},
+	} {
+		resp, err := c.PostV1PostsWithResponse(ctx, clientgen.CreatePostRequest{Content: post.content},
+			withBearerToken(post.token))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+	}
+
+	t.Run("lists only the user's posts with the author embedded", func(t *testing.T) {
+		resp, err := c.GetV1UsersUserIdPostsWithResponse(ctx, uuid.MustParse(authorID), nil,
+			withBearerToken(ownerToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		require.Len(t, resp.JSON200.Posts, 2)
+		assert.Equal(t, "author second", resp.JSON200.Posts[0].Content)
+		assert.Equal(t, "author first", resp.JSON200.Posts[1].Content)
+		require.NotNil(t, resp.JSON200.Posts[0].Author)
+		assert.Equal(t, authorID, resp.JSON200.Posts[0].Author.Id.String())
+		assert.Equal(t, "Test User", resp.JSON200.Posts[0].Author.Name)
+		require.NotNil(t, resp.JSON200.Total)
+		assert.Equal(t, 2, *resp.JSON200.Total)
+	})
+
+	t.Run("GET /v1/posts filters by author and creation time", func(t *testing.T) {
+		authors := []uuid.UUID{uuid.MustParse(ownerID)}
+		resp, err := c.GetV1PostsWithResponse(ctx, &clientgen.GetV1PostsParams{AuthorId: &authors},
+			withBearerToken(authorToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		require.Len(t, resp.JSON200.Posts, 1)
+		assert.Equal(t, "owner post", resp.JSON200.Posts[0].Content)
+
+		future := time.Now().Add(time.Hour)
+		resp, err = c.GetV1PostsWithResponse(ctx, &clientgen.GetV1PostsParams{CreatedAfter: &future},
+			withBearerToken(authorToken))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.NotNil(t, resp.JSON200)
+		assert.Empty(t, resp.JSON200.Posts)
+	})
+
+	t.Run("an empty date range returns 400", func(t *testing.T) {
+		now := time.Now()
+		resp, err := c.GetV1UsersUserIdPostsWithResponse(ctx, uuid.MustParse(authorID),
+			&clientgen.GetV1UsersUserIdPostsParams{CreatedAfter: &now, CreatedBefore: &now},
+			withBearerToken(ownerToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+
+	t.Run("users outside the organization return 404", func(t *testing.T) {
+		resp, err := c.GetV1UsersUserIdPostsWithResponse(ctx, uuid.MustParse(outsiderID), nil,
+			withBearerToken(ownerToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+
+		// Nor can outsiders read the timeline of a member.
+		resp, err = c.GetV1UsersUserIdPostsWithResponse(ctx, uuid.MustParse(authorID), nil,
+			withBearerToken(outsiderToken))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+
+	t.Run("missing token returns 401", func(t *testing.T) {
+		resp, err := c.GetV1UsersUserIdPostsWithResponse(ctx, uuid.MustParse(authorID), nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	})
+}
@@ -199,6 +199,8 @@ func TestLogin(t *testing.T) {
 				require.NotNil(t, resp.JSON200)
 				assert.NotEmpty(t, resp.JSON200.Token)
 				assert.NotZero(t, resp.JSON200.ExpiresAt)
+				// Without an explicit organization the login defaults to the personal one made at signup.
+				assert.NotNil(t, resp.JSON200.OrganizationId)
 			} else if tt.responseCode == http.StatusBadRequest {
 				require.NotNil(t, resp.ApplicationproblemJSON400)
 				assert.Equal(t, tt.problemType, resp.ApplicationproblemJSON400.Type)
@@ -366,10 +368,14 @@ func TestListUsers(t *testing.T) {
 	t.Run("pagination with offset returns correct subset", func(t *testing.T) {
 		// Ensure a clean slate so the exact total=3 assertion is reliable.
 		require.NoError(t, testDb.Cleanup())
-		// 1 admin + 2 regular users = 3 total; offset=2 → 1 user returned
-		token, _ := signupAndGetToken(t, "offset-admin@example.com", adminRoleID)
-		signupAndGetToken(t, "offset-user1@example.com", "")
-		signupAndGetToken(t, "offset-user2@example.com", "")
+		// 1 admin + 2 regular users in the admin's organization = 3 total; offset=2 → 1 user returned
+		token, adminID := signupAndGetToken(t, "offset-admin@example.com", adminRoleID)
+		_, user1ID := signupAndGetToken(t, "offset-user1@example.com", "")
+		_, user2ID := signupAndGetToken(t, "offset-user2@example.com", "")
+
+		orgID := organizationOf(t, adminID)
+		joinOrganization(t, orgID, user1ID, viewerRoleID)
+		joinOrganization(t, orgID, user2ID, viewerRoleID)
 
 		offset := 2
 		limit := 10
@@ -20,3 +20,12 @@ func writeJSONError(w http.ResponseWriter, err error) error {
 
 	return werr
 }
+
+// optionalString maps the empty string to an omitted JSON field.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
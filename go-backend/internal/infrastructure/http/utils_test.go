@@ -15,7 +15,10 @@ import (
 	clientgen "github.com/Haya372/web-app-template/go-backend/test/integration/client/generated"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
 	"github.com/Haya372/web-app-template/go-backend/test/integration"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/require"
 )
 
@@ -30,6 +33,16 @@ func TestMain(m *testing.M) {
 		log.Fatalf("failed to set AUTH_JWT_TTL_MINUTES, err=%v", err)
 	}
 
+	blobDir, err := os.MkdirTemp("", "http_it_blobs")
+	if err != nil {
+		log.Fatalf("failed to create blob directory, err=%v", err)
+	}
+	defer os.RemoveAll(blobDir)
+
+	if err = os.Setenv("BLOB_STORAGE_LOCAL_DIR", blobDir); err != nil {
+		log.Fatalf("failed to set BLOB_STORAGE_LOCAL_DIR, err=%v", err)
+	}
+
 	db, err := integration.NewTestDb(integration.TestDbProps{
 		User:      "postgres",
 		Password:  "postgres",
@@ -134,3 +147,64 @@ func signupAndGetToken(t *testing.T, email, roleID string) (token, userID string
 
 	return token, userID
 }
+
+// organizationOf returns the organization a user's login defaults to: the oldest membership,
+// which for a freshly signed-up user is the personal organization created at signup.
+func organizationOf(t *testing.T, userID string) string {
+	t.Helper()
+
+	var orgID string
+
+	// Outside any organization, row-level security still shows users their own memberships.
+	ctx := common.WithUserID(context.Background(), userID)
+	err := testDb.DbManager().TxFunc(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return tx.QueryRow(ctx,
+			"SELECT organization_id FROM organization_memberships WHERE user_id = $1 ORDER BY created_at LIMIT 1",
+			userID,
+		).Scan(&orgID)
+	})
+	require.NoError(t, err)
+
+	return orgID
+}
+
+// joinOrganization adds a user to an organization with the given per-organization role.
+func joinOrganization(t *testing.T, organizationID, userID, roleID string) {
+	t.Helper()
+
+	inOrganization(t, organizationID, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx,
+			"INSERT INTO organization_memberships (organization_id, user_id, role_id) VALUES ($1, $2, $3)",
+			organizationID, userID, roleID,
+		)
+
+		return err
+	})
+}
+
+// inOrganization runs raw statements in a transaction scoped to the organization, so row-level
+// security applies to them as it does to the server.
+func inOrganization(t *testing.T, organizationID string, fn func(ctx context.Context, tx pgx.Tx) error) {
+	t.Helper()
+
+	ctx := common.WithTenantID(context.Background(), organizationID)
+	require.NoError(t, testDb.DbManager().TxFunc(ctx, fn))
+}
+
+// loginToOrganization logs in with an explicit organization and returns the token.
+func loginToOrganization(t *testing.T, email, organizationID string) string {
+	t.Helper()
+
+	orgID := uuid.MustParse(organizationID)
+
+	resp, err := newTestClient().PostV1UsersLoginWithResponse(context.Background(), clientgen.LoginRequest{
+		Email:          openapi_types.Email(email),
+		Password:       "password",
+		OrganizationId: &orgID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode())
+	require.NotNil(t, resp.JSON200)
+
+	return resp.JSON200.Token
+}
@@ -0,0 +1,82 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	usecasequery "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type attachmentQueryServiceImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (s *attachmentQueryServiceImpl) FindByID(
+	ctx context.Context, id uuid.UUID,
+) (*usecasequery.AttachmentDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindByID")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var row sqlc.FindAttachmentByIDRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		row, err = queries.FindAttachmentByID(ctx, sqlc.FindAttachmentByIDParams{
+			ID:             pgtype.UUID{Bytes: id, Valid: true},
+			OrganizationID: tenantID,
+		})
+
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query attachment", "error", err)
+
+		return nil, err
+	}
+
+	return &usecasequery.AttachmentDto{
+		ID:           uuid.UUID(row.ID.Bytes),
+		UploaderID:   uuid.UUID(row.UploaderID.Bytes),
+		PostID:       fromNullablePgtypeUuid(row.PostID),
+		FileName:     row.FileName,
+		ContentType:  row.ContentType,
+		Size:         row.SizeBytes,
+		StorageKey:   row.StorageKey,
+		ThumbnailKey: row.ThumbnailKey.String,
+	}, nil
+}
+
+// NewAttachmentQueryService creates a new AttachmentQueryService backed by Postgres.
+func NewAttachmentQueryService(dbManager db.DbManager) usecasequery.AttachmentQueryService {
+	return &attachmentQueryServiceImpl{
+		tracer:    otel.Tracer("AttachmentQueryService"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,62 @@
+//go:build integration
+
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/query"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachmentQueryService_FindByID(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherCtx := seedTenant(t)
+	alice := seedMember(t, ctx, "alice@example.com")
+	attachmentRepo := repository.NewAttachmentRepository(testDb.DbManager())
+	svc := query.NewAttachmentQueryService(testDb.DbManager())
+
+	photo, err := entity.NewAttachment(alice.ID(), "photo.png", vo.AttachmentContentTypePNG, 1024, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, photo.AddThumbnail(640, 480))
+	require.NoError(t, attachmentRepo.Create(ctx, photo))
+
+	found, err := svc.FindByID(ctx, photo.ID())
+	require.NoError(t, err)
+	assert.Equal(t, &post.AttachmentDto{
+		ID:           photo.ID(),
+		UploaderID:   alice.ID(),
+		FileName:     "photo.png",
+		ContentType:  "image/png",
+		Size:         1024,
+		StorageKey:   photo.StorageKey(),
+		ThumbnailKey: photo.ThumbnailKey(),
+	}, found)
+
+	withPost := seedPost(t, ctx, alice.ID(), "with photo", time.Now())
+	require.NoError(t, photo.AttachTo(withPost.ID(), 0))
+	require.NoError(t, attachmentRepo.Attach(ctx, photo))
+
+	found, err = svc.FindByID(ctx, photo.ID())
+	require.NoError(t, err)
+	require.NotNil(t, found.PostID)
+	assert.Equal(t, withPost.ID(), *found.PostID)
+
+	// Missing attachments and those of other tenants are reported as nil.
+	found, err = svc.FindByID(ctx, uuid.New())
+	require.NoError(t, err)
+	assert.Nil(t, found)
+
+	found, err = svc.FindByID(otherCtx, photo.ID())
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
@@ -0,0 +1,128 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	usecasequery "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type collectionQueryServiceImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (s *collectionQueryServiceImpl) FindByUser(
+	ctx context.Context, userID uuid.UUID,
+) ([]usecasequery.CollectionDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindByUser")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var rows []sqlc.FindCollectionsByUserIDRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		rows, err = queries.FindCollectionsByUserID(ctx, sqlc.FindCollectionsByUserIDParams{
+			OrganizationID: tenantID,
+			UserID:         pgtype.UUID{Bytes: userID, Valid: true},
+		})
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query collections", "error", err)
+
+		return nil, err
+	}
+
+	dtos := make([]usecasequery.CollectionDto, len(rows))
+	for i, row := range rows {
+		dtos[i] = usecasequery.CollectionDto{
+			ID:        uuid.UUID(row.ID.Bytes),
+			Name:      row.Name,
+			CreatedAt: row.CreatedAt.Time,
+			UpdatedAt: row.UpdatedAt.Time,
+		}
+	}
+
+	return dtos, nil
+}
+
+func (s *collectionQueryServiceImpl) FindByID(
+	ctx context.Context, id, userID uuid.UUID,
+) (*usecasequery.CollectionDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindByID")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var row sqlc.FindCollectionByIDRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		row, err = queries.FindCollectionByID(ctx, sqlc.FindCollectionByIDParams{
+			ID:             pgtype.UUID{Bytes: id, Valid: true},
+			OrganizationID: tenantID,
+		})
+
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query collection", "error", err)
+
+		return nil, err
+	}
+
+	if uuid.UUID(row.UserID.Bytes) != userID {
+		return nil, nil
+	}
+
+	return &usecasequery.CollectionDto{
+		ID:        uuid.UUID(row.ID.Bytes),
+		Name:      row.Name,
+		CreatedAt: row.CreatedAt.Time,
+		UpdatedAt: row.UpdatedAt.Time,
+	}, nil
+}
+
+func NewCollectionQueryService(dbManager db.DbManager) usecasequery.CollectionQueryService {
+	return &collectionQueryServiceImpl{
+		tracer:    otel.Tracer("CollectionQueryService"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
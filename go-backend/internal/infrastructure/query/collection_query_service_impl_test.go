@@ -0,0 +1,63 @@
+//go:build integration
+
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/query"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectionQueryService(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	owner := seedMember(t, ctx, "owner@example.com")
+	other := seedMember(t, ctx, "other@example.com")
+
+	collections := repository.NewCollectionRepository(testDb.DbManager())
+	create := func(userID uuid.UUID, raw string, createdAt time.Time) entity.Collection {
+		c, err := entity.NewCollection(userID, raw, createdAt)
+		require.NoError(t, err)
+
+		c, err = collections.Create(ctx, c)
+		require.NoError(t, err)
+
+		return c
+	}
+
+	at := func(h int) time.Time { return time.Date(2026, 3, 1, h, 0, 0, 0, time.UTC) }
+	recipes := create(owner.ID(), "Recipes", at(2))
+	travel := create(owner.ID(), "Travel", at(1))
+	foreign := create(other.ID(), "Not yours", at(3))
+
+	svc := query.NewCollectionQueryService(testDb.DbManager())
+
+	// Oldest first, only the user's own.
+	list, err := svc.FindByUser(ctx, owner.ID())
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, travel.ID(), list[0].ID)
+	assert.Equal(t, "Travel", list[0].Name)
+	assert.Equal(t, recipes.ID(), list[1].ID)
+
+	found, err := svc.FindByID(ctx, recipes.ID(), owner.ID())
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, "Recipes", found.Name)
+	assert.Equal(t, at(2), found.CreatedAt)
+
+	found, err = svc.FindByID(ctx, foreign.ID(), owner.ID())
+	require.NoError(t, err)
+	assert.Nil(t, found, "someone else's collection")
+
+	found, err = svc.FindByID(ctx, uuid.New(), owner.ID())
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
@@ -0,0 +1,152 @@
+package query
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	usecasequery "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type commentQueryServiceImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (s *commentQueryServiceImpl) FindByParent(
+	ctx context.Context,
+	postID, viewerID uuid.UUID,
+	parentID *uuid.UUID,
+	after *usecasequery.CommentCursor,
+	limit int,
+) ([]usecasequery.CommentDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindByParent")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	params := sqlc.FindCommentsParams{
+		OrganizationID: tenantID,
+		PostID:         pgtype.UUID{Bytes: postID, Valid: true},
+		ViewerID:       pgtype.UUID{Bytes: viewerID, Valid: true},
+		PageLimit:      int32(limit), //nolint:gosec // limit is validated (1-100) by the use case layer
+	}
+
+	if parentID != nil {
+		params.ParentID = pgtype.UUID{Bytes: *parentID, Valid: true}
+	}
+
+	if after != nil {
+		params.CursorCreatedAt = pgtype.Timestamp{Time: after.CreatedAt, Valid: true}
+		params.CursorID = pgtype.UUID{Bytes: after.ID, Valid: true}
+	}
+
+	var rows []sqlc.FindCommentsRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		rows, err = queries.FindComments(ctx, params)
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query comments", "error", err)
+
+		return nil, err
+	}
+
+	dtos := make([]usecasequery.CommentDto, len(rows))
+	for i, row := range rows {
+		dtos[i] = toCommentDto(row)
+	}
+
+	return dtos, nil
+}
+
+func (s *commentQueryServiceImpl) FindReplies(
+	ctx context.Context, parentIDs []uuid.UUID, viewerID uuid.UUID, perParent int,
+) ([]usecasequery.CommentDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindReplies")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	ids := make([]pgtype.UUID, len(parentIDs))
+	for i, id := range parentIDs {
+		ids[i] = pgtype.UUID{Bytes: id, Valid: true}
+	}
+
+	var rows []sqlc.FindCommentRepliesRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		rows, err = queries.FindCommentReplies(ctx, sqlc.FindCommentRepliesParams{
+			OrganizationID: tenantID,
+			ParentIds:      ids,
+			ViewerID:       pgtype.UUID{Bytes: viewerID, Valid: true},
+			PerParentLimit: int64(perParent),
+		})
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query comment replies", "error", err)
+
+		return nil, err
+	}
+
+	dtos := make([]usecasequery.CommentDto, len(rows))
+	for i, row := range rows {
+		dtos[i] = toCommentDto(sqlc.FindCommentsRow(row))
+	}
+
+	return dtos, nil
+}
+
+func toCommentDto(row sqlc.FindCommentsRow) usecasequery.CommentDto {
+	return usecasequery.CommentDto{
+		ID:         uuid.UUID(row.ID.Bytes),
+		PostID:     uuid.UUID(row.PostID.Bytes),
+		ParentID:   fromNullablePgtypeUuid(row.ParentID),
+		UserID:     uuid.UUID(row.UserID.Bytes),
+		AuthorName: row.AuthorName,
+		Content:    row.Content,
+		Depth:      int(row.Depth),
+		CreatedAt:  row.CreatedAt.Time,
+		ReplyCount: int(row.ReplyCount),
+	}
+}
+
+// NewCommentQueryService creates a new CommentQueryService backed by Postgres.
+func NewCommentQueryService(dbManager db.DbManager) usecasequery.CommentQueryService {
+	return &commentQueryServiceImpl{
+		tracer:    otel.Tracer("CommentQueryService"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,167 @@
+//go:build integration
+
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/query"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seedComment(
+	t *testing.T, ctx context.Context, p entity.Post, parent entity.Comment, userID uuid.UUID, createdAt time.Time,
+) entity.Comment {
+	t.Helper()
+
+	comment, err := entity.NewComment(p.ID(), userID, parent, "comment", createdAt)
+	require.NoError(t, err)
+	created, err := repository.NewCommentRepository(testDb.DbManager()).Create(ctx, comment)
+	require.NoError(t, err)
+
+	return created
+}
+
+func commentIDs(comments []post.CommentDto) []uuid.UUID {
+	ids := make([]uuid.UUID, len(comments))
+	for i, c := range comments {
+		ids[i] = c.ID
+	}
+
+	return ids
+}
+
+func TestCommentQueryService_FindByParent(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	user := seedPostUser(t, "comments@example.com")
+	base := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	target := seedPost(t, ctx, user.ID(), "post", base)
+	other := seedPost(t, ctx, user.ID(), "other post", base)
+
+	first := seedComment(t, ctx, target, nil, user.ID(), base.Add(time.Minute))
+	second := seedComment(t, ctx, target, nil, user.ID(), base.Add(2*time.Minute))
+	third := seedComment(t, ctx, target, nil, user.ID(), base.Add(3*time.Minute))
+	reply := seedComment(t, ctx, target, first, user.ID(), base.Add(4*time.Minute))
+	seedComment(t, ctx, target, reply, user.ID(), base.Add(5*time.Minute))
+	seedComment(t, ctx, other, nil, user.ID(), base.Add(time.Minute))
+
+	svc := query.NewCommentQueryService(testDb.DbManager())
+
+	t.Run("top-level comments oldest first with reply counts", func(t *testing.T) {
+		comments, err := svc.FindByParent(ctx, target.ID(), user.ID(), nil, nil, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{first.ID(), second.ID(), third.ID()}, commentIDs(comments))
+		assert.Equal(t, 1, comments[0].ReplyCount)
+		assert.Equal(t, 0, comments[1].ReplyCount)
+		assert.Equal(t, "Post User", comments[0].AuthorName)
+		assert.Nil(t, comments[0].ParentID)
+		assert.Nil(t, comments[0].Replies)
+	})
+
+	t.Run("keyset page after a cursor", func(t *testing.T) {
+		after := &post.CommentCursor{CreatedAt: first.CreatedAt(), ID: first.ID()}
+		comments, err := svc.FindByParent(ctx, target.ID(), user.ID(), nil, after, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{second.ID()}, commentIDs(comments))
+	})
+
+	t.Run("replies to a comment", func(t *testing.T) {
+		parentID := first.ID()
+		comments, err := svc.FindByParent(ctx, target.ID(), user.ID(), &parentID, nil, 10)
+
+		require.NoError(t, err)
+		require.Len(t, comments, 1)
+		assert.Equal(t, reply.ID(), comments[0].ID)
+		assert.Equal(t, first.ID(), *comments[0].ParentID)
+		assert.Equal(t, 1, comments[0].Depth)
+		assert.Equal(t, 1, comments[0].ReplyCount)
+	})
+
+	t.Run("post without comments", func(t *testing.T) {
+		comments, err := svc.FindByParent(ctx, uuid.New(), user.ID(), nil, nil, 10)
+
+		require.NoError(t, err)
+		assert.NotNil(t, comments)
+		assert.Empty(t, comments)
+	})
+
+	t.Run("other tenant sees nothing", func(t *testing.T) {
+		comments, err := svc.FindByParent(seedTenant(t), target.ID(), user.ID(), nil, nil, 10)
+
+		require.NoError(t, err)
+		assert.Empty(t, comments)
+	})
+}
+
+func TestCommentQueryService_BlocksAndMutes(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	viewer := seedMember(t, ctx, "viewer@example.com")
+	blocked := seedMember(t, ctx, "blocked@example.com")
+	muted := seedMember(t, ctx, "muted@example.com")
+	base := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	target := seedPost(t, ctx, viewer.ID(), "post", base)
+
+	own := seedComment(t, ctx, target, nil, viewer.ID(), base.Add(time.Minute))
+	seedComment(t, ctx, target, nil, blocked.ID(), base.Add(2*time.Minute))
+	seedComment(t, ctx, target, nil, muted.ID(), base.Add(3*time.Minute))
+	seedComment(t, ctx, target, own, muted.ID(), base.Add(4*time.Minute))
+
+	seedBlock(t, ctx, viewer.ID(), blocked.ID(), base)
+	seedMute(t, ctx, viewer.ID(), muted.ID(), base)
+
+	svc := query.NewCommentQueryService(testDb.DbManager())
+
+	comments, err := svc.FindByParent(ctx, target.ID(), viewer.ID(), nil, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{own.ID()}, commentIDs(comments))
+
+	replies, err := svc.FindReplies(ctx, []uuid.UUID{own.ID()}, viewer.ID(), 10)
+	require.NoError(t, err)
+	assert.Empty(t, replies)
+
+	// A mute is one-way: the muted user still sees every comment.
+	comments, err = svc.FindByParent(ctx, target.ID(), muted.ID(), nil, nil, 10)
+	require.NoError(t, err)
+	assert.Len(t, comments, 3)
+}
+
+func TestCommentQueryService_FindReplies_LimitsPerParent(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	user := seedPostUser(t, "replies@example.com")
+	base := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	target := seedPost(t, ctx, user.ID(), "post", base)
+
+	a := seedComment(t, ctx, target, nil, user.ID(), base.Add(time.Minute))
+	b := seedComment(t, ctx, target, nil, user.ID(), base.Add(2*time.Minute))
+	a1 := seedComment(t, ctx, target, a, user.ID(), base.Add(3*time.Minute))
+	b1 := seedComment(t, ctx, target, b, user.ID(), base.Add(4*time.Minute))
+	a2 := seedComment(t, ctx, target, a, user.ID(), base.Add(5*time.Minute))
+	seedComment(t, ctx, target, a, user.ID(), base.Add(6*time.Minute))
+
+	svc := query.NewCommentQueryService(testDb.DbManager())
+	replies, err := svc.FindReplies(ctx, []uuid.UUID{a.ID(), b.ID()}, user.ID(), 2)
+
+	require.NoError(t, err)
+	// The oldest two replies of each parent, merged oldest first.
+	assert.Equal(t, []uuid.UUID{a1.ID(), b1.ID(), a2.ID()}, commentIDs(replies))
+
+	posts, err := query.NewPostQueryService(testDb.DbManager()).FindAll(ctx, post.PostFilter{}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, 6, posts[0].CommentCount)
+}
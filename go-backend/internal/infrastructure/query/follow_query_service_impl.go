@@ -0,0 +1,159 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	usecasequery "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// findFollowsFunc runs one of the follow-list queries, which share their parameters and columns.
+type findFollowsFunc func(ctx context.Context, queries sqlc.Queries, params sqlc.FindFollowersParams) (
+	[]sqlc.FindFollowersRow, error,
+)
+
+type followQueryServiceImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (s *followQueryServiceImpl) IsMember(ctx context.Context, userID uuid.UUID) (bool, error) {
+	ctx, span := s.tracer.Start(ctx, "IsMember")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		_, err := queries.FindOrganizationMembership(ctx, sqlc.FindOrganizationMembershipParams{
+			OrganizationID: tenantID,
+			UserID:         pgtype.UUID{Bytes: userID, Valid: true},
+		})
+
+		return err
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query membership", "error", err)
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *followQueryServiceImpl) FindFollowers(
+	ctx context.Context, userID uuid.UUID, after *usecasequery.FollowCursor, limit int,
+) ([]usecasequery.FollowDto, error) {
+	return s.findFollows(ctx, "FindFollowers", userID, after, limit,
+		func(ctx context.Context, queries sqlc.Queries, params sqlc.FindFollowersParams) (
+			[]sqlc.FindFollowersRow, error,
+		) {
+			return queries.FindFollowers(ctx, params)
+		})
+}
+
+func (s *followQueryServiceImpl) FindFollowing(
+	ctx context.Context, userID uuid.UUID, after *usecasequery.FollowCursor, limit int,
+) ([]usecasequery.FollowDto, error) {
+	return s.findFollows(ctx, "FindFollowing", userID, after, limit,
+		func(ctx context.Context, queries sqlc.Queries, params sqlc.FindFollowersParams) (
+			[]sqlc.FindFollowersRow, error,
+		) {
+			rows, err := queries.FindFollowing(ctx, sqlc.FindFollowingParams(params))
+			if err != nil {
+				return nil, err
+			}
+
+			followerRows := make([]sqlc.FindFollowersRow, len(rows))
+			for i, row := range rows {
+				followerRows[i] = sqlc.FindFollowersRow(row)
+			}
+
+			return followerRows, nil
+		})
+}
+
+func (s *followQueryServiceImpl) findFollows(
+	ctx context.Context, spanName string, userID uuid.UUID, after *usecasequery.FollowCursor, limit int,
+	find findFollowsFunc,
+) ([]usecasequery.FollowDto, error) {
+	ctx, span := s.tracer.Start(ctx, spanName)
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	params := sqlc.FindFollowersParams{
+		OrganizationID: tenantID,
+		UserID:         pgtype.UUID{Bytes: userID, Valid: true},
+		PageLimit:      int32(limit), //nolint:gosec // limit is validated (1-100) by the use case layer
+	}
+
+	if after != nil {
+		params.CursorCreatedAt = pgtype.Timestamp{Time: after.CreatedAt, Valid: true}
+		params.CursorID = pgtype.UUID{Bytes: after.UserID, Valid: true}
+	}
+
+	var rows []sqlc.FindFollowersRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		rows, err = find(ctx, queries, params)
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query follows", "error", err)
+
+		return nil, err
+	}
+
+	dtos := make([]usecasequery.FollowDto, len(rows))
+	for i, row := range rows {
+		dtos[i] = usecasequery.FollowDto{
+			UserID:     uuid.UUID(row.ID.Bytes),
+			Name:       row.Name,
+			FollowedAt: row.CreatedAt.Time,
+		}
+	}
+
+	return dtos, nil
+}
+
+// NewFollowQueryService creates a new FollowQueryService backed by Postgres.
+func NewFollowQueryService(dbManager db.DbManager) usecasequery.FollowQueryService {
+	return &followQueryServiceImpl{
+		tracer:    otel.Tracer("FollowQueryService"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,91 @@
+//go:build integration
+
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/query"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	queryuser "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seedFollow(t *testing.T, ctx context.Context, followerID, followeeID uuid.UUID, createdAt time.Time) {
+	t.Helper()
+
+	follow, err := entity.NewFollow(followerID, followeeID, createdAt)
+	require.NoError(t, err)
+	_, err = repository.NewFollowRepository(testDb.DbManager()).Add(ctx, follow)
+	require.NoError(t, err)
+}
+
+func TestFollowQueryService_IsMember(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	member := seedMember(t, ctx, "member@example.com")
+	outsider := seedUser(t, "outsider@example.com")
+
+	svc := query.NewFollowQueryService(testDb.DbManager())
+
+	isMember, err := svc.IsMember(ctx, member.ID())
+	require.NoError(t, err)
+	assert.True(t, isMember)
+
+	isMember, err = svc.IsMember(ctx, outsider.ID())
+	require.NoError(t, err)
+	assert.False(t, isMember)
+}
+
+func TestFollowQueryService_FindFollowersAndFollowing(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherCtx := seedTenant(t)
+	star := seedMember(t, ctx, "star@example.com")
+	first := seedMember(t, ctx, "first@example.com")
+	second := seedMember(t, ctx, "second@example.com")
+	third := seedMember(t, ctx, "third@example.com")
+	joinTenant(t, otherCtx, star.ID())
+	joinTenant(t, otherCtx, first.ID())
+
+	base := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	seedFollow(t, ctx, first.ID(), star.ID(), base)
+	seedFollow(t, ctx, second.ID(), star.ID(), base.Add(time.Hour))
+	seedFollow(t, ctx, third.ID(), star.ID(), base.Add(2*time.Hour))
+	seedFollow(t, ctx, star.ID(), first.ID(), base)
+	seedFollow(t, otherCtx, star.ID(), first.ID(), base)
+
+	svc := query.NewFollowQueryService(testDb.DbManager())
+
+	followers, err := svc.FindFollowers(ctx, star.ID(), nil, 2)
+	require.NoError(t, err)
+	require.Len(t, followers, 2)
+	assert.Equal(t, third.ID(), followers[0].UserID)
+	assert.Equal(t, second.ID(), followers[1].UserID)
+	assert.Equal(t, "Test User", followers[0].Name)
+	assert.True(t, followers[0].FollowedAt.Equal(base.Add(2*time.Hour)))
+
+	cursor := queryuser.FollowCursor{CreatedAt: followers[1].FollowedAt, UserID: followers[1].UserID}
+	rest, err := svc.FindFollowers(ctx, star.ID(), &cursor, 2)
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	assert.Equal(t, first.ID(), rest[0].UserID)
+
+	// The follow made in the other organization does not show up here.
+	following, err := svc.FindFollowing(ctx, star.ID(), nil, 10)
+	require.NoError(t, err)
+	require.Len(t, following, 1)
+	assert.Equal(t, first.ID(), following[0].UserID)
+
+	none, err := svc.FindFollowing(ctx, third.ID(), nil, 10)
+	require.NoError(t, err)
+	assert.NotNil(t, none)
+	assert.Empty(t, none)
+}
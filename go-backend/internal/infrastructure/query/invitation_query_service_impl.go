@@ -0,0 +1,63 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	usecasequery "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type invitationQueryServiceImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (s *invitationQueryServiceImpl) FindPendingByTokenHash(
+	ctx context.Context, tokenHash []byte,
+) (*usecasequery.InvitationPreviewDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindPendingByTokenHash")
+	defer span.End()
+
+	var row sqlc.FindPendingInvitationByTokenHashRow
+
+	err := s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		row, err = queries.FindPendingInvitationByTokenHash(ctx, tokenHash)
+
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query invitation", "error", err)
+
+		return nil, err
+	}
+
+	return &usecasequery.InvitationPreviewDto{
+		Email:     row.Email,
+		ExpiresAt: row.ExpiresAt.Time,
+	}, nil
+}
+
+// NewInvitationQueryService creates a new InvitationQueryService backed by Postgres.
+func NewInvitationQueryService(dbManager db.DbManager) usecasequery.InvitationQueryService {
+	return &invitationQueryServiceImpl{
+		tracer:    otel.Tracer("InvitationQueryService"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,74 @@
+//go:build integration
+
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/query"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvitationQueryService_FindPendingByTokenHash(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	inviter := seedUser(t, "inviter@example.com")
+	invitee := seedUser(t, "invitee@example.com")
+	invitationRepo := repository.NewInvitationRepository(testDb.DbManager())
+	now := time.Now().UTC()
+
+	seedInvitation := func(token vo.InvitationToken, expiresAt time.Time) entity.Invitation {
+		invitation, err := entity.NewInvitation(
+			"invitee@example.com", nil, inviter.ID(), token, expiresAt, now.Add(-8*24*time.Hour),
+		)
+		require.NoError(t, err)
+		require.NoError(t, invitationRepo.Create(ctx, invitation))
+
+		return invitation
+	}
+
+	pending := seedInvitation("pending", now.Add(7*24*time.Hour))
+	seedInvitation("expired", now.Add(-time.Minute))
+
+	accepted := seedInvitation("accepted", now.Add(7*24*time.Hour))
+	require.NoError(t, accepted.Accept(invitee.ID(), "invitee@example.com", now))
+	require.NoError(t, invitationRepo.MarkAccepted(ctx, accepted))
+
+	tests := []struct {
+		name  string
+		token vo.InvitationToken
+		want  bool
+	}{
+		{name: "pending invitation", token: "pending", want: true},
+		{name: "expired invitation", token: "expired"},
+		{name: "already accepted invitation", token: "accepted"},
+		{name: "unknown token", token: "unknown"},
+	}
+
+	svc := query.NewInvitationQueryService(testDb.DbManager())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			preview, err := svc.FindPendingByTokenHash(ctx, tt.token.Hash())
+
+			require.NoError(t, err)
+
+			if !tt.want {
+				assert.Nil(t, preview)
+
+				return
+			}
+
+			require.NotNil(t, preview)
+			assert.Equal(t, "invitee@example.com", preview.Email)
+			assert.True(t, pending.ExpiresAt().Truncate(time.Microsecond).Equal(preview.ExpiresAt))
+		})
+	}
+}
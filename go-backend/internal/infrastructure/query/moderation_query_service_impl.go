@@ -0,0 +1,124 @@
+package query
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	usecasequery "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type moderationQueryServiceImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (s *moderationQueryServiceImpl) FindQueue(
+	ctx context.Context, limit, offset int,
+) ([]usecasequery.ModerationQueueItemDto, int, error) {
+	ctx, span := s.tracer.Start(ctx, "FindQueue")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, 0, err
+	}
+
+	var (
+		rows    []sqlc.FindModerationQueueRow
+		reports []sqlc.FindOpenPostReportsRow
+		total   int64
+	)
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		rows, err = queries.FindModerationQueue(ctx, sqlc.FindModerationQueueParams{
+			OrganizationID: tenantID,
+			PageLimit:      int32(limit),  //nolint:gosec // limit is validated (1-100) by the use case layer
+			PageOffset:     int32(offset), //nolint:gosec // offset is validated (>=0) by the use case layer
+		})
+		if err != nil {
+			return err
+		}
+
+		total, err = queries.CountModerationQueue(ctx, tenantID)
+		if err != nil || len(rows) == 0 {
+			return err
+		}
+
+		postIDs := make([]pgtype.UUID, len(rows))
+		for i, row := range rows {
+			postIDs[i] = row.ID
+		}
+
+		reports, err = queries.FindOpenPostReports(ctx, sqlc.FindOpenPostReportsParams{
+			OrganizationID: tenantID,
+			PostIds:        postIDs,
+		})
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query moderation queue", "error", err)
+
+		return nil, 0, err
+	}
+
+	reportsByPost := make(map[uuid.UUID][]usecasequery.PostReportDto, len(rows))
+	for _, r := range reports {
+		postID := uuid.UUID(r.PostID.Bytes)
+		reportsByPost[postID] = append(reportsByPost[postID], usecasequery.PostReportDto{
+			ID:         uuid.UUID(r.ID.Bytes),
+			ReporterID: fromNullablePgtypeUuid(r.ReporterID),
+			Reason:     r.Reason,
+			Details:    r.Details,
+			CreatedAt:  r.CreatedAt.Time,
+		})
+	}
+
+	items := make([]usecasequery.ModerationQueueItemDto, 0, len(rows))
+	for _, row := range rows {
+		postID := uuid.UUID(row.ID.Bytes)
+
+		postReports := reportsByPost[postID]
+		if postReports == nil {
+			postReports = []usecasequery.PostReportDto{}
+		}
+
+		items = append(items, usecasequery.ModerationQueueItemDto{
+			PostID:          postID,
+			AuthorID:        uuid.UUID(row.UserID.Bytes),
+			AuthorName:      row.AuthorName,
+			Content:         row.Content,
+			Hidden:          row.HiddenAt.Valid,
+			CreatedAt:       row.CreatedAt.Time,
+			ReportCount:     int(row.ReportCount),
+			FirstReportedAt: row.FirstReportedAt.Time,
+			LastReportedAt:  row.LastReportedAt.Time,
+			Reports:         postReports,
+		})
+	}
+
+	return items, int(total), nil
+}
+
+func NewModerationQueryService(dbManager db.DbManager) usecasequery.ModerationQueryService {
+	return &moderationQueryServiceImpl{
+		tracer:    otel.Tracer("ModerationQueryService"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,91 @@
+//go:build integration
+
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/query"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seedReport(
+	t *testing.T, ctx context.Context, p entity.Post, reporterID uuid.UUID, reason string, createdAt time.Time,
+) entity.PostReport {
+	t.Helper()
+
+	report, err := entity.NewPostReport(p.ID(), reporterID, reason, "details", createdAt)
+	require.NoError(t, err)
+	require.NoError(t, repository.NewPostReportRepository(testDb.DbManager()).Create(ctx, report))
+
+	return report
+}
+
+func TestModerationQueryService_FindQueue(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherCtx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	first := seedMember(t, ctx, "first@example.com")
+	second := seedMember(t, ctx, "second@example.com")
+	joinTenant(t, otherCtx, author.ID())
+	joinTenant(t, otherCtx, first.ID())
+
+	at := func(h int) time.Time { return time.Date(2026, 3, 1, h, 0, 0, 0, time.UTC) }
+	waiting := seedPost(t, ctx, author.ID(), "waiting longest", at(1))
+	recent := seedPost(t, ctx, author.ID(), "reported recently", at(2))
+	resolved := seedPost(t, ctx, author.ID(), "already handled", at(3))
+	elsewhere := seedPost(t, otherCtx, author.ID(), "other tenant", at(4))
+
+	firstReport := seedReport(t, ctx, waiting, first.ID(), "spam", at(5))
+	seedReport(t, ctx, waiting, second.ID(), "harassment", at(8))
+	seedReport(t, ctx, recent, first.ID(), "hate", at(6))
+	seedReport(t, ctx, resolved, first.ID(), "spam", at(4))
+	seedReport(t, otherCtx, elsewhere, first.ID(), "spam", at(1))
+
+	_, err := repository.NewPostReportRepository(testDb.DbManager()).
+		ResolveByPostID(ctx, resolved.ID(), second.ID(), at(9))
+	require.NoError(t, err)
+
+	require.NoError(t, recent.Hide(at(9)))
+	require.NoError(t, repository.NewPostRepository(testDb.DbManager()).Hide(ctx, recent))
+
+	svc := query.NewModerationQueryService(testDb.DbManager())
+
+	items, total, err := svc.FindQueue(ctx, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, items, 2)
+
+	// The post that has waited longest since its first open report comes first.
+	assert.Equal(t, waiting.ID(), items[0].PostID)
+	assert.Equal(t, author.ID(), items[0].AuthorID)
+	assert.Equal(t, "waiting longest", items[0].Content)
+	assert.False(t, items[0].Hidden)
+	assert.Equal(t, 2, items[0].ReportCount)
+	assert.True(t, at(5).Equal(items[0].FirstReportedAt))
+	assert.True(t, at(8).Equal(items[0].LastReportedAt))
+	require.Len(t, items[0].Reports, 2)
+	assert.Equal(t, firstReport.ID(), items[0].Reports[0].ID)
+	assert.Equal(t, first.ID(), *items[0].Reports[0].ReporterID)
+	assert.Equal(t, "spam", items[0].Reports[0].Reason)
+	assert.Equal(t, "details", items[0].Reports[0].Details)
+	assert.Equal(t, "harassment", items[0].Reports[1].Reason)
+
+	assert.Equal(t, recent.ID(), items[1].PostID)
+	assert.True(t, items[1].Hidden)
+	assert.Equal(t, 1, items[1].ReportCount)
+
+	paged, total, err := svc.FindQueue(ctx, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, paged, 1)
+	assert.Equal(t, recent.ID(), paged[0].PostID)
+}
@@ -0,0 +1,84 @@
+package query
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	usecasequery "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type postExportQueryServiceImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (s *postExportQueryServiceImpl) FindPage(
+	ctx context.Context, after *usecasequery.PostCursor, limit int,
+) ([]usecasequery.ExportedPostDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindPage")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	params := sqlc.FindPostsForExportParams{
+		OrganizationID: tenantID,
+		PageLimit:      int32(limit), //nolint:gosec // limit is a constant page size of the use case layer
+	}
+	if after != nil {
+		params.CursorCreatedAt = pgtype.Timestamp{Time: after.CreatedAt, Valid: true}
+		params.CursorID = pgtype.UUID{Bytes: after.ID, Valid: true}
+	}
+
+	var rows []sqlc.FindPostsForExportRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		rows, err = queries.FindPostsForExport(ctx, params)
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query posts to export", "error", err)
+
+		return nil, err
+	}
+
+	dtos := make([]usecasequery.ExportedPostDto, len(rows))
+	for i, row := range rows {
+		dtos[i] = usecasequery.ExportedPostDto{
+			ID:         uuid.UUID(row.ID.Bytes),
+			UserID:     uuid.UUID(row.UserID.Bytes),
+			Content:    row.Content,
+			Format:     row.Format,
+			Visibility: row.Visibility,
+			CreatedAt:  row.CreatedAt.Time,
+		}
+	}
+
+	return dtos, nil
+}
+
+func NewPostExportQueryService(dbManager db.DbManager) usecasequery.PostExportQueryService {
+	return &postExportQueryServiceImpl{
+		tracer:    otel.Tracer("PostExportQueryService"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,63 @@
+//go:build integration
+
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/query"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostExportQueryService_FindPage(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	at := func(h int) time.Time { return time.Date(2026, 3, 1, h, 0, 0, 0, time.UTC) }
+
+	first := seedPost(t, ctx, author.ID(), "first", at(1))
+	second := seedPostWith(t, ctx, author.ID(), "second", vo.PostVisibilityPrivate, vo.PostStatusPublished, nil, at(2))
+	third := seedPost(t, ctx, author.ID(), "third", at(3))
+
+	// Drafts, hidden and trashed posts, and the posts of other tenants are not exported.
+	seedPostWith(t, ctx, author.ID(), "draft", vo.PostVisibilityPublic, vo.PostStatusDraft, nil, at(4))
+
+	hidden := seedPost(t, ctx, author.ID(), "hidden", at(5))
+	require.NoError(t, hidden.Hide(at(6)))
+	require.NoError(t, repository.NewPostRepository(testDb.DbManager()).Hide(ctx, hidden))
+
+	trashPost(t, ctx, seedPost(t, ctx, author.ID(), "trashed", at(7)), author.ID(), at(8))
+
+	otherCtx := seedTenant(t)
+	seedPost(t, otherCtx, seedMember(t, otherCtx, "other@example.com").ID(), "other tenant", at(1))
+
+	svc := query.NewPostExportQueryService(testDb.DbManager())
+
+	page, err := svc.FindPage(ctx, nil, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, first.ID(), page[0].ID)
+	assert.Equal(t, author.ID(), page[0].UserID)
+	assert.Equal(t, "first", page[0].Content)
+	assert.Equal(t, "plain", page[0].Format)
+	assert.Equal(t, "public", page[0].Visibility)
+	assert.Equal(t, at(1), page[0].CreatedAt)
+	assert.Equal(t, second.ID(), page[1].ID)
+	assert.Equal(t, "private", page[1].Visibility)
+
+	page, err = svc.FindPage(ctx, &post.PostCursor{CreatedAt: page[1].CreatedAt, ID: page[1].ID}, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, third.ID(), page[0].ID)
+
+	page, err = svc.FindPage(ctx, &post.PostCursor{CreatedAt: page[0].CreatedAt, ID: page[0].ID}, 2)
+	require.NoError(t, err)
+	assert.NotNil(t, page)
+	assert.Empty(t, page)
+}
@@ -6,10 +6,13 @@ import (
 	"fmt"
 
 	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
 	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
 	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
 	usecasequery "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
@@ -26,27 +29,182 @@ type postQueryServiceImpl struct {
 	dbManager db.DbManager
 }
 
-func (s *postQueryServiceImpl) FindAll(ctx context.Context, limit, offset int) ([]usecasequery.PostDto, int, error) {
+func (s *postQueryServiceImpl) FindAll(
+	ctx context.Context, filter usecasequery.PostFilter, limit, offset int,
+) ([]usecasequery.PostDto, error) {
 	ctx, span := s.tracer.Start(ctx, "FindAll")
 	defer span.End()
 
-	var (
-		rows  []sqlc.FindAllPostsRow
-		total int64
-	)
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
 
-	err := s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+	var rows []sqlc.FindAllPostsRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
 		var err error
 
+		args := toPostFilterArgs(filter)
 		rows, err = queries.FindAllPosts(ctx, sqlc.FindAllPostsParams{
-			Limit:  int32(limit),  //nolint:gosec // limit is validated (1-100) by the use case layer
-			Offset: int32(offset), //nolint:gosec // offset is validated (>=0) by the use case layer
+			OrganizationID: tenantID,
+			AuthorIds:      args.authorIDs,
+			CreatedAfter:   args.createdAfter,
+			CreatedBefore:  args.createdBefore,
+			Hashtag:        args.hashtag,
+			Status:         args.status,
+			ViewerID:       args.viewerID,
+			PageLimit:      int32(limit),  //nolint:gosec // limit is validated (1-100) by the use case layer
+			PageOffset:     int32(offset), //nolint:gosec // offset is validated (>=0) by the use case layer
 		})
-		if err != nil {
-			return err
-		}
 
-		total, err = queries.CountPosts(ctx)
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query posts", "error", err)
+
+		return nil, err
+	}
+
+	dtos, err := toPostDtos(rows)
+	if err == nil {
+		err = attachPostEntities(ctx, s.dbManager, tenantID, dtos)
+	}
+
+	if err == nil {
+		err = attachPostAttachments(ctx, s.dbManager, tenantID, dtos)
+	}
+
+	if err == nil {
+		err = attachOriginalPosts(ctx, s.dbManager, tenantID, filter.ViewerID, dtos)
+	}
+
+	if err == nil {
+		err = attachPolls(ctx, s.dbManager, tenantID, filter.ViewerID, dtos)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return dtos, nil
+}
+
+func (s *postQueryServiceImpl) FindAfter(
+	ctx context.Context, filter usecasequery.PostFilter, cursor usecasequery.PostCursor, limit int,
+) ([]usecasequery.PostDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindAfter")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var rows []sqlc.FindPostsAfterRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		args := toPostFilterArgs(filter)
+		rows, err = queries.FindPostsAfter(ctx, sqlc.FindPostsAfterParams{
+			OrganizationID: tenantID,
+			AuthorIds:      args.authorIDs,
+			CreatedAfter:   args.createdAfter,
+			CreatedBefore:  args.createdBefore,
+			Hashtag:        args.hashtag,
+			Status:         args.status,
+			ViewerID:       args.viewerID,
+			CreatedAt:      pgtype.Timestamp{Time: cursor.CreatedAt, Valid: true},
+			ID:             pgtype.UUID{Bytes: cursor.ID, Valid: true},
+			PageLimit:      int32(limit), //nolint:gosec // limit is validated (1-100) by the use case layer
+		})
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query posts", "error", err)
+
+		return nil, err
+	}
+
+	postRows := make([]sqlc.FindAllPostsRow, len(rows))
+	for i, row := range rows {
+		postRows[i] = sqlc.FindAllPostsRow(row)
+	}
+
+	dtos, err := toPostDtos(postRows)
+	if err == nil {
+		err = attachPostEntities(ctx, s.dbManager, tenantID, dtos)
+	}
+
+	if err == nil {
+		err = attachPostAttachments(ctx, s.dbManager, tenantID, dtos)
+	}
+
+	if err == nil {
+		err = attachOriginalPosts(ctx, s.dbManager, tenantID, filter.ViewerID, dtos)
+	}
+
+	if err == nil {
+		err = attachPolls(ctx, s.dbManager, tenantID, filter.ViewerID, dtos)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return dtos, nil
+}
+
+func (s *postQueryServiceImpl) FindBefore(
+	ctx context.Context, filter usecasequery.PostFilter, cursor usecasequery.PostCursor, limit int,
+) ([]usecasequery.PostDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindBefore")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var rows []sqlc.FindPostsBeforeRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		args := toPostFilterArgs(filter)
+		rows, err = queries.FindPostsBefore(ctx, sqlc.FindPostsBeforeParams{
+			OrganizationID: tenantID,
+			AuthorIds:      args.authorIDs,
+			CreatedAfter:   args.createdAfter,
+			CreatedBefore:  args.createdBefore,
+			Hashtag:        args.hashtag,
+			Status:         args.status,
+			ViewerID:       args.viewerID,
+			CreatedAt:      pgtype.Timestamp{Time: cursor.CreatedAt, Valid: true},
+			ID:             pgtype.UUID{Bytes: cursor.ID, Valid: true},
+			PageLimit:      int32(limit), //nolint:gosec // limit is validated (1-100) by the use case layer
+		})
 
 		return err
 	})
@@ -55,37 +213,720 @@ func (s *postQueryServiceImpl) FindAll(ctx context.Context, limit, offset int) (
 		span.SetStatus(codes.Error, err.Error())
 		s.logger.Error(ctx, "failed to query posts", "error", err)
 
-		return nil, 0, err
+		return nil, err
+	}
+
+	// The query walks away from the cursor (oldest first); flip to feed order.
+	postRows := make([]sqlc.FindAllPostsRow, len(rows))
+	for i, row := range rows {
+		postRows[len(rows)-1-i] = sqlc.FindAllPostsRow(row)
+	}
+
+	dtos, err := toPostDtos(postRows)
+	if err == nil {
+		err = attachPostEntities(ctx, s.dbManager, tenantID, dtos)
+	}
+
+	if err == nil {
+		err = attachPostAttachments(ctx, s.dbManager, tenantID, dtos)
+	}
+
+	if err == nil {
+		err = attachOriginalPosts(ctx, s.dbManager, tenantID, filter.ViewerID, dtos)
+	}
+
+	if err == nil {
+		err = attachPolls(ctx, s.dbManager, tenantID, filter.ViewerID, dtos)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return dtos, nil
+}
+
+func (s *postQueryServiceImpl) FindTimeline(
+	ctx context.Context, viewerID uuid.UUID, after *usecasequery.PostCursor, limit int,
+) ([]usecasequery.PostDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindTimeline")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	params := sqlc.FindTimelineParams{
+		OrganizationID: tenantID,
+		UserID:         pgtype.UUID{Bytes: viewerID, Valid: true},
+		PageLimit:      int32(limit), //nolint:gosec // limit is validated (1-100) by the use case layer
+	}
+	if after != nil {
+		params.CursorCreatedAt = pgtype.Timestamp{Time: after.CreatedAt, Valid: true}
+		params.CursorID = pgtype.UUID{Bytes: after.ID, Valid: true}
+	}
+
+	var rows []sqlc.FindTimelineRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		rows, err = queries.FindTimeline(ctx, params)
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query timeline", "error", err)
+
+		return nil, err
+	}
+
+	postRows := make([]sqlc.FindAllPostsRow, len(rows))
+	for i, row := range rows {
+		postRows[i] = sqlc.FindAllPostsRow(row)
+	}
+
+	dtos, err := toPostDtos(postRows)
+	if err == nil {
+		err = attachPostEntities(ctx, s.dbManager, tenantID, dtos)
+	}
+
+	if err == nil {
+		err = attachPostAttachments(ctx, s.dbManager, tenantID, dtos)
+	}
+
+	if err == nil {
+		err = attachOriginalPosts(ctx, s.dbManager, tenantID, viewerID, dtos)
+	}
+
+	if err == nil {
+		err = attachPolls(ctx, s.dbManager, tenantID, viewerID, dtos)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return dtos, nil
+}
+
+func (s *postQueryServiceImpl) Count(ctx context.Context, filter usecasequery.PostFilter) (int, error) {
+	ctx, span := s.tracer.Start(ctx, "Count")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return 0, err
+	}
+
+	var total int64
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		args := toPostFilterArgs(filter)
+		total, err = queries.CountPosts(ctx, sqlc.CountPostsParams{
+			OrganizationID: tenantID,
+			AuthorIds:      args.authorIDs,
+			CreatedAfter:   args.createdAfter,
+			CreatedBefore:  args.createdBefore,
+			Hashtag:        args.hashtag,
+			Status:         args.status,
+			ViewerID:       args.viewerID,
+		})
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to count posts", "error", err)
+
+		return 0, err
+	}
+
+	return int(total), nil
+}
+
+func (s *postQueryServiceImpl) FindByID(
+	ctx context.Context, id, viewerID uuid.UUID,
+) (*usecasequery.PostDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindByID")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var row sqlc.FindPostDetailByIDRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		row, err = queries.FindPostDetailByID(ctx, sqlc.FindPostDetailByIDParams{
+			ID:             pgtype.UUID{Bytes: id, Valid: true},
+			OrganizationID: tenantID,
+			ViewerID:       pgtype.UUID{Bytes: viewerID, Valid: true},
+		})
+
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query post", "error", err)
+
+		return nil, err
+	}
+
+	dtos, err := toPostDtos([]sqlc.FindAllPostsRow{sqlc.FindAllPostsRow(row)})
+	if err == nil {
+		err = attachPostEntities(ctx, s.dbManager, tenantID, dtos)
+	}
+
+	if err == nil {
+		err = attachPostAttachments(ctx, s.dbManager, tenantID, dtos)
+	}
+
+	if err == nil {
+		err = attachOriginalPosts(ctx, s.dbManager, tenantID, viewerID, dtos)
+	}
+
+	if err == nil {
+		err = attachPolls(ctx, s.dbManager, tenantID, viewerID, dtos)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return &dtos[0], nil
+}
+
+func (s *postQueryServiceImpl) FindRevisions(
+	ctx context.Context, postID uuid.UUID,
+) ([]usecasequery.PostRevisionDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindRevisions")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var rows []sqlc.FindPostRevisionsRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		rows, err = queries.FindPostRevisions(ctx, sqlc.FindPostRevisionsParams{
+			PostID:         pgtype.UUID{Bytes: postID, Valid: true},
+			OrganizationID: tenantID,
+		})
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query post revisions", "error", err)
+
+		return nil, err
+	}
+
+	dtos := make([]usecasequery.PostRevisionDto, 0, len(rows))
+	for _, row := range rows {
+		dtos = append(dtos, toPostRevisionDto(row))
+	}
+
+	return dtos, nil
+}
+
+func (s *postQueryServiceImpl) FindRevision(
+	ctx context.Context, postID uuid.UUID, number int,
+) (*usecasequery.PostRevisionDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindRevision")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var row sqlc.FindPostRevisionByNumberRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		row, err = queries.FindPostRevisionByNumber(ctx, sqlc.FindPostRevisionByNumberParams{
+			PostID:         pgtype.UUID{Bytes: postID, Valid: true},
+			OrganizationID: tenantID,
+			Number:         int32(number), //nolint:gosec // number is validated (>=1) by the use case layer
+		})
+
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query post revision", "error", err)
+
+		return nil, err
+	}
+
+	dto := toPostRevisionDto(sqlc.FindPostRevisionsRow(row))
+
+	return &dto, nil
+}
+
+func (s *postQueryServiceImpl) FindAuthor(
+	ctx context.Context, userID uuid.UUID,
+) (*usecasequery.PostAuthorDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindAuthor")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var row sqlc.FindPostAuthorRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		row, err = queries.FindPostAuthor(ctx, sqlc.FindPostAuthorParams{
+			UserID:         pgtype.UUID{Bytes: userID, Valid: true},
+			OrganizationID: tenantID,
+		})
+
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query post author", "error", err)
+
+		return nil, err
+	}
+
+	return &usecasequery.PostAuthorDto{ID: uuid.UUID(row.ID.Bytes), Name: row.Name}, nil
+}
+
+// postFilterArgs holds a PostFilter as query arguments, with SQL NULL for every unset condition.
+type postFilterArgs struct {
+	authorIDs     []pgtype.UUID
+	createdAfter  pgtype.Timestamp
+	createdBefore pgtype.Timestamp
+	hashtag       pgtype.Text
+	status        string
+	viewerID      pgtype.UUID
+}
+
+func toPostFilterArgs(filter usecasequery.PostFilter) postFilterArgs {
+	args := postFilterArgs{
+		status:   vo.PostStatusPublished.String(),
+		viewerID: pgtype.UUID{Bytes: filter.ViewerID, Valid: true},
+	}
+	if filter.Drafts {
+		args.status = vo.PostStatusDraft.String()
+	}
+
+	// A nil slice is sent as NULL, which the queries read as "every author".
+	if len(filter.AuthorIDs) > 0 {
+		args.authorIDs = make([]pgtype.UUID, len(filter.AuthorIDs))
+		for i, id := range filter.AuthorIDs {
+			args.authorIDs[i] = pgtype.UUID{Bytes: id, Valid: true}
+		}
+	}
+
+	// created_at holds UTC without a zone, while callers may pass times in any zone.
+	if filter.CreatedAfter != nil {
+		args.createdAfter = pgtype.Timestamp{Time: filter.CreatedAfter.UTC(), Valid: true}
+	}
+
+	if filter.CreatedBefore != nil {
+		args.createdBefore = pgtype.Timestamp{Time: filter.CreatedBefore.UTC(), Valid: true}
 	}
 
+	if filter.Hashtag != "" {
+		args.hashtag = pgtype.Text{String: filter.Hashtag, Valid: true}
+	}
+
+	return args
+}
+
+func toPostDtos(rows []sqlc.FindAllPostsRow) ([]usecasequery.PostDto, error) {
 	dtos := make([]usecasequery.PostDto, 0, len(rows))
 
 	for _, row := range rows {
 		if !row.ID.Valid || !row.UserID.Valid {
-			err := fmt.Errorf("%w: id_valid=%v user_id_valid=%v", errNullPostUUID, row.ID.Valid, row.UserID.Valid)
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-
-			return nil, 0, err
+			return nil, fmt.Errorf("%w: id_valid=%v user_id_valid=%v", errNullPostUUID, row.ID.Valid, row.UserID.Valid)
 		}
 
 		if !row.CreatedAt.Valid {
-			err := fmt.Errorf("%w for id %s", errNullPostCreatedAt, uuid.UUID(row.ID.Bytes))
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-
-			return nil, 0, err
+			return nil, fmt.Errorf("%w for id %s", errNullPostCreatedAt, uuid.UUID(row.ID.Bytes))
 		}
 
 		dtos = append(dtos, usecasequery.PostDto{
+			ID:            uuid.UUID(row.ID.Bytes),
+			UserID:        uuid.UUID(row.UserID.Bytes),
+			AuthorName:    row.AuthorName,
+			Content:       row.Content,
+			Format:        row.Format,
+			Kind:          row.Kind,
+			Visibility:    row.Visibility,
+			Status:        row.Status,
+			PublishAt:     fromNullablePgtypeTimestamp(row.PublishAt),
+			Hidden:        row.HiddenAt.Valid,
+			CreatedAt:     row.CreatedAt.Time,
+			UpdatedAt:     row.UpdatedAt.Time,
+			Edited:        row.RevisionCount > 1,
+			RevisionCount: int(row.RevisionCount),
+			CommentCount:  int(row.CommentCount),
+			RepostCount:   int(row.RepostCount),
+		})
+
+		// The original is filled in by attachOriginalPosts; a purged one leaves no ID to look up.
+		if row.Kind != vo.PostKindPost.String() {
+			dtos[len(dtos)-1].Original = &usecasequery.OriginalPostDto{ID: uuid.UUID(row.OriginalID.Bytes)}
+		}
+	}
+
+	return dtos, nil
+}
+
+// attachPostEntities sets the Entities of posts: the hashtags parsed from their content and the mentions
+// that were resolved to a member when the content was saved. Mentions that matched nobody are left out.
+func attachPostEntities(
+	ctx context.Context, dbManager db.DbManager, tenantID pgtype.UUID, posts []usecasequery.PostDto,
+) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postIDs := make([]pgtype.UUID, len(posts))
+
+	for i, post := range posts {
+		postIDs[i] = pgtype.UUID{Bytes: post.ID, Valid: true}
+	}
+
+	var rows []sqlc.FindPostMentionsRow
+
+	err := dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		rows, err = queries.FindPostMentions(ctx, sqlc.FindPostMentionsParams{
+			OrganizationID: tenantID,
+			PostIds:        postIDs,
+		})
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	mentions := make(map[uuid.UUID]map[string]uuid.UUID, len(posts))
+
+	for _, row := range rows {
+		postID := uuid.UUID(row.PostID.Bytes)
+		if mentions[postID] == nil {
+			mentions[postID] = map[string]uuid.UUID{}
+		}
+
+		mentions[postID][row.Handle] = uuid.UUID(row.UserID.Bytes)
+	}
+
+	for i := range posts {
+		posts[i].Entities = toPostEntityDtos(vo.ParseContentEntities(posts[i].Content), mentions[posts[i].ID])
+	}
+
+	return nil
+}
+
+// attachPostAttachments sets the Attachments of posts, in the order chosen by their authors.
+func attachPostAttachments(
+	ctx context.Context, dbManager db.DbManager, tenantID pgtype.UUID, posts []usecasequery.PostDto,
+) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postIDs := make([]pgtype.UUID, len(posts))
+
+	for i, post := range posts {
+		postIDs[i] = pgtype.UUID{Bytes: post.ID, Valid: true}
+	}
+
+	var rows []sqlc.FindPostAttachmentsRow
+
+	err := dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		rows, err = queries.FindPostAttachments(ctx, sqlc.FindPostAttachmentsParams{
+			OrganizationID: tenantID,
+			PostIds:        postIDs,
+		})
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	attachments := make(map[uuid.UUID][]usecasequery.PostAttachmentDto, len(posts))
+
+	for _, row := range rows {
+		postID := uuid.UUID(row.PostID.Bytes)
+		attachments[postID] = append(attachments[postID], usecasequery.PostAttachmentDto{
+			ID:           uuid.UUID(row.ID.Bytes),
+			FileName:     row.FileName,
+			ContentType:  row.ContentType,
+			Size:         row.SizeBytes,
+			Width:        int(row.Width.Int32),
+			Height:       int(row.Height.Int32),
+			HasThumbnail: row.HasThumbnail,
+		})
+	}
+
+	for i := range posts {
+		posts[i].Attachments = attachments[posts[i].ID]
+		if posts[i].Attachments == nil {
+			posts[i].Attachments = []usecasequery.PostAttachmentDto{}
+		}
+	}
+
+	return nil
+}
+
+// attachOriginalPosts fills in the Original of the reposts and quotes among posts with the originals viewerID
+// may see. Originals that are trashed, purged or hidden stay unavailable, even to their authors, as do those
+// of users blocked either way.
+func attachOriginalPosts(
+	ctx context.Context, dbManager db.DbManager, tenantID pgtype.UUID, viewerID uuid.UUID,
+	posts []usecasequery.PostDto,
+) error {
+	var originalIDs []pgtype.UUID
+
+	for _, post := range posts {
+		if post.Original != nil && post.Original.ID != uuid.Nil {
+			originalIDs = append(originalIDs, pgtype.UUID{Bytes: post.Original.ID, Valid: true})
+		}
+	}
+
+	var rows []sqlc.FindVisiblePostsByIDsRow
+
+	if len(originalIDs) > 0 {
+		err := dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+			var err error
+
+			rows, err = queries.FindVisiblePostsByIDs(ctx, sqlc.FindVisiblePostsByIDsParams{
+				OrganizationID: tenantID,
+				Ids:            originalIDs,
+				ViewerID:       pgtype.UUID{Bytes: viewerID, Valid: true},
+			})
+
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	originals := make(map[uuid.UUID]usecasequery.OriginalPostDto, len(rows))
+
+	for _, row := range rows {
+		if row.HiddenAt.Valid {
+			continue
+		}
+
+		originals[uuid.UUID(row.ID.Bytes)] = usecasequery.OriginalPostDto{
+			Available:     true,
+			ID:            uuid.UUID(row.ID.Bytes),
+			UserID:        uuid.UUID(row.UserID.Bytes),
+			AuthorName:    row.AuthorName,
+			Content:       row.Content,
+			Format:        row.Format,
+			RevisionCount: int(row.RevisionCount),
+			CreatedAt:     row.CreatedAt.Time,
+		}
+	}
+
+	for i := range posts {
+		if posts[i].Original == nil {
+			continue
+		}
+
+		// A missing original leaves the zero value, which is unavailable.
+		original := originals[posts[i].Original.ID]
+		posts[i].Original = &original
+	}
+
+	return nil
+}
+
+// attachPolls sets the Poll of the posts that have one, with the live tallies and the options viewerID voted
+// for. Hiding the tallies from viewers who have not voted is left to the use cases.
+func attachPolls(
+	ctx context.Context, dbManager db.DbManager, tenantID pgtype.UUID, viewerID uuid.UUID,
+	posts []usecasequery.PostDto,
+) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postIDs := make([]pgtype.UUID, len(posts))
+
+	for i, post := range posts {
+		postIDs[i] = pgtype.UUID{Bytes: post.ID, Valid: true}
+	}
+
+	var (
+		pollRows   []sqlc.FindPostPollsRow
+		optionRows []sqlc.FindPostPollOptionTalliesRow
+		voteRows   []sqlc.FindUserPostPollVotesRow
+	)
+
+	err := dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		pollRows, err = queries.FindPostPolls(ctx, sqlc.FindPostPollsParams{
+			OrganizationID: tenantID,
+			PostIds:        postIDs,
+		})
+		if err != nil || len(pollRows) == 0 {
+			return err
+		}
+
+		optionRows, err = queries.FindPostPollOptionTallies(ctx, sqlc.FindPostPollOptionTalliesParams{
+			OrganizationID: tenantID,
+			PostIds:        postIDs,
+		})
+		if err != nil {
+			return err
+		}
+
+		voteRows, err = queries.FindUserPostPollVotes(ctx, sqlc.FindUserPostPollVotesParams{
+			OrganizationID: tenantID,
+			UserID:         pgtype.UUID{Bytes: viewerID, Valid: true},
+			PostIds:        postIDs,
+		})
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	polls := make(map[uuid.UUID]*usecasequery.PostPollDto, len(pollRows))
+
+	for _, row := range pollRows {
+		polls[uuid.UUID(row.PostID.Bytes)] = &usecasequery.PostPollDto{
+			Multiple:   row.Multiple,
+			ClosesAt:   row.ClosesAt.Time,
+			VoterCount: int(row.VoterCount),
+			Options:    []usecasequery.PostPollOptionDto{},
+			MyVotes:    []uuid.UUID{},
+		}
+	}
+
+	for _, row := range optionRows {
+		poll := polls[uuid.UUID(row.PostID.Bytes)]
+		poll.Options = append(poll.Options, usecasequery.PostPollOptionDto{
 			ID:        uuid.UUID(row.ID.Bytes),
-			UserID:    uuid.UUID(row.UserID.Bytes),
-			Content:   row.Content,
-			CreatedAt: row.CreatedAt.Time,
+			Label:     row.Label,
+			VoteCount: int(row.VoteCount),
 		})
 	}
 
-	return dtos, int(total), nil
+	for _, row := range voteRows {
+		poll := polls[uuid.UUID(row.PostID.Bytes)]
+		poll.MyVotes = append(poll.MyVotes, uuid.UUID(row.OptionID.Bytes))
+	}
+
+	for i := range posts {
+		posts[i].Poll = polls[posts[i].ID]
+	}
+
+	return nil
+}
+
+func toPostEntityDtos(entities vo.ContentEntities, mentions map[string]uuid.UUID) []usecasequery.PostEntityDto {
+	dtos := make([]usecasequery.PostEntityDto, 0, len(entities))
+
+	for _, entity := range entities {
+		dto := usecasequery.PostEntityDto{
+			Type: string(entity.Type), Start: entity.Start, End: entity.End, Value: entity.Value,
+		}
+
+		if entity.Type == vo.ContentEntityTypeMention {
+			userID, ok := mentions[entity.Value]
+			if !ok {
+				continue
+			}
+
+			dto.UserID = &userID
+		}
+
+		dtos = append(dtos, dto)
+	}
+
+	return dtos
+}
+
+func toPostRevisionDto(row sqlc.FindPostRevisionsRow) usecasequery.PostRevisionDto {
+	return usecasequery.PostRevisionDto{
+		ID:        uuid.UUID(row.ID.Bytes),
+		Number:    int(row.Number),
+		Content:   row.Content,
+		Format:    row.Format,
+		EditorID:  fromNullablePgtypeUuid(row.EditorID),
+		CreatedAt: row.CreatedAt.Time,
+	}
 }
 
 // NewPostQueryService creates a new PostQueryService backed by Postgres.
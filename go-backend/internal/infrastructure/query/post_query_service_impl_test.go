@@ -9,8 +9,10 @@ import (
 
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
 	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/query"
 	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,28 +35,68 @@ func seedPostUser(t *testing.T, email string) entity.User {
 	return created
 }
 
-func seedPost(t *testing.T, userID uuid.UUID, content string, createdAt time.Time) entity.Post {
+func seedPost(
+	t *testing.T, ctx context.Context, userID uuid.UUID, content string, createdAt time.Time,
+) entity.Post {
 	t.Helper()
 
-	p := entity.ReconstructPost(uuid.New(), userID, content, createdAt)
+	return seedPostWith(t, ctx, userID, content, vo.PostVisibilityPublic, vo.PostStatusPublished, nil, createdAt)
+}
+
+func seedPostWith(
+	t *testing.T,
+	ctx context.Context,
+	userID uuid.UUID,
+	content string,
+	visibility vo.PostVisibility,
+	status vo.PostStatus,
+	publishAt *time.Time,
+	createdAt time.Time,
+) entity.Post {
+	t.Helper()
+
+	p := entity.ReconstructPost(
+		uuid.New(), userID, content, vo.ContentFormatPlain, vo.PostKindPost, nil, visibility, status, publishAt, nil, nil,
+		nil, createdAt, createdAt,
+	)
 	repo := repository.NewPostRepository(testDb.DbManager())
-	created, err := repo.Create(context.Background(), p)
+	created, err := repo.Create(ctx, p)
 	require.NoError(t, err)
+	seedPostRevision(t, ctx, created, userID)
 	return created
 }
 
+// seedPostRevision records the post's current content as its next revision, as the post use cases do.
+func seedPostRevision(t *testing.T, ctx context.Context, post entity.Post, editorID uuid.UUID) {
+	t.Helper()
+
+	revision, err := entity.NewPostRevision(post, editorID)
+	require.NoError(t, err)
+	_, err = repository.NewPostRevisionRepository(testDb.DbManager()).Create(ctx, revision)
+	require.NoError(t, err)
+}
+
+func assertPostCount(t *testing.T, ctx context.Context, svc post.PostQueryService, expected int) {
+	t.Helper()
+
+	count, err := svc.Count(ctx, post.PostFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, expected, count)
+}
+
 func TestPostQueryService_FindAll_ReturnsPostsOrderedByCreatedAtDesc(t *testing.T) {
 	defer func() { require.NoError(t, testDb.Cleanup()) }()
 
+	ctx := seedTenant(t)
 	user := seedPostUser(t, "findall@example.com")
-	older := seedPost(t, user.ID(), "older post", time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC))
-	newer := seedPost(t, user.ID(), "newer post", time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC))
+	older := seedPost(t, ctx, user.ID(), "older post", time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC))
+	newer := seedPost(t, ctx, user.ID(), "newer post", time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC))
 
 	svc := query.NewPostQueryService(testDb.DbManager())
-	posts, total, err := svc.FindAll(context.Background(), 10, 0)
+	posts, err := svc.FindAll(ctx, post.PostFilter{}, 10, 0)
 
 	require.NoError(t, err)
-	assert.Equal(t, 2, total)
+	assertPostCount(t, ctx, svc, 2)
 	require.Len(t, posts, 2)
 	// ORDER BY created_at DESC: newer first
 	assert.Equal(t, newer.ID(), posts[0].ID)
@@ -64,11 +106,12 @@ func TestPostQueryService_FindAll_ReturnsPostsOrderedByCreatedAtDesc(t *testing.
 func TestPostQueryService_FindAll_EmptyTable(t *testing.T) {
 	defer func() { require.NoError(t, testDb.Cleanup()) }()
 
+	ctx := seedTenant(t)
 	svc := query.NewPostQueryService(testDb.DbManager())
-	posts, total, err := svc.FindAll(context.Background(), 10, 0)
+	posts, err := svc.FindAll(ctx, post.PostFilter{}, 10, 0)
 
 	require.NoError(t, err)
-	assert.Equal(t, 0, total)
+	assertPostCount(t, ctx, svc, 0)
 	assert.NotNil(t, posts)
 	assert.Empty(t, posts)
 }
@@ -76,23 +119,24 @@ func TestPostQueryService_FindAll_EmptyTable(t *testing.T) {
 func TestPostQueryService_FindAll_Pagination(t *testing.T) {
 	defer func() { require.NoError(t, testDb.Cleanup()) }()
 
+	ctx := seedTenant(t)
 	user := seedPostUser(t, "pagination@example.com")
 	for i := range 5 {
-		seedPost(t, user.ID(), "post content", time.Date(2026, 1, i+1, 0, 0, 0, 0, time.UTC))
+		seedPost(t, ctx, user.ID(), "post content", time.Date(2026, 1, i+1, 0, 0, 0, 0, time.UTC))
 	}
 
 	svc := query.NewPostQueryService(testDb.DbManager())
 
-	page1, total, err := svc.FindAll(context.Background(), 2, 0)
+	page1, err := svc.FindAll(ctx, post.PostFilter{}, 2, 0)
 	require.NoError(t, err)
-	assert.Equal(t, 5, total)
+	assertPostCount(t, ctx, svc, 5)
 	assert.Len(t, page1, 2)
 
-	page2, _, err := svc.FindAll(context.Background(), 2, 2)
+	page2, err := svc.FindAll(ctx, post.PostFilter{}, 2, 2)
 	require.NoError(t, err)
 	assert.Len(t, page2, 2)
 
-	page3, _, err := svc.FindAll(context.Background(), 2, 4)
+	page3, err := svc.FindAll(ctx, post.PostFilter{}, 2, 4)
 	require.NoError(t, err)
 	assert.Len(t, page3, 1)
 }
@@ -100,14 +144,15 @@ func TestPostQueryService_FindAll_Pagination(t *testing.T) {
 func TestPostQueryService_FindAll_BeyondOffsetReturnsEmpty(t *testing.T) {
 	defer func() { require.NoError(t, testDb.Cleanup()) }()
 
+	ctx := seedTenant(t)
 	user := seedPostUser(t, "beyond@example.com")
-	seedPost(t, user.ID(), "only post", time.Now().UTC())
+	seedPost(t, ctx, user.ID(), "only post", time.Now().UTC())
 
 	svc := query.NewPostQueryService(testDb.DbManager())
-	posts, total, err := svc.FindAll(context.Background(), 10, 100)
+	posts, err := svc.FindAll(ctx, post.PostFilter{}, 10, 100)
 
 	require.NoError(t, err)
-	assert.Equal(t, 1, total)
+	assertPostCount(t, ctx, svc, 1)
 	assert.NotNil(t, posts)
 	assert.Empty(t, posts)
 }
@@ -115,17 +160,19 @@ func TestPostQueryService_FindAll_BeyondOffsetReturnsEmpty(t *testing.T) {
 func TestPostQueryService_FindAll_MapsFieldsCorrectly(t *testing.T) {
 	defer func() { require.NoError(t, testDb.Cleanup()) }()
 
+	ctx := seedTenant(t)
 	user := seedPostUser(t, "mapping@example.com")
-	created := seedPost(t, user.ID(), "hello mapping", time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	created := seedPost(t, ctx, user.ID(), "hello mapping", time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
 
 	svc := query.NewPostQueryService(testDb.DbManager())
-	posts, total, err := svc.FindAll(context.Background(), 10, 0)
+	posts, err := svc.FindAll(ctx, post.PostFilter{}, 10, 0)
 
 	require.NoError(t, err)
-	assert.Equal(t, 1, total)
+	assertPostCount(t, ctx, svc, 1)
 	require.Len(t, posts, 1)
 	assert.Equal(t, created.ID(), posts[0].ID)
 	assert.Equal(t, user.ID(), posts[0].UserID)
+	assert.Equal(t, "Post User", posts[0].AuthorName)
 	assert.Equal(t, "hello mapping", posts[0].Content)
 	assert.Equal(t, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC), posts[0].CreatedAt)
 }
@@ -133,16 +180,781 @@ func TestPostQueryService_FindAll_MapsFieldsCorrectly(t *testing.T) {
 func TestPostQueryService_FindAll_TotalAndCountAreConsistent(t *testing.T) {
 	defer func() { require.NoError(t, testDb.Cleanup()) }()
 
+	ctx := seedTenant(t)
 	user := seedPostUser(t, "consistent@example.com")
 	for i := range 7 {
-		seedPost(t, user.ID(), "post", time.Date(2026, 1, i+1, 0, 0, 0, 0, time.UTC))
+		seedPost(t, ctx, user.ID(), "post", time.Date(2026, 1, i+1, 0, 0, 0, 0, time.UTC))
 	}
 
 	svc := query.NewPostQueryService(testDb.DbManager())
 
-	// Fetch only 3 rows but total should be 7
-	posts, total, err := svc.FindAll(context.Background(), 3, 0)
+	// Fetch only 3 rows but the count covers all 7
+	posts, err := svc.FindAll(ctx, post.PostFilter{}, 3, 0)
 	require.NoError(t, err)
-	assert.Equal(t, 7, total)
 	assert.Len(t, posts, 3)
+	assertPostCount(t, ctx, svc, 7)
+}
+
+func TestPostQueryService_FindAll_ScopedToTenant(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherCtx := seedTenant(t)
+
+	user := seedPostUser(t, "tenant@example.com")
+	own := seedPost(t, ctx, user.ID(), "own post", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	seedPost(t, otherCtx, user.ID(), "other tenant post", time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC))
+
+	svc := query.NewPostQueryService(testDb.DbManager())
+	posts, err := svc.FindAll(ctx, post.PostFilter{}, 10, 0)
+
+	require.NoError(t, err)
+	assertPostCount(t, ctx, svc, 1)
+	require.Len(t, posts, 1)
+	assert.Equal(t, own.ID(), posts[0].ID)
+}
+
+func TestPostQueryService_FindAll_NoActiveTenant(t *testing.T) {
+	svc := query.NewPostQueryService(testDb.DbManager())
+	posts, err := svc.FindAll(context.Background(), post.PostFilter{}, 10, 0)
+
+	require.ErrorIs(t, err, db.ErrNoActiveTenant)
+	assert.Nil(t, posts)
+
+	_, err = svc.Count(context.Background(), post.PostFilter{})
+	require.ErrorIs(t, err, db.ErrNoActiveTenant)
+}
+
+func TestPostQueryService_FindAfterAndBefore(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherCtx := seedTenant(t)
+	user := seedPostUser(t, "keyset@example.com")
+
+	// Two posts share a timestamp so that the id breaks the tie.
+	tied := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+	seedPost(t, ctx, user.ID(), "first", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	seedPost(t, ctx, user.ID(), "second", time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC))
+	seedPost(t, ctx, user.ID(), "tied a", tied)
+	seedPost(t, ctx, user.ID(), "tied b", tied)
+	seedPost(t, ctx, user.ID(), "fifth", time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC))
+	seedPost(t, otherCtx, user.ID(), "other tenant", time.Date(2026, 1, 3, 12, 0, 0, 0, time.UTC))
+
+	svc := query.NewPostQueryService(testDb.DbManager())
+	all, err := svc.FindAll(ctx, post.PostFilter{}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, all, 5)
+
+	cursorOf := func(p post.PostDto) post.PostCursor { return post.PostCursor{CreatedAt: p.CreatedAt, ID: p.ID} }
+
+	// Seeking after a tied post continues with its sibling and never repeats or skips a row.
+	after, err := svc.FindAfter(ctx, post.PostFilter{}, cursorOf(all[1]), 2)
+	require.NoError(t, err)
+	assert.Equal(t, all[2:4], after)
+
+	// Seeking before returns the closest newer posts, still newest first.
+	before, err := svc.FindBefore(ctx, post.PostFilter{}, cursorOf(all[3]), 2)
+	require.NoError(t, err)
+	assert.Equal(t, all[1:3], before)
+
+	edge, err := svc.FindAfter(ctx, post.PostFilter{}, cursorOf(all[4]), 2)
+	require.NoError(t, err)
+	assert.NotNil(t, edge)
+	assert.Empty(t, edge)
+
+	// A cursor from another tenant's feed only pages through the current tenant.
+	hidden, err := svc.FindAfter(otherCtx, post.PostFilter{}, cursorOf(all[0]), 10)
+	require.NoError(t, err)
+	require.Len(t, hidden, 1)
+	assert.Equal(t, "other tenant", hidden[0].Content)
+}
+
+func TestPostQueryService_FindByID(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherCtx := seedTenant(t)
+	user := seedPostUser(t, "findbyid@example.com")
+	createdAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	created := seedPost(t, ctx, user.ID(), "hello", createdAt)
+
+	svc := query.NewPostQueryService(testDb.DbManager())
+
+	found, err := svc.FindByID(ctx, created.ID(), user.ID())
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, created.ID(), found.ID)
+	assert.Equal(t, user.ID(), found.UserID)
+	assert.Equal(t, "hello", found.Content)
+	assert.Equal(t, createdAt, found.CreatedAt)
+	assert.Equal(t, createdAt, found.UpdatedAt)
+	assert.False(t, found.Edited)
+	assert.Equal(t, 1, found.RevisionCount)
+
+	// Unknown posts and posts of another tenant are reported as nil.
+	missing, err := svc.FindByID(ctx, uuid.New(), user.ID())
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	hidden, err := svc.FindByID(otherCtx, created.ID(), user.ID())
+	require.NoError(t, err)
+	assert.Nil(t, hidden)
+}
+
+func TestPostQueryService_Revisions(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherCtx := seedTenant(t)
+	author := seedPostUser(t, "revisions-author@example.com")
+	editor := seedPostUser(t, "revisions-editor@example.com")
+	createdAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	created := seedPost(t, ctx, author.ID(), "typo", createdAt)
+
+	editedAt := createdAt.Add(time.Hour)
+	require.NoError(t, created.Edit("fixed", "markdown", editedAt))
+	require.NoError(t, repository.NewPostRepository(testDb.DbManager()).Update(ctx, created))
+	seedPostRevision(t, ctx, created, editor.ID())
+
+	svc := query.NewPostQueryService(testDb.DbManager())
+
+	found, err := svc.FindByID(ctx, created.ID(), editor.ID())
+	require.NoError(t, err)
+	assert.True(t, found.Edited)
+	assert.Equal(t, 2, found.RevisionCount)
+	assert.Equal(t, "markdown", found.Format)
+
+	posts, err := svc.FindAll(ctx, post.PostFilter{}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.True(t, posts[0].Edited)
+	assert.Equal(t, 2, posts[0].RevisionCount)
+	assert.Equal(t, "markdown", posts[0].Format)
+
+	revisions, err := svc.FindRevisions(ctx, created.ID())
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, 1, revisions[0].Number)
+	assert.Equal(t, "typo", revisions[0].Content)
+	assert.Equal(t, "plain", revisions[0].Format)
+	assert.Equal(t, author.ID(), *revisions[0].EditorID)
+	assert.Equal(t, createdAt, revisions[0].CreatedAt)
+	assert.Equal(t, 2, revisions[1].Number)
+	assert.Equal(t, "fixed", revisions[1].Content)
+	assert.Equal(t, "markdown", revisions[1].Format)
+	assert.Equal(t, editor.ID(), *revisions[1].EditorID)
+	assert.Equal(t, editedAt, revisions[1].CreatedAt)
+
+	second, err := svc.FindRevision(ctx, created.ID(), 2)
+	require.NoError(t, err)
+	require.NotNil(t, second)
+	assert.Equal(t, revisions[1], *second)
+
+	missing, err := svc.FindRevision(ctx, created.ID(), 3)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	// Another tenant sees neither the history nor a single revision.
+	hidden, err := svc.FindRevisions(otherCtx, created.ID())
+	require.NoError(t, err)
+	assert.Empty(t, hidden)
+
+	hiddenRevision, err := svc.FindRevision(otherCtx, created.ID(), 1)
+	require.NoError(t, err)
+	assert.Nil(t, hiddenRevision)
+}
+
+func TestPostQueryService_Filter(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	alice := seedPostUser(t, "filter-alice@example.com")
+	bob := seedPostUser(t, "filter-bob@example.com")
+	carol := seedPostUser(t, "filter-carol@example.com")
+
+	day := func(d int) time.Time { return time.Date(2026, 2, d, 0, 0, 0, 0, time.UTC) }
+	a1 := seedPost(t, ctx, alice.ID(), "alice 1", day(1))
+	a2 := seedPost(t, ctx, alice.ID(), "alice 2", day(2))
+	b3 := seedPost(t, ctx, bob.ID(), "bob 3", day(3))
+	seedPost(t, ctx, carol.ID(), "carol 4", day(4))
+
+	svc := query.NewPostQueryService(testDb.DbManager())
+	ids := func(posts []post.PostDto) []uuid.UUID {
+		out := make([]uuid.UUID, len(posts))
+		for i, p := range posts {
+			out[i] = p.ID
+		}
+
+		return out
+	}
+
+	from, to := day(2), day(4)
+	tests := []struct {
+		name   string
+		filter post.PostFilter
+		want   []uuid.UUID
+	}{
+		{
+			name:   "single author",
+			filter: post.PostFilter{AuthorIDs: []uuid.UUID{alice.ID()}},
+			want:   []uuid.UUID{a2.ID(), a1.ID()},
+		},
+		{
+			name:   "several authors",
+			filter: post.PostFilter{AuthorIDs: []uuid.UUID{alice.ID(), bob.ID()}},
+			want:   []uuid.UUID{b3.ID(), a2.ID(), a1.ID()},
+		},
+		// The range includes its start and excludes its end.
+		{
+			name:   "date range",
+			filter: post.PostFilter{CreatedAfter: &from, CreatedBefore: &to},
+			want:   []uuid.UUID{b3.ID(), a2.ID()},
+		},
+		{
+			name:   "author and date range",
+			filter: post.PostFilter{AuthorIDs: []uuid.UUID{alice.ID()}, CreatedAfter: &from},
+			want:   []uuid.UUID{a2.ID()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			posts, err := svc.FindAll(ctx, tt.filter, 10, 0)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ids(posts))
+
+			count, err := svc.Count(ctx, tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.want), count)
+
+			// Keyset pages honour the same filter.
+			after, err := svc.FindAfter(ctx, tt.filter, post.PostCursor{CreatedAt: posts[0].CreatedAt, ID: posts[0].ID}, 10)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want[1:], ids(after))
+
+			last := posts[len(posts)-1]
+			before, err := svc.FindBefore(ctx, tt.filter, post.PostCursor{CreatedAt: last.CreatedAt, ID: last.ID}, 10)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want[:len(tt.want)-1], ids(before))
+		})
+	}
+}
+
+func TestPostQueryService_FindAuthor(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherCtx := seedTenant(t)
+	author := seedPostUser(t, "author@example.com")
+	seedPost(t, ctx, author.ID(), "hello", time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	svc := query.NewPostQueryService(testDb.DbManager())
+
+	// Authors with posts in the tenant are found even without a membership.
+	found, err := svc.FindAuthor(ctx, author.ID())
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, post.PostAuthorDto{ID: author.ID(), Name: "Post User"}, *found)
+
+	// Members are found before their first post.
+	member := seedPostUser(t, "member@example.com")
+	joinTenant(t, ctx, member.ID())
+
+	newcomer, err := svc.FindAuthor(ctx, member.ID())
+	require.NoError(t, err)
+	require.NotNil(t, newcomer)
+	assert.Equal(t, member.ID(), newcomer.ID)
+
+	hidden, err := svc.FindAuthor(otherCtx, author.ID())
+	require.NoError(t, err)
+	assert.Nil(t, hidden)
+
+	missing, err := svc.FindAuthor(ctx, uuid.New())
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestPostQueryService_FindTimeline(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	viewer := seedPostUser(t, "viewer@example.com")
+	popular := seedPostUser(t, "popular@example.com")
+	quiet := seedPostUser(t, "quiet@example.com")
+	stranger := seedPostUser(t, "stranger@example.com")
+
+	base := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	seedFollow(t, ctx, viewer.ID(), popular.ID(), base)
+	seedFollow(t, ctx, viewer.ID(), quiet.ID(), base)
+
+	oldest := seedPost(t, ctx, quiet.ID(), "quiet old", base.Add(time.Hour))
+	fannedOut := seedPost(t, ctx, popular.ID(), "fanned out", base.Add(2*time.Hour))
+	_, err := repository.NewTimelineRepository(testDb.DbManager()).FanOut(ctx, fannedOut, 1)
+	require.NoError(t, err)
+
+	quietNew := seedPost(t, ctx, quiet.ID(), "quiet new", base.Add(3*time.Hour))
+	seedPost(t, ctx, stranger.ID(), "not followed", base.Add(4*time.Hour))
+	newest := seedPost(t, ctx, popular.ID(), "read on demand", base.Add(5*time.Hour))
+
+	ids := func(posts []post.PostDto) []uuid.UUID {
+		out := make([]uuid.UUID, len(posts))
+		for i, p := range posts {
+			out[i] = p.ID
+		}
+
+		return out
+	}
+
+	svc := query.NewPostQueryService(testDb.DbManager())
+
+	// Posts from the viewer's entries and those read through the follows merge without duplicates.
+	all, err := svc.FindTimeline(ctx, viewer.ID(), nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{newest.ID(), quietNew.ID(), fannedOut.ID(), oldest.ID()}, ids(all))
+	assert.Equal(t, "Post User", all[0].AuthorName)
+
+	page, err := svc.FindTimeline(ctx, viewer.ID(), &post.PostCursor{CreatedAt: all[1].CreatedAt, ID: all[1].ID}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{fannedOut.ID(), oldest.ID()}, ids(page))
+
+	empty, err := svc.FindTimeline(ctx, stranger.ID(), nil, 10)
+	require.NoError(t, err)
+	assert.NotNil(t, empty)
+	assert.Empty(t, empty)
+}
+
+func TestPostQueryService_BlocksAndMutes(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	viewer := seedMember(t, ctx, "viewer@example.com")
+	blocker := seedMember(t, ctx, "blocker@example.com")
+	muted := seedMember(t, ctx, "muted@example.com")
+	other := seedMember(t, ctx, "other@example.com")
+
+	base := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	seedFollow(t, ctx, viewer.ID(), blocker.ID(), base)
+	seedFollow(t, ctx, viewer.ID(), muted.ID(), base)
+	seedFollow(t, ctx, viewer.ID(), other.ID(), base)
+
+	blocked := seedPost(t, ctx, blocker.ID(), "blocker", base.Add(time.Hour))
+	mutedPost := seedPost(t, ctx, muted.ID(), "muted", base.Add(2*time.Hour))
+	visible := seedPost(t, ctx, other.ID(), "other", base.Add(3*time.Hour))
+
+	// The block is made by the other side: blocks hide the users from each other either way.
+	seedBlock(t, ctx, blocker.ID(), viewer.ID(), base)
+	seedMute(t, ctx, viewer.ID(), muted.ID(), base)
+
+	ids := func(posts []post.PostDto) []uuid.UUID {
+		out := make([]uuid.UUID, len(posts))
+		for i, p := range posts {
+			out[i] = p.ID
+		}
+
+		return out
+	}
+
+	svc := query.NewPostQueryService(testDb.DbManager())
+
+	t.Run("the feed hides blocked and muted users", func(t *testing.T) {
+		posts, err := svc.FindAll(ctx, post.PostFilter{ViewerID: viewer.ID()}, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{visible.ID()}, ids(posts))
+
+		count, err := svc.Count(ctx, post.PostFilter{ViewerID: viewer.ID()})
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("the timeline hides blocked and muted users", func(t *testing.T) {
+		posts, err := svc.FindTimeline(ctx, viewer.ID(), nil, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{visible.ID()}, ids(posts))
+	})
+
+	t.Run("listing an author shows a muted user but not a blocked one", func(t *testing.T) {
+		posts, err := svc.FindAll(ctx, post.PostFilter{
+			ViewerID: viewer.ID(), AuthorIDs: []uuid.UUID{blocker.ID(), muted.ID()},
+		}, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{mutedPost.ID()}, ids(posts))
+	})
+
+	t.Run("a blocked user's post is not found", func(t *testing.T) {
+		dto, err := svc.FindByID(ctx, blocked.ID(), viewer.ID())
+		require.NoError(t, err)
+		assert.Nil(t, dto)
+
+		dto, err = svc.FindByID(ctx, mutedPost.ID(), viewer.ID())
+		require.NoError(t, err)
+		assert.NotNil(t, dto)
+	})
+
+	t.Run("the blocker sees nothing of the viewer either", func(t *testing.T) {
+		seedPost(t, ctx, viewer.ID(), "viewer", base.Add(4*time.Hour))
+
+		filter := post.PostFilter{ViewerID: blocker.ID(), AuthorIDs: []uuid.UUID{viewer.ID()}}
+		posts, err := svc.FindAll(ctx, filter, 10, 0)
+		require.NoError(t, err)
+		assert.Empty(t, posts)
+	})
+
+	t.Run("comment counts leave out the comments the thread hides", func(t *testing.T) {
+		shown := seedComment(t, ctx, visible, nil, other.ID(), base.Add(5*time.Hour))
+		seedComment(t, ctx, visible, nil, blocker.ID(), base.Add(6*time.Hour))
+		seedComment(t, ctx, visible, shown, muted.ID(), base.Add(7*time.Hour))
+
+		dto, err := svc.FindByID(ctx, visible.ID(), viewer.ID())
+		require.NoError(t, err)
+		require.NotNil(t, dto)
+		assert.Equal(t, 1, dto.CommentCount)
+
+		posts, err := svc.FindAll(ctx, post.PostFilter{ViewerID: viewer.ID()}, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, posts, 1)
+		assert.Equal(t, 1, posts[0].CommentCount)
+
+		comments, err := query.NewCommentQueryService(testDb.DbManager()).
+			FindByParent(ctx, visible.ID(), viewer.ID(), nil, nil, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{shown.ID()}, commentIDs(comments))
+		assert.Equal(t, 0, comments[0].ReplyCount)
+
+		// Counts follow the viewer: the muted user is not muted by the blocker.
+		dto, err = svc.FindByID(ctx, visible.ID(), blocker.ID())
+		require.NoError(t, err)
+		require.NotNil(t, dto)
+		assert.Equal(t, 3, dto.CommentCount)
+	})
+}
+
+func TestPostQueryService_HashtagsAndMentions(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherCtx := seedTenant(t)
+	alice := seedMember(t, ctx, "alice@example.com")
+	joinTenant(t, otherCtx, alice.ID())
+
+	_, err := testDb.Pool().Exec(ctx, "UPDATE users SET name = 'Alice' WHERE id = $1", alice.ID())
+	require.NoError(t, err)
+
+	entityRepo := repository.NewPostEntityRepository(testDb.DbManager())
+	seedTaggedPost := func(ctx context.Context, content string, createdAt time.Time) entity.Post {
+		created := seedPost(t, ctx, alice.ID(), content, createdAt)
+		require.NoError(t, entityRepo.Replace(ctx, created, vo.ParseContentEntities(created.Content())))
+
+		return created
+	}
+
+	tagged := seedTaggedPost(ctx, "Hi @alice and @bob, #Go!", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	seedTaggedPost(ctx, "#rust only", time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC))
+	seedTaggedPost(otherCtx, "#go elsewhere", time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC))
+
+	svc := query.NewPostQueryService(testDb.DbManager())
+	filter := post.PostFilter{Hashtag: "go"}
+
+	posts, err := svc.FindAll(ctx, filter, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, tagged.ID(), posts[0].ID)
+
+	total, err := svc.Count(ctx, filter)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+
+	// @bob matches no member and is left out.
+	aliceID := alice.ID()
+	want := []post.PostEntityDto{
+		{Type: "mention", Start: 3, End: 9, Value: "alice", UserID: &aliceID},
+		{Type: "hashtag", Start: 20, End: 23, Value: "go"},
+	}
+	assert.Equal(t, want, posts[0].Entities)
+
+	found, err := svc.FindByID(ctx, tagged.ID(), alice.ID())
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, want, found.Entities)
+
+	untagged, err := svc.FindAll(ctx, post.PostFilter{Hashtag: "python"}, 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, untagged)
+}
+
+func TestPostQueryService_Attachments(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	alice := seedMember(t, ctx, "alice@example.com")
+	attachmentRepo := repository.NewAttachmentRepository(testDb.DbManager())
+
+	withFiles := seedPost(t, ctx, alice.ID(), "with files", time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC))
+	seedPost(t, ctx, alice.ID(), "without files", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	notes, err := entity.NewAttachment(alice.ID(), "notes.txt", vo.AttachmentContentTypeText, 5, time.Now())
+	require.NoError(t, err)
+	photo, err := entity.NewAttachment(alice.ID(), "photo.png", vo.AttachmentContentTypePNG, 1024, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, photo.AddThumbnail(640, 480))
+
+	for i, attachment := range []entity.Attachment{photo, notes} {
+		require.NoError(t, attachmentRepo.Create(ctx, attachment))
+		require.NoError(t, attachment.AttachTo(withFiles.ID(), i))
+		require.NoError(t, attachmentRepo.Attach(ctx, attachment))
+	}
+
+	svc := query.NewPostQueryService(testDb.DbManager())
+
+	posts, err := svc.FindAll(ctx, post.PostFilter{}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, posts, 2)
+
+	want := []post.PostAttachmentDto{
+		{
+			ID: photo.ID(), FileName: "photo.png", ContentType: "image/png", Size: 1024, Width: 640, Height: 480,
+			HasThumbnail: true,
+		},
+		{ID: notes.ID(), FileName: "notes.txt", ContentType: "text/plain", Size: 5},
+	}
+	assert.Equal(t, want, posts[0].Attachments)
+	assert.NotNil(t, posts[1].Attachments)
+	assert.Empty(t, posts[1].Attachments)
+
+	found, err := svc.FindByID(ctx, withFiles.ID(), alice.ID())
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, want, found.Attachments)
+}
+
+func TestPostQueryService_Visibility(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	follower := seedMember(t, ctx, "follower@example.com")
+	stranger := seedMember(t, ctx, "stranger@example.com")
+	seedFollow(t, ctx, follower.ID(), author.ID(), time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	at := func(h int) time.Time { return time.Date(2026, 3, 1, h, 0, 0, 0, time.UTC) }
+	publishAt := at(12)
+	public := seedPost(t, ctx, author.ID(), "public", at(1))
+	followers := seedPostWith(
+		t, ctx, author.ID(), "followers", vo.PostVisibilityFollowers, vo.PostStatusPublished, nil, at(2),
+	)
+	private := seedPostWith(
+		t, ctx, author.ID(), "private", vo.PostVisibilityPrivate, vo.PostStatusPublished, nil, at(3),
+	)
+	draft := seedPostWith(t, ctx, author.ID(), "draft", vo.PostVisibilityPublic, vo.PostStatusDraft, nil, at(4))
+	scheduled := seedPostWith(
+		t, ctx, author.ID(), "scheduled", vo.PostVisibilityPublic, vo.PostStatusDraft, &publishAt, at(5),
+	)
+	ownDraft := seedPostWith(
+		t, ctx, stranger.ID(), "own draft", vo.PostVisibilityPublic, vo.PostStatusDraft, nil, at(6),
+	)
+	moderated := seedPost(t, ctx, author.ID(), "moderated", at(7))
+	require.NoError(t, moderated.Hide(at(8)))
+	require.NoError(t, repository.NewPostRepository(testDb.DbManager()).Hide(ctx, moderated))
+
+	svc := query.NewPostQueryService(testDb.DbManager())
+	ids := func(posts []post.PostDto) []uuid.UUID {
+		result := make([]uuid.UUID, len(posts))
+		for i, p := range posts {
+			result[i] = p.ID
+		}
+
+		return result
+	}
+
+	tests := []struct {
+		name       string
+		viewerID   uuid.UUID
+		drafts     bool
+		want       []uuid.UUID
+		wantHidden []uuid.UUID
+	}{
+		{
+			name:     "the author sees all of their published posts, including hidden ones",
+			viewerID: author.ID(),
+			want:     []uuid.UUID{moderated.ID(), private.ID(), followers.ID(), public.ID()},
+		},
+		{
+			name:       "a follower sees public and followers-only posts",
+			viewerID:   follower.ID(),
+			want:       []uuid.UUID{followers.ID(), public.ID()},
+			wantHidden: []uuid.UUID{private.ID(), draft.ID(), moderated.ID()},
+		},
+		{
+			name:       "anyone else sees public posts only",
+			viewerID:   stranger.ID(),
+			want:       []uuid.UUID{public.ID()},
+			wantHidden: []uuid.UUID{followers.ID(), private.ID(), draft.ID(), scheduled.ID(), moderated.ID()},
+		},
+		{
+			name:     "the author lists their own drafts",
+			viewerID: author.ID(),
+			drafts:   true,
+			want:     []uuid.UUID{scheduled.ID(), draft.ID()},
+		},
+		{
+			name:     "drafts of other users are never listed",
+			viewerID: stranger.ID(),
+			drafts:   true,
+			want:     []uuid.UUID{ownDraft.ID()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := post.PostFilter{ViewerID: tt.viewerID, Drafts: tt.drafts}
+
+			posts, err := svc.FindAll(ctx, filter, 10, 0)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ids(posts))
+
+			count, err := svc.Count(ctx, filter)
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.want), count)
+
+			for _, id := range tt.wantHidden {
+				found, err := svc.FindByID(ctx, id, tt.viewerID)
+				require.NoError(t, err)
+				assert.Nil(t, found)
+			}
+		})
+	}
+
+	found, err := svc.FindByID(ctx, scheduled.ID(), author.ID())
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, "public", found.Visibility)
+	assert.Equal(t, "draft", found.Status)
+	assert.Equal(t, &publishAt, found.PublishAt)
+	assert.False(t, found.Hidden)
+
+	found, err = svc.FindByID(ctx, moderated.ID(), author.ID())
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.True(t, found.Hidden)
+}
+
+func TestPostQueryService_Reposts(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	viewer := seedMember(t, ctx, "viewer@example.com")
+	author := seedMember(t, ctx, "author@example.com")
+	reposter := seedMember(t, ctx, "reposter@example.com")
+	seedFollow(t, ctx, viewer.ID(), reposter.ID(), time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	at := func(h int) time.Time { return time.Date(2026, 3, 1, h, 0, 0, 0, time.UTC) }
+	postRepo := repository.NewPostRepository(testDb.DbManager())
+	original := seedPost(t, ctx, author.ID(), "original", at(1))
+
+	repost, err := entity.NewRepost(reposter.ID(), original, at(2))
+	require.NoError(t, err)
+	_, err = postRepo.CreateRepost(ctx, repost)
+	require.NoError(t, err)
+
+	quote, err := entity.NewQuotePost(original, entity.PostParams{
+		UserID:    reposter.ID(),
+		Content:   "look",
+		CreatedAt: at(3),
+	})
+	require.NoError(t, err)
+	quote, err = postRepo.Create(ctx, quote)
+	require.NoError(t, err)
+	seedPostRevision(t, ctx, quote, reposter.ID())
+
+	svc := query.NewPostQueryService(testDb.DbManager())
+
+	// The timeline carries the shares of followed users with the shared post embedded, and only reposts count.
+	timeline, err := svc.FindTimeline(ctx, viewer.ID(), nil, 10)
+	require.NoError(t, err)
+	require.Len(t, timeline, 2)
+
+	assert.Equal(t, quote.ID(), timeline[0].ID)
+	assert.Equal(t, "quote", timeline[0].Kind)
+	assert.Equal(t, repost.ID(), timeline[1].ID)
+	assert.Equal(t, "repost", timeline[1].Kind)
+	assert.Equal(t, reposter.ID(), timeline[1].UserID)
+
+	for _, share := range timeline {
+		require.NotNil(t, share.Original)
+		assert.True(t, share.Original.Available)
+		assert.Equal(t, original.ID(), share.Original.ID)
+		assert.Equal(t, author.ID(), share.Original.UserID)
+		assert.Equal(t, "original", share.Original.Content)
+		assert.Equal(t, 1, share.Original.RevisionCount)
+	}
+
+	found, err := svc.FindByID(ctx, original.ID(), viewer.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "post", found.Kind)
+	assert.Nil(t, found.Original)
+	assert.Equal(t, 1, found.RepostCount)
+
+	// A hidden original degrades to a placeholder, even for its author.
+	require.NoError(t, original.Hide(at(4)))
+	require.NoError(t, postRepo.Hide(ctx, original))
+
+	for _, viewerID := range []uuid.UUID{viewer.ID(), author.ID()} {
+		found, err = svc.FindByID(ctx, repost.ID(), viewerID)
+		require.NoError(t, err)
+		require.NotNil(t, found.Original)
+		assert.Equal(t, post.OriginalPostDto{}, *found.Original)
+	}
+}
+
+func TestPostQueryService_Polls(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	viewer := seedMember(t, ctx, "viewer@example.com")
+	author := seedMember(t, ctx, "author@example.com")
+
+	at := func(h int) time.Time { return time.Date(2026, 3, 1, h, 0, 0, 0, time.UTC) }
+	polled := seedPost(t, ctx, author.ID(), "Which day?", at(1))
+	plain := seedPost(t, ctx, author.ID(), "no poll", at(2))
+
+	poll, err := entity.NewPoll(polled, []string{"Tue", "Wed"}, false, at(5))
+	require.NoError(t, err)
+
+	pollRepo := repository.NewPollRepository(testDb.DbManager())
+	require.NoError(t, pollRepo.Create(ctx, poll))
+
+	wed := poll.Options()[1].ID()
+	vote, err := poll.Vote(author.ID(), []uuid.UUID{wed}, at(3))
+	require.NoError(t, err)
+	require.NoError(t, db.NewTransactionManger(testDb.Pool()).Do(ctx, func(ctx context.Context) error {
+		_, err := pollRepo.Vote(ctx, vote)
+
+		return err
+	}))
+
+	svc := query.NewPostQueryService(testDb.DbManager())
+
+	// The query service reports the tallies as they are; hiding them is up to the use cases.
+	posts, err := svc.FindAll(ctx, post.PostFilter{ViewerID: viewer.ID()}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, posts, 2)
+
+	assert.Equal(t, plain.ID(), posts[0].ID)
+	assert.Nil(t, posts[0].Poll)
+
+	require.NotNil(t, posts[1].Poll)
+	assert.Equal(t, &post.PostPollDto{
+		ClosesAt:   at(5),
+		VoterCount: 1,
+		Options: []post.PostPollOptionDto{
+			{ID: poll.Options()[0].ID(), Label: "Tue", VoteCount: 0},
+			{ID: wed, Label: "Wed", VoteCount: 1},
+		},
+		MyVotes: []uuid.UUID{},
+	}, posts[1].Poll)
+
+	found, err := svc.FindByID(ctx, polled.ID(), author.ID())
+	require.NoError(t, err)
+	require.NotNil(t, found.Poll)
+	assert.Equal(t, []uuid.UUID{wed}, found.Poll.MyVotes)
 }
@@ -0,0 +1,180 @@
+package query
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	usecasequery "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type postSearchServiceImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (s *postSearchServiceImpl) Search(
+	ctx context.Context, query vo.SearchQuery, viewerID uuid.UUID, limit, offset int,
+) ([]usecasequery.PostSearchHitDto, error) {
+	ctx, span := s.tracer.Start(ctx, "Search")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	patterns := toLikePatterns(query)
+
+	var rows []sqlc.SearchPostsRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		rows, err = queries.SearchPosts(ctx, sqlc.SearchPostsParams{
+			TsQuery:        toTsQuery(query),
+			OrganizationID: tenantID,
+			AnchorPattern:  longestPattern(patterns),
+			Patterns:       patterns,
+			ViewerID:       pgtype.UUID{Bytes: viewerID, Valid: true},
+			PageLimit:      int32(limit),  //nolint:gosec // limit is validated (1-100) by the use case layer
+			PageOffset:     int32(offset), //nolint:gosec // offset is validated (>=0) by the use case layer
+		})
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to search posts", "error", err)
+
+		return nil, err
+	}
+
+	postRows := make([]sqlc.FindAllPostsRow, len(rows))
+	for i, row := range rows {
+		postRows[i] = sqlc.FindAllPostsRow{
+			ID:            row.ID,
+			UserID:        row.UserID,
+			Content:       row.Content,
+			Format:        row.Format,
+			Kind:          row.Kind,
+			OriginalID:    row.OriginalID,
+			Visibility:    row.Visibility,
+			Status:        row.Status,
+			PublishAt:     row.PublishAt,
+			HiddenAt:      row.HiddenAt,
+			CreatedAt:     row.CreatedAt,
+			UpdatedAt:     row.UpdatedAt,
+			AuthorName:    row.AuthorName,
+			RevisionCount: row.RevisionCount,
+			CommentCount:  row.CommentCount,
+			RepostCount:   row.RepostCount,
+		}
+	}
+
+	posts, err := toPostDtos(postRows)
+	if err == nil {
+		err = attachPostEntities(ctx, s.dbManager, tenantID, posts)
+	}
+
+	if err == nil {
+		err = attachPostAttachments(ctx, s.dbManager, tenantID, posts)
+	}
+
+	if err == nil {
+		err = attachOriginalPosts(ctx, s.dbManager, tenantID, viewerID, posts)
+	}
+
+	if err == nil {
+		err = attachPolls(ctx, s.dbManager, tenantID, viewerID, posts)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	hits := make([]usecasequery.PostSearchHitDto, len(posts))
+	for i, post := range posts {
+		hits[i] = usecasequery.PostSearchHitDto{Post: post, Rank: rows[i].Rank}
+	}
+
+	return hits, nil
+}
+
+// toTsQuery renders query in to_tsquery syntax: every term is required, phrase words must be adjacent
+// and prefix terms match any lexeme starting with the word.
+func toTsQuery(query vo.SearchQuery) string {
+	terms := make([]string, 0, len(query.Terms()))
+
+	for _, term := range query.Terms() {
+		words := make([]string, len(term.Words))
+		for i, word := range term.Words {
+			words[i] = quoteLexeme(word)
+		}
+
+		rendered := strings.Join(words, " <-> ")
+		if term.Prefix {
+			rendered += ":*"
+		}
+
+		terms = append(terms, rendered)
+	}
+
+	return strings.Join(terms, " & ")
+}
+
+// quoteLexeme quotes word so that tsquery operators in it are taken literally.
+func quoteLexeme(word string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `''`).Replace(word) + "'"
+}
+
+// toLikePatterns returns one ILIKE pattern per term that matches the term anywhere in the content.
+func toLikePatterns(query vo.SearchQuery) []string {
+	escape := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+	patterns := make([]string, len(query.Terms()))
+	for i, term := range query.Terms() {
+		patterns[i] = "%" + escape.Replace(term.Text()) + "%"
+	}
+
+	return patterns
+}
+
+// longestPattern picks the pattern with the most trigrams, the most selective one for the index.
+func longestPattern(patterns []string) string {
+	longest := ""
+
+	for _, pattern := range patterns {
+		if utf8.RuneCountInString(pattern) > utf8.RuneCountInString(longest) {
+			longest = pattern
+		}
+	}
+
+	return longest
+}
+
+// NewPostSearchService creates a new PostSearchService backed by Postgres full-text search.
+func NewPostSearchService(dbManager db.DbManager) usecasequery.PostSearchService {
+	return &postSearchServiceImpl{
+		tracer:    otel.Tracer("PostSearchService"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,123 @@
+//go:build integration
+
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostSearchService_Search(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherCtx := seedTenant(t)
+	user := seedPostUser(t, "search@example.com")
+
+	at := func(h int) time.Time { return time.Date(2026, 3, 1, h, 0, 0, 0, time.UTC) }
+	notes := seedPost(t, ctx, user.ID(), "Release notes for the deploy", at(1))
+	reversed := seedPost(t, ctx, user.ID(), "Notes about the release, a big release", at(2))
+	deploying := seedPost(t, ctx, user.ID(), "Deploying on Friday", at(3))
+	tokyo := seedPost(t, ctx, user.ID(), "きょうはとうきょうでかいぎがあります", at(4))
+	percent := seedPost(t, ctx, user.ID(), "Coverage is 100% now", at(5))
+	seedPost(t, otherCtx, user.ID(), "Release notes of another organization", at(6))
+
+	svc := query.NewPostSearchService(testDb.DbManager())
+	search := func(t *testing.T, raw string) []uuid.UUID {
+		t.Helper()
+
+		q, err := vo.NewSearchQuery(raw)
+		require.NoError(t, err)
+
+		hits, err := svc.Search(ctx, *q, user.ID(), 10, 0)
+		require.NoError(t, err)
+
+		ids := make([]uuid.UUID, len(hits))
+		for i, hit := range hits {
+			ids[i] = hit.Post.ID
+		}
+
+		return ids
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []uuid.UUID
+	}{
+		// Cover density ranks the post where the words stand together first.
+		{name: "words match in any order, ranked", query: "release notes", want: []uuid.UUID{notes.ID(), reversed.ID()}},
+		{name: "phrase words must be adjacent", query: `"release notes"`, want: []uuid.UUID{notes.ID()}},
+		{name: "prefix", query: "deploy*", want: []uuid.UUID{deploying.ID(), notes.ID()}},
+		{name: "words are case-insensitive", query: "FRIDAY", want: []uuid.UUID{deploying.ID()}},
+		{name: "text without spaces matches substrings", query: "とうきょう かいぎ", want: []uuid.UUID{tokyo.ID()}},
+		{name: "LIKE wildcards are literal", query: "100%", want: []uuid.UUID{percent.ID()}},
+		{name: "tsquery syntax is taken literally", query: "release's | (", want: []uuid.UUID{}},
+		{name: "no match", query: "nothing", want: []uuid.UUID{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, search(t, tt.query))
+		})
+	}
+
+	// "release" occurs twice in the reversed post, which therefore ranks first.
+	t.Run("ranks and pages", func(t *testing.T) {
+		q, err := vo.NewSearchQuery("release")
+		require.NoError(t, err)
+
+		hits, err := svc.Search(ctx, *q, user.ID(), 1, 1)
+		require.NoError(t, err)
+		require.Len(t, hits, 1)
+		assert.Equal(t, notes.ID(), hits[0].Post.ID)
+		assert.Equal(t, "Post User", hits[0].Post.AuthorName)
+		assert.Positive(t, hits[0].Rank)
+		assert.Less(t, hits[0].Rank, 1.0)
+	})
+
+	t.Run("no active tenant", func(t *testing.T) {
+		q, err := vo.NewSearchQuery("release")
+		require.NoError(t, err)
+
+		_, err = svc.Search(context.Background(), *q, user.ID(), 10, 0)
+		require.ErrorIs(t, err, db.ErrNoActiveTenant)
+	})
+}
+
+func TestPostSearchService_Visibility(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "search-author@example.com")
+	stranger := seedMember(t, ctx, "search-stranger@example.com")
+
+	at := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	public := seedPost(t, ctx, author.ID(), "release plan", at)
+	private := seedPostWith(
+		t, ctx, author.ID(), "release secrets", vo.PostVisibilityPrivate, vo.PostStatusPublished, nil, at,
+	)
+	seedPostWith(t, ctx, author.ID(), "release draft", vo.PostVisibilityPublic, vo.PostStatusDraft, nil, at)
+
+	svc := query.NewPostSearchService(testDb.DbManager())
+	q, err := vo.NewSearchQuery("release")
+	require.NoError(t, err)
+
+	// Drafts are never found; private posts are found by their author only.
+	hits, err := svc.Search(ctx, *q, stranger.ID(), 10, 0)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, public.ID(), hits[0].Post.ID)
+
+	hits, err = svc.Search(ctx, *q, author.ID(), 10, 0)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{public.ID(), private.ID()}, []uuid.UUID{hits[0].Post.ID, hits[1].Post.ID})
+}
@@ -0,0 +1,118 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	querypost "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	usecasequery "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type profileQueryServiceImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (s *profileQueryServiceImpl) FindProfile(
+	ctx context.Context, userID, viewerID uuid.UUID,
+) (*usecasequery.UserProfileDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindProfile")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var row sqlc.FindUserProfileRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		row, err = queries.FindUserProfile(ctx, sqlc.FindUserProfileParams{
+			OrganizationID: tenantID,
+			UserID:         pgtype.UUID{Bytes: userID, Valid: true},
+			ViewerID:       pgtype.UUID{Bytes: viewerID, Valid: true},
+		})
+
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query user profile", "error", err)
+
+		return nil, err
+	}
+
+	pinned, err := findSavedPosts(ctx, s.dbManager, tenantID, viewerID,
+		func(ctx context.Context, queries sqlc.Queries) ([]savedPostRow, error) {
+			rows, err := queries.FindPinnedPosts(ctx, sqlc.FindPinnedPostsParams{
+				OrganizationID: tenantID,
+				UserID:         pgtype.UUID{Bytes: userID, Valid: true},
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			saved := make([]savedPostRow, len(rows))
+			for i, row := range rows {
+				saved[i] = savedPostRow{postID: row.PostID, savedAt: row.PinnedAt}
+			}
+
+			return saved, nil
+		})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query pinned posts", "error", err)
+
+		return nil, err
+	}
+
+	profile := &usecasequery.UserProfileDto{
+		ID:            uuid.UUID(row.ID.Bytes),
+		Name:          row.Name,
+		Bio:           row.Bio,
+		AvatarURL:     row.AvatarUrl.String,
+		JoinedAt:      row.JoinedAt.Time,
+		FollowerCount: int(row.FollowerCount),
+		PinnedPosts:   []querypost.PostDto{},
+	}
+
+	// Pins of trashed posts, and of posts the viewer may not see, are left out rather than shown as
+	// tombstones.
+	for _, p := range pinned {
+		if p.Post != nil && len(profile.PinnedPosts) < entity.MaxPinnedPosts {
+			profile.PinnedPosts = append(profile.PinnedPosts, *p.Post)
+		}
+	}
+
+	return profile, nil
+}
+
+func NewProfileQueryService(dbManager db.DbManager) usecasequery.ProfileQueryService {
+	return &profileQueryServiceImpl{
+		tracer:    otel.Tracer("ProfileQueryService"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,88 @@
+//go:build integration
+
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/query"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pinnedIDs(posts []post.PostDto) []uuid.UUID {
+	ids := make([]uuid.UUID, len(posts))
+	for i, p := range posts {
+		ids[i] = p.ID
+	}
+
+	return ids
+}
+
+func TestProfileQueryService_FindProfile(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherCtx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	viewer := seedMember(t, ctx, "viewer@example.com")
+	outsider := seedMember(t, otherCtx, "outsider@example.com")
+	seedFollow(t, ctx, viewer.ID(), author.ID(), time.Now())
+
+	profile, err := entity.NewUserProfile(author.ID(), "Gopher", "https://example.com/a.png", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, repository.NewUserProfileRepository(testDb.DbManager()).Save(ctx, profile))
+
+	at := func(h int) time.Time { return time.Date(2026, 3, 1, h, 0, 0, 0, time.UTC) }
+	older := seedPost(t, ctx, author.ID(), "older", at(1))
+	private := seedPostWith(t, ctx, author.ID(), "private", vo.PostVisibilityPrivate, vo.PostStatusPublished, nil, at(2))
+	trashed := seedPost(t, ctx, author.ID(), "trashed", at(3))
+
+	pins := repository.NewPinnedPostRepository(testDb.DbManager())
+	for i, p := range []entity.Post{older, private, trashed} {
+		pin, err := entity.NewPinnedPost(author.ID(), p, at(10+i))
+		require.NoError(t, err)
+		_, err = pins.Pin(ctx, pin)
+		require.NoError(t, err)
+	}
+
+	trashPost(t, ctx, trashed, author.ID(), at(20))
+
+	svc := query.NewProfileQueryService(testDb.DbManager())
+
+	// Pins of trashed posts and of posts the viewer may not see are left out.
+	found, err := svc.FindProfile(ctx, author.ID(), viewer.ID())
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, author.ID(), found.ID)
+	assert.Equal(t, "Gopher", found.Bio)
+	assert.Equal(t, "https://example.com/a.png", found.AvatarURL)
+	assert.Equal(t, 1, found.FollowerCount)
+	assert.False(t, found.JoinedAt.IsZero())
+	assert.Equal(t, []uuid.UUID{older.ID()}, pinnedIDs(found.PinnedPosts))
+
+	own, err := svc.FindProfile(ctx, author.ID(), author.ID())
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{private.ID(), older.ID()}, pinnedIDs(own.PinnedPosts))
+
+	// Members who never filled in their profile have a blank one.
+	blank, err := svc.FindProfile(ctx, viewer.ID(), author.ID())
+	require.NoError(t, err)
+	require.NotNil(t, blank)
+	assert.Empty(t, blank.Bio)
+	assert.Empty(t, blank.AvatarURL)
+	assert.Zero(t, blank.FollowerCount)
+	assert.NotNil(t, blank.PinnedPosts)
+	assert.Empty(t, blank.PinnedPosts)
+
+	// Users of other organizations are not found.
+	missing, err := svc.FindProfile(ctx, outsider.ID(), viewer.ID())
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
@@ -0,0 +1,121 @@
+package query
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	usecasequery "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type reactionQueryServiceImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (s *reactionQueryServiceImpl) FindByPosts(
+	ctx context.Context, postIDs []uuid.UUID, viewerID uuid.UUID,
+) (map[uuid.UUID]usecasequery.PostReactionsDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindByPosts")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	ids := make([]pgtype.UUID, len(postIDs))
+	for i, id := range postIDs {
+		ids[i] = pgtype.UUID{Bytes: id, Valid: true}
+	}
+
+	var (
+		counts []sqlc.FindPostReactionCountsRow
+		mine   []sqlc.FindUserPostReactionsRow
+	)
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		counts, err = queries.FindPostReactionCounts(ctx, sqlc.FindPostReactionCountsParams{
+			OrganizationID: tenantID,
+			PostIds:        ids,
+		})
+		if err != nil {
+			return err
+		}
+
+		mine, err = queries.FindUserPostReactions(ctx, sqlc.FindUserPostReactionsParams{
+			OrganizationID: tenantID,
+			UserID:         pgtype.UUID{Bytes: viewerID, Valid: true},
+			PostIds:        ids,
+		})
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query post reactions", "error", err)
+
+		return nil, err
+	}
+
+	return toPostReactionsDtos(postIDs, counts, mine), nil
+}
+
+func toPostReactionsDtos(
+	postIDs []uuid.UUID, counts []sqlc.FindPostReactionCountsRow, mine []sqlc.FindUserPostReactionsRow,
+) map[uuid.UUID]usecasequery.PostReactionsDto {
+	dtos := make(map[uuid.UUID]usecasequery.PostReactionsDto, len(postIDs))
+	for _, id := range postIDs {
+		dtos[id] = usecasequery.PostReactionsDto{Counts: map[vo.ReactionType]int{}, Mine: []vo.ReactionType{}}
+	}
+
+	for _, row := range counts {
+		dtos[row.PostID.Bytes].Counts[vo.ReactionType(row.Type)] = int(row.Count)
+	}
+
+	reacted := make(map[uuid.UUID]map[vo.ReactionType]bool, len(mine))
+	for _, row := range mine {
+		if reacted[row.PostID.Bytes] == nil {
+			reacted[row.PostID.Bytes] = map[vo.ReactionType]bool{}
+		}
+
+		reacted[row.PostID.Bytes][vo.ReactionType(row.Type)] = true
+	}
+
+	// Keep the viewer's reactions in the fixed display order rather than the order the rows came in.
+	for id, types := range reacted {
+		dto := dtos[id]
+
+		for _, t := range vo.ReactionTypes() {
+			if types[t] {
+				dto.Mine = append(dto.Mine, t)
+			}
+		}
+
+		dtos[id] = dto
+	}
+
+	return dtos
+}
+
+func NewReactionQueryService(dbManager db.DbManager) usecasequery.ReactionQueryService {
+	return &reactionQueryServiceImpl{
+		tracer:    otel.Tracer("ReactionQueryService"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
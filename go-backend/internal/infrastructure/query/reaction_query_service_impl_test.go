@@ -0,0 +1,79 @@
+//go:build integration
+
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/query"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seedReaction(t *testing.T, ctx context.Context, p entity.Post, userID uuid.UUID, reactionType string) {
+	t.Helper()
+
+	reaction, err := entity.NewReaction(p.ID(), userID, reactionType, time.Now())
+	require.NoError(t, err)
+	_, err = repository.NewReactionRepository(testDb.DbManager()).Add(ctx, reaction)
+	require.NoError(t, err)
+}
+
+func TestReactionQueryService_FindByPosts(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	viewer := seedPostUser(t, "viewer@example.com")
+	other := seedPostUser(t, "other@example.com")
+	base := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	popular := seedPost(t, ctx, other.ID(), "popular", base)
+	quiet := seedPost(t, ctx, other.ID(), "quiet", base)
+
+	// Added out of display order to show that the viewer's reactions come back sorted.
+	seedReaction(t, ctx, popular, viewer.ID(), "wow")
+	seedReaction(t, ctx, popular, viewer.ID(), "like")
+	seedReaction(t, ctx, popular, other.ID(), "like")
+
+	svc := query.NewReactionQueryService(testDb.DbManager())
+
+	t.Run("counts and the viewer's own reactions", func(t *testing.T) {
+		reactions, err := svc.FindByPosts(ctx, []uuid.UUID{popular.ID(), quiet.ID()}, viewer.ID())
+
+		require.NoError(t, err)
+		assert.Equal(t, post.PostReactionsDto{
+			Counts: map[vo.ReactionType]int{vo.ReactionTypeLike: 2, vo.ReactionTypeWow: 1},
+			Mine:   []vo.ReactionType{vo.ReactionTypeLike, vo.ReactionTypeWow},
+		}, reactions[popular.ID()])
+		assert.Equal(t, post.PostReactionsDto{
+			Counts: map[vo.ReactionType]int{},
+			Mine:   []vo.ReactionType{},
+		}, reactions[quiet.ID()])
+	})
+
+	t.Run("withdrawn reactions are not counted", func(t *testing.T) {
+		_, err := repository.NewReactionRepository(testDb.DbManager()).
+			Remove(ctx, popular.ID(), viewer.ID(), vo.ReactionTypeWow)
+		require.NoError(t, err)
+
+		reactions, err := svc.FindByPosts(ctx, []uuid.UUID{popular.ID()}, other.ID())
+
+		require.NoError(t, err)
+		assert.Equal(t, map[vo.ReactionType]int{vo.ReactionTypeLike: 2}, reactions[popular.ID()].Counts)
+		assert.Equal(t, []vo.ReactionType{vo.ReactionTypeLike}, reactions[popular.ID()].Mine)
+	})
+
+	t.Run("other tenant sees nothing", func(t *testing.T) {
+		reactions, err := svc.FindByPosts(seedTenant(t), []uuid.UUID{popular.ID()}, viewer.ID())
+
+		require.NoError(t, err)
+		assert.Empty(t, reactions[popular.ID()].Counts)
+		assert.Empty(t, reactions[popular.ID()].Mine)
+	})
+}
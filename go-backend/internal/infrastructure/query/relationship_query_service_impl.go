@@ -0,0 +1,123 @@
+package query
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	usecasequery "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// findRelationshipsFunc runs one of the block- and mute-list queries, which share their parameters and
+// columns.
+type findRelationshipsFunc func(ctx context.Context, queries sqlc.Queries, params sqlc.FindBlocksParams) (
+	[]sqlc.FindBlocksRow, error,
+)
+
+type relationshipQueryServiceImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (s *relationshipQueryServiceImpl) FindBlocks(
+	ctx context.Context, userID uuid.UUID, after *usecasequery.RelationshipCursor, limit int,
+) ([]usecasequery.RelationshipDto, error) {
+	return s.findRelationships(ctx, "FindBlocks", userID, after, limit,
+		func(ctx context.Context, queries sqlc.Queries, params sqlc.FindBlocksParams) (
+			[]sqlc.FindBlocksRow, error,
+		) {
+			return queries.FindBlocks(ctx, params)
+		})
+}
+
+func (s *relationshipQueryServiceImpl) FindMutes(
+	ctx context.Context, userID uuid.UUID, after *usecasequery.RelationshipCursor, limit int,
+) ([]usecasequery.RelationshipDto, error) {
+	return s.findRelationships(ctx, "FindMutes", userID, after, limit,
+		func(ctx context.Context, queries sqlc.Queries, params sqlc.FindBlocksParams) (
+			[]sqlc.FindBlocksRow, error,
+		) {
+			rows, err := queries.FindMutes(ctx, sqlc.FindMutesParams(params))
+			if err != nil {
+				return nil, err
+			}
+
+			blockRows := make([]sqlc.FindBlocksRow, len(rows))
+			for i, row := range rows {
+				blockRows[i] = sqlc.FindBlocksRow(row)
+			}
+
+			return blockRows, nil
+		})
+}
+
+func (s *relationshipQueryServiceImpl) findRelationships(
+	ctx context.Context, spanName string, userID uuid.UUID, after *usecasequery.RelationshipCursor, limit int,
+	find findRelationshipsFunc,
+) ([]usecasequery.RelationshipDto, error) {
+	ctx, span := s.tracer.Start(ctx, spanName)
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	params := sqlc.FindBlocksParams{
+		OrganizationID: tenantID,
+		UserID:         pgtype.UUID{Bytes: userID, Valid: true},
+		PageLimit:      int32(limit), //nolint:gosec // limit is validated (1-100) by the use case layer
+	}
+
+	if after != nil {
+		params.CursorCreatedAt = pgtype.Timestamp{Time: after.CreatedAt, Valid: true}
+		params.CursorID = pgtype.UUID{Bytes: after.UserID, Valid: true}
+	}
+
+	var rows []sqlc.FindBlocksRow
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		rows, err = find(ctx, queries, params)
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query relationships", "error", err)
+
+		return nil, err
+	}
+
+	dtos := make([]usecasequery.RelationshipDto, len(rows))
+	for i, row := range rows {
+		dtos[i] = usecasequery.RelationshipDto{
+			UserID:    uuid.UUID(row.ID.Bytes),
+			Name:      row.Name,
+			CreatedAt: row.CreatedAt.Time,
+		}
+	}
+
+	return dtos, nil
+}
+
+// NewRelationshipQueryService creates a new RelationshipQueryService backed by Postgres.
+func NewRelationshipQueryService(dbManager db.DbManager) usecasequery.RelationshipQueryService {
+	return &relationshipQueryServiceImpl{
+		tracer:    otel.Tracer("RelationshipQueryService"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
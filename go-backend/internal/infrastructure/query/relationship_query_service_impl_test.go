@@ -0,0 +1,84 @@
+//go:build integration
+
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/query"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	queryuser "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seedBlock(t *testing.T, ctx context.Context, blockerID, blockedID uuid.UUID, createdAt time.Time) {
+	t.Helper()
+
+	block, err := entity.NewBlock(blockerID, blockedID, createdAt)
+	require.NoError(t, err)
+	_, err = repository.NewBlockRepository(testDb.DbManager()).Add(ctx, block)
+	require.NoError(t, err)
+}
+
+func seedMute(t *testing.T, ctx context.Context, muterID, mutedID uuid.UUID, createdAt time.Time) {
+	t.Helper()
+
+	mute, err := entity.NewMute(muterID, mutedID, createdAt)
+	require.NoError(t, err)
+	_, err = repository.NewMuteRepository(testDb.DbManager()).Add(ctx, mute)
+	require.NoError(t, err)
+}
+
+func TestRelationshipQueryService_FindBlocksAndMutes(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	actor := seedMember(t, ctx, "actor@example.com")
+	first := seedMember(t, ctx, "first@example.com")
+	second := seedMember(t, ctx, "second@example.com")
+	third := seedMember(t, ctx, "third@example.com")
+
+	base := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	seedBlock(t, ctx, actor.ID(), first.ID(), base)
+	seedBlock(t, ctx, actor.ID(), second.ID(), base.Add(time.Hour))
+	seedBlock(t, ctx, actor.ID(), third.ID(), base.Add(2*time.Hour))
+	seedBlock(t, ctx, first.ID(), actor.ID(), base)
+	seedMute(t, ctx, actor.ID(), second.ID(), base)
+
+	svc := query.NewRelationshipQueryService(testDb.DbManager())
+
+	blocks, err := svc.FindBlocks(ctx, actor.ID(), nil, 2)
+	require.NoError(t, err)
+	require.Len(t, blocks, 2)
+	assert.Equal(t, third.ID(), blocks[0].UserID)
+	assert.Equal(t, second.ID(), blocks[1].UserID)
+	assert.Equal(t, "Test User", blocks[0].Name)
+	assert.True(t, blocks[0].CreatedAt.Equal(base.Add(2*time.Hour)))
+
+	cursor := queryuser.RelationshipCursor{CreatedAt: blocks[1].CreatedAt, UserID: blocks[1].UserID}
+	rest, err := svc.FindBlocks(ctx, actor.ID(), &cursor, 2)
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	assert.Equal(t, first.ID(), rest[0].UserID)
+
+	// Only the blocks the user made are listed, not those of which they are the target.
+	byFirst, err := svc.FindBlocks(ctx, first.ID(), nil, 10)
+	require.NoError(t, err)
+	require.Len(t, byFirst, 1)
+	assert.Equal(t, actor.ID(), byFirst[0].UserID)
+
+	mutes, err := svc.FindMutes(ctx, actor.ID(), nil, 10)
+	require.NoError(t, err)
+	require.Len(t, mutes, 1)
+	assert.Equal(t, second.ID(), mutes[0].UserID)
+
+	none, err := svc.FindMutes(ctx, third.ID(), nil, 10)
+	require.NoError(t, err)
+	assert.NotNil(t, none)
+	assert.Empty(t, none)
+}
@@ -0,0 +1,108 @@
+package query
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	usecasequery "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type roleAssignmentQueryServiceImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (s *roleAssignmentQueryServiceImpl) FindActiveByUserID(
+	ctx context.Context, userID uuid.UUID,
+) ([]usecasequery.RoleAssignmentDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindActiveByUserID")
+	defer span.End()
+
+	var rows []sqlc.FindActiveUserRolesByUserIDRow
+
+	err := s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		rows, err = queries.FindActiveUserRolesByUserID(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query role assignments", "error", err)
+
+		return nil, err
+	}
+
+	dtos := make([]usecasequery.RoleAssignmentDto, 0, len(rows))
+	for _, row := range rows {
+		dtos = append(dtos, usecasequery.RoleAssignmentDto{
+			RoleID:    uuid.UUID(row.RoleID.Bytes),
+			RoleName:  row.RoleName,
+			GrantedBy: fromNullablePgtypeUuid(row.GrantedBy),
+			GrantedAt: row.GrantedAt.Time,
+			ExpiresAt: fromNullablePgtypeTimestamp(row.ExpiresAt),
+			Reason:    fromNullablePgtypeText(row.Reason),
+		})
+	}
+
+	return dtos, nil
+}
+
+func (s *roleAssignmentQueryServiceImpl) FindHistoryByUserID(
+	ctx context.Context, userID uuid.UUID,
+) ([]usecasequery.RoleAssignmentHistoryDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindHistoryByUserID")
+	defer span.End()
+
+	var rows []sqlc.FindUserRoleHistoriesByUserIDRow
+
+	err := s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		rows, err = queries.FindUserRoleHistoriesByUserID(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query role assignment history", "error", err)
+
+		return nil, err
+	}
+
+	dtos := make([]usecasequery.RoleAssignmentHistoryDto, 0, len(rows))
+	for _, row := range rows {
+		dtos = append(dtos, usecasequery.RoleAssignmentHistoryDto{
+			ID:        uuid.UUID(row.ID.Bytes),
+			RoleID:    uuid.UUID(row.RoleID.Bytes),
+			RoleName:  row.RoleName,
+			Action:    row.Action,
+			ActorID:   fromNullablePgtypeUuid(row.ActorID),
+			Reason:    fromNullablePgtypeText(row.Reason),
+			ExpiresAt: fromNullablePgtypeTimestamp(row.ExpiresAt),
+			CreatedAt: row.CreatedAt.Time,
+		})
+	}
+
+	return dtos, nil
+}
+
+// NewRoleAssignmentQueryService creates a new RoleAssignmentQueryService backed by Postgres.
+func NewRoleAssignmentQueryService(dbManager db.DbManager) usecasequery.RoleAssignmentQueryService {
+	return &roleAssignmentQueryServiceImpl{
+		tracer:    otel.Tracer("RoleAssignmentQueryService"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,100 @@
+//go:build integration
+
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/query"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	adminRoleID  = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	viewerRoleID = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+)
+
+func TestRoleAssignmentQueryService_FindActiveByUserID(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	u := seedUser(t, "target@example.com")
+	actor := seedUser(t, "actor@example.com")
+
+	grant, err := entity.NewRoleAssignment(u.ID(), viewerRoleID, actor.ID(), time.Now(), nil, "default access")
+	require.NoError(t, err)
+	_, err = repository.NewRoleAssignmentRepository(testDb.DbManager()).Grant(ctx, grant)
+	require.NoError(t, err)
+
+	// An expired assignment must not be listed even before the sweep removes it.
+	_, err = testDb.Pool().Exec(ctx,
+		"INSERT INTO user_roles (user_id, role_id, expires_at) VALUES ($1, $2, now() - interval '1 minute')",
+		u.ID().String(), adminRoleID.String(),
+	)
+	require.NoError(t, err)
+
+	svc := query.NewRoleAssignmentQueryService(testDb.DbManager())
+	assignments, err := svc.FindActiveByUserID(ctx, u.ID())
+
+	require.NoError(t, err)
+	require.Len(t, assignments, 1)
+	assert.Equal(t, viewerRoleID, assignments[0].RoleID)
+	assert.Equal(t, "viewer", assignments[0].RoleName)
+	require.NotNil(t, assignments[0].GrantedBy)
+	assert.Equal(t, actor.ID(), *assignments[0].GrantedBy)
+	assert.Nil(t, assignments[0].ExpiresAt)
+	require.NotNil(t, assignments[0].Reason)
+	assert.Equal(t, "default access", *assignments[0].Reason)
+}
+
+func TestRoleAssignmentQueryService_FindHistoryByUserID(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	u := seedUser(t, "target@example.com")
+	actor := seedUser(t, "actor@example.com")
+	repo := repository.NewRoleAssignmentRepository(testDb.DbManager())
+
+	expiresAt := time.Now().Add(time.Hour)
+	grant, err := entity.NewRoleAssignment(u.ID(), adminRoleID, actor.ID(), time.Now(), &expiresAt, "incident")
+	require.NoError(t, err)
+	_, err = repo.Grant(ctx, grant)
+	require.NoError(t, err)
+
+	_, err = testDb.Pool().Exec(ctx,
+		"UPDATE user_roles SET expires_at = now() - interval '1 second' WHERE user_id = $1", u.ID().String(),
+	)
+	require.NoError(t, err)
+	_, err = repo.DeleteExpired(ctx)
+	require.NoError(t, err)
+
+	svc := query.NewRoleAssignmentQueryService(testDb.DbManager())
+	history, err := svc.FindHistoryByUserID(ctx, u.ID())
+
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, "EXPIRED", history[0].Action)
+	assert.Nil(t, history[0].ActorID)
+	assert.Equal(t, "GRANTED", history[1].Action)
+	require.NotNil(t, history[1].ActorID)
+	assert.Equal(t, actor.ID(), *history[1].ActorID)
+	assert.Equal(t, "admin", history[1].RoleName)
+}
+
+func TestRoleAssignmentQueryService_UnknownUser(t *testing.T) {
+	svc := query.NewRoleAssignmentQueryService(testDb.DbManager())
+
+	assignments, err := svc.FindActiveByUserID(context.Background(), uuid.New())
+	require.NoError(t, err)
+	assert.Empty(t, assignments)
+
+	history, err := svc.FindHistoryByUserID(context.Background(), uuid.New())
+	require.NoError(t, err)
+	assert.Empty(t, history)
+}
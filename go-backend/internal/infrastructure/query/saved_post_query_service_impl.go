@@ -0,0 +1,211 @@
+package query
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	usecasequery "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type savedPostQueryServiceImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+// savedPostRow is a bookmark or collection entry: which post was saved and when.
+type savedPostRow struct {
+	postID  pgtype.UUID
+	savedAt pgtype.Timestamp
+}
+
+func (s *savedPostQueryServiceImpl) FindBookmarks(
+	ctx context.Context, userID uuid.UUID, after *usecasequery.SavedPostCursor, limit int,
+) ([]usecasequery.SavedPostDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindBookmarks")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	params := sqlc.FindBookmarksParams{
+		OrganizationID: tenantID,
+		UserID:         pgtype.UUID{Bytes: userID, Valid: true},
+		PageLimit:      int32(limit), //nolint:gosec // limit is validated (1-100) by the use case layer
+	}
+	if after != nil {
+		params.CursorCreatedAt = pgtype.Timestamp{Time: after.CreatedAt, Valid: true}
+		params.CursorID = pgtype.UUID{Bytes: after.ID, Valid: true}
+	}
+
+	dtos, err := findSavedPosts(ctx, s.dbManager, tenantID, userID,
+		func(ctx context.Context, queries sqlc.Queries) ([]savedPostRow, error) {
+			rows, err := queries.FindBookmarks(ctx, params)
+			if err != nil {
+				return nil, err
+			}
+
+			saved := make([]savedPostRow, len(rows))
+			for i, row := range rows {
+				saved[i] = savedPostRow{postID: row.PostID, savedAt: row.CreatedAt}
+			}
+
+			return saved, nil
+		})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query bookmarks", "error", err)
+
+		return nil, err
+	}
+
+	return dtos, nil
+}
+
+func (s *savedPostQueryServiceImpl) FindCollectionPosts(
+	ctx context.Context, collectionID, viewerID uuid.UUID, after *usecasequery.SavedPostCursor, limit int,
+) ([]usecasequery.SavedPostDto, error) {
+	ctx, span := s.tracer.Start(ctx, "FindCollectionPosts")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	params := sqlc.FindCollectionPostsParams{
+		OrganizationID: tenantID,
+		CollectionID:   pgtype.UUID{Bytes: collectionID, Valid: true},
+		PageLimit:      int32(limit), //nolint:gosec // limit is validated (1-100) by the use case layer
+	}
+	if after != nil {
+		params.CursorAddedAt = pgtype.Timestamp{Time: after.CreatedAt, Valid: true}
+		params.CursorID = pgtype.UUID{Bytes: after.ID, Valid: true}
+	}
+
+	dtos, err := findSavedPosts(ctx, s.dbManager, tenantID, viewerID,
+		func(ctx context.Context, queries sqlc.Queries) ([]savedPostRow, error) {
+			rows, err := queries.FindCollectionPosts(ctx, params)
+			if err != nil {
+				return nil, err
+			}
+
+			saved := make([]savedPostRow, len(rows))
+			for i, row := range rows {
+				saved[i] = savedPostRow{postID: row.PostID, savedAt: row.AddedAt}
+			}
+
+			return saved, nil
+		})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query collection posts", "error", err)
+
+		return nil, err
+	}
+
+	return dtos, nil
+}
+
+// findSavedPosts lists the entries returned by find and loads the posts among them that viewerID may see.
+// Entries whose post is gone or no longer visible are returned as tombstones, without a Post.
+func findSavedPosts(
+	ctx context.Context,
+	dbManager db.DbManager,
+	tenantID pgtype.UUID,
+	viewerID uuid.UUID,
+	find func(ctx context.Context, queries sqlc.Queries) ([]savedPostRow, error),
+) ([]usecasequery.SavedPostDto, error) {
+	var (
+		saved    []savedPostRow
+		postRows []sqlc.FindAllPostsRow
+	)
+
+	err := dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		if saved, err = find(ctx, queries); err != nil || len(saved) == 0 {
+			return err
+		}
+
+		ids := make([]pgtype.UUID, len(saved))
+		for i, row := range saved {
+			ids[i] = row.postID
+		}
+
+		rows, err := queries.FindVisiblePostsByIDs(ctx, sqlc.FindVisiblePostsByIDsParams{
+			OrganizationID: tenantID,
+			Ids:            ids,
+			ViewerID:       pgtype.UUID{Bytes: viewerID, Valid: true},
+		})
+
+		postRows = make([]sqlc.FindAllPostsRow, len(rows))
+		for i, row := range rows {
+			postRows[i] = sqlc.FindAllPostsRow(row)
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := toPostDtos(postRows)
+	if err == nil {
+		err = attachPostEntities(ctx, dbManager, tenantID, posts)
+	}
+
+	if err == nil {
+		err = attachPostAttachments(ctx, dbManager, tenantID, posts)
+	}
+
+	if err == nil {
+		err = attachOriginalPosts(ctx, dbManager, tenantID, viewerID, posts)
+	}
+
+	if err == nil {
+		err = attachPolls(ctx, dbManager, tenantID, viewerID, posts)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]*usecasequery.PostDto, len(posts))
+	for i := range posts {
+		byID[posts[i].ID] = &posts[i]
+	}
+
+	dtos := make([]usecasequery.SavedPostDto, len(saved))
+	for i, row := range saved {
+		postID := uuid.UUID(row.postID.Bytes)
+		dtos[i] = usecasequery.SavedPostDto{PostID: postID, SavedAt: row.savedAt.Time, Post: byID[postID]}
+	}
+
+	return dtos, nil
+}
+
+func NewSavedPostQueryService(dbManager db.DbManager) usecasequery.SavedPostQueryService {
+	return &savedPostQueryServiceImpl{
+		tracer:    otel.Tracer("SavedPostQueryService"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
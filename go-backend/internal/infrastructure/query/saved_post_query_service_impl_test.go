@@ -0,0 +1,120 @@
+//go:build integration
+
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/query"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func savedPostIDs(saved []post.SavedPostDto) []uuid.UUID {
+	ids := make([]uuid.UUID, len(saved))
+	for i, s := range saved {
+		ids[i] = s.PostID
+	}
+
+	return ids
+}
+
+func TestSavedPostQueryService_FindBookmarks(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	reader := seedMember(t, ctx, "reader@example.com")
+	author := seedMember(t, ctx, "author@example.com")
+	moderator := seedMember(t, ctx, "moderator@example.com")
+
+	at := func(h int) time.Time { return time.Date(2026, 3, 1, h, 0, 0, 0, time.UTC) }
+	kept := seedPost(t, ctx, author.ID(), "kept #topic", at(1))
+	trashed := seedPost(t, ctx, author.ID(), "trashed", at(2))
+	hidden := seedPost(t, ctx, author.ID(), "hidden", at(3))
+	other := seedPost(t, ctx, author.ID(), "bookmarked by someone else", at(4))
+
+	bookmarks := repository.NewBookmarkRepository(testDb.DbManager())
+	for i, p := range []entity.Post{kept, trashed, hidden} {
+		_, err := bookmarks.Add(ctx, entity.NewBookmark(p.ID(), reader.ID(), at(10+i)))
+		require.NoError(t, err)
+	}
+
+	_, err := bookmarks.Add(ctx, entity.NewBookmark(other.ID(), author.ID(), at(13)))
+	require.NoError(t, err)
+
+	trashPost(t, ctx, trashed, author.ID(), at(20))
+	require.NoError(t, hidden.Hide(at(21)))
+	require.NoError(t, repository.NewPostRepository(testDb.DbManager()).Hide(ctx, hidden))
+
+	svc := query.NewSavedPostQueryService(testDb.DbManager())
+
+	// Most recently saved first; posts that are gone or hidden from the reader become tombstones.
+	saved, err := svc.FindBookmarks(ctx, reader.ID(), nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{hidden.ID(), trashed.ID(), kept.ID()}, savedPostIDs(saved))
+	assert.Nil(t, saved[0].Post)
+	assert.Nil(t, saved[1].Post)
+	require.NotNil(t, saved[2].Post)
+	assert.Equal(t, at(10), saved[2].SavedAt)
+	assert.Equal(t, "kept #topic", saved[2].Post.Content)
+	assert.Equal(t, "Test User", saved[2].Post.AuthorName)
+	require.Len(t, saved[2].Post.Entities, 1)
+	assert.NotNil(t, saved[2].Post.Attachments)
+
+	after := &post.SavedPostCursor{CreatedAt: saved[1].SavedAt, ID: saved[1].PostID}
+	page, err := svc.FindBookmarks(ctx, reader.ID(), after, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{kept.ID()}, savedPostIDs(page))
+
+	empty, err := svc.FindBookmarks(ctx, moderator.ID(), nil, 10)
+	require.NoError(t, err)
+	assert.NotNil(t, empty)
+	assert.Empty(t, empty)
+}
+
+func TestSavedPostQueryService_FindCollectionPosts(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	reader := seedMember(t, ctx, "reader@example.com")
+	author := seedMember(t, ctx, "author@example.com")
+
+	at := func(h int) time.Time { return time.Date(2026, 3, 1, h, 0, 0, 0, time.UTC) }
+	first := seedPost(t, ctx, author.ID(), "first", at(1))
+	second := seedPost(t, ctx, author.ID(), "second", at(2))
+	third := seedPost(t, ctx, author.ID(), "third", at(3))
+
+	collection, err := entity.NewCollection(reader.ID(), "Reading list", at(4))
+	require.NoError(t, err)
+
+	collections := repository.NewCollectionRepository(testDb.DbManager())
+	collection, err = collections.Create(ctx, collection)
+	require.NoError(t, err)
+
+	// Added out of creation order; the collection keeps the order in which posts were added.
+	for i, p := range []entity.Post{third, first, second} {
+		_, err = collections.AddPost(ctx, collection, p.ID(), at(5+i))
+		require.NoError(t, err)
+	}
+
+	trashPost(t, ctx, first, author.ID(), at(9))
+
+	svc := query.NewSavedPostQueryService(testDb.DbManager())
+
+	saved, err := svc.FindCollectionPosts(ctx, collection.ID(), reader.ID(), nil, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{third.ID(), first.ID()}, savedPostIDs(saved))
+	assert.Equal(t, "third", saved[0].Post.Content)
+	assert.Nil(t, saved[1].Post)
+
+	after := &post.SavedPostCursor{CreatedAt: saved[1].SavedAt, ID: saved[1].PostID}
+	page, err := svc.FindCollectionPosts(ctx, collection.ID(), reader.ID(), after, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{second.ID()}, savedPostIDs(page))
+	assert.Equal(t, at(7), page[0].SavedAt)
+}
@@ -0,0 +1,95 @@
+package query
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	usecasequery "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type trashQueryServiceImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (s *trashQueryServiceImpl) FindTrash(
+	ctx context.Context, userID uuid.UUID, limit, offset int,
+) ([]usecasequery.TrashedPostDto, int, error) {
+	ctx, span := s.tracer.Start(ctx, "FindTrash")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, 0, err
+	}
+
+	var (
+		rows  []sqlc.FindTrashedPostsRow
+		total int64
+	)
+
+	user := pgtype.UUID{Bytes: userID, Valid: true}
+
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		rows, err = queries.FindTrashedPosts(ctx, sqlc.FindTrashedPostsParams{
+			OrganizationID: tenantID,
+			UserID:         user,
+			PageLimit:      int32(limit),  //nolint:gosec // limit is validated (1-100) by the use case layer
+			PageOffset:     int32(offset), //nolint:gosec // offset is validated (>=0) by the use case layer
+		})
+		if err != nil {
+			return err
+		}
+
+		total, err = queries.CountTrashedPosts(ctx, sqlc.CountTrashedPostsParams{
+			OrganizationID: tenantID,
+			UserID:         user,
+		})
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.logger.Error(ctx, "failed to query trash", "error", err)
+
+		return nil, 0, err
+	}
+
+	items := make([]usecasequery.TrashedPostDto, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, usecasequery.TrashedPostDto{
+			ID:         uuid.UUID(row.ID.Bytes),
+			Content:    row.Content,
+			Visibility: row.Visibility,
+			Status:     row.Status,
+			CreatedAt:  row.CreatedAt.Time,
+			UpdatedAt:  row.UpdatedAt.Time,
+			DeletedAt:  row.DeletedAt.Time,
+			DeletedBy:  fromNullablePgtypeUuid(row.DeletedBy),
+		})
+	}
+
+	return items, int(total), nil
+}
+
+func NewTrashQueryService(dbManager db.DbManager) usecasequery.TrashQueryService {
+	return &trashQueryServiceImpl{
+		tracer:    otel.Tracer("TrashQueryService"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,88 @@
+//go:build integration
+
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/query"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func trashPost(t *testing.T, ctx context.Context, p entity.Post, actorID uuid.UUID, deletedAt time.Time) {
+	t.Helper()
+
+	require.NoError(t, p.Trash(actorID, deletedAt))
+	require.NoError(t, repository.NewPostRepository(testDb.DbManager()).Trash(ctx, p))
+}
+
+func TestTrashQueryService_FindTrash(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherCtx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	moderator := seedMember(t, ctx, "moderator@example.com")
+	joinTenant(t, otherCtx, author.ID())
+
+	at := func(h int) time.Time { return time.Date(2026, 3, 1, h, 0, 0, 0, time.UTC) }
+	selfTrashed := seedPost(t, ctx, author.ID(), "self trashed", at(1))
+	moderated := seedPost(t, ctx, author.ID(), "moderated", at(2))
+	seedPost(t, ctx, author.ID(), "live", at(3))
+	others := seedPost(t, ctx, moderator.ID(), "someone else's", at(4))
+	elsewhere := seedPost(t, otherCtx, author.ID(), "other tenant", at(5))
+
+	trashPost(t, ctx, selfTrashed, author.ID(), at(6))
+	trashPost(t, ctx, moderated, moderator.ID(), at(7))
+	trashPost(t, ctx, others, moderator.ID(), at(8))
+	trashPost(t, otherCtx, elsewhere, author.ID(), at(9))
+
+	svc := query.NewTrashQueryService(testDb.DbManager())
+
+	// The author's own trash, most recently trashed first, whoever trashed the posts.
+	items, total, err := svc.FindTrash(ctx, author.ID(), 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, items, 2)
+	assert.Equal(t, moderated.ID(), items[0].ID)
+	assert.Equal(t, at(7), items[0].DeletedAt)
+	assert.Equal(t, moderator.ID(), *items[0].DeletedBy)
+	assert.Equal(t, selfTrashed.ID(), items[1].ID)
+	assert.Equal(t, "self trashed", items[1].Content)
+	assert.Equal(t, at(1), items[1].CreatedAt)
+
+	page, total, err := svc.FindTrash(ctx, author.ID(), 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, page, 1)
+	assert.Equal(t, selfTrashed.ID(), page[0].ID)
+}
+
+func TestPostQueryService_ExcludesTrashedPosts(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	live := seedPost(t, ctx, author.ID(), "live #topic", time.Date(2026, 3, 1, 1, 0, 0, 0, time.UTC))
+	trashed := seedPost(t, ctx, author.ID(), "trashed #topic", time.Date(2026, 3, 1, 2, 0, 0, 0, time.UTC))
+	trashPost(t, ctx, trashed, author.ID(), time.Date(2026, 3, 1, 3, 0, 0, 0, time.UTC))
+
+	svc := query.NewPostQueryService(testDb.DbManager())
+
+	posts, err := svc.FindAll(ctx, post.PostFilter{}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, live.ID(), posts[0].ID)
+	assertPostCount(t, ctx, svc, 1)
+
+	found, err := svc.FindByID(ctx, trashed.ID(), author.ID())
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
@@ -25,23 +25,32 @@ func (s *userQueryServiceImpl) FindAll(ctx context.Context, limit, offset int) (
 	ctx, span := s.tracer.Start(ctx, "FindAll")
 	defer span.End()
 
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, 0, err
+	}
+
 	var (
 		rows  []sqlc.FindAllUsersRow
 		total int64
 	)
 
-	err := s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+	err = s.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
 		var err error
 
 		rows, err = queries.FindAllUsers(ctx, sqlc.FindAllUsersParams{
-			Limit:  int32(limit),  //nolint:gosec // limit is validated (1-100) by the use case layer
-			Offset: int32(offset), //nolint:gosec // offset is validated (>=0) by the use case layer
+			OrganizationID: tenantID,
+			Limit:          int32(limit),  //nolint:gosec // limit is validated (1-100) by the use case layer
+			Offset:         int32(offset), //nolint:gosec // offset is validated (>=0) by the use case layer
 		})
 		if err != nil {
 			return err
 		}
 
-		total, err = queries.CountUsers(ctx)
+		total, err = queries.CountUsers(ctx, tenantID)
 
 		return err
 	})
@@ -34,14 +34,25 @@ func seedUser(t *testing.T, email string) entity.User {
 	return created
 }
 
+// seedMember seeds a user who belongs to the organization active in ctx.
+func seedMember(t *testing.T, ctx context.Context, email string) entity.User {
+	t.Helper()
+
+	created := seedUser(t, email)
+	joinTenant(t, ctx, created.ID())
+
+	return created
+}
+
 func TestUserQueryService_FindAll_ReturnsUsers(t *testing.T) {
 	defer func() { require.NoError(t, testDb.Cleanup()) }()
 
-	seedUser(t, "alice@example.com")
-	seedUser(t, "bob@example.com")
+	ctx := seedTenant(t)
+	seedMember(t, ctx, "alice@example.com")
+	seedMember(t, ctx, "bob@example.com")
 
 	svc := query.NewUserQueryService(testDb.DbManager())
-	users, total, err := svc.FindAll(context.Background(), 10, 0)
+	users, total, err := svc.FindAll(ctx, 10, 0)
 
 	require.NoError(t, err)
 	assert.Equal(t, 2, total)
@@ -59,8 +70,9 @@ func TestUserQueryService_FindAll_ReturnsUsers(t *testing.T) {
 func TestUserQueryService_FindAll_EmptyTable(t *testing.T) {
 	defer func() { require.NoError(t, testDb.Cleanup()) }()
 
+	ctx := seedTenant(t)
 	svc := query.NewUserQueryService(testDb.DbManager())
-	users, total, err := svc.FindAll(context.Background(), 10, 0)
+	users, total, err := svc.FindAll(ctx, 10, 0)
 
 	require.NoError(t, err)
 	assert.Equal(t, 0, total)
@@ -70,22 +82,23 @@ func TestUserQueryService_FindAll_EmptyTable(t *testing.T) {
 func TestUserQueryService_FindAll_Pagination(t *testing.T) {
 	defer func() { require.NoError(t, testDb.Cleanup()) }()
 
+	ctx := seedTenant(t)
 	for i := range 5 {
-		seedUser(t, "user"+string(rune('a'+i))+"@example.com")
+		seedMember(t, ctx, "user"+string(rune('a'+i))+"@example.com")
 	}
 
 	svc := query.NewUserQueryService(testDb.DbManager())
 
-	page1, total, err := svc.FindAll(context.Background(), 2, 0)
+	page1, total, err := svc.FindAll(ctx, 2, 0)
 	require.NoError(t, err)
 	assert.Equal(t, 5, total)
 	assert.Len(t, page1, 2)
 
-	page2, _, err := svc.FindAll(context.Background(), 2, 2)
+	page2, _, err := svc.FindAll(ctx, 2, 2)
 	require.NoError(t, err)
 	assert.Len(t, page2, 2)
 
-	page3, _, err := svc.FindAll(context.Background(), 2, 4)
+	page3, _, err := svc.FindAll(ctx, 2, 4)
 	require.NoError(t, err)
 	assert.Len(t, page3, 1)
 }
@@ -93,11 +106,12 @@ func TestUserQueryService_FindAll_Pagination(t *testing.T) {
 func TestUserQueryService_FindAll_OrderedByCreatedAtDesc(t *testing.T) {
 	defer func() { require.NoError(t, testDb.Cleanup()) }()
 
-	seedUser(t, "first@example.com")
-	seedUser(t, "second@example.com")
+	ctx := seedTenant(t)
+	seedMember(t, ctx, "first@example.com")
+	seedMember(t, ctx, "second@example.com")
 
 	svc := query.NewUserQueryService(testDb.DbManager())
-	users, _, err := svc.FindAll(context.Background(), 10, 0)
+	users, _, err := svc.FindAll(ctx, 10, 0)
 
 	require.NoError(t, err)
 	require.Len(t, users, 2)
@@ -111,10 +125,11 @@ func TestUserQueryService_FindAll_OrderedByCreatedAtDesc(t *testing.T) {
 func TestUserQueryService_FindAll_BeyondOffsetReturnsEmpty(t *testing.T) {
 	defer func() { require.NoError(t, testDb.Cleanup()) }()
 
-	seedUser(t, "beyond@example.com")
+	ctx := seedTenant(t)
+	seedMember(t, ctx, "beyond@example.com")
 
 	svc := query.NewUserQueryService(testDb.DbManager())
-	users, total, err := svc.FindAll(context.Background(), 10, 100)
+	users, total, err := svc.FindAll(ctx, 10, 100)
 
 	require.NoError(t, err)
 	assert.Equal(t, 1, total)
@@ -124,10 +139,11 @@ func TestUserQueryService_FindAll_BeyondOffsetReturnsEmpty(t *testing.T) {
 func TestUserQueryService_FindAll_MapsFieldsCorrectly(t *testing.T) {
 	defer func() { require.NoError(t, testDb.Cleanup()) }()
 
-	created := seedUser(t, "mapping@example.com")
+	ctx := seedTenant(t)
+	created := seedMember(t, ctx, "mapping@example.com")
 
 	svc := query.NewUserQueryService(testDb.DbManager())
-	users, total, err := svc.FindAll(context.Background(), 10, 0)
+	users, total, err := svc.FindAll(ctx, 10, 0)
 
 	require.NoError(t, err)
 	assert.Equal(t, 1, total)
@@ -141,14 +157,31 @@ func TestUserQueryService_FindAll_MapsFieldsCorrectly(t *testing.T) {
 func TestUserQueryService_FindAll_TotalAndCountAreConsistent(t *testing.T) {
 	defer func() { require.NoError(t, testDb.Cleanup()) }()
 
+	ctx := seedTenant(t)
 	for i := range 3 {
-		seedUser(t, "user"+string(rune('a'+i))+"consistent@example.com")
+		seedMember(t, ctx, "user"+string(rune('a'+i))+"consistent@example.com")
 	}
 
 	svc := query.NewUserQueryService(testDb.DbManager())
-	users, total, err := svc.FindAll(context.Background(), 1, 0)
+	users, total, err := svc.FindAll(ctx, 1, 0)
 
 	require.NoError(t, err)
 	assert.Equal(t, 3, total)
 	assert.Len(t, users, 1)
 }
+
+func TestUserQueryService_FindAll_OnlyMembersOfTenant(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	member := seedMember(t, ctx, "member@example.com")
+	seedUser(t, "outsider@example.com")
+
+	svc := query.NewUserQueryService(testDb.DbManager())
+	users, total, err := svc.FindAll(ctx, 10, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, users, 1)
+	assert.Equal(t, member.ID(), users[0].ID)
+}
@@ -0,0 +1,37 @@
+package query
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// fromNullablePgtypeUuid maps SQL NULL to a nil pointer.
+func fromNullablePgtypeUuid(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+
+	v := uuid.UUID(id.Bytes)
+
+	return &v
+}
+
+// fromNullablePgtypeTimestamp maps SQL NULL to a nil pointer.
+func fromNullablePgtypeTimestamp(t pgtype.Timestamp) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	return &t.Time
+}
+
+// fromNullablePgtypeText maps SQL NULL to a nil pointer.
+func fromNullablePgtypeText(s pgtype.Text) *string {
+	if !s.Valid {
+		return nil
+	}
+
+	return &s.String
+}
@@ -3,12 +3,20 @@
 package query_test
 
 import (
+	"context"
 	"log"
 	"testing"
 
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
 	"github.com/Haya372/web-app-template/go-backend/test/integration"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
 )
 
+// memberRoleID is the seeded viewer role from db/seeds/master/seed.sql, used for test memberships.
+const memberRoleID = "00000000-0000-0000-0000-000000000002"
+
 var testDb integration.TestDb
 
 func TestMain(m *testing.M) {
@@ -29,3 +37,41 @@ func TestMain(m *testing.M) {
 
 	m.Run()
 }
+
+// seedTenant creates an organization and returns a context acting inside it.
+func seedTenant(t *testing.T) context.Context {
+	t.Helper()
+
+	orgID := uuid.New()
+	ctx := common.WithTenantID(context.Background(), orgID.String())
+
+	inTenant(t, ctx, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, "INSERT INTO organizations (id, name) VALUES ($1, $2)", orgID, "Test Organization")
+
+		return err
+	})
+
+	return ctx
+}
+
+// joinTenant makes the user a member of the organization active in ctx.
+func joinTenant(t *testing.T, ctx context.Context, userID uuid.UUID) {
+	t.Helper()
+
+	inTenant(t, ctx, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx,
+			"INSERT INTO organization_memberships (organization_id, user_id, role_id) VALUES ($1, $2, $3)",
+			common.TenantIDFromContext(ctx), userID, memberRoleID,
+		)
+
+		return err
+	})
+}
+
+// inTenant runs raw statements in a transaction scoped to the tenant in ctx, so row-level security
+// applies to them as it does to the query services.
+func inTenant(t *testing.T, ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) {
+	t.Helper()
+
+	require.NoError(t, testDb.DbManager().TxFunc(ctx, fn))
+}
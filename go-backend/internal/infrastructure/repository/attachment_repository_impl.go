@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type attachmentRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *attachmentRepositoryImpl) Create(ctx context.Context, attachment entity.Attachment) error {
+	ctx, span := r.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		tenantID, err := db.TenantID(ctx)
+		if err != nil {
+			return err
+		}
+
+		return queries.CreateAttachment(ctx, sqlc.CreateAttachmentParams{
+			ID:             toPgtypeUuid(attachment.ID()),
+			OrganizationID: tenantID,
+			UploaderID:     toPgtypeUuid(attachment.UploaderID()),
+			FileName:       attachment.FileName(),
+			ContentType:    attachment.ContentType().String(),
+			SizeBytes:      attachment.Size(),
+			Width:          toNullablePgtypeInt4(attachment.Width()),
+			Height:         toNullablePgtypeInt4(attachment.Height()),
+			StorageKey:     attachment.StorageKey(),
+			ThumbnailKey:   toNullablePgtypeText(attachment.ThumbnailKey()),
+			CreatedAt:      toPgtypeTimestamp(attachment.CreatedAt()),
+		})
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *attachmentRepositoryImpl) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.Attachment, error) {
+	ctx, span := r.tracer.Start(ctx, "FindByIDs")
+	defer span.End()
+
+	var rows []sqlc.FindAttachmentsByIDsRow
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		tenantID, err := db.TenantID(ctx)
+		if err != nil {
+			return err
+		}
+
+		rows, err = queries.FindAttachmentsByIDs(ctx, sqlc.FindAttachmentsByIDsParams{
+			OrganizationID: tenantID,
+			Ids:            toPgtypeUuids(ids),
+		})
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	attachments := make([]entity.Attachment, 0, len(rows))
+	for _, row := range rows {
+		attachments = append(attachments, toAttachment(row))
+	}
+
+	return attachments, nil
+}
+
+func (r *attachmentRepositoryImpl) Attach(ctx context.Context, attachment entity.Attachment) error {
+	ctx, span := r.tracer.Start(ctx, "Attach")
+	defer span.End()
+
+	var affected int64
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		tenantID, err := db.TenantID(ctx)
+		if err != nil {
+			return err
+		}
+
+		affected, err = queries.AttachAttachment(ctx, sqlc.AttachAttachmentParams{
+			ID:             toPgtypeUuid(attachment.ID()),
+			OrganizationID: tenantID,
+			PostID:         toNullablePgtypeUuidPtr(attachment.PostID()),
+			Position:       pgtype.Int2{Int16: int16(attachment.Position()), Valid: true}, //nolint:gosec // at most MaxPostAttachments
+		})
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	if affected == 0 {
+		return repository.ErrAttachmentAlreadyAttached
+	}
+
+	return nil
+}
+
+func (r *attachmentRepositoryImpl) FindOrphans(
+	ctx context.Context, uploadedBefore time.Time, limit int,
+) ([]entity.Attachment, error) {
+	ctx, span := r.tracer.Start(ctx, "FindOrphans")
+	defer span.End()
+
+	var rows []sqlc.FindOrphanedAttachmentsRow
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		tenantID, err := db.TenantID(ctx)
+		if err != nil {
+			return err
+		}
+
+		rows, err = queries.FindOrphanedAttachments(ctx, sqlc.FindOrphanedAttachmentsParams{
+			OrganizationID: tenantID,
+			CreatedBefore:  toPgtypeTimestamp(uploadedBefore),
+			PageLimit:      int32(limit), //nolint:gosec // callers pass a small batch size
+		})
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	attachments := make([]entity.Attachment, 0, len(rows))
+	for _, row := range rows {
+		attachments = append(attachments, toAttachment(sqlc.FindAttachmentsByIDsRow(row)))
+	}
+
+	return attachments, nil
+}
+
+func (r *attachmentRepositoryImpl) Delete(ctx context.Context, ids []uuid.UUID) error {
+	ctx, span := r.tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		tenantID, err := db.TenantID(ctx)
+		if err != nil {
+			return err
+		}
+
+		return queries.DeleteAttachments(ctx, sqlc.DeleteAttachmentsParams{
+			OrganizationID: tenantID,
+			Ids:            toPgtypeUuids(ids),
+		})
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+func toAttachment(row sqlc.FindAttachmentsByIDsRow) entity.Attachment {
+	return entity.ReconstructAttachment(
+		row.ID.Bytes,
+		row.UploaderID.Bytes,
+		fromNullablePgtypeUuid(row.PostID),
+		int(row.Position.Int16),
+		row.FileName,
+		vo.AttachmentContentType(row.ContentType),
+		row.SizeBytes,
+		int(row.Width.Int32),
+		int(row.Height.Int32),
+		row.StorageKey,
+		row.ThumbnailKey.String,
+		row.CreatedAt.Time,
+	)
+}
+
+func NewAttachmentRepository(dbManager db.DbManager) repository.AttachmentRepository {
+	return &attachmentRepositoryImpl{
+		tracer:    otel.Tracer("AttachmentRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
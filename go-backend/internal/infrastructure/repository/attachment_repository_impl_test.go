@@ -0,0 +1,127 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	domainrepository "github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seedAttachment(
+	t *testing.T, ctx context.Context, uploaderID uuid.UUID, createdAt time.Time,
+) entity.Attachment {
+	t.Helper()
+
+	attachment, err := entity.NewAttachment(uploaderID, "photo.png", vo.AttachmentContentTypePNG, 1024, createdAt)
+	require.NoError(t, err)
+	require.NoError(t, attachment.AddThumbnail(640, 480))
+	require.NoError(t, repository.NewAttachmentRepository(testDb.DbManager()).Create(ctx, attachment))
+
+	return attachment
+}
+
+func TestAttachmentRepository_CreateFindAttach_HappyCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	user := seedUser(t)
+	post := seedCommentPost(t, ctx, user.ID())
+	target := repository.NewAttachmentRepository(testDb.DbManager())
+	created := seedAttachment(t, ctx, user.ID(), time.Now().UTC())
+
+	found, err := target.FindByIDs(ctx, []uuid.UUID{created.ID(), uuid.New()})
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, created.ID(), found[0].ID())
+	assert.Equal(t, user.ID(), found[0].UploaderID())
+	assert.Nil(t, found[0].PostID())
+	assert.Equal(t, "photo.png", found[0].FileName())
+	assert.Equal(t, vo.AttachmentContentTypePNG, found[0].ContentType())
+	assert.Equal(t, int64(1024), found[0].Size())
+	assert.Equal(t, 640, found[0].Width())
+	assert.Equal(t, 480, found[0].Height())
+	assert.Equal(t, created.StorageKey(), found[0].StorageKey())
+	assert.Equal(t, created.ThumbnailKey(), found[0].ThumbnailKey())
+	assert.WithinDuration(t, created.CreatedAt(), found[0].CreatedAt(), time.Millisecond)
+
+	require.NoError(t, found[0].AttachTo(post.ID(), 2))
+	require.NoError(t, target.Attach(ctx, found[0]))
+
+	found, err = target.FindByIDs(ctx, []uuid.UUID{created.ID()})
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	require.NotNil(t, found[0].PostID())
+	assert.Equal(t, post.ID(), *found[0].PostID())
+	assert.Equal(t, 2, found[0].Position())
+
+	// The upload the first Attach was based on is stale: it cannot be attached a second time.
+	require.NoError(t, created.AttachTo(seedCommentPost(t, ctx, user.ID()).ID(), 0))
+	require.ErrorIs(t, target.Attach(ctx, created), domainrepository.ErrAttachmentAlreadyAttached)
+}
+
+func TestAttachmentRepository_FindOrphansDelete(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	user := seedUser(t)
+	post := seedCommentPost(t, ctx, user.ID())
+	target := repository.NewAttachmentRepository(testDb.DbManager())
+	now := time.Now().UTC()
+
+	oldest := seedAttachment(t, ctx, user.ID(), now.Add(-72*time.Hour))
+	old := seedAttachment(t, ctx, user.ID(), now.Add(-48*time.Hour))
+	recent := seedAttachment(t, ctx, user.ID(), now)
+	attached := seedAttachment(t, ctx, user.ID(), now.Add(-96*time.Hour))
+	require.NoError(t, attached.AttachTo(post.ID(), 0))
+	require.NoError(t, target.Attach(ctx, attached))
+
+	orphans, err := target.FindOrphans(ctx, now.Add(-24*time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, orphans, 2)
+	assert.Equal(t, oldest.ID(), orphans[0].ID())
+	assert.Equal(t, old.ID(), orphans[1].ID())
+
+	orphans, err = target.FindOrphans(ctx, now.Add(-24*time.Hour), 1)
+	require.NoError(t, err)
+	require.Len(t, orphans, 1)
+
+	require.NoError(t, target.Delete(ctx, []uuid.UUID{oldest.ID(), old.ID()}))
+
+	found, err := target.FindByIDs(ctx, []uuid.UUID{oldest.ID(), old.ID(), recent.ID(), attached.ID()})
+	require.NoError(t, err)
+	assert.Len(t, found, 2)
+}
+
+func TestAttachmentRepository_OtherTenant(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherTenant := seedTenant(t)
+	user := seedUser(t)
+	target := repository.NewAttachmentRepository(testDb.DbManager())
+	created := seedAttachment(t, ctx, user.ID(), time.Now().Add(-48*time.Hour))
+
+	found, err := target.FindByIDs(otherTenant, []uuid.UUID{created.ID()})
+	require.NoError(t, err)
+	assert.Empty(t, found)
+
+	orphans, err := target.FindOrphans(otherTenant, time.Now(), 10)
+	require.NoError(t, err)
+	assert.Empty(t, orphans)
+
+	// Deleting from another tenant leaves the attachment alone.
+	require.NoError(t, target.Delete(otherTenant, []uuid.UUID{created.ID()}))
+
+	found, err = target.FindByIDs(ctx, []uuid.UUID{created.ID()})
+	require.NoError(t, err)
+	assert.Len(t, found, 1)
+}
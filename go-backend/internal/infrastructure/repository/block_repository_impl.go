@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type blockRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *blockRepositoryImpl) Add(ctx context.Context, block entity.Block) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "Add")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		_, qErr := queries.FindOrganizationMembership(ctx, sqlc.FindOrganizationMembershipParams{
+			OrganizationID: tenantID,
+			UserID:         toPgtypeUuid(block.BlockedID()),
+		})
+		if errors.Is(qErr, pgx.ErrNoRows) {
+			return repository.ErrBlockedUserNotFound
+		}
+
+		if qErr != nil {
+			return qErr
+		}
+
+		affected, qErr = queries.CreateBlock(ctx, sqlc.CreateBlockParams{
+			OrganizationID: tenantID,
+			BlockerID:      toPgtypeUuid(block.BlockerID()),
+			BlockedID:      toPgtypeUuid(block.BlockedID()),
+			CreatedAt:      toPgtypeTimestamp(block.CreatedAt()),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		if !errors.Is(err, repository.ErrBlockedUserNotFound) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (r *blockRepositoryImpl) Remove(ctx context.Context, blockerID, blockedID uuid.UUID) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "Remove")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.DeleteBlock(ctx, sqlc.DeleteBlockParams{
+			OrganizationID: tenantID,
+			BlockerID:      toPgtypeUuid(blockerID),
+			BlockedID:      toPgtypeUuid(blockedID),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func NewBlockRepository(dbManager db.DbManager) repository.BlockRepository {
+	return &blockRepositoryImpl{
+		tracer:    otel.Tracer("BlockRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
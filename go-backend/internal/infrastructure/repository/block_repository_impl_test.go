@@ -0,0 +1,70 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	domainrepository "github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seedBlock(t *testing.T, ctx context.Context, blockerID, blockedID uuid.UUID) {
+	t.Helper()
+
+	block, err := entity.NewBlock(blockerID, blockedID, time.Now())
+	require.NoError(t, err)
+	_, err = repository.NewBlockRepository(testDb.DbManager()).Add(ctx, block)
+	require.NoError(t, err)
+}
+
+func TestBlockRepository_AddRemove_Idempotent(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	blocker := seedMember(t, ctx, "blocker@example.com")
+	blocked := seedMember(t, ctx, "blocked@example.com")
+	target := repository.NewBlockRepository(testDb.DbManager())
+
+	block, err := entity.NewBlock(blocker.ID(), blocked.ID(), time.Now())
+	require.NoError(t, err)
+
+	added, err := target.Add(ctx, block)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	added, err = target.Add(ctx, block)
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	removed, err := target.Remove(ctx, blocker.ID(), blocked.ID())
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	removed, err = target.Remove(ctx, blocker.ID(), blocked.ID())
+	require.NoError(t, err)
+	assert.False(t, removed)
+}
+
+func TestBlockRepository_Add_BlockedUserOutsideTenant(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherTenant := seedTenant(t)
+	blocker := seedMember(t, ctx, "blocker@example.com")
+	outsider := seedMember(t, otherTenant, "outsider@example.com")
+	target := repository.NewBlockRepository(testDb.DbManager())
+
+	block, err := entity.NewBlock(blocker.ID(), outsider.ID(), time.Now())
+	require.NoError(t, err)
+
+	added, err := target.Add(ctx, block)
+	require.ErrorIs(t, err, domainrepository.ErrBlockedUserNotFound)
+	assert.False(t, added)
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type bookmarkRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *bookmarkRepositoryImpl) Add(ctx context.Context, bookmark entity.Bookmark) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "Add")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		qErr := requireVisiblePost(ctx, queries, tenantID, bookmark.PostID(), bookmark.UserID())
+		if qErr != nil {
+			return qErr
+		}
+
+		affected, qErr = queries.CreateBookmark(ctx, sqlc.CreateBookmarkParams{
+			OrganizationID: tenantID,
+			UserID:         toPgtypeUuid(bookmark.UserID()),
+			PostID:         toPgtypeUuid(bookmark.PostID()),
+			CreatedAt:      toPgtypeTimestamp(bookmark.CreatedAt()),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		if !errors.Is(err, repository.ErrPostNotFound) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (r *bookmarkRepositoryImpl) Remove(ctx context.Context, userID, postID uuid.UUID) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "Remove")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.DeleteBookmark(ctx, sqlc.DeleteBookmarkParams{
+			OrganizationID: tenantID,
+			UserID:         toPgtypeUuid(userID),
+			PostID:         toPgtypeUuid(postID),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// requireVisiblePost returns ErrPostNotFound unless viewerID may see the post in the tenant, so that
+// nobody can save a post they cannot read. Posts of users blocked either way cannot be read; see
+// visible_posts.
+func requireVisiblePost(
+	ctx context.Context, queries sqlc.Queries, tenantID pgtype.UUID, postID, viewerID uuid.UUID,
+) error {
+	visible, err := queries.IsPostVisible(ctx, sqlc.IsPostVisibleParams{
+		ID:             toPgtypeUuid(postID),
+		OrganizationID: tenantID,
+		ViewerID:       toPgtypeUuid(viewerID),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !visible {
+		return repository.ErrPostNotFound
+	}
+
+	return nil
+}
+
+func NewBookmarkRepository(dbManager db.DbManager) repository.BookmarkRepository {
+	return &bookmarkRepositoryImpl{
+		tracer:    otel.Tracer("BookmarkRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
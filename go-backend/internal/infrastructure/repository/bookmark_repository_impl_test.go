@@ -0,0 +1,76 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	domainrepository "github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookmarkRepository_AddRemove_Idempotent(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	user := seedUser(t)
+	post := seedCommentPost(t, ctx, user.ID())
+	target := repository.NewBookmarkRepository(testDb.DbManager())
+
+	added, err := target.Add(ctx, entity.NewBookmark(post.ID(), user.ID(), time.Now()))
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	added, err = target.Add(ctx, entity.NewBookmark(post.ID(), user.ID(), time.Now()))
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	removed, err := target.Remove(ctx, user.ID(), post.ID())
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	removed, err = target.Remove(ctx, user.ID(), post.ID())
+	require.NoError(t, err)
+	assert.False(t, removed)
+}
+
+func TestBookmarkRepository_Add_PostNotVisible(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	reader := seedMember(t, ctx, "reader@example.com")
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+
+	private, err := repository.NewPostRepository(testDb.DbManager()).Create(ctx, entity.ReconstructPost(
+		uuid.New(), author.ID(), "private", vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityPrivate,
+		vo.PostStatusPublished, nil, nil, nil, nil, createdAt, createdAt,
+	))
+	require.NoError(t, err)
+
+	target := repository.NewBookmarkRepository(testDb.DbManager())
+
+	_, err = target.Add(ctx, entity.NewBookmark(private.ID(), reader.ID(), time.Now()))
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+
+	_, err = target.Add(ctx, entity.NewBookmark(uuid.New(), reader.ID(), time.Now()))
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+
+	// The author may save their own private post.
+	added, err := target.Add(ctx, entity.NewBookmark(private.ID(), author.ID(), time.Now()))
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	// Users who block one another cannot see, so cannot save, each other's posts.
+	public := seedCommentPost(t, ctx, author.ID())
+	seedBlock(t, ctx, author.ID(), reader.ID())
+
+	_, err = target.Add(ctx, entity.NewBookmark(public.ID(), reader.ID(), time.Now()))
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+}
@@ -0,0 +1,282 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type collectionRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *collectionRepositoryImpl) Create(
+	ctx context.Context, collection entity.Collection,
+) (entity.Collection, error) {
+	ctx, span := r.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		return queries.CreateCollection(ctx, sqlc.CreateCollectionParams{
+			ID:             toPgtypeUuid(collection.ID()),
+			OrganizationID: tenantID,
+			UserID:         toPgtypeUuid(collection.UserID()),
+			Name:           collection.Name(),
+			CreatedAt:      toPgtypeTimestamp(collection.CreatedAt()),
+			UpdatedAt:      toPgtypeTimestamp(collection.UpdatedAt()),
+		})
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+func (r *collectionRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (entity.Collection, error) {
+	ctx, span := r.tracer.Start(ctx, "FindByID")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var row sqlc.FindCollectionByIDRow
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		row, qErr = queries.FindCollectionByID(ctx, sqlc.FindCollectionByIDParams{
+			ID:             toPgtypeUuid(id),
+			OrganizationID: tenantID,
+		})
+
+		return qErr
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrCollectionNotFound
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return entity.ReconstructCollection(
+		row.ID.Bytes, row.UserID.Bytes, row.Name, row.CreatedAt.Time, row.UpdatedAt.Time,
+	), nil
+}
+
+func (r *collectionRepositoryImpl) CountByUserID(ctx context.Context, userID uuid.UUID) (int, error) {
+	ctx, span := r.tracer.Start(ctx, "CountByUserID")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return 0, err
+	}
+
+	var count int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		count, qErr = queries.CountCollectionsByUserID(ctx, sqlc.CountCollectionsByUserIDParams{
+			OrganizationID: tenantID,
+			UserID:         toPgtypeUuid(userID),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+func (r *collectionRepositoryImpl) Update(ctx context.Context, collection entity.Collection) error {
+	ctx, span := r.tracer.Start(ctx, "Update")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		affected, qErr := queries.UpdateCollection(ctx, sqlc.UpdateCollectionParams{
+			ID:             toPgtypeUuid(collection.ID()),
+			OrganizationID: tenantID,
+			Name:           collection.Name(),
+			UpdatedAt:      toPgtypeTimestamp(collection.UpdatedAt()),
+		})
+		if qErr == nil && affected == 0 {
+			return repository.ErrCollectionNotFound
+		}
+
+		return qErr
+	})
+	if err != nil && !errors.Is(err, repository.ErrCollectionNotFound) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (r *collectionRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	ctx, span := r.tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		affected, qErr := queries.DeleteCollection(ctx, sqlc.DeleteCollectionParams{
+			ID:             toPgtypeUuid(id),
+			OrganizationID: tenantID,
+		})
+		if qErr == nil && affected == 0 {
+			return repository.ErrCollectionNotFound
+		}
+
+		return qErr
+	})
+	if err != nil && !errors.Is(err, repository.ErrCollectionNotFound) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (r *collectionRepositoryImpl) AddPost(
+	ctx context.Context, collection entity.Collection, postID uuid.UUID, addedAt time.Time,
+) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "AddPost")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		qErr := requireVisiblePost(ctx, queries, tenantID, postID, collection.UserID())
+		if qErr != nil {
+			return qErr
+		}
+
+		affected, qErr = queries.CreateCollectionPost(ctx, sqlc.CreateCollectionPostParams{
+			OrganizationID: tenantID,
+			CollectionID:   toPgtypeUuid(collection.ID()),
+			PostID:         toPgtypeUuid(postID),
+			AddedAt:        toPgtypeTimestamp(addedAt),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		if !errors.Is(err, repository.ErrPostNotFound) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (r *collectionRepositoryImpl) RemovePost(ctx context.Context, collectionID, postID uuid.UUID) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "RemovePost")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.DeleteCollectionPost(ctx, sqlc.DeleteCollectionPostParams{
+			OrganizationID: tenantID,
+			CollectionID:   toPgtypeUuid(collectionID),
+			PostID:         toPgtypeUuid(postID),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func NewCollectionRepository(dbManager db.DbManager) repository.CollectionRepository {
+	return &collectionRepositoryImpl{
+		tracer:    otel.Tracer("CollectionRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,85 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	domainrepository "github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectionRepository_Lifecycle(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	user := seedUser(t)
+	target := repository.NewCollectionRepository(testDb.DbManager())
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+
+	collection, err := entity.NewCollection(user.ID(), "Recipes", createdAt)
+	require.NoError(t, err)
+
+	_, err = target.Create(ctx, collection)
+	require.NoError(t, err)
+
+	count, err := target.CountByUserID(ctx, user.ID())
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	require.NoError(t, collection.Rename("Dinner", createdAt.Add(time.Hour)))
+	require.NoError(t, target.Update(ctx, collection))
+
+	found, err := target.FindByID(ctx, collection.ID())
+	require.NoError(t, err)
+	assert.Equal(t, user.ID(), found.UserID())
+	assert.Equal(t, "Dinner", found.Name())
+	assert.Equal(t, createdAt, found.CreatedAt())
+	assert.Equal(t, createdAt.Add(time.Hour), found.UpdatedAt())
+
+	require.NoError(t, target.Delete(ctx, collection.ID()))
+
+	_, err = target.FindByID(ctx, collection.ID())
+	require.ErrorIs(t, err, domainrepository.ErrCollectionNotFound)
+	require.ErrorIs(t, target.Update(ctx, collection), domainrepository.ErrCollectionNotFound)
+	require.ErrorIs(t, target.Delete(ctx, collection.ID()), domainrepository.ErrCollectionNotFound)
+}
+
+func TestCollectionRepository_AddRemovePost(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	user := seedUser(t)
+	post := seedCommentPost(t, ctx, user.ID())
+	target := repository.NewCollectionRepository(testDb.DbManager())
+
+	collection, err := entity.NewCollection(user.ID(), "Recipes", time.Now())
+	require.NoError(t, err)
+
+	_, err = target.Create(ctx, collection)
+	require.NoError(t, err)
+
+	added, err := target.AddPost(ctx, collection, post.ID(), time.Now())
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	added, err = target.AddPost(ctx, collection, post.ID(), time.Now())
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	_, err = target.AddPost(ctx, collection, uuid.New(), time.Now())
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+
+	removed, err := target.RemovePost(ctx, collection.ID(), post.ID())
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	removed, err = target.RemovePost(ctx, collection.ID(), post.ID())
+	require.NoError(t, err)
+	assert.False(t, removed)
+}
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	commentsPostIDFkey         = "comments_post_id_fkey"
+	commentsPostIDParentIDFkey = "comments_post_id_parent_id_fkey"
+)
+
+type commentRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *commentRepositoryImpl) Create(ctx context.Context, comment entity.Comment) (entity.Comment, error) {
+	ctx, span := r.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var row sqlc.CreateCommentRow
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		row, qErr = queries.CreateComment(ctx, sqlc.CreateCommentParams{
+			ID:             toPgtypeUuid(comment.ID()),
+			OrganizationID: tenantID,
+			PostID:         toPgtypeUuid(comment.PostID()),
+			ParentID:       toNullablePgtypeUuidPtr(comment.ParentID()),
+			UserID:         toPgtypeUuid(comment.UserID()),
+			Content:        comment.Content(),
+			Depth:          int32(comment.Depth()), //nolint:gosec // depth is bounded by the Comment entity
+			CreatedAt:      toPgtypeTimestamp(comment.CreatedAt()),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		if mapped := mapCreateCommentError(err); mapped != nil {
+			return nil, mapped
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return reconstructComment(sqlc.FindCommentByIDRow(row)), nil
+}
+
+// mapCreateCommentError translates the ways a comment can lose its post or parent to domain errors;
+// it returns nil for any other error.
+func mapCreateCommentError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return repository.ErrPostNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+		switch pgErr.ConstraintName {
+		// The post was deleted after the insert read it.
+		case commentsPostIDFkey:
+			return repository.ErrPostNotFound
+		case commentsPostIDParentIDFkey:
+			return repository.ErrCommentNotFound
+		}
+	}
+
+	return nil
+}
+
+func (r *commentRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (entity.Comment, error) {
+	ctx, span := r.tracer.Start(ctx, "FindByID")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var row sqlc.FindCommentByIDRow
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		row, qErr = queries.FindCommentByID(ctx, sqlc.FindCommentByIDParams{
+			ID:             toPgtypeUuid(id),
+			OrganizationID: tenantID,
+		})
+
+		return qErr
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrCommentNotFound
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return reconstructComment(row), nil
+}
+
+func (r *commentRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	ctx, span := r.tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		affected, qErr := queries.DeleteComment(ctx, sqlc.DeleteCommentParams{
+			ID:             toPgtypeUuid(id),
+			OrganizationID: tenantID,
+		})
+		if qErr == nil && affected == 0 {
+			return repository.ErrCommentNotFound
+		}
+
+		return qErr
+	})
+	if err != nil && !errors.Is(err, repository.ErrCommentNotFound) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func reconstructComment(row sqlc.FindCommentByIDRow) entity.Comment {
+	return entity.ReconstructComment(
+		row.ID.Bytes,
+		row.PostID.Bytes,
+		fromNullablePgtypeUuid(row.ParentID),
+		row.UserID.Bytes,
+		row.Content,
+		int(row.Depth),
+		row.CreatedAt.Time,
+	)
+}
+
+func NewCommentRepository(dbManager db.DbManager) repository.CommentRepository {
+	return &commentRepositoryImpl{
+		tracer:    otel.Tracer("CommentRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,220 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	domainrepository "github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seedCommentPost(t *testing.T, ctx context.Context, userID uuid.UUID) entity.Post {
+	t.Helper()
+
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	post, err := repository.NewPostRepository(testDb.DbManager()).
+		Create(ctx, entity.ReconstructPost(
+			uuid.New(),
+			userID,
+			"post",
+			vo.ContentFormatPlain,
+			vo.PostKindPost,
+			nil,
+			vo.PostVisibilityPublic,
+			vo.PostStatusPublished,
+			nil,
+			nil,
+			nil,
+			nil,
+			createdAt,
+			createdAt,
+		))
+	require.NoError(t, err)
+
+	return post
+}
+
+func TestCommentRepository_CreateFindDelete_HappyCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	user := seedUser(t)
+	post := seedCommentPost(t, ctx, user.ID())
+	createdAt := time.Date(2026, 3, 8, 13, 0, 0, 0, time.UTC)
+	target := repository.NewCommentRepository(testDb.DbManager())
+
+	root, err := entity.NewComment(post.ID(), user.ID(), nil, "root", createdAt)
+	require.NoError(t, err)
+	root, err = target.Create(ctx, root)
+	require.NoError(t, err)
+
+	reply, err := entity.NewComment(post.ID(), user.ID(), root, "reply", createdAt.Add(time.Minute))
+	require.NoError(t, err)
+	reply, err = target.Create(ctx, reply)
+	require.NoError(t, err)
+
+	nested, err := entity.NewComment(post.ID(), user.ID(), reply, "nested", createdAt.Add(2*time.Minute))
+	require.NoError(t, err)
+	_, err = target.Create(ctx, nested)
+	require.NoError(t, err)
+
+	found, err := target.FindByID(ctx, nested.ID())
+	require.NoError(t, err)
+	assert.Equal(t, post.ID(), found.PostID())
+	assert.Equal(t, reply.ID(), *found.ParentID())
+	assert.Equal(t, user.ID(), found.UserID())
+	assert.Equal(t, "nested", found.Content())
+	assert.Equal(t, 2, found.Depth())
+	assert.True(t, nested.CreatedAt().Equal(found.CreatedAt()))
+
+	found, err = target.FindByID(ctx, root.ID())
+	require.NoError(t, err)
+	assert.Nil(t, found.ParentID())
+
+	// Deleting a comment deletes the whole thread below it.
+	require.NoError(t, target.Delete(ctx, reply.ID()))
+
+	for _, id := range []uuid.UUID{reply.ID(), nested.ID()} {
+		_, err = target.FindByID(ctx, id)
+		require.ErrorIs(t, err, domainrepository.ErrCommentNotFound)
+	}
+
+	_, err = target.FindByID(ctx, root.ID())
+	require.NoError(t, err)
+	require.ErrorIs(t, target.Delete(ctx, reply.ID()), domainrepository.ErrCommentNotFound)
+}
+
+func TestCommentRepository_Create_MissingPostOrParent(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	user := seedUser(t)
+	post := seedCommentPost(t, ctx, user.ID())
+	otherPost := seedCommentPost(t, ctx, user.ID())
+	now := time.Date(2026, 3, 8, 13, 0, 0, 0, time.UTC)
+	target := repository.NewCommentRepository(testDb.DbManager())
+
+	otherRoot, err := target.Create(ctx,
+		entity.ReconstructComment(uuid.New(), otherPost.ID(), nil, user.ID(), "other", 0, now))
+	require.NoError(t, err)
+
+	missingID := uuid.New()
+	otherRootID := otherRoot.ID()
+
+	tests := []struct {
+		name     string
+		postID   uuid.UUID
+		parentID *uuid.UUID
+		wantErr  error
+	}{
+		{name: "post does not exist", postID: uuid.New(), wantErr: domainrepository.ErrPostNotFound},
+		{
+			name:     "parent does not exist",
+			postID:   post.ID(),
+			parentID: &missingID,
+			wantErr:  domainrepository.ErrCommentNotFound,
+		},
+		{
+			name:     "parent is on another post",
+			postID:   post.ID(),
+			parentID: &otherRootID,
+			wantErr:  domainrepository.ErrCommentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comment := entity.ReconstructComment(uuid.New(), tt.postID, tt.parentID, user.ID(), "comment", 1, now)
+
+			_, err := target.Create(ctx, comment)
+
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommentRepository_DeletedWithPost(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	user := seedUser(t)
+	post := seedCommentPost(t, ctx, user.ID())
+	target := repository.NewCommentRepository(testDb.DbManager())
+
+	comment, err := entity.NewComment(post.ID(), user.ID(), nil, "comment", time.Now())
+	require.NoError(t, err)
+	_, err = target.Create(ctx, comment)
+	require.NoError(t, err)
+
+	purgePost(t, ctx, post)
+
+	_, err = target.FindByID(ctx, comment.ID())
+	require.ErrorIs(t, err, domainrepository.ErrCommentNotFound)
+}
+
+func TestCommentRepository_OtherTenant_NotFound(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherTenant := seedTenant(t)
+	user := seedUser(t)
+	post := seedCommentPost(t, ctx, user.ID())
+	target := repository.NewCommentRepository(testDb.DbManager())
+
+	comment, err := entity.NewComment(post.ID(), user.ID(), nil, "mine", time.Now())
+	require.NoError(t, err)
+	_, err = target.Create(ctx, comment)
+	require.NoError(t, err)
+
+	_, err = target.FindByID(otherTenant, comment.ID())
+	require.ErrorIs(t, err, domainrepository.ErrCommentNotFound)
+	require.ErrorIs(t, target.Delete(otherTenant, comment.ID()), domainrepository.ErrCommentNotFound)
+
+	// A comment cannot be attached to another tenant's post either.
+	_, err = target.Create(otherTenant,
+		entity.ReconstructComment(uuid.New(), post.ID(), nil, user.ID(), "x", 0, time.Now()))
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+
+	_, err = target.FindByID(ctx, comment.ID())
+	require.NoError(t, err)
+}
+
+func TestCommentRepository_Create_Blocked(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	blocker := seedMember(t, ctx, "blocker@example.com")
+	commenter := seedMember(t, ctx, "commenter@example.com")
+	replier := seedMember(t, ctx, "replier@example.com")
+	post := seedCommentPost(t, ctx, author.ID())
+	target := repository.NewCommentRepository(testDb.DbManager())
+	now := time.Now()
+
+	parent, err := target.Create(ctx, entity.ReconstructComment(uuid.New(), post.ID(), nil, blocker.ID(), "p", 0, now))
+	require.NoError(t, err)
+
+	// Blocks apply either way: the commenter blocks the post's author, and the parent's author blocks the
+	// replier.
+	seedBlock(t, ctx, commenter.ID(), author.ID())
+	seedBlock(t, ctx, blocker.ID(), replier.ID())
+
+	_, err = target.Create(ctx, entity.ReconstructComment(uuid.New(), post.ID(), nil, commenter.ID(), "x", 0, now))
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+
+	_, err = target.Create(ctx, entity.ReconstructComment(uuid.New(), post.ID(), nil, replier.ID(), "x", 0, now))
+	require.NoError(t, err)
+
+	parentID := parent.ID()
+	_, err = target.Create(ctx,
+		entity.ReconstructComment(uuid.New(), post.ID(), &parentID, replier.ID(), "reply", 1, now))
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// stagedCopy bulk-inserts rows through a temporary staging table. PostgreSQL refuses COPY FROM into
+// tables under row-level security, so the rows are copied into the staging table first and then moved
+// by insertSQL, whose INSERT ... SELECT is checked against the policies like any other insert.
+type stagedCopy struct {
+	// table is created by createSQL, once per transaction; it is dropped when the transaction ends.
+	table     string
+	createSQL string
+	columns   []string
+	insertSQL string
+}
+
+func (c stagedCopy) run(ctx context.Context, tx pgx.Tx, rows [][]any, insertArgs ...any) error {
+	if _, err := tx.Exec(ctx, c.createSQL); err != nil {
+		return err
+	}
+
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{c.table}, c.columns, pgx.CopyFromRows(rows)); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, c.insertSQL, insertArgs...); err != nil {
+		return err
+	}
+
+	// Empty the staging table for the next batch of the same transaction.
+	_, err := tx.Exec(ctx, fmt.Sprintf("truncate %s", pgx.Identifier{c.table}.Sanitize()))
+
+	return err
+}
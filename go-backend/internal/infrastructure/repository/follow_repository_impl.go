@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type followRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *followRepositoryImpl) Add(ctx context.Context, follow entity.Follow) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "Add")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		_, qErr := queries.FindOrganizationMembership(ctx, sqlc.FindOrganizationMembershipParams{
+			OrganizationID: tenantID,
+			UserID:         toPgtypeUuid(follow.FolloweeID()),
+		})
+		if errors.Is(qErr, pgx.ErrNoRows) {
+			return repository.ErrFolloweeNotFound
+		}
+
+		if qErr != nil {
+			return qErr
+		}
+
+		affected, qErr = queries.CreateFollow(ctx, sqlc.CreateFollowParams{
+			OrganizationID: tenantID,
+			FollowerID:     toPgtypeUuid(follow.FollowerID()),
+			FolloweeID:     toPgtypeUuid(follow.FolloweeID()),
+			CreatedAt:      toPgtypeTimestamp(follow.CreatedAt()),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		if !errors.Is(err, repository.ErrFolloweeNotFound) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (r *followRepositoryImpl) Remove(ctx context.Context, followerID, followeeID uuid.UUID) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "Remove")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.DeleteFollow(ctx, sqlc.DeleteFollowParams{
+			OrganizationID: tenantID,
+			FollowerID:     toPgtypeUuid(followerID),
+			FolloweeID:     toPgtypeUuid(followeeID),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func NewFollowRepository(dbManager db.DbManager) repository.FollowRepository {
+	return &followRepositoryImpl{
+		tracer:    otel.Tracer("FollowRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,112 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	domainrepository "github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seedMember seeds a user who belongs to the organization active in ctx.
+func seedMember(t *testing.T, ctx context.Context, email string) entity.User {
+	t.Helper()
+
+	user, err := repository.NewUserRepository(testDb.DbManager()).Create(ctx, entity.ReconstructUser(
+		uuid.New(), email, []byte("password"), "Member", vo.UserStatusActive, time.Now(),
+	))
+	require.NoError(t, err)
+
+	inTenant(t, ctx, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx,
+			"INSERT INTO organization_memberships (organization_id, user_id, role_id) VALUES ($1, $2, $3)",
+			common.TenantIDFromContext(ctx), user.ID(), viewerRoleID,
+		)
+
+		return err
+	})
+
+	return user
+}
+
+func seedFollow(t *testing.T, ctx context.Context, followerID, followeeID uuid.UUID) {
+	t.Helper()
+
+	follow, err := entity.NewFollow(followerID, followeeID, time.Now())
+	require.NoError(t, err)
+	_, err = repository.NewFollowRepository(testDb.DbManager()).Add(ctx, follow)
+	require.NoError(t, err)
+}
+
+func TestFollowRepository_AddRemove_Idempotent(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	follower := seedMember(t, ctx, "follower@example.com")
+	followee := seedMember(t, ctx, "followee@example.com")
+	target := repository.NewFollowRepository(testDb.DbManager())
+
+	follow, err := entity.NewFollow(follower.ID(), followee.ID(), time.Now())
+	require.NoError(t, err)
+
+	added, err := target.Add(ctx, follow)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	added, err = target.Add(ctx, follow)
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	removed, err := target.Remove(ctx, follower.ID(), followee.ID())
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	removed, err = target.Remove(ctx, follower.ID(), followee.ID())
+	require.NoError(t, err)
+	assert.False(t, removed)
+}
+
+func TestFollowRepository_Add_FolloweeOutsideTenant(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherTenant := seedTenant(t)
+	follower := seedMember(t, ctx, "follower@example.com")
+	outsider := seedMember(t, otherTenant, "outsider@example.com")
+	target := repository.NewFollowRepository(testDb.DbManager())
+
+	follow, err := entity.NewFollow(follower.ID(), outsider.ID(), time.Now())
+	require.NoError(t, err)
+
+	added, err := target.Add(ctx, follow)
+	require.ErrorIs(t, err, domainrepository.ErrFolloweeNotFound)
+	assert.False(t, added)
+}
+
+func TestFollowRepository_Add_Blocked(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	follower := seedMember(t, ctx, "follower@example.com")
+	followee := seedMember(t, ctx, "followee@example.com")
+	seedBlock(t, ctx, followee.ID(), follower.ID())
+	target := repository.NewFollowRepository(testDb.DbManager())
+
+	follow, err := entity.NewFollow(follower.ID(), followee.ID(), time.Now())
+	require.NoError(t, err)
+
+	// A block made by the followee keeps the follower from following them.
+	added, err := target.Add(ctx, follow)
+	require.NoError(t, err)
+	assert.False(t, added)
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type impersonationAuditLogRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *impersonationAuditLogRepositoryImpl) Create(ctx context.Context, log entity.ImpersonationAuditLog) error {
+	ctx, span := r.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		return queries.CreateImpersonationAuditLog(ctx, sqlc.CreateImpersonationAuditLogParams{
+			ID:        toPgtypeUuid(log.ID()),
+			ActorID:   toPgtypeUuid(log.ActorID()),
+			SubjectID: toPgtypeUuid(log.SubjectID()),
+			Action:    log.Action().String(),
+			Method:    log.Method(),
+			Path:      log.Path(),
+			CreatedAt: toPgtypeTimestamp(log.CreatedAt()),
+		})
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+func NewImpersonationAuditLogRepository(dbManager db.DbManager) repository.ImpersonationAuditLogRepository {
+	return &impersonationAuditLogRepositoryImpl{
+		tracer:    otel.Tracer("ImpersonationAuditLogRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
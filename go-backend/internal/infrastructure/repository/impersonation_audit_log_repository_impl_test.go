@@ -0,0 +1,44 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpersonationAuditLogRepository_Create(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	subject := seedUser(t)
+	actor := seedActor(t)
+
+	log, err := entity.NewImpersonationAuditLog(
+		actor.ID(), subject.ID(), vo.ImpersonationAuditActionRequest, "GET", "/v1/posts",
+		time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC),
+	)
+	require.NoError(t, err)
+
+	r := repository.NewImpersonationAuditLogRepository(testDb.DbManager())
+	require.NoError(t, r.Create(context.Background(), log))
+
+	var (
+		action, method, path string
+	)
+
+	err = testDb.Pool().QueryRow(context.Background(),
+		"SELECT action, method, path FROM impersonation_audit_logs WHERE actor_id = $1 AND subject_id = $2",
+		actor.ID().String(), subject.ID().String(),
+	).Scan(&action, &method, &path)
+	require.NoError(t, err)
+	assert.Equal(t, "REQUEST", action)
+	assert.Equal(t, "GET", method)
+	assert.Equal(t, "/v1/posts", path)
+}
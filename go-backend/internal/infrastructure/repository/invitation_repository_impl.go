@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const invitationRolesRoleIDFkey = "invitation_roles_role_id_fkey"
+
+type invitationRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *invitationRepositoryImpl) Create(ctx context.Context, invitation entity.Invitation) error {
+	ctx, span := r.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		if err := queries.CreateInvitation(ctx, sqlc.CreateInvitationParams{
+			ID:        toPgtypeUuid(invitation.ID()),
+			Email:     invitation.Email(),
+			TokenHash: invitation.TokenHash(),
+			InvitedBy: toNullablePgtypeUuid(invitation.InvitedBy()),
+			ExpiresAt: toPgtypeTimestamp(invitation.ExpiresAt()),
+			CreatedAt: toPgtypeTimestamp(invitation.CreatedAt()),
+		}); err != nil {
+			return err
+		}
+
+		for _, roleID := range invitation.RoleIDs() {
+			if err := queries.CreateInvitationRole(ctx, sqlc.CreateInvitationRoleParams{
+				InvitationID: toPgtypeUuid(invitation.ID()),
+				RoleID:       toPgtypeUuid(roleID),
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation &&
+			pgErr.ConstraintName == invitationRolesRoleIDFkey {
+			return vo.NewValidationError("role does not exist", nil, errRoleNotExist)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *invitationRepositoryImpl) FindByTokenHash(ctx context.Context, tokenHash []byte) (entity.Invitation, error) {
+	ctx, span := r.tracer.Start(ctx, "FindByTokenHash")
+	defer span.End()
+
+	var row sqlc.FindInvitationByTokenHashRow
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		row, qErr = queries.FindInvitationByTokenHash(ctx, tokenHash)
+
+		return qErr
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrInvitationNotFound
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	roleIDs := make([]uuid.UUID, 0, len(row.RoleIds))
+	for _, roleID := range row.RoleIds {
+		roleIDs = append(roleIDs, roleID.Bytes)
+	}
+
+	return entity.ReconstructInvitation(
+		row.ID.Bytes,
+		row.Email,
+		roleIDs,
+		row.InvitedBy.Bytes,
+		row.TokenHash,
+		row.ExpiresAt.Time,
+		fromNullablePgtypeTimestamp(row.AcceptedAt),
+		row.AcceptedBy.Bytes,
+		row.CreatedAt.Time,
+	), nil
+}
+
+func (r *invitationRepositoryImpl) MarkAccepted(ctx context.Context, invitation entity.Invitation) error {
+	ctx, span := r.tracer.Start(ctx, "MarkAccepted")
+	defer span.End()
+
+	var affected int64
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.AcceptInvitation(ctx, sqlc.AcceptInvitationParams{
+			ID:         toPgtypeUuid(invitation.ID()),
+			AcceptedAt: toNullablePgtypeTimestamp(invitation.AcceptedAt()),
+			AcceptedBy: toNullablePgtypeUuid(invitation.AcceptedBy()),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	// The update is conditional on accepted_at being NULL; no row means another signup won the race.
+	if affected == 0 {
+		return repository.ErrInvitationAlreadyAccepted
+	}
+
+	return nil
+}
+
+func NewInvitationRepository(dbManager db.DbManager) repository.InvitationRepository {
+	return &invitationRepositoryImpl{
+		tracer:    otel.Tracer("InvitationRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,108 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	domainrepository "github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestInvitation(
+	t *testing.T, inviter entity.User, token vo.InvitationToken, roleIDs ...uuid.UUID,
+) entity.Invitation {
+	t.Helper()
+
+	createdAt := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	invitation, err := entity.NewInvitation(
+		"invitee@example.com", roleIDs, inviter.ID(), token, createdAt.Add(7*24*time.Hour), createdAt,
+	)
+	require.NoError(t, err)
+
+	return invitation
+}
+
+func TestInvitationRepository_CreateAndFind_HappyCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	inviter := seedActor(t)
+	token := vo.InvitationToken("create-and-find")
+	invitation := newTestInvitation(t, inviter, token, uuid.MustParse(viewerRoleID))
+
+	target := repository.NewInvitationRepository(testDb.DbManager())
+	require.NoError(t, target.Create(context.Background(), invitation))
+
+	found, err := target.FindByTokenHash(context.Background(), token.Hash())
+
+	require.NoError(t, err)
+	assert.Equal(t, invitation.ID(), found.ID())
+	assert.Equal(t, invitation.Email(), found.Email())
+	assert.Equal(t, []uuid.UUID{uuid.MustParse(viewerRoleID)}, found.RoleIDs())
+	assert.Equal(t, inviter.ID(), found.InvitedBy())
+	assert.Equal(t, token.Hash(), found.TokenHash())
+	assert.True(t, invitation.ExpiresAt().Equal(found.ExpiresAt()))
+	assert.Nil(t, found.AcceptedAt())
+}
+
+func TestInvitationRepository_Create_FailureCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	inviter := seedActor(t)
+	invitation := newTestInvitation(t, inviter, vo.InvitationToken("unknown-role"), uuid.New())
+
+	target := repository.NewInvitationRepository(testDb.DbManager())
+	err := target.Create(context.Background(), invitation)
+
+	var voErr vo.Error
+	require.ErrorAs(t, err, &voErr)
+	assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+}
+
+func TestInvitationRepository_FindByTokenHash_NotFound(t *testing.T) {
+	target := repository.NewInvitationRepository(testDb.DbManager())
+
+	found, err := target.FindByTokenHash(context.Background(), vo.InvitationToken("missing").Hash())
+
+	require.ErrorIs(t, err, domainrepository.ErrInvitationNotFound)
+	assert.Nil(t, found)
+}
+
+func TestInvitationRepository_MarkAccepted(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	inviter := seedActor(t)
+	invitee := seedUser(t)
+	token := vo.InvitationToken("mark-accepted")
+	invitation := newTestInvitation(t, inviter, token)
+
+	target := repository.NewInvitationRepository(testDb.DbManager())
+	require.NoError(t, target.Create(context.Background(), invitation))
+
+	// Two signups loaded the same pending invitation; only the first may accept it.
+	first, err := target.FindByTokenHash(context.Background(), token.Hash())
+	require.NoError(t, err)
+	second, err := target.FindByTokenHash(context.Background(), token.Hash())
+	require.NoError(t, err)
+
+	acceptedAt := time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, first.Accept(invitee.ID(), invitation.Email(), acceptedAt))
+	require.NoError(t, second.Accept(invitee.ID(), invitation.Email(), acceptedAt))
+
+	require.NoError(t, target.MarkAccepted(context.Background(), first))
+	require.ErrorIs(t, target.MarkAccepted(context.Background(), second), domainrepository.ErrInvitationAlreadyAccepted)
+
+	found, err := target.FindByTokenHash(context.Background(), token.Hash())
+	require.NoError(t, err)
+	require.NotNil(t, found.AcceptedAt())
+	assert.True(t, acceptedAt.Equal(*found.AcceptedAt()))
+	assert.Equal(t, invitee.ID(), found.AcceptedBy())
+}
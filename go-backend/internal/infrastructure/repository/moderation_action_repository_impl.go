@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type moderationActionRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *moderationActionRepositoryImpl) Create(ctx context.Context, action entity.ModerationAction) error {
+	ctx, span := r.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		return queries.CreateModerationAction(ctx, sqlc.CreateModerationActionParams{
+			ID:             toPgtypeUuid(action.ID()),
+			OrganizationID: tenantID,
+			ModeratorID:    toPgtypeUuid(action.ModeratorID()),
+			PostID:         toPgtypeUuid(action.PostID()),
+			TargetUserID:   toPgtypeUuid(action.TargetUserID()),
+			Action:         action.Type().String(),
+			Reason:         action.Reason().String(),
+			CreatedAt:      toPgtypeTimestamp(action.CreatedAt()),
+		})
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+func NewModerationActionRepository(dbManager db.DbManager) repository.ModerationActionRepository {
+	return &moderationActionRepositoryImpl{
+		tracer:    otel.Tracer("ModerationActionRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,47 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModerationActionRepository_Create_OutlivesPost(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	moderator := seedMember(t, ctx, "moderator@example.com")
+	post := seedCommentPost(t, ctx, author.ID())
+	target := repository.NewModerationActionRepository(testDb.DbManager())
+
+	action, err := entity.NewModerationAction(moderator.ID(), post, "delete", "spam campaign", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, target.Create(ctx, action))
+	purgePost(t, ctx, post)
+
+	// The audit record is kept after the post it targets is gone.
+	var (
+		moderatorID, targetUserID uuid.UUID
+		kind, reason              string
+	)
+
+	inTenant(t, ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return tx.QueryRow(
+			ctx, "SELECT moderator_id, target_user_id, action, reason FROM moderation_actions WHERE post_id = $1", post.ID(),
+		).Scan(&moderatorID, &targetUserID, &kind, &reason)
+	})
+	assert.Equal(t, moderator.ID(), moderatorID)
+	assert.Equal(t, author.ID(), targetUserID)
+	assert.Equal(t, "delete", kind)
+	assert.Equal(t, "spam campaign", reason)
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type muteRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *muteRepositoryImpl) Add(ctx context.Context, mute entity.Mute) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "Add")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		_, qErr := queries.FindOrganizationMembership(ctx, sqlc.FindOrganizationMembershipParams{
+			OrganizationID: tenantID,
+			UserID:         toPgtypeUuid(mute.MutedID()),
+		})
+		if errors.Is(qErr, pgx.ErrNoRows) {
+			return repository.ErrMutedUserNotFound
+		}
+
+		if qErr != nil {
+			return qErr
+		}
+
+		affected, qErr = queries.CreateMute(ctx, sqlc.CreateMuteParams{
+			OrganizationID: tenantID,
+			MuterID:        toPgtypeUuid(mute.MuterID()),
+			MutedID:        toPgtypeUuid(mute.MutedID()),
+			CreatedAt:      toPgtypeTimestamp(mute.CreatedAt()),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		if !errors.Is(err, repository.ErrMutedUserNotFound) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (r *muteRepositoryImpl) Remove(ctx context.Context, muterID, mutedID uuid.UUID) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "Remove")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.DeleteMute(ctx, sqlc.DeleteMuteParams{
+			OrganizationID: tenantID,
+			MuterID:        toPgtypeUuid(muterID),
+			MutedID:        toPgtypeUuid(mutedID),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func NewMuteRepository(dbManager db.DbManager) repository.MuteRepository {
+	return &muteRepositoryImpl{
+		tracer:    otel.Tracer("MuteRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,59 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	domainrepository "github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMuteRepository_AddRemove_Idempotent(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	muter := seedMember(t, ctx, "muter@example.com")
+	muted := seedMember(t, ctx, "muted@example.com")
+	target := repository.NewMuteRepository(testDb.DbManager())
+
+	mute, err := entity.NewMute(muter.ID(), muted.ID(), time.Now())
+	require.NoError(t, err)
+
+	added, err := target.Add(ctx, mute)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	added, err = target.Add(ctx, mute)
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	removed, err := target.Remove(ctx, muter.ID(), muted.ID())
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	removed, err = target.Remove(ctx, muter.ID(), muted.ID())
+	require.NoError(t, err)
+	assert.False(t, removed)
+}
+
+func TestMuteRepository_Add_MutedUserOutsideTenant(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherTenant := seedTenant(t)
+	muter := seedMember(t, ctx, "muter@example.com")
+	outsider := seedMember(t, otherTenant, "outsider@example.com")
+	target := repository.NewMuteRepository(testDb.DbManager())
+
+	mute, err := entity.NewMute(muter.ID(), outsider.ID(), time.Now())
+	require.NoError(t, err)
+
+	added, err := target.Add(ctx, mute)
+	require.ErrorIs(t, err, domainrepository.ErrMutedUserNotFound)
+	assert.False(t, added)
+}
@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	pgUniqueViolation = "23505"
+
+	organizationMembershipsRoleIDFkey = "organization_memberships_role_id_fkey"
+	organizationMembershipsUserIDFkey = "organization_memberships_user_id_fkey"
+)
+
+var errMembershipOutsideTenant = errors.New("membership does not belong to the active tenant")
+
+type organizationRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *organizationRepositoryImpl) Create(
+	ctx context.Context, org entity.Organization, founder entity.OrganizationMembership,
+) error {
+	ctx, span := r.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	// A new organization is its own tenant: the founding membership is written inside it.
+	ctx = common.WithTenantID(ctx, org.ID().String())
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		if err := queries.CreateOrganization(ctx, sqlc.CreateOrganizationParams{
+			ID:        toPgtypeUuid(org.ID()),
+			Name:      org.Name(),
+			CreatedAt: toPgtypeTimestamp(org.CreatedAt()),
+		}); err != nil {
+			return err
+		}
+
+		return createMembership(ctx, queries, founder)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapMembershipError(err)
+	}
+
+	return nil
+}
+
+func (r *organizationRepositoryImpl) AddMembership(
+	ctx context.Context, membership entity.OrganizationMembership,
+) error {
+	ctx, span := r.tracer.Start(ctx, "AddMembership")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err == nil && uuid.UUID(tenantID.Bytes) != membership.OrganizationID() {
+		err = errMembershipOutsideTenant
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		return createMembership(ctx, queries, membership)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return mapMembershipError(err)
+	}
+
+	return nil
+}
+
+func (r *organizationRepositoryImpl) FindMembership(
+	ctx context.Context, organizationID, userID uuid.UUID,
+) (entity.OrganizationMembership, error) {
+	ctx, span := r.tracer.Start(ctx, "FindMembership")
+	defer span.End()
+
+	var row sqlc.OrganizationMembership
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		row, qErr = queries.FindOrganizationMembership(ctx, sqlc.FindOrganizationMembershipParams{
+			OrganizationID: toPgtypeUuid(organizationID),
+			UserID:         toPgtypeUuid(userID),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrMembershipNotFound
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return toMembership(row), nil
+}
+
+func (r *organizationRepositoryImpl) FindMembershipsByUserID(
+	ctx context.Context, userID uuid.UUID,
+) ([]entity.OrganizationMembership, error) {
+	ctx, span := r.tracer.Start(ctx, "FindMembershipsByUserID")
+	defer span.End()
+
+	var rows []sqlc.OrganizationMembership
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		rows, qErr = queries.FindOrganizationMembershipsByUserID(ctx, toPgtypeUuid(userID))
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	memberships := make([]entity.OrganizationMembership, 0, len(rows))
+	for _, row := range rows {
+		memberships = append(memberships, toMembership(row))
+	}
+
+	return memberships, nil
+}
+
+func (r *organizationRepositoryImpl) FindMemberIDs(ctx context.Context, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	ctx, span := r.tracer.Start(ctx, "FindMemberIDs")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var rows []pgtype.UUID
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		rows, qErr = queries.FindOrganizationMemberIDs(ctx, sqlc.FindOrganizationMemberIDsParams{
+			OrganizationID: tenantID,
+			UserIds:        toPgtypeUuids(userIDs),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.Bytes)
+	}
+
+	return ids, nil
+}
+
+func (r *organizationRepositoryImpl) FindAllIDs(ctx context.Context) ([]uuid.UUID, error) {
+	ctx, span := r.tracer.Start(ctx, "FindAllIDs")
+	defer span.End()
+
+	var rows []pgtype.UUID
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		rows, qErr = queries.FindAllOrganizationIDs(ctx)
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.Bytes)
+	}
+
+	return ids, nil
+}
+
+func createMembership(ctx context.Context, queries sqlc.Queries, m entity.OrganizationMembership) error {
+	return queries.CreateOrganizationMembership(ctx, sqlc.CreateOrganizationMembershipParams{
+		OrganizationID: toPgtypeUuid(m.OrganizationID()),
+		UserID:         toPgtypeUuid(m.UserID()),
+		RoleID:         toPgtypeUuid(m.RoleID()),
+		CreatedAt:      toPgtypeTimestamp(m.JoinedAt()),
+	})
+}
+
+func mapMembershipError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch {
+	case pgErr.Code == pgUniqueViolation:
+		return repository.ErrMembershipAlreadyExists
+	case pgErr.Code == pgForeignKeyViolation && pgErr.ConstraintName == organizationMembershipsRoleIDFkey:
+		return vo.NewValidationError("role does not exist", nil, errRoleNotExist)
+	case pgErr.Code == pgForeignKeyViolation && pgErr.ConstraintName == organizationMembershipsUserIDFkey:
+		return vo.NewValidationError("user does not exist", nil, errUserNotExist)
+	default:
+		return err
+	}
+}
+
+func toMembership(row sqlc.OrganizationMembership) entity.OrganizationMembership {
+	return entity.ReconstructOrganizationMembership(
+		row.OrganizationID.Bytes,
+		row.UserID.Bytes,
+		row.RoleID.Bytes,
+		row.CreatedAt.Time,
+	)
+}
+
+func NewOrganizationRepository(dbManager db.DbManager) repository.OrganizationRepository {
+	return &organizationRepositoryImpl{
+		tracer:    otel.Tracer("OrganizationRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,184 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	domainrepository "github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seedOrganization(t *testing.T, founder entity.User, createdAt time.Time) entity.Organization {
+	t.Helper()
+
+	org, err := entity.NewOrganization("Acme", createdAt)
+	require.NoError(t, err)
+
+	membership, err := entity.NewOrganizationMembership(org.ID(), founder.ID(), entity.OrganizationOwnerRoleID, createdAt)
+	require.NoError(t, err)
+
+	err = repository.NewOrganizationRepository(testDb.DbManager()).Create(context.Background(), org, membership)
+	require.NoError(t, err)
+
+	return org
+}
+
+func TestOrganizationRepository_Create_HappyCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	founder := seedUser(t)
+	org := seedOrganization(t, founder, time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC))
+
+	target := repository.NewOrganizationRepository(testDb.DbManager())
+	membership, err := target.FindMembership(context.Background(), org.ID(), founder.ID())
+
+	require.NoError(t, err)
+	assert.Equal(t, org.ID(), membership.OrganizationID())
+	assert.Equal(t, founder.ID(), membership.UserID())
+	assert.Equal(t, entity.OrganizationOwnerRoleID, membership.RoleID())
+}
+
+func TestOrganizationRepository_AddMembership_HappyCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	founder := seedUser(t)
+	member := seedActor(t)
+	org := seedOrganization(t, founder, time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC))
+	ctx := common.WithTenantID(context.Background(), org.ID().String())
+
+	membership, err := entity.NewOrganizationMembership(
+		org.ID(), member.ID(), uuid.MustParse(viewerRoleID), time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC),
+	)
+	require.NoError(t, err)
+
+	target := repository.NewOrganizationRepository(testDb.DbManager())
+	require.NoError(t, target.AddMembership(ctx, membership))
+
+	found, err := target.FindMembership(ctx, org.ID(), member.ID())
+	require.NoError(t, err)
+	assert.Equal(t, uuid.MustParse(viewerRoleID), found.RoleID())
+}
+
+func TestOrganizationRepository_AddMembership_FailureCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	founder := seedUser(t)
+	member := seedActor(t)
+	org := seedOrganization(t, founder, time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC))
+	ctx := common.WithTenantID(context.Background(), org.ID().String())
+	joinedAt := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		userID   uuid.UUID
+		roleID   uuid.UUID
+		wantErr  error
+		wantCode vo.ErrorCode
+	}{
+		{
+			name:    "already a member",
+			ctx:     ctx,
+			userID:  founder.ID(),
+			roleID:  uuid.MustParse(viewerRoleID),
+			wantErr: domainrepository.ErrMembershipAlreadyExists,
+		},
+		{
+			name:     "role does not exist",
+			ctx:      ctx,
+			userID:   member.ID(),
+			roleID:   uuid.New(),
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:     "user does not exist",
+			ctx:      ctx,
+			userID:   uuid.New(),
+			roleID:   uuid.MustParse(viewerRoleID),
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:   "organization is not the active tenant",
+			ctx:    seedTenant(t),
+			userID: member.ID(),
+			roleID: uuid.MustParse(viewerRoleID),
+		},
+	}
+
+	target := repository.NewOrganizationRepository(testDb.DbManager())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			membership, err := entity.NewOrganizationMembership(org.ID(), tt.userID, tt.roleID, joinedAt)
+			require.NoError(t, err)
+
+			err = target.AddMembership(tt.ctx, membership)
+			require.Error(t, err)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+
+			if tt.wantCode != "" {
+				var voErr vo.Error
+				require.ErrorAs(t, err, &voErr)
+				assert.Equal(t, tt.wantCode, voErr.Code())
+			}
+		})
+	}
+}
+
+func TestOrganizationRepository_FindMembership_NotFound(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	founder := seedUser(t)
+	org := seedOrganization(t, founder, time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC))
+
+	target := repository.NewOrganizationRepository(testDb.DbManager())
+	_, err := target.FindMembership(context.Background(), org.ID(), uuid.New())
+
+	require.ErrorIs(t, err, domainrepository.ErrMembershipNotFound)
+}
+
+func TestOrganizationRepository_FindMembershipsByUserID_OldestFirst(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	founder := seedUser(t)
+	newer := seedOrganization(t, founder, time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC))
+	older := seedOrganization(t, founder, time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC))
+
+	target := repository.NewOrganizationRepository(testDb.DbManager())
+	memberships, err := target.FindMembershipsByUserID(context.Background(), founder.ID())
+
+	require.NoError(t, err)
+	require.Len(t, memberships, 2)
+	assert.Equal(t, older.ID(), memberships[0].OrganizationID())
+	assert.Equal(t, newer.ID(), memberships[1].OrganizationID())
+}
+
+func TestOrganizationRepository_FindMemberIDs(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	member := seedMember(t, ctx, "member@example.com")
+	outsider := seedUser(t)
+
+	// A member of another organization is not a member of the active one.
+	otherCtx := seedTenant(t)
+	otherMember := seedMember(t, otherCtx, "other@example.com")
+
+	target := repository.NewOrganizationRepository(testDb.DbManager())
+	ids, err := target.FindMemberIDs(ctx, []uuid.UUID{member.ID(), outsider.ID(), otherMember.ID(), uuid.New()})
+
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{member.ID()}, ids)
+}
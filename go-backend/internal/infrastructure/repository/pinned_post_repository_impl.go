@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type pinnedPostRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *pinnedPostRepositoryImpl) Pin(ctx context.Context, pin entity.PinnedPost) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "Pin")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		qErr := queries.LockPinnedPosts(ctx, toPgtypeUuid(pin.UserID()))
+		if qErr != nil {
+			return qErr
+		}
+
+		affected, qErr = queries.CreatePinnedPost(ctx, sqlc.CreatePinnedPostParams{
+			OrganizationID: tenantID,
+			UserID:         toPgtypeUuid(pin.UserID()),
+			PostID:         toPgtypeUuid(pin.PostID()),
+			PinnedAt:       toPgtypeTimestamp(pin.PinnedAt()),
+		})
+		if qErr != nil || affected == 0 {
+			return qErr
+		}
+
+		// The new pin is counted too; the caller's transaction rolls it back when it is one too many.
+		count, qErr := queries.CountPinnedPosts(ctx, sqlc.CountPinnedPostsParams{
+			OrganizationID: tenantID,
+			UserID:         toPgtypeUuid(pin.UserID()),
+		})
+		if qErr != nil {
+			return qErr
+		}
+
+		if count > entity.MaxPinnedPosts {
+			return repository.ErrTooManyPinnedPosts
+		}
+
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, repository.ErrTooManyPinnedPosts) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (r *pinnedPostRepositoryImpl) Unpin(ctx context.Context, userID, postID uuid.UUID) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "Unpin")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.DeletePinnedPost(ctx, sqlc.DeletePinnedPostParams{
+			OrganizationID: tenantID,
+			UserID:         toPgtypeUuid(userID),
+			PostID:         toPgtypeUuid(postID),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func NewPinnedPostRepository(dbManager db.DbManager) repository.PinnedPostRepository {
+	return &pinnedPostRepositoryImpl{
+		tracer:    otel.Tracer("PinnedPostRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
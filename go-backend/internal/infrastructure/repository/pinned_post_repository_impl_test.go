@@ -0,0 +1,154 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	domainrepository "github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pinnedPostIDs(t *testing.T, ctx context.Context, userID uuid.UUID) []uuid.UUID {
+	t.Helper()
+
+	var ids []uuid.UUID
+
+	inTenant(t, ctx, func(ctx context.Context, tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, "SELECT post_id FROM pinned_posts WHERE user_id = $1 ORDER BY pinned_at", userID)
+		if err != nil {
+			return err
+		}
+
+		ids, err = pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+
+		return err
+	})
+
+	return ids
+}
+
+func TestPinnedPostRepository_PinUnpin(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	target := repository.NewPinnedPostRepository(testDb.DbManager())
+	txManager := db.NewTransactionManger(testDb.Pool())
+
+	pin := func(p entity.Post, pinnedAt time.Time) (bool, error) {
+		pinnedPost, err := entity.NewPinnedPost(author.ID(), p, pinnedAt)
+		require.NoError(t, err)
+
+		var pinned bool
+
+		err = txManager.Do(ctx, func(ctx context.Context) error {
+			var txErr error
+
+			pinned, txErr = target.Pin(ctx, pinnedPost)
+
+			return txErr
+		})
+
+		return pinned, err
+	}
+
+	now := time.Now().UTC()
+	posts := make([]entity.Post, entity.MaxPinnedPosts+1)
+
+	for i := range posts {
+		posts[i] = seedCommentPost(t, ctx, author.ID())
+	}
+
+	for i, p := range posts[:entity.MaxPinnedPosts] {
+		pinned, err := pin(p, now.Add(time.Duration(i)*time.Minute))
+		require.NoError(t, err)
+		assert.True(t, pinned)
+	}
+
+	// Pinning a post again changes nothing and does not count against the limit.
+	pinned, err := pin(posts[0], now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, pinned)
+
+	_, err = pin(posts[entity.MaxPinnedPosts], now.Add(time.Hour))
+	require.ErrorIs(t, err, domainrepository.ErrTooManyPinnedPosts)
+	assert.Len(t, pinnedPostIDs(t, ctx, author.ID()), entity.MaxPinnedPosts)
+
+	// A pinned post in the trash keeps its slot, so restoring it cannot take the user past the limit.
+	postRepository := repository.NewPostRepository(testDb.DbManager())
+	require.NoError(t, posts[1].Trash(author.ID(), now))
+	require.NoError(t, postRepository.Trash(ctx, posts[1]))
+
+	_, err = pin(posts[entity.MaxPinnedPosts], now.Add(time.Hour))
+	require.ErrorIs(t, err, domainrepository.ErrTooManyPinnedPosts)
+
+	require.NoError(t, posts[1].Restore(now))
+	require.NoError(t, postRepository.Restore(ctx, posts[1]))
+	assert.Len(t, pinnedPostIDs(t, ctx, author.ID()), entity.MaxPinnedPosts)
+
+	// Unpinning frees the slot.
+	unpinned, err := target.Unpin(ctx, author.ID(), posts[1].ID())
+	require.NoError(t, err)
+	assert.True(t, unpinned)
+
+	unpinned, err = target.Unpin(ctx, author.ID(), posts[1].ID())
+	require.NoError(t, err)
+	assert.False(t, unpinned)
+
+	pinned, err = pin(posts[entity.MaxPinnedPosts], now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, pinned)
+
+	assert.Equal(t, []uuid.UUID{posts[0].ID(), posts[2].ID(), posts[3].ID()}, pinnedPostIDs(t, ctx, author.ID()))
+}
+
+func TestPinnedPostRepository_Pin_Concurrent(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	target := repository.NewPinnedPostRepository(testDb.DbManager())
+	txManager := db.NewTransactionManger(testDb.Pool())
+
+	// Pinning more posts than allowed at once may not get past the limit.
+	var (
+		wg     sync.WaitGroup
+		pinned atomic.Int32
+	)
+
+	for range 2 * entity.MaxPinnedPosts {
+		p := seedCommentPost(t, ctx, author.ID())
+
+		wg.Go(func() {
+			pinnedPost, err := entity.NewPinnedPost(author.ID(), p, time.Now())
+			assert.NoError(t, err)
+
+			err = txManager.Do(ctx, func(ctx context.Context) error {
+				_, txErr := target.Pin(ctx, pinnedPost)
+
+				return txErr
+			})
+			if err == nil {
+				pinned.Add(1)
+			} else {
+				assert.ErrorIs(t, err, domainrepository.ErrTooManyPinnedPosts)
+			}
+		})
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(entity.MaxPinnedPosts), pinned.Load())
+	assert.Len(t, pinnedPostIDs(t, ctx, author.ID()), entity.MaxPinnedPosts)
+}
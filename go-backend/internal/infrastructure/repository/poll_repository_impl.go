@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type pollRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *pollRepositoryImpl) Create(ctx context.Context, poll entity.Poll) error {
+	ctx, span := r.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		qErr := queries.CreatePostPoll(ctx, sqlc.CreatePostPollParams{
+			OrganizationID: tenantID,
+			PostID:         toPgtypeUuid(poll.PostID()),
+			Multiple:       poll.Multiple(),
+			ClosesAt:       toPgtypeTimestamp(poll.ClosesAt()),
+			CreatedAt:      toPgtypeTimestamp(poll.CreatedAt()),
+		})
+		if qErr != nil {
+			return qErr
+		}
+
+		for i, option := range poll.Options() {
+			qErr = queries.CreatePostPollOption(ctx, sqlc.CreatePostPollOptionParams{
+				ID:             toPgtypeUuid(option.ID()),
+				OrganizationID: tenantID,
+				PostID:         toPgtypeUuid(poll.PostID()),
+				Position:       int16(i), //nolint:gosec // a poll has at most entity.MaxPollOptions options
+				Label:          option.Label(),
+			})
+			if qErr != nil {
+				return qErr
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *pollRepositoryImpl) FindByPostID(ctx context.Context, postID, viewerID uuid.UUID) (entity.Poll, error) {
+	ctx, span := r.tracer.Start(ctx, "FindByPostID")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var (
+		row        sqlc.FindPostPollRow
+		optionRows []sqlc.FindPostPollOptionsRow
+	)
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		qErr := requireVisiblePost(ctx, queries, tenantID, postID, viewerID)
+		if qErr != nil {
+			return qErr
+		}
+
+		row, qErr = queries.FindPostPoll(ctx, sqlc.FindPostPollParams{
+			OrganizationID: tenantID,
+			PostID:         toPgtypeUuid(postID),
+		})
+		if qErr != nil {
+			return qErr
+		}
+
+		optionRows, qErr = queries.FindPostPollOptions(ctx, sqlc.FindPostPollOptionsParams{
+			OrganizationID: tenantID,
+			PostID:         toPgtypeUuid(postID),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrPollNotFound
+		}
+
+		if !errors.Is(err, repository.ErrPostNotFound) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		return nil, err
+	}
+
+	options := make([]entity.PollOption, len(optionRows))
+	for i, option := range optionRows {
+		options[i] = entity.ReconstructPollOption(option.ID.Bytes, option.Label)
+	}
+
+	return entity.ReconstructPoll(row.PostID.Bytes, options, row.Multiple, row.ClosesAt.Time, row.CreatedAt.Time), nil
+}
+
+func (r *pollRepositoryImpl) Vote(ctx context.Context, vote entity.PollVote) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "Vote")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	var voted bool
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		affected, qErr := queries.CreatePostPollBallot(ctx, sqlc.CreatePostPollBallotParams{
+			OrganizationID: tenantID,
+			PostID:         toPgtypeUuid(vote.PostID()),
+			UserID:         toPgtypeUuid(vote.UserID()),
+			CreatedAt:      toPgtypeTimestamp(vote.CreatedAt()),
+		})
+		if qErr != nil || affected == 0 {
+			return qErr
+		}
+
+		voted = true
+		optionIDs := toPgtypeUuids(vote.OptionIDs())
+
+		qErr = queries.CreatePostPollVotes(ctx, sqlc.CreatePostPollVotesParams{
+			OrganizationID: tenantID,
+			PostID:         toPgtypeUuid(vote.PostID()),
+			UserID:         toPgtypeUuid(vote.UserID()),
+			OptionIds:      optionIDs,
+		})
+		if qErr != nil {
+			return qErr
+		}
+
+		qErr = queries.IncrementPostPollVoterCount(ctx, sqlc.IncrementPostPollVoterCountParams{
+			OrganizationID: tenantID,
+			PostID:         toPgtypeUuid(vote.PostID()),
+		})
+		if qErr != nil {
+			return qErr
+		}
+
+		return queries.IncrementPostPollVoteCounts(ctx, sqlc.IncrementPostPollVoteCountsParams{
+			OrganizationID: tenantID,
+			PostID:         toPgtypeUuid(vote.PostID()),
+			OptionIds:      optionIDs,
+		})
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	return voted, nil
+}
+
+func NewPollRepository(dbManager db.DbManager) repository.PollRepository {
+	return &pollRepositoryImpl{
+		tracer:    otel.Tracer("PollRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,167 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	domainrepository "github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seedPoll(t *testing.T, ctx context.Context, authorID uuid.UUID, draft bool) entity.Poll {
+	t.Helper()
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	post, err := entity.NewPost(entity.PostParams{
+		UserID:     authorID,
+		Content:    "Which day?",
+		Format:     "plain",
+		Visibility: "public",
+		Draft:      draft,
+		CreatedAt:  now,
+	})
+	require.NoError(t, err)
+	post, err = repository.NewPostRepository(testDb.DbManager()).Create(ctx, post)
+	require.NoError(t, err)
+
+	poll, err := entity.NewPoll(post, []string{"Tue", "Wed", "Thu"}, true, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, repository.NewPollRepository(testDb.DbManager()).Create(ctx, poll))
+
+	return poll
+}
+
+func pollTallies(t *testing.T, ctx context.Context, postID uuid.UUID) (int, []int) {
+	t.Helper()
+
+	var voterCount int
+
+	var voteCounts []int
+
+	inTenant(t, ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if err := tx.QueryRow(ctx,
+			"SELECT voter_count FROM post_polls WHERE post_id = $1", postID,
+		).Scan(&voterCount); err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx,
+			"SELECT vote_count FROM post_poll_options WHERE post_id = $1 ORDER BY position", postID)
+		if err != nil {
+			return err
+		}
+
+		voteCounts, err = pgx.CollectRows(rows, pgx.RowTo[int])
+
+		return err
+	})
+
+	return voterCount, voteCounts
+}
+
+func TestPollRepository_CreateFind(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	viewer := seedMember(t, ctx, "viewer@example.com")
+	target := repository.NewPollRepository(testDb.DbManager())
+
+	poll := seedPoll(t, ctx, author.ID(), false)
+
+	found, err := target.FindByPostID(ctx, poll.PostID(), viewer.ID())
+	require.NoError(t, err)
+	assert.Equal(t, poll.Multiple(), found.Multiple())
+	assert.True(t, poll.ClosesAt().Equal(found.ClosesAt()))
+	require.Len(t, found.Options(), 3)
+
+	for i, option := range poll.Options() {
+		assert.Equal(t, option.ID(), found.Options()[i].ID())
+		assert.Equal(t, option.Label(), found.Options()[i].Label())
+	}
+
+	// The poll of a draft cannot be voted on yet, even by its author; other members do not see the draft.
+	draft := seedPoll(t, ctx, author.ID(), true)
+
+	_, err = target.FindByPostID(ctx, draft.PostID(), author.ID())
+	require.ErrorIs(t, err, domainrepository.ErrPollNotFound)
+
+	_, err = target.FindByPostID(ctx, draft.PostID(), viewer.ID())
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+
+	_, err = target.FindByPostID(ctx, uuid.New(), viewer.ID())
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+}
+
+func TestPollRepository_Vote_Concurrent(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	target := repository.NewPollRepository(testDb.DbManager())
+	txManager := db.NewTransactionManger(testDb.Pool())
+
+	poll := seedPoll(t, ctx, author.ID(), false)
+	tue, wed := poll.Options()[0].ID(), poll.Options()[1].ID()
+
+	const voters = 8
+
+	users := make([]entity.User, voters)
+	for i := range users {
+		users[i] = seedMember(t, ctx, fmt.Sprintf("voter%d@example.com", i))
+	}
+
+	// Every voter votes twice at once; only one ballot per voter may count, and the tallies must match them.
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		counted = map[uuid.UUID]int{}
+	)
+
+	for _, user := range users {
+		for range 2 {
+			wg.Go(func() {
+				vote, err := poll.Vote(user.ID(), []uuid.UUID{tue, wed}, time.Now())
+				assert.NoError(t, err)
+
+				var voted bool
+
+				assert.NoError(t, txManager.Do(ctx, func(ctx context.Context) error {
+					voted, err = target.Vote(ctx, vote)
+
+					return err
+				}))
+
+				if voted {
+					mu.Lock()
+					counted[user.ID()]++
+					mu.Unlock()
+				}
+			})
+		}
+	}
+
+	wg.Wait()
+
+	require.Len(t, counted, voters)
+
+	for _, n := range counted {
+		assert.Equal(t, 1, n)
+	}
+
+	voterCount, voteCounts := pollTallies(t, ctx, poll.PostID())
+	assert.Equal(t, voters, voterCount)
+	assert.Equal(t, []int{voters, voters, 0}, voteCounts)
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type postEntityRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *postEntityRepositoryImpl) Replace(
+	ctx context.Context, post entity.Post, entities vo.ContentEntities,
+) error {
+	ctx, span := r.tracer.Start(ctx, "Replace")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	postID := toPgtypeUuid(post.ID())
+	hashtags := entities.Values(vo.ContentEntityTypeHashtag)
+	handles := entities.Values(vo.ContentEntityTypeMention)
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		err := queries.DeletePostHashtags(ctx, sqlc.DeletePostHashtagsParams{OrganizationID: tenantID, PostID: postID})
+		if err != nil {
+			return err
+		}
+
+		if err = queries.DeletePostMentions(ctx, sqlc.DeletePostMentionsParams{
+			OrganizationID: tenantID, PostID: postID,
+		}); err != nil {
+			return err
+		}
+
+		if len(hashtags) > 0 {
+			if err = queries.CreateHashtags(ctx, sqlc.CreateHashtagsParams{
+				OrganizationID: tenantID, Names: hashtags,
+			}); err != nil {
+				return err
+			}
+
+			if err = queries.CreatePostHashtags(ctx, sqlc.CreatePostHashtagsParams{
+				PostID: postID, OrganizationID: tenantID, Names: hashtags,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if len(handles) == 0 {
+			return nil
+		}
+
+		// Users who block one another cannot mention each other.
+		return queries.CreatePostMentions(ctx, sqlc.CreatePostMentionsParams{
+			PostID:         postID,
+			OrganizationID: tenantID,
+			Handles:        handles,
+			AuthorID:       toPgtypeUuid(post.UserID()),
+		})
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+func NewPostEntityRepository(dbManager db.DbManager) repository.PostEntityRepository {
+	return &postEntityRepositoryImpl{
+		tracer:    otel.Tracer("PostEntityRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,118 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func postHashtags(t *testing.T, ctx context.Context, postID uuid.UUID) []string {
+	t.Helper()
+
+	var names []string
+
+	inTenant(t, ctx, func(ctx context.Context, tx pgx.Tx) error {
+		rows, err := tx.Query(ctx,
+			"SELECT h.name FROM post_hashtags ph JOIN hashtags h ON h.id = ph.hashtag_id WHERE ph.post_id = $1 "+
+				"ORDER BY h.name",
+			postID,
+		)
+		if err != nil {
+			return err
+		}
+
+		names, err = pgx.CollectRows(rows, pgx.RowTo[string])
+
+		return err
+	})
+
+	return names
+}
+
+func postMentions(t *testing.T, ctx context.Context, postID uuid.UUID) map[string]uuid.UUID {
+	t.Helper()
+
+	mentions := map[string]uuid.UUID{}
+
+	var (
+		handle string
+		userID uuid.UUID
+	)
+
+	inTenant(t, ctx, func(ctx context.Context, tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, "SELECT handle, user_id FROM post_mentions WHERE post_id = $1", postID)
+		if err != nil {
+			return err
+		}
+
+		_, err = pgx.ForEachRow(rows, []any{&handle, &userID}, func() error {
+			mentions[handle] = userID
+
+			return nil
+		})
+
+		return err
+	})
+
+	return mentions
+}
+
+func TestPostEntityRepository_Replace(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	alice := seedMember(t, ctx, "alice@example.com")
+	seedMember(t, ctx, "first@example.com")
+	seedMember(t, ctx, "second@example.com")
+	post := seedCommentPost(t, ctx, alice.ID())
+	target := repository.NewPostEntityRepository(testDb.DbManager())
+
+	_, err := testDb.Pool().Exec(ctx, "UPDATE users SET name = 'Ａｌｉｃｅ' WHERE id = $1", alice.ID())
+	require.NoError(t, err)
+
+	// @member matches two members and @nobody none, so neither is stored; #Go and #go are the same tag.
+	require.NoError(t, target.Replace(ctx, post, vo.ParseContentEntities("#Go #go #golang @alice @member @nobody")))
+	assert.Equal(t, []string{"go", "golang"}, postHashtags(t, ctx, post.ID()))
+	assert.Equal(t, map[string]uuid.UUID{"alice": alice.ID()}, postMentions(t, ctx, post.ID()))
+
+	// Saving again replaces the previous entities; the tags themselves are kept for reuse.
+	require.NoError(t, target.Replace(ctx, post, vo.ParseContentEntities("#rust")))
+	assert.Equal(t, []string{"rust"}, postHashtags(t, ctx, post.ID()))
+	assert.Empty(t, postMentions(t, ctx, post.ID()))
+
+	var tags int
+
+	inTenant(t, ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return tx.QueryRow(ctx, "SELECT COUNT(*) FROM hashtags").Scan(&tags)
+	})
+	assert.Equal(t, 3, tags)
+}
+
+func TestPostEntityRepository_Replace_SkipsBlockedMentions(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	alice := seedMember(t, ctx, "alice@example.com")
+	bob := seedMember(t, ctx, "bob@example.com")
+	carol := seedMember(t, ctx, "carol@example.com")
+	post := seedCommentPost(t, ctx, alice.ID())
+	target := repository.NewPostEntityRepository(testDb.DbManager())
+
+	_, err := testDb.Pool().Exec(ctx, "UPDATE users SET name = split_part(email, '@', 1) WHERE id IN ($1, $2)",
+		bob.ID(), carol.ID())
+	require.NoError(t, err)
+
+	// Bob blocked the author, so the author cannot reach him with a mention.
+	seedBlock(t, ctx, bob.ID(), alice.ID())
+
+	require.NoError(t, target.Replace(ctx, post, vo.ParseContentEntities("@bob @carol")))
+	assert.Equal(t, map[string]uuid.UUID{"carol": carol.ID()}, postMentions(t, ctx, post.ID()))
+}
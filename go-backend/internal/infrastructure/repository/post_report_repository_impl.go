@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type postReportRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *postReportRepositoryImpl) Create(ctx context.Context, report entity.PostReport) error {
+	ctx, span := r.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	if report.ReporterID() == nil {
+		return r.createFlagged(ctx, span, tenantID, report)
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.CreatePostReport(ctx, sqlc.CreatePostReportParams{
+			ID:             toPgtypeUuid(report.ID()),
+			ReporterID:     toPgtypeUuid(*report.ReporterID()),
+			Reason:         report.Reason().String(),
+			Details:        report.Details(),
+			CreatedAt:      toPgtypeTimestamp(report.CreatedAt()),
+			PostID:         toPgtypeUuid(report.PostID()),
+			OrganizationID: tenantID,
+		})
+
+		return qErr
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+			return repository.ErrPostAlreadyReported
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	if affected == 0 {
+		return repository.ErrPostNotFound
+	}
+
+	return nil
+}
+
+func (r *postReportRepositoryImpl) createFlagged(
+	ctx context.Context, span trace.Span, tenantID pgtype.UUID, report entity.PostReport,
+) error {
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		return queries.CreateFlaggedPostReport(ctx, sqlc.CreateFlaggedPostReportParams{
+			ID:             toPgtypeUuid(report.ID()),
+			OrganizationID: tenantID,
+			PostID:         toPgtypeUuid(report.PostID()),
+			Reason:         report.Reason().String(),
+			Details:        report.Details(),
+			CreatedAt:      toPgtypeTimestamp(report.CreatedAt()),
+		})
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+			return repository.ErrPostNotFound
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *postReportRepositoryImpl) ResolveByPostID(
+	ctx context.Context, postID, moderatorID uuid.UUID, at time.Time,
+) (int, error) {
+	ctx, span := r.tracer.Start(ctx, "ResolveByPostID")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return 0, err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.ResolvePostReports(ctx, sqlc.ResolvePostReportsParams{
+			PostID:         toPgtypeUuid(postID),
+			OrganizationID: tenantID,
+			ResolvedAt:     toPgtypeTimestamp(at),
+			ResolvedBy:     toPgtypeUuid(moderatorID),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
+func NewPostReportRepository(dbManager db.DbManager) repository.PostReportRepository {
+	return &postReportRepositoryImpl{
+		tracer:    otel.Tracer("PostReportRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,133 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	domainrepository "github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openReportCount(t *testing.T, ctx context.Context, postID uuid.UUID) int {
+	t.Helper()
+
+	var count int
+	inTenant(t, ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return tx.QueryRow(
+			ctx, "SELECT COUNT(*) FROM post_reports WHERE post_id = $1 AND resolved_at IS NULL", postID,
+		).Scan(&count)
+	})
+
+	return count
+}
+
+func newTestReport(t *testing.T, postID, reporterID uuid.UUID) entity.PostReport {
+	t.Helper()
+
+	report, err := entity.NewPostReport(postID, reporterID, "spam", "", time.Now())
+	require.NoError(t, err)
+
+	return report
+}
+
+func TestPostReportRepository_CreateResolve(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	reporter := seedMember(t, ctx, "reporter@example.com")
+	moderator := seedMember(t, ctx, "moderator@example.com")
+	post := seedCommentPost(t, ctx, author.ID())
+	target := repository.NewPostReportRepository(testDb.DbManager())
+
+	require.NoError(t, target.Create(ctx, newTestReport(t, post.ID(), reporter.ID())))
+	require.ErrorIs(
+		t, target.Create(ctx, newTestReport(t, post.ID(), reporter.ID())), domainrepository.ErrPostAlreadyReported,
+	)
+	require.NoError(t, target.Create(ctx, newTestReport(t, post.ID(), moderator.ID())))
+	assert.Equal(t, 2, openReportCount(t, ctx, post.ID()))
+
+	resolved, err := target.ResolveByPostID(ctx, post.ID(), moderator.ID(), time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 2, resolved)
+	assert.Equal(t, 0, openReportCount(t, ctx, post.ID()))
+
+	// Once the earlier report is resolved the same reporter may report the post again.
+	require.NoError(t, target.Create(ctx, newTestReport(t, post.ID(), reporter.ID())))
+	assert.Equal(t, 1, openReportCount(t, ctx, post.ID()))
+}
+
+func TestPostReportRepository_Create_PostNotVisible(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherTenant := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	reporter := seedMember(t, ctx, "reporter@example.com")
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	postRepository := repository.NewPostRepository(testDb.DbManager())
+	target := repository.NewPostReportRepository(testDb.DbManager())
+
+	followersOnly, err := postRepository.Create(ctx, entity.ReconstructPost(
+		uuid.New(), author.ID(), "followers", vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityFollowers,
+		vo.PostStatusPublished, nil, nil, nil, nil, createdAt, createdAt,
+	))
+	require.NoError(t, err)
+
+	hidden := seedCommentPost(t, ctx, author.ID())
+	require.NoError(t, hidden.Hide(createdAt))
+	require.NoError(t, postRepository.Hide(ctx, hidden))
+
+	public := seedCommentPost(t, ctx, author.ID())
+
+	require.ErrorIs(
+		t, target.Create(ctx, newTestReport(t, followersOnly.ID(), reporter.ID())), domainrepository.ErrPostNotFound,
+	)
+	require.ErrorIs(t, target.Create(ctx, newTestReport(t, hidden.ID(), reporter.ID())), domainrepository.ErrPostNotFound)
+	require.ErrorIs(
+		t, target.Create(otherTenant, newTestReport(t, public.ID(), reporter.ID())), domainrepository.ErrPostNotFound,
+	)
+
+	resolved, err := target.ResolveByPostID(otherTenant, public.ID(), reporter.ID(), time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, resolved)
+}
+
+func TestPostReportRepository_Create_Flagged(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	postRepository := repository.NewPostRepository(testDb.DbManager())
+	target := repository.NewPostReportRepository(testDb.DbManager())
+
+	// The content filters see every new post, whatever its visibility.
+	followersOnly, err := postRepository.Create(ctx, entity.ReconstructPost(
+		uuid.New(), author.ID(), "followers", vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityFollowers,
+		vo.PostStatusPublished, nil, nil, nil, nil, createdAt, createdAt,
+	))
+	require.NoError(t, err)
+
+	newFlagged := func(postID uuid.UUID) entity.PostReport {
+		report, err := entity.NewFlaggedPostReport(postID, vo.ReportReasonSpam, "duplicates", time.Now())
+		require.NoError(t, err)
+
+		return report
+	}
+
+	require.NoError(t, target.Create(ctx, newFlagged(followersOnly.ID())))
+	require.NoError(t, target.Create(ctx, newFlagged(followersOnly.ID())))
+	assert.Equal(t, 2, openReportCount(t, ctx, followersOnly.ID()))
+
+	require.ErrorIs(t, target.Create(ctx, newFlagged(uuid.New())), domainrepository.ErrPostNotFound)
+}
@@ -2,17 +2,26 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/Haya372/web-app-template/go-backend/internal/common"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
 	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
 	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 )
 
+// postsRepostIdx is the unique index that lets a user repost a post only once.
+const postsRepostIdx = "posts_original_id_user_id_repost_idx"
+
 type postRepositoryImpl struct {
 	tracer    trace.Tracer
 	logger    common.Logger
@@ -23,33 +32,583 @@ func (r *postRepositoryImpl) Create(ctx context.Context, post entity.Post) (enti
 	ctx, span := r.tracer.Start(ctx, "Create")
 	defer span.End()
 
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
 	var row sqlc.CreatePostRow
 
-	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
 		var qErr error
 
 		row, qErr = queries.CreatePost(ctx, sqlc.CreatePostParams{
-			ID:        toPgtypeUuid(post.ID()),
-			UserID:    toPgtypeUuid(post.UserID()),
-			Content:   post.Content(),
-			CreatedAt: toPgtypeTimestamp(post.CreatedAt()),
+			ID:             toPgtypeUuid(post.ID()),
+			OrganizationID: tenantID,
+			UserID:         toPgtypeUuid(post.UserID()),
+			Content:        post.Content(),
+			Format:         post.Format().String(),
+			Kind:           post.Kind().String(),
+			OriginalID:     toNullablePgtypeUuidPtr(post.OriginalID()),
+			Visibility:     post.Visibility().String(),
+			Status:         post.Status().String(),
+			PublishAt:      toNullablePgtypeTimestamp(post.PublishAt()),
+			CreatedAt:      toPgtypeTimestamp(post.CreatedAt()),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrPostNotFound
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return reconstructPost(sqlc.FindPostByIDRow(row)), nil
+}
+
+// postImportCopy stages the posts stored by CreateMany.
+var postImportCopy = stagedCopy{
+	table: "post_imports",
+	createSQL: `create temporary table if not exists post_imports (
+  id uuid, user_id uuid, content text, format varchar(16), visibility varchar(16), status varchar(16),
+  publish_at timestamp, created_at timestamp
+) on commit drop`,
+	columns: []string{"id", "user_id", "content", "format", "visibility", "status", "publish_at", "created_at"},
+	insertSQL: `insert into posts(
+  id, organization_id, user_id, content, format, visibility, status, publish_at, created_at, updated_at
+)
+select id, $1, user_id, content, format, visibility, status, publish_at, created_at, created_at
+from post_imports`,
+}
+
+func (r *postRepositoryImpl) CreateMany(ctx context.Context, posts []entity.Post) error {
+	ctx, span := r.tracer.Start(ctx, "CreateMany")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	rows := make([][]any, 0, len(posts))
+	for _, post := range posts {
+		rows = append(rows, []any{
+			toPgtypeUuid(post.ID()),
+			toPgtypeUuid(post.UserID()),
+			post.Content(),
+			post.Format().String(),
+			post.Visibility().String(),
+			post.Status().String(),
+			toNullablePgtypeTimestamp(post.PublishAt()),
+			toPgtypeTimestamp(post.CreatedAt()),
+		})
+	}
+
+	err = r.dbManager.TxFunc(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return postImportCopy.run(ctx, tx, rows, tenantID)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *postRepositoryImpl) CreateRepost(ctx context.Context, repost entity.Post) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "CreateRepost")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.CreateRepost(ctx, sqlc.CreateRepostParams{
+			ID:             toPgtypeUuid(repost.ID()),
+			OrganizationID: tenantID,
+			UserID:         toPgtypeUuid(repost.UserID()),
+			OriginalID:     toNullablePgtypeUuidPtr(repost.OriginalID()),
+			CreatedAt:      toPgtypeTimestamp(repost.CreatedAt()),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (r *postRepositoryImpl) DeleteRepost(ctx context.Context, userID, originalID uuid.UUID) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "DeleteRepost")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.DeleteRepost(ctx, sqlc.DeleteRepostParams{
+			OrganizationID: tenantID,
+			UserID:         toPgtypeUuid(userID),
+			OriginalID:     toPgtypeUuid(originalID),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (r *postRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	ctx, span := r.tracer.Start(ctx, "FindByID")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var row sqlc.FindPostByIDRow
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		row, qErr = queries.FindPostByID(ctx, sqlc.FindPostByIDParams{
+			ID:             toPgtypeUuid(id),
+			OrganizationID: tenantID,
 		})
 
 		return qErr
 	})
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrPostNotFound
+		}
+
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 
 		return nil, err
 	}
 
+	return reconstructPost(sqlc.FindPostByIDRow(row)), nil
+}
+
+func (r *postRepositoryImpl) Update(ctx context.Context, post entity.Post) error {
+	ctx, span := r.tracer.Start(ctx, "Update")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.UpdatePost(ctx, sqlc.UpdatePostParams{
+			ID:             toPgtypeUuid(post.ID()),
+			OrganizationID: tenantID,
+			Content:        post.Content(),
+			Format:         post.Format().String(),
+			UpdatedAt:      toPgtypeTimestamp(post.UpdatedAt()),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	if affected == 0 {
+		return repository.ErrPostNotFound
+	}
+
+	return nil
+}
+
+func (r *postRepositoryImpl) Publish(ctx context.Context, post entity.Post) error {
+	ctx, span := r.tracer.Start(ctx, "Publish")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.PublishPost(ctx, sqlc.PublishPostParams{
+			ID:             toPgtypeUuid(post.ID()),
+			OrganizationID: tenantID,
+			CreatedAt:      toPgtypeTimestamp(post.CreatedAt()),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	if affected == 0 {
+		return repository.ErrPostNotFound
+	}
+
+	return nil
+}
+
+func (r *postRepositoryImpl) FindDue(ctx context.Context, now time.Time, limit int) ([]entity.Post, error) {
+	ctx, span := r.tracer.Start(ctx, "FindDue")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var rows []sqlc.FindDuePostsRow
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		rows, qErr = queries.FindDuePosts(ctx, sqlc.FindDuePostsParams{
+			OrganizationID: tenantID,
+			PublishBefore:  toPgtypeTimestamp(now),
+			PageLimit:      int32(limit), //nolint:gosec // callers pass a small batch size
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	posts := make([]entity.Post, len(rows))
+	for i, row := range rows {
+		posts[i] = reconstructPost(sqlc.FindPostByIDRow(row))
+	}
+
+	return posts, nil
+}
+
+func (r *postRepositoryImpl) CountWithContentSince(
+	ctx context.Context, userID uuid.UUID, content string, since time.Time,
+) (int, error) {
+	ctx, span := r.tracer.Start(ctx, "CountWithContentSince")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return 0, err
+	}
+
+	var count int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		count, qErr = queries.CountPostsWithContentSince(ctx, sqlc.CountPostsWithContentSinceParams{
+			OrganizationID: tenantID,
+			UserID:         toPgtypeUuid(userID),
+			Content:        content,
+			CreatedAt:      toPgtypeTimestamp(since),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+func (r *postRepositoryImpl) Hide(ctx context.Context, post entity.Post) error {
+	ctx, span := r.tracer.Start(ctx, "Hide")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.HidePost(ctx, sqlc.HidePostParams{
+			ID:             toPgtypeUuid(post.ID()),
+			OrganizationID: tenantID,
+			HiddenAt:       toNullablePgtypeTimestamp(post.HiddenAt()),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	if affected == 0 {
+		return repository.ErrPostNotFound
+	}
+
+	return nil
+}
+
+func (r *postRepositoryImpl) FindTrashedByID(ctx context.Context, id uuid.UUID) (entity.Post, error) {
+	ctx, span := r.tracer.Start(ctx, "FindTrashedByID")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var row sqlc.FindTrashedPostByIDRow
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		row, qErr = queries.FindTrashedPostByID(ctx, sqlc.FindTrashedPostByIDParams{
+			ID:             toPgtypeUuid(id),
+			OrganizationID: tenantID,
+		})
+
+		return qErr
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrPostNotFound
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return reconstructPost(sqlc.FindPostByIDRow(row)), nil
+}
+
+func (r *postRepositoryImpl) Trash(ctx context.Context, post entity.Post) error {
+	ctx, span := r.tracer.Start(ctx, "Trash")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.TrashPost(ctx, sqlc.TrashPostParams{
+			ID:             toPgtypeUuid(post.ID()),
+			OrganizationID: tenantID,
+			DeletedAt:      toNullablePgtypeTimestamp(post.DeletedAt()),
+			DeletedBy:      toNullablePgtypeUuidPtr(post.DeletedBy()),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	if affected == 0 {
+		return repository.ErrPostNotFound
+	}
+
+	return nil
+}
+
+func (r *postRepositoryImpl) Restore(ctx context.Context, post entity.Post) error {
+	ctx, span := r.tracer.Start(ctx, "Restore")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.RestorePost(ctx, sqlc.RestorePostParams{
+			ID:             toPgtypeUuid(post.ID()),
+			OrganizationID: tenantID,
+		})
+
+		return qErr
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation && pgErr.ConstraintName == postsRepostIdx {
+			return repository.ErrDuplicateRepost
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	if affected == 0 {
+		return repository.ErrPostNotFound
+	}
+
+	return nil
+}
+
+func (r *postRepositoryImpl) PurgeTrashed(ctx context.Context, trashedBefore time.Time, limit int) (int, error) {
+	ctx, span := r.tracer.Start(ctx, "PurgeTrashed")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return 0, err
+	}
+
+	var affected int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.PurgeTrashedPosts(ctx, sqlc.PurgeTrashedPostsParams{
+			OrganizationID: tenantID,
+			DeletedBefore:  toPgtypeTimestamp(trashedBefore),
+			PageLimit:      int32(limit), //nolint:gosec // callers pass a small batch size
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
+func reconstructPost(row sqlc.FindPostByIDRow) entity.Post {
 	return entity.ReconstructPost(
 		row.ID.Bytes,
 		row.UserID.Bytes,
 		row.Content,
+		vo.ContentFormat(row.Format),
+		vo.PostKind(row.Kind),
+		fromNullablePgtypeUuid(row.OriginalID),
+		vo.PostVisibility(row.Visibility),
+		vo.PostStatus(row.Status),
+		fromNullablePgtypeTimestamp(row.PublishAt),
+		fromNullablePgtypeTimestamp(row.HiddenAt),
+		fromNullablePgtypeTimestamp(row.DeletedAt),
+		fromNullablePgtypeUuid(row.DeletedBy),
 		row.CreatedAt.Time,
-	), nil
+		row.UpdatedAt.Time,
+	)
 }
 
 func NewPostRepository(dbManager db.DbManager) repository.PostRepository {
@@ -8,7 +8,9 @@ import (
 	"time"
 
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	domainrepository "github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
 	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -34,7 +36,23 @@ func seedUser(t *testing.T) entity.User {
 	return created
 }
 
+// purgePost deletes the post for good the only way there is: it trashes the post past its retention and purges it.
+func purgePost(t *testing.T, ctx context.Context, post entity.Post) {
+	t.Helper()
+
+	target := repository.NewPostRepository(testDb.DbManager())
+	expired := time.Now().Add(-entity.PostTrashRetention - time.Hour)
+
+	require.NoError(t, post.Trash(post.UserID(), expired))
+	require.NoError(t, target.Trash(ctx, post))
+
+	purged, err := target.PurgeTrashed(ctx, time.Now().Add(-entity.PostTrashRetention), 100)
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+}
+
 func TestCreatePost_HappyCase(t *testing.T) {
+	ctx := seedTenant(t)
 	user := seedUser(t)
 	target := repository.NewPostRepository(testDb.DbManager())
 
@@ -48,6 +66,16 @@ func TestCreatePost_HappyCase(t *testing.T) {
 				uuid.New(),
 				user.ID(),
 				"Hello, world!",
+				vo.ContentFormatPlain,
+				vo.PostKindPost,
+				nil,
+				vo.PostVisibilityPublic,
+				vo.PostStatusPublished,
+				nil,
+				nil,
+				nil,
+				nil,
+				time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC),
 				time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC),
 			),
 		},
@@ -55,7 +83,6 @@ func TestCreatePost_HappyCase(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ctx := context.Background()
 			created, err := target.Create(ctx, tt.post)
 
 			assert.NoError(t, err)
@@ -72,17 +99,29 @@ func TestCreatePost_HappyCase(t *testing.T) {
 func TestCreatePost_ForeignKeyViolation(t *testing.T) {
 	defer func() { require.NoError(t, testDb.Cleanup()) }()
 
+	ctx := seedTenant(t)
+
 	// Use a random UUID that has no corresponding user row to trigger a FK violation.
 	nonExistentUserID := uuid.New()
 	post := entity.ReconstructPost(
 		uuid.New(),
 		nonExistentUserID,
 		"this should fail",
+		vo.ContentFormatPlain,
+		vo.PostKindPost,
+		nil,
+		vo.PostVisibilityPublic,
+		vo.PostStatusPublished,
+		nil,
+		nil,
+		nil,
+		nil,
+		time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC),
 		time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC),
 	)
 
 	target := repository.NewPostRepository(testDb.DbManager())
-	_, err := target.Create(context.Background(), post)
+	_, err := target.Create(ctx, post)
 
 	require.Error(t, err)
 }
@@ -90,6 +129,7 @@ func TestCreatePost_ForeignKeyViolation(t *testing.T) {
 func TestCreatePost_DuplicateID(t *testing.T) {
 	defer func() { require.NoError(t, testDb.Cleanup()) }()
 
+	ctx := seedTenant(t)
 	user := seedUser(t)
 	fixedID := uuid.New()
 
@@ -97,21 +137,482 @@ func TestCreatePost_DuplicateID(t *testing.T) {
 		fixedID,
 		user.ID(),
 		"first post",
+		vo.ContentFormatPlain,
+		vo.PostKindPost,
+		nil,
+		vo.PostVisibilityPublic,
+		vo.PostStatusPublished,
+		nil,
+		nil,
+		nil,
+		nil,
+		time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC),
 		time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC),
 	)
 
 	target := repository.NewPostRepository(testDb.DbManager())
 
-	_, err := target.Create(context.Background(), post)
+	_, err := target.Create(ctx, post)
 	require.NoError(t, err)
 
 	duplicate := entity.ReconstructPost(
 		fixedID,
 		user.ID(),
 		"duplicate post",
+		vo.ContentFormatPlain,
+		vo.PostKindPost,
+		nil,
+		vo.PostVisibilityPublic,
+		vo.PostStatusPublished,
+		nil,
+		nil,
+		nil,
+		nil,
+		time.Date(2026, 3, 8, 13, 0, 0, 0, time.UTC),
 		time.Date(2026, 3, 8, 13, 0, 0, 0, time.UTC),
 	)
-	_, err = target.Create(context.Background(), duplicate)
+	_, err = target.Create(ctx, duplicate)
 
 	require.Error(t, err)
 }
+
+func TestCreatePost_NoActiveTenant(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	user := seedUser(t)
+	post := entity.ReconstructPost(
+		uuid.New(),
+		user.ID(),
+		"no tenant",
+		vo.ContentFormatPlain,
+		vo.PostKindPost,
+		nil,
+		vo.PostVisibilityPublic,
+		vo.PostStatusPublished,
+		nil,
+		nil,
+		nil,
+		nil,
+		time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC),
+	)
+
+	target := repository.NewPostRepository(testDb.DbManager())
+	_, err := target.Create(context.Background(), post)
+
+	require.ErrorIs(t, err, db.ErrNoActiveTenant)
+}
+
+func TestPostRepository_FindUpdateDelete_HappyCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	user := seedUser(t)
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	target := repository.NewPostRepository(testDb.DbManager())
+
+	created, err := target.Create(ctx, entity.ReconstructPost(
+		uuid.New(),
+		user.ID(),
+		"typo",
+		vo.ContentFormatPlain,
+		vo.PostKindPost,
+		nil,
+		vo.PostVisibilityPublic,
+		vo.PostStatusPublished,
+		nil,
+		nil,
+		nil,
+		nil,
+		createdAt,
+		createdAt,
+	))
+	require.NoError(t, err)
+
+	found, err := target.FindByID(ctx, created.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "typo", found.Content())
+	assert.Equal(t, vo.ContentFormatPlain, found.Format())
+	assert.True(t, createdAt.Equal(found.UpdatedAt()))
+
+	editedAt := createdAt.Add(time.Hour)
+	require.NoError(t, found.Edit("*fixed*", "markdown", editedAt))
+	require.NoError(t, target.Update(ctx, found))
+
+	found, err = target.FindByID(ctx, created.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "*fixed*", found.Content())
+	assert.Equal(t, vo.ContentFormatMarkdown, found.Format())
+	assert.True(t, createdAt.Equal(found.CreatedAt()))
+	assert.True(t, editedAt.Equal(found.UpdatedAt()))
+
+	require.NoError(t, found.Trash(user.ID(), editedAt))
+	require.NoError(t, target.Trash(ctx, found))
+
+	_, err = target.FindByID(ctx, created.ID())
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+}
+
+func TestPostRepository_OtherTenant_NotFound(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherTenant := seedTenant(t)
+	user := seedUser(t)
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	target := repository.NewPostRepository(testDb.DbManager())
+
+	created, err := target.Create(ctx, entity.ReconstructPost(
+		uuid.New(),
+		user.ID(),
+		"mine",
+		vo.ContentFormatPlain,
+		vo.PostKindPost,
+		nil,
+		vo.PostVisibilityPublic,
+		vo.PostStatusPublished,
+		nil,
+		nil,
+		nil,
+		nil,
+		createdAt,
+		createdAt,
+	))
+	require.NoError(t, err)
+
+	_, err = target.FindByID(otherTenant, created.ID())
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+	require.ErrorIs(t, target.Update(otherTenant, created), domainrepository.ErrPostNotFound)
+	require.NoError(t, created.Trash(user.ID(), createdAt))
+	require.ErrorIs(t, target.Trash(otherTenant, created), domainrepository.ErrPostNotFound)
+
+	_, err = target.FindTrashedByID(ctx, created.ID())
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+
+	// The post is untouched in its own tenant.
+	found, err := target.FindByID(ctx, created.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "mine", found.Content())
+}
+
+func TestPostRepository_PublishDue(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherTenant := seedTenant(t)
+	user := seedUser(t)
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	target := repository.NewPostRepository(testDb.DbManager())
+
+	createDraft := func(ctx context.Context, content string, publishAt *time.Time) entity.Post {
+		created, err := target.Create(ctx, entity.ReconstructPost(
+			uuid.New(), user.ID(), content, vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityFollowers,
+			vo.PostStatusDraft, publishAt, nil, nil, nil, createdAt, createdAt,
+		))
+		require.NoError(t, err)
+
+		return created
+	}
+
+	first, second, later := createdAt.Add(time.Hour), createdAt.Add(2*time.Hour), createdAt.Add(time.Hour*24)
+	dueSecond := createDraft(ctx, "second", &second)
+	dueFirst := createDraft(ctx, "first", &first)
+	createDraft(ctx, "later", &later)
+	createDraft(ctx, "unscheduled", nil)
+	createDraft(otherTenant, "elsewhere", &first)
+
+	// Only scheduled drafts of the tenant whose time has come are due, earliest first.
+	due, err := target.FindDue(ctx, second, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	assert.Equal(t, dueFirst.ID(), due[0].ID())
+	assert.Equal(t, dueSecond.ID(), due[1].ID())
+	assert.Equal(t, vo.PostVisibilityFollowers, due[0].Visibility())
+	assert.True(t, first.Equal(*due[0].PublishAt()))
+
+	limited, err := target.FindDue(ctx, second, 1)
+	require.NoError(t, err)
+	require.Len(t, limited, 1)
+
+	require.NoError(t, due[0].Publish(second))
+	require.NoError(t, target.Publish(ctx, due[0]))
+
+	found, err := target.FindByID(ctx, dueFirst.ID())
+	require.NoError(t, err)
+	assert.Equal(t, vo.PostStatusPublished, found.Status())
+	assert.Nil(t, found.PublishAt())
+	assert.True(t, second.Equal(found.CreatedAt()))
+
+	// A post is published once only.
+	require.ErrorIs(t, target.Publish(ctx, due[0]), domainrepository.ErrPostNotFound)
+
+	due, err = target.FindDue(ctx, second, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, dueSecond.ID(), due[0].ID())
+}
+
+func TestPostRepository_Hide(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherTenant := seedTenant(t)
+	user := seedUser(t)
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	target := repository.NewPostRepository(testDb.DbManager())
+
+	created, err := target.Create(ctx, entity.ReconstructPost(
+		uuid.New(), user.ID(), "abuse", vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityPublic,
+		vo.PostStatusPublished, nil, nil, nil, nil, createdAt, createdAt,
+	))
+	require.NoError(t, err)
+
+	hiddenAt := createdAt.Add(time.Hour)
+	require.NoError(t, created.Hide(hiddenAt))
+	require.ErrorIs(t, target.Hide(otherTenant, created), domainrepository.ErrPostNotFound)
+	require.NoError(t, target.Hide(ctx, created))
+
+	found, err := target.FindByID(ctx, created.ID())
+	require.NoError(t, err)
+	require.NotNil(t, found.HiddenAt())
+	assert.True(t, hiddenAt.Equal(*found.HiddenAt()))
+
+	// A post is hidden once only.
+	require.ErrorIs(t, target.Hide(ctx, created), domainrepository.ErrPostNotFound)
+}
+
+func TestPostRepository_CountWithContentSince(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherTenant := seedTenant(t)
+	user := seedMember(t, ctx, "author@example.com")
+	other := seedMember(t, ctx, "other@example.com")
+	since := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	target := repository.NewPostRepository(testDb.DbManager())
+
+	create := func(ctx context.Context, userID uuid.UUID, content string, createdAt time.Time) {
+		_, err := target.Create(ctx, entity.ReconstructPost(
+			uuid.New(), userID, content, vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityPublic,
+			vo.PostStatusPublished, nil, nil, nil, nil, createdAt, createdAt,
+		))
+		require.NoError(t, err)
+	}
+
+	create(ctx, user.ID(), "buy now", since)
+	create(ctx, user.ID(), "buy now", since.Add(time.Minute))
+	create(ctx, user.ID(), "buy now", since.Add(-time.Minute))
+	create(ctx, user.ID(), "buy later", since.Add(time.Minute))
+	create(ctx, other.ID(), "buy now", since.Add(time.Minute))
+	create(otherTenant, user.ID(), "buy now", since.Add(time.Minute))
+
+	count, err := target.CountWithContentSince(ctx, user.ID(), "buy now", since)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestPostRepository_TrashRestorePurge(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherTenant := seedTenant(t)
+	user := seedMember(t, ctx, "author@example.com")
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	target := repository.NewPostRepository(testDb.DbManager())
+
+	create := func(content string) entity.Post {
+		created, err := target.Create(ctx, entity.ReconstructPost(
+			uuid.New(), user.ID(), content, vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityPublic,
+			vo.PostStatusPublished, nil, nil, nil, nil, now.Add(-60*24*time.Hour), now.Add(-60*24*time.Hour),
+		))
+		require.NoError(t, err)
+
+		return created
+	}
+
+	recent, expired := create("recent"), create("expired")
+
+	require.NoError(t, recent.Trash(user.ID(), now))
+	require.NoError(t, target.Trash(ctx, recent))
+	require.NoError(t, expired.Trash(user.ID(), now.Add(-entity.PostTrashRetention-time.Hour)))
+	require.NoError(t, target.Trash(ctx, expired))
+
+	// A post is trashed once only, and a trashed post is out of reach of FindByID and updates.
+	require.ErrorIs(t, target.Trash(ctx, recent), domainrepository.ErrPostNotFound)
+	_, err := target.FindByID(ctx, recent.ID())
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+	require.ErrorIs(t, target.Update(ctx, recent), domainrepository.ErrPostNotFound)
+
+	found, err := target.FindTrashedByID(ctx, recent.ID())
+	require.NoError(t, err)
+	require.NotNil(t, found.DeletedAt())
+	assert.True(t, now.Equal(*found.DeletedAt()))
+	assert.Equal(t, user.ID(), *found.DeletedBy())
+
+	_, err = target.FindTrashedByID(otherTenant, recent.ID())
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+
+	// Only the posts past the retention are purged, and only in the active organization.
+	purged, err := target.PurgeTrashed(otherTenant, now.Add(-entity.PostTrashRetention), 100)
+	require.NoError(t, err)
+	assert.Zero(t, purged)
+
+	purged, err = target.PurgeTrashed(ctx, now.Add(-entity.PostTrashRetention), 100)
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+
+	_, err = target.FindTrashedByID(ctx, expired.ID())
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+
+	require.ErrorIs(t, target.Restore(otherTenant, found), domainrepository.ErrPostNotFound)
+	require.NoError(t, found.Restore(now))
+	require.NoError(t, target.Restore(ctx, found))
+	require.ErrorIs(t, target.Restore(ctx, found), domainrepository.ErrPostNotFound)
+
+	restored, err := target.FindByID(ctx, recent.ID())
+	require.NoError(t, err)
+	assert.Nil(t, restored.DeletedAt())
+	assert.Nil(t, restored.DeletedBy())
+}
+
+func TestPostRepository_CreateMany_RollsBackWithTransaction(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedUser(t)
+	postRepo := repository.NewPostRepository(testDb.DbManager())
+
+	post, err := entity.NewPost(entity.PostParams{
+		UserID:     author.ID(),
+		Content:    "imported",
+		Format:     "plain",
+		Visibility: "public",
+		CreatedAt:  time.Now(),
+	})
+	require.NoError(t, err)
+
+	// A post whose author does not exist fails the whole batch.
+	orphan, err := entity.NewPost(entity.PostParams{
+		UserID:     uuid.New(),
+		Content:    "orphan",
+		Format:     "plain",
+		Visibility: "public",
+		CreatedAt:  time.Now(),
+	})
+	require.NoError(t, err)
+
+	err = db.NewTransactionManger(testDb.Pool()).Do(ctx, func(ctx context.Context) error {
+		return postRepo.CreateMany(ctx, []entity.Post{post, orphan})
+	})
+	require.Error(t, err)
+
+	_, err = postRepo.FindByID(ctx, post.ID())
+	assert.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+}
+
+func TestPostRepository_Reposts(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	reposter := seedMember(t, ctx, "reposter@example.com")
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	target := repository.NewPostRepository(testDb.DbManager())
+
+	original, err := entity.NewPost(entity.PostParams{
+		UserID:     author.ID(),
+		Content:    "original",
+		Format:     "plain",
+		Visibility: "public",
+		CreatedAt:  now,
+	})
+	require.NoError(t, err)
+	original, err = target.Create(ctx, original)
+	require.NoError(t, err)
+
+	repost, err := entity.NewRepost(reposter.ID(), original, now)
+	require.NoError(t, err)
+
+	// A post is reposted once per user; reposting it again stores nothing.
+	created, err := target.CreateRepost(ctx, repost)
+	require.NoError(t, err)
+	assert.True(t, created)
+
+	again, err := entity.NewRepost(reposter.ID(), original, now.Add(time.Minute))
+	require.NoError(t, err)
+	created, err = target.CreateRepost(ctx, again)
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	found, err := target.FindByID(ctx, repost.ID())
+	require.NoError(t, err)
+	assert.Equal(t, vo.PostKindRepost, found.Kind())
+	assert.Equal(t, original.ID(), *found.OriginalID())
+	assert.Empty(t, found.Content())
+
+	// A trashed repost does not count: the post can be reposted again, and the trashed one no longer restored.
+	require.NoError(t, found.Trash(reposter.ID(), now))
+	require.NoError(t, target.Trash(ctx, found))
+
+	created, err = target.CreateRepost(ctx, again)
+	require.NoError(t, err)
+	assert.True(t, created)
+
+	require.NoError(t, found.Restore(now))
+	require.ErrorIs(t, target.Restore(ctx, found), domainrepository.ErrDuplicateRepost)
+
+	// Undoing a repost deletes it for good, and only the live one.
+	deleted, err := target.DeleteRepost(ctx, reposter.ID(), original.ID())
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	deleted, err = target.DeleteRepost(ctx, reposter.ID(), original.ID())
+	require.NoError(t, err)
+	assert.False(t, deleted)
+
+	_, err = target.FindByID(ctx, again.ID())
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+
+	_, err = target.FindTrashedByID(ctx, repost.ID())
+	require.NoError(t, err)
+
+	// Purging the original keeps the shares, which no longer point anywhere.
+	quote, err := entity.NewQuotePost(original, entity.PostParams{UserID: author.ID(), Content: "look", CreatedAt: now})
+	require.NoError(t, err)
+	quote, err = target.Create(ctx, quote)
+	require.NoError(t, err)
+
+	purgePost(t, ctx, original)
+
+	found, err = target.FindByID(ctx, quote.ID())
+	require.NoError(t, err)
+	assert.Equal(t, vo.PostKindQuote, found.Kind())
+	assert.Nil(t, found.OriginalID())
+}
+
+func TestPostRepository_Shares_BlockedAuthor(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	blocked := seedMember(t, ctx, "blocked@example.com")
+	original := seedCommentPost(t, ctx, author.ID())
+	seedBlock(t, ctx, author.ID(), blocked.ID())
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	target := repository.NewPostRepository(testDb.DbManager())
+
+	// The original is hidden from a user its author blocked, so it can be neither reposted nor quoted.
+	repost, err := entity.NewRepost(blocked.ID(), original, now)
+	require.NoError(t, err)
+	created, err := target.CreateRepost(ctx, repost)
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	quote, err := entity.NewQuotePost(original, entity.PostParams{UserID: blocked.ID(), Content: "look", CreatedAt: now})
+	require.NoError(t, err)
+	_, err = target.Create(ctx, quote)
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+
+	_, err = target.FindByID(ctx, quote.ID())
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+}
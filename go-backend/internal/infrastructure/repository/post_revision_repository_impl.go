@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type postRevisionRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *postRevisionRepositoryImpl) Create(
+	ctx context.Context, revision entity.PostRevision,
+) (entity.PostRevision, error) {
+	ctx, span := r.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var row sqlc.CreatePostRevisionRow
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		row, qErr = queries.CreatePostRevision(ctx, sqlc.CreatePostRevisionParams{
+			ID:             toPgtypeUuid(revision.ID()),
+			OrganizationID: tenantID,
+			PostID:         toPgtypeUuid(revision.PostID()),
+			Content:        revision.Content(),
+			Format:         revision.Format().String(),
+			EditorID:       toNullablePgtypeUuid(revision.EditorID()),
+			CreatedAt:      toPgtypeTimestamp(revision.CreatedAt()),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrPostNotFound
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return entity.ReconstructPostRevision(
+		row.ID.Bytes,
+		row.PostID.Bytes,
+		int(row.Number),
+		row.Content,
+		vo.ContentFormat(row.Format),
+		row.EditorID.Bytes,
+		row.CreatedAt.Time,
+	), nil
+}
+
+// postRevisionImportCopy stages the revisions stored by CreateInitial.
+var postRevisionImportCopy = stagedCopy{
+	table: "post_revision_imports",
+	createSQL: `create temporary table if not exists post_revision_imports (
+  id uuid, post_id uuid, content text, format varchar(16), editor_id uuid, created_at timestamp
+) on commit drop`,
+	columns: []string{"id", "post_id", "content", "format", "editor_id", "created_at"},
+	insertSQL: `insert into post_revisions(id, organization_id, post_id, number, content, format, editor_id, created_at)
+select id, $1, post_id, 1, content, format, editor_id, created_at
+from post_revision_imports`,
+}
+
+func (r *postRevisionRepositoryImpl) CreateInitial(ctx context.Context, revisions []entity.PostRevision) error {
+	ctx, span := r.tracer.Start(ctx, "CreateInitial")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	rows := make([][]any, 0, len(revisions))
+	for _, revision := range revisions {
+		rows = append(rows, []any{
+			toPgtypeUuid(revision.ID()),
+			toPgtypeUuid(revision.PostID()),
+			revision.Content(),
+			revision.Format().String(),
+			toNullablePgtypeUuid(revision.EditorID()),
+			toPgtypeTimestamp(revision.CreatedAt()),
+		})
+	}
+
+	err = r.dbManager.TxFunc(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return postRevisionImportCopy.run(ctx, tx, rows, tenantID)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *postRevisionRepositoryImpl) CountByPostID(ctx context.Context, postID uuid.UUID) (int, error) {
+	ctx, span := r.tracer.Start(ctx, "CountByPostID")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return 0, err
+	}
+
+	var count int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		count, qErr = queries.CountPostRevisions(ctx, sqlc.CountPostRevisionsParams{
+			PostID:         toPgtypeUuid(postID),
+			OrganizationID: tenantID,
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+func NewPostRevisionRepository(dbManager db.DbManager) repository.PostRevisionRepository {
+	return &postRevisionRepositoryImpl{
+		tracer:    otel.Tracer("PostRevisionRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,213 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	domainrepository "github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostRevisionRepository_Create_NumbersRevisionsPerPost(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedUser(t)
+	editor := seedActor(t)
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	postRepo := repository.NewPostRepository(testDb.DbManager())
+	target := repository.NewPostRevisionRepository(testDb.DbManager())
+
+	post, err := postRepo.Create(ctx, entity.ReconstructPost(
+		uuid.New(),
+		author.ID(),
+		"typo",
+		vo.ContentFormatPlain,
+		vo.PostKindPost,
+		nil,
+		vo.PostVisibilityPublic,
+		vo.PostStatusPublished,
+		nil,
+		nil,
+		nil,
+		nil,
+		createdAt,
+		createdAt,
+	))
+	require.NoError(t, err)
+	other, err := postRepo.Create(ctx, entity.ReconstructPost(
+		uuid.New(),
+		author.ID(),
+		"other",
+		vo.ContentFormatPlain,
+		vo.PostKindPost,
+		nil,
+		vo.PostVisibilityPublic,
+		vo.PostStatusPublished,
+		nil,
+		nil,
+		nil,
+		nil,
+		createdAt,
+		createdAt,
+	))
+	require.NoError(t, err)
+
+	original, err := entity.NewPostRevision(post, author.ID())
+	require.NoError(t, err)
+	first, err := target.Create(ctx, original)
+	require.NoError(t, err)
+	assert.Equal(t, 1, first.Number())
+	assert.Equal(t, original.ID(), first.ID())
+	assert.Equal(t, post.ID(), first.PostID())
+	assert.Equal(t, "typo", first.Content())
+	assert.Equal(t, vo.ContentFormatPlain, first.Format())
+	assert.Equal(t, author.ID(), first.EditorID())
+	assert.True(t, createdAt.Equal(first.CreatedAt()))
+
+	require.NoError(t, post.Edit("fixed", "markdown", createdAt.Add(time.Hour)))
+	edit, err := entity.NewPostRevision(post, editor.ID())
+	require.NoError(t, err)
+	second, err := target.Create(ctx, edit)
+	require.NoError(t, err)
+	assert.Equal(t, 2, second.Number())
+	assert.Equal(t, vo.ContentFormatMarkdown, second.Format())
+	assert.Equal(t, editor.ID(), second.EditorID())
+
+	// Numbering is per post.
+	otherOriginal, err := entity.NewPostRevision(other, author.ID())
+	require.NoError(t, err)
+	otherFirst, err := target.Create(ctx, otherOriginal)
+	require.NoError(t, err)
+	assert.Equal(t, 1, otherFirst.Number())
+
+	count, err := target.CountByPostID(ctx, post.ID())
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestPostRevisionRepository_OtherTenant(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherTenant := seedTenant(t)
+	author := seedUser(t)
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	target := repository.NewPostRevisionRepository(testDb.DbManager())
+
+	post, err := repository.NewPostRepository(testDb.DbManager()).
+		Create(ctx, entity.ReconstructPost(
+			uuid.New(),
+			author.ID(),
+			"mine",
+			vo.ContentFormatPlain,
+			vo.PostKindPost,
+			nil,
+			vo.PostVisibilityPublic,
+			vo.PostStatusPublished,
+			nil,
+			nil,
+			nil,
+			nil,
+			createdAt,
+			createdAt,
+		))
+	require.NoError(t, err)
+
+	revision, err := entity.NewPostRevision(post, author.ID())
+	require.NoError(t, err)
+	_, err = target.Create(ctx, revision)
+	require.NoError(t, err)
+
+	// Another tenant can neither append to the post's history nor count it.
+	intruder, err := entity.NewPostRevision(post, author.ID())
+	require.NoError(t, err)
+	_, err = target.Create(otherTenant, intruder)
+	require.ErrorIs(t, err, domainrepository.ErrPostNotFound)
+
+	count, err := target.CountByPostID(otherTenant, post.ID())
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	_, err = target.Create(context.Background(), intruder)
+	require.ErrorIs(t, err, db.ErrNoActiveTenant)
+}
+
+func TestPostRevisionRepository_CreateInitial_ImportedPosts(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedUser(t)
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	postRepo := repository.NewPostRepository(testDb.DbManager())
+	target := repository.NewPostRevisionRepository(testDb.DbManager())
+
+	var posts []entity.Post
+
+	var revisions []entity.PostRevision
+
+	for i := range 3 {
+		post, err := entity.NewPost(entity.PostParams{
+			UserID:     author.ID(),
+			Content:    "imported",
+			Format:     "markdown",
+			Visibility: "followers",
+			CreatedAt:  createdAt.Add(time.Duration(i) * time.Hour),
+		})
+		require.NoError(t, err)
+
+		revision, err := entity.NewPostRevision(post, author.ID())
+		require.NoError(t, err)
+
+		posts = append(posts, post)
+		revisions = append(revisions, revision)
+	}
+
+	// Two batches in one transaction reuse the staging tables.
+	txManager := db.NewTransactionManger(testDb.Pool())
+	err := txManager.Do(ctx, func(ctx context.Context) error {
+		for _, batch := range [][2]int{{0, 2}, {2, 3}} {
+			if err := postRepo.CreateMany(ctx, posts[batch[0]:batch[1]]); err != nil {
+				return err
+			}
+
+			if err := target.CreateInitial(ctx, revisions[batch[0]:batch[1]]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	for i, post := range posts {
+		found, err := postRepo.FindByID(ctx, post.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "imported", found.Content())
+		assert.Equal(t, vo.ContentFormatMarkdown, found.Format())
+		assert.Equal(t, vo.PostVisibilityFollowers, found.Visibility())
+		assert.Equal(t, vo.PostStatusPublished, found.Status())
+		assert.True(t, createdAt.Add(time.Duration(i)*time.Hour).Equal(found.CreatedAt()))
+
+		count, err := target.CountByPostID(ctx, post.ID())
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	}
+
+	// The next edit is numbered after the imported revision.
+	require.NoError(t, posts[0].Edit("edited", "plain", createdAt.Add(24*time.Hour)))
+	edit, err := entity.NewPostRevision(posts[0], author.ID())
+	require.NoError(t, err)
+	stored, err := target.Create(ctx, edit)
+	require.NoError(t, err)
+	assert.Equal(t, 2, stored.Number())
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// reactionCounterShards is the number of counter rows per post and reaction type. Reactions by different
+// users to the same post spread over the shards instead of queueing on one row lock.
+const reactionCounterShards = 16
+
+type reactionRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *reactionRepositoryImpl) Add(ctx context.Context, reaction entity.Reaction) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "Add")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	var added bool
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		affected, qErr := queries.CreatePostReaction(ctx, sqlc.CreatePostReactionParams{
+			UserID:         toPgtypeUuid(reaction.UserID()),
+			Type:           reaction.Type().String(),
+			CreatedAt:      toPgtypeTimestamp(reaction.CreatedAt()),
+			PostID:         toPgtypeUuid(reaction.PostID()),
+			OrganizationID: tenantID,
+		})
+		if qErr != nil || affected == 0 {
+			return qErr
+		}
+
+		added = true
+
+		return addReactionCount(ctx, queries, tenantID, reaction.PostID(), reaction.UserID(), reaction.Type(), 1)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	return added, nil
+}
+
+func (r *reactionRepositoryImpl) Remove(
+	ctx context.Context, postID, userID uuid.UUID, reactionType vo.ReactionType,
+) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "Remove")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	var removed bool
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		affected, qErr := queries.DeletePostReaction(ctx, sqlc.DeletePostReactionParams{
+			PostID:         toPgtypeUuid(postID),
+			UserID:         toPgtypeUuid(userID),
+			Type:           reactionType.String(),
+			OrganizationID: tenantID,
+		})
+		if qErr != nil || affected == 0 {
+			return qErr
+		}
+
+		removed = true
+
+		return addReactionCount(ctx, queries, tenantID, postID, userID, reactionType, -1)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return false, err
+	}
+
+	return removed, nil
+}
+
+// addReactionCount moves the count on the shard of userID, so that removing a reaction undoes the
+// increment on the very row its addition made.
+func addReactionCount(
+	ctx context.Context, queries sqlc.Queries, tenantID pgtype.UUID,
+	postID, userID uuid.UUID, reactionType vo.ReactionType, delta int64,
+) error {
+	return queries.AddPostReactionCount(ctx, sqlc.AddPostReactionCountParams{
+		OrganizationID: tenantID,
+		PostID:         toPgtypeUuid(postID),
+		Type:           reactionType.String(),
+		Shard:          reactionShardOf(userID),
+		Delta:          delta,
+	})
+}
+
+// reactionShardOf picks the shard from the last bytes of the ID, which are random in both UUIDv4 and v7.
+func reactionShardOf(userID uuid.UUID) int16 {
+	//nolint:gosec // the remainder is below reactionCounterShards
+	return int16(binary.BigEndian.Uint16(userID[14:]) % reactionCounterShards)
+}
+
+func NewReactionRepository(dbManager db.DbManager) repository.ReactionRepository {
+	return &reactionRepositoryImpl{
+		tracer:    otel.Tracer("ReactionRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
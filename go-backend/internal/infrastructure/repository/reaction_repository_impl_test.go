@@ -0,0 +1,160 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func reactionCount(t *testing.T, ctx context.Context, postID uuid.UUID, reactionType vo.ReactionType) int {
+	t.Helper()
+
+	var count int
+
+	inTenant(t, ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return tx.QueryRow(ctx,
+			"SELECT COALESCE(SUM(count), 0) FROM post_reaction_counts WHERE post_id = $1 AND type = $2",
+			postID, reactionType.String(),
+		).Scan(&count)
+	})
+
+	return count
+}
+
+func TestReactionRepository_AddRemove_Idempotent(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	user := seedUser(t)
+	post := seedCommentPost(t, ctx, user.ID())
+	target := repository.NewReactionRepository(testDb.DbManager())
+
+	reaction, err := entity.NewReaction(post.ID(), user.ID(), "like", time.Now())
+	require.NoError(t, err)
+
+	added, err := target.Add(ctx, reaction)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	// The same reaction again changes neither the reactions nor the count.
+	added, err = target.Add(ctx, reaction)
+	require.NoError(t, err)
+	assert.False(t, added)
+	assert.Equal(t, 1, reactionCount(t, ctx, post.ID(), vo.ReactionTypeLike))
+
+	removed, err := target.Remove(ctx, post.ID(), user.ID(), vo.ReactionTypeLike)
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	removed, err = target.Remove(ctx, post.ID(), user.ID(), vo.ReactionTypeLike)
+	require.NoError(t, err)
+	assert.False(t, removed)
+	assert.Equal(t, 0, reactionCount(t, ctx, post.ID(), vo.ReactionTypeLike))
+}
+
+func TestReactionRepository_CountsAcrossShards(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedUser(t)
+	post := seedCommentPost(t, ctx, author.ID())
+	target := repository.NewReactionRepository(testDb.DbManager())
+
+	// Enough users to spread the count over several shards.
+	const reactors = 40
+
+	userRepo := repository.NewUserRepository(testDb.DbManager())
+	userIDs := make([]uuid.UUID, reactors)
+
+	for i := range userIDs {
+		user, err := userRepo.Create(context.Background(), entity.ReconstructUser(
+			uuid.New(), fmt.Sprintf("reactor%d@example.com", i), []byte("password"), "Reactor",
+			vo.UserStatusActive, time.Now(),
+		))
+		require.NoError(t, err)
+
+		userIDs[i] = user.ID()
+
+		reaction, err := entity.NewReaction(post.ID(), user.ID(), "wow", time.Now())
+		require.NoError(t, err)
+		_, err = target.Add(ctx, reaction)
+		require.NoError(t, err)
+	}
+
+	for _, id := range userIDs[:10] {
+		_, err := target.Remove(ctx, post.ID(), id, vo.ReactionTypeWow)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, reactors-10, reactionCount(t, ctx, post.ID(), vo.ReactionTypeWow))
+
+	var shards, negative int
+
+	inTenant(t, ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return tx.QueryRow(ctx,
+			"SELECT COUNT(*), COUNT(*) FILTER (WHERE count < 0) FROM post_reaction_counts WHERE post_id = $1",
+			post.ID(),
+		).Scan(&shards, &negative)
+	})
+	assert.Greater(t, shards, 1)
+	assert.Zero(t, negative)
+}
+
+func TestReactionRepository_OtherTenant(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherTenant := seedTenant(t)
+	user := seedUser(t)
+	post := seedCommentPost(t, ctx, user.ID())
+	target := repository.NewReactionRepository(testDb.DbManager())
+
+	reaction, err := entity.NewReaction(post.ID(), user.ID(), "love", time.Now())
+	require.NoError(t, err)
+
+	// Another tenant's post is invisible, so nothing is added there.
+	added, err := target.Add(otherTenant, reaction)
+	require.NoError(t, err)
+	assert.False(t, added)
+	assert.Equal(t, 0, reactionCount(t, ctx, post.ID(), vo.ReactionTypeLove))
+
+	added, err = target.Add(ctx, reaction)
+	require.NoError(t, err)
+	require.True(t, added)
+
+	removed, err := target.Remove(otherTenant, post.ID(), user.ID(), vo.ReactionTypeLove)
+	require.NoError(t, err)
+	assert.False(t, removed)
+	assert.Equal(t, 1, reactionCount(t, ctx, post.ID(), vo.ReactionTypeLove))
+}
+
+func TestReactionRepository_Add_BlockedAuthor(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	blocked := seedMember(t, ctx, "blocked@example.com")
+	post := seedCommentPost(t, ctx, author.ID())
+	seedBlock(t, ctx, author.ID(), blocked.ID())
+	target := repository.NewReactionRepository(testDb.DbManager())
+
+	reaction, err := entity.NewReaction(post.ID(), blocked.ID(), "like", time.Now())
+	require.NoError(t, err)
+
+	// The post is hidden from a user its author blocked, so nothing is added.
+	added, err := target.Add(ctx, reaction)
+	require.NoError(t, err)
+	assert.False(t, added)
+	assert.Equal(t, 0, reactionCount(t, ctx, post.ID(), vo.ReactionTypeLike))
+}
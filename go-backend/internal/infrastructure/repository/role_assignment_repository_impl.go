@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	pgForeignKeyViolation = "23503"
+
+	userRolesRoleIDFkey = "user_roles_role_id_fkey"
+	userRolesUserIDFkey = "user_roles_user_id_fkey"
+)
+
+var (
+	errRoleNotExist = errors.New("role does not exist")
+	errUserNotExist = errors.New("user does not exist")
+)
+
+type roleAssignmentRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *roleAssignmentRepositoryImpl) Grant(
+	ctx context.Context, assignment entity.RoleAssignment,
+) (entity.RoleAssignment, error) {
+	ctx, span := r.tracer.Start(ctx, "Grant")
+	defer span.End()
+
+	var row sqlc.UserRole
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		row, qErr = queries.UpsertUserRole(ctx, sqlc.UpsertUserRoleParams{
+			UserID:    toPgtypeUuid(assignment.UserID()),
+			RoleID:    toPgtypeUuid(assignment.RoleID()),
+			GrantedBy: toNullablePgtypeUuid(assignment.GrantedBy()),
+			GrantedAt: toPgtypeTimestamp(assignment.GrantedAt()),
+			ExpiresAt: toNullablePgtypeTimestamp(assignment.ExpiresAt()),
+			Reason:    toNullablePgtypeText(assignment.Reason()),
+		})
+		if qErr != nil {
+			return qErr
+		}
+
+		return queries.CreateUserRoleHistory(ctx, sqlc.CreateUserRoleHistoryParams{
+			UserID:    row.UserID,
+			RoleID:    row.RoleID,
+			Action:    vo.RoleAssignmentActionGranted.String(),
+			ActorID:   row.GrantedBy,
+			Reason:    row.Reason,
+			ExpiresAt: row.ExpiresAt,
+			CreatedAt: row.GrantedAt,
+		})
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+			switch pgErr.ConstraintName {
+			case userRolesRoleIDFkey:
+				return nil, vo.NewValidationError("role does not exist", nil, errRoleNotExist)
+			case userRolesUserIDFkey:
+				return nil, vo.NewValidationError("user does not exist", nil, errUserNotExist)
+			}
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return toRoleAssignment(row), nil
+}
+
+func (r *roleAssignmentRepositoryImpl) DeleteExpired(ctx context.Context) ([]entity.RoleAssignment, error) {
+	ctx, span := r.tracer.Start(ctx, "DeleteExpired")
+	defer span.End()
+
+	var rows []sqlc.UserRole
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		rows, qErr = queries.DeleteExpiredUserRoles(ctx)
+		if qErr != nil {
+			return qErr
+		}
+
+		sweptAt := toPgtypeTimestamp(time.Now())
+
+		for _, row := range rows {
+			// The expiry is a system action, so actor_id stays NULL; the original reason is kept for context.
+			qErr = queries.CreateUserRoleHistory(ctx, sqlc.CreateUserRoleHistoryParams{
+				UserID:    row.UserID,
+				RoleID:    row.RoleID,
+				Action:    vo.RoleAssignmentActionExpired.String(),
+				Reason:    row.Reason,
+				ExpiresAt: row.ExpiresAt,
+				CreatedAt: sweptAt,
+			})
+			if qErr != nil {
+				return qErr
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	assignments := make([]entity.RoleAssignment, 0, len(rows))
+	for _, row := range rows {
+		assignments = append(assignments, toRoleAssignment(row))
+	}
+
+	return assignments, nil
+}
+
+func toRoleAssignment(row sqlc.UserRole) entity.RoleAssignment {
+	return entity.ReconstructRoleAssignment(
+		row.UserID.Bytes,
+		row.RoleID.Bytes,
+		row.GrantedBy.Bytes, // zero value (uuid.Nil) when NULL
+		row.GrantedAt.Time,
+		fromNullablePgtypeTimestamp(row.ExpiresAt),
+		row.Reason.String,
+	)
+}
+
+func NewRoleAssignmentRepository(dbManager db.DbManager) repository.RoleAssignmentRepository {
+	return &roleAssignmentRepositoryImpl{
+		tracer:    otel.Tracer("RoleAssignmentRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
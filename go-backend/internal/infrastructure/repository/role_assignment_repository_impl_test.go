@@ -0,0 +1,153 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seedActor(t *testing.T) entity.User {
+	t.Helper()
+
+	actor := entity.ReconstructUser(
+		uuid.New(),
+		"actor@example.com",
+		[]byte("password"),
+		"Actor",
+		vo.UserStatusActive,
+		time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC),
+	)
+
+	created, err := repository.NewUserRepository(testDb.DbManager()).Create(context.Background(), actor)
+	require.NoError(t, err)
+
+	return created
+}
+
+func countHistories(t *testing.T, userID uuid.UUID, action vo.RoleAssignmentAction) int {
+	t.Helper()
+
+	var n int
+
+	err := testDb.Pool().QueryRow(
+		context.Background(),
+		"SELECT count(*) FROM user_role_histories WHERE user_id = $1 AND action = $2",
+		userID.String(), action.String(),
+	).Scan(&n)
+	require.NoError(t, err)
+
+	return n
+}
+
+func TestRoleAssignmentRepository_Grant_HappyCase(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	u := seedUser(t)
+	actor := seedActor(t)
+	grantedAt := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	expiresAt := grantedAt.Add(time.Hour)
+
+	assignment, err := entity.NewRoleAssignment(
+		u.ID(), uuid.MustParse(adminRoleID), actor.ID(), grantedAt, &expiresAt, "incident response",
+	)
+	require.NoError(t, err)
+
+	r := repository.NewRoleAssignmentRepository(testDb.DbManager())
+	granted, err := r.Grant(context.Background(), assignment)
+
+	require.NoError(t, err)
+	assert.Equal(t, u.ID(), granted.UserID())
+	assert.Equal(t, actor.ID(), granted.GrantedBy())
+	assert.Equal(t, grantedAt, granted.GrantedAt())
+	require.NotNil(t, granted.ExpiresAt())
+	assert.Equal(t, expiresAt, *granted.ExpiresAt())
+	assert.Equal(t, "incident response", granted.Reason())
+	assert.Equal(t, 1, countHistories(t, u.ID(), vo.RoleAssignmentActionGranted))
+}
+
+func TestRoleAssignmentRepository_Grant_RegrantReplacesExpiry(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	u := seedUser(t)
+	actor := seedActor(t)
+	grantedAt := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	expiresAt := grantedAt.Add(time.Hour)
+
+	r := repository.NewRoleAssignmentRepository(testDb.DbManager())
+
+	first, err := entity.NewRoleAssignment(
+		u.ID(), uuid.MustParse(adminRoleID), actor.ID(), grantedAt, &expiresAt, "temporary",
+	)
+	require.NoError(t, err)
+	_, err = r.Grant(context.Background(), first)
+	require.NoError(t, err)
+
+	second, err := entity.NewRoleAssignment(
+		u.ID(), uuid.MustParse(adminRoleID), actor.ID(), grantedAt.Add(time.Minute), nil, "made permanent",
+	)
+	require.NoError(t, err)
+	granted, err := r.Grant(context.Background(), second)
+
+	require.NoError(t, err)
+	assert.Nil(t, granted.ExpiresAt())
+	assert.Equal(t, "made permanent", granted.Reason())
+	assert.Equal(t, 2, countHistories(t, u.ID(), vo.RoleAssignmentActionGranted))
+}
+
+func TestRoleAssignmentRepository_Grant_UnknownRole(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	u := seedUser(t)
+
+	assignment, err := entity.NewRoleAssignment(u.ID(), uuid.New(), uuid.Nil, time.Now(), nil, "reason")
+	require.NoError(t, err)
+
+	r := repository.NewRoleAssignmentRepository(testDb.DbManager())
+	granted, err := r.Grant(context.Background(), assignment)
+
+	require.Error(t, err)
+	assert.Nil(t, granted)
+
+	var voErr vo.Error
+	require.ErrorAs(t, err, &voErr)
+	assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+}
+
+func TestRoleAssignmentRepository_DeleteExpired(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	u := seedUser(t)
+	ctx := context.Background()
+
+	_, err := testDb.Pool().Exec(ctx,
+		`INSERT INTO user_roles (user_id, role_id, expires_at, reason) VALUES
+		   ($1, $2, now() - interval '1 minute', 'expired'),
+		   ($1, $3, now() + interval '1 hour', 'still valid')`,
+		u.ID().String(), adminRoleID, viewerRoleID,
+	)
+	require.NoError(t, err)
+
+	r := repository.NewRoleAssignmentRepository(testDb.DbManager())
+	removed, err := r.DeleteExpired(ctx)
+
+	require.NoError(t, err)
+	require.Len(t, removed, 1)
+	assert.Equal(t, uuid.MustParse(adminRoleID), removed[0].RoleID())
+	assert.Equal(t, "expired", removed[0].Reason())
+	assert.Equal(t, 1, countHistories(t, u.ID(), vo.RoleAssignmentActionExpired))
+
+	var remaining int
+	require.NoError(t, testDb.Pool().QueryRow(ctx,
+		"SELECT count(*) FROM user_roles WHERE user_id = $1", u.ID().String(),
+	).Scan(&remaining))
+	assert.Equal(t, 1, remaining)
+}
@@ -0,0 +1,144 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pgInsufficientPrivilege is raised when a row fails the with check clause of a row-level security policy.
+const pgInsufficientPrivilege = "42501"
+
+// The statements below deliberately leave out organization_id filters: only the policies in
+// db/schema/schema.sql keep the tenants apart.
+
+func TestRowLevelSecurity_AppRole(t *testing.T) {
+	var superuser, bypassRLS bool
+
+	require.NoError(t, testDb.Pool().QueryRow(context.Background(),
+		"SELECT rolsuper, rolbypassrls FROM pg_roles WHERE rolname = current_user",
+	).Scan(&superuser, &bypassRLS))
+	assert.False(t, superuser)
+	assert.False(t, bypassRLS)
+}
+
+func TestRowLevelSecurity_Read(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherTenant := seedTenant(t)
+	post := seedCommentPost(t, ctx, seedMember(t, ctx, "author@example.com").ID())
+	seedCommentPost(t, otherTenant, seedMember(t, otherTenant, "other@example.com").ID())
+
+	postIDs := func(ctx context.Context) []uuid.UUID {
+		var ids []uuid.UUID
+
+		inTenant(t, ctx, func(ctx context.Context, tx pgx.Tx) error {
+			rows, err := tx.Query(ctx, "SELECT id FROM posts")
+			if err != nil {
+				return err
+			}
+
+			ids, err = pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+
+			return err
+		})
+
+		return ids
+	}
+
+	assert.Equal(t, []uuid.UUID{post.ID()}, postIDs(ctx))
+
+	// Without a tenant no tenant-owned row is visible at all.
+	assert.Empty(t, postIDs(context.Background()))
+}
+
+func TestRowLevelSecurity_Write(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherTenant := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	post := seedCommentPost(t, ctx, author.ID())
+	otherPost := seedCommentPost(t, otherTenant, seedMember(t, otherTenant, "other@example.com").ID())
+
+	exec := func(sql string, args ...any) (int64, error) {
+		var affected int64
+
+		err := testDb.DbManager().TxFunc(ctx, func(ctx context.Context, tx pgx.Tx) error {
+			tag, err := tx.Exec(ctx, sql, args...)
+			affected = tag.RowsAffected()
+
+			return err
+		})
+
+		return affected, err
+	}
+
+	tests := []struct {
+		name         string
+		sql          string
+		args         []any
+		wantAffected int64
+		wantCode     string
+	}{
+		{
+			name:         "another tenant's rows cannot be updated",
+			sql:          "UPDATE posts SET content = 'changed' WHERE id = $1",
+			args:         []any{otherPost.ID()},
+			wantAffected: 0,
+		},
+		{
+			name:         "another tenant's rows cannot be deleted",
+			sql:          "DELETE FROM posts WHERE id = $1",
+			args:         []any{otherPost.ID()},
+			wantAffected: 0,
+		},
+		{
+			name:     "rows cannot be inserted into another tenant",
+			sql:      "INSERT INTO posts (organization_id, user_id, content) VALUES ($1, $2, 'post')",
+			args:     []any{common.TenantIDFromContext(otherTenant), author.ID()},
+			wantCode: pgInsufficientPrivilege,
+		},
+		{
+			name:     "rows cannot be moved to another tenant",
+			sql:      "UPDATE posts SET organization_id = $1 WHERE id = $2",
+			args:     []any{common.TenantIDFromContext(otherTenant), post.ID()},
+			wantCode: pgInsufficientPrivilege,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			affected, err := exec(tt.sql, tt.args...)
+
+			if tt.wantCode != "" {
+				var pgErr *pgconn.PgError
+				require.True(t, errors.As(err, &pgErr))
+				assert.Equal(t, tt.wantCode, pgErr.Code)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAffected, affected)
+		})
+	}
+
+	// The other tenant still sees its post as it was.
+	var content string
+
+	inTenant(t, otherTenant, func(ctx context.Context, tx pgx.Tx) error {
+		return tx.QueryRow(ctx, "SELECT content FROM posts WHERE id = $1", otherPost.ID()).Scan(&content)
+	})
+	assert.Equal(t, "post", content)
+}
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type timelineRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *timelineRepositoryImpl) FanOut(ctx context.Context, post entity.Post, minFollowers int) (int, error) {
+	ctx, span := r.tracer.Start(ctx, "FanOut")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return 0, err
+	}
+
+	var written int64
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		followers, qErr := queries.CountFollowers(ctx, sqlc.CountFollowersParams{
+			OrganizationID: tenantID,
+			FolloweeID:     toPgtypeUuid(post.UserID()),
+		})
+		// Posts that are not fanned out reach every follower through fan-out on read, so a count that
+		// is out of date by a few follows is harmless.
+		if qErr != nil || followers < int64(minFollowers) {
+			return qErr
+		}
+
+		if qErr = queries.LockTimelineAuthor(ctx, toPgtypeUuid(post.UserID())); qErr != nil {
+			return qErr
+		}
+
+		written, qErr = queries.CreateTimelineEntries(ctx, sqlc.CreateTimelineEntriesParams{
+			PostID:         toPgtypeUuid(post.ID()),
+			CreatedAt:      toPgtypeTimestamp(post.CreatedAt()),
+			OrganizationID: tenantID,
+			AuthorID:       toPgtypeUuid(post.UserID()),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return 0, err
+	}
+
+	return int(written), nil
+}
+
+func (r *timelineRepositoryImpl) Backfill(ctx context.Context, followerID, followeeID uuid.UUID) error {
+	ctx, span := r.tracer.Start(ctx, "Backfill")
+	defer span.End()
+
+	err := r.withAuthorLock(ctx, followeeID, func(ctx context.Context, queries sqlc.Queries, tenantID pgtype.UUID) error {
+		_, qErr := queries.BackfillTimelineEntries(ctx, sqlc.BackfillTimelineEntriesParams{
+			UserID:         toPgtypeUuid(followerID),
+			OrganizationID: tenantID,
+			AuthorID:       toPgtypeUuid(followeeID),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *timelineRepositoryImpl) Prune(ctx context.Context, followerID, followeeID uuid.UUID) error {
+	ctx, span := r.tracer.Start(ctx, "Prune")
+	defer span.End()
+
+	err := r.withAuthorLock(ctx, followeeID, func(ctx context.Context, queries sqlc.Queries, tenantID pgtype.UUID) error {
+		return queries.DeleteTimelineEntriesByAuthor(ctx, sqlc.DeleteTimelineEntriesByAuthorParams{
+			OrganizationID: tenantID,
+			UserID:         toPgtypeUuid(followerID),
+			AuthorID:       toPgtypeUuid(followeeID),
+		})
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// withAuthorLock runs fn while holding the timeline lock of authorID, so that it does not interleave with
+// a fan-out of one of the author's posts.
+func (r *timelineRepositoryImpl) withAuthorLock(
+	ctx context.Context, authorID uuid.UUID,
+	fn func(ctx context.Context, queries sqlc.Queries, tenantID pgtype.UUID) error,
+) error {
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		if err := queries.LockTimelineAuthor(ctx, toPgtypeUuid(authorID)); err != nil {
+			return err
+		}
+
+		return fn(ctx, queries, tenantID)
+	})
+}
+
+func NewTimelineRepository(dbManager db.DbManager) repository.TimelineRepository {
+	return &timelineRepositoryImpl{
+		tracer:    otel.Tracer("TimelineRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,104 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func timelineEntryCount(t *testing.T, ctx context.Context, userID uuid.UUID) int {
+	t.Helper()
+
+	var count int
+
+	inTenant(t, ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return tx.QueryRow(ctx, "SELECT COUNT(*) FROM timeline_entries WHERE user_id = $1", userID).Scan(&count)
+	})
+
+	return count
+}
+
+func TestTimelineRepository_FanOut(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	first := seedMember(t, ctx, "first@example.com")
+	second := seedMember(t, ctx, "second@example.com")
+	seedFollow(t, ctx, first.ID(), author.ID())
+	seedFollow(t, ctx, second.ID(), author.ID())
+
+	post := seedCommentPost(t, ctx, author.ID())
+	target := repository.NewTimelineRepository(testDb.DbManager())
+
+	// Below the threshold the post is left to fan-out on read.
+	written, err := target.FanOut(ctx, post, 3)
+	require.NoError(t, err)
+	assert.Zero(t, written)
+	assert.Zero(t, timelineEntryCount(t, ctx, first.ID()))
+
+	written, err = target.FanOut(ctx, post, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, written)
+	assert.Equal(t, 1, timelineEntryCount(t, ctx, first.ID()))
+	assert.Equal(t, 1, timelineEntryCount(t, ctx, second.ID()))
+
+	// Fanning out the same post again writes nothing new.
+	written, err = target.FanOut(ctx, post, 2)
+	require.NoError(t, err)
+	assert.Zero(t, written)
+}
+
+func TestTimelineRepository_BackfillPrune(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	early := seedMember(t, ctx, "early@example.com")
+	late := seedMember(t, ctx, "late@example.com")
+	target := repository.NewTimelineRepository(testDb.DbManager())
+
+	seedFollow(t, ctx, early.ID(), author.ID())
+
+	fannedOut := seedCommentPost(t, ctx, author.ID())
+	_, err := target.FanOut(ctx, fannedOut, 1)
+	require.NoError(t, err)
+
+	// A post without entries is served by fan-out on read and must not be copied.
+	seedCommentPost(t, ctx, author.ID())
+
+	seedFollow(t, ctx, late.ID(), author.ID())
+	require.NoError(t, target.Backfill(ctx, late.ID(), author.ID()))
+	assert.Equal(t, 1, timelineEntryCount(t, ctx, late.ID()))
+
+	require.NoError(t, target.Prune(ctx, late.ID(), author.ID()))
+	assert.Zero(t, timelineEntryCount(t, ctx, late.ID()))
+	assert.Equal(t, 1, timelineEntryCount(t, ctx, early.ID()))
+}
+
+func TestTimelineRepository_OtherTenant(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherTenant := seedTenant(t)
+	author := seedMember(t, ctx, "author@example.com")
+	follower := seedMember(t, ctx, "follower@example.com")
+	seedFollow(t, ctx, follower.ID(), author.ID())
+
+	post, err := entity.NewPost(entity.PostParams{UserID: author.ID(), Content: "hello", CreatedAt: time.Now()})
+	require.NoError(t, err)
+
+	// The follow lives in another organization, so nobody there receives the post.
+	written, err := repository.NewTimelineRepository(testDb.DbManager()).FanOut(otherTenant, post, 0)
+	require.NoError(t, err)
+	assert.Zero(t, written)
+}
@@ -2,13 +2,13 @@ package repository
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/Haya372/web-app-template/go-backend/internal/common"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate"
 	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
 	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
 	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
@@ -19,8 +19,6 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
-var errUserNotFound = errors.New("user not found")
-
 type userPermissionRepositoryImpl struct {
 	tracer    trace.Tracer
 	logger    common.Logger
@@ -33,14 +31,19 @@ func (r *userPermissionRepositoryImpl) FindByUserID(
 	ctx, span := r.tracer.Start(ctx, "FindByUserID")
 	defer span.End()
 
-	pgID := pgtype.UUID{Bytes: userID, Valid: true}
+	params := sqlc.FindUserPermissionSnapshotParams{UserID: pgtype.UUID{Bytes: userID, Valid: true}}
+
+	// Without an active tenant only platform-wide roles count; a NULL organization matches no membership.
+	if tenantID, err := db.TenantID(ctx); err == nil {
+		params.OrganizationID = tenantID
+	}
 
 	var rows []sqlc.FindUserPermissionSnapshotRow
 
 	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
 		var err error
 
-		rows, err = queries.FindUserPermissionSnapshot(ctx, pgID)
+		rows, err = queries.FindUserPermissionSnapshot(ctx, params)
 
 		return err
 	})
@@ -53,7 +56,7 @@ func (r *userPermissionRepositoryImpl) FindByUserID(
 	}
 
 	if len(rows) == 0 {
-		return nil, errUserNotFound
+		return nil, repository.ErrUserNotFound
 	}
 
 	first := rows[0]
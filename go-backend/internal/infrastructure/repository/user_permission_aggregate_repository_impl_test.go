@@ -6,9 +6,12 @@ import (
 	"context"
 	"testing"
 
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	domainrepository "github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
 	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -17,6 +20,7 @@ import (
 const (
 	adminRoleID  = "00000000-0000-0000-0000-000000000001"
 	viewerRoleID = "00000000-0000-0000-0000-000000000002"
+	ownerRoleID  = "00000000-0000-0000-0000-000000000003"
 )
 
 func assignRole(t *testing.T, userID, roleID string) {
@@ -95,6 +99,54 @@ func TestUserPermissionRepository_FindByUserId_UserNotFound(t *testing.T) {
 	r := repository.NewUserPermissionRepository(testDb.DbManager())
 	agg, err := r.FindByUserID(context.Background(), uuid.New())
 
-	assert.Error(t, err)
+	assert.ErrorIs(t, err, domainrepository.ErrUserNotFound)
 	assert.Nil(t, agg)
 }
+
+func TestUserPermissionRepository_FindByUserId_IgnoresExpiredRole(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	u := seedUser(t)
+
+	_, err := testDb.Pool().Exec(
+		context.Background(),
+		"INSERT INTO user_roles (user_id, role_id, expires_at) VALUES ($1, $2, now() - interval '1 minute')",
+		u.ID().String(), adminRoleID,
+	)
+	require.NoError(t, err)
+
+	r := repository.NewUserPermissionRepository(testDb.DbManager())
+	agg, err := r.FindByUserID(context.Background(), u.ID())
+
+	require.NoError(t, err)
+	assert.False(t, agg.HasPermission(vo.PermissionUsersList))
+	assert.Empty(t, agg.Permissions)
+}
+
+func TestUserPermissionRepository_FindByUserId_IncludesActiveTenantRole(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	u := seedUser(t)
+	ctx := seedTenant(t)
+	otherCtx := seedTenant(t)
+
+	inTenant(t, ctx, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx,
+			"INSERT INTO organization_memberships (organization_id, user_id, role_id) VALUES ($1, $2, $3)",
+			common.TenantIDFromContext(ctx), u.ID().String(), ownerRoleID,
+		)
+
+		return err
+	})
+
+	r := repository.NewUserPermissionRepository(testDb.DbManager())
+
+	inside, err := r.FindByUserID(ctx, u.ID())
+	require.NoError(t, err)
+	assert.True(t, inside.HasPermission(vo.PermissionMembersAdd))
+
+	// The membership role applies only while the user acts in that organization.
+	outside, err := r.FindByUserID(otherCtx, u.ID())
+	require.NoError(t, err)
+	assert.False(t, outside.HasPermission(vo.PermissionMembersAdd))
+}
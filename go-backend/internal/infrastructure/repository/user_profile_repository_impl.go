@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type userProfileRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *userProfileRepositoryImpl) Save(ctx context.Context, profile entity.UserProfile) error {
+	ctx, span := r.tracer.Start(ctx, "Save")
+	defer span.End()
+
+	var avatarURL string
+	if profile.AvatarURL() != nil {
+		avatarURL = profile.AvatarURL().String()
+	}
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		return queries.UpsertUserProfile(ctx, sqlc.UpsertUserProfileParams{
+			UserID:    toPgtypeUuid(profile.UserID()),
+			Bio:       profile.Bio().String(),
+			AvatarUrl: toNullablePgtypeText(avatarURL),
+			UpdatedAt: toPgtypeTimestamp(profile.UpdatedAt()),
+		})
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+func NewUserProfileRepository(dbManager db.DbManager) repository.UserProfileRepository {
+	return &userProfileRepositoryImpl{
+		tracer:    otel.Tracer("UserProfileRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,53 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserProfileRepository_Save(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := context.Background()
+	user := seedUser(t)
+	target := repository.NewUserProfileRepository(testDb.DbManager())
+
+	stored := func() (string, pgtype.Text) {
+		var (
+			bio       string
+			avatarURL pgtype.Text
+		)
+
+		require.NoError(t, testDb.Pool().QueryRow(ctx,
+			"SELECT bio, avatar_url FROM user_profiles WHERE user_id = $1", user.ID(),
+		).Scan(&bio, &avatarURL))
+
+		return bio, avatarURL
+	}
+
+	profile, err := entity.NewUserProfile(user.ID(), "Gopher", "https://example.com/a.png", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, target.Save(ctx, profile))
+
+	bio, avatarURL := stored()
+	assert.Equal(t, "Gopher", bio)
+	assert.Equal(t, pgtype.Text{String: "https://example.com/a.png", Valid: true}, avatarURL)
+
+	// Saving again replaces the profile; a blank avatar is stored as none.
+	profile, err = entity.NewUserProfile(user.ID(), "", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, target.Save(ctx, profile))
+
+	bio, avatarURL = stored()
+	assert.Empty(t, bio)
+	assert.False(t, avatarURL.Valid)
+}
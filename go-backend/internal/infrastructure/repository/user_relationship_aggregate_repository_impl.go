@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type userRelationshipRepositoryImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	dbManager db.DbManager
+}
+
+func (r *userRelationshipRepositoryImpl) FindByUserID(
+	ctx context.Context, userID uuid.UUID,
+) (*aggregate.UserRelationshipAggregate, error) {
+	ctx, span := r.tracer.Start(ctx, "FindByUserID")
+	defer span.End()
+
+	tenantID, err := db.TenantID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var rows []sqlc.FindRelationshipsRow
+
+	err = r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var err error
+
+		rows, err = queries.FindRelationships(ctx, sqlc.FindRelationshipsParams{
+			OrganizationID: tenantID,
+			UserID:         toPgtypeUuid(userID),
+		})
+
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.logger.Error(ctx, "failed to query user relationships", "error", err)
+
+		return nil, err
+	}
+
+	relationships := &aggregate.UserRelationshipAggregate{UserID: userID}
+
+	for _, row := range rows {
+		if row.Blocked {
+			relationships.BlockedIDs = append(relationships.BlockedIDs, uuid.UUID(row.UserID.Bytes))
+		} else {
+			relationships.MutedIDs = append(relationships.MutedIDs, uuid.UUID(row.UserID.Bytes))
+		}
+	}
+
+	return relationships, nil
+}
+
+func NewUserRelationshipRepository(dbManager db.DbManager) aggregaterepository.UserRelationshipRepository {
+	return &userRelationshipRepositoryImpl{
+		tracer:    otel.Tracer("UserRelationshipRepository"),
+		logger:    common.NewLogger(),
+		dbManager: dbManager,
+	}
+}
@@ -0,0 +1,58 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserRelationshipRepository_FindByUserID(t *testing.T) {
+	defer func() { require.NoError(t, testDb.Cleanup()) }()
+
+	ctx := seedTenant(t)
+	otherTenant := seedTenant(t)
+	user := seedMember(t, ctx, "user@example.com")
+	blocked := seedMember(t, ctx, "blocked@example.com")
+	blocker := seedMember(t, ctx, "blocker@example.com")
+	muted := seedMember(t, ctx, "muted@example.com")
+	muter := seedMember(t, ctx, "muter@example.com")
+	outsider := seedMember(t, otherTenant, "outsider@example.com")
+
+	seedBlock(t, ctx, user.ID(), blocked.ID())
+	seedBlock(t, ctx, blocker.ID(), user.ID())
+
+	for _, m := range [][2]uuid.UUID{{user.ID(), muted.ID()}, {muter.ID(), user.ID()}} {
+		mute, err := entity.NewMute(m[0], m[1], time.Now())
+		require.NoError(t, err)
+		_, err = repository.NewMuteRepository(testDb.DbManager()).Add(ctx, mute)
+		require.NoError(t, err)
+	}
+
+	target := repository.NewUserRelationshipRepository(testDb.DbManager())
+
+	relationships, err := target.FindByUserID(ctx, user.ID())
+	require.NoError(t, err)
+	assert.Equal(t, user.ID(), relationships.UserID)
+	// Blocks count in both directions; only the user's own mutes do.
+	assert.ElementsMatch(t, []uuid.UUID{blocked.ID(), blocker.ID()}, relationships.BlockedIDs)
+	assert.Equal(t, []uuid.UUID{muted.ID()}, relationships.MutedIDs)
+
+	// The relationships of another organization do not apply.
+	seedBlock(t, otherTenant, user.ID(), outsider.ID())
+
+	elsewhere, err := target.FindByUserID(otherTenant, user.ID())
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{outsider.ID()}, elsewhere.BlockedIDs)
+	assert.Empty(t, elsewhere.MutedIDs)
+
+	relationships, err = target.FindByUserID(ctx, user.ID())
+	require.NoError(t, err)
+	assert.NotContains(t, relationships.BlockedIDs, outsider.ID())
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
 	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/db"
 	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/sqlc"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"go.opentelemetry.io/otel"
@@ -80,6 +81,67 @@ func (r *userRepositoryImpl) FindByEmail(ctx context.Context, email string) (ent
 		return nil, err
 	}
 
+	return reconstructUser(span, *dbUser)
+}
+
+func (r *userRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (entity.User, error) {
+	ctx, span := r.tracer.Start(ctx, "FindByID")
+	defer span.End()
+
+	var dbUser sqlc.User
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		dbUser, qErr = queries.FindUserByID(ctx, toPgtypeUuid(id))
+
+		return qErr
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrUserNotFound
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return reconstructUser(span, dbUser)
+}
+
+func (r *userRepositoryImpl) UpdateStatus(ctx context.Context, user entity.User) error {
+	ctx, span := r.tracer.Start(ctx, "UpdateStatus")
+	defer span.End()
+
+	var affected int64
+
+	err := r.dbManager.QueriesFunc(ctx, func(ctx context.Context, queries sqlc.Queries) error {
+		var qErr error
+
+		affected, qErr = queries.UpdateUserStatus(ctx, sqlc.UpdateUserStatusParams{
+			ID:         toPgtypeUuid(user.ID()),
+			StatusCode: user.Status().String(),
+		})
+
+		return qErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	if affected == 0 {
+		return repository.ErrUserNotFound
+	}
+
+	return nil
+}
+
+func reconstructUser(span trace.Span, dbUser sqlc.User) (entity.User, error) {
 	// NOTE: schema guarantees FK integrity, but keep this guard defensive to surface unexpected records.
 	status, err := vo.UserStatusFromString(dbUser.StatusCode)
 	if err != nil {
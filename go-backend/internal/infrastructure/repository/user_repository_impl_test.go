@@ -192,3 +192,39 @@ func TestFindByEmail_ErrorCase(t *testing.T) {
 
 	testDb.Cleanup()
 }
+
+func TestFindByID_UpdateStatus(t *testing.T) {
+	target := repository.NewUserRepository(testDb.DbManager())
+	seedUser := entity.ReconstructUser(
+		uuid.New(),
+		"status@example.com",
+		[]byte("password"),
+		"Status User",
+		vo.UserStatusActive,
+		time.Date(2026, 1, 18, 0, 0, 0, 0, time.UTC),
+	)
+
+	_, err := target.Create(context.Background(), seedUser)
+	assert.NoError(t, err)
+
+	found, err := target.FindByID(context.Background(), seedUser.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, seedUser, found)
+
+	frozen, err := found.UpdateStatus(vo.UserStatusFrozen)
+	assert.NoError(t, err)
+	assert.NoError(t, target.UpdateStatus(context.Background(), frozen))
+
+	found, err = target.FindByID(context.Background(), seedUser.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, vo.UserStatusFrozen, found.Status())
+
+	missing := entity.ReconstructUser(
+		uuid.New(), "missing@example.com", nil, "Missing", vo.UserStatusActive, time.Now(),
+	)
+	_, err = target.FindByID(context.Background(), missing.ID())
+	assert.True(t, errors.Is(err, domain_repository.ErrUserNotFound))
+	assert.True(t, errors.Is(target.UpdateStatus(context.Background(), missing), domain_repository.ErrUserNotFound))
+
+	testDb.Cleanup()
+}
@@ -20,3 +20,74 @@ func toPgtypeTimestamp(t time.Time) pgtype.Timestamp {
 		Valid: true,
 	}
 }
+
+// toNullablePgtypeUuid maps uuid.Nil to SQL NULL.
+func toNullablePgtypeUuid(id uuid.UUID) pgtype.UUID {
+	return pgtype.UUID{
+		Bytes: id,
+		Valid: id != uuid.Nil,
+	}
+}
+
+// toNullablePgtypeUuidPtr maps a nil pointer to SQL NULL.
+func toNullablePgtypeUuidPtr(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+
+	return toPgtypeUuid(*id)
+}
+
+// fromNullablePgtypeUuid maps SQL NULL to a nil pointer.
+func fromNullablePgtypeUuid(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+
+	v := uuid.UUID(id.Bytes)
+
+	return &v
+}
+
+// toNullablePgtypeTimestamp maps a nil pointer to SQL NULL.
+func toNullablePgtypeTimestamp(t *time.Time) pgtype.Timestamp {
+	if t == nil {
+		return pgtype.Timestamp{}
+	}
+
+	return toPgtypeTimestamp(*t)
+}
+
+// toNullablePgtypeText maps the empty string to SQL NULL.
+func toNullablePgtypeText(s string) pgtype.Text {
+	return pgtype.Text{
+		String: s,
+		Valid:  s != "",
+	}
+}
+
+// fromNullablePgtypeTimestamp maps SQL NULL to a nil pointer.
+func fromNullablePgtypeTimestamp(t pgtype.Timestamp) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	return &t.Time
+}
+
+func toPgtypeUuids(ids []uuid.UUID) []pgtype.UUID {
+	pgIDs := make([]pgtype.UUID, len(ids))
+	for i, id := range ids {
+		pgIDs[i] = toPgtypeUuid(id)
+	}
+
+	return pgIDs
+}
+
+// toNullablePgtypeInt4 maps 0 to SQL NULL.
+func toNullablePgtypeInt4(n int) pgtype.Int4 {
+	return pgtype.Int4{
+		Int32: int32(n), //nolint:gosec // callers pass small values such as image dimensions
+		Valid: n != 0,
+	}
+}
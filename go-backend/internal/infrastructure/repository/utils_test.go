@@ -3,10 +3,15 @@
 package repository_test
 
 import (
+	"context"
 	"log"
 	"testing"
 
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
 	"github.com/Haya372/web-app-template/go-backend/test/integration"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
 )
 
 var testDb integration.TestDb
@@ -28,3 +33,27 @@ func TestMain(m *testing.M) {
 
 	m.Run()
 }
+
+// seedTenant creates an organization and returns a context acting inside it.
+func seedTenant(t *testing.T) context.Context {
+	t.Helper()
+
+	orgID := uuid.New()
+	ctx := common.WithTenantID(context.Background(), orgID.String())
+
+	inTenant(t, ctx, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, "INSERT INTO organizations (id, name) VALUES ($1, $2)", orgID, "Test Organization")
+
+		return err
+	})
+
+	return ctx
+}
+
+// inTenant runs raw statements in a transaction scoped to the tenant in ctx, so row-level security
+// applies to them as it does to the repositories.
+func inTenant(t *testing.T, ctx context.Context, fn func(ctx context.Context, tx pgx.Tx) error) {
+	t.Helper()
+
+	require.NoError(t, testDb.DbManager().TxFunc(ctx, fn))
+}
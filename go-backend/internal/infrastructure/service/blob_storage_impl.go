@@ -0,0 +1,76 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+)
+
+const (
+	blobStorageDriverLocal = "local"
+	blobStorageDriverS3    = "s3"
+
+	defaultBlobStorageLocalDir = "data/blobs"
+	defaultBlobStorageS3Region = "us-east-1"
+)
+
+var (
+	errUnknownBlobStorageDriver = errors.New("BLOB_STORAGE_DRIVER must be local or s3")
+	errMissingS3Bucket          = errors.New("BLOB_STORAGE_S3_BUCKET is required")
+	errMissingS3Credentials     = errors.New(
+		"BLOB_STORAGE_S3_ACCESS_KEY_ID and BLOB_STORAGE_S3_SECRET_ACCESS_KEY are required",
+	)
+)
+
+// NewBlobStorage selects the blob storage with BLOB_STORAGE_DRIVER (local or s3; default local).
+//
+// The local driver keeps blobs in files below BLOB_STORAGE_LOCAL_DIR (default data/blobs). The s3 driver
+// stores them in BLOB_STORAGE_S3_BUCKET, authenticating with BLOB_STORAGE_S3_ACCESS_KEY_ID and
+// BLOB_STORAGE_S3_SECRET_ACCESS_KEY in BLOB_STORAGE_S3_REGION (default us-east-1). S3-compatible stores
+// such as MinIO are reached by setting BLOB_STORAGE_S3_ENDPOINT, which also switches to path-style URLs.
+func NewBlobStorage() (service.BlobStorage, error) {
+	switch driver := os.Getenv("BLOB_STORAGE_DRIVER"); driver {
+	case "", blobStorageDriverLocal:
+		dir := os.Getenv("BLOB_STORAGE_LOCAL_DIR")
+		if dir == "" {
+			dir = defaultBlobStorageLocalDir
+		}
+
+		return newLocalBlobStorage(dir)
+	case blobStorageDriverS3:
+		config, err := loadS3BlobStorageConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		return newS3BlobStorage(config), nil
+	default:
+		return nil, fmt.Errorf("%w: got %q", errUnknownBlobStorageDriver, driver)
+	}
+}
+
+func loadS3BlobStorageConfig() (s3BlobStorageConfig, error) {
+	config := s3BlobStorageConfig{
+		bucket:          os.Getenv("BLOB_STORAGE_S3_BUCKET"),
+		region:          os.Getenv("BLOB_STORAGE_S3_REGION"),
+		endpoint:        os.Getenv("BLOB_STORAGE_S3_ENDPOINT"),
+		accessKeyID:     os.Getenv("BLOB_STORAGE_S3_ACCESS_KEY_ID"),
+		secretAccessKey: os.Getenv("BLOB_STORAGE_S3_SECRET_ACCESS_KEY"),
+	}
+
+	if config.bucket == "" {
+		return s3BlobStorageConfig{}, errMissingS3Bucket
+	}
+
+	if config.accessKeyID == "" || config.secretAccessKey == "" {
+		return s3BlobStorageConfig{}, errMissingS3Credentials
+	}
+
+	if config.region == "" {
+		config.region = defaultBlobStorageS3Region
+	}
+
+	return config, nil
+}
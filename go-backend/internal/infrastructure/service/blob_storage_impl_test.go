@@ -0,0 +1,130 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	infra_service "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/service"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/johannesboyne/gofakes3"
+	"github.com/johannesboyne/gofakes3/backend/s3mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setBlobStorageEnv configures each driver; s3 runs against an in-memory S3 stand-in.
+func setBlobStorageEnv(t *testing.T, driver string) {
+	t.Helper()
+
+	t.Setenv("BLOB_STORAGE_DRIVER", driver)
+
+	switch driver {
+	case "local":
+		t.Setenv("BLOB_STORAGE_LOCAL_DIR", t.TempDir())
+	case "s3":
+		fake := httptest.NewServer(gofakes3.New(s3mem.New(), gofakes3.WithAutoBucket(true)).Server())
+		t.Cleanup(fake.Close)
+
+		t.Setenv("BLOB_STORAGE_S3_ENDPOINT", fake.URL)
+		t.Setenv("BLOB_STORAGE_S3_BUCKET", "attachments")
+		t.Setenv("BLOB_STORAGE_S3_ACCESS_KEY_ID", "test")
+		t.Setenv("BLOB_STORAGE_S3_SECRET_ACCESS_KEY", "test")
+	}
+}
+
+func TestBlobStorage_RoundTrip(t *testing.T) {
+	for _, driver := range []string{"local", "s3"} {
+		t.Run(driver, func(t *testing.T) {
+			setBlobStorageEnv(t, driver)
+
+			storage, err := infra_service.NewBlobStorage()
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			key := "attachments/0195b4a8-0000-7000-8000-000000000000/original"
+
+			_, err = storage.Open(ctx, key)
+			require.ErrorIs(t, err, service.ErrBlobNotFound)
+
+			require.NoError(t, storage.Put(ctx, key, strings.NewReader("first"), 5, "text/plain"))
+			require.NoError(t, storage.Put(ctx, key, strings.NewReader("replaced"), 8, "text/plain"))
+
+			blob, err := storage.Open(ctx, key)
+			require.NoError(t, err)
+
+			content, err := io.ReadAll(blob)
+			require.NoError(t, err)
+			require.NoError(t, blob.Close())
+			assert.Equal(t, "replaced", string(content))
+
+			require.NoError(t, storage.Delete(ctx, key))
+
+			_, err = storage.Open(ctx, key)
+			require.ErrorIs(t, err, service.ErrBlobNotFound)
+
+			// Deleting again is not an error.
+			require.NoError(t, storage.Delete(ctx, key))
+		})
+	}
+}
+
+func TestLocalBlobStorage_RejectsKeysOutsideItsDirectory(t *testing.T) {
+	setBlobStorageEnv(t, "local")
+
+	storage, err := infra_service.NewBlobStorage()
+	require.NoError(t, err)
+
+	for _, key := range []string{"../escape", "/etc/passwd", "a/../../escape", ""} {
+		err = storage.Put(context.Background(), key, strings.NewReader("x"), 1, "text/plain")
+		assert.Error(t, err, key)
+	}
+}
+
+func TestLocalBlobStorage_RejectsContentOfTheWrongSize(t *testing.T) {
+	setBlobStorageEnv(t, "local")
+
+	storage, err := infra_service.NewBlobStorage()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	for _, content := range []string{"shrt", "too long"} {
+		require.Error(t, storage.Put(ctx, "blob", strings.NewReader(content), 5, "text/plain"), content)
+	}
+
+	_, err = storage.Open(ctx, "blob")
+	require.ErrorIs(t, err, service.ErrBlobNotFound)
+}
+
+func TestNewBlobStorage_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+	}{
+		{name: "unknown driver", env: map[string]string{"BLOB_STORAGE_DRIVER": "ftp"}},
+		{name: "s3 without bucket", env: map[string]string{
+			"BLOB_STORAGE_DRIVER": "s3", "BLOB_STORAGE_S3_BUCKET": "",
+			"BLOB_STORAGE_S3_ACCESS_KEY_ID": "key", "BLOB_STORAGE_S3_SECRET_ACCESS_KEY": "secret",
+		}},
+		{name: "s3 without credentials", env: map[string]string{
+			"BLOB_STORAGE_DRIVER": "s3", "BLOB_STORAGE_S3_BUCKET": "attachments",
+			"BLOB_STORAGE_S3_ACCESS_KEY_ID": "", "BLOB_STORAGE_S3_SECRET_ACCESS_KEY": "",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			storage, err := infra_service.NewBlobStorage()
+
+			require.Error(t, err)
+			assert.Nil(t, storage)
+		})
+	}
+}
@@ -0,0 +1,383 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultContentFilterReloadIntervalSeconds = 10
+
+	bannedWordsFilterName    = "banned_words"
+	blockedDomainsFilterName = "blocked_domains"
+	duplicatesFilterName     = "duplicates"
+)
+
+var (
+	errInvalidContentFilterReloadInterval = errors.New(
+		"CONTENT_FILTER_RELOAD_INTERVAL_SECONDS must be a non-negative int",
+	)
+	errInvalidContentFilterAction = errors.New("content filter action must be reject or flag")
+	errEmptyContentFilterRule     = errors.New("content filter rule lists nothing to filter")
+	errInvalidDuplicatesRule      = errors.New("duplicates rule needs a count of at least 2 and a positive window")
+)
+
+// contentFilterConfig is the content filter configuration file.
+type contentFilterConfig struct {
+	BannedWords    []bannedWordsRule    `json:"bannedWords"`
+	BlockedDomains []blockedDomainsRule `json:"blockedDomains"`
+	Duplicates     *duplicatesRule      `json:"duplicates"`
+}
+
+type bannedWordsRule struct {
+	Action       string   `json:"action"`
+	ReportReason string   `json:"reportReason"`
+	Words        []string `json:"words"`
+}
+
+type blockedDomainsRule struct {
+	Action       string   `json:"action"`
+	ReportReason string   `json:"reportReason"`
+	Domains      []string `json:"domains"`
+}
+
+type duplicatesRule struct {
+	Action       string `json:"action"`
+	ReportReason string `json:"reportReason"`
+	// Count is the number of posts with the same content, the new one included, that trips the rule.
+	Count         int `json:"count"`
+	WindowSeconds int `json:"windowSeconds"`
+}
+
+// contentFilterChain runs filters in order. A rejection stops the chain; a flag is remembered while the rest
+// of the chain may still reject. The first flag wins.
+type contentFilterChain []service.ContentFilter
+
+func (c contentFilterChain) Check(
+	ctx context.Context, authorID uuid.UUID, content string,
+) (service.ContentVerdict, error) {
+	verdict := service.ContentVerdict{Action: service.ContentAccept}
+
+	for _, filter := range c {
+		v, err := filter.Check(ctx, authorID, content)
+		if err != nil {
+			return service.ContentVerdict{}, err
+		}
+
+		switch v.Action {
+		case service.ContentReject:
+			return v, nil
+		case service.ContentFlag:
+			if verdict.Action == service.ContentAccept {
+				verdict = v
+			}
+		case service.ContentAccept:
+		}
+	}
+
+	return verdict, nil
+}
+
+// ruleVerdict is the verdict a rule gives to the content it matches.
+type ruleVerdict struct {
+	action       service.ContentFilterAction
+	filter       string
+	reason       string
+	reportReason vo.ReportReason
+}
+
+func (r ruleVerdict) with(match string) service.ContentVerdict {
+	return service.ContentVerdict{
+		Action:       r.action,
+		Filter:       r.filter,
+		Reason:       r.reason,
+		Match:        match,
+		ReportReason: r.reportReason,
+	}
+}
+
+type bannedWordsFilter struct {
+	verdict ruleVerdict
+	words   vo.BannedWordList
+}
+
+func (f *bannedWordsFilter) Check(_ context.Context, _ uuid.UUID, content string) (service.ContentVerdict, error) {
+	if word, found := f.words.Find(content); found {
+		return f.verdict.with(word), nil
+	}
+
+	return service.ContentVerdict{Action: service.ContentAccept}, nil
+}
+
+type blockedDomainsFilter struct {
+	verdict ruleVerdict
+	domains vo.DomainBlocklist
+}
+
+func (f *blockedDomainsFilter) Check(_ context.Context, _ uuid.UUID, content string) (service.ContentVerdict, error) {
+	if host, found := f.domains.Find(content); found {
+		return f.verdict.with(host), nil
+	}
+
+	return service.ContentVerdict{Action: service.ContentAccept}, nil
+}
+
+type duplicatesFilter struct {
+	verdict        ruleVerdict
+	count          int
+	window         time.Duration
+	postRepository repository.PostRepository
+}
+
+func (f *duplicatesFilter) Check(
+	ctx context.Context, authorID uuid.UUID, content string,
+) (service.ContentVerdict, error) {
+	previous, err := f.postRepository.CountWithContentSince(ctx, authorID, content, time.Now().Add(-f.window))
+	if err != nil {
+		return service.ContentVerdict{}, err
+	}
+
+	if previous+1 >= f.count {
+		return f.verdict.with(fmt.Sprintf("%d identical posts within %s", previous+1, f.window)), nil
+	}
+
+	return service.ContentVerdict{Action: service.ContentAccept}, nil
+}
+
+// reloadingContentFilter runs the chain built from a configuration file, rebuilding it when the file changes.
+// The file is checked at most once per interval, on the next post; a file that fails to load is logged and the
+// previous chain is kept.
+type reloadingContentFilter struct {
+	logger         common.Logger
+	path           string
+	interval       time.Duration
+	postRepository repository.PostRepository
+
+	mu        sync.Mutex
+	chain     contentFilterChain
+	modTime   time.Time
+	size      int64
+	checkedAt time.Time
+}
+
+func (f *reloadingContentFilter) Check(
+	ctx context.Context, authorID uuid.UUID, content string,
+) (service.ContentVerdict, error) {
+	return f.current(ctx).Check(ctx, authorID, content)
+}
+
+func (f *reloadingContentFilter) current(ctx context.Context) contentFilterChain {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(f.checkedAt) < f.interval {
+		return f.chain
+	}
+
+	f.checkedAt = now
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		f.logger.Error(ctx, "failed to stat content filter config", "path", f.path, "error", err)
+
+		return f.chain
+	}
+
+	if info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return f.chain
+	}
+
+	f.modTime, f.size = info.ModTime(), info.Size()
+
+	chain, err := loadContentFilterChain(f.path, f.postRepository)
+	if err != nil {
+		f.logger.Error(ctx, "failed to reload content filter config, keeping the previous one",
+			"path", f.path, "error", err)
+
+		return f.chain
+	}
+
+	f.chain = chain
+	f.logger.Info(ctx, "content filter config reloaded", "path", f.path, "filters", len(chain))
+
+	return f.chain
+}
+
+// NewContentFilter builds the content filters from the JSON file at CONTENT_FILTER_CONFIG_PATH. Without it,
+// every post is accepted. The file is checked for changes at most every CONTENT_FILTER_RELOAD_INTERVAL_SECONDS
+// (default 10; 0 checks on every post) and reloaded without a restart. A file that fails to load at startup is
+// an error; later, the previous filters stay in effect.
+//
+// The file lists rules, each with an action of reject or flag and an optional reportReason for flagged posts:
+//
+//	{
+//	  "bannedWords": [{"action": "reject", "words": ["..."]}],
+//	  "blockedDomains": [{"action": "flag", "reportReason": "spam", "domains": ["spam.example"]}],
+//	  "duplicates": {"action": "reject", "count": 3, "windowSeconds": 3600}
+//	}
+//
+// Banned words run first, then blocked domains, then duplicates, which counts the author's posts with the same
+// content within the window.
+func NewContentFilter(postRepository repository.PostRepository) (service.ContentFilter, error) {
+	path := os.Getenv("CONTENT_FILTER_CONFIG_PATH")
+	if path == "" {
+		return contentFilterChain{}, nil
+	}
+
+	interval := defaultContentFilterReloadIntervalSeconds
+
+	if raw := os.Getenv("CONTENT_FILTER_RELOAD_INTERVAL_SECONDS"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("%w: got %q", errInvalidContentFilterReloadInterval, raw)
+		}
+
+		interval = parsed
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("content filter config: %w", err)
+	}
+
+	chain, err := loadContentFilterChain(path, postRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reloadingContentFilter{
+		logger:         common.NewLogger(),
+		path:           path,
+		interval:       time.Duration(interval) * time.Second,
+		postRepository: postRepository,
+		chain:          chain,
+		modTime:        info.ModTime(),
+		size:           info.Size(),
+		checkedAt:      time.Now(),
+	}, nil
+}
+
+func loadContentFilterChain(path string, postRepository repository.PostRepository) (contentFilterChain, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("content filter config: %w", err)
+	}
+
+	// Unknown fields are errors, so that a misspelt rule does not silently filter nothing.
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+
+	var config contentFilterConfig
+	if err = decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("content filter config %s: %w", path, err)
+	}
+
+	chain, err := buildContentFilterChain(config, postRepository)
+	if err != nil {
+		return nil, fmt.Errorf("content filter config %s: %w", path, err)
+	}
+
+	return chain, nil
+}
+
+func buildContentFilterChain(
+	config contentFilterConfig, postRepository repository.PostRepository,
+) (contentFilterChain, error) {
+	var chain contentFilterChain
+
+	for _, rule := range config.BannedWords {
+		verdict, err := newRuleVerdict(
+			rule.Action, rule.ReportReason, bannedWordsFilterName, "content contains a banned word", vo.ReportReasonOther,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		words := vo.NewBannedWordList(rule.Words)
+		if words.Len() == 0 {
+			return nil, fmt.Errorf("%s: %w", bannedWordsFilterName, errEmptyContentFilterRule)
+		}
+
+		chain = append(chain, &bannedWordsFilter{verdict: verdict, words: words})
+	}
+
+	for _, rule := range config.BlockedDomains {
+		verdict, err := newRuleVerdict(
+			rule.Action, rule.ReportReason, blockedDomainsFilterName, "content links to a blocked domain",
+			vo.ReportReasonSpam,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		domains, err := vo.NewDomainBlocklist(rule.Domains)
+		if err != nil {
+			return nil, err
+		}
+
+		if domains.Len() == 0 {
+			return nil, fmt.Errorf("%s: %w", blockedDomainsFilterName, errEmptyContentFilterRule)
+		}
+
+		chain = append(chain, &blockedDomainsFilter{verdict: verdict, domains: domains})
+	}
+
+	if rule := config.Duplicates; rule != nil {
+		verdict, err := newRuleVerdict(
+			rule.Action, rule.ReportReason, duplicatesFilterName, "the same content was posted too many times recently",
+			vo.ReportReasonSpam,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if rule.Count < 2 || rule.WindowSeconds <= 0 {
+			return nil, errInvalidDuplicatesRule
+		}
+
+		chain = append(chain, &duplicatesFilter{
+			verdict:        verdict,
+			count:          rule.Count,
+			window:         time.Duration(rule.WindowSeconds) * time.Second,
+			postRepository: postRepository,
+		})
+	}
+
+	return chain, nil
+}
+
+func newRuleVerdict(
+	rawAction, rawReportReason, filter, reason string, defaultReportReason vo.ReportReason,
+) (ruleVerdict, error) {
+	action := service.ContentFilterAction(rawAction)
+	if action != service.ContentReject && action != service.ContentFlag {
+		return ruleVerdict{}, fmt.Errorf("%s: %w: got %q", filter, errInvalidContentFilterAction, rawAction)
+	}
+
+	reportReason := defaultReportReason
+
+	if rawReportReason != "" {
+		parsed, err := vo.ReportReasonFromString(rawReportReason)
+		if err != nil {
+			return ruleVerdict{}, fmt.Errorf("%s: %w", filter, err)
+		}
+
+		reportReason = parsed
+	}
+
+	return ruleVerdict{action: action, filter: filter, reason: reason, reportReason: reportReason}, nil
+}
@@ -0,0 +1,212 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	infra_service "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/service"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// writeContentFilterConfig writes the config to a temporary file and points the filters at it.
+func writeContentFilterConfig(t *testing.T, config string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "content_filter.json")
+	require.NoError(t, os.WriteFile(path, []byte(config), 0o600))
+	t.Setenv("CONTENT_FILTER_CONFIG_PATH", path)
+
+	return path
+}
+
+func TestNewContentFilter_WithoutConfig(t *testing.T) {
+	t.Setenv("CONTENT_FILTER_CONFIG_PATH", "")
+
+	filter, err := infra_service.NewContentFilter(nil)
+	require.NoError(t, err)
+
+	verdict, err := filter.Check(context.Background(), uuid.New(), "anything goes")
+	require.NoError(t, err)
+	assert.Equal(t, service.ContentAccept, verdict.Action)
+}
+
+func TestNewContentFilter_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		interval string
+	}{
+		{name: "malformed json", config: `{"bannedWords": [`},
+		{name: "unknown field", config: `{"bannedWord": [{"action": "reject", "words": ["spam"]}]}`},
+		{name: "unknown action", config: `{"bannedWords": [{"action": "delete", "words": ["spam"]}]}`},
+		{name: "empty word list", config: `{"bannedWords": [{"action": "reject", "words": [" "]}]}`},
+		{
+			name:   "unknown report reason",
+			config: `{"bannedWords": [{"action": "flag", "reportReason": "rude", "words": ["spam"]}]}`,
+		},
+		{name: "domain with a path", config: `{"blockedDomains": [{"action": "reject", "domains": ["a.example/x"]}]}`},
+		{name: "duplicates count too low", config: `{"duplicates": {"action": "reject", "count": 1, "windowSeconds": 60}}`},
+		{name: "duplicates without window", config: `{"duplicates": {"action": "reject", "count": 2}}`},
+		{name: "negative reload interval", config: `{}`, interval: "-1"},
+		{name: "invalid reload interval", config: `{}`, interval: "soon"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeContentFilterConfig(t, tt.config)
+			t.Setenv("CONTENT_FILTER_RELOAD_INTERVAL_SECONDS", tt.interval)
+
+			_, err := infra_service.NewContentFilter(nil)
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Setenv("CONTENT_FILTER_CONFIG_PATH", filepath.Join(t.TempDir(), "missing.json"))
+
+		_, err := infra_service.NewContentFilter(nil)
+		require.Error(t, err)
+	})
+}
+
+func TestContentFilter_Check(t *testing.T) {
+	const config = `{
+		"bannedWords": [
+			{"action": "reject", "words": ["scam"]},
+			{"action": "flag", "reportReason": "harassment", "words": ["idiot"]}
+		],
+		"blockedDomains": [{"action": "flag", "domains": ["spam.example"]}],
+		"duplicates": {"action": "reject", "count": 3, "windowSeconds": 3600}
+	}`
+
+	tests := []struct {
+		name       string
+		content    string
+		previous   int
+		want       service.ContentFilterAction
+		wantFilter string
+		wantMatch  string
+		wantReason vo.ReportReason
+	}{
+		{name: "clean content", content: "hello world", want: service.ContentAccept},
+		{
+			name: "banned word after normalisation", content: "what a ＳＣＡＭ", want: service.ContentReject,
+			wantFilter: "banned_words", wantMatch: "scam",
+		},
+		{
+			name: "flagged word", content: "you idiot", want: service.ContentFlag,
+			wantFilter: "banned_words", wantMatch: "idiot", wantReason: vo.ReportReasonHarassment,
+		},
+		{
+			name: "blocked domain", content: "see https://www.spam.example/offer", want: service.ContentFlag,
+			wantFilter: "blocked_domains", wantMatch: "www.spam.example", wantReason: vo.ReportReasonSpam,
+		},
+		{
+			name: "a rejection later in the chain beats a flag", content: "you idiot", previous: 2,
+			want: service.ContentReject, wantFilter: "duplicates",
+		},
+		{name: "below the duplicate count", content: "hello again", previous: 1, want: service.ContentAccept},
+		{
+			name: "duplicate spam", content: "hello again", previous: 2, want: service.ContentReject,
+			wantFilter: "duplicates", wantMatch: "3 identical posts within 1h0m0s",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			authorID := uuid.New()
+
+			writeContentFilterConfig(t, config)
+
+			// Duplicates are counted over the author's posts within the window.
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().CountWithContentSince(gomock.Any(), authorID, tt.content, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, since time.Time) (int, error) {
+					assert.WithinDuration(t, time.Now().Add(-time.Hour), since, time.Minute)
+
+					return tt.previous, nil
+				}).AnyTimes()
+
+			filter, err := infra_service.NewContentFilter(postRepository)
+			require.NoError(t, err)
+
+			verdict, err := filter.Check(context.Background(), authorID, tt.content)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, verdict.Action)
+			assert.Equal(t, tt.wantFilter, verdict.Filter)
+
+			if tt.wantMatch != "" {
+				assert.Equal(t, tt.wantMatch, verdict.Match)
+			}
+
+			if tt.wantReason != "" {
+				assert.Equal(t, tt.wantReason, verdict.ReportReason)
+			}
+		})
+	}
+}
+
+func TestContentFilter_CheckFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repoErr := errors.New("db error")
+
+	writeContentFilterConfig(t, `{"duplicates": {"action": "reject", "count": 2, "windowSeconds": 60}}`)
+
+	postRepository := mock_repository.NewMockPostRepository(ctrl)
+	postRepository.EXPECT().CountWithContentSince(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(0, repoErr).Times(1)
+
+	filter, err := infra_service.NewContentFilter(postRepository)
+	require.NoError(t, err)
+
+	_, err = filter.Check(context.Background(), uuid.New(), "hello")
+	require.ErrorIs(t, err, repoErr)
+}
+
+func TestContentFilter_HotReload(t *testing.T) {
+	ctx := context.Background()
+	authorID := uuid.New()
+
+	path := writeContentFilterConfig(t, `{"bannedWords": [{"action": "reject", "words": ["scam"]}]}`)
+	t.Setenv("CONTENT_FILTER_RELOAD_INTERVAL_SECONDS", "0")
+
+	filter, err := infra_service.NewContentFilter(nil)
+	require.NoError(t, err)
+
+	verdict, err := filter.Check(ctx, authorID, "free lottery")
+	require.NoError(t, err)
+	assert.Equal(t, service.ContentAccept, verdict.Action)
+
+	// touch moves the modification time on, so that the change is seen even within the clock's resolution.
+	touch := func() {
+		later := time.Now().Add(time.Minute)
+		require.NoError(t, os.Chtimes(path, later, later))
+	}
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"bannedWords": [{"action": "reject", "words": ["lottery"]}]}`), 0o600))
+	touch()
+
+	verdict, err = filter.Check(ctx, authorID, "free lottery")
+	require.NoError(t, err)
+	assert.Equal(t, service.ContentReject, verdict.Action)
+	assert.Equal(t, "lottery", verdict.Match)
+
+	// A broken file keeps the filters that were last loaded.
+	require.NoError(t, os.WriteFile(path, []byte(`{"bannedWords": [`), 0o600))
+	touch()
+
+	verdict, err = filter.Check(ctx, authorID, "free lottery")
+	require.NoError(t, err)
+	assert.Equal(t, service.ContentReject, verdict.Action)
+}
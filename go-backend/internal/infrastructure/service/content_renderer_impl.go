@@ -0,0 +1,238 @@
+package service
+
+import (
+	"bytes"
+	"container/list"
+	"errors"
+	"fmt"
+	"html"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/google/uuid"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	markdownhtml "github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// defaultContentRenderCacheSize is how many rendered revisions are kept when CONTENT_RENDER_CACHE_SIZE is unset.
+const defaultContentRenderCacheSize = 10000
+
+var (
+	errInvalidContentRenderCacheSize = errors.New("CONTENT_RENDER_CACHE_SIZE must be a non-negative integer")
+
+	// codeLanguageClass is the class goldmark gives the code of a fenced code block with an info string.
+	codeLanguageClass = regexp.MustCompile(`^language-[\w+#-]+$`)
+	blankLines        = regexp.MustCompile(`\n(?:[ \t]*\n)+`)
+)
+
+type contentRenderKey struct {
+	postID   uuid.UUID
+	revision int
+}
+
+type contentRenderEntry struct {
+	key  contentRenderKey
+	html string
+}
+
+type contentRendererImpl struct {
+	markdown goldmark.Markdown
+	policy   *bluemonday.Policy
+
+	mu      sync.Mutex
+	size    int
+	entries map[contentRenderKey]*list.Element
+	// recent holds the cached entries, most recently used first.
+	recent *list.List
+}
+
+func (r *contentRendererImpl) Render(postID uuid.UUID, revision int, format vo.ContentFormat, source string) string {
+	key := contentRenderKey{postID: postID, revision: revision}
+	if rendered, ok := r.cached(key); ok {
+		return rendered
+	}
+
+	var rendered string
+	if format == vo.ContentFormatMarkdown {
+		rendered = r.renderMarkdown(source)
+	} else {
+		rendered = renderPlain(source)
+	}
+
+	rendered = r.policy.Sanitize(rendered)
+	r.store(key, rendered)
+
+	return rendered
+}
+
+func (r *contentRendererImpl) renderMarkdown(source string) string {
+	var buf bytes.Buffer
+	if err := r.markdown.Convert([]byte(source), &buf); err != nil {
+		// Rendering into memory does not fail, but should it, the source is still shown.
+		return renderPlain(source)
+	}
+
+	return buf.String()
+}
+
+// renderPlain escapes text and keeps its layout: blank lines separate paragraphs, other line breaks are kept.
+func renderPlain(source string) string {
+	source = strings.TrimSpace(strings.ReplaceAll(source, "\r\n", "\n"))
+	if source == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, paragraph := range blankLines.Split(source, -1) {
+		b.WriteString("<p>")
+		b.WriteString(strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>\n"))
+		b.WriteString("</p>\n")
+	}
+
+	return b.String()
+}
+
+func (r *contentRendererImpl) cached(key contentRenderKey) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	element, ok := r.entries[key]
+	if !ok {
+		return "", false
+	}
+
+	r.recent.MoveToFront(element)
+
+	return element.Value.(contentRenderEntry).html, true
+}
+
+// store caches the rendering of key, evicting the least recently used entry once the cache is full.
+func (r *contentRendererImpl) store(key contentRenderKey, rendered string) {
+	if r.size == 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if element, ok := r.entries[key]; ok {
+		r.recent.MoveToFront(element)
+
+		return
+	}
+
+	r.entries[key] = r.recent.PushFront(contentRenderEntry{key: key, html: rendered})
+
+	if r.recent.Len() > r.size {
+		oldest := r.recent.Back()
+		r.recent.Remove(oldest)
+		delete(r.entries, oldest.Value.(contentRenderEntry).key)
+	}
+}
+
+// imagesAsLinks turns images into links to the image: posts do not embed remote images, which would let
+// their hosts track readers.
+type imagesAsLinks struct{}
+
+func (imagesAsLinks) Transform(doc *ast.Document, _ text.Reader, _ parser.Context) {
+	var images []*ast.Image
+
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if image, ok := node.(*ast.Image); ok && entering {
+			images = append(images, image)
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	for _, image := range images {
+		link := ast.NewLink()
+		link.Destination = image.Destination
+		link.Title = image.Title
+
+		for child := image.FirstChild(); child != nil; {
+			next := child.NextSibling()
+			link.AppendChild(link, child)
+			child = next
+		}
+
+		image.Parent().ReplaceChild(image.Parent(), image, link)
+	}
+}
+
+// newPostMarkdown parses the Markdown subset of posts: paragraphs, emphasis, strikethrough, links, lists,
+// block quotes and code. Headings, thematic breaks and raw HTML are left as text.
+func newPostMarkdown() goldmark.Markdown {
+	return goldmark.New(
+		goldmark.WithParser(parser.NewParser(
+			parser.WithBlockParsers(
+				util.Prioritized(parser.NewListParser(), 300),
+				util.Prioritized(parser.NewListItemParser(), 400),
+				util.Prioritized(parser.NewCodeBlockParser(), 500),
+				util.Prioritized(parser.NewFencedCodeBlockParser(), 700),
+				util.Prioritized(parser.NewBlockquoteParser(), 800),
+				util.Prioritized(parser.NewParagraphParser(), 1000),
+			),
+			parser.WithInlineParsers(
+				util.Prioritized(parser.NewCodeSpanParser(), 100),
+				util.Prioritized(parser.NewLinkParser(), 200),
+				util.Prioritized(parser.NewAutoLinkParser(), 300),
+				util.Prioritized(parser.NewEmphasisParser(), 500),
+			),
+			parser.WithParagraphTransformers(util.Prioritized(parser.LinkReferenceParagraphTransformer, 100)),
+			parser.WithASTTransformers(util.Prioritized(imagesAsLinks{}, 100)),
+		)),
+		goldmark.WithExtensions(extension.Strikethrough, extension.Linkify),
+		// Posts are written like messages, where a line break is meant as one.
+		goldmark.WithRendererOptions(markdownhtml.WithHardWraps()),
+	)
+}
+
+// newPostPolicy allows the tags the renderers produce and nothing else. Links must be http, https or mailto
+// and get rel="nofollow", so that posts lend no ranking to the sites they link.
+func newPostPolicy() *bluemonday.Policy {
+	policy := bluemonday.NewPolicy()
+	policy.AllowElements("p", "br", "strong", "em", "del", "code", "pre", "blockquote", "ul", "ol", "li")
+	policy.AllowAttrs("href").OnElements("a")
+	policy.AllowURLSchemes("http", "https", "mailto")
+	policy.RequireParseableURLs(true)
+	policy.RequireNoFollowOnLinks(true)
+	policy.AllowAttrs("class").Matching(codeLanguageClass).OnElements("code")
+	policy.AllowAttrs("start").Matching(bluemonday.Integer).OnElements("ol")
+
+	return policy
+}
+
+// NewContentRenderer renders post content and keeps the renderings of the CONTENT_RENDER_CACHE_SIZE most
+// recently read revisions (10000 by default, 0 disables the cache) in memory.
+func NewContentRenderer() (service.ContentRenderer, error) {
+	size := defaultContentRenderCacheSize
+
+	if raw := os.Getenv("CONTENT_RENDER_CACHE_SIZE"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("%w: got %q", errInvalidContentRenderCacheSize, raw)
+		}
+
+		size = parsed
+	}
+
+	return &contentRendererImpl{
+		markdown: newPostMarkdown(),
+		policy:   newPostPolicy(),
+		size:     size,
+		entries:  make(map[contentRenderKey]*list.Element),
+		recent:   list.New(),
+	}, nil
+}
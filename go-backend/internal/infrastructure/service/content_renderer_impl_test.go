@@ -0,0 +1,149 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	infra_service "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newContentRenderer(t *testing.T, cacheSize string) func(format vo.ContentFormat, source string) string {
+	t.Helper()
+	t.Setenv("CONTENT_RENDER_CACHE_SIZE", cacheSize)
+
+	renderer, err := infra_service.NewContentRenderer()
+	require.NoError(t, err)
+
+	return func(format vo.ContentFormat, source string) string {
+		return renderer.Render(uuid.New(), 1, format, source)
+	}
+}
+
+func TestContentRenderer_Markdown(t *testing.T) {
+	render := newContentRenderer(t, "")
+
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{
+			name:   "emphasis",
+			source: "**bold**, *italic* and ~~gone~~",
+			want:   "<p><strong>bold</strong>, <em>italic</em> and <del>gone</del></p>\n",
+		},
+		{name: "line breaks are kept", source: "one\ntwo", want: "<p>one<br>\ntwo</p>\n"},
+		{
+			name:   "links get nofollow",
+			source: "[docs](https://example.com/docs)",
+			want:   `<p><a href="https://example.com/docs" rel="nofollow">docs</a></p>` + "\n",
+		},
+		{
+			name:   "bare URLs become links",
+			source: "see https://example.com",
+			want:   `<p>see <a href="https://example.com" rel="nofollow">https://example.com</a></p>` + "\n",
+		},
+		{name: "javascript links lose their target", source: "[click](javascript:alert(1))", want: "<p>click</p>\n"},
+		{
+			name:   "raw HTML is text",
+			source: "<script>alert(1)</script>",
+			want:   "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>\n",
+		},
+		{
+			name:   "inline event handlers are text",
+			source: `<img src=x onerror="alert(1)">`,
+			want:   "<p>&lt;img src=x onerror=&#34;alert(1)&#34;&gt;</p>\n",
+		},
+		{
+			name:   "images become links",
+			source: "![a cat](https://example.com/cat.png)",
+			want:   `<p><a href="https://example.com/cat.png" rel="nofollow">a cat</a></p>` + "\n",
+		},
+		{
+			name:   "fenced code keeps its language",
+			source: "```go\nfmt.Println(\"<hi>\")\n```",
+			want:   "<pre><code class=\"language-go\">fmt.Println(&#34;&lt;hi&gt;&#34;)\n</code></pre>\n",
+		},
+		{
+			name:   "odd languages are dropped",
+			source: "```x\" onclick=\"alert(1)\ncode\n```",
+			want:   "<pre><code>code\n</code></pre>\n",
+		},
+		{name: "inline code", source: "run `make`", want: "<p>run <code>make</code></p>\n"},
+		{name: "lists", source: "3. three\n4. four", want: "<ol start=\"3\">\n<li>three</li>\n<li>four</li>\n</ol>\n"},
+		{name: "quotes", source: "> quoted", want: "<blockquote>\n<p>quoted</p>\n</blockquote>\n"},
+		{name: "headings are text", source: "# Title", want: "<p># Title</p>\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, render(vo.ContentFormatMarkdown, tt.source))
+		})
+	}
+}
+
+func TestContentRenderer_Plain(t *testing.T) {
+	render := newContentRenderer(t, "")
+
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{
+			name:   "text is escaped",
+			source: "<b>hi</b> & **not bold**",
+			want:   "<p>&lt;b&gt;hi&lt;/b&gt; &amp; **not bold**</p>\n",
+		},
+		{name: "line breaks are kept", source: "one\r\ntwo", want: "<p>one<br>\ntwo</p>\n"},
+		{name: "blank lines separate paragraphs", source: "one\n\n \ntwo", want: "<p>one</p>\n<p>two</p>\n"},
+		{name: "empty content", source: " \n", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, render(vo.ContentFormatPlain, tt.source))
+		})
+	}
+}
+
+func TestContentRenderer_CachesPerRevision(t *testing.T) {
+	t.Setenv("CONTENT_RENDER_CACHE_SIZE", "2")
+
+	renderer, err := infra_service.NewContentRenderer()
+	require.NoError(t, err)
+
+	postID, otherID, thirdID := uuid.New(), uuid.New(), uuid.New()
+
+	// A revision never changes, so its first rendering is served for as long as it stays cached.
+	assert.Equal(t, "<p>first</p>\n", renderer.Render(postID, 1, vo.ContentFormatPlain, "first"))
+	assert.Equal(t, "<p>first</p>\n", renderer.Render(postID, 1, vo.ContentFormatPlain, "changed"))
+	assert.Equal(t, "<p>second</p>\n", renderer.Render(postID, 2, vo.ContentFormatPlain, "second"))
+
+	// Revision 1 was used more recently than revision 2, which makes way for another post.
+	renderer.Render(postID, 1, vo.ContentFormatPlain, "")
+	renderer.Render(otherID, 1, vo.ContentFormatPlain, "other")
+	assert.Equal(t, "<p>first</p>\n", renderer.Render(postID, 1, vo.ContentFormatPlain, "changed"))
+	assert.Equal(t, "<p>changed</p>\n", renderer.Render(postID, 2, vo.ContentFormatPlain, "changed"))
+
+	t.Setenv("CONTENT_RENDER_CACHE_SIZE", "0")
+
+	uncached, err := infra_service.NewContentRenderer()
+	require.NoError(t, err)
+	uncached.Render(thirdID, 1, vo.ContentFormatPlain, "first")
+	assert.Equal(t, "<p>changed</p>\n", uncached.Render(thirdID, 1, vo.ContentFormatPlain, "changed"))
+}
+
+func TestNewContentRenderer_InvalidCacheSize(t *testing.T) {
+	for _, raw := range []string{"-1", "many"} {
+		t.Run(raw, func(t *testing.T) {
+			t.Setenv("CONTENT_RENDER_CACHE_SIZE", raw)
+
+			_, err := infra_service.NewContentRenderer()
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+)
+
+// cursorKeyLabel separates the cursor key derived from AUTH_JWT_SECRET from the JWT signing key itself.
+const cursorKeyLabel = "pagination-cursor"
+
+var errMissingCursorSecret = errors.New("PAGINATION_CURSOR_SECRET or AUTH_JWT_SECRET is required")
+
+type cursorCodecImpl struct {
+	key []byte
+}
+
+func (c *cursorCodecImpl) Encode(payload []byte) string {
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(c.sign(payload))
+}
+
+func (c *cursorCodecImpl) Decode(token string) ([]byte, error) {
+	rawPayload, rawSignature, found := strings.Cut(token, ".")
+	if !found {
+		return nil, service.ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(rawPayload)
+	if err != nil {
+		return nil, service.ErrInvalidCursor
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(rawSignature)
+	if err != nil || !hmac.Equal(signature, c.sign(payload)) {
+		return nil, service.ErrInvalidCursor
+	}
+
+	return payload, nil
+}
+
+func (c *cursorCodecImpl) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
+
+// NewCursorCodec signs cursors with PAGINATION_CURSOR_SECRET. When it is unset, a key is derived from
+// AUTH_JWT_SECRET so that existing deployments need no new configuration.
+func NewCursorCodec() (service.CursorCodec, error) {
+	if secret := os.Getenv("PAGINATION_CURSOR_SECRET"); secret != "" {
+		return &cursorCodecImpl{key: []byte(secret)}, nil
+	}
+
+	jwtSecret := os.Getenv("AUTH_JWT_SECRET")
+	if jwtSecret == "" {
+		return nil, errMissingCursorSecret
+	}
+
+	mac := hmac.New(sha256.New, []byte(jwtSecret))
+	mac.Write([]byte(cursorKeyLabel))
+
+	return &cursorCodecImpl{key: mac.Sum(nil)}, nil
+}
@@ -0,0 +1,85 @@
+package service_test
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	infra_service "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/service"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorCodec_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name         string
+		cursorSecret string
+		jwtSecret    string
+	}{
+		{name: "dedicated secret", cursorSecret: "cursor-secret"},
+		{name: "key derived from the JWT secret", jwtSecret: "jwt-secret"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("PAGINATION_CURSOR_SECRET", tt.cursorSecret)
+			t.Setenv("AUTH_JWT_SECRET", tt.jwtSecret)
+
+			codec, err := infra_service.NewCursorCodec()
+			require.NoError(t, err)
+
+			token := codec.Encode([]byte("position"))
+			payload, err := codec.Decode(token)
+
+			require.NoError(t, err)
+			assert.Equal(t, []byte("position"), payload)
+		})
+	}
+}
+
+func TestCursorCodec_Decode_FailureCase(t *testing.T) {
+	t.Setenv("PAGINATION_CURSOR_SECRET", "cursor-secret")
+
+	codec, err := infra_service.NewCursorCodec()
+	require.NoError(t, err)
+
+	valid := codec.Encode([]byte("position"))
+	payload, signature, _ := strings.Cut(valid, ".")
+
+	t.Setenv("PAGINATION_CURSOR_SECRET", "other-secret")
+
+	otherCodec, err := infra_service.NewCursorCodec()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "empty", token: ""},
+		{name: "no signature", token: payload},
+		{name: "payload is not base64", token: "!!!." + signature},
+		{name: "signature is not base64", token: payload + ".!!!"},
+		{name: "tampered payload", token: base64.RawURLEncoding.EncodeToString([]byte("elsewhere")) + "." + signature},
+		{name: "signed with another key", token: otherCodec.Encode([]byte("position"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, err := codec.Decode(tt.token)
+
+			require.ErrorIs(t, err, service.ErrInvalidCursor)
+			assert.Nil(t, decoded)
+		})
+	}
+}
+
+func TestNewCursorCodec_MissingSecret(t *testing.T) {
+	t.Setenv("PAGINATION_CURSOR_SECRET", "")
+	t.Setenv("AUTH_JWT_SECRET", "")
+
+	codec, err := infra_service.NewCursorCodec()
+
+	require.Error(t, err)
+	assert.Nil(t, codec)
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif"  // registers the GIF decoder with image.Decode
+	_ "image/jpeg" // registers the JPEG decoder with image.Decode
+	"image/png"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // registers the WebP decoder with image.Decode
+)
+
+const (
+	// thumbnailMaxSide bounds the longer side of thumbnails; smaller images keep their size.
+	thumbnailMaxSide = 320
+	// maxDecodedImagePixels refuses images whose decoded form would be huge even though the file is small.
+	maxDecodedImagePixels = 50_000_000
+)
+
+// imageThumbnailerImpl decodes PNG, JPEG, GIF (first frame) and WebP in pure Go and renders PNG thumbnails.
+type imageThumbnailerImpl struct{}
+
+func (t *imageThumbnailerImpl) Thumbnail(data []byte) (*service.ImageThumbnail, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", service.ErrUndecodableImage, err)
+	}
+
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxDecodedImagePixels {
+		return nil, fmt.Errorf("%w: %dx%d pixels", service.ErrUndecodableImage, config.Width, config.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", service.ErrUndecodableImage, err)
+	}
+
+	width, height := thumbnailSize(config.Width, config.Height)
+	thumbnail := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(thumbnail, thumbnail.Bounds(), img, img.Bounds(), draw.Src, nil)
+
+	var encoded bytes.Buffer
+	if err = png.Encode(&encoded, thumbnail); err != nil {
+		return nil, err
+	}
+
+	return &service.ImageThumbnail{Width: config.Width, Height: config.Height, PNG: encoded.Bytes()}, nil
+}
+
+// thumbnailSize scales width x height down to fit thumbnailMaxSide, keeping the aspect ratio.
+func thumbnailSize(width, height int) (int, int) {
+	longer := max(width, height)
+	if longer <= thumbnailMaxSide {
+		return width, height
+	}
+
+	return max(1, width*thumbnailMaxSide/longer), max(1, height*thumbnailMaxSide/longer)
+}
+
+func NewImageThumbnailer() service.ImageThumbnailer {
+	return &imageThumbnailerImpl{}
+}
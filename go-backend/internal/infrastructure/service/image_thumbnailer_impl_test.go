@@ -0,0 +1,88 @@
+package service_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"testing"
+
+	infra_service "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/service"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeTestImage(t *testing.T, width, height int, encode func(io.Writer, image.Image) error) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := range width {
+		img.Set(x, height/2, color.RGBA{R: 255, A: 255})
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, encode(&buf, img))
+
+	return buf.Bytes()
+}
+
+func TestImageThumbnailer_Thumbnail(t *testing.T) {
+	encoders := map[string]func(io.Writer, image.Image) error{
+		"png":  png.Encode,
+		"jpeg": func(w io.Writer, img image.Image) error { return jpeg.Encode(w, img, nil) },
+		"gif":  func(w io.Writer, img image.Image) error { return gif.Encode(w, img, nil) },
+	}
+
+	tests := []struct {
+		name                   string
+		width, height          int
+		wantThumbW, wantThumbH int
+	}{
+		{name: "landscape is scaled to the maximum width", width: 800, height: 400, wantThumbW: 320, wantThumbH: 160},
+		{name: "portrait is scaled to the maximum height", width: 300, height: 960, wantThumbW: 100, wantThumbH: 320},
+		{name: "small images keep their size", width: 40, height: 30, wantThumbW: 40, wantThumbH: 30},
+	}
+
+	thumbnailer := infra_service.NewImageThumbnailer()
+
+	for format, encode := range encoders {
+		for _, tt := range tests {
+			t.Run(format+"/"+tt.name, func(t *testing.T) {
+				thumbnail, err := thumbnailer.Thumbnail(encodeTestImage(t, tt.width, tt.height, encode))
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.width, thumbnail.Width)
+				assert.Equal(t, tt.height, thumbnail.Height)
+
+				decoded, err := png.Decode(bytes.NewReader(thumbnail.PNG))
+				require.NoError(t, err)
+				assert.Equal(t, image.Rect(0, 0, tt.wantThumbW, tt.wantThumbH), decoded.Bounds())
+			})
+		}
+	}
+}
+
+func TestImageThumbnailer_UndecodableImage(t *testing.T) {
+	valid := encodeTestImage(t, 10, 10, png.Encode)
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "not an image", data: []byte("hello")},
+		{name: "truncated image", data: valid[:len(valid)/2]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			thumbnail, err := infra_service.NewImageThumbnailer().Thumbnail(tt.data)
+
+			require.ErrorIs(t, err, service.ErrUndecodableImage)
+			assert.Nil(t, thumbnail)
+		})
+	}
+}
@@ -16,27 +16,31 @@ import (
 	"github.com/Haya372/web-app-template/go-backend/internal/common"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
 	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 )
 
 const (
-	defaultJWTTTLMinutes = 60
-	jwtPartsCount        = 3
+	defaultJWTTTLMinutes           = 60
+	defaultImpersonationTTLMinutes = 15
+	jwtPartsCount                  = 3
 )
 
 var (
 	errMissingJWTSecret = errors.New("AUTH_JWT_SECRET is required")
 	errInvalidJWTTTL    = errors.New("AUTH_JWT_TTL_MINUTES must be positive int")
+	errInvalidImpTTL    = errors.New("AUTH_IMPERSONATION_TTL_MINUTES must be positive int")
 	errInvalidJWTFormat = errors.New("invalid JWT format")
 	errInvalidSignature = errors.New("invalid JWT signature")
 	errTokenExpired     = errors.New("JWT token has expired")
 )
 
 type jwtConfig struct {
-	secret []byte
-	ttl    time.Duration
+	secret           []byte
+	ttl              time.Duration
+	impersonationTTL time.Duration
 }
 
 type jwtServiceImpl struct {
@@ -51,56 +55,69 @@ type jwtHeader struct {
 }
 
 type jwtClaims struct {
-	Subject   string `json:"sub"`
-	ExpiresAt int64  `json:"exp"`
-	IssuedAt  int64  `json:"iat"`
+	Subject   string         `json:"sub"`
+	Actor     *jwtActorClaim `json:"act,omitempty"`
+	TenantID  string         `json:"tid,omitempty"`
+	ExpiresAt int64          `json:"exp"`
+	IssuedAt  int64          `json:"iat"`
+}
+
+// jwtActorClaim follows the RFC 8693 "act" claim: the party acting on behalf of the subject.
+type jwtActorClaim struct {
+	Subject string `json:"sub"`
 }
 
 func (g *jwtServiceImpl) GenerateUserAccessToken(
 	ctx context.Context,
 	user entity.User,
+	tenantID uuid.UUID,
 ) (*service.UserAccessToken, error) {
 	ctx, span := g.tracer.Start(ctx, "Generate")
 	defer span.End()
 
 	now := time.Now().UTC()
-	expiresAt := now.Add(g.config.ttl)
 
-	header := jwtHeader{
-		Algorithm: "HS256",
-		Type:      "JWT",
-	}
-	claims := jwtClaims{
+	token, err := g.sign(ctx, jwtClaims{
 		Subject:   user.ID().String(),
-		ExpiresAt: expiresAt.Unix(),
+		TenantID:  tenantClaim(tenantID),
+		ExpiresAt: now.Add(g.config.ttl).Unix(),
 		IssuedAt:  now.Unix(),
-	}
-
-	headerSegment, err := encodeJWTSection(header)
+	})
 	if err != nil {
-		g.logger.Error(ctx, "failed to encode jwt header", "error", err)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 
 		return nil, err
 	}
 
-	claimsSegment, err := encodeJWTSection(claims)
+	return token, nil
+}
+
+func (g *jwtServiceImpl) GenerateImpersonationToken(
+	ctx context.Context,
+	user entity.User,
+	actorID, tenantID uuid.UUID,
+) (*service.UserAccessToken, error) {
+	ctx, span := g.tracer.Start(ctx, "GenerateImpersonationToken")
+	defer span.End()
+
+	now := time.Now().UTC()
+
+	token, err := g.sign(ctx, jwtClaims{
+		Subject:   user.ID().String(),
+		Actor:     &jwtActorClaim{Subject: actorID.String()},
+		TenantID:  tenantClaim(tenantID),
+		ExpiresAt: now.Add(g.config.impersonationTTL).Unix(),
+		IssuedAt:  now.Unix(),
+	})
 	if err != nil {
-		g.logger.Error(ctx, "failed to encode jwt claims", "error", err)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 
 		return nil, err
 	}
 
-	signingInput := fmt.Sprintf("%s.%s", headerSegment, claimsSegment)
-	signature := signHS256(g.config.secret, signingInput)
-
-	return &service.UserAccessToken{
-		Value:     fmt.Sprintf("%s.%s", signingInput, signature),
-		ExpiresAt: expiresAt,
-	}, nil
+	return token, nil
 }
 
 func (g *jwtServiceImpl) ValidateToken(ctx context.Context, token string) (*service.TokenClaims, error) {
@@ -148,7 +165,41 @@ func (g *jwtServiceImpl) ValidateToken(ctx context.Context, token string) (*serv
 		return nil, errTokenExpired
 	}
 
-	return &service.TokenClaims{UserID: claims.Subject}, nil
+	result := &service.TokenClaims{UserID: claims.Subject, TenantID: claims.TenantID}
+	if claims.Actor != nil {
+		result.ActorID = claims.Actor.Subject
+	}
+
+	return result, nil
+}
+
+func (g *jwtServiceImpl) sign(ctx context.Context, claims jwtClaims) (*service.UserAccessToken, error) {
+	header := jwtHeader{
+		Algorithm: "HS256",
+		Type:      "JWT",
+	}
+
+	headerSegment, err := encodeJWTSection(header)
+	if err != nil {
+		g.logger.Error(ctx, "failed to encode jwt header", "error", err)
+
+		return nil, err
+	}
+
+	claimsSegment, err := encodeJWTSection(claims)
+	if err != nil {
+		g.logger.Error(ctx, "failed to encode jwt claims", "error", err)
+
+		return nil, err
+	}
+
+	signingInput := fmt.Sprintf("%s.%s", headerSegment, claimsSegment)
+	signature := signHS256(g.config.secret, signingInput)
+
+	return &service.UserAccessToken{
+		Value:     fmt.Sprintf("%s.%s", signingInput, signature),
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0).UTC(),
+	}, nil
 }
 
 func NewJwtService() (service.JwtService, error) {
@@ -181,12 +232,33 @@ func loadJWTConfig() (jwtConfig, error) {
 		ttlMinutes = parsed
 	}
 
+	impersonationTTLMinutes := defaultImpersonationTTLMinutes
+
+	if rawTTL := os.Getenv("AUTH_IMPERSONATION_TTL_MINUTES"); rawTTL != "" {
+		parsed, err := strconv.Atoi(rawTTL)
+		if err != nil || parsed <= 0 {
+			return jwtConfig{}, fmt.Errorf("%w: got %q", errInvalidImpTTL, rawTTL)
+		}
+
+		impersonationTTLMinutes = parsed
+	}
+
 	return jwtConfig{
-		secret: []byte(secret),
-		ttl:    time.Duration(ttlMinutes) * time.Minute,
+		secret:           []byte(secret),
+		ttl:              time.Duration(ttlMinutes) * time.Minute,
+		impersonationTTL: time.Duration(impersonationTTLMinutes) * time.Minute,
 	}, nil
 }
 
+// tenantClaim leaves the "tid" claim out of tokens issued without a tenant.
+func tenantClaim(tenantID uuid.UUID) string {
+	if tenantID == uuid.Nil {
+		return ""
+	}
+
+	return tenantID.String()
+}
+
 func encodeJWTSection(value any) (string, error) {
 	payload, err := json.Marshal(value)
 	if err != nil {
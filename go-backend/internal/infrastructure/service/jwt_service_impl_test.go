@@ -11,6 +11,7 @@ import (
 
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
 	infra_service "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/service"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -37,7 +38,7 @@ func TestJwtService_GenerateUserAccessToken(t *testing.T) {
 	require.NoError(t, err)
 
 	now := time.Now().UTC()
-	token, err := svc.GenerateUserAccessToken(t.Context(), user)
+	token, err := svc.GenerateUserAccessToken(t.Context(), user, uuid.Nil)
 	require.NoError(t, err)
 	require.NotNil(t, token)
 
@@ -100,13 +101,83 @@ func TestJwtService_ValidateToken_Valid(t *testing.T) {
 	user, err := entity.NewUser("test@example.com", "password", "Test", time.Date(2026, 2, 14, 0, 0, 0, 0, time.UTC))
 	require.NoError(t, err)
 
-	token, err := svc.GenerateUserAccessToken(t.Context(), user)
+	token, err := svc.GenerateUserAccessToken(t.Context(), user, uuid.Nil)
 	require.NoError(t, err)
 
 	claims, err := svc.ValidateToken(t.Context(), token.Value)
 	require.NoError(t, err)
 	require.NotNil(t, claims)
 	assert.Equal(t, user.ID().String(), claims.UserID)
+	assert.Empty(t, claims.ActorID)
+	assert.Empty(t, claims.TenantID)
+}
+
+func TestJwtService_ValidateToken_CarriesTenant(t *testing.T) {
+	t.Setenv("AUTH_JWT_SECRET", "test-secret")
+	t.Setenv("AUTH_JWT_TTL_MINUTES", "5")
+
+	svc, err := infra_service.NewJwtService()
+	require.NoError(t, err)
+
+	user, err := entity.NewUser("test@example.com", "password", "Test", time.Date(2026, 2, 14, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	tenantID := uuid.New()
+
+	token, err := svc.GenerateUserAccessToken(t.Context(), user, tenantID)
+	require.NoError(t, err)
+
+	claims, err := svc.ValidateToken(t.Context(), token.Value)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID().String(), claims.UserID)
+	assert.Equal(t, tenantID.String(), claims.TenantID)
+}
+
+func TestJwtService_GenerateImpersonationToken(t *testing.T) {
+	t.Setenv("AUTH_JWT_SECRET", "test-secret")
+	t.Setenv("AUTH_JWT_TTL_MINUTES", "60")
+	t.Setenv("AUTH_IMPERSONATION_TTL_MINUTES", "10")
+
+	svc, err := infra_service.NewJwtService()
+	require.NoError(t, err)
+
+	user, err := entity.NewUser("test@example.com", "password", "Test", time.Date(2026, 2, 14, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	actorID, tenantID := uuid.New(), uuid.New()
+	now := time.Now().UTC()
+
+	token, err := svc.GenerateImpersonationToken(t.Context(), user, actorID, tenantID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, now.Add(10*time.Minute), token.ExpiresAt, 2*time.Second)
+
+	parts := strings.Split(token.Value, ".")
+	require.Len(t, parts, 3)
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(payload, &raw))
+	assert.Equal(t, user.ID().String(), raw["sub"])
+	assert.Equal(t, map[string]any{"sub": actorID.String()}, raw["act"])
+	assert.Equal(t, tenantID.String(), raw["tid"])
+
+	claims, err := svc.ValidateToken(t.Context(), token.Value)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID().String(), claims.UserID)
+	assert.Equal(t, actorID.String(), claims.ActorID)
+	assert.Equal(t, tenantID.String(), claims.TenantID)
+}
+
+func TestJwtService_NewJwtService_InvalidImpersonationTTL(t *testing.T) {
+	t.Setenv("AUTH_JWT_SECRET", "test-secret")
+	t.Setenv("AUTH_JWT_TTL_MINUTES", "")
+	t.Setenv("AUTH_IMPERSONATION_TTL_MINUTES", "0")
+
+	svc, err := infra_service.NewJwtService()
+	require.Error(t, err)
+	assert.Nil(t, svc)
 }
 
 func TestJwtService_ValidateToken_InvalidFormat(t *testing.T) {
@@ -131,7 +202,7 @@ func TestJwtService_ValidateToken_InvalidSignature(t *testing.T) {
 	user, err := entity.NewUser("test@example.com", "password", "Test", time.Date(2026, 2, 14, 0, 0, 0, 0, time.UTC))
 	require.NoError(t, err)
 
-	token, err := svc.GenerateUserAccessToken(t.Context(), user)
+	token, err := svc.GenerateUserAccessToken(t.Context(), user, uuid.Nil)
 	require.NoError(t, err)
 
 	parts := strings.Split(token.Value, ".")
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	blobDirPerm  = 0o750
+	blobFilePerm = 0o640
+)
+
+var (
+	errInvalidBlobKey   = errors.New("invalid blob key")
+	errBlobSizeMismatch = errors.New("blob content does not match its size")
+)
+
+// localBlobStorage keeps each blob in a file at its key below root. It suits development and single-node
+// deployments; the files are not shared between hosts.
+type localBlobStorage struct {
+	tracer trace.Tracer
+	root   string
+}
+
+func (s *localBlobStorage) Put(ctx context.Context, key string, content io.Reader, size int64, _ string) error {
+	_, span := s.tracer.Start(ctx, "Put")
+	defer span.End()
+
+	err := s.put(key, content, size)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// put writes to a temporary file that is renamed into place, so that readers never see a partial blob.
+func (s *localBlobStorage) put(key string, content io.Reader, size int64) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), blobDirPerm); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	written, err := io.Copy(tmp, io.LimitReader(content, size+1))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if written != size {
+		return fmt.Errorf("%w: got %d bytes, want %d", errBlobSizeMismatch, written, size)
+	}
+
+	if err = os.Chmod(tmp.Name(), blobFilePerm); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *localBlobStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	_, span := s.tracer.Start(ctx, "Open")
+	defer span.End()
+
+	path, err := s.path(key)
+	if err == nil {
+		var file *os.File
+
+		file, err = os.Open(path) //nolint:gosec // path is confined to root by s.path
+		if err == nil {
+			return file, nil
+		}
+
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, service.ErrBlobNotFound
+		}
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+
+	return nil, err
+}
+
+func (s *localBlobStorage) Delete(ctx context.Context, key string) error {
+	_, span := s.tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	path, err := s.path(key)
+	if err == nil {
+		err = os.Remove(path)
+	}
+
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// path maps key to a file below root, refusing keys that would leave it.
+func (s *localBlobStorage) path(key string) (string, error) {
+	rel := filepath.FromSlash(key)
+	if !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("%w: %q", errInvalidBlobKey, key)
+	}
+
+	return filepath.Join(s.root, rel), nil
+}
+
+func newLocalBlobStorage(dir string) (service.BlobStorage, error) {
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = os.MkdirAll(root, blobDirPerm); err != nil {
+		return nil, err
+	}
+
+	return &localBlobStorage{
+		tracer: otel.Tracer("LocalBlobStorage"),
+		root:   root,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"os"
+	"strings"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+)
+
+// NewSignupPolicy loads the signup policy from SIGNUP_MODE (open, invite_only, or allowed_domains;
+// default open) and SIGNUP_ALLOWED_DOMAINS (comma-separated, surrounding spaces ignored, used by allowed_domains).
+func NewSignupPolicy() (vo.SignupPolicy, error) {
+	mode := vo.SignupModeOpen
+
+	if raw := os.Getenv("SIGNUP_MODE"); raw != "" {
+		parsed, err := vo.SignupModeFromString(raw)
+		if err != nil {
+			return vo.SignupPolicy{}, err
+		}
+
+		mode = parsed
+	}
+
+	var domains []string
+
+	for domain := range strings.SplitSeq(os.Getenv("SIGNUP_ALLOWED_DOMAINS"), ",") {
+		if domain = strings.TrimSpace(domain); domain != "" {
+			domains = append(domains, domain)
+		}
+	}
+
+	return vo.NewSignupPolicy(mode, domains)
+}
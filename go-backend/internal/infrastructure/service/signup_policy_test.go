@@ -0,0 +1,93 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	infra_service "github.com/Haya372/web-app-template/go-backend/internal/infrastructure/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSignupPolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		mode         string
+		domains      string
+		wantMode     vo.SignupMode
+		wantAdmitted []string
+		wantRefused  []string
+		wantErr      bool
+	}{
+		{
+			name:         "defaults to open",
+			wantMode:     vo.SignupModeOpen,
+			wantAdmitted: []string{"anyone@example.com"},
+		},
+		{
+			name:        "invite only",
+			mode:        "invite_only",
+			wantMode:    vo.SignupModeInviteOnly,
+			wantRefused: []string{"anyone@example.com"},
+		},
+		{
+			name:         "allowed domains are split on commas",
+			mode:         "allowed_domains",
+			domains:      "a.example,b.example",
+			wantMode:     vo.SignupModeAllowedDomains,
+			wantAdmitted: []string{"user@a.example", "user@b.example"},
+			wantRefused:  []string{"user@c.example"},
+		},
+		{
+			name:         "spaces around allowed domains are ignored",
+			mode:         "allowed_domains",
+			domains:      " a.example, b.example ,,",
+			wantMode:     vo.SignupModeAllowedDomains,
+			wantAdmitted: []string{"user@a.example", "user@b.example"},
+			wantRefused:  []string{"user@c.example"},
+		},
+		{
+			name:    "invalid mode",
+			mode:    "closed",
+			wantErr: true,
+		},
+		{
+			name:    "allowed domains without any domain",
+			mode:    "allowed_domains",
+			domains: " , ",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SIGNUP_MODE", tt.mode)
+			t.Setenv("SIGNUP_ALLOWED_DOMAINS", tt.domains)
+
+			policy, err := infra_service.NewSignupPolicy()
+
+			if tt.wantErr {
+				var voErr vo.Error
+				require.ErrorAs(t, err, &voErr)
+				assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMode, policy.Mode())
+
+			for _, address := range tt.wantAdmitted {
+				email, err := vo.NewEmail(address)
+				require.NoError(t, err)
+				assert.True(t, policy.AdmitsUninvited(*email), address)
+			}
+
+			for _, address := range tt.wantRefused {
+				email, err := vo.NewEmail(address)
+				require.NoError(t, err)
+				assert.False(t, policy.AdmitsUninvited(*email), address)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
+)
+
+const (
+	defaultRoleAssignmentSweepIntervalSeconds = 60
+	defaultAttachmentSweepIntervalSeconds     = 3600
+	defaultDuePostPublishIntervalSeconds      = 60
+	defaultTrashPurgeIntervalSeconds          = 3600
+)
+
+var errInvalidInterval = errors.New("job interval must be positive int seconds")
+
+// NewWorker wires the application's background jobs.
+//
+// Intervals are configured via environment variables:
+//   - WORKER_ROLE_ASSIGNMENT_SWEEP_INTERVAL_SECONDS (default 60)
+//   - WORKER_ATTACHMENT_SWEEP_INTERVAL_SECONDS (default 3600)
+//   - WORKER_DUE_POST_PUBLISH_INTERVAL_SECONDS (default 60)
+//   - WORKER_TRASH_PURGE_INTERVAL_SECONDS (default 3600)
+func NewWorker(
+	sweepUseCase user.SweepExpiredRoleAssignmentsUseCase,
+	attachmentSweepUseCase post.SweepOrphanedAttachmentsUseCase,
+	publishDuePostsUseCase post.PublishDuePostsUseCase,
+	purgeTrashedPostsUseCase post.PurgeTrashedPostsUseCase,
+) (*Worker, error) {
+	sweepInterval, err := loadInterval(
+		"WORKER_ROLE_ASSIGNMENT_SWEEP_INTERVAL_SECONDS", defaultRoleAssignmentSweepIntervalSeconds,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	attachmentSweepInterval, err := loadInterval(
+		"WORKER_ATTACHMENT_SWEEP_INTERVAL_SECONDS", defaultAttachmentSweepIntervalSeconds,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	duePostPublishInterval, err := loadInterval(
+		"WORKER_DUE_POST_PUBLISH_INTERVAL_SECONDS", defaultDuePostPublishIntervalSeconds,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	trashPurgeInterval, err := loadInterval("WORKER_TRASH_PURGE_INTERVAL_SECONDS", defaultTrashPurgeIntervalSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	return newWorker(
+		Job{
+			Name:     "sweepExpiredRoleAssignments",
+			Interval: sweepInterval,
+			Run: func(ctx context.Context) error {
+				_, err := sweepUseCase.Execute(ctx)
+
+				return err
+			},
+		},
+		Job{
+			Name:     "sweepOrphanedAttachments",
+			Interval: attachmentSweepInterval,
+			Run: func(ctx context.Context) error {
+				_, err := attachmentSweepUseCase.Execute(ctx)
+
+				return err
+			},
+		},
+		Job{
+			Name:     "publishDuePosts",
+			Interval: duePostPublishInterval,
+			Run: func(ctx context.Context) error {
+				_, err := publishDuePostsUseCase.Execute(ctx)
+
+				return err
+			},
+		},
+		Job{
+			Name:     "purgeTrashedPosts",
+			Interval: trashPurgeInterval,
+			Run: func(ctx context.Context) error {
+				_, err := purgeTrashedPostsUseCase.Execute(ctx)
+
+				return err
+			},
+		},
+	), nil
+}
+
+func loadInterval(envKey string, defaultSeconds int) (time.Duration, error) {
+	seconds := defaultSeconds
+
+	if raw := os.Getenv(envKey); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			return 0, fmt.Errorf("%w: %s=%q", errInvalidInterval, envKey, raw)
+		}
+
+		seconds = parsed
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
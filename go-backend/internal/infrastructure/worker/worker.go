@@ -0,0 +1,82 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Job is a unit of periodic background work.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Worker runs a fixed set of Jobs on their own intervals until its context is cancelled.
+// A failing run is logged and retried on the next tick; it never stops the worker.
+type Worker struct {
+	tracer trace.Tracer
+	logger common.Logger
+	jobs   []Job
+}
+
+// Jobs returns the scheduled jobs.
+func (w *Worker) Jobs() []Job {
+	return w.jobs
+}
+
+// Start runs every job once immediately and then on each tick of its interval.
+// It blocks until ctx is cancelled and all in-flight runs have returned.
+func (w *Worker) Start(ctx context.Context) error {
+	var wg sync.WaitGroup
+
+	for _, job := range w.jobs {
+		wg.Go(func() {
+			w.loop(ctx, job)
+		})
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+func (w *Worker) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		w.runOnce(ctx, job)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Worker) runOnce(ctx context.Context, job Job) {
+	ctx, span := w.tracer.Start(ctx, job.Name)
+	defer span.End()
+
+	if err := job.Run(ctx); err != nil {
+		w.logger.Error(ctx, "job failed", "job", job.Name, "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+func newWorker(jobs ...Job) *Worker {
+	return &Worker{
+		tracer: otel.Tracer("Worker"),
+		logger: common.NewLogger(),
+		jobs:   jobs,
+	}
+}
@@ -0,0 +1,119 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/infrastructure/worker"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSweepUseCase struct {
+	calls  atomic.Int32
+	onCall func(n int32)
+}
+
+func (f *fakeSweepUseCase) Execute(context.Context) (*user.SweepExpiredRoleAssignmentsOutput, error) {
+	n := f.calls.Add(1)
+	if f.onCall != nil {
+		f.onCall(n)
+	}
+
+	return nil, errors.New("db error")
+}
+
+type fakeAttachmentSweepUseCase struct{}
+
+func (f *fakeAttachmentSweepUseCase) Execute(context.Context) (*post.SweepOrphanedAttachmentsOutput, error) {
+	return &post.SweepOrphanedAttachmentsOutput{}, nil
+}
+
+type fakePublishDuePostsUseCase struct{}
+
+func (f *fakePublishDuePostsUseCase) Execute(context.Context) (*post.PublishDuePostsOutput, error) {
+	return &post.PublishDuePostsOutput{}, nil
+}
+
+type fakePurgeTrashedPostsUseCase struct{}
+
+func (f *fakePurgeTrashedPostsUseCase) Execute(context.Context) (*post.PurgeTrashedPostsOutput, error) {
+	return &post.PurgeTrashedPostsOutput{}, nil
+}
+
+func TestNewWorker_DefaultInterval(t *testing.T) {
+	t.Setenv("WORKER_ROLE_ASSIGNMENT_SWEEP_INTERVAL_SECONDS", "")
+	t.Setenv("WORKER_ATTACHMENT_SWEEP_INTERVAL_SECONDS", "")
+	t.Setenv("WORKER_DUE_POST_PUBLISH_INTERVAL_SECONDS", "")
+	t.Setenv("WORKER_TRASH_PURGE_INTERVAL_SECONDS", "")
+
+	w, err := worker.NewWorker(
+		&fakeSweepUseCase{}, &fakeAttachmentSweepUseCase{}, &fakePublishDuePostsUseCase{},
+		&fakePurgeTrashedPostsUseCase{},
+	)
+
+	require.NoError(t, err)
+	require.Len(t, w.Jobs(), 4)
+	assert.Equal(t, time.Minute, w.Jobs()[0].Interval)
+	assert.Equal(t, time.Hour, w.Jobs()[1].Interval)
+	assert.Equal(t, time.Minute, w.Jobs()[2].Interval)
+	assert.Equal(t, time.Hour, w.Jobs()[3].Interval)
+}
+
+func TestNewWorker_InvalidInterval(t *testing.T) {
+	for _, envKey := range []string{
+		"WORKER_ROLE_ASSIGNMENT_SWEEP_INTERVAL_SECONDS", "WORKER_ATTACHMENT_SWEEP_INTERVAL_SECONDS",
+		"WORKER_DUE_POST_PUBLISH_INTERVAL_SECONDS", "WORKER_TRASH_PURGE_INTERVAL_SECONDS",
+	} {
+		for _, raw := range []string{"0", "-5", "abc"} {
+			t.Run(envKey+"="+raw, func(t *testing.T) {
+				t.Setenv(envKey, raw)
+
+				w, err := worker.NewWorker(
+					&fakeSweepUseCase{}, &fakeAttachmentSweepUseCase{}, &fakePublishDuePostsUseCase{},
+					&fakePurgeTrashedPostsUseCase{},
+				)
+
+				require.Error(t, err)
+				assert.Nil(t, w)
+			})
+		}
+	}
+}
+
+func TestWorker_StartRunsJobsUntilCancelled(t *testing.T) {
+	t.Setenv("WORKER_ROLE_ASSIGNMENT_SWEEP_INTERVAL_SECONDS", "1")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// A failing run must not stop the worker; cancel once the job has run twice.
+	sweep := &fakeSweepUseCase{onCall: func(n int32) {
+		if n == 2 {
+			cancel()
+		}
+	}}
+
+	w, err := worker.NewWorker(
+		sweep, &fakeAttachmentSweepUseCase{}, &fakePublishDuePostsUseCase{}, &fakePurgeTrashedPostsUseCase{},
+	)
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+
+	go func() { done <- w.Start(ctx) }()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("worker did not stop after context cancellation")
+	}
+
+	assert.Equal(t, int32(2), sweep.calls.Load())
+}
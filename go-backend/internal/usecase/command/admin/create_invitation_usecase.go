@@ -0,0 +1,139 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultInvitationTTL applies when the admin does not choose an expiry.
+const defaultInvitationTTL = 7 * 24 * time.Hour
+
+var errLacksInvitePerm = errors.New("user lacks users:invite permission")
+
+// CreateInvitationUseCase lets an admin invite an email address to sign up with pre-assigned roles.
+type CreateInvitationUseCase interface {
+	Execute(ctx context.Context, input CreateInvitationInput) (*CreateInvitationOutput, error)
+}
+
+type CreateInvitationInput struct {
+	ActorID uuid.UUID
+	Email   string
+	RoleIDs []uuid.UUID
+	// ExpiresAt defaults to defaultInvitationTTL from now when nil.
+	ExpiresAt *time.Time
+}
+
+type CreateInvitationOutput struct {
+	ID        uuid.UUID
+	Email     string
+	RoleIDs   []uuid.UUID
+	ExpiresAt time.Time
+	// Token is the only copy of the secret; it is not stored and cannot be retrieved later.
+	Token string
+}
+
+type createInvitationUseCaseImpl struct {
+	tracer               trace.Tracer
+	logger               common.Logger
+	permissionRepository aggregaterepository.UserPermissionRepository
+	invitationRepository repository.InvitationRepository
+	txManager            shared.TransactionManager
+}
+
+func (uc *createInvitationUseCaseImpl) Execute(
+	ctx context.Context, input CreateInvitationInput,
+) (*CreateInvitationOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	actor, err := uc.permissionRepository.FindByUserID(ctx, input.ActorID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	if !actor.HasPermission(vo.PermissionUsersInvite) {
+		span.RecordError(errLacksInvitePerm)
+		span.SetStatus(codes.Error, errLacksInvitePerm.Error())
+
+		return nil, vo.NewForbiddenError("insufficient permissions", nil, errLacksInvitePerm)
+	}
+
+	token, err := vo.GenerateInvitationToken()
+	if err != nil {
+		uc.logger.Error(ctx, "failed to generate invitation token", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	now := time.Now()
+
+	expiresAt := now.Add(defaultInvitationTTL)
+	if input.ExpiresAt != nil {
+		expiresAt = *input.ExpiresAt
+	}
+
+	invitation, err := entity.NewInvitation(input.Email, input.RoleIDs, input.ActorID, token, expiresAt, now)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		if repoErr := uc.invitationRepository.Create(ctx, invitation); repoErr != nil {
+			uc.logger.Error(ctx, "failed to save Invitation", "error", repoErr)
+
+			return repoErr
+		}
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	uc.logger.Info(ctx, "invitation created", "invitationID", invitation.ID(), "expiresAt", invitation.ExpiresAt())
+
+	return &CreateInvitationOutput{
+		ID:        invitation.ID(),
+		Email:     invitation.Email(),
+		RoleIDs:   invitation.RoleIDs(),
+		ExpiresAt: invitation.ExpiresAt(),
+		Token:     token.String(),
+	}, nil
+}
+
+func NewCreateInvitationUseCase(
+	permissionRepository aggregaterepository.UserPermissionRepository,
+	invitationRepository repository.InvitationRepository,
+	txManager shared.TransactionManager,
+) CreateInvitationUseCase {
+	return &createInvitationUseCaseImpl{
+		tracer:               otel.Tracer("CreateInvitationUseCase"),
+		logger:               common.NewLogger(),
+		permissionRepository: permissionRepository,
+		invitationRepository: invitationRepository,
+		txManager:            txManager,
+	}
+}
@@ -0,0 +1,173 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/admin"
+	mock_aggregate_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/aggregate/repository"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateInvitationUseCase_HappyCase(t *testing.T) {
+	customExpiry := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+	roleID := uuid.New()
+
+	tests := []struct {
+		name      string
+		expiresAt *time.Time
+		wantTTL   time.Duration
+	}{
+		{name: "default expiry", expiresAt: nil, wantTTL: 7 * 24 * time.Hour},
+		{name: "custom expiry", expiresAt: &customExpiry},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID := uuid.New()
+
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).
+				Return(newAggregate(actorID, vo.UserStatusActive, vo.PermissionUsersInvite), nil)
+
+			var saved entity.Invitation
+
+			invitationRepo := mock_repository.NewMockInvitationRepository(ctrl)
+			invitationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, invitation entity.Invitation) error {
+					saved = invitation
+
+					return nil
+				},
+			).Times(1)
+
+			uc := admin.NewCreateInvitationUseCase(permRepo, invitationRepo, mock_shared.NewMockTransactionManager(nil))
+			output, err := uc.Execute(context.Background(), admin.CreateInvitationInput{
+				ActorID:   actorID,
+				Email:     "invitee@example.com",
+				RoleIDs:   []uuid.UUID{roleID},
+				ExpiresAt: tt.expiresAt,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, saved.ID(), output.ID)
+			assert.Equal(t, "invitee@example.com", output.Email)
+			assert.Equal(t, []uuid.UUID{roleID}, output.RoleIDs)
+			assert.Equal(t, actorID, saved.InvitedBy())
+
+			// Only the hash of the returned token is persisted.
+			assert.Equal(t, vo.InvitationToken(output.Token).Hash(), saved.TokenHash())
+
+			if tt.expiresAt != nil {
+				assert.Equal(t, *tt.expiresAt, output.ExpiresAt)
+			} else {
+				assert.WithinDuration(t, time.Now().Add(tt.wantTTL), output.ExpiresAt, time.Minute)
+			}
+		})
+	}
+}
+
+func TestCreateInvitationUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		perms     []vo.Permission
+		permErr   error
+		email     string
+		expiresAt *time.Time
+		createErr error
+		txErr     error
+		wantErr   error
+		wantCode  vo.ErrorCode
+	}{
+		{
+			name:    "actor lookup fails",
+			permErr: errDB,
+			email:   "invitee@example.com",
+			wantErr: errDB,
+		},
+		{
+			name:     "actor lacks users:invite",
+			perms:    []vo.Permission{vo.PermissionUsersList},
+			email:    "invitee@example.com",
+			wantCode: vo.ForbiddenErrorCode,
+		},
+		{
+			name:     "invalid email",
+			perms:    []vo.Permission{vo.PermissionUsersInvite},
+			email:    "not-an-email",
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:      "expiry in the past",
+			perms:     []vo.Permission{vo.PermissionUsersInvite},
+			email:     "invitee@example.com",
+			expiresAt: &past,
+			wantCode:  vo.ValidationErrorCode,
+		},
+		{
+			name:      "failed to save invitation",
+			perms:     []vo.Permission{vo.PermissionUsersInvite},
+			email:     "invitee@example.com",
+			createErr: errDB,
+			wantErr:   errDB,
+		},
+		{
+			name:    "transaction fails",
+			perms:   []vo.Permission{vo.PermissionUsersInvite},
+			email:   "invitee@example.com",
+			txErr:   errDB,
+			wantErr: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID := uuid.New()
+
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			if tt.permErr != nil {
+				permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).Return(nil, tt.permErr)
+			} else {
+				permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).
+					Return(newAggregate(actorID, vo.UserStatusActive, tt.perms...), nil)
+			}
+
+			invitationRepo := mock_repository.NewMockInvitationRepository(ctrl)
+			invitationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(tt.createErr).AnyTimes()
+
+			uc := admin.NewCreateInvitationUseCase(permRepo, invitationRepo, mock_shared.NewMockTransactionManager(tt.txErr))
+			output, err := uc.Execute(context.Background(), admin.CreateInvitationInput{
+				ActorID:   actorID,
+				Email:     tt.email,
+				ExpiresAt: tt.expiresAt,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
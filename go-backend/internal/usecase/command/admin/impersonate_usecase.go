@@ -0,0 +1,184 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	errLacksImpersonatePerm  = errors.New("user lacks users:impersonate permission")
+	errImpersonateSelf       = errors.New("cannot impersonate oneself")
+	errImpersonateUnknown    = errors.New("impersonation target does not exist")
+	errImpersonateInactive   = errors.New("impersonation target is not active")
+	errImpersonatePrivileged = errors.New("impersonation target holds users:impersonate")
+	errImpersonateOutsider   = errors.New("impersonation target is not a member of the active organization")
+)
+
+// ImpersonateUseCase issues a short-lived token that lets an admin act as another user.
+type ImpersonateUseCase interface {
+	Execute(ctx context.Context, input ImpersonateInput) (*ImpersonateOutput, error)
+}
+
+type ImpersonateInput struct {
+	ActorID   uuid.UUID
+	SubjectID uuid.UUID
+	// TenantID is the actor's active organization; the subject must be a member and the token acts in it.
+	TenantID uuid.UUID
+	// Method and Path identify the request that started the impersonation; they are written to the audit log.
+	Method string
+	Path   string
+}
+
+type ImpersonateOutput struct {
+	Token     string
+	ExpiresAt time.Time
+	SubjectID uuid.UUID
+	ActorID   uuid.UUID
+}
+
+type impersonateUseCaseImpl struct {
+	tracer               trace.Tracer
+	logger               common.Logger
+	permissionRepository aggregaterepository.UserPermissionRepository
+	auditLogRepository   repository.ImpersonationAuditLogRepository
+	orgRepository        repository.OrganizationRepository
+	jwtService           service.JwtService
+	txManager            shared.TransactionManager
+}
+
+func (uc *impersonateUseCaseImpl) Execute(ctx context.Context, input ImpersonateInput) (*ImpersonateOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	subject, err := uc.authorize(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	auditLog, err := entity.NewImpersonationAuditLog(
+		input.ActorID, input.SubjectID, vo.ImpersonationAuditActionStarted, input.Method, input.Path, time.Now(),
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var token *service.UserAccessToken
+
+	// The audit entry and the token are produced together: no token is returned unless the start was recorded.
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		if repoErr := uc.auditLogRepository.Create(ctx, auditLog); repoErr != nil {
+			uc.logger.Error(ctx, "failed to save ImpersonationAuditLog", "error", repoErr)
+
+			return repoErr
+		}
+
+		var tokenErr error
+
+		token, tokenErr = uc.jwtService.GenerateImpersonationToken(ctx, subject, input.ActorID, input.TenantID)
+		if tokenErr != nil {
+			uc.logger.Error(ctx, "failed to generate impersonation token", "error", tokenErr)
+
+			return tokenErr
+		}
+
+		return nil
+	})
+	if err != nil {
+		uc.logger.Error(ctx, "transaction error", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	uc.logger.Info(ctx, "impersonation started", "subjectID", input.SubjectID, "expiresAt", token.ExpiresAt)
+
+	return &ImpersonateOutput{
+		Token:     token.Value,
+		ExpiresAt: token.ExpiresAt,
+		SubjectID: input.SubjectID,
+		ActorID:   input.ActorID,
+	}, nil
+}
+
+// authorize checks that the actor may impersonate and that the subject is an active, unprivileged
+// member of the actor's active organization.
+func (uc *impersonateUseCaseImpl) authorize(ctx context.Context, input ImpersonateInput) (entity.User, error) {
+	actor, err := uc.permissionRepository.FindByUserID(ctx, input.ActorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !actor.HasPermission(vo.PermissionUsersImpersonate) {
+		return nil, vo.NewForbiddenError("insufficient permissions", nil, errLacksImpersonatePerm)
+	}
+
+	if input.ActorID == input.SubjectID {
+		return nil, vo.NewValidationError("cannot impersonate yourself", nil, errImpersonateSelf)
+	}
+
+	subject, err := uc.permissionRepository.FindByUserID(ctx, input.SubjectID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, vo.NewValidationError("user does not exist", nil, errImpersonateUnknown)
+		}
+
+		return nil, err
+	}
+
+	if subject.User.Status() != vo.UserStatusActive {
+		return nil, vo.NewValidationError("user is not active", nil, errImpersonateInactive)
+	}
+
+	// Impersonating another impersonator would let an admin borrow a peer's privileges.
+	if subject.HasPermission(vo.PermissionUsersImpersonate) {
+		return nil, vo.NewForbiddenError("cannot impersonate a privileged user", nil, errImpersonatePrivileged)
+	}
+
+	if _, err := uc.orgRepository.FindMembership(ctx, input.TenantID, input.SubjectID); err != nil {
+		if errors.Is(err, repository.ErrMembershipNotFound) {
+			return nil, vo.NewValidationError("user is not a member of the active organization", nil, errImpersonateOutsider)
+		}
+
+		return nil, err
+	}
+
+	return subject.User, nil
+}
+
+func NewImpersonateUseCase(
+	permissionRepository aggregaterepository.UserPermissionRepository,
+	auditLogRepository repository.ImpersonationAuditLogRepository,
+	orgRepository repository.OrganizationRepository,
+	jwtService service.JwtService,
+	txManager shared.TransactionManager,
+) ImpersonateUseCase {
+	return &impersonateUseCaseImpl{
+		tracer:               otel.Tracer("ImpersonateUseCase"),
+		logger:               common.NewLogger(),
+		permissionRepository: permissionRepository,
+		auditLogRepository:   auditLogRepository,
+		orgRepository:        orgRepository,
+		jwtService:           jwtService,
+		txManager:            txManager,
+	}
+}
@@ -0,0 +1,199 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/admin"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	mock_aggregate_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/aggregate/repository"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_service "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/service"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newAggregate(id uuid.UUID, status vo.UserStatus, perms ...vo.Permission) *aggregate.UserPermissionAggregate {
+	return &aggregate.UserPermissionAggregate{
+		UserID:      id,
+		User:        entity.ReconstructUser(id, id.String()+"@example.com", nil, "User", status, time.Now()),
+		Permissions: perms,
+	}
+}
+
+func TestImpersonateUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	actorID, subjectID, tenantID := uuid.New(), uuid.New(), uuid.New()
+	expiresAt := time.Now().Add(15 * time.Minute)
+
+	permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+	permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).
+		Return(newAggregate(actorID, vo.UserStatusActive, vo.PermissionUsersImpersonate), nil)
+	permRepo.EXPECT().FindByUserID(gomock.Any(), subjectID).
+		Return(newAggregate(subjectID, vo.UserStatusActive, vo.PermissionUsersList), nil)
+
+	orgRepo := mock_repository.NewMockOrganizationRepository(ctrl)
+	orgRepo.EXPECT().FindMembership(gomock.Any(), tenantID, subjectID).
+		Return(entity.ReconstructOrganizationMembership(tenantID, subjectID, uuid.New(), time.Now()), nil)
+
+	auditRepo := mock_repository.NewMockImpersonationAuditLogRepository(ctrl)
+	auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, log entity.ImpersonationAuditLog) error {
+			assert.Equal(t, actorID, log.ActorID())
+			assert.Equal(t, subjectID, log.SubjectID())
+			assert.Equal(t, vo.ImpersonationAuditActionStarted, log.Action())
+			assert.Equal(t, "POST", log.Method())
+
+			return nil
+		},
+	).Times(1)
+
+	jwtService := mock_service.NewMockJwtService(ctrl)
+	jwtService.EXPECT().GenerateImpersonationToken(gomock.Any(), gomock.Any(), actorID, tenantID).
+		Return(&service.UserAccessToken{Value: "token", ExpiresAt: expiresAt}, nil).Times(1)
+
+	uc := admin.NewImpersonateUseCase(permRepo, auditRepo, orgRepo, jwtService, mock_shared.NewMockTransactionManager(nil))
+	output, err := uc.Execute(context.Background(), admin.ImpersonateInput{
+		ActorID:   actorID,
+		SubjectID: subjectID,
+		TenantID:  tenantID,
+		Method:    "POST",
+		Path:      "/v1/admin/impersonate/" + subjectID.String(),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "token", output.Token)
+	assert.Equal(t, expiresAt, output.ExpiresAt)
+	assert.Equal(t, actorID, output.ActorID)
+	assert.Equal(t, subjectID, output.SubjectID)
+}
+
+func TestImpersonateUseCase_FailureCase(t *testing.T) {
+	actorID, subjectID, tenantID := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name          string
+		actor         *aggregate.UserPermissionAggregate
+		subjectID     uuid.UUID
+		subject       *aggregate.UserPermissionAggregate
+		subjErr       error
+		membershipErr error
+		wantCode      vo.ErrorCode
+	}{
+		{
+			name:      "actor lacks users:impersonate",
+			actor:     newAggregate(actorID, vo.UserStatusActive, vo.PermissionUsersList),
+			subjectID: subjectID,
+			wantCode:  vo.ForbiddenErrorCode,
+		},
+		{
+			name:      "impersonating oneself",
+			actor:     newAggregate(actorID, vo.UserStatusActive, vo.PermissionUsersImpersonate),
+			subjectID: actorID,
+			wantCode:  vo.ValidationErrorCode,
+		},
+		{
+			name:      "subject does not exist",
+			actor:     newAggregate(actorID, vo.UserStatusActive, vo.PermissionUsersImpersonate),
+			subjectID: subjectID,
+			subjErr:   repository.ErrUserNotFound,
+			wantCode:  vo.ValidationErrorCode,
+		},
+		{
+			name:      "subject is frozen",
+			actor:     newAggregate(actorID, vo.UserStatusActive, vo.PermissionUsersImpersonate),
+			subjectID: subjectID,
+			subject:   newAggregate(subjectID, vo.UserStatusFrozen),
+			wantCode:  vo.ValidationErrorCode,
+		},
+		{
+			name:      "subject is a fellow impersonator",
+			actor:     newAggregate(actorID, vo.UserStatusActive, vo.PermissionUsersImpersonate),
+			subjectID: subjectID,
+			subject:   newAggregate(subjectID, vo.UserStatusActive, vo.PermissionUsersImpersonate),
+			wantCode:  vo.ForbiddenErrorCode,
+		},
+		{
+			name:          "subject belongs to another organization",
+			actor:         newAggregate(actorID, vo.UserStatusActive, vo.PermissionUsersImpersonate),
+			subjectID:     subjectID,
+			subject:       newAggregate(subjectID, vo.UserStatusActive),
+			membershipErr: repository.ErrMembershipNotFound,
+			wantCode:      vo.ValidationErrorCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).Return(tt.actor, nil)
+
+			if tt.subject != nil || tt.subjErr != nil {
+				permRepo.EXPECT().FindByUserID(gomock.Any(), tt.subjectID).Return(tt.subject, tt.subjErr)
+			}
+
+			orgRepo := mock_repository.NewMockOrganizationRepository(ctrl)
+			if tt.membershipErr != nil {
+				orgRepo.EXPECT().FindMembership(gomock.Any(), tenantID, tt.subjectID).Return(nil, tt.membershipErr)
+			}
+
+			// Neither an audit entry nor a token may be produced on rejection.
+			auditRepo := mock_repository.NewMockImpersonationAuditLogRepository(ctrl)
+			jwtService := mock_service.NewMockJwtService(ctrl)
+
+			uc := admin.NewImpersonateUseCase(
+				permRepo, auditRepo, orgRepo, jwtService, mock_shared.NewMockTransactionManager(nil),
+			)
+			output, err := uc.Execute(context.Background(), admin.ImpersonateInput{
+				ActorID:   actorID,
+				SubjectID: tt.subjectID,
+				TenantID:  tenantID,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
+
+func TestImpersonateUseCase_AuditFailureIssuesNoToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	actorID, subjectID := uuid.New(), uuid.New()
+	errDB := errors.New("db error")
+
+	permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+	permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).
+		Return(newAggregate(actorID, vo.UserStatusActive, vo.PermissionUsersImpersonate), nil)
+	permRepo.EXPECT().FindByUserID(gomock.Any(), subjectID).
+		Return(newAggregate(subjectID, vo.UserStatusActive), nil)
+
+	orgRepo := mock_repository.NewMockOrganizationRepository(ctrl)
+	orgRepo.EXPECT().FindMembership(gomock.Any(), gomock.Any(), subjectID).
+		Return(entity.ReconstructOrganizationMembership(uuid.New(), subjectID, uuid.New(), time.Now()), nil)
+
+	auditRepo := mock_repository.NewMockImpersonationAuditLogRepository(ctrl)
+	auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errDB).Times(1)
+
+	jwtService := mock_service.NewMockJwtService(ctrl)
+
+	uc := admin.NewImpersonateUseCase(permRepo, auditRepo, orgRepo, jwtService, mock_shared.NewMockTransactionManager(nil))
+	output, err := uc.Execute(context.Background(), admin.ImpersonateInput{ActorID: actorID, SubjectID: subjectID})
+
+	require.ErrorIs(t, err, errDB)
+	assert.Nil(t, output)
+}
@@ -0,0 +1,526 @@
+package admin
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// importBatchSize is how many valid lines are collected before they are copied into the database.
+	importBatchSize = 500
+	// maxImportLineBytes bounds a single NDJSON line; a post at the content limit stays well below it.
+	maxImportLineBytes = 1 << 20
+)
+
+var (
+	errLacksImportPerm       = errors.New("user lacks posts:import permission")
+	errMalformedImportLine   = errors.New("malformed import line")
+	errImportLineTooLong     = errors.New("import line too long")
+	errImportedPostFuture    = errors.New("imported post created in the future")
+	errImportAuthorInvalid   = errors.New("imported post author is invalid")
+	errImportAttachmentID    = errors.New("imported post attachment ID is invalid")
+	errImportContentRejected = errors.New("imported post content rejected by a content filter")
+)
+
+// ImportPostsUseCase lets an admin create posts in bulk on behalf of the members of the active tenant,
+// e.g. to migrate them from another system. Each line is validated like a new post, content filters and
+// attachments included; lines that fail are reported and skipped. The valid lines are imported in batches,
+// each in a transaction of its own, so that a batch that cannot be stored is reported line by line without
+// undoing the batches before it.
+type ImportPostsUseCase interface {
+	Execute(ctx context.Context, input ImportPostsInput) (*ImportPostsOutput, error)
+}
+
+type ImportPostsInput struct {
+	ActorID uuid.UUID
+	// Lines is NDJSON: one JSON object per line, with the fields of importedPostLine. Blank lines are skipped.
+	Lines io.Reader
+}
+
+type ImportPostsOutput struct {
+	Imported int
+	// Errors lists the lines that were not imported, in line order.
+	Errors []ImportPostError
+}
+
+type ImportPostError struct {
+	// Line is the 1-based line number in the input.
+	Line    int
+	Message string
+}
+
+// importedPostLine is one line of the import. Posts are imported as published; format and visibility
+// default like they do for new posts, and createdAt defaults to the time of the import. attachmentIds are
+// uploads of the author, attached in this order as they are to new posts.
+type importedPostLine struct {
+	UserID        string     `json:"userId"`
+	Content       string     `json:"content"`
+	Format        string     `json:"format"`
+	Visibility    string     `json:"visibility"`
+	CreatedAt     *time.Time `json:"createdAt"`
+	AttachmentIDs []string   `json:"attachmentIds"`
+}
+
+type importedPost struct {
+	line          int
+	post          entity.Post
+	attachmentIDs []uuid.UUID
+	// verdict is what the content filters decided; flagged posts are reported once they are stored.
+	verdict service.ContentVerdict
+}
+
+type importPostsUseCaseImpl struct {
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	permissionRepository   aggregaterepository.UserPermissionRepository
+	organizationRepository repository.OrganizationRepository
+	postRepository         repository.PostRepository
+	postRevisionRepository repository.PostRevisionRepository
+	postEntityRepository   repository.PostEntityRepository
+	attachmentRepository   repository.AttachmentRepository
+	postReportRepository   repository.PostReportRepository
+	contentFilter          service.ContentFilter
+	txManager              shared.TransactionManager
+}
+
+func (uc *importPostsUseCaseImpl) Execute(ctx context.Context, input ImportPostsInput) (*ImportPostsOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	actor, err := uc.permissionRepository.FindByUserID(ctx, input.ActorID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	if !actor.HasPermission(vo.PermissionPostsImport) {
+		span.RecordError(errLacksImportPerm)
+		span.SetStatus(codes.Error, errLacksImportPerm.Error())
+
+		return nil, vo.NewForbiddenError("insufficient permissions", nil, errLacksImportPerm)
+	}
+
+	output := &ImportPostsOutput{Errors: []ImportPostError{}}
+
+	if err = uc.importLines(ctx, input.Lines, time.Now(), output); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	// A batch reports its authors only once it is flushed, after the lines that follow it were parsed.
+	slices.SortFunc(output.Errors, func(a, b ImportPostError) int { return cmp.Compare(a.Line, b.Line) })
+
+	uc.logger.Info(ctx, "posts imported", "imported", output.Imported, "failed", len(output.Errors))
+
+	return output, nil
+}
+
+// importLines reads the lines one at a time and copies the valid ones in batches, so that only a batch is
+// held in memory however long the input is.
+func (uc *importPostsUseCaseImpl) importLines(
+	ctx context.Context, lines io.Reader, now time.Time, output *ImportPostsOutput,
+) error {
+	scanner := bufio.NewScanner(lines)
+	scanner.Buffer(nil, maxImportLineBytes)
+
+	batch := make([]importedPost, 0, importBatchSize)
+	lineNo := 0
+
+	for scanner.Scan() {
+		lineNo++
+
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+
+		item, err := uc.parseLine(ctx, raw, now)
+		if err != nil {
+			output.Errors = append(output.Errors, ImportPostError{Line: lineNo, Message: importErrorMessage(err)})
+
+			continue
+		}
+
+		item.line = lineNo
+
+		batch = append(batch, item)
+		if len(batch) < importBatchSize {
+			continue
+		}
+
+		uc.flush(ctx, batch, output)
+
+		batch = batch[:0]
+	}
+
+	if err := scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return vo.NewValidationError(
+				fmt.Sprintf("line %d is longer than %d bytes", lineNo+1, maxImportLineBytes),
+				map[string]any{"line": lineNo + 1, "max_bytes": maxImportLineBytes},
+				errImportLineTooLong,
+			)
+		}
+
+		return err
+	}
+
+	uc.flush(ctx, batch, output)
+
+	return nil
+}
+
+// parseLine builds the post of a line and runs the content filters on it, as they run on new posts.
+func (uc *importPostsUseCaseImpl) parseLine(ctx context.Context, raw []byte, now time.Time) (importedPost, error) {
+	item, err := parseImportedPost(raw, now)
+	if err != nil {
+		return importedPost{}, err
+	}
+
+	item.verdict, err = uc.contentFilter.Check(ctx, item.post.UserID(), item.post.Content())
+	if err != nil {
+		uc.logger.Error(ctx, "failed to filter imported post content", "error", err)
+
+		return importedPost{}, err
+	}
+
+	if item.verdict.Action == service.ContentReject {
+		return importedPost{}, vo.NewValidationError(item.verdict.Reason, map[string]any{
+			"filter": item.verdict.Filter,
+		}, errImportContentRejected)
+	}
+
+	return item, nil
+}
+
+// flush stores the batch in a transaction of its own. When it cannot be stored, every line of the batch is
+// reported, with the reason the line was rejected for when there is one.
+func (uc *importPostsUseCaseImpl) flush(ctx context.Context, batch []importedPost, output *ImportPostsOutput) {
+	if len(batch) == 0 {
+		return
+	}
+
+	var (
+		stored   int
+		rejected []ImportPostError
+	)
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var txErr error
+
+		stored, rejected, txErr = uc.store(ctx, batch)
+
+		return txErr
+	})
+
+	output.Errors = append(output.Errors, rejected...)
+
+	if err == nil {
+		output.Imported += stored
+
+		return
+	}
+
+	uc.logger.Error(ctx, "failed to import a batch of posts", "firstLine", batch[0].line, "error", err)
+
+	for _, item := range batch {
+		if !slices.ContainsFunc(rejected, func(e ImportPostError) bool { return e.Line == item.line }) {
+			output.Errors = append(output.Errors, ImportPostError{
+				Line: item.line, Message: "the post could not be stored",
+			})
+		}
+	}
+}
+
+// store saves the posts of the batch whose authors are members of the active tenant and whose attachments
+// are unattached uploads of theirs, with their first revisions, entities, attachments and the reports of
+// flagged content. It returns how many it saved and the lines it rejected.
+func (uc *importPostsUseCaseImpl) store(ctx context.Context, batch []importedPost) (int, []ImportPostError, error) {
+	authorIDs := make([]uuid.UUID, 0, len(batch))
+	attachmentIDs := []uuid.UUID{}
+
+	for _, item := range batch {
+		authorIDs = append(authorIDs, item.post.UserID())
+		attachmentIDs = append(attachmentIDs, item.attachmentIDs...)
+	}
+
+	memberIDs, err := uc.organizationRepository.FindMemberIDs(ctx, authorIDs)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find the authors of imported posts", "error", err)
+
+		return 0, nil, err
+	}
+
+	attachments, err := uc.findAttachments(ctx, attachmentIDs)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	members := make(map[uuid.UUID]bool, len(memberIDs))
+	for _, id := range memberIDs {
+		members[id] = true
+	}
+
+	var (
+		rejected  []ImportPostError
+		accepted  = make([]importedPost, 0, len(batch))
+		posts     = make([]entity.Post, 0, len(batch))
+		revisions = make([]entity.PostRevision, 0, len(batch))
+		claimed   = map[uuid.UUID]bool{}
+	)
+
+	for _, item := range batch {
+		if !members[item.post.UserID()] {
+			rejected = append(rejected, ImportPostError{
+				Line: item.line, Message: "userId is not a member of the organization",
+			})
+
+			continue
+		}
+
+		if message := checkAttachments(item, attachments, claimed); message != "" {
+			rejected = append(rejected, ImportPostError{Line: item.line, Message: message})
+
+			continue
+		}
+
+		revision, err := entity.NewPostRevision(item.post, item.post.UserID())
+		if err != nil {
+			return 0, rejected, err
+		}
+
+		accepted = append(accepted, item)
+		posts = append(posts, item.post)
+		revisions = append(revisions, revision)
+	}
+
+	if len(posts) == 0 {
+		return 0, rejected, nil
+	}
+
+	if err := uc.postRepository.CreateMany(ctx, posts); err != nil {
+		uc.logger.Error(ctx, "failed to save imported posts", "error", err)
+
+		return 0, rejected, err
+	}
+
+	if err := uc.postRevisionRepository.CreateInitial(ctx, revisions); err != nil {
+		uc.logger.Error(ctx, "failed to save revisions of imported posts", "error", err)
+
+		return 0, rejected, err
+	}
+
+	for _, item := range accepted {
+		if err := uc.complete(ctx, item, attachments); err != nil {
+			return 0, rejected, err
+		}
+	}
+
+	return len(posts), rejected, nil
+}
+
+// findAttachments loads the attachments of the batch by ID.
+func (uc *importPostsUseCaseImpl) findAttachments(
+	ctx context.Context, ids []uuid.UUID,
+) (map[uuid.UUID]entity.Attachment, error) {
+	byID := make(map[uuid.UUID]entity.Attachment, len(ids))
+	if len(ids) == 0 {
+		return byID, nil
+	}
+
+	found, err := uc.attachmentRepository.FindByIDs(ctx, ids)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find the attachments of imported posts", "error", err)
+
+		return nil, err
+	}
+
+	for _, attachment := range found {
+		byID[attachment.ID()] = attachment
+	}
+
+	return byID, nil
+}
+
+// checkAttachments returns why the attachments of item cannot be attached to it, or "" when they can.
+// Uploads of other users are reported like missing ones, as they are for new posts, and an upload claimed
+// by an earlier line of the batch like one already attached.
+func checkAttachments(
+	item importedPost, attachments map[uuid.UUID]entity.Attachment, claimed map[uuid.UUID]bool,
+) string {
+	for _, id := range item.attachmentIDs {
+		attachment, ok := attachments[id]
+		if !ok || attachment.UploaderID() != item.post.UserID() {
+			return "attachment not found"
+		}
+
+		if attachment.PostID() != nil || claimed[id] {
+			return "attachment is already attached to a post"
+		}
+	}
+
+	for _, id := range item.attachmentIDs {
+		claimed[id] = true
+	}
+
+	return ""
+}
+
+// complete stores what goes with a saved post: its entities, its attachments and, when the content filters
+// flagged it, its report to the moderators.
+func (uc *importPostsUseCaseImpl) complete(
+	ctx context.Context, item importedPost, attachments map[uuid.UUID]entity.Attachment,
+) error {
+	post := item.post
+
+	if entities := vo.ParseContentEntities(post.Content()); len(entities) > 0 {
+		if err := uc.postEntityRepository.Replace(ctx, post, entities); err != nil {
+			uc.logger.Error(ctx, "failed to save entities of imported post", "postID", post.ID(), "error", err)
+
+			return err
+		}
+	}
+
+	for i, id := range item.attachmentIDs {
+		attachment := attachments[id]
+		if err := attachment.AttachTo(post.ID(), i); err != nil {
+			return err
+		}
+
+		if err := uc.attachmentRepository.Attach(ctx, attachment); err != nil {
+			uc.logger.Error(ctx, "failed to attach attachment of imported post", "postID", post.ID(), "error", err)
+
+			return err
+		}
+	}
+
+	if item.verdict.Action != service.ContentFlag {
+		return nil
+	}
+
+	report, err := entity.NewFlaggedPostReport(
+		post.ID(), item.verdict.ReportReason, item.verdict.ReportDetails(), post.CreatedAt(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = uc.postReportRepository.Create(ctx, report); err != nil {
+		uc.logger.Error(ctx, "failed to report flagged imported post", "postID", post.ID(), "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+func parseImportedPost(raw []byte, now time.Time) (importedPost, error) {
+	var line importedPostLine
+	if err := json.Unmarshal(raw, &line); err != nil {
+		return importedPost{}, vo.NewValidationError("line must be a JSON object describing a post", nil,
+			fmt.Errorf("%w: %w", errMalformedImportLine, err))
+	}
+
+	userID, err := uuid.Parse(line.UserID)
+	if err != nil {
+		return importedPost{}, vo.NewValidationError("userId must be a UUID", nil,
+			fmt.Errorf("%w: %w", errImportAuthorInvalid, err))
+	}
+
+	attachmentIDs := make([]uuid.UUID, len(line.AttachmentIDs))
+	for i, raw := range line.AttachmentIDs {
+		if attachmentIDs[i], err = uuid.Parse(raw); err != nil {
+			return importedPost{}, vo.NewValidationError("attachmentIds must be UUIDs", nil,
+				fmt.Errorf("%w: %w", errImportAttachmentID, err))
+		}
+	}
+
+	if err = entity.ValidateAttachmentIDs(attachmentIDs); err != nil {
+		return importedPost{}, err
+	}
+
+	createdAt := now
+	if line.CreatedAt != nil {
+		if line.CreatedAt.After(now) {
+			return importedPost{}, vo.NewValidationError("createdAt must not be in the future", nil,
+				errImportedPostFuture)
+		}
+
+		// Timestamps are stored without a zone, so keep them in the zone of the server's own clock.
+		createdAt = line.CreatedAt.In(now.Location())
+	}
+
+	post, err := entity.NewPost(entity.PostParams{
+		UserID:     userID,
+		Content:    line.Content,
+		Format:     line.Format,
+		Visibility: line.Visibility,
+		CreatedAt:  createdAt,
+	})
+	if err != nil {
+		return importedPost{}, err
+	}
+
+	return importedPost{post: post, attachmentIDs: attachmentIDs}, nil
+}
+
+// importErrorMessage returns the message reported for a rejected line, which is the message of the
+// validation error that rejected it. Other failures, which are logged, are not described to the caller.
+func importErrorMessage(err error) string {
+	var voErr vo.Error
+	if errors.As(err, &voErr) {
+		return voErr.Message()
+	}
+
+	return "the line could not be checked"
+}
+
+func NewImportPostsUseCase(
+	permissionRepository aggregaterepository.UserPermissionRepository,
+	organizationRepository repository.OrganizationRepository,
+	postRepository repository.PostRepository,
+	postRevisionRepository repository.PostRevisionRepository,
+	postEntityRepository repository.PostEntityRepository,
+	attachmentRepository repository.AttachmentRepository,
+	postReportRepository repository.PostReportRepository,
+	contentFilter service.ContentFilter,
+	txManager shared.TransactionManager,
+) ImportPostsUseCase {
+	return &importPostsUseCaseImpl{
+		tracer:                 otel.Tracer("ImportPostsUseCase"),
+		logger:                 common.NewLogger(),
+		permissionRepository:   permissionRepository,
+		organizationRepository: organizationRepository,
+		postRepository:         postRepository,
+		postRevisionRepository: postRevisionRepository,
+		postEntityRepository:   postEntityRepository,
+		attachmentRepository:   attachmentRepository,
+		postReportRepository:   postReportRepository,
+		contentFilter:          contentFilter,
+		txManager:              txManager,
+	}
+}
@@ -0,0 +1,342 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/admin"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	mock_aggregate_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/aggregate/repository"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_service "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/service"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// filterContent returns a content filter that rejects "spam", flags "flag me" and accepts anything else.
+func filterContent(ctrl *gomock.Controller) *mock_service.MockContentFilter {
+	contentFilter := mock_service.NewMockContentFilter(ctrl)
+	contentFilter.EXPECT().Check(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uuid.UUID, content string) (service.ContentVerdict, error) {
+			switch content {
+			case "spam":
+				return service.ContentVerdict{
+					Action: service.ContentReject, Filter: "banned_words", Reason: "content contains a banned word",
+				}, nil
+			case "flag me":
+				return service.ContentVerdict{
+					Action: service.ContentFlag, Filter: "domains", Reason: "links to a watched domain",
+					Match: "example.com", ReportReason: vo.ReportReasonSpam,
+				}, nil
+			default:
+				return service.ContentVerdict{Action: service.ContentAccept}, nil
+			}
+		},
+	).AnyTimes()
+
+	return contentFilter
+}
+
+func newImportAttachment(uploaderID uuid.UUID, postID *uuid.UUID) entity.Attachment {
+	return entity.ReconstructAttachment(
+		uuid.New(), uploaderID, postID, 0, "photo.png", vo.AttachmentContentTypePNG, 1024, 640, 480,
+		"attachments/original", "attachments/thumbnail", time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC),
+	)
+}
+
+func TestImportPostsUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	actorID, memberID, strangerID := uuid.New(), uuid.New(), uuid.New()
+	photo := newImportAttachment(memberID, nil)
+	attached := newImportAttachment(memberID, &actorID)
+	othersPhoto := newImportAttachment(strangerID, nil)
+
+	lines := strings.Join([]string{
+		fmt.Sprintf(`{"userId":%q,"content":"hello #go","createdAt":"2024-01-02T03:04:05Z"}`, memberID),
+		"",
+		`not json`,
+		fmt.Sprintf(`{"userId":%q,"content":"from a stranger"}`, strangerID),
+		`{"userId":"nope","content":"bad author"}`,
+		fmt.Sprintf(`{"userId":%q,"content":"   "}`, memberID),
+		fmt.Sprintf(`{"userId":%q,"content":"later","createdAt":"2999-01-01T00:00:00Z"}`, memberID),
+		fmt.Sprintf(`{"userId":%q,"content":"**bold**","format":"markdown","visibility":"followers"}`, memberID),
+		fmt.Sprintf(`{"userId":%q,"content":"spam"}`, memberID),
+		fmt.Sprintf(`{"userId":%q,"content":"flag me"}`, memberID),
+		fmt.Sprintf(`{"userId":%q,"content":"photo","attachmentIds":[%q]}`, memberID, photo.ID()),
+		fmt.Sprintf(`{"userId":%q,"content":"again","attachmentIds":[%q]}`, memberID, photo.ID()),
+		fmt.Sprintf(`{"userId":%q,"content":"taken","attachmentIds":[%q]}`, memberID, attached.ID()),
+		fmt.Sprintf(`{"userId":%q,"content":"theirs","attachmentIds":[%q]}`, memberID, othersPhoto.ID()),
+		fmt.Sprintf(`{"userId":%q,"content":"twice","attachmentIds":[%q,%q]}`, memberID, photo.ID(), photo.ID()),
+		fmt.Sprintf(`{"userId":%q,"content":"bad","attachmentIds":["nope"]}`, memberID),
+	}, "\n")
+
+	permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+	permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).
+		Return(newAggregate(actorID, vo.UserStatusActive, vo.PermissionPostsImport), nil)
+
+	orgRepo := mock_repository.NewMockOrganizationRepository(ctrl)
+	orgRepo.EXPECT().FindMemberIDs(gomock.Any(), gomock.Len(8)).Return([]uuid.UUID{memberID}, nil).Times(1)
+
+	var saved []entity.Post
+
+	postRepo := mock_repository.NewMockPostRepository(ctrl)
+	postRepo.EXPECT().CreateMany(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, posts []entity.Post) error {
+			saved = posts
+
+			return nil
+		},
+	).Times(1)
+
+	var revisions []entity.PostRevision
+
+	revisionRepo := mock_repository.NewMockPostRevisionRepository(ctrl)
+	revisionRepo.EXPECT().CreateInitial(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r []entity.PostRevision) error {
+			revisions = r
+
+			return nil
+		},
+	).Times(1)
+
+	// Only the post with a hashtag has entities to store.
+	entityRepo := mock_repository.NewMockPostEntityRepository(ctrl)
+	entityRepo.EXPECT().Replace(gomock.Any(), gomock.Any(), gomock.Len(1)).Return(nil).Times(1)
+
+	attachmentRepo := mock_repository.NewMockAttachmentRepository(ctrl)
+	attachmentRepo.EXPECT().FindByIDs(gomock.Any(), gomock.Any()).
+		Return([]entity.Attachment{photo, attached, othersPhoto}, nil).Times(1)
+	attachmentRepo.EXPECT().Attach(gomock.Any(), photo).Return(nil).Times(1)
+
+	var report entity.PostReport
+
+	reportRepo := mock_repository.NewMockPostReportRepository(ctrl)
+	reportRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r entity.PostReport) error {
+			report = r
+
+			return nil
+		},
+	).Times(1)
+
+	uc := admin.NewImportPostsUseCase(
+		permRepo, orgRepo, postRepo, revisionRepo, entityRepo, attachmentRepo, reportRepo, filterContent(ctrl),
+		mock_shared.NewMockTransactionManager(nil),
+	)
+	output, err := uc.Execute(context.Background(), admin.ImportPostsInput{
+		ActorID: actorID, Lines: strings.NewReader(lines),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, output.Imported)
+	assert.Equal(t, []admin.ImportPostError{
+		{Line: 3, Message: "line must be a JSON object describing a post"},
+		{Line: 4, Message: "userId is not a member of the organization"},
+		{Line: 5, Message: "userId must be a UUID"},
+		{Line: 6, Message: "content is required"},
+		{Line: 7, Message: "createdAt must not be in the future"},
+		{Line: 9, Message: "content contains a banned word"},
+		{Line: 12, Message: "attachment is already attached to a post"},
+		{Line: 13, Message: "attachment is already attached to a post"},
+		{Line: 14, Message: "attachment not found"},
+		{Line: 15, Message: "attachments must not repeat"},
+		{Line: 16, Message: "attachmentIds must be UUIDs"},
+	}, output.Errors)
+
+	require.Len(t, saved, 4)
+	assert.Equal(t, "hello #go", saved[0].Content())
+	assert.True(t, saved[0].CreatedAt().Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)))
+	assert.Equal(t, vo.PostStatusPublished, saved[0].Status())
+	assert.Equal(t, vo.ContentFormatMarkdown, saved[1].Format())
+	assert.Equal(t, vo.PostVisibilityFollowers, saved[1].Visibility())
+
+	require.Len(t, revisions, 4)
+	assert.Equal(t, saved[0].ID(), revisions[0].PostID())
+	assert.Equal(t, memberID, revisions[0].EditorID())
+
+	// The flagged post is reported to the moderators, and the upload is attached to its post.
+	require.NotNil(t, report)
+	assert.Equal(t, saved[2].ID(), report.PostID())
+	assert.Equal(t, vo.ReportReasonSpam, report.Reason())
+	assert.Equal(t, saved[3].ID(), *photo.PostID())
+}
+
+func TestImportPostsUseCase_Batches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	actorID, memberID := uuid.New(), uuid.New()
+	errDB := errors.New("db error")
+
+	var lines strings.Builder
+	for range 501 {
+		fmt.Fprintf(&lines, `{"userId":%q,"content":"imported"}`+"\n", memberID)
+	}
+
+	permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+	permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).
+		Return(newAggregate(actorID, vo.UserStatusActive, vo.PermissionPostsImport), nil)
+
+	orgRepo := mock_repository.NewMockOrganizationRepository(ctrl)
+	orgRepo.EXPECT().FindMemberIDs(gomock.Any(), gomock.Any()).Return([]uuid.UUID{memberID}, nil).Times(2)
+
+	// Each batch is committed on its own: the first fails, and the second is imported anyway.
+	postRepo := mock_repository.NewMockPostRepository(ctrl)
+	gomock.InOrder(
+		postRepo.EXPECT().CreateMany(gomock.Any(), gomock.Len(500)).Return(errDB),
+		postRepo.EXPECT().CreateMany(gomock.Any(), gomock.Len(1)).Return(nil),
+	)
+
+	revisionRepo := mock_repository.NewMockPostRevisionRepository(ctrl)
+	revisionRepo.EXPECT().CreateInitial(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	uc := admin.NewImportPostsUseCase(
+		permRepo, orgRepo, postRepo, revisionRepo, mock_repository.NewMockPostEntityRepository(ctrl),
+		mock_repository.NewMockAttachmentRepository(ctrl), mock_repository.NewMockPostReportRepository(ctrl),
+		filterContent(ctrl), mock_shared.NewMockTransactionManager(nil),
+	)
+	output, err := uc.Execute(context.Background(), admin.ImportPostsInput{
+		ActorID: actorID, Lines: strings.NewReader(lines.String()),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, output.Imported)
+	require.Len(t, output.Errors, 500)
+	assert.Equal(t, admin.ImportPostError{Line: 1, Message: "the post could not be stored"}, output.Errors[0])
+	assert.Equal(t, 500, output.Errors[499].Line)
+}
+
+func TestImportPostsUseCase_LinesNotImported(t *testing.T) {
+	errDB := errors.New("db error")
+	memberID := uuid.New()
+	validLine := fmt.Sprintf(`{"userId":%q,"content":"imported"}`, memberID)
+	strangerLine := fmt.Sprintf(`{"userId":%q,"content":"imported"}`, uuid.New())
+
+	tests := []struct {
+		name      string
+		lines     string
+		filterErr error
+		memberErr error
+		createErr error
+		txErr     error
+		want      []admin.ImportPostError
+	}{
+		{
+			name:      "content filter fails",
+			lines:     validLine,
+			filterErr: errDB,
+			want:      []admin.ImportPostError{{Line: 1, Message: "the line could not be checked"}},
+		},
+		{
+			name:      "member lookup fails",
+			lines:     validLine,
+			memberErr: errDB,
+			want:      []admin.ImportPostError{{Line: 1, Message: "the post could not be stored"}},
+		},
+		{
+			name:      "copy fails",
+			lines:     strangerLine + "\n" + validLine,
+			createErr: errDB,
+			want: []admin.ImportPostError{
+				{Line: 1, Message: "userId is not a member of the organization"},
+				{Line: 2, Message: "the post could not be stored"},
+			},
+		},
+		{
+			name:  "transaction fails",
+			lines: validLine,
+			txErr: errDB,
+			want:  []admin.ImportPostError{{Line: 1, Message: "the post could not be stored"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID := uuid.New()
+
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).
+				Return(newAggregate(actorID, vo.UserStatusActive, vo.PermissionPostsImport), nil)
+
+			orgRepo := mock_repository.NewMockOrganizationRepository(ctrl)
+			orgRepo.EXPECT().FindMemberIDs(gomock.Any(), gomock.Any()).
+				Return([]uuid.UUID{memberID}, tt.memberErr).AnyTimes()
+
+			postRepo := mock_repository.NewMockPostRepository(ctrl)
+			postRepo.EXPECT().CreateMany(gomock.Any(), gomock.Any()).Return(tt.createErr).AnyTimes()
+
+			contentFilter := mock_service.NewMockContentFilter(ctrl)
+			contentFilter.EXPECT().Check(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(service.ContentVerdict{Action: service.ContentAccept}, tt.filterErr).AnyTimes()
+
+			uc := admin.NewImportPostsUseCase(
+				permRepo, orgRepo, postRepo, mock_repository.NewMockPostRevisionRepository(ctrl),
+				mock_repository.NewMockPostEntityRepository(ctrl), mock_repository.NewMockAttachmentRepository(ctrl),
+				mock_repository.NewMockPostReportRepository(ctrl), contentFilter,
+				mock_shared.NewMockTransactionManager(tt.txErr),
+			)
+			output, err := uc.Execute(context.Background(), admin.ImportPostsInput{
+				ActorID: actorID, Lines: strings.NewReader(tt.lines),
+			})
+
+			require.NoError(t, err)
+			assert.Zero(t, output.Imported)
+			assert.Equal(t, tt.want, output.Errors)
+		})
+	}
+}
+
+func TestImportPostsUseCase_FailureCase(t *testing.T) {
+	validLine := fmt.Sprintf(`{"userId":%q,"content":"imported"}`, uuid.New())
+
+	tests := []struct {
+		name     string
+		perms    []vo.Permission
+		lines    string
+		wantCode vo.ErrorCode
+	}{
+		{name: "lacks permission", lines: validLine, wantCode: vo.ForbiddenErrorCode},
+		{
+			name:     "line too long",
+			perms:    []vo.Permission{vo.PermissionPostsImport},
+			lines:    validLine + "\n" + strings.Repeat("x", 1<<20+1),
+			wantCode: vo.ValidationErrorCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID := uuid.New()
+
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).
+				Return(newAggregate(actorID, vo.UserStatusActive, tt.perms...), nil)
+
+			uc := admin.NewImportPostsUseCase(
+				permRepo, mock_repository.NewMockOrganizationRepository(ctrl), mock_repository.NewMockPostRepository(ctrl),
+				mock_repository.NewMockPostRevisionRepository(ctrl), mock_repository.NewMockPostEntityRepository(ctrl),
+				mock_repository.NewMockAttachmentRepository(ctrl), mock_repository.NewMockPostReportRepository(ctrl),
+				filterContent(ctrl), mock_shared.NewMockTransactionManager(nil),
+			)
+			output, err := uc.Execute(context.Background(), admin.ImportPostsInput{
+				ActorID: actorID, Lines: strings.NewReader(tt.lines),
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RecordImpersonatedRequestUseCase writes one audit entry for a request made with an impersonation token.
+type RecordImpersonatedRequestUseCase interface {
+	Execute(ctx context.Context, input RecordImpersonatedRequestInput) error
+}
+
+type RecordImpersonatedRequestInput struct {
+	ActorID   uuid.UUID
+	SubjectID uuid.UUID
+	Method    string
+	Path      string
+}
+
+type recordImpersonatedRequestUseCaseImpl struct {
+	tracer             trace.Tracer
+	logger             common.Logger
+	auditLogRepository repository.ImpersonationAuditLogRepository
+	txManager          shared.TransactionManager
+}
+
+func (uc *recordImpersonatedRequestUseCaseImpl) Execute(
+	ctx context.Context, input RecordImpersonatedRequestInput,
+) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	auditLog, err := entity.NewImpersonationAuditLog(
+		input.ActorID, input.SubjectID, vo.ImpersonationAuditActionRequest, input.Method, input.Path, time.Now(),
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		return uc.auditLogRepository.Create(ctx, auditLog)
+	})
+	if err != nil {
+		uc.logger.Error(ctx, "failed to save ImpersonationAuditLog", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+func NewRecordImpersonatedRequestUseCase(
+	auditLogRepository repository.ImpersonationAuditLogRepository,
+	txManager shared.TransactionManager,
+) RecordImpersonatedRequestUseCase {
+	return &recordImpersonatedRequestUseCaseImpl{
+		tracer:             otel.Tracer("RecordImpersonatedRequestUseCase"),
+		logger:             common.NewLogger(),
+		auditLogRepository: auditLogRepository,
+		txManager:          txManager,
+	}
+}
@@ -0,0 +1,61 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/admin"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRecordImpersonatedRequestUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	actorID, subjectID := uuid.New(), uuid.New()
+
+	auditRepo := mock_repository.NewMockImpersonationAuditLogRepository(ctrl)
+	auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, log entity.ImpersonationAuditLog) error {
+			assert.Equal(t, actorID, log.ActorID())
+			assert.Equal(t, subjectID, log.SubjectID())
+			assert.Equal(t, vo.ImpersonationAuditActionRequest, log.Action())
+			assert.Equal(t, "GET", log.Method())
+			assert.Equal(t, "/v1/posts", log.Path())
+
+			return nil
+		},
+	).Times(1)
+
+	uc := admin.NewRecordImpersonatedRequestUseCase(auditRepo, mock_shared.NewMockTransactionManager(nil))
+	err := uc.Execute(context.Background(), admin.RecordImpersonatedRequestInput{
+		ActorID:   actorID,
+		SubjectID: subjectID,
+		Method:    "GET",
+		Path:      "/v1/posts",
+	})
+
+	require.NoError(t, err)
+}
+
+func TestRecordImpersonatedRequestUseCase_FailureCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	errDB := errors.New("db error")
+
+	auditRepo := mock_repository.NewMockImpersonationAuditLogRepository(ctrl)
+	auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errDB).Times(1)
+
+	uc := admin.NewRecordImpersonatedRequestUseCase(auditRepo, mock_shared.NewMockTransactionManager(nil))
+	err := uc.Execute(context.Background(), admin.RecordImpersonatedRequestInput{
+		ActorID:   uuid.New(),
+		SubjectID: uuid.New(),
+	})
+
+	require.ErrorIs(t, err, errDB)
+}
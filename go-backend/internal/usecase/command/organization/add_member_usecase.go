@@ -0,0 +1,133 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	errLacksMembersAddPerm  = errors.New("user lacks members:add permission")
+	errOrganizationInactive = errors.New("organization is not the active tenant")
+	errAlreadyMember        = errors.New("user is already a member")
+)
+
+// AddMemberUseCase adds a user to the actor's active organization with a per-organization role.
+type AddMemberUseCase interface {
+	Execute(ctx context.Context, input AddMemberInput) (*AddMemberOutput, error)
+}
+
+type AddMemberInput struct {
+	ActorID uuid.UUID
+	// TenantID is the actor's active organization; OrganizationID must match it.
+	TenantID       uuid.UUID
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	RoleID         uuid.UUID
+}
+
+type AddMemberOutput struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	RoleID         uuid.UUID
+	JoinedAt       time.Time
+}
+
+type addMemberUseCaseImpl struct {
+	tracer               trace.Tracer
+	logger               common.Logger
+	permissionRepository aggregaterepository.UserPermissionRepository
+	orgRepository        repository.OrganizationRepository
+	txManager            shared.TransactionManager
+}
+
+func (uc *addMemberUseCaseImpl) Execute(ctx context.Context, input AddMemberInput) (*AddMemberOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	if err := uc.authorize(ctx, input); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	membership, err := entity.NewOrganizationMembership(input.OrganizationID, input.UserID, input.RoleID, time.Now())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		if repoErr := uc.orgRepository.AddMembership(ctx, membership); repoErr != nil {
+			if errors.Is(repoErr, repository.ErrMembershipAlreadyExists) {
+				return vo.NewValidationError("user is already a member of the organization", nil, errAlreadyMember)
+			}
+
+			uc.logger.Error(ctx, "failed to save OrganizationMembership", "error", repoErr)
+
+			return repoErr
+		}
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return &AddMemberOutput{
+		OrganizationID: membership.OrganizationID(),
+		UserID:         membership.UserID(),
+		RoleID:         membership.RoleID(),
+		JoinedAt:       membership.JoinedAt(),
+	}, nil
+}
+
+// authorize checks that the target organization is the actor's active tenant and that the actor holds
+// members:add there.
+func (uc *addMemberUseCaseImpl) authorize(ctx context.Context, input AddMemberInput) error {
+	if input.OrganizationID != input.TenantID {
+		return vo.NewForbiddenError("organization is not active in this session", nil, errOrganizationInactive)
+	}
+
+	agg, err := uc.permissionRepository.FindByUserID(ctx, input.ActorID)
+	if err != nil {
+		return err
+	}
+
+	if !agg.HasPermission(vo.PermissionMembersAdd) {
+		return vo.NewForbiddenError("insufficient permissions", nil, errLacksMembersAddPerm)
+	}
+
+	return nil
+}
+
+func NewAddMemberUseCase(
+	permissionRepository aggregaterepository.UserPermissionRepository,
+	orgRepository repository.OrganizationRepository,
+	txManager shared.TransactionManager,
+) AddMemberUseCase {
+	return &addMemberUseCaseImpl{
+		tracer:               otel.Tracer("AddMemberUseCase"),
+		logger:               common.NewLogger(),
+		permissionRepository: permissionRepository,
+		orgRepository:        orgRepository,
+		txManager:            txManager,
+	}
+}
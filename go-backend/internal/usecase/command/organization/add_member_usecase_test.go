@@ -0,0 +1,132 @@
+package organization_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/organization"
+	mock_aggregate_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/aggregate/repository"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAddMemberUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	actorID, orgID, userID, roleID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+	permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).Return(&aggregate.UserPermissionAggregate{
+		UserID:      actorID,
+		Permissions: []vo.Permission{vo.PermissionMembersAdd},
+	}, nil).Times(1)
+
+	orgRepo := mock_repository.NewMockOrganizationRepository(ctrl)
+	orgRepo.EXPECT().AddMembership(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	uc := organization.NewAddMemberUseCase(permRepo, orgRepo, mock_shared.NewMockTransactionManager(nil))
+	output, err := uc.Execute(context.Background(), organization.AddMemberInput{
+		ActorID:        actorID,
+		TenantID:       orgID,
+		OrganizationID: orgID,
+		UserID:         userID,
+		RoleID:         roleID,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, orgID, output.OrganizationID)
+	assert.Equal(t, userID, output.UserID)
+	assert.Equal(t, roleID, output.RoleID)
+	assert.False(t, output.JoinedAt.IsZero())
+}
+
+func TestAddMemberUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name        string
+		perms       []vo.Permission
+		otherTenant bool
+		addErr      error
+		wantErr     error
+		wantCode    vo.ErrorCode
+	}{
+		{
+			name:        "organization is not the active tenant",
+			perms:       []vo.Permission{vo.PermissionMembersAdd},
+			otherTenant: true,
+			wantCode:    vo.ForbiddenErrorCode,
+		},
+		{
+			name:     "actor lacks members:add",
+			perms:    []vo.Permission{vo.PermissionUsersList},
+			wantCode: vo.ForbiddenErrorCode,
+		},
+		{
+			name:     "already a member",
+			perms:    []vo.Permission{vo.PermissionMembersAdd},
+			addErr:   repository.ErrMembershipAlreadyExists,
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:    "repository error",
+			perms:   []vo.Permission{vo.PermissionMembersAdd},
+			addErr:  errDB,
+			wantErr: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID, orgID := uuid.New(), uuid.New()
+
+			tenantID := orgID
+			if tt.otherTenant {
+				tenantID = uuid.New()
+			}
+
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			if !tt.otherTenant {
+				permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).Return(&aggregate.UserPermissionAggregate{
+					UserID:      actorID,
+					Permissions: tt.perms,
+				}, nil).Times(1)
+			}
+
+			orgRepo := mock_repository.NewMockOrganizationRepository(ctrl)
+			if tt.addErr != nil {
+				orgRepo.EXPECT().AddMembership(gomock.Any(), gomock.Any()).Return(tt.addErr).Times(1)
+			}
+
+			uc := organization.NewAddMemberUseCase(permRepo, orgRepo, mock_shared.NewMockTransactionManager(nil))
+			output, err := uc.Execute(context.Background(), organization.AddMemberInput{
+				ActorID:        actorID,
+				TenantID:       tenantID,
+				OrganizationID: orgID,
+				UserID:         uuid.New(),
+				RoleID:         uuid.New(),
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
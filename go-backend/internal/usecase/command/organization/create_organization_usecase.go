@@ -0,0 +1,99 @@
+package organization
+
+import (
+	"context"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CreateOrganizationUseCase creates an organization whose creator becomes its owner.
+type CreateOrganizationUseCase interface {
+	Execute(ctx context.Context, input CreateOrganizationInput) (*CreateOrganizationOutput, error)
+}
+
+type CreateOrganizationInput struct {
+	UserID uuid.UUID
+	Name   string
+}
+
+type CreateOrganizationOutput struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+}
+
+type createOrganizationUseCaseImpl struct {
+	tracer        trace.Tracer
+	logger        common.Logger
+	orgRepository repository.OrganizationRepository
+	txManager     shared.TransactionManager
+}
+
+func (uc *createOrganizationUseCaseImpl) Execute(
+	ctx context.Context, input CreateOrganizationInput,
+) (*CreateOrganizationOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	now := time.Now()
+
+	org, err := entity.NewOrganization(input.Name, now)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to create Organization", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	founder, err := entity.NewOrganizationMembership(org.ID(), input.UserID, entity.OrganizationOwnerRoleID, now)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := uc.orgRepository.Create(ctx, org, founder); err != nil {
+			uc.logger.Error(ctx, "failed to save Organization", "error", err)
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		uc.logger.Error(ctx, "transaction error", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return &CreateOrganizationOutput{
+		ID:        org.ID(),
+		Name:      org.Name(),
+		CreatedAt: org.CreatedAt(),
+	}, nil
+}
+
+func NewCreateOrganizationUseCase(
+	orgRepository repository.OrganizationRepository,
+	txManager shared.TransactionManager,
+) CreateOrganizationUseCase {
+	return &createOrganizationUseCaseImpl{
+		tracer:        otel.Tracer("CreateOrganizationUseCase"),
+		logger:        common.NewLogger(),
+		orgRepository: orgRepository,
+		txManager:     txManager,
+	}
+}
@@ -0,0 +1,104 @@
+package organization_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/organization"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateOrganizationUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userID := uuid.New()
+
+	orgRepo := mock_repository.NewMockOrganizationRepository(ctrl)
+	orgRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, org entity.Organization, founder entity.OrganizationMembership) error {
+			assert.Equal(t, org.ID(), founder.OrganizationID())
+			assert.Equal(t, userID, founder.UserID())
+			assert.Equal(t, entity.OrganizationOwnerRoleID, founder.RoleID())
+
+			return nil
+		}).Times(1)
+
+	uc := organization.NewCreateOrganizationUseCase(orgRepo, mock_shared.NewMockTransactionManager(nil))
+	output, err := uc.Execute(context.Background(), organization.CreateOrganizationInput{
+		UserID: userID,
+		Name:   "Acme",
+	})
+
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, output.ID)
+	assert.Equal(t, "Acme", output.Name)
+	assert.False(t, output.CreatedAt.IsZero())
+}
+
+func TestCreateOrganizationUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name      string
+		orgName   string
+		userID    uuid.UUID
+		createErr error
+		wantCode  vo.ErrorCode
+	}{
+		{
+			name:     "empty name",
+			orgName:  "",
+			userID:   uuid.New(),
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:     "missing user",
+			orgName:  "Acme",
+			userID:   uuid.Nil,
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:      "repository error",
+			orgName:   "Acme",
+			userID:    uuid.New(),
+			createErr: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			orgRepo := mock_repository.NewMockOrganizationRepository(ctrl)
+			if tt.createErr != nil {
+				orgRepo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.createErr).Times(1)
+			}
+
+			uc := organization.NewCreateOrganizationUseCase(orgRepo, mock_shared.NewMockTransactionManager(nil))
+			output, err := uc.Execute(context.Background(), organization.CreateOrganizationInput{
+				UserID: tt.userID,
+				Name:   tt.orgName,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.createErr != nil {
+				assert.ErrorIs(t, err, tt.createErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
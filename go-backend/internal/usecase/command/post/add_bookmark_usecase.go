@@ -0,0 +1,81 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AddBookmarkUseCase bookmarks a post the actor may see. Bookmarking a post again succeeds without
+// changing anything.
+type AddBookmarkUseCase interface {
+	Execute(ctx context.Context, input AddBookmarkInput) error
+}
+
+type AddBookmarkInput struct {
+	ActorID uuid.UUID
+	PostID  uuid.UUID
+}
+
+type addBookmarkUseCaseImpl struct {
+	tracer             trace.Tracer
+	logger             common.Logger
+	bookmarkRepository repository.BookmarkRepository
+	txManager          shared.TransactionManager
+}
+
+func (uc *addBookmarkUseCaseImpl) Execute(ctx context.Context, input AddBookmarkInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	bookmark := entity.NewBookmark(input.PostID, input.ActorID, time.Now())
+
+	var added bool
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var txErr error
+
+		added, txErr = uc.bookmarkRepository.Add(ctx, bookmark)
+		if errors.Is(txErr, repository.ErrPostNotFound) {
+			return vo.NewNotFoundError("post not found", nil, txErr)
+		}
+
+		if txErr != nil {
+			uc.logger.Error(ctx, "failed to save Bookmark", "error", txErr)
+		}
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "bookmark added", "postID", input.PostID, "actorID", input.ActorID, "changed", added)
+
+	return nil
+}
+
+func NewAddBookmarkUseCase(
+	bookmarkRepository repository.BookmarkRepository,
+	txManager shared.TransactionManager,
+) AddBookmarkUseCase {
+	return &addBookmarkUseCaseImpl{
+		tracer:             otel.Tracer("AddBookmarkUseCase"),
+		logger:             common.NewLogger(),
+		bookmarkRepository: bookmarkRepository,
+		txManager:          txManager,
+	}
+}
@@ -0,0 +1,83 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAddBookmarkUseCase_HappyCase(t *testing.T) {
+	// Bookmarking twice is not an error.
+	for name, added := range map[string]bool{"new bookmark": true, "existing bookmark": false} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID, postID := uuid.New(), uuid.New()
+
+			bookmarkRepository := mock_repository.NewMockBookmarkRepository(ctrl)
+			bookmarkRepository.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, b entity.Bookmark) (bool, error) {
+					assert.Equal(t, postID, b.PostID())
+					assert.Equal(t, actorID, b.UserID())
+					assert.False(t, b.CreatedAt().IsZero())
+
+					return added, nil
+				}).Times(1)
+
+			uc := post.NewAddBookmarkUseCase(bookmarkRepository, mock_shared.NewMockTransactionManager(nil))
+			err := uc.Execute(context.Background(), post.AddBookmarkInput{ActorID: actorID, PostID: postID})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAddBookmarkUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name     string
+		addErr   error
+		txErr    error
+		wantErr  error
+		wantCode vo.ErrorCode
+	}{
+		{name: "post missing or not visible", addErr: repository.ErrPostNotFound, wantCode: vo.NotFoundErrorCode},
+		{name: "add fails", addErr: errDB, wantErr: errDB},
+		{name: "transaction fails", txErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			bookmarkRepository := mock_repository.NewMockBookmarkRepository(ctrl)
+			bookmarkRepository.EXPECT().Add(gomock.Any(), gomock.Any()).Return(false, tt.addErr).AnyTimes()
+
+			uc := post.NewAddBookmarkUseCase(bookmarkRepository, mock_shared.NewMockTransactionManager(tt.txErr))
+			err := uc.Execute(context.Background(), post.AddBookmarkInput{ActorID: uuid.New(), PostID: uuid.New()})
+
+			require.Error(t, err)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
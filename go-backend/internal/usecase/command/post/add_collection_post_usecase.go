@@ -0,0 +1,83 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AddCollectionPostUseCase appends a post the actor may see to the end of one of their collections.
+// Adding a post that is already in the collection succeeds without changing anything, and keeps its place.
+type AddCollectionPostUseCase interface {
+	Execute(ctx context.Context, input AddCollectionPostInput) error
+}
+
+type AddCollectionPostInput struct {
+	ActorID      uuid.UUID
+	CollectionID uuid.UUID
+	PostID       uuid.UUID
+}
+
+type addCollectionPostUseCaseImpl struct {
+	tracer               trace.Tracer
+	logger               common.Logger
+	collectionRepository repository.CollectionRepository
+	txManager            shared.TransactionManager
+}
+
+func (uc *addCollectionPostUseCaseImpl) Execute(ctx context.Context, input AddCollectionPostInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	var added bool
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		collection, txErr := findOwnCollection(ctx, uc.collectionRepository, input.CollectionID, input.ActorID)
+		if txErr != nil {
+			return txErr
+		}
+
+		added, txErr = uc.collectionRepository.AddPost(ctx, collection, input.PostID, time.Now())
+		if errors.Is(txErr, repository.ErrPostNotFound) {
+			return vo.NewNotFoundError("post not found", nil, txErr)
+		}
+
+		if txErr != nil {
+			uc.logger.Error(ctx, "failed to add post to Collection", "error", txErr)
+		}
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "post added to collection", "collectionID", input.CollectionID, "postID", input.PostID,
+		"actorID", input.ActorID, "changed", added)
+
+	return nil
+}
+
+func NewAddCollectionPostUseCase(
+	collectionRepository repository.CollectionRepository,
+	txManager shared.TransactionManager,
+) AddCollectionPostUseCase {
+	return &addCollectionPostUseCaseImpl{
+		tracer:               otel.Tracer("AddCollectionPostUseCase"),
+		logger:               common.NewLogger(),
+		collectionRepository: collectionRepository,
+		txManager:            txManager,
+	}
+}
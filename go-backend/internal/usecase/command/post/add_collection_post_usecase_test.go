@@ -0,0 +1,96 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAddCollectionPostUseCase_HappyCase(t *testing.T) {
+	// Adding a post twice is not an error.
+	for name, added := range map[string]bool{"new post": true, "post already in the collection": false} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID, postID := uuid.New(), uuid.New()
+			existing := collectionOf(actorID)
+
+			collectionRepository := mock_repository.NewMockCollectionRepository(ctrl)
+			collectionRepository.EXPECT().FindByID(gomock.Any(), existing.ID()).Return(existing, nil).Times(1)
+			collectionRepository.EXPECT().AddPost(gomock.Any(), existing, postID, gomock.Any()).
+				Return(added, nil).Times(1)
+
+			uc := post.NewAddCollectionPostUseCase(collectionRepository, mock_shared.NewMockTransactionManager(nil))
+			err := uc.Execute(context.Background(), post.AddCollectionPostInput{
+				ActorID: actorID, CollectionID: existing.ID(), PostID: postID,
+			})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAddCollectionPostUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+	actorID := uuid.New()
+
+	tests := []struct {
+		name     string
+		owner    uuid.UUID
+		findErr  error
+		addErr   error
+		txErr    error
+		wantErr  error
+		wantCode vo.ErrorCode
+	}{
+		{name: "collection does not exist", findErr: repository.ErrCollectionNotFound, wantCode: vo.NotFoundErrorCode},
+		{name: "collection of another user", owner: uuid.New(), wantCode: vo.NotFoundErrorCode},
+		{name: "post missing or not visible", addErr: repository.ErrPostNotFound, wantCode: vo.NotFoundErrorCode},
+		{name: "add fails", addErr: errDB, wantErr: errDB},
+		{name: "transaction fails", txErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			owner := actorID
+			if tt.owner != uuid.Nil {
+				owner = tt.owner
+			}
+
+			collectionRepository := mock_repository.NewMockCollectionRepository(ctrl)
+			collectionRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).
+				Return(collectionOf(owner), tt.findErr).AnyTimes()
+			collectionRepository.EXPECT().AddPost(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(false, tt.addErr).AnyTimes()
+
+			txManager := mock_shared.NewMockTransactionManager(tt.txErr)
+			uc := post.NewAddCollectionPostUseCase(collectionRepository, txManager)
+			err := uc.Execute(context.Background(), post.AddCollectionPostInput{
+				ActorID: actorID, CollectionID: uuid.New(), PostID: uuid.New(),
+			})
+
+			require.Error(t, err)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AddReactionUseCase reacts to a post on behalf of the actor. Reacting again with the same type succeeds
+// without changing anything.
+type AddReactionUseCase interface {
+	Execute(ctx context.Context, input AddReactionInput) error
+}
+
+type AddReactionInput struct {
+	ActorID uuid.UUID
+	PostID  uuid.UUID
+	// Type must be one of vo.ReactionTypes.
+	Type string
+}
+
+type addReactionUseCaseImpl struct {
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	postRepository         repository.PostRepository
+	reactionRepository     repository.ReactionRepository
+	relationshipRepository aggregaterepository.UserRelationshipRepository
+	txManager              shared.TransactionManager
+}
+
+func (uc *addReactionUseCaseImpl) Execute(ctx context.Context, input AddReactionInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	reaction, err := entity.NewReaction(input.PostID, input.ActorID, input.Type, time.Now())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	var added bool
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		target, txErr := findPost(ctx, uc.postRepository, input.PostID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = authorizeInteraction(ctx, uc.relationshipRepository, input.ActorID, target); txErr != nil {
+			return txErr
+		}
+
+		added, txErr = uc.reactionRepository.Add(ctx, reaction)
+		if txErr != nil {
+			uc.logger.Error(ctx, "failed to save Reaction", "error", txErr)
+		}
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "reaction added", "postID", input.PostID, "actorID", input.ActorID,
+		"type", reaction.Type(), "changed", added)
+
+	return nil
+}
+
+func NewAddReactionUseCase(
+	postRepository repository.PostRepository,
+	reactionRepository repository.ReactionRepository,
+	relationshipRepository aggregaterepository.UserRelationshipRepository,
+	txManager shared.TransactionManager,
+) AddReactionUseCase {
+	return &addReactionUseCaseImpl{
+		tracer:                 otel.Tracer("AddReactionUseCase"),
+		logger:                 common.NewLogger(),
+		postRepository:         postRepository,
+		reactionRepository:     reactionRepository,
+		relationshipRepository: relationshipRepository,
+		txManager:              txManager,
+	}
+}
@@ -0,0 +1,178 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_aggregate_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/aggregate/repository"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// relationshipsBlocking is a relationship repository under which every actor blocks exactly blockedIDs.
+func relationshipsBlocking(
+	ctrl *gomock.Controller, blockedIDs ...uuid.UUID,
+) *mock_aggregate_repository.MockUserRelationshipRepository {
+	relationshipRepository := mock_aggregate_repository.NewMockUserRelationshipRepository(ctrl)
+	relationshipRepository.EXPECT().FindByUserID(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, userID uuid.UUID) (*aggregate.UserRelationshipAggregate, error) {
+			return &aggregate.UserRelationshipAggregate{UserID: userID, BlockedIDs: blockedIDs}, nil
+		}).AnyTimes()
+
+	return relationshipRepository
+}
+
+func TestAddReactionUseCase_HappyCase(t *testing.T) {
+	// Reacting twice with the same type is not an error.
+	tests := []struct {
+		name  string
+		added bool
+	}{
+		{name: "new reaction", added: true},
+		{name: "existing reaction", added: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID := uuid.New()
+			existing := entity.ReconstructPost(
+				uuid.New(),
+				uuid.New(),
+				"content",
+				vo.ContentFormatPlain,
+				vo.PostKindPost,
+				nil,
+				vo.PostVisibilityPublic,
+				vo.PostStatusPublished,
+				nil,
+				nil,
+				nil,
+				nil,
+				time.Now(),
+				time.Now(),
+			)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), existing.ID()).Return(existing, nil).Times(1)
+
+			reactionRepository := mock_repository.NewMockReactionRepository(ctrl)
+			reactionRepository.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, r entity.Reaction) (bool, error) {
+					assert.Equal(t, existing.ID(), r.PostID())
+					assert.Equal(t, actorID, r.UserID())
+					assert.Equal(t, vo.ReactionTypeLike, r.Type())
+
+					return tt.added, nil
+				}).Times(1)
+
+			uc := post.NewAddReactionUseCase(
+				postRepository, reactionRepository, relationshipsBlocking(ctrl), mock_shared.NewMockTransactionManager(nil),
+			)
+			err := uc.Execute(context.Background(), post.AddReactionInput{
+				ActorID: actorID,
+				PostID:  existing.ID(),
+				Type:    "like",
+			})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAddReactionUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name        string
+		reaction    string
+		findPostErr error
+		blocked     bool
+		findRelErr  error
+		addErr      error
+		txErr       error
+		wantErr     error
+		wantCode    vo.ErrorCode
+	}{
+		{name: "unknown reaction type", reaction: "dislike", wantCode: vo.ValidationErrorCode},
+		{name: "post does not exist", findPostErr: repository.ErrPostNotFound, wantCode: vo.NotFoundErrorCode},
+		{name: "find post fails", findPostErr: errDB, wantErr: errDB},
+		{name: "author is blocked", blocked: true, wantCode: vo.NotFoundErrorCode},
+		{name: "find relationships fails", findRelErr: errDB, wantErr: errDB},
+		{name: "add fails", addErr: errDB, wantErr: errDB},
+		{name: "transaction fails", txErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			existing := entity.ReconstructPost(
+				uuid.New(),
+				uuid.New(),
+				"content",
+				vo.ContentFormatPlain,
+				vo.PostKindPost,
+				nil,
+				vo.PostVisibilityPublic,
+				vo.PostStatusPublished,
+				nil,
+				nil,
+				nil,
+				nil,
+				time.Now(),
+				time.Now(),
+			)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(existing, tt.findPostErr).AnyTimes()
+
+			reactionRepository := mock_repository.NewMockReactionRepository(ctrl)
+			reactionRepository.EXPECT().Add(gomock.Any(), gomock.Any()).Return(false, tt.addErr).AnyTimes()
+
+			relationships := &aggregate.UserRelationshipAggregate{}
+			if tt.blocked {
+				relationships.BlockedIDs = []uuid.UUID{existing.UserID()}
+			}
+
+			relationshipRepository := mock_aggregate_repository.NewMockUserRelationshipRepository(ctrl)
+			relationshipRepository.EXPECT().FindByUserID(gomock.Any(), gomock.Any()).
+				Return(relationships, tt.findRelErr).AnyTimes()
+
+			reaction := tt.reaction
+			if reaction == "" {
+				reaction = "like"
+			}
+
+			txManager := mock_shared.NewMockTransactionManager(tt.txErr)
+			uc := post.NewAddReactionUseCase(postRepository, reactionRepository, relationshipRepository, txManager)
+			err := uc.Execute(context.Background(), post.AddReactionInput{
+				ActorID: uuid.New(),
+				PostID:  existing.ID(),
+				Type:    reaction,
+			})
+
+			require.Error(t, err)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package post
+
+import (
+	"context"
+	"errors"
+
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+var (
+	errNotAuthorOrModerator = errors.New("user is neither the author nor holds posts:moderate")
+	errNotModerator         = errors.New("user does not hold posts:moderate")
+)
+
+// authorizeModification lets authors modify their own posts and holders of posts:moderate modify any post
+// in the active tenant.
+func authorizeModification(
+	ctx context.Context,
+	permissionRepository aggregaterepository.UserPermissionRepository,
+	actorID uuid.UUID,
+	post entity.Post,
+) error {
+	return authorizeAuthorOrModerator(ctx, permissionRepository, actorID, post.UserID(), "post")
+}
+
+// authorizeCommentModification applies the rule of authorizeModification to comments.
+func authorizeCommentModification(
+	ctx context.Context,
+	permissionRepository aggregaterepository.UserPermissionRepository,
+	actorID uuid.UUID,
+	comment entity.Comment,
+) error {
+	return authorizeAuthorOrModerator(ctx, permissionRepository, actorID, comment.UserID(), "comment")
+}
+
+func authorizeAuthorOrModerator(
+	ctx context.Context,
+	permissionRepository aggregaterepository.UserPermissionRepository,
+	actorID, authorID uuid.UUID,
+	subject string,
+) error {
+	if authorID == actorID {
+		return nil
+	}
+
+	actor, err := permissionRepository.FindByUserID(ctx, actorID)
+	if err != nil {
+		return err
+	}
+
+	if !actor.HasPermission(vo.PermissionPostsModerate) {
+		return vo.NewForbiddenError("only the author or a moderator may modify this "+subject, nil,
+			errNotAuthorOrModerator)
+	}
+
+	return nil
+}
+
+// authorizeModeration lets only holders of posts:moderate act as moderators.
+func authorizeModeration(
+	ctx context.Context, permissionRepository aggregaterepository.UserPermissionRepository, actorID uuid.UUID,
+) error {
+	actor, err := permissionRepository.FindByUserID(ctx, actorID)
+	if err != nil {
+		return err
+	}
+
+	if !actor.HasPermission(vo.PermissionPostsModerate) {
+		return vo.NewForbiddenError("insufficient permissions", nil, errNotModerator)
+	}
+
+	return nil
+}
+
+// authorizeRestore lets authors restore the posts they trashed themselves and holders of posts:moderate
+// restore any trashed post in the active tenant, so that authors cannot undo a moderator's deletion.
+func authorizeRestore(
+	ctx context.Context,
+	permissionRepository aggregaterepository.UserPermissionRepository,
+	actorID uuid.UUID,
+	post entity.Post,
+) error {
+	if deletedBy := post.DeletedBy(); post.UserID() == actorID && deletedBy != nil && *deletedBy == actorID {
+		return nil
+	}
+
+	actor, err := permissionRepository.FindByUserID(ctx, actorID)
+	if err != nil {
+		return err
+	}
+
+	if !actor.HasPermission(vo.PermissionPostsModerate) {
+		return vo.NewForbiddenError("only the author who deleted this post or a moderator may restore it", nil,
+			errNotModerator)
+	}
+
+	return nil
+}
+
+// findPost loads a post of the active tenant, reporting a missing one as a NotFound error.
+func findPost(ctx context.Context, postRepository repository.PostRepository, id uuid.UUID) (entity.Post, error) {
+	post, err := postRepository.FindByID(ctx, id)
+	if errors.Is(err, repository.ErrPostNotFound) {
+		return nil, vo.NewNotFoundError("post not found", nil, err)
+	}
+
+	return post, err
+}
+
+// findSharedPost loads a post the actor wants to repost or quote. Drafts and private or hidden posts of others
+// are reported as missing, since the actor may not see them; see entity.NewRepost for what can be shared.
+func findSharedPost(
+	ctx context.Context, postRepository repository.PostRepository, actorID, id uuid.UUID,
+) (entity.Post, error) {
+	post, err := findPost(ctx, postRepository, id)
+	if err != nil {
+		return nil, err
+	}
+
+	hiddenFromActor := post.Status().IsDraft() || post.HiddenAt() != nil ||
+		post.Visibility() == vo.PostVisibilityPrivate
+	if post.UserID() != actorID && hiddenFromActor {
+		return nil, vo.NewNotFoundError("post not found", nil, repository.ErrPostNotFound)
+	}
+
+	return post, nil
+}
+
+// authorizeInteraction keeps users who block one another, in either direction, from interacting with each
+// other's posts. Such a post is hidden from the actor, so it is reported as missing. The repositories keep
+// the rule as well, through the user_exclusions view; checking first lets the use cases report it.
+func authorizeInteraction(
+	ctx context.Context,
+	relationshipRepository aggregaterepository.UserRelationshipRepository,
+	actorID uuid.UUID,
+	post entity.Post,
+) error {
+	blocked, err := isBlocked(ctx, relationshipRepository, actorID, post.UserID())
+	if err != nil {
+		return err
+	}
+
+	if blocked {
+		return vo.NewNotFoundError("post not found", nil, repository.ErrPostNotFound)
+	}
+
+	return nil
+}
+
+// isBlocked reports whether the actor and userID block one another, in either direction; see
+// aggregate.UserRelationshipAggregate.
+func isBlocked(
+	ctx context.Context,
+	relationshipRepository aggregaterepository.UserRelationshipRepository,
+	actorID, userID uuid.UUID,
+) (bool, error) {
+	if actorID == userID {
+		return false, nil
+	}
+
+	relationships, err := relationshipRepository.FindByUserID(ctx, actorID)
+	if err != nil {
+		return false, err
+	}
+
+	return relationships.Blocks(userID), nil
+}
+
+// findComment loads a comment of the given post, reporting a missing one, or one on another post, as a
+// NotFound error.
+func findComment(
+	ctx context.Context, commentRepository repository.CommentRepository, postID, id uuid.UUID,
+) (entity.Comment, error) {
+	comment, err := commentRepository.FindByID(ctx, id)
+	if errors.Is(err, repository.ErrCommentNotFound) {
+		return nil, vo.NewNotFoundError("comment not found", nil, err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.PostID() != postID {
+		return nil, vo.NewNotFoundError("comment not found", nil, repository.ErrCommentNotFound)
+	}
+
+	return comment, nil
+}
+
+// findOwnCollection loads a collection of the actor. Collections are private, so those of other users are
+// reported as missing just like collections that do not exist.
+func findOwnCollection(
+	ctx context.Context, collectionRepository repository.CollectionRepository, id, actorID uuid.UUID,
+) (entity.Collection, error) {
+	collection, err := collectionRepository.FindByID(ctx, id)
+	if errors.Is(err, repository.ErrCollectionNotFound) {
+		return nil, vo.NewNotFoundError("collection not found", nil, err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if collection.UserID() != actorID {
+		return nil, vo.NewNotFoundError("collection not found", nil, repository.ErrCollectionNotFound)
+	}
+
+	return collection, nil
+}
@@ -0,0 +1,117 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxCollectionsPerUser keeps the list of a user's collections small enough to return in one response.
+const maxCollectionsPerUser = 100
+
+var errTooManyCollections = errors.New("too many collections")
+
+// CreateCollectionUseCase creates an empty, named collection owned by the actor.
+type CreateCollectionUseCase interface {
+	Execute(ctx context.Context, input CreateCollectionInput) (*CollectionOutput, error)
+}
+
+type CreateCollectionInput struct {
+	ActorID uuid.UUID
+	Name    string
+}
+
+// CollectionOutput describes a collection after it was created or renamed.
+type CollectionOutput struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type createCollectionUseCaseImpl struct {
+	tracer               trace.Tracer
+	logger               common.Logger
+	collectionRepository repository.CollectionRepository
+	txManager            shared.TransactionManager
+}
+
+func (uc *createCollectionUseCaseImpl) Execute(
+	ctx context.Context, input CreateCollectionInput,
+) (*CollectionOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	collection, err := entity.NewCollection(input.ActorID, input.Name, time.Now())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		count, txErr := uc.collectionRepository.CountByUserID(ctx, input.ActorID)
+		if txErr != nil {
+			uc.logger.Error(ctx, "failed to count Collections", "error", txErr)
+
+			return txErr
+		}
+
+		if count >= maxCollectionsPerUser {
+			return vo.NewValidationError(
+				fmt.Sprintf("a user can have at most %d collections", maxCollectionsPerUser),
+				map[string]any{"max_collections": maxCollectionsPerUser},
+				errTooManyCollections,
+			)
+		}
+
+		if collection, txErr = uc.collectionRepository.Create(ctx, collection); txErr != nil {
+			uc.logger.Error(ctx, "failed to save Collection", "error", txErr)
+		}
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	uc.logger.Info(ctx, "collection created", "collectionID", collection.ID(), "actorID", input.ActorID)
+
+	return toCollectionOutput(collection), nil
+}
+
+func toCollectionOutput(collection entity.Collection) *CollectionOutput {
+	return &CollectionOutput{
+		ID:        collection.ID(),
+		Name:      collection.Name(),
+		CreatedAt: collection.CreatedAt(),
+		UpdatedAt: collection.UpdatedAt(),
+	}
+}
+
+func NewCreateCollectionUseCase(
+	collectionRepository repository.CollectionRepository,
+	txManager shared.TransactionManager,
+) CreateCollectionUseCase {
+	return &createCollectionUseCaseImpl{
+		tracer:               otel.Tracer("CreateCollectionUseCase"),
+		logger:               common.NewLogger(),
+		collectionRepository: collectionRepository,
+		txManager:            txManager,
+	}
+}
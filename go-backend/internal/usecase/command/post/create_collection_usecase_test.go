@@ -0,0 +1,100 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// collectionOf returns a saved collection owned by userID.
+func collectionOf(userID uuid.UUID) entity.Collection {
+	createdAt := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+
+	return entity.ReconstructCollection(uuid.New(), userID, "Read later", createdAt, createdAt)
+}
+
+func TestCreateCollectionUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	actorID := uuid.New()
+
+	collectionRepository := mock_repository.NewMockCollectionRepository(ctrl)
+	collectionRepository.EXPECT().CountByUserID(gomock.Any(), actorID).Return(99, nil).Times(1)
+	collectionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, c entity.Collection) (entity.Collection, error) {
+			assert.Equal(t, actorID, c.UserID())
+
+			return c, nil
+		}).Times(1)
+
+	uc := post.NewCreateCollectionUseCase(collectionRepository, mock_shared.NewMockTransactionManager(nil))
+	output, err := uc.Execute(context.Background(), post.CreateCollectionInput{ActorID: actorID, Name: " Recipes "})
+
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, output.ID)
+	assert.Equal(t, "Recipes", output.Name)
+	assert.Equal(t, output.CreatedAt, output.UpdatedAt)
+}
+
+func TestCreateCollectionUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name      string
+		input     string
+		count     int
+		countErr  error
+		createErr error
+		txErr     error
+		wantErr   error
+		wantCode  vo.ErrorCode
+	}{
+		{name: "empty name", input: " ", wantCode: vo.ValidationErrorCode},
+		{name: "too many collections", count: 100, wantCode: vo.ValidationErrorCode},
+		{name: "count fails", countErr: errDB, wantErr: errDB},
+		{name: "create fails", createErr: errDB, wantErr: errDB},
+		{name: "transaction fails", txErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			collectionRepository := mock_repository.NewMockCollectionRepository(ctrl)
+			collectionRepository.EXPECT().CountByUserID(gomock.Any(), gomock.Any()).
+				Return(tt.count, tt.countErr).AnyTimes()
+			collectionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, tt.createErr).AnyTimes()
+
+			name := tt.input
+			if name == "" {
+				name = "Recipes"
+			}
+
+			uc := post.NewCreateCollectionUseCase(collectionRepository, mock_shared.NewMockTransactionManager(tt.txErr))
+			output, err := uc.Execute(context.Background(), post.CreateCollectionInput{ActorID: uuid.New(), Name: name})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
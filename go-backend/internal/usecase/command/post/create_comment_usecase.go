@@ -0,0 +1,157 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errParentCommentNotExist = errors.New("parent comment does not exist")
+
+// CreateCommentUseCase adds a comment to a post, either top-level or as a reply to another comment.
+type CreateCommentUseCase interface {
+	Execute(ctx context.Context, input CreateCommentInput) (*CreateCommentOutput, error)
+}
+
+type CreateCommentInput struct {
+	ActorID uuid.UUID
+	PostID  uuid.UUID
+	// ParentID is the comment replied to; nil for a top-level comment.
+	ParentID *uuid.UUID
+	Content  string
+}
+
+type CreateCommentOutput struct {
+	ID        uuid.UUID
+	PostID    uuid.UUID
+	ParentID  *uuid.UUID
+	UserID    uuid.UUID
+	Content   string
+	Depth     int
+	CreatedAt time.Time
+}
+
+type createCommentUseCaseImpl struct {
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	postRepository         repository.PostRepository
+	commentRepository      repository.CommentRepository
+	relationshipRepository aggregaterepository.UserRelationshipRepository
+	txManager              shared.TransactionManager
+}
+
+func (uc *createCommentUseCaseImpl) Execute(
+	ctx context.Context, input CreateCommentInput,
+) (*CreateCommentOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	var created entity.Comment
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		target, txErr := findPost(ctx, uc.postRepository, input.PostID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = authorizeInteraction(ctx, uc.relationshipRepository, input.ActorID, target); txErr != nil {
+			return txErr
+		}
+
+		parent, txErr := uc.findParent(ctx, input.ActorID, input.ParentID)
+		if txErr != nil {
+			return txErr
+		}
+
+		comment, txErr := entity.NewComment(input.PostID, input.ActorID, parent, input.Content, time.Now())
+		if txErr != nil {
+			return txErr
+		}
+
+		created, txErr = uc.commentRepository.Create(ctx, comment)
+
+		switch {
+		case errors.Is(txErr, repository.ErrPostNotFound):
+			return vo.NewNotFoundError("post not found", nil, txErr)
+		case errors.Is(txErr, repository.ErrCommentNotFound):
+			return vo.NewValidationError("parent comment does not exist", nil, txErr)
+		case txErr != nil:
+			uc.logger.Error(ctx, "failed to save Comment", "error", txErr)
+		}
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return &CreateCommentOutput{
+		ID:        created.ID(),
+		PostID:    created.PostID(),
+		ParentID:  created.ParentID(),
+		UserID:    created.UserID(),
+		Content:   created.Content(),
+		Depth:     created.Depth(),
+		CreatedAt: created.CreatedAt(),
+	}, nil
+}
+
+// findParent loads the comment replied to; entity.NewComment rejects one that is on another post. Comments
+// of users who block the actor, or whom the actor blocks, are hidden from the actor and thus missing.
+func (uc *createCommentUseCaseImpl) findParent(
+	ctx context.Context, actorID uuid.UUID, parentID *uuid.UUID,
+) (entity.Comment, error) {
+	if parentID == nil {
+		return nil, nil
+	}
+
+	parent, err := uc.commentRepository.FindByID(ctx, *parentID)
+	if errors.Is(err, repository.ErrCommentNotFound) {
+		return nil, vo.NewValidationError("parent comment does not exist", nil, errParentCommentNotExist)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	blocked, err := isBlocked(ctx, uc.relationshipRepository, actorID, parent.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	if blocked {
+		return nil, vo.NewValidationError("parent comment does not exist", nil, errParentCommentNotExist)
+	}
+
+	return parent, nil
+}
+
+func NewCreateCommentUseCase(
+	postRepository repository.PostRepository,
+	commentRepository repository.CommentRepository,
+	relationshipRepository aggregaterepository.UserRelationshipRepository,
+	txManager shared.TransactionManager,
+) CreateCommentUseCase {
+	return &createCommentUseCaseImpl{
+		tracer:                 otel.Tracer("CreateCommentUseCase"),
+		logger:                 common.NewLogger(),
+		postRepository:         postRepository,
+		commentRepository:      commentRepository,
+		relationshipRepository: relationshipRepository,
+		txManager:              txManager,
+	}
+}
@@ -0,0 +1,210 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateCommentUseCase_HappyCase(t *testing.T) {
+	actorID := uuid.New()
+	existing := entity.ReconstructPost(
+		uuid.New(),
+		uuid.New(),
+		"content",
+		vo.ContentFormatPlain,
+		vo.PostKindPost,
+		nil,
+		vo.PostVisibilityPublic,
+		vo.PostStatusPublished,
+		nil,
+		nil,
+		nil,
+		nil,
+		time.Now(),
+		time.Now(),
+	)
+	parent := entity.ReconstructComment(uuid.New(), existing.ID(), nil, uuid.New(), "parent", 0, time.Now())
+	parentID := parent.ID()
+
+	tests := []struct {
+		name      string
+		parentID  *uuid.UUID
+		wantDepth int
+	}{
+		{name: "top-level comment", parentID: nil, wantDepth: 0},
+		{name: "reply to a comment", parentID: &parentID, wantDepth: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), existing.ID()).Return(existing, nil).Times(1)
+
+			commentRepository := mock_repository.NewMockCommentRepository(ctrl)
+			if tt.parentID != nil {
+				commentRepository.EXPECT().FindByID(gomock.Any(), parentID).Return(parent, nil).Times(1)
+			}
+
+			commentRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, c entity.Comment) (entity.Comment, error) {
+					return c, nil
+				}).Times(1)
+
+			uc := post.NewCreateCommentUseCase(
+				postRepository, commentRepository, relationshipsBlocking(ctrl), mock_shared.NewMockTransactionManager(nil),
+			)
+			output, err := uc.Execute(context.Background(), post.CreateCommentInput{
+				ActorID:  actorID,
+				PostID:   existing.ID(),
+				ParentID: tt.parentID,
+				Content:  " first! ",
+			})
+
+			require.NoError(t, err)
+			assert.NotEqual(t, uuid.Nil, output.ID)
+			assert.Equal(t, existing.ID(), output.PostID)
+			assert.Equal(t, tt.parentID, output.ParentID)
+			assert.Equal(t, actorID, output.UserID)
+			assert.Equal(t, "first!", output.Content)
+			assert.Equal(t, tt.wantDepth, output.Depth)
+		})
+	}
+}
+
+func TestCreateCommentUseCase_FailureCase(t *testing.T) {
+	postID := uuid.New()
+	parentID := uuid.New()
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name          string
+		parentID      *uuid.UUID
+		content       string
+		findPostErr   error
+		blockAuthor   bool
+		blockParent   bool
+		findParentErr error
+		parentPostID  uuid.UUID
+		createErr     error
+		txErr         error
+		wantErr       error
+		wantCode      vo.ErrorCode
+	}{
+		{name: "post does not exist", findPostErr: repository.ErrPostNotFound, wantCode: vo.NotFoundErrorCode},
+		{name: "empty content", content: " ", wantCode: vo.ValidationErrorCode},
+		{name: "post author is blocked", blockAuthor: true, wantCode: vo.NotFoundErrorCode},
+		{name: "parent author is blocked", parentID: &parentID, blockParent: true, wantCode: vo.ValidationErrorCode},
+		{
+			name:          "parent does not exist",
+			parentID:      &parentID,
+			findParentErr: repository.ErrCommentNotFound,
+			wantCode:      vo.ValidationErrorCode,
+		},
+		{name: "parent on another post", parentID: &parentID, parentPostID: uuid.New(), wantCode: vo.ValidationErrorCode},
+		{
+			name:      "post deleted concurrently",
+			createErr: repository.ErrPostNotFound,
+			wantCode:  vo.NotFoundErrorCode,
+		},
+		{
+			name:      "parent deleted concurrently",
+			parentID:  &parentID,
+			createErr: repository.ErrCommentNotFound,
+			wantCode:  vo.ValidationErrorCode,
+		},
+		{name: "find post fails", findPostErr: errDB, wantErr: errDB},
+		{name: "find parent fails", parentID: &parentID, findParentErr: errDB, wantErr: errDB},
+		{name: "create fails", createErr: errDB, wantErr: errDB},
+		{name: "transaction fails", txErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			existing := entity.ReconstructPost(
+				postID,
+				uuid.New(),
+				"content",
+				vo.ContentFormatPlain,
+				vo.PostKindPost,
+				nil,
+				vo.PostVisibilityPublic,
+				vo.PostStatusPublished,
+				nil,
+				nil,
+				nil,
+				nil,
+				time.Now(),
+				time.Now(),
+			)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(existing, tt.findPostErr).AnyTimes()
+
+			parentPostID := postID
+			if tt.parentPostID != uuid.Nil {
+				parentPostID = tt.parentPostID
+			}
+
+			parentAuthorID := uuid.New()
+			parent := entity.ReconstructComment(parentID, parentPostID, nil, parentAuthorID, "parent", 0, time.Now())
+
+			commentRepository := mock_repository.NewMockCommentRepository(ctrl)
+			commentRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(parent, tt.findParentErr).AnyTimes()
+			commentRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, tt.createErr).AnyTimes()
+
+			content := tt.content
+			if content == "" {
+				content = "comment"
+			}
+
+			var blockedIDs []uuid.UUID
+			if tt.blockAuthor {
+				blockedIDs = append(blockedIDs, existing.UserID())
+			}
+
+			if tt.blockParent {
+				blockedIDs = append(blockedIDs, parentAuthorID)
+			}
+
+			txManager := mock_shared.NewMockTransactionManager(tt.txErr)
+			uc := post.NewCreateCommentUseCase(
+				postRepository, commentRepository, relationshipsBlocking(ctrl, blockedIDs...), txManager,
+			)
+			output, err := uc.Execute(context.Background(), post.CreateCommentInput{
+				ActorID:  uuid.New(),
+				PostID:   postID,
+				ParentID: tt.parentID,
+				Content:  content,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
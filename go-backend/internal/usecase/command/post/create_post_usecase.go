@@ -2,11 +2,15 @@ package post
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
 	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
@@ -14,6 +18,16 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+// timelineFanOutMinFollowers is the follower count from which an author's new posts are written to every
+// follower's timeline (fan-out on write) instead of being collected when a timeline is read. Reading the
+// posts of authors with this many followers again for every follower would cost more than writing them once.
+const timelineFanOutMinFollowers = 1000
+
+var (
+	errPostAttachmentNotFound = errors.New("attachment not found")
+	errContentRejected        = errors.New("content rejected by a content filter")
+)
+
 type CreatePostUseCase interface {
 	Execute(ctx context.Context, input CreatePostInput) (*CreatePostOutput, error)
 }
@@ -21,27 +35,86 @@ type CreatePostUseCase interface {
 type CreatePostInput struct {
 	UserID  uuid.UUID
 	Content string
+	// Format is "plain" or "markdown"; empty means plain.
+	Format string
+	// Visibility is "public", "followers" or "private"; empty means public.
+	Visibility string
+	// Draft saves the post unpublished. PublishAt schedules a draft for publication, so it implies Draft.
+	Draft     bool
+	PublishAt *time.Time
+	// AttachmentIDs are uploads of the user, attached to the post in this order. Each upload can be
+	// attached to one post only.
+	AttachmentIDs []uuid.UUID
+	// QuotedPostID makes the post a quote of that post when set; see entity.NewQuotePost.
+	QuotedPostID *uuid.UUID
+	// Poll attaches a poll to the post when set.
+	Poll *PollInput
+}
+
+// PollInput describes a poll to attach to a new post; see entity.NewPoll for the rules.
+type PollInput struct {
+	// Options are the labels of the options in display order.
+	Options []string
+	// Multiple lets voters choose several options instead of one.
+	Multiple bool
+	ClosesAt time.Time
 }
 
 type CreatePostOutput struct {
-	ID        uuid.UUID
-	UserID    uuid.UUID
-	Content   string
-	CreatedAt time.Time
+	ID     uuid.UUID
+	UserID uuid.UUID
+	// Content is the source of the post in Format; ContentHTML is its sanitised rendering.
+	Content     string
+	Format      vo.ContentFormat
+	ContentHTML string
+	// Kind is vo.PostKindQuote for quotes and vo.PostKindPost otherwise.
+	Kind       vo.PostKind
+	Visibility vo.PostVisibility
+	Status     vo.PostStatus
+	PublishAt  *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	// RevisionCount is 1: the original content is the post's first revision.
+	RevisionCount int
+	// Attachments are in the order of CreatePostInput.AttachmentIDs; never nil.
+	Attachments []AttachmentOutput
+	// Poll is nil unless CreatePostInput.Poll was set. Nobody has voted in a new poll yet.
+	Poll *PollOutput
+}
+
+type PollOutput struct {
+	// Options are in display order.
+	Options  []PollOptionOutput
+	Multiple bool
+	ClosesAt time.Time
+}
+
+type PollOptionOutput struct {
+	ID    uuid.UUID
+	Label string
 }
 
 type createPostUseCaseImpl struct {
-	tracer         trace.Tracer
-	logger         common.Logger
-	postRepository repository.PostRepository
-	txManager      shared.TransactionManager
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	postRepository         repository.PostRepository
+	postRevisionRepository repository.PostRevisionRepository
+	postEntityRepository   repository.PostEntityRepository
+	timelineRepository     repository.TimelineRepository
+	attachmentRepository   repository.AttachmentRepository
+	postReportRepository   repository.PostReportRepository
+	pollRepository         repository.PollRepository
+	relationshipRepository aggregaterepository.UserRelationshipRepository
+	contentFilter          service.ContentFilter
+	contentRenderer        service.ContentRenderer
+	txManager              shared.TransactionManager
 }
 
 func (uc *createPostUseCaseImpl) Execute(ctx context.Context, input CreatePostInput) (*CreatePostOutput, error) {
 	ctx, span := uc.tracer.Start(ctx, "execute")
 	defer span.End()
 
-	post, err := entity.NewPost(input.UserID, input.Content, time.Now())
+	post, err := uc.newPost(ctx, input, time.Now())
 	if err != nil {
 		uc.logger.Error(ctx, "failed to create Post", "error", err)
 		span.RecordError(err)
@@ -50,18 +123,95 @@ func (uc *createPostUseCaseImpl) Execute(ctx context.Context, input CreatePostIn
 		return nil, err
 	}
 
-	var created entity.Post
+	revision, err := entity.NewPostRevision(post, input.UserID)
+	if err == nil {
+		err = entity.ValidateAttachmentIDs(input.AttachmentIDs)
+	}
+
+	var poll entity.Poll
+	if err == nil && input.Poll != nil {
+		poll, err = entity.NewPoll(post, input.Poll.Options, input.Poll.Multiple, input.Poll.ClosesAt)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	verdict, err := filterContent(ctx, uc.logger, uc.contentFilter, post)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var (
+		created         entity.Post
+		createdRevision entity.PostRevision
+		attachments     []entity.Attachment
+	)
 
 	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
 		var repoErr error
 
 		created, repoErr = uc.postRepository.Create(ctx, post)
+		if errors.Is(repoErr, repository.ErrPostNotFound) {
+			return vo.NewNotFoundError("post not found", nil, repoErr)
+		}
+
 		if repoErr != nil {
 			uc.logger.Error(ctx, "failed to save Post", "error", repoErr)
 
 			return repoErr
 		}
 
+		createdRevision, repoErr = uc.postRevisionRepository.Create(ctx, revision)
+		if repoErr != nil {
+			uc.logger.Error(ctx, "failed to save PostRevision", "error", repoErr)
+
+			return repoErr
+		}
+
+		repoErr = uc.postEntityRepository.Replace(ctx, created, vo.ParseContentEntities(created.Content()))
+		if repoErr != nil {
+			uc.logger.Error(ctx, "failed to save Post entities", "error", repoErr)
+
+			return repoErr
+		}
+
+		attachments, repoErr = uc.attach(ctx, created, input.AttachmentIDs)
+		if repoErr != nil {
+			uc.logger.Error(ctx, "failed to attach Attachments", "error", repoErr)
+
+			return repoErr
+		}
+
+		if poll != nil {
+			if repoErr = uc.pollRepository.Create(ctx, poll); repoErr != nil {
+				uc.logger.Error(ctx, "failed to save Poll", "error", repoErr)
+
+				return repoErr
+			}
+		}
+
+		if repoErr = fanOut(ctx, uc.timelineRepository, created); repoErr != nil {
+			uc.logger.Error(ctx, "failed to fan out Post", "error", repoErr)
+
+			return repoErr
+		}
+
+		if verdict.Action == service.ContentFlag {
+			repoErr = flagForReview(ctx, uc.logger, uc.postReportRepository, created, verdict, created.CreatedAt())
+			if repoErr != nil {
+				uc.logger.Error(ctx, "failed to report flagged Post", "error", repoErr)
+
+				return repoErr
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -72,22 +222,188 @@ func (uc *createPostUseCaseImpl) Execute(ctx context.Context, input CreatePostIn
 		return nil, err
 	}
 
+	attachmentOutputs := make([]AttachmentOutput, len(attachments))
+	for i, attachment := range attachments {
+		attachmentOutputs[i] = toAttachmentOutput(attachment)
+	}
+
 	return &CreatePostOutput{
-		ID:        created.ID(),
-		UserID:    created.UserID(),
-		Content:   created.Content(),
-		CreatedAt: created.CreatedAt(),
+		ID:            created.ID(),
+		UserID:        created.UserID(),
+		Content:       created.Content(),
+		Format:        created.Format(),
+		ContentHTML:   uc.contentRenderer.Render(created.ID(), createdRevision.Number(), created.Format(), created.Content()),
+		Kind:          created.Kind(),
+		Visibility:    created.Visibility(),
+		Status:        created.Status(),
+		PublishAt:     created.PublishAt(),
+		CreatedAt:     created.CreatedAt(),
+		UpdatedAt:     created.UpdatedAt(),
+		RevisionCount: createdRevision.Number(),
+		Attachments:   attachmentOutputs,
+		Poll:          toPollOutput(poll),
 	}, nil
 }
 
+func toPollOutput(poll entity.Poll) *PollOutput {
+	if poll == nil {
+		return nil
+	}
+
+	options := make([]PollOptionOutput, len(poll.Options()))
+	for i, option := range poll.Options() {
+		options[i] = PollOptionOutput{ID: option.ID(), Label: option.Label()}
+	}
+
+	return &PollOutput{Options: options, Multiple: poll.Multiple(), ClosesAt: poll.ClosesAt()}
+}
+
+// newPost builds the post described by input, as a quote of the post identified by QuotedPostID when set.
+func (uc *createPostUseCaseImpl) newPost(
+	ctx context.Context, input CreatePostInput, now time.Time,
+) (entity.Post, error) {
+	params := entity.PostParams{
+		UserID:     input.UserID,
+		Content:    input.Content,
+		Format:     input.Format,
+		Visibility: input.Visibility,
+		Draft:      input.Draft,
+		PublishAt:  input.PublishAt,
+		CreatedAt:  now,
+	}
+
+	if input.QuotedPostID == nil {
+		return entity.NewPost(params)
+	}
+
+	original, err := findSharedPost(ctx, uc.postRepository, input.UserID, *input.QuotedPostID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = authorizeInteraction(ctx, uc.relationshipRepository, input.UserID, original); err != nil {
+		return nil, err
+	}
+
+	return entity.NewQuotePost(original, params)
+}
+
+// filterContent runs the content filters on the content of the post, new or edited. Rejected content is a
+// validation problem; the reason tells the author which rule it broke but not what matched.
+func filterContent(
+	ctx context.Context, logger common.Logger, contentFilter service.ContentFilter, post entity.Post,
+) (service.ContentVerdict, error) {
+	verdict, err := contentFilter.Check(ctx, post.UserID(), post.Content())
+	if err != nil {
+		logger.Error(ctx, "failed to filter Post content", "error", err)
+
+		return service.ContentVerdict{}, err
+	}
+
+	if verdict.Action == service.ContentReject {
+		logger.Info(ctx, "post content rejected", "userID", post.UserID(), "filter", verdict.Filter,
+			"match", verdict.Match)
+
+		return service.ContentVerdict{}, vo.NewValidationError(verdict.Reason, map[string]any{
+			"filter": verdict.Filter,
+		}, errContentRejected)
+	}
+
+	return verdict, nil
+}
+
+// flagForReview reports the flagged post to the moderators on behalf of the content filters, as of reportedAt.
+func flagForReview(
+	ctx context.Context, logger common.Logger, postReportRepository repository.PostReportRepository,
+	post entity.Post, verdict service.ContentVerdict, reportedAt time.Time,
+) error {
+	report, err := entity.NewFlaggedPostReport(post.ID(), verdict.ReportReason, verdict.ReportDetails(), reportedAt)
+	if err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "post flagged for review", "postID", post.ID(), "filter", verdict.Filter,
+		"match", verdict.Match)
+
+	return postReportRepository.Create(ctx, report)
+}
+
+// attach attaches the uploads identified by ids to post in that order. Uploads of other users are reported
+// like missing ones, so that their IDs reveal nothing.
+func (uc *createPostUseCaseImpl) attach(
+	ctx context.Context, post entity.Post, ids []uuid.UUID,
+) ([]entity.Attachment, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	found, err := uc.attachmentRepository.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]entity.Attachment, len(found))
+	for _, attachment := range found {
+		byID[attachment.ID()] = attachment
+	}
+
+	attachments := make([]entity.Attachment, len(ids))
+
+	for i, id := range ids {
+		attachment, ok := byID[id]
+		if !ok || attachment.UploaderID() != post.UserID() {
+			return nil, vo.NewValidationError("attachment not found", map[string]any{
+				"attachment_id": id.String(),
+			}, errPostAttachmentNotFound)
+		}
+
+		if err = attachment.AttachTo(post.ID(), i); err != nil {
+			return nil, err
+		}
+
+		err = uc.attachmentRepository.Attach(ctx, attachment)
+		if errors.Is(err, repository.ErrAttachmentAlreadyAttached) {
+			return nil, vo.NewValidationError("attachment is already attached to a post", map[string]any{
+				"attachment_id": id.String(),
+			}, err)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		attachments[i] = attachment
+	}
+
+	return attachments, nil
+}
+
 func NewCreatePostUseCase(
 	postRepository repository.PostRepository,
+	postRevisionRepository repository.PostRevisionRepository,
+	postEntityRepository repository.PostEntityRepository,
+	timelineRepository repository.TimelineRepository,
+	attachmentRepository repository.AttachmentRepository,
+	postReportRepository repository.PostReportRepository,
+	pollRepository repository.PollRepository,
+	relationshipRepository aggregaterepository.UserRelationshipRepository,
+	contentFilter service.ContentFilter,
+	contentRenderer service.ContentRenderer,
 	txManager shared.TransactionManager,
 ) CreatePostUseCase {
 	return &createPostUseCaseImpl{
-		tracer:         otel.Tracer("CreatePostUseCase"),
-		logger:         common.NewLogger(),
-		postRepository: postRepository,
-		txManager:      txManager,
+		tracer:                 otel.Tracer("CreatePostUseCase"),
+		logger:                 common.NewLogger(),
+		postRepository:         postRepository,
+		postRevisionRepository: postRevisionRepository,
+		postEntityRepository:   postEntityRepository,
+		timelineRepository:     timelineRepository,
+		attachmentRepository:   attachmentRepository,
+		postReportRepository:   postReportRepository,
+		pollRepository:         pollRepository,
+		relationshipRepository: relationshipRepository,
+		contentFilter:          contentFilter,
+		contentRenderer:        contentRenderer,
+		txManager:              txManager,
 	}
 }
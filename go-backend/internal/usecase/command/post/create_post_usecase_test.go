@@ -3,12 +3,18 @@ package post_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
 	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
 	mock_entity "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity"
 	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_service "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/service"
 	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -16,11 +22,31 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+// acceptContent is a content filter that accepts everything.
+func acceptContent(ctrl *gomock.Controller) *mock_service.MockContentFilter {
+	contentFilter := mock_service.NewMockContentFilter(ctrl)
+	contentFilter.EXPECT().Check(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(service.ContentVerdict{Action: service.ContentAccept}, nil).AnyTimes()
+
+	return contentFilter
+}
+
+// renderContent is a content renderer whose output shows what it was asked to render.
+func renderContent(ctrl *gomock.Controller) *mock_service.MockContentRenderer {
+	contentRenderer := mock_service.NewMockContentRenderer(ctrl)
+	contentRenderer.EXPECT().Render(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ uuid.UUID, revision int, format vo.ContentFormat, source string) string {
+			return fmt.Sprintf("%s@%d:%s", format, revision, source)
+		}).AnyTimes()
+
+	return contentRenderer
+}
+
 func TestCreatePostUseCase_HappyCase(t *testing.T) {
 	userID := uuid.New()
 	postID := uuid.New()
 	createdAt := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
-	content := "Hello, world!"
+	content := "Hello, #world and @Alice!"
 
 	tests := []struct {
 		name  string
@@ -31,6 +57,7 @@ func TestCreatePostUseCase_HappyCase(t *testing.T) {
 			input: post.CreatePostInput{
 				UserID:  userID,
 				Content: content,
+				Format:  "markdown",
 			},
 		},
 	}
@@ -47,11 +74,42 @@ func TestCreatePostUseCase_HappyCase(t *testing.T) {
 			mockPost.EXPECT().ID().Return(postID).AnyTimes()
 			mockPost.EXPECT().UserID().Return(userID).AnyTimes()
 			mockPost.EXPECT().Content().Return(content).AnyTimes()
+			mockPost.EXPECT().Format().Return(vo.ContentFormatMarkdown).AnyTimes()
+			mockPost.EXPECT().Kind().Return(vo.PostKindPost).AnyTimes()
 			mockPost.EXPECT().CreatedAt().Return(createdAt).AnyTimes()
+			mockPost.EXPECT().UpdatedAt().Return(createdAt).AnyTimes()
+			mockPost.EXPECT().Visibility().Return(vo.PostVisibilityPublic).AnyTimes()
+			mockPost.EXPECT().Status().Return(vo.PostStatusPublished).AnyTimes()
+			mockPost.EXPECT().PublishAt().Return(nil).AnyTimes()
 
 			postRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(mockPost, nil).Times(1)
 
-			usecase := post.NewCreatePostUseCase(postRepository, txManager)
+			// The original content is stored as revision 1, written by the author.
+			revisionRepository := mock_repository.NewMockPostRevisionRepository(ctrl)
+			revisionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, r entity.PostRevision) (entity.PostRevision, error) {
+					assert.Equal(t, userID, r.EditorID())
+					assert.Equal(t, content, r.Content())
+
+					return entity.ReconstructPostRevision(
+						r.ID(), r.PostID(), 1, r.Content(), r.Format(), r.EditorID(), r.CreatedAt(),
+					), nil
+				}).Times(1)
+
+			postEntityRepository := mock_repository.NewMockPostEntityRepository(ctrl)
+			postEntityRepository.EXPECT().Replace(gomock.Any(), mockPost, vo.ParseContentEntities(content)).
+				Return(nil).Times(1)
+
+			// The created post, not the unsaved one, is offered to the followers' timelines.
+			timelineRepository := mock_repository.NewMockTimelineRepository(ctrl)
+			timelineRepository.EXPECT().FanOut(gomock.Any(), mockPost, 1000).Return(0, nil).Times(1)
+
+			usecase := post.NewCreatePostUseCase(
+				postRepository, revisionRepository, postEntityRepository, timelineRepository,
+				mock_repository.NewMockAttachmentRepository(ctrl), mock_repository.NewMockPostReportRepository(ctrl),
+				mock_repository.NewMockPollRepository(ctrl), relationshipsBlocking(ctrl),
+				acceptContent(ctrl), renderContent(ctrl), txManager,
+			)
 			output, err := usecase.Execute(ctx, tt.input)
 
 			require.NoError(t, err)
@@ -59,7 +117,432 @@ func TestCreatePostUseCase_HappyCase(t *testing.T) {
 			assert.Equal(t, postID, output.ID)
 			assert.Equal(t, userID, output.UserID)
 			assert.Equal(t, content, output.Content)
+			assert.Equal(t, vo.ContentFormatMarkdown, output.Format)
+			assert.Equal(t, "markdown@1:"+content, output.ContentHTML)
 			assert.Equal(t, createdAt, output.CreatedAt)
+			assert.Equal(t, createdAt, output.UpdatedAt)
+			assert.Equal(t, 1, output.RevisionCount)
+			assert.Empty(t, output.Attachments)
+			assert.NotNil(t, output.Attachments)
+			assert.Equal(t, vo.PostVisibilityPublic, output.Visibility)
+			assert.Equal(t, vo.PostStatusPublished, output.Status)
+			assert.Nil(t, output.PublishAt)
+		})
+	}
+}
+
+func TestCreatePostUseCase_NotOnTimelines(t *testing.T) {
+	publishAt := time.Now().Add(time.Hour).UTC()
+
+	tests := []struct {
+		name       string
+		input      post.CreatePostInput
+		wantStatus vo.PostStatus
+	}{
+		{
+			name:       "draft",
+			input:      post.CreatePostInput{UserID: uuid.New(), Content: "later", Draft: true},
+			wantStatus: vo.PostStatusDraft,
+		},
+		{
+			name:       "scheduled draft",
+			input:      post.CreatePostInput{UserID: uuid.New(), Content: "later", PublishAt: &publishAt},
+			wantStatus: vo.PostStatusDraft,
+		},
+		{
+			name:       "private post",
+			input:      post.CreatePostInput{UserID: uuid.New(), Content: "diary", Visibility: "private"},
+			wantStatus: vo.PostStatusPublished,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, p entity.Post) (entity.Post, error) { return p, nil }).Times(1)
+
+			revisionRepository := mock_repository.NewMockPostRevisionRepository(ctrl)
+			revisionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, r entity.PostRevision) (entity.PostRevision, error) {
+					return entity.ReconstructPostRevision(
+						r.ID(), r.PostID(), 1, r.Content(), r.Format(), r.EditorID(), r.CreatedAt(),
+					), nil
+				}).Times(1)
+
+			postEntityRepository := mock_repository.NewMockPostEntityRepository(ctrl)
+			postEntityRepository.EXPECT().Replace(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+			// No FanOut call is expected: the post must not reach any timeline.
+			usecase := post.NewCreatePostUseCase(
+				postRepository, revisionRepository, postEntityRepository,
+				mock_repository.NewMockTimelineRepository(ctrl), mock_repository.NewMockAttachmentRepository(ctrl),
+				mock_repository.NewMockPostReportRepository(ctrl),
+				mock_repository.NewMockPollRepository(ctrl), relationshipsBlocking(ctrl), acceptContent(ctrl), renderContent(ctrl),
+				mock_shared.NewMockTransactionManager(nil),
+			)
+			output, err := usecase.Execute(context.Background(), tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, output.Status)
+			assert.Equal(t, tt.input.PublishAt, output.PublishAt)
+		})
+	}
+}
+
+func newSharedPost(authorID uuid.UUID, kind vo.PostKind, visibility vo.PostVisibility) entity.Post {
+	content := "original"
+	if kind == vo.PostKindRepost {
+		content = ""
+	}
+
+	return entity.ReconstructPost(
+		uuid.New(), authorID, content, vo.ContentFormatPlain, kind, nil, visibility, vo.PostStatusPublished, nil,
+		nil, nil, nil, time.Now(), time.Now(),
+	)
+}
+
+func TestCreatePostUseCase_Quote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userID := uuid.New()
+	original := newSharedPost(uuid.New(), vo.PostKindPost, vo.PostVisibilityPublic)
+
+	postRepository := mock_repository.NewMockPostRepository(ctrl)
+	postRepository.EXPECT().FindByID(gomock.Any(), original.ID()).Return(original, nil).Times(1)
+	postRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, p entity.Post) (entity.Post, error) {
+			assert.Equal(t, vo.PostKindQuote, p.Kind())
+			assert.Equal(t, original.ID(), *p.OriginalID())
+
+			return p, nil
+		}).Times(1)
+
+	revisionRepository := mock_repository.NewMockPostRevisionRepository(ctrl)
+	revisionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r entity.PostRevision) (entity.PostRevision, error) { return r, nil }).Times(1)
+
+	postEntityRepository := mock_repository.NewMockPostEntityRepository(ctrl)
+	postEntityRepository.EXPECT().Replace(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	// Quotes reach the timelines like any other post.
+	timelineRepository := mock_repository.NewMockTimelineRepository(ctrl)
+	timelineRepository.EXPECT().FanOut(gomock.Any(), gomock.Any(), 1000).Return(0, nil).Times(1)
+
+	usecase := post.NewCreatePostUseCase(
+		postRepository, revisionRepository, postEntityRepository, timelineRepository,
+		mock_repository.NewMockAttachmentRepository(ctrl), mock_repository.NewMockPostReportRepository(ctrl),
+		mock_repository.NewMockPollRepository(ctrl), relationshipsBlocking(ctrl),
+		acceptContent(ctrl), renderContent(ctrl), mock_shared.NewMockTransactionManager(nil),
+	)
+	originalID := original.ID()
+	output, err := usecase.Execute(context.Background(), post.CreatePostInput{
+		UserID: userID, Content: "so true", QuotedPostID: &originalID,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, vo.PostKindQuote, output.Kind)
+	assert.Equal(t, "so true", output.Content)
+}
+
+func TestCreatePostUseCase_Quote_FailureCase(t *testing.T) {
+	userID := uuid.New()
+	privatePost := newSharedPost(uuid.New(), vo.PostKindPost, vo.PostVisibilityPrivate)
+	blockedAuthorPost := newSharedPost(uuid.New(), vo.PostKindPost, vo.PostVisibilityPublic)
+
+	tests := []struct {
+		name     string
+		original entity.Post
+		findErr  error
+		wantCode vo.ErrorCode
+	}{
+		{
+			name:     "quoted post not found",
+			original: privatePost,
+			findErr:  repository.ErrPostNotFound,
+			wantCode: vo.NotFoundErrorCode,
+		},
+		{name: "private post of another user", original: privatePost, wantCode: vo.NotFoundErrorCode},
+		{
+			name:     "followers-only post",
+			original: newSharedPost(uuid.New(), vo.PostKindPost, vo.PostVisibilityFollowers),
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:     "own private post",
+			original: newSharedPost(userID, vo.PostKindPost, vo.PostVisibilityPrivate),
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:     "repost",
+			original: newSharedPost(uuid.New(), vo.PostKindRepost, vo.PostVisibilityPublic),
+			wantCode: vo.ValidationErrorCode,
+		},
+		{name: "post of a blocked user", original: blockedAuthorPost, wantCode: vo.NotFoundErrorCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			// Nothing is stored: no Create call is expected.
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), tt.original.ID()).Return(tt.original, tt.findErr).Times(1)
+
+			usecase := post.NewCreatePostUseCase(
+				postRepository, mock_repository.NewMockPostRevisionRepository(ctrl),
+				mock_repository.NewMockPostEntityRepository(ctrl), mock_repository.NewMockTimelineRepository(ctrl),
+				mock_repository.NewMockAttachmentRepository(ctrl), mock_repository.NewMockPostReportRepository(ctrl),
+				mock_repository.NewMockPollRepository(ctrl), relationshipsBlocking(ctrl, blockedAuthorPost.UserID()),
+				acceptContent(ctrl), renderContent(ctrl), mock_shared.NewMockTransactionManager(nil),
+			)
+			originalID := tt.original.ID()
+			output, err := usecase.Execute(context.Background(), post.CreatePostInput{
+				UserID: userID, Content: "quote", QuotedPostID: &originalID,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
+
+func TestCreatePostUseCase_Poll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	closesAt := time.Now().Add(24 * time.Hour)
+
+	postRepository := mock_repository.NewMockPostRepository(ctrl)
+	postRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, p entity.Post) (entity.Post, error) { return p, nil }).Times(1)
+
+	revisionRepository := mock_repository.NewMockPostRevisionRepository(ctrl)
+	revisionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r entity.PostRevision) (entity.PostRevision, error) { return r, nil }).Times(1)
+
+	postEntityRepository := mock_repository.NewMockPostEntityRepository(ctrl)
+	postEntityRepository.EXPECT().Replace(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	timelineRepository := mock_repository.NewMockTimelineRepository(ctrl)
+	timelineRepository.EXPECT().FanOut(gomock.Any(), gomock.Any(), 1000).Return(0, nil).Times(1)
+
+	var saved entity.Poll
+
+	pollRepository := mock_repository.NewMockPollRepository(ctrl)
+	pollRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, poll entity.Poll) error {
+			saved = poll
+
+			return nil
+		}).Times(1)
+
+	usecase := post.NewCreatePostUseCase(
+		postRepository, revisionRepository, postEntityRepository, timelineRepository,
+		mock_repository.NewMockAttachmentRepository(ctrl), mock_repository.NewMockPostReportRepository(ctrl),
+		pollRepository, relationshipsBlocking(ctrl), acceptContent(ctrl), renderContent(ctrl),
+		mock_shared.NewMockTransactionManager(nil),
+	)
+	output, err := usecase.Execute(context.Background(), post.CreatePostInput{
+		UserID:  uuid.New(),
+		Content: "Which day works?",
+		Poll:    &post.PollInput{Options: []string{"Tue", "Wed"}, Multiple: true, ClosesAt: closesAt},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	assert.Equal(t, output.ID, saved.PostID())
+	require.NotNil(t, output.Poll)
+	assert.True(t, output.Poll.Multiple)
+	assert.Equal(t, closesAt, output.Poll.ClosesAt)
+	require.Len(t, output.Poll.Options, 2)
+	assert.Equal(t, saved.Options()[0].ID(), output.Poll.Options[0].ID)
+	assert.Equal(t, "Tue", output.Poll.Options[0].Label)
+	assert.Equal(t, "Wed", output.Poll.Options[1].Label)
+}
+
+func TestCreatePostUseCase_InvalidPoll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// The poll is checked before anything is stored: no repository call is expected.
+	usecase := post.NewCreatePostUseCase(
+		mock_repository.NewMockPostRepository(ctrl), mock_repository.NewMockPostRevisionRepository(ctrl),
+		mock_repository.NewMockPostEntityRepository(ctrl), mock_repository.NewMockTimelineRepository(ctrl),
+		mock_repository.NewMockAttachmentRepository(ctrl), mock_repository.NewMockPostReportRepository(ctrl),
+		mock_repository.NewMockPollRepository(ctrl), relationshipsBlocking(ctrl),
+		acceptContent(ctrl), renderContent(ctrl), mock_shared.NewMockTransactionManager(nil),
+	)
+	output, err := usecase.Execute(context.Background(), post.CreatePostInput{
+		UserID:  uuid.New(),
+		Content: "Which day works?",
+		Poll:    &post.PollInput{Options: []string{"Tue"}, ClosesAt: time.Now().Add(time.Hour)},
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, output)
+
+	var voErr vo.Error
+	require.ErrorAs(t, err, &voErr)
+	assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+}
+
+func newTestAttachment(uploaderID uuid.UUID, postID *uuid.UUID) entity.Attachment {
+	return entity.ReconstructAttachment(
+		uuid.New(), uploaderID, postID, 0, "photo.png", vo.AttachmentContentTypePNG, 1024, 640, 480,
+		"attachments/original", "attachments/thumbnail", time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC),
+	)
+}
+
+func TestCreatePostUseCase_Attachments(t *testing.T) {
+	userID := uuid.New()
+	postID := uuid.New()
+	first := newTestAttachment(userID, nil)
+	second := newTestAttachment(userID, nil)
+
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+
+	mockPost := mock_entity.NewMockPost(ctrl)
+	mockPost.EXPECT().ID().Return(postID).AnyTimes()
+	mockPost.EXPECT().UserID().Return(userID).AnyTimes()
+	mockPost.EXPECT().Content().Return("with photos").AnyTimes()
+	mockPost.EXPECT().Format().Return(vo.ContentFormatPlain).AnyTimes()
+	mockPost.EXPECT().Kind().Return(vo.PostKindPost).AnyTimes()
+	mockPost.EXPECT().CreatedAt().Return(time.Now()).AnyTimes()
+	mockPost.EXPECT().UpdatedAt().Return(time.Now()).AnyTimes()
+	mockPost.EXPECT().Visibility().Return(vo.PostVisibilityPublic).AnyTimes()
+	mockPost.EXPECT().Status().Return(vo.PostStatusPublished).AnyTimes()
+	mockPost.EXPECT().PublishAt().Return(nil).AnyTimes()
+
+	postRepository := mock_repository.NewMockPostRepository(ctrl)
+	postRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(mockPost, nil)
+
+	revisionRepository := mock_repository.NewMockPostRevisionRepository(ctrl)
+	revisionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r entity.PostRevision) (entity.PostRevision, error) {
+			return entity.ReconstructPostRevision(
+				r.ID(), r.PostID(), 1, r.Content(), r.Format(), r.EditorID(), r.CreatedAt(),
+			), nil
+		})
+
+	postEntityRepository := mock_repository.NewMockPostEntityRepository(ctrl)
+	postEntityRepository.EXPECT().Replace(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	timelineRepository := mock_repository.NewMockTimelineRepository(ctrl)
+	timelineRepository.EXPECT().FanOut(gomock.Any(), gomock.Any(), gomock.Any()).Return(0, nil)
+
+	// The repository returns the attachments in no particular order; the post keeps the requested one.
+	attachmentRepository := mock_repository.NewMockAttachmentRepository(ctrl)
+	attachmentRepository.EXPECT().FindByIDs(gomock.Any(), []uuid.UUID{second.ID(), first.ID()}).
+		Return([]entity.Attachment{first, second}, nil)
+	attachmentRepository.EXPECT().Attach(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	usecase := post.NewCreatePostUseCase(
+		postRepository, revisionRepository, postEntityRepository, timelineRepository, attachmentRepository,
+		mock_repository.NewMockPostReportRepository(ctrl),
+		mock_repository.NewMockPollRepository(ctrl), relationshipsBlocking(ctrl), acceptContent(ctrl), renderContent(ctrl),
+		mock_shared.NewMockTransactionManager(nil),
+	)
+	output, err := usecase.Execute(ctx, post.CreatePostInput{
+		UserID: userID, Content: "with photos", AttachmentIDs: []uuid.UUID{second.ID(), first.ID()},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Attachments, 2)
+	assert.Equal(t, second.ID(), output.Attachments[0].ID)
+	assert.Equal(t, first.ID(), output.Attachments[1].ID)
+	assert.Equal(t, "image/png", output.Attachments[0].ContentType)
+	assert.True(t, output.Attachments[0].HasThumbnail)
+
+	require.NotNil(t, second.PostID())
+	assert.Equal(t, postID, *second.PostID())
+	assert.Equal(t, 0, second.Position())
+	assert.Equal(t, 1, first.Position())
+}
+
+func TestCreatePostUseCase_AttachmentFailureCase(t *testing.T) {
+	userID := uuid.New()
+	otherPostID := uuid.New()
+	own := newTestAttachment(userID, nil)
+	foreign := newTestAttachment(uuid.New(), nil)
+	attached := newTestAttachment(userID, &otherPostID)
+
+	tests := []struct {
+		name      string
+		ids       []uuid.UUID
+		found     []entity.Attachment
+		attachErr error
+	}{
+		{
+			name: "too many attachments",
+			ids:  []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()},
+		},
+		{
+			name: "repeated attachment",
+			ids:  []uuid.UUID{own.ID(), own.ID()},
+		},
+		{
+			name: "unknown attachment",
+			ids:  []uuid.UUID{uuid.New()},
+		},
+		{
+			name:  "attachment of another user",
+			ids:   []uuid.UUID{foreign.ID()},
+			found: []entity.Attachment{foreign},
+		},
+		{
+			name:  "attachment already attached",
+			ids:   []uuid.UUID{attached.ID()},
+			found: []entity.Attachment{attached},
+		},
+		{
+			name:      "attachment attached concurrently",
+			ids:       []uuid.UUID{own.ID()},
+			found:     []entity.Attachment{own},
+			attachErr: repository.ErrAttachmentAlreadyAttached,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockPost := mock_entity.NewMockPost(ctrl)
+			mockPost.EXPECT().ID().Return(uuid.New()).AnyTimes()
+			mockPost.EXPECT().UserID().Return(userID).AnyTimes()
+			mockPost.EXPECT().Content().Return("content").AnyTimes()
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(mockPost, nil).AnyTimes()
+
+			revisionRepository := mock_repository.NewMockPostRevisionRepository(ctrl)
+			revisionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+			postEntityRepository := mock_repository.NewMockPostEntityRepository(ctrl)
+			postEntityRepository.EXPECT().Replace(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			attachmentRepository := mock_repository.NewMockAttachmentRepository(ctrl)
+			attachmentRepository.EXPECT().FindByIDs(gomock.Any(), gomock.Any()).Return(tt.found, nil).AnyTimes()
+			attachmentRepository.EXPECT().Attach(gomock.Any(), gomock.Any()).Return(tt.attachErr).AnyTimes()
+
+			usecase := post.NewCreatePostUseCase(
+				postRepository, revisionRepository, postEntityRepository,
+				mock_repository.NewMockTimelineRepository(ctrl), attachmentRepository,
+				mock_repository.NewMockPostReportRepository(ctrl),
+				mock_repository.NewMockPollRepository(ctrl), relationshipsBlocking(ctrl), acceptContent(ctrl), renderContent(ctrl),
+				mock_shared.NewMockTransactionManager(nil),
+			)
+			output, err := usecase.Execute(context.Background(), post.CreatePostInput{
+				UserID: userID, Content: "content", AttachmentIDs: tt.ids,
+			})
+
+			var domainErr vo.Error
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, vo.ValidationErrorCode, domainErr.Code())
+			assert.Nil(t, output)
 		})
 	}
 }
@@ -68,10 +551,13 @@ func TestCreatePostUseCase_FailureCase(t *testing.T) {
 	userID := uuid.New()
 
 	tests := []struct {
-		name    string
-		input   post.CreatePostInput
-		repoErr error
-		txErr   error
+		name        string
+		input       post.CreatePostInput
+		repoErr     error
+		revisionErr error
+		entityErr   error
+		fanOutErr   error
+		txErr       error
 	}{
 		{
 			name: "empty content returns error",
@@ -80,6 +566,14 @@ func TestCreatePostUseCase_FailureCase(t *testing.T) {
 				Content: "",
 			},
 		},
+		{
+			name: "unknown format returns error",
+			input: post.CreatePostInput{
+				UserID:  userID,
+				Content: "valid content",
+				Format:  "html",
+			},
+		},
 		{
 			name: "repository error propagates",
 			input: post.CreatePostInput{
@@ -88,6 +582,30 @@ func TestCreatePostUseCase_FailureCase(t *testing.T) {
 			},
 			repoErr: errors.New("db error"),
 		},
+		{
+			name: "revision repository error propagates",
+			input: post.CreatePostInput{
+				UserID:  userID,
+				Content: "valid content",
+			},
+			revisionErr: errors.New("db error"),
+		},
+		{
+			name: "post entity repository error propagates",
+			input: post.CreatePostInput{
+				UserID:  userID,
+				Content: "valid #content",
+			},
+			entityErr: errors.New("db error"),
+		},
+		{
+			name: "timeline repository error propagates",
+			input: post.CreatePostInput{
+				UserID:  userID,
+				Content: "valid content",
+			},
+			fanOutErr: errors.New("db error"),
+		},
 		{
 			name: "transaction error propagates",
 			input: post.CreatePostInput{
@@ -107,9 +625,26 @@ func TestCreatePostUseCase_FailureCase(t *testing.T) {
 			postRepository := mock_repository.NewMockPostRepository(ctrl)
 			mockPost := mock_entity.NewMockPost(ctrl)
 
+			mockPost.EXPECT().Content().Return(tt.input.Content).AnyTimes()
+			mockPost.EXPECT().Visibility().Return(vo.PostVisibilityPublic).AnyTimes()
+			mockPost.EXPECT().Status().Return(vo.PostStatusPublished).AnyTimes()
 			postRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(mockPost, tt.repoErr).AnyTimes()
 
-			usecase := post.NewCreatePostUseCase(postRepository, txManager)
+			revisionRepository := mock_repository.NewMockPostRevisionRepository(ctrl)
+			revisionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, tt.revisionErr).AnyTimes()
+
+			postEntityRepository := mock_repository.NewMockPostEntityRepository(ctrl)
+			postEntityRepository.EXPECT().Replace(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.entityErr).AnyTimes()
+
+			timelineRepository := mock_repository.NewMockTimelineRepository(ctrl)
+			timelineRepository.EXPECT().FanOut(gomock.Any(), gomock.Any(), gomock.Any()).Return(0, tt.fanOutErr).AnyTimes()
+
+			usecase := post.NewCreatePostUseCase(
+				postRepository, revisionRepository, postEntityRepository, timelineRepository,
+				mock_repository.NewMockAttachmentRepository(ctrl), mock_repository.NewMockPostReportRepository(ctrl),
+				mock_repository.NewMockPollRepository(ctrl), relationshipsBlocking(ctrl),
+				acceptContent(ctrl), renderContent(ctrl), txManager,
+			)
 			output, err := usecase.Execute(ctx, tt.input)
 
 			require.Error(t, err)
@@ -117,3 +652,134 @@ func TestCreatePostUseCase_FailureCase(t *testing.T) {
 		})
 	}
 }
+
+func TestCreatePostUseCase_ContentFilter(t *testing.T) {
+	userID := uuid.New()
+	filterErr := errors.New("filter error")
+
+	tests := []struct {
+		name     string
+		verdict  service.ContentVerdict
+		checkErr error
+		wantCode vo.ErrorCode
+		wantErr  error
+	}{
+		{
+			name: "rejected content is a validation problem",
+			verdict: service.ContentVerdict{
+				Action: service.ContentReject, Filter: "banned_words", Reason: "content contains a banned word",
+				Match: "spam",
+			},
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:     "filter failure",
+			checkErr: filterErr,
+			wantErr:  filterErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			contentFilter := mock_service.NewMockContentFilter(ctrl)
+			contentFilter.EXPECT().Check(gomock.Any(), userID, "buy spam now").
+				Return(tt.verdict, tt.checkErr).Times(1)
+
+			// Nothing is written for content that is not accepted.
+			usecase := post.NewCreatePostUseCase(
+				mock_repository.NewMockPostRepository(ctrl), mock_repository.NewMockPostRevisionRepository(ctrl),
+				mock_repository.NewMockPostEntityRepository(ctrl), mock_repository.NewMockTimelineRepository(ctrl),
+				mock_repository.NewMockAttachmentRepository(ctrl), mock_repository.NewMockPostReportRepository(ctrl),
+				mock_repository.NewMockPollRepository(ctrl), relationshipsBlocking(ctrl),
+				contentFilter, renderContent(ctrl), mock_shared.NewMockTransactionManager(nil),
+			)
+			output, err := usecase.Execute(context.Background(), post.CreatePostInput{
+				UserID: userID, Content: "buy spam now",
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var domainErr vo.Error
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tt.wantCode, domainErr.Code())
+			assert.Equal(t, tt.verdict.Reason, domainErr.Message())
+			assert.Equal(t, map[string]any{"filter": "banned_words"}, domainErr.Details())
+		})
+	}
+}
+
+func TestCreatePostUseCase_FlaggedContent(t *testing.T) {
+	userID := uuid.New()
+	postID := uuid.New()
+	createdAt := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+	content := "see http://spam.example"
+
+	ctrl := gomock.NewController(t)
+
+	contentFilter := mock_service.NewMockContentFilter(ctrl)
+	contentFilter.EXPECT().Check(gomock.Any(), userID, content).Return(service.ContentVerdict{
+		Action: service.ContentFlag, Filter: "blocked_domains", Reason: "content links to a blocked domain",
+		Match: "spam.example", ReportReason: vo.ReportReasonSpam,
+	}, nil).Times(1)
+
+	mockPost := mock_entity.NewMockPost(ctrl)
+	mockPost.EXPECT().ID().Return(postID).AnyTimes()
+	mockPost.EXPECT().UserID().Return(userID).AnyTimes()
+	mockPost.EXPECT().Content().Return(content).AnyTimes()
+	mockPost.EXPECT().Format().Return(vo.ContentFormatPlain).AnyTimes()
+	mockPost.EXPECT().Kind().Return(vo.PostKindPost).AnyTimes()
+	mockPost.EXPECT().CreatedAt().Return(createdAt).AnyTimes()
+	mockPost.EXPECT().UpdatedAt().Return(createdAt).AnyTimes()
+	mockPost.EXPECT().Visibility().Return(vo.PostVisibilityPublic).AnyTimes()
+	mockPost.EXPECT().Status().Return(vo.PostStatusPublished).AnyTimes()
+	mockPost.EXPECT().PublishAt().Return(nil).AnyTimes()
+
+	postRepository := mock_repository.NewMockPostRepository(ctrl)
+	postRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(mockPost, nil).Times(1)
+
+	revisionRepository := mock_repository.NewMockPostRevisionRepository(ctrl)
+	revisionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r entity.PostRevision) (entity.PostRevision, error) {
+			return entity.ReconstructPostRevision(
+				r.ID(), r.PostID(), 1, r.Content(), r.Format(), r.EditorID(), r.CreatedAt(),
+			), nil
+		}).Times(1)
+
+	postEntityRepository := mock_repository.NewMockPostEntityRepository(ctrl)
+	postEntityRepository.EXPECT().Replace(gomock.Any(), mockPost, gomock.Any()).Return(nil).Times(1)
+
+	timelineRepository := mock_repository.NewMockTimelineRepository(ctrl)
+	timelineRepository.EXPECT().FanOut(gomock.Any(), mockPost, 1000).Return(0, nil).Times(1)
+
+	// A flagged post is published and reported to the moderators without a reporter.
+	postReportRepository := mock_repository.NewMockPostReportRepository(ctrl)
+	postReportRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r entity.PostReport) error {
+			assert.Equal(t, postID, r.PostID())
+			assert.Nil(t, r.ReporterID())
+			assert.Equal(t, vo.ReportReasonSpam, r.Reason())
+			assert.Equal(t, "blocked_domains: content links to a blocked domain (spam.example)", r.Details())
+
+			return nil
+		}).Times(1)
+
+	usecase := post.NewCreatePostUseCase(
+		postRepository, revisionRepository, postEntityRepository, timelineRepository,
+		mock_repository.NewMockAttachmentRepository(ctrl), postReportRepository,
+		mock_repository.NewMockPollRepository(ctrl), relationshipsBlocking(ctrl), contentFilter, renderContent(ctrl),
+		mock_shared.NewMockTransactionManager(nil),
+	)
+	output, err := usecase.Execute(context.Background(), post.CreatePostInput{UserID: userID, Content: content})
+
+	require.NoError(t, err)
+	assert.Equal(t, postID, output.ID)
+}
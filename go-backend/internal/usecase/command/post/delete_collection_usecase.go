@@ -0,0 +1,76 @@
+package post
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DeleteCollectionUseCase deletes one of the actor's collections. The posts in it are not affected.
+type DeleteCollectionUseCase interface {
+	Execute(ctx context.Context, input DeleteCollectionInput) error
+}
+
+type DeleteCollectionInput struct {
+	ActorID      uuid.UUID
+	CollectionID uuid.UUID
+}
+
+type deleteCollectionUseCaseImpl struct {
+	tracer               trace.Tracer
+	logger               common.Logger
+	collectionRepository repository.CollectionRepository
+	txManager            shared.TransactionManager
+}
+
+func (uc *deleteCollectionUseCaseImpl) Execute(ctx context.Context, input DeleteCollectionInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		collection, txErr := findOwnCollection(ctx, uc.collectionRepository, input.CollectionID, input.ActorID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = uc.collectionRepository.Delete(ctx, collection.ID()); txErr != nil {
+			if errors.Is(txErr, repository.ErrCollectionNotFound) {
+				return vo.NewNotFoundError("collection not found", nil, txErr)
+			}
+
+			uc.logger.Error(ctx, "failed to delete Collection", "error", txErr)
+		}
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "collection deleted", "collectionID", input.CollectionID, "actorID", input.ActorID)
+
+	return nil
+}
+
+func NewDeleteCollectionUseCase(
+	collectionRepository repository.CollectionRepository,
+	txManager shared.TransactionManager,
+) DeleteCollectionUseCase {
+	return &deleteCollectionUseCaseImpl{
+		tracer:               otel.Tracer("DeleteCollectionUseCase"),
+		logger:               common.NewLogger(),
+		collectionRepository: collectionRepository,
+		txManager:            txManager,
+	}
+}
@@ -0,0 +1,86 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteCollectionUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	actorID := uuid.New()
+	existing := collectionOf(actorID)
+
+	collectionRepository := mock_repository.NewMockCollectionRepository(ctrl)
+	collectionRepository.EXPECT().FindByID(gomock.Any(), existing.ID()).Return(existing, nil).Times(1)
+	collectionRepository.EXPECT().Delete(gomock.Any(), existing.ID()).Return(nil).Times(1)
+
+	uc := post.NewDeleteCollectionUseCase(collectionRepository, mock_shared.NewMockTransactionManager(nil))
+	err := uc.Execute(context.Background(), post.DeleteCollectionInput{ActorID: actorID, CollectionID: existing.ID()})
+
+	require.NoError(t, err)
+}
+
+func TestDeleteCollectionUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+	actorID := uuid.New()
+
+	tests := []struct {
+		name      string
+		owner     uuid.UUID
+		findErr   error
+		deleteErr error
+		txErr     error
+		wantErr   error
+		wantCode  vo.ErrorCode
+	}{
+		{name: "collection does not exist", findErr: repository.ErrCollectionNotFound, wantCode: vo.NotFoundErrorCode},
+		{name: "collection of another user", owner: uuid.New(), wantCode: vo.NotFoundErrorCode},
+		{name: "deleted meanwhile", deleteErr: repository.ErrCollectionNotFound, wantCode: vo.NotFoundErrorCode},
+		{name: "delete fails", deleteErr: errDB, wantErr: errDB},
+		{name: "transaction fails", txErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			owner := actorID
+			if tt.owner != uuid.Nil {
+				owner = tt.owner
+			}
+
+			collectionRepository := mock_repository.NewMockCollectionRepository(ctrl)
+			collectionRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).
+				Return(collectionOf(owner), tt.findErr).AnyTimes()
+			collectionRepository.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(tt.deleteErr).AnyTimes()
+
+			uc := post.NewDeleteCollectionUseCase(collectionRepository, mock_shared.NewMockTransactionManager(tt.txErr))
+			err := uc.Execute(context.Background(), post.DeleteCollectionInput{
+				ActorID: actorID, CollectionID: uuid.New(),
+			})
+
+			require.Error(t, err)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
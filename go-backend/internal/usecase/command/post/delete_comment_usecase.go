@@ -0,0 +1,88 @@
+package post
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DeleteCommentUseCase removes a comment and every reply below it; only its author or a moderator may
+// do so.
+type DeleteCommentUseCase interface {
+	Execute(ctx context.Context, input DeleteCommentInput) error
+}
+
+type DeleteCommentInput struct {
+	ActorID   uuid.UUID
+	PostID    uuid.UUID
+	CommentID uuid.UUID
+}
+
+type deleteCommentUseCaseImpl struct {
+	tracer               trace.Tracer
+	logger               common.Logger
+	commentRepository    repository.CommentRepository
+	permissionRepository aggregaterepository.UserPermissionRepository
+	txManager            shared.TransactionManager
+}
+
+func (uc *deleteCommentUseCaseImpl) Execute(ctx context.Context, input DeleteCommentInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		comment, txErr := findComment(ctx, uc.commentRepository, input.PostID, input.CommentID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = authorizeCommentModification(ctx, uc.permissionRepository, input.ActorID, comment); txErr != nil {
+			return txErr
+		}
+
+		if txErr = uc.commentRepository.Delete(ctx, comment.ID()); txErr != nil {
+			if errors.Is(txErr, repository.ErrCommentNotFound) {
+				return vo.NewNotFoundError("comment not found", nil, txErr)
+			}
+
+			uc.logger.Error(ctx, "failed to delete Comment", "error", txErr)
+
+			return txErr
+		}
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "comment deleted", "commentID", input.CommentID, "actorID", input.ActorID)
+
+	return nil
+}
+
+func NewDeleteCommentUseCase(
+	commentRepository repository.CommentRepository,
+	permissionRepository aggregaterepository.UserPermissionRepository,
+	txManager shared.TransactionManager,
+) DeleteCommentUseCase {
+	return &deleteCommentUseCaseImpl{
+		tracer:               otel.Tracer("DeleteCommentUseCase"),
+		logger:               common.NewLogger(),
+		commentRepository:    commentRepository,
+		permissionRepository: permissionRepository,
+		txManager:            txManager,
+	}
+}
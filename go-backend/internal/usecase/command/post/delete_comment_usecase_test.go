@@ -0,0 +1,136 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_aggregate_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/aggregate/repository"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteCommentUseCase_HappyCase(t *testing.T) {
+	authorID, moderatorID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name    string
+		actorID uuid.UUID
+	}{
+		{name: "author deletes own comment", actorID: authorID},
+		{name: "moderator deletes another user's comment", actorID: moderatorID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			existing := entity.ReconstructComment(uuid.New(), uuid.New(), nil, authorID, "comment", 0, time.Now())
+
+			commentRepository := mock_repository.NewMockCommentRepository(ctrl)
+			commentRepository.EXPECT().FindByID(gomock.Any(), existing.ID()).Return(existing, nil).Times(1)
+			commentRepository.EXPECT().Delete(gomock.Any(), existing.ID()).Return(nil).Times(1)
+
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			if tt.actorID != authorID {
+				permRepo.EXPECT().FindByUserID(gomock.Any(), tt.actorID).
+					Return(newPermissions(tt.actorID, vo.PermissionPostsModerate), nil).Times(1)
+			}
+
+			uc := post.NewDeleteCommentUseCase(commentRepository, permRepo, mock_shared.NewMockTransactionManager(nil))
+			err := uc.Execute(context.Background(), post.DeleteCommentInput{
+				ActorID:   tt.actorID,
+				PostID:    existing.PostID(),
+				CommentID: existing.ID(),
+			})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeleteCommentUseCase_FailureCase(t *testing.T) {
+	authorID, otherID := uuid.New(), uuid.New()
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name      string
+		actorID   uuid.UUID
+		otherPost bool
+		findErr   error
+		deleteErr error
+		txErr     error
+		wantErr   error
+		wantCode  vo.ErrorCode
+	}{
+		{
+			name:     "comment does not exist",
+			actorID:  authorID,
+			findErr:  repository.ErrCommentNotFound,
+			wantCode: vo.NotFoundErrorCode,
+		},
+		{name: "comment is on another post", actorID: authorID, otherPost: true, wantCode: vo.NotFoundErrorCode},
+		{name: "neither author nor moderator", actorID: otherID, wantCode: vo.ForbiddenErrorCode},
+		{
+			name:      "comment deleted concurrently",
+			actorID:   authorID,
+			deleteErr: repository.ErrCommentNotFound,
+			wantCode:  vo.NotFoundErrorCode,
+		},
+		{name: "find fails", actorID: authorID, findErr: errDB, wantErr: errDB},
+		{name: "delete fails", actorID: authorID, deleteErr: errDB, wantErr: errDB},
+		{name: "transaction fails", actorID: authorID, txErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			postID := uuid.New()
+			existing := entity.ReconstructComment(uuid.New(), postID, nil, authorID, "comment", 0, time.Now())
+
+			commentRepository := mock_repository.NewMockCommentRepository(ctrl)
+			if tt.findErr != nil {
+				commentRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(nil, tt.findErr).AnyTimes()
+			} else {
+				commentRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(existing, nil).AnyTimes()
+			}
+
+			commentRepository.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(tt.deleteErr).AnyTimes()
+
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			permRepo.EXPECT().FindByUserID(gomock.Any(), gomock.Any()).
+				Return(newPermissions(tt.actorID, vo.PermissionUsersList), nil).AnyTimes()
+
+			if tt.otherPost {
+				postID = uuid.New()
+			}
+
+			uc := post.NewDeleteCommentUseCase(commentRepository, permRepo, mock_shared.NewMockTransactionManager(tt.txErr))
+			err := uc.Execute(context.Background(), post.DeleteCommentInput{
+				ActorID:   tt.actorID,
+				PostID:    postID,
+				CommentID: existing.ID(),
+			})
+
+			require.Error(t, err)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DeletePostUseCase moves a post to the trash, from which it can be restored until entity.PostTrashRetention
+// runs out; only its author or a moderator may do so.
+type DeletePostUseCase interface {
+	Execute(ctx context.Context, input DeletePostInput) error
+}
+
+type DeletePostInput struct {
+	ActorID uuid.UUID
+	PostID  uuid.UUID
+}
+
+type deletePostUseCaseImpl struct {
+	tracer               trace.Tracer
+	logger               common.Logger
+	postRepository       repository.PostRepository
+	permissionRepository aggregaterepository.UserPermissionRepository
+	txManager            shared.TransactionManager
+}
+
+func (uc *deletePostUseCaseImpl) Execute(ctx context.Context, input DeletePostInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		post, txErr := findPost(ctx, uc.postRepository, input.PostID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = authorizeModification(ctx, uc.permissionRepository, input.ActorID, post); txErr != nil {
+			return txErr
+		}
+
+		return trash(ctx, uc.logger, uc.postRepository, post, input.ActorID, time.Now())
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "post moved to the trash", "postID", input.PostID, "actorID", input.ActorID)
+
+	return nil
+}
+
+// trash moves the post to the trash on behalf of actorID, reporting a post that was deleted meanwhile as a
+// NotFound error. Callers run it in a transaction.
+func trash(
+	ctx context.Context,
+	logger common.Logger,
+	postRepository repository.PostRepository,
+	post entity.Post,
+	actorID uuid.UUID,
+	now time.Time,
+) error {
+	if err := post.Trash(actorID, now); err != nil {
+		return err
+	}
+
+	err := postRepository.Trash(ctx, post)
+	if errors.Is(err, repository.ErrPostNotFound) {
+		return vo.NewNotFoundError("post not found", nil, err)
+	}
+
+	if err != nil {
+		logger.Error(ctx, "failed to move Post to the trash", "error", err)
+	}
+
+	return err
+}
+
+func NewDeletePostUseCase(
+	postRepository repository.PostRepository,
+	permissionRepository aggregaterepository.UserPermissionRepository,
+	txManager shared.TransactionManager,
+) DeletePostUseCase {
+	return &deletePostUseCaseImpl{
+		tracer:               otel.Tracer("DeletePostUseCase"),
+		logger:               common.NewLogger(),
+		postRepository:       postRepository,
+		permissionRepository: permissionRepository,
+		txManager:            txManager,
+	}
+}
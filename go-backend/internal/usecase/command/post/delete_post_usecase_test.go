@@ -0,0 +1,153 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_aggregate_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/aggregate/repository"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeletePostUseCase_HappyCase(t *testing.T) {
+	authorID, moderatorID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name    string
+		actorID uuid.UUID
+	}{
+		{name: "author deletes own post", actorID: authorID},
+		{name: "moderator deletes another user's post", actorID: moderatorID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			existing := entity.ReconstructPost(
+				uuid.New(),
+				authorID,
+				"content",
+				vo.ContentFormatPlain,
+				vo.PostKindPost,
+				nil,
+				vo.PostVisibilityPublic,
+				vo.PostStatusPublished,
+				nil,
+				nil,
+				nil,
+				nil,
+				time.Now(),
+				time.Now(),
+			)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), existing.ID()).Return(existing, nil).Times(1)
+			// The post is moved to the trash on behalf of the actor.
+			postRepository.EXPECT().Trash(gomock.Any(), existing).DoAndReturn(
+				func(_ context.Context, p entity.Post) error {
+					require.NotNil(t, p.DeletedAt())
+					assert.Equal(t, &tt.actorID, p.DeletedBy())
+
+					return nil
+				}).Times(1)
+
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			if tt.actorID != authorID {
+				permRepo.EXPECT().FindByUserID(gomock.Any(), tt.actorID).
+					Return(newPermissions(tt.actorID, vo.PermissionPostsModerate), nil).Times(1)
+			}
+
+			uc := post.NewDeletePostUseCase(postRepository, permRepo, mock_shared.NewMockTransactionManager(nil))
+			err := uc.Execute(context.Background(), post.DeletePostInput{ActorID: tt.actorID, PostID: existing.ID()})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeletePostUseCase_FailureCase(t *testing.T) {
+	authorID, otherID := uuid.New(), uuid.New()
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name      string
+		actorID   uuid.UUID
+		findErr   error
+		deleteErr error
+		txErr     error
+		wantErr   error
+		wantCode  vo.ErrorCode
+	}{
+		{name: "post does not exist", actorID: authorID, findErr: repository.ErrPostNotFound, wantCode: vo.NotFoundErrorCode},
+		{name: "neither author nor moderator", actorID: otherID, wantCode: vo.ForbiddenErrorCode},
+		{
+			name:      "post deleted concurrently",
+			actorID:   authorID,
+			deleteErr: repository.ErrPostNotFound,
+			wantCode:  vo.NotFoundErrorCode,
+		},
+		{name: "find fails", actorID: authorID, findErr: errDB, wantErr: errDB},
+		{name: "delete fails", actorID: authorID, deleteErr: errDB, wantErr: errDB},
+		{name: "transaction fails", actorID: authorID, txErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			existing := entity.ReconstructPost(
+				uuid.New(),
+				authorID,
+				"content",
+				vo.ContentFormatPlain,
+				vo.PostKindPost,
+				nil,
+				vo.PostVisibilityPublic,
+				vo.PostStatusPublished,
+				nil,
+				nil,
+				nil,
+				nil,
+				time.Now(),
+				time.Now(),
+			)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			if tt.findErr != nil {
+				postRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(nil, tt.findErr).AnyTimes()
+			} else {
+				postRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(existing, nil).AnyTimes()
+			}
+
+			postRepository.EXPECT().Trash(gomock.Any(), gomock.Any()).Return(tt.deleteErr).AnyTimes()
+
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			permRepo.EXPECT().FindByUserID(gomock.Any(), gomock.Any()).
+				Return(newPermissions(tt.actorID, vo.PermissionUsersList), nil).AnyTimes()
+
+			uc := post.NewDeletePostUseCase(postRepository, permRepo, mock_shared.NewMockTransactionManager(tt.txErr))
+			err := uc.Execute(context.Background(), post.DeletePostInput{ActorID: tt.actorID, PostID: existing.ID()})
+
+			require.Error(t, err)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ModeratePostUseCase applies a moderator's action to a post: hiding or deleting it, warning its author,
+// freezing its author's account, or dismissing its reports. Every action is recorded with the moderator
+// and the reason, and closes the open reports of the post. Only holders of posts:moderate may moderate.
+type ModeratePostUseCase interface {
+	Execute(ctx context.Context, input ModeratePostInput) (*ModeratePostOutput, error)
+}
+
+type ModeratePostInput struct {
+	ModeratorID uuid.UUID
+	PostID      uuid.UUID
+	// Action must be one of the vo.ModerationActionType values.
+	Action string
+	Reason string
+}
+
+type ModeratePostOutput struct {
+	ID           uuid.UUID
+	PostID       uuid.UUID
+	TargetUserID uuid.UUID
+	Action       vo.ModerationActionType
+	Reason       string
+	// ResolvedReports is the number of open reports of the post the action closed.
+	ResolvedReports int
+	CreatedAt       time.Time
+}
+
+type moderatePostUseCaseImpl struct {
+	tracer                     trace.Tracer
+	logger                     common.Logger
+	postRepository             repository.PostRepository
+	postReportRepository       repository.PostReportRepository
+	moderationActionRepository repository.ModerationActionRepository
+	userRepository             repository.UserRepository
+	permissionRepository       aggregaterepository.UserPermissionRepository
+	txManager                  shared.TransactionManager
+}
+
+func (uc *moderatePostUseCaseImpl) Execute(
+	ctx context.Context, input ModeratePostInput,
+) (*ModeratePostOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	var (
+		action   entity.ModerationAction
+		resolved int
+	)
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		if txErr := authorizeModeration(ctx, uc.permissionRepository, input.ModeratorID); txErr != nil {
+			return txErr
+		}
+
+		post, txErr := findPost(ctx, uc.postRepository, input.PostID)
+		if txErr != nil {
+			return txErr
+		}
+
+		action, txErr = entity.NewModerationAction(input.ModeratorID, post, input.Action, input.Reason, time.Now())
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = uc.moderationActionRepository.Create(ctx, action); txErr != nil {
+			uc.logger.Error(ctx, "failed to save ModerationAction", "error", txErr)
+
+			return txErr
+		}
+
+		resolved, txErr = uc.postReportRepository.ResolveByPostID(
+			ctx, post.ID(), input.ModeratorID, action.CreatedAt(),
+		)
+		if txErr != nil {
+			uc.logger.Error(ctx, "failed to resolve PostReports", "error", txErr)
+
+			return txErr
+		}
+
+		return uc.apply(ctx, action, post)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	uc.logger.Info(ctx, "post moderated", "postID", action.PostID(), "moderatorID", input.ModeratorID,
+		"action", action.Type(), "resolvedReports", resolved)
+
+	return &ModeratePostOutput{
+		ID:              action.ID(),
+		PostID:          action.PostID(),
+		TargetUserID:    action.TargetUserID(),
+		Action:          action.Type(),
+		Reason:          action.Reason().String(),
+		ResolvedReports: resolved,
+		CreatedAt:       action.CreatedAt(),
+	}, nil
+}
+
+// apply carries out the effect of the action. Warnings and dismissals have none besides being recorded.
+func (uc *moderatePostUseCaseImpl) apply(ctx context.Context, action entity.ModerationAction, post entity.Post) error {
+	var err error
+
+	switch action.Type() {
+	case vo.ModerationActionHide:
+		if err = post.Hide(action.CreatedAt()); err != nil {
+			return err
+		}
+
+		err = uc.postRepository.Hide(ctx, post)
+	case vo.ModerationActionDelete:
+		return trash(ctx, uc.logger, uc.postRepository, post, action.ModeratorID(), action.CreatedAt())
+	case vo.ModerationActionFreeze:
+		return uc.freezeAuthor(ctx, post.UserID())
+	case vo.ModerationActionWarn, vo.ModerationActionDismiss:
+		return nil
+	}
+
+	if errors.Is(err, repository.ErrPostNotFound) {
+		return vo.NewNotFoundError("post not found", nil, err)
+	}
+
+	if err != nil {
+		uc.logger.Error(ctx, "failed to moderate Post", "error", err)
+	}
+
+	return err
+}
+
+func (uc *moderatePostUseCaseImpl) freezeAuthor(ctx context.Context, authorID uuid.UUID) error {
+	author, err := uc.userRepository.FindByID(ctx, authorID)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return vo.NewNotFoundError("user not found", nil, err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	frozen, err := author.UpdateStatus(vo.UserStatusFrozen)
+	if err != nil {
+		return err
+	}
+
+	err = uc.userRepository.UpdateStatus(ctx, frozen)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return vo.NewNotFoundError("user not found", nil, err)
+	}
+
+	if err != nil {
+		uc.logger.Error(ctx, "failed to freeze User", "error", err)
+	}
+
+	return err
+}
+
+func NewModeratePostUseCase(
+	postRepository repository.PostRepository,
+	postReportRepository repository.PostReportRepository,
+	moderationActionRepository repository.ModerationActionRepository,
+	userRepository repository.UserRepository,
+	permissionRepository aggregaterepository.UserPermissionRepository,
+	txManager shared.TransactionManager,
+) ModeratePostUseCase {
+	return &moderatePostUseCaseImpl{
+		tracer:                     otel.Tracer("ModeratePostUseCase"),
+		logger:                     common.NewLogger(),
+		postRepository:             postRepository,
+		postReportRepository:       postReportRepository,
+		moderationActionRepository: moderationActionRepository,
+		userRepository:             userRepository,
+		permissionRepository:       permissionRepository,
+		txManager:                  txManager,
+	}
+}
@@ -0,0 +1,246 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_aggregate_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/aggregate/repository"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type moderatePostMocks struct {
+	postRepository             *mock_repository.MockPostRepository
+	postReportRepository       *mock_repository.MockPostReportRepository
+	moderationActionRepository *mock_repository.MockModerationActionRepository
+	userRepository             *mock_repository.MockUserRepository
+	permissionRepository       *mock_aggregate_repository.MockUserPermissionRepository
+}
+
+func newModeratePostMocks(ctrl *gomock.Controller) moderatePostMocks {
+	return moderatePostMocks{
+		postRepository:             mock_repository.NewMockPostRepository(ctrl),
+		postReportRepository:       mock_repository.NewMockPostReportRepository(ctrl),
+		moderationActionRepository: mock_repository.NewMockModerationActionRepository(ctrl),
+		userRepository:             mock_repository.NewMockUserRepository(ctrl),
+		permissionRepository:       mock_aggregate_repository.NewMockUserPermissionRepository(ctrl),
+	}
+}
+
+func (m moderatePostMocks) useCase(txErr error) post.ModeratePostUseCase {
+	return post.NewModeratePostUseCase(
+		m.postRepository, m.postReportRepository, m.moderationActionRepository, m.userRepository,
+		m.permissionRepository, mock_shared.NewMockTransactionManager(txErr),
+	)
+}
+
+func TestModeratePostUseCase_HappyCase(t *testing.T) {
+	moderatorID, authorID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name   string
+		action string
+		expect func(m moderatePostMocks, p entity.Post)
+		check  func(t *testing.T, p entity.Post)
+	}{
+		{
+			name:   "hide",
+			action: "hide",
+			expect: func(m moderatePostMocks, p entity.Post) {
+				m.postRepository.EXPECT().Hide(gomock.Any(), p).Return(nil).Times(1)
+			},
+			check: func(t *testing.T, p entity.Post) {
+				t.Helper()
+				assert.NotNil(t, p.HiddenAt())
+			},
+		},
+		{
+			name:   "delete",
+			action: "delete",
+			expect: func(m moderatePostMocks, p entity.Post) {
+				m.postRepository.EXPECT().Trash(gomock.Any(), p).Return(nil).Times(1)
+			},
+			check: func(t *testing.T, p entity.Post) {
+				t.Helper()
+				assert.NotNil(t, p.DeletedAt())
+			},
+		},
+		{
+			name:   "freeze",
+			action: "freeze",
+			expect: func(m moderatePostMocks, _ entity.Post) {
+				author := entity.ReconstructUser(
+					authorID, "author@example.com", nil, "author", vo.UserStatusActive, time.Now(),
+				)
+				m.userRepository.EXPECT().FindByID(gomock.Any(), authorID).Return(author, nil).Times(1)
+				m.userRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, u entity.User) error {
+						assert.Equal(t, authorID, u.ID())
+						assert.Equal(t, vo.UserStatusFrozen, u.Status())
+
+						return nil
+					}).Times(1)
+			},
+		},
+		{name: "warn", action: "warn", expect: func(moderatePostMocks, entity.Post) {}},
+		{name: "dismiss", action: "dismiss", expect: func(moderatePostMocks, entity.Post) {}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			existing := entity.ReconstructPost(
+				uuid.New(), authorID, "abuse", vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityPublic,
+				vo.PostStatusPublished, nil, nil, nil, nil, time.Now(), time.Now(),
+			)
+
+			m := newModeratePostMocks(ctrl)
+			m.permissionRepository.EXPECT().FindByUserID(gomock.Any(), moderatorID).
+				Return(newPermissions(moderatorID, vo.PermissionPostsModerate), nil).Times(1)
+			m.postRepository.EXPECT().FindByID(gomock.Any(), existing.ID()).Return(existing, nil).Times(1)
+			m.moderationActionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, a entity.ModerationAction) error {
+					assert.Equal(t, moderatorID, a.ModeratorID())
+					assert.Equal(t, existing.ID(), a.PostID())
+					assert.Equal(t, authorID, a.TargetUserID())
+					assert.Equal(t, "repeated insults", a.Reason().String())
+
+					return nil
+				}).Times(1)
+			m.postReportRepository.EXPECT().ResolveByPostID(gomock.Any(), existing.ID(), moderatorID, gomock.Any()).
+				Return(3, nil).Times(1)
+			tt.expect(m, existing)
+
+			output, err := m.useCase(nil).Execute(context.Background(), post.ModeratePostInput{
+				ModeratorID: moderatorID, PostID: existing.ID(), Action: tt.action, Reason: "repeated insults",
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, existing.ID(), output.PostID)
+			assert.Equal(t, authorID, output.TargetUserID)
+			assert.Equal(t, vo.ModerationActionType(tt.action), output.Action)
+			assert.Equal(t, "repeated insults", output.Reason)
+			assert.Equal(t, 3, output.ResolvedReports)
+			assert.WithinDuration(t, time.Now(), output.CreatedAt, time.Minute)
+
+			if tt.check != nil {
+				tt.check(t, existing)
+			}
+		})
+	}
+}
+
+func TestModeratePostUseCase_FailureCase(t *testing.T) {
+	moderatorID, authorID := uuid.New(), uuid.New()
+	errDB := errors.New("db error")
+	hiddenAt := time.Now()
+
+	tests := []struct {
+		name     string
+		action   string
+		reason   string
+		perms    []vo.Permission
+		hidden   bool
+		findErr  error
+		expect   func(m moderatePostMocks)
+		wantErr  error
+		wantCode vo.ErrorCode
+	}{
+		{name: "not a moderator", action: "hide", reason: "r", wantCode: vo.ForbiddenErrorCode},
+		{
+			name: "post does not exist", action: "hide", reason: "r", perms: []vo.Permission{vo.PermissionPostsModerate},
+			findErr: repository.ErrPostNotFound, wantCode: vo.NotFoundErrorCode,
+		},
+		{
+			name: "unknown action", action: "ban", reason: "r", perms: []vo.Permission{vo.PermissionPostsModerate},
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name: "missing reason", action: "warn", reason: " ", perms: []vo.Permission{vo.PermissionPostsModerate},
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name: "post is already hidden", action: "hide", reason: "r",
+			perms: []vo.Permission{vo.PermissionPostsModerate}, hidden: true, wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name: "post was deleted meanwhile", action: "delete", reason: "r",
+			perms: []vo.Permission{vo.PermissionPostsModerate},
+			expect: func(m moderatePostMocks) {
+				m.postRepository.EXPECT().Trash(gomock.Any(), gomock.Any()).Return(repository.ErrPostNotFound)
+			},
+			wantCode: vo.NotFoundErrorCode,
+		},
+		{
+			name: "author is already frozen", action: "freeze", reason: "r",
+			perms: []vo.Permission{vo.PermissionPostsModerate},
+			expect: func(m moderatePostMocks) {
+				frozen := entity.ReconstructUser(authorID, "a@example.com", nil, "a", vo.UserStatusFrozen, time.Now())
+				m.userRepository.EXPECT().FindByID(gomock.Any(), authorID).Return(frozen, nil)
+			},
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name: "hiding fails", action: "hide", reason: "r", perms: []vo.Permission{vo.PermissionPostsModerate},
+			expect: func(m moderatePostMocks) {
+				m.postRepository.EXPECT().Hide(gomock.Any(), gomock.Any()).Return(errDB)
+			},
+			wantErr: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var hidden *time.Time
+			if tt.hidden {
+				hidden = &hiddenAt
+			}
+
+			existing := entity.ReconstructPost(
+				uuid.New(), authorID, "abuse", vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityPublic,
+				vo.PostStatusPublished, nil, hidden, nil, nil, time.Now(), time.Now(),
+			)
+
+			m := newModeratePostMocks(ctrl)
+			m.permissionRepository.EXPECT().FindByUserID(gomock.Any(), moderatorID).
+				Return(newPermissions(moderatorID, tt.perms...), nil).Times(1)
+			m.postRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(existing, tt.findErr).AnyTimes()
+			m.moderationActionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			m.postReportRepository.EXPECT().ResolveByPostID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(0, nil).AnyTimes()
+
+			if tt.expect != nil {
+				tt.expect(m)
+			}
+
+			output, err := m.useCase(nil).Execute(context.Background(), post.ModeratePostInput{
+				ModeratorID: moderatorID, PostID: existing.ID(), Action: tt.action, Reason: tt.reason,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var domainErr vo.Error
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tt.wantCode, domainErr.Code())
+		})
+	}
+}
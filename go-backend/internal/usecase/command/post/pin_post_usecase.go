@@ -0,0 +1,93 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PinPostUseCase pins one of the actor's posts to their profile. Pinning a post again succeeds without
+// changing anything.
+type PinPostUseCase interface {
+	Execute(ctx context.Context, input PinPostInput) error
+}
+
+type PinPostInput struct {
+	ActorID uuid.UUID
+	// PostID is the post to pin; see entity.NewPinnedPost for the posts that can be pinned.
+	PostID uuid.UUID
+}
+
+type pinPostUseCaseImpl struct {
+	tracer               trace.Tracer
+	logger               common.Logger
+	postRepository       repository.PostRepository
+	pinnedPostRepository repository.PinnedPostRepository
+	txManager            shared.TransactionManager
+}
+
+func (uc *pinPostUseCaseImpl) Execute(ctx context.Context, input PinPostInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	var pinned bool
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		post, txErr := findSharedPost(ctx, uc.postRepository, input.ActorID, input.PostID)
+		if txErr != nil {
+			return txErr
+		}
+
+		pin, txErr := entity.NewPinnedPost(input.ActorID, post, time.Now())
+		if txErr != nil {
+			return txErr
+		}
+
+		pinned, txErr = uc.pinnedPostRepository.Pin(ctx, pin)
+		if errors.Is(txErr, repository.ErrTooManyPinnedPosts) {
+			return vo.NewValidationError(fmt.Sprintf("at most %d posts can be pinned", entity.MaxPinnedPosts),
+				map[string]any{"max_pinned_posts": entity.MaxPinnedPosts}, txErr)
+		}
+
+		if txErr != nil {
+			uc.logger.Error(ctx, "failed to save pinned post", "error", txErr)
+		}
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "post pinned", "postID", input.PostID, "actorID", input.ActorID, "changed", pinned)
+
+	return nil
+}
+
+func NewPinPostUseCase(
+	postRepository repository.PostRepository,
+	pinnedPostRepository repository.PinnedPostRepository,
+	txManager shared.TransactionManager,
+) PinPostUseCase {
+	return &pinPostUseCaseImpl{
+		tracer:               otel.Tracer("PinPostUseCase"),
+		logger:               common.NewLogger(),
+		postRepository:       postRepository,
+		pinnedPostRepository: pinnedPostRepository,
+		txManager:            txManager,
+	}
+}
@@ -0,0 +1,126 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPinPostUseCase_HappyCase(t *testing.T) {
+	// Pinning a post again is not an error.
+	for name, pinned := range map[string]bool{"new pin": true, "already pinned": false} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID := uuid.New()
+			target := newSharedPost(actorID, vo.PostKindPost, vo.PostVisibilityPrivate)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), target.ID()).Return(target, nil).Times(1)
+
+			pinnedPostRepository := mock_repository.NewMockPinnedPostRepository(ctrl)
+			pinnedPostRepository.EXPECT().Pin(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, pin entity.PinnedPost) (bool, error) {
+					assert.Equal(t, actorID, pin.UserID())
+					assert.Equal(t, target.ID(), pin.PostID())
+
+					return pinned, nil
+				}).Times(1)
+
+			uc := post.NewPinPostUseCase(postRepository, pinnedPostRepository, mock_shared.NewMockTransactionManager(nil))
+			err := uc.Execute(context.Background(), post.PinPostInput{ActorID: actorID, PostID: target.ID()})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPinPostUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+	actorID := uuid.New()
+	own := newSharedPost(actorID, vo.PostKindPost, vo.PostVisibilityPublic)
+	hidden := time.Now()
+
+	tests := []struct {
+		name     string
+		target   entity.Post
+		findErr  error
+		pinErr   error
+		txErr    error
+		wantErr  error
+		wantCode vo.ErrorCode
+	}{
+		{name: "post not found", target: own, findErr: repository.ErrPostNotFound, wantCode: vo.NotFoundErrorCode},
+		{
+			name:     "private post of another user",
+			target:   newSharedPost(uuid.New(), vo.PostKindPost, vo.PostVisibilityPrivate),
+			wantCode: vo.NotFoundErrorCode,
+		},
+		{
+			name:     "public post of another user",
+			target:   newSharedPost(uuid.New(), vo.PostKindPost, vo.PostVisibilityPublic),
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name: "hidden post",
+			target: entity.ReconstructPost(
+				uuid.New(), actorID, "hidden", vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityPublic,
+				vo.PostStatusPublished, nil, &hidden, nil, nil, time.Now(), time.Now(),
+			),
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:     "repost",
+			target:   newSharedPost(actorID, vo.PostKindRepost, vo.PostVisibilityPublic),
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:     "too many pinned posts",
+			target:   own,
+			pinErr:   repository.ErrTooManyPinnedPosts,
+			wantCode: vo.ValidationErrorCode,
+		},
+		{name: "pin fails", target: own, pinErr: errDB, wantErr: errDB},
+		{name: "transaction fails", target: own, txErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), tt.target.ID()).Return(tt.target, tt.findErr).AnyTimes()
+
+			pinnedPostRepository := mock_repository.NewMockPinnedPostRepository(ctrl)
+			pinnedPostRepository.EXPECT().Pin(gomock.Any(), gomock.Any()).Return(true, tt.pinErr).AnyTimes()
+
+			uc := post.NewPinPostUseCase(
+				postRepository, pinnedPostRepository, mock_shared.NewMockTransactionManager(tt.txErr),
+			)
+			err := uc.Execute(context.Background(), post.PinPostInput{ActorID: actorID, PostID: tt.target.ID()})
+
+			require.Error(t, err)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const duePostBatch = 100
+
+// PublishDuePostsUseCase publishes the scheduled drafts whose publication time has come. It visits every
+// organization in turn.
+type PublishDuePostsUseCase interface {
+	Execute(ctx context.Context) (*PublishDuePostsOutput, error)
+}
+
+type PublishDuePostsOutput struct {
+	Published int
+}
+
+type publishDuePostsUseCaseImpl struct {
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	organizationRepository repository.OrganizationRepository
+	postRepository         repository.PostRepository
+	timelineRepository     repository.TimelineRepository
+	txManager              shared.TransactionManager
+}
+
+func (uc *publishDuePostsUseCaseImpl) Execute(ctx context.Context) (*PublishDuePostsOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	organizationIDs, err := uc.organizationRepository.FindAllIDs(ctx)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to list organizations", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	published := 0
+
+	// One failing organization does not keep the others from being published.
+	var errs []error
+
+	for _, organizationID := range organizationIDs {
+		n, publishErr := uc.publishTenant(common.WithTenantID(ctx, organizationID.String()), time.Now())
+		published += n
+
+		if publishErr != nil {
+			uc.logger.Error(ctx, "failed to publish due posts", "organizationID", organizationID,
+				"error", publishErr)
+			errs = append(errs, publishErr)
+		}
+	}
+
+	if published > 0 {
+		uc.logger.Info(ctx, "due posts published", "count", published)
+	}
+
+	if err = errors.Join(errs...); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return &PublishDuePostsOutput{Published: published}, nil
+}
+
+// publishTenant publishes the due drafts of the organization active in ctx batch by batch, each batch in
+// one transaction. Drafts published or deleted meanwhile, e.g. by their author, are skipped.
+func (uc *publishDuePostsUseCaseImpl) publishTenant(ctx context.Context, now time.Time) (int, error) {
+	published := 0
+
+	for {
+		var found, batchPublished int
+
+		err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+			posts, txErr := uc.postRepository.FindDue(ctx, now, duePostBatch)
+			if txErr != nil {
+				return txErr
+			}
+
+			found = len(posts)
+
+			for _, post := range posts {
+				txErr = publish(ctx, uc.postRepository, uc.timelineRepository, post, now)
+
+				var domainErr vo.Error
+				if errors.As(txErr, &domainErr) && domainErr.Code() == vo.NotFoundErrorCode {
+					continue
+				}
+
+				if txErr != nil {
+					return txErr
+				}
+
+				batchPublished++
+			}
+
+			return nil
+		})
+		if err != nil {
+			return published, err
+		}
+
+		published += batchPublished
+
+		if found < duePostBatch {
+			return published, nil
+		}
+	}
+}
+
+func NewPublishDuePostsUseCase(
+	organizationRepository repository.OrganizationRepository,
+	postRepository repository.PostRepository,
+	timelineRepository repository.TimelineRepository,
+	txManager shared.TransactionManager,
+) PublishDuePostsUseCase {
+	return &publishDuePostsUseCaseImpl{
+		tracer:                 otel.Tracer("PublishDuePostsUseCase"),
+		logger:                 common.NewLogger(),
+		organizationRepository: organizationRepository,
+		postRepository:         postRepository,
+		timelineRepository:     timelineRepository,
+		txManager:              txManager,
+	}
+}
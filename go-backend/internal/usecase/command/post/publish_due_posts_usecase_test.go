@@ -0,0 +1,131 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPublishDuePostsUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	orgA, orgB := uuid.New(), uuid.New()
+	publishAt := time.Now().Add(-time.Minute)
+	dueA := newTestDraft(uuid.New(), vo.PostVisibilityPublic, &publishAt)
+	dueB := newTestDraft(uuid.New(), vo.PostVisibilityPrivate, &publishAt)
+	gone := newTestDraft(uuid.New(), vo.PostVisibilityPublic, &publishAt)
+
+	organizationRepository := mock_repository.NewMockOrganizationRepository(ctrl)
+	organizationRepository.EXPECT().FindAllIDs(gomock.Any()).Return([]uuid.UUID{orgA, orgB}, nil)
+
+	// Each organization is published in its own tenant context.
+	due := map[string][]entity.Post{orgA.String(): {dueA, gone}, orgB.String(): {dueB}}
+	postRepository := mock_repository.NewMockPostRepository(ctrl)
+	postRepository.EXPECT().FindDue(gomock.Any(), gomock.Any(), 100).DoAndReturn(
+		func(ctx context.Context, now time.Time, _ int) ([]entity.Post, error) {
+			assert.WithinDuration(t, time.Now(), now, time.Minute)
+
+			return due[common.TenantIDFromContext(ctx)], nil
+		}).Times(2)
+	postRepository.EXPECT().Publish(gomock.Any(), dueA).Return(nil)
+	postRepository.EXPECT().Publish(gomock.Any(), dueB).Return(nil)
+	// A draft its author published or deleted meanwhile is skipped.
+	postRepository.EXPECT().Publish(gomock.Any(), gone).Return(repository.ErrPostNotFound)
+
+	// Private posts are published without reaching any timeline.
+	timelineRepository := mock_repository.NewMockTimelineRepository(ctrl)
+	timelineRepository.EXPECT().FanOut(gomock.Any(), dueA, 1000).Return(0, nil)
+
+	uc := post.NewPublishDuePostsUseCase(
+		organizationRepository, postRepository, timelineRepository, mock_shared.NewMockTransactionManager(nil),
+	)
+	output, err := uc.Execute(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, output.Published)
+	assert.Equal(t, vo.PostStatusPublished, dueA.Status())
+	assert.Equal(t, vo.PostStatusPublished, dueB.Status())
+}
+
+func TestPublishDuePostsUseCase_FullBatchFetchesAgain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	publishAt := time.Now().Add(-time.Minute)
+
+	batch := make([]entity.Post, 100)
+	for i := range batch {
+		batch[i] = newTestDraft(uuid.New(), vo.PostVisibilityPrivate, &publishAt)
+	}
+
+	organizationRepository := mock_repository.NewMockOrganizationRepository(ctrl)
+	organizationRepository.EXPECT().FindAllIDs(gomock.Any()).Return([]uuid.UUID{uuid.New()}, nil)
+
+	postRepository := mock_repository.NewMockPostRepository(ctrl)
+	gomock.InOrder(
+		postRepository.EXPECT().FindDue(gomock.Any(), gomock.Any(), 100).Return(batch, nil),
+		postRepository.EXPECT().FindDue(gomock.Any(), gomock.Any(), 100).Return(nil, nil),
+	)
+	postRepository.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).Times(100)
+
+	uc := post.NewPublishDuePostsUseCase(
+		organizationRepository, postRepository, mock_repository.NewMockTimelineRepository(ctrl),
+		mock_shared.NewMockTransactionManager(nil),
+	)
+	output, err := uc.Execute(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 100, output.Published)
+}
+
+func TestPublishDuePostsUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name       string
+		listErr    error
+		findErr    error
+		publishErr error
+		txErr      error
+	}{
+		{name: "listing organizations fails", listErr: errDB},
+		{name: "finding due posts fails", findErr: errDB},
+		{name: "publishing fails", publishErr: errDB},
+		{name: "transaction fails", txErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			publishAt := time.Now().Add(-time.Minute)
+
+			organizationRepository := mock_repository.NewMockOrganizationRepository(ctrl)
+			organizationRepository.EXPECT().FindAllIDs(gomock.Any()).Return([]uuid.UUID{uuid.New()}, tt.listErr)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindDue(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]entity.Post{newTestDraft(uuid.New(), vo.PostVisibilityPrivate, &publishAt)}, tt.findErr).
+				AnyTimes()
+			postRepository.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(tt.publishErr).AnyTimes()
+
+			uc := post.NewPublishDuePostsUseCase(
+				organizationRepository, postRepository, mock_repository.NewMockTimelineRepository(ctrl),
+				mock_shared.NewMockTransactionManager(tt.txErr),
+			)
+			output, err := uc.Execute(context.Background())
+
+			require.ErrorIs(t, err, errDB)
+			assert.Nil(t, output)
+		})
+	}
+}
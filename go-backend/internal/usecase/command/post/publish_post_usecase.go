@@ -0,0 +1,160 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PublishPostUseCase publishes a draft right away, scheduled or not. Only the author may publish a post.
+type PublishPostUseCase interface {
+	Execute(ctx context.Context, input PublishPostInput) (*PublishPostOutput, error)
+}
+
+type PublishPostInput struct {
+	ActorID uuid.UUID
+	PostID  uuid.UUID
+}
+
+type PublishPostOutput struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	// Content is the source of the post in Format; ContentHTML is its sanitised rendering.
+	Content     string
+	Format      vo.ContentFormat
+	ContentHTML string
+	Visibility  vo.PostVisibility
+	Status      vo.PostStatus
+	// CreatedAt is the publication time.
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	RevisionCount int
+}
+
+type publishPostUseCaseImpl struct {
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	postRepository         repository.PostRepository
+	postRevisionRepository repository.PostRevisionRepository
+	timelineRepository     repository.TimelineRepository
+	contentRenderer        service.ContentRenderer
+	txManager              shared.TransactionManager
+}
+
+func (uc *publishPostUseCaseImpl) Execute(ctx context.Context, input PublishPostInput) (*PublishPostOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	var (
+		post          entity.Post
+		revisionCount int
+	)
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var txErr error
+
+		post, txErr = findPost(ctx, uc.postRepository, input.PostID)
+		if txErr != nil {
+			return txErr
+		}
+
+		// Other users may not even see the post, so it is reported exactly like a missing one.
+		if post.UserID() != input.ActorID {
+			return vo.NewNotFoundError("post not found", nil, repository.ErrPostNotFound)
+		}
+
+		if txErr = publish(ctx, uc.postRepository, uc.timelineRepository, post, time.Now()); txErr != nil {
+			return txErr
+		}
+
+		revisionCount, txErr = uc.postRevisionRepository.CountByPostID(ctx, post.ID())
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	uc.logger.Info(ctx, "post published", "postID", post.ID())
+
+	return &PublishPostOutput{
+		ID:            post.ID(),
+		UserID:        post.UserID(),
+		Content:       post.Content(),
+		Format:        post.Format(),
+		ContentHTML:   uc.contentRenderer.Render(post.ID(), revisionCount, post.Format(), post.Content()),
+		Visibility:    post.Visibility(),
+		Status:        post.Status(),
+		CreatedAt:     post.CreatedAt(),
+		UpdatedAt:     post.UpdatedAt(),
+		RevisionCount: revisionCount,
+	}, nil
+}
+
+// publish publishes the draft at now and fans it out, reporting a draft that was published or deleted
+// meanwhile as a NotFound error. Callers run it in a transaction.
+func publish(
+	ctx context.Context,
+	postRepository repository.PostRepository,
+	timelineRepository repository.TimelineRepository,
+	post entity.Post,
+	now time.Time,
+) error {
+	if err := post.Publish(now); err != nil {
+		return err
+	}
+
+	if err := postRepository.Publish(ctx, post); err != nil {
+		if errors.Is(err, repository.ErrPostNotFound) {
+			return vo.NewNotFoundError("post not found", nil, err)
+		}
+
+		return err
+	}
+
+	return fanOut(ctx, timelineRepository, post)
+}
+
+// fanOut writes a published post to the timelines of its author's followers when the author has enough of
+// them. Drafts and private posts never appear on timelines.
+func fanOut(ctx context.Context, timelineRepository repository.TimelineRepository, post entity.Post) error {
+	if post.Status().IsDraft() || post.Visibility() == vo.PostVisibilityPrivate {
+		return nil
+	}
+
+	_, err := timelineRepository.FanOut(ctx, post, timelineFanOutMinFollowers)
+
+	return err
+}
+
+func NewPublishPostUseCase(
+	postRepository repository.PostRepository,
+	postRevisionRepository repository.PostRevisionRepository,
+	timelineRepository repository.TimelineRepository,
+	contentRenderer service.ContentRenderer,
+	txManager shared.TransactionManager,
+) PublishPostUseCase {
+	return &publishPostUseCaseImpl{
+		tracer:                 otel.Tracer("PublishPostUseCase"),
+		logger:                 common.NewLogger(),
+		postRepository:         postRepository,
+		postRevisionRepository: postRevisionRepository,
+		timelineRepository:     timelineRepository,
+		contentRenderer:        contentRenderer,
+		txManager:              txManager,
+	}
+}
@@ -0,0 +1,154 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestDraft(authorID uuid.UUID, visibility vo.PostVisibility, publishAt *time.Time) entity.Post {
+	createdAt := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+
+	return entity.ReconstructPost(
+		uuid.New(), authorID, "draft", vo.ContentFormatPlain, vo.PostKindPost, nil, visibility, vo.PostStatusDraft, publishAt,
+		nil, nil, nil, createdAt, createdAt,
+	)
+}
+
+func TestPublishPostUseCase_HappyCase(t *testing.T) {
+	authorID := uuid.New()
+	publishAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		draft      entity.Post
+		wantFanOut bool
+	}{
+		{name: "draft", draft: newTestDraft(authorID, vo.PostVisibilityPublic, nil), wantFanOut: true},
+		{
+			name:       "scheduled draft ahead of time",
+			draft:      newTestDraft(authorID, vo.PostVisibilityFollowers, &publishAt),
+			wantFanOut: true,
+		},
+		{name: "private draft stays off timelines", draft: newTestDraft(authorID, vo.PostVisibilityPrivate, nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), tt.draft.ID()).Return(tt.draft, nil).Times(1)
+			postRepository.EXPECT().Publish(gomock.Any(), tt.draft).Return(nil).Times(1)
+
+			revisionRepository := mock_repository.NewMockPostRevisionRepository(ctrl)
+			revisionRepository.EXPECT().CountByPostID(gomock.Any(), tt.draft.ID()).Return(1, nil).Times(1)
+
+			timelineRepository := mock_repository.NewMockTimelineRepository(ctrl)
+			if tt.wantFanOut {
+				timelineRepository.EXPECT().FanOut(gomock.Any(), tt.draft, 1000).Return(0, nil).Times(1)
+			}
+
+			uc := post.NewPublishPostUseCase(
+				postRepository, revisionRepository, timelineRepository, renderContent(ctrl), mock_shared.NewMockTransactionManager(nil),
+			)
+			output, err := uc.Execute(context.Background(), post.PublishPostInput{ActorID: authorID, PostID: tt.draft.ID()})
+
+			require.NoError(t, err)
+			assert.Equal(t, vo.PostStatusPublished, output.Status)
+			assert.Equal(t, tt.draft.Visibility(), output.Visibility)
+			assert.WithinDuration(t, time.Now(), output.CreatedAt, time.Minute)
+			assert.Equal(t, output.CreatedAt, output.UpdatedAt)
+			assert.Equal(t, 1, output.RevisionCount)
+			assert.Nil(t, tt.draft.PublishAt())
+		})
+	}
+}
+
+func TestPublishPostUseCase_FailureCase(t *testing.T) {
+	authorID := uuid.New()
+	errDB := errors.New("db error")
+	createdAt := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+	published := entity.ReconstructPost(
+		uuid.New(), authorID, "done", vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityPublic,
+		vo.PostStatusPublished, nil, nil, nil, nil, createdAt, createdAt,
+	)
+
+	tests := []struct {
+		name       string
+		actorID    uuid.UUID
+		post       entity.Post
+		findErr    error
+		publishErr error
+		fanOutErr  error
+		wantErr    error
+		wantCode   vo.ErrorCode
+	}{
+		{
+			name: "post does not exist", actorID: authorID, findErr: repository.ErrPostNotFound,
+			wantCode: vo.NotFoundErrorCode,
+		},
+		{name: "post of another user", actorID: uuid.New(), wantCode: vo.NotFoundErrorCode},
+		{name: "post is already published", actorID: authorID, post: published, wantCode: vo.ValidationErrorCode},
+		{
+			name: "post was published meanwhile", actorID: authorID, publishErr: repository.ErrPostNotFound,
+			wantCode: vo.NotFoundErrorCode,
+		},
+		{name: "publishing fails", actorID: authorID, publishErr: errDB, wantErr: errDB},
+		{name: "fan-out fails", actorID: authorID, fanOutErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			existing := tt.post
+			if existing == nil {
+				existing = newTestDraft(authorID, vo.PostVisibilityPublic, nil)
+			}
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(existing, tt.findErr).Times(1)
+			postRepository.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(tt.publishErr).AnyTimes()
+
+			revisionRepository := mock_repository.NewMockPostRevisionRepository(ctrl)
+			revisionRepository.EXPECT().CountByPostID(gomock.Any(), gomock.Any()).Return(1, nil).AnyTimes()
+
+			timelineRepository := mock_repository.NewMockTimelineRepository(ctrl)
+			timelineRepository.EXPECT().FanOut(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(0, tt.fanOutErr).AnyTimes()
+
+			uc := post.NewPublishPostUseCase(
+				postRepository, revisionRepository, timelineRepository, renderContent(ctrl), mock_shared.NewMockTransactionManager(nil),
+			)
+			output, err := uc.Execute(context.Background(), post.PublishPostInput{
+				ActorID: tt.actorID, PostID: existing.ID(),
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var domainErr vo.Error
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tt.wantCode, domainErr.Code())
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const trashedPostPurgeBatch = 100
+
+// PurgeTrashedPostsUseCase deletes the posts that have been in the trash longer than
+// entity.PostTrashRetention for good, with everything that belongs to them. Their attachments are left to
+// SweepOrphanedAttachmentsUseCase. It visits every organization in turn.
+type PurgeTrashedPostsUseCase interface {
+	Execute(ctx context.Context) (*PurgeTrashedPostsOutput, error)
+}
+
+type PurgeTrashedPostsOutput struct {
+	Purged int
+}
+
+type purgeTrashedPostsUseCaseImpl struct {
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	organizationRepository repository.OrganizationRepository
+	postRepository         repository.PostRepository
+	txManager              shared.TransactionManager
+}
+
+func (uc *purgeTrashedPostsUseCaseImpl) Execute(ctx context.Context) (*PurgeTrashedPostsOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	organizationIDs, err := uc.organizationRepository.FindAllIDs(ctx)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to list organizations", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	trashedBefore := time.Now().Add(-entity.PostTrashRetention)
+	purged := 0
+
+	// One failing organization does not keep the others from being purged.
+	var errs []error
+
+	for _, organizationID := range organizationIDs {
+		n, purgeErr := uc.purgeTenant(common.WithTenantID(ctx, organizationID.String()), trashedBefore)
+		purged += n
+
+		if purgeErr != nil {
+			uc.logger.Error(ctx, "failed to purge trashed posts", "organizationID", organizationID,
+				"error", purgeErr)
+			errs = append(errs, purgeErr)
+		}
+	}
+
+	if purged > 0 {
+		uc.logger.Info(ctx, "trashed posts purged", "count", purged)
+	}
+
+	if err = errors.Join(errs...); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return &PurgeTrashedPostsOutput{Purged: purged}, nil
+}
+
+// purgeTenant purges the expired trash of the organization active in ctx, one batch per transaction.
+func (uc *purgeTrashedPostsUseCaseImpl) purgeTenant(ctx context.Context, trashedBefore time.Time) (int, error) {
+	purged := 0
+
+	for {
+		var n int
+
+		err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+			var txErr error
+
+			n, txErr = uc.postRepository.PurgeTrashed(ctx, trashedBefore, trashedPostPurgeBatch)
+
+			return txErr
+		})
+		if err != nil {
+			return purged, err
+		}
+
+		purged += n
+
+		if n < trashedPostPurgeBatch {
+			return purged, nil
+		}
+	}
+}
+
+func NewPurgeTrashedPostsUseCase(
+	organizationRepository repository.OrganizationRepository,
+	postRepository repository.PostRepository,
+	txManager shared.TransactionManager,
+) PurgeTrashedPostsUseCase {
+	return &purgeTrashedPostsUseCaseImpl{
+		tracer:                 otel.Tracer("PurgeTrashedPostsUseCase"),
+		logger:                 common.NewLogger(),
+		organizationRepository: organizationRepository,
+		postRepository:         postRepository,
+		txManager:              txManager,
+	}
+}
@@ -0,0 +1,84 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPurgeTrashedPostsUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	orgA, orgB := uuid.New(), uuid.New()
+
+	organizationRepository := mock_repository.NewMockOrganizationRepository(ctrl)
+	organizationRepository.EXPECT().FindAllIDs(gomock.Any()).Return([]uuid.UUID{orgA, orgB}, nil)
+
+	// orgA has a full batch and then some, so it takes a second round; orgB is done in one.
+	batches := map[string][]int{orgA.String(): {100, 3}, orgB.String(): {7}}
+	postRepository := mock_repository.NewMockPostRepository(ctrl)
+	postRepository.EXPECT().PurgeTrashed(gomock.Any(), gomock.Any(), 100).DoAndReturn(
+		func(ctx context.Context, trashedBefore time.Time, _ int) (int, error) {
+			assert.WithinDuration(t, time.Now().Add(-entity.PostTrashRetention), trashedBefore, time.Minute)
+
+			tenantID := common.TenantIDFromContext(ctx)
+			n := batches[tenantID][0]
+			batches[tenantID] = batches[tenantID][1:]
+
+			return n, nil
+		}).Times(3)
+
+	uc := post.NewPurgeTrashedPostsUseCase(
+		organizationRepository, postRepository, mock_shared.NewMockTransactionManager(nil),
+	)
+	output, err := uc.Execute(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 110, output.Purged)
+}
+
+func TestPurgeTrashedPostsUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name     string
+		listErr  error
+		purgeErr error
+		txErr    error
+	}{
+		{name: "listing organizations fails", listErr: errDB},
+		{name: "purging fails", purgeErr: errDB},
+		{name: "transaction fails", txErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			organizationRepository := mock_repository.NewMockOrganizationRepository(ctrl)
+			organizationRepository.EXPECT().FindAllIDs(gomock.Any()).Return([]uuid.UUID{uuid.New()}, tt.listErr)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().PurgeTrashed(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(0, tt.purgeErr).AnyTimes()
+
+			uc := post.NewPurgeTrashedPostsUseCase(
+				organizationRepository, postRepository, mock_shared.NewMockTransactionManager(tt.txErr),
+			)
+			output, err := uc.Execute(context.Background())
+
+			require.ErrorIs(t, err, errDB)
+			assert.Nil(t, output)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RemoveBookmarkUseCase removes a post from the actor's bookmarks, also when the post itself is gone.
+// Removing a bookmark that does not exist succeeds without changing anything.
+type RemoveBookmarkUseCase interface {
+	Execute(ctx context.Context, input RemoveBookmarkInput) error
+}
+
+type RemoveBookmarkInput struct {
+	ActorID uuid.UUID
+	PostID  uuid.UUID
+}
+
+type removeBookmarkUseCaseImpl struct {
+	tracer             trace.Tracer
+	logger             common.Logger
+	bookmarkRepository repository.BookmarkRepository
+	txManager          shared.TransactionManager
+}
+
+func (uc *removeBookmarkUseCaseImpl) Execute(ctx context.Context, input RemoveBookmarkInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	var removed bool
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var txErr error
+
+		removed, txErr = uc.bookmarkRepository.Remove(ctx, input.ActorID, input.PostID)
+		if txErr != nil {
+			uc.logger.Error(ctx, "failed to remove Bookmark", "error", txErr)
+		}
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "bookmark removed", "postID", input.PostID, "actorID", input.ActorID, "changed", removed)
+
+	return nil
+}
+
+func NewRemoveBookmarkUseCase(
+	bookmarkRepository repository.BookmarkRepository,
+	txManager shared.TransactionManager,
+) RemoveBookmarkUseCase {
+	return &removeBookmarkUseCaseImpl{
+		tracer:             otel.Tracer("RemoveBookmarkUseCase"),
+		logger:             common.NewLogger(),
+		bookmarkRepository: bookmarkRepository,
+		txManager:          txManager,
+	}
+}
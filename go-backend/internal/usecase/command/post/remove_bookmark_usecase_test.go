@@ -0,0 +1,61 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRemoveBookmarkUseCase_HappyCase(t *testing.T) {
+	// Removing a bookmark that does not exist is not an error.
+	for name, removed := range map[string]bool{"existing bookmark": true, "missing bookmark": false} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID, postID := uuid.New(), uuid.New()
+
+			bookmarkRepository := mock_repository.NewMockBookmarkRepository(ctrl)
+			bookmarkRepository.EXPECT().Remove(gomock.Any(), actorID, postID).Return(removed, nil).Times(1)
+
+			uc := post.NewRemoveBookmarkUseCase(bookmarkRepository, mock_shared.NewMockTransactionManager(nil))
+			err := uc.Execute(context.Background(), post.RemoveBookmarkInput{ActorID: actorID, PostID: postID})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRemoveBookmarkUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name      string
+		removeErr error
+		txErr     error
+	}{
+		{name: "remove fails", removeErr: errDB},
+		{name: "transaction fails", txErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			bookmarkRepository := mock_repository.NewMockBookmarkRepository(ctrl)
+			bookmarkRepository.EXPECT().Remove(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(false, tt.removeErr).AnyTimes()
+
+			uc := post.NewRemoveBookmarkUseCase(bookmarkRepository, mock_shared.NewMockTransactionManager(tt.txErr))
+			err := uc.Execute(context.Background(), post.RemoveBookmarkInput{ActorID: uuid.New(), PostID: uuid.New()})
+
+			assert.ErrorIs(t, err, errDB)
+		})
+	}
+}
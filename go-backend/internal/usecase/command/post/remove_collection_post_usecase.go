@@ -0,0 +1,76 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RemoveCollectionPostUseCase takes a post out of one of the actor's collections, also when the post itself
+// is gone. Removing a post that is not in the collection succeeds without changing anything.
+type RemoveCollectionPostUseCase interface {
+	Execute(ctx context.Context, input RemoveCollectionPostInput) error
+}
+
+type RemoveCollectionPostInput struct {
+	ActorID      uuid.UUID
+	CollectionID uuid.UUID
+	PostID       uuid.UUID
+}
+
+type removeCollectionPostUseCaseImpl struct {
+	tracer               trace.Tracer
+	logger               common.Logger
+	collectionRepository repository.CollectionRepository
+	txManager            shared.TransactionManager
+}
+
+func (uc *removeCollectionPostUseCaseImpl) Execute(ctx context.Context, input RemoveCollectionPostInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	var removed bool
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		collection, txErr := findOwnCollection(ctx, uc.collectionRepository, input.CollectionID, input.ActorID)
+		if txErr != nil {
+			return txErr
+		}
+
+		removed, txErr = uc.collectionRepository.RemovePost(ctx, collection.ID(), input.PostID)
+		if txErr != nil {
+			uc.logger.Error(ctx, "failed to remove post from Collection", "error", txErr)
+		}
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "post removed from collection", "collectionID", input.CollectionID,
+		"postID", input.PostID, "actorID", input.ActorID, "changed", removed)
+
+	return nil
+}
+
+func NewRemoveCollectionPostUseCase(
+	collectionRepository repository.CollectionRepository,
+	txManager shared.TransactionManager,
+) RemoveCollectionPostUseCase {
+	return &removeCollectionPostUseCaseImpl{
+		tracer:               otel.Tracer("RemoveCollectionPostUseCase"),
+		logger:               common.NewLogger(),
+		collectionRepository: collectionRepository,
+		txManager:            txManager,
+	}
+}
@@ -0,0 +1,90 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RemoveReactionUseCase withdraws the actor's reaction of one type from a post. Removing a reaction that
+// does not exist succeeds without changing anything.
+type RemoveReactionUseCase interface {
+	Execute(ctx context.Context, input RemoveReactionInput) error
+}
+
+type RemoveReactionInput struct {
+	ActorID uuid.UUID
+	PostID  uuid.UUID
+	// Type must be one of vo.ReactionTypes.
+	Type string
+}
+
+type removeReactionUseCaseImpl struct {
+	tracer             trace.Tracer
+	logger             common.Logger
+	postRepository     repository.PostRepository
+	reactionRepository repository.ReactionRepository
+	txManager          shared.TransactionManager
+}
+
+func (uc *removeReactionUseCaseImpl) Execute(ctx context.Context, input RemoveReactionInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	reactionType, err := vo.ReactionTypeFromString(input.Type)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	var removed bool
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		if _, txErr := findPost(ctx, uc.postRepository, input.PostID); txErr != nil {
+			return txErr
+		}
+
+		var txErr error
+
+		removed, txErr = uc.reactionRepository.Remove(ctx, input.PostID, input.ActorID, reactionType)
+		if txErr != nil {
+			uc.logger.Error(ctx, "failed to remove Reaction", "error", txErr)
+		}
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "reaction removed", "postID", input.PostID, "actorID", input.ActorID,
+		"type", reactionType, "changed", removed)
+
+	return nil
+}
+
+func NewRemoveReactionUseCase(
+	postRepository repository.PostRepository,
+	reactionRepository repository.ReactionRepository,
+	txManager shared.TransactionManager,
+) RemoveReactionUseCase {
+	return &removeReactionUseCaseImpl{
+		tracer:             otel.Tracer("RemoveReactionUseCase"),
+		logger:             common.NewLogger(),
+		postRepository:     postRepository,
+		reactionRepository: reactionRepository,
+		txManager:          txManager,
+	}
+}
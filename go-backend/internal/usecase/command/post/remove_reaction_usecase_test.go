@@ -0,0 +1,143 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRemoveReactionUseCase_HappyCase(t *testing.T) {
+	// Removing a reaction that is not there is not an error.
+	tests := []struct {
+		name    string
+		removed bool
+	}{
+		{name: "existing reaction", removed: true},
+		{name: "missing reaction", removed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID := uuid.New()
+			existing := entity.ReconstructPost(
+				uuid.New(),
+				uuid.New(),
+				"content",
+				vo.ContentFormatPlain,
+				vo.PostKindPost,
+				nil,
+				vo.PostVisibilityPublic,
+				vo.PostStatusPublished,
+				nil,
+				nil,
+				nil,
+				nil,
+				time.Now(),
+				time.Now(),
+			)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), existing.ID()).Return(existing, nil).Times(1)
+
+			reactionRepository := mock_repository.NewMockReactionRepository(ctrl)
+			reactionRepository.EXPECT().Remove(gomock.Any(), existing.ID(), actorID, vo.ReactionTypeSad).
+				Return(tt.removed, nil).Times(1)
+
+			uc := post.NewRemoveReactionUseCase(postRepository, reactionRepository, mock_shared.NewMockTransactionManager(nil))
+			err := uc.Execute(context.Background(), post.RemoveReactionInput{
+				ActorID: actorID,
+				PostID:  existing.ID(),
+				Type:    "sad",
+			})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRemoveReactionUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name        string
+		reaction    string
+		findPostErr error
+		removeErr   error
+		txErr       error
+		wantErr     error
+		wantCode    vo.ErrorCode
+	}{
+		{name: "unknown reaction type", reaction: "dislike", wantCode: vo.ValidationErrorCode},
+		{name: "post does not exist", findPostErr: repository.ErrPostNotFound, wantCode: vo.NotFoundErrorCode},
+		{name: "find post fails", findPostErr: errDB, wantErr: errDB},
+		{name: "remove fails", removeErr: errDB, wantErr: errDB},
+		{name: "transaction fails", txErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			existing := entity.ReconstructPost(
+				uuid.New(),
+				uuid.New(),
+				"content",
+				vo.ContentFormatPlain,
+				vo.PostKindPost,
+				nil,
+				vo.PostVisibilityPublic,
+				vo.PostStatusPublished,
+				nil,
+				nil,
+				nil,
+				nil,
+				time.Now(),
+				time.Now(),
+			)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(existing, tt.findPostErr).AnyTimes()
+
+			reactionRepository := mock_repository.NewMockReactionRepository(ctrl)
+			reactionRepository.EXPECT().Remove(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(false, tt.removeErr).AnyTimes()
+
+			reaction := tt.reaction
+			if reaction == "" {
+				reaction = "like"
+			}
+
+			txManager := mock_shared.NewMockTransactionManager(tt.txErr)
+			uc := post.NewRemoveReactionUseCase(postRepository, reactionRepository, txManager)
+			err := uc.Execute(context.Background(), post.RemoveReactionInput{
+				ActorID: uuid.New(),
+				PostID:  existing.ID(),
+				Type:    reaction,
+			})
+
+			require.Error(t, err)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
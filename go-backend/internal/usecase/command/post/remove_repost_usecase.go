@@ -0,0 +1,73 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RemoveRepostUseCase undoes the actor's repost of a post, also when the post was trashed or hidden since. The
+// repost is deleted for good rather than moved to the trash. Removing a repost that does not exist succeeds
+// without changing anything.
+type RemoveRepostUseCase interface {
+	Execute(ctx context.Context, input RemoveRepostInput) error
+}
+
+type RemoveRepostInput struct {
+	ActorID uuid.UUID
+	// PostID is the reposted post, not the repost.
+	PostID uuid.UUID
+}
+
+type removeRepostUseCaseImpl struct {
+	tracer         trace.Tracer
+	logger         common.Logger
+	postRepository repository.PostRepository
+	txManager      shared.TransactionManager
+}
+
+func (uc *removeRepostUseCaseImpl) Execute(ctx context.Context, input RemoveRepostInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	var removed bool
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var txErr error
+
+		removed, txErr = uc.postRepository.DeleteRepost(ctx, input.ActorID, input.PostID)
+		if txErr != nil {
+			uc.logger.Error(ctx, "failed to delete repost", "error", txErr)
+		}
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "repost removed", "postID", input.PostID, "actorID", input.ActorID, "changed", removed)
+
+	return nil
+}
+
+func NewRemoveRepostUseCase(
+	postRepository repository.PostRepository,
+	txManager shared.TransactionManager,
+) RemoveRepostUseCase {
+	return &removeRepostUseCaseImpl{
+		tracer:         otel.Tracer("RemoveRepostUseCase"),
+		logger:         common.NewLogger(),
+		postRepository: postRepository,
+		txManager:      txManager,
+	}
+}
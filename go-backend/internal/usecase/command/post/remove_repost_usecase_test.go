@@ -0,0 +1,61 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRemoveRepostUseCase_HappyCase(t *testing.T) {
+	// Removing a repost that does not exist is not an error.
+	for name, removed := range map[string]bool{"existing repost": true, "missing repost": false} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID, postID := uuid.New(), uuid.New()
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().DeleteRepost(gomock.Any(), actorID, postID).Return(removed, nil).Times(1)
+
+			uc := post.NewRemoveRepostUseCase(postRepository, mock_shared.NewMockTransactionManager(nil))
+			err := uc.Execute(context.Background(), post.RemoveRepostInput{ActorID: actorID, PostID: postID})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRemoveRepostUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name      string
+		deleteErr error
+		txErr     error
+	}{
+		{name: "delete fails", deleteErr: errDB},
+		{name: "transaction fails", txErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().DeleteRepost(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(false, tt.deleteErr).AnyTimes()
+
+			uc := post.NewRemoveRepostUseCase(postRepository, mock_shared.NewMockTransactionManager(tt.txErr))
+			err := uc.Execute(context.Background(), post.RemoveRepostInput{ActorID: uuid.New(), PostID: uuid.New()})
+
+			assert.ErrorIs(t, err, errDB)
+		})
+	}
+}
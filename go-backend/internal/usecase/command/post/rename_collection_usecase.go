@@ -0,0 +1,89 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RenameCollectionUseCase renames one of the actor's collections.
+type RenameCollectionUseCase interface {
+	Execute(ctx context.Context, input RenameCollectionInput) (*CollectionOutput, error)
+}
+
+type RenameCollectionInput struct {
+	ActorID      uuid.UUID
+	CollectionID uuid.UUID
+	Name         string
+}
+
+type renameCollectionUseCaseImpl struct {
+	tracer               trace.Tracer
+	logger               common.Logger
+	collectionRepository repository.CollectionRepository
+	txManager            shared.TransactionManager
+}
+
+func (uc *renameCollectionUseCaseImpl) Execute(
+	ctx context.Context, input RenameCollectionInput,
+) (*CollectionOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	var collection entity.Collection
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var txErr error
+
+		collection, txErr = findOwnCollection(ctx, uc.collectionRepository, input.CollectionID, input.ActorID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = collection.Rename(input.Name, time.Now()); txErr != nil {
+			return txErr
+		}
+
+		if txErr = uc.collectionRepository.Update(ctx, collection); txErr != nil {
+			if errors.Is(txErr, repository.ErrCollectionNotFound) {
+				return vo.NewNotFoundError("collection not found", nil, txErr)
+			}
+
+			uc.logger.Error(ctx, "failed to update Collection", "error", txErr)
+		}
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	uc.logger.Info(ctx, "collection renamed", "collectionID", input.CollectionID, "actorID", input.ActorID)
+
+	return toCollectionOutput(collection), nil
+}
+
+func NewRenameCollectionUseCase(
+	collectionRepository repository.CollectionRepository,
+	txManager shared.TransactionManager,
+) RenameCollectionUseCase {
+	return &renameCollectionUseCaseImpl{
+		tracer:               otel.Tracer("RenameCollectionUseCase"),
+		logger:               common.NewLogger(),
+		collectionRepository: collectionRepository,
+		txManager:            txManager,
+	}
+}
@@ -0,0 +1,108 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRenameCollectionUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	actorID := uuid.New()
+	existing := collectionOf(actorID)
+	createdAt := existing.CreatedAt()
+
+	collectionRepository := mock_repository.NewMockCollectionRepository(ctrl)
+	collectionRepository.EXPECT().FindByID(gomock.Any(), existing.ID()).Return(existing, nil).Times(1)
+	collectionRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, c entity.Collection) error {
+			assert.Equal(t, "Dinner", c.Name())
+
+			return nil
+		}).Times(1)
+
+	uc := post.NewRenameCollectionUseCase(collectionRepository, mock_shared.NewMockTransactionManager(nil))
+	output, err := uc.Execute(context.Background(), post.RenameCollectionInput{
+		ActorID: actorID, CollectionID: existing.ID(), Name: "Dinner",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, existing.ID(), output.ID)
+	assert.Equal(t, "Dinner", output.Name)
+	assert.Equal(t, createdAt, output.CreatedAt)
+	assert.True(t, output.UpdatedAt.After(createdAt))
+}
+
+func TestRenameCollectionUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+	actorID := uuid.New()
+
+	tests := []struct {
+		name      string
+		owner     uuid.UUID
+		input     string
+		findErr   error
+		updateErr error
+		txErr     error
+		wantErr   error
+		wantCode  vo.ErrorCode
+	}{
+		{name: "collection does not exist", findErr: repository.ErrCollectionNotFound, wantCode: vo.NotFoundErrorCode},
+		{name: "collection of another user", owner: uuid.New(), wantCode: vo.NotFoundErrorCode},
+		{name: "empty name", input: " ", wantCode: vo.ValidationErrorCode},
+		{name: "deleted meanwhile", updateErr: repository.ErrCollectionNotFound, wantCode: vo.NotFoundErrorCode},
+		{name: "find fails", findErr: errDB, wantErr: errDB},
+		{name: "update fails", updateErr: errDB, wantErr: errDB},
+		{name: "transaction fails", txErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			owner := actorID
+			if tt.owner != uuid.Nil {
+				owner = tt.owner
+			}
+
+			collectionRepository := mock_repository.NewMockCollectionRepository(ctrl)
+			collectionRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).
+				Return(collectionOf(owner), tt.findErr).AnyTimes()
+			collectionRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(tt.updateErr).AnyTimes()
+
+			name := tt.input
+			if name == "" {
+				name = "Dinner"
+			}
+
+			uc := post.NewRenameCollectionUseCase(collectionRepository, mock_shared.NewMockTransactionManager(tt.txErr))
+			output, err := uc.Execute(context.Background(), post.RenameCollectionInput{
+				ActorID: actorID, CollectionID: uuid.New(), Name: name,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errReportOwnPost = errors.New("user reported their own post")
+
+// ReportPostUseCase reports a post the actor can see to the moderators. A user may not report their own
+// posts, nor report a post again while their previous report of it is open.
+type ReportPostUseCase interface {
+	Execute(ctx context.Context, input ReportPostInput) (*ReportPostOutput, error)
+}
+
+type ReportPostInput struct {
+	ReporterID uuid.UUID
+	PostID     uuid.UUID
+	// Reason must be one of vo.ReportReasons.
+	Reason  string
+	Details string
+}
+
+type ReportPostOutput struct {
+	ID        uuid.UUID
+	PostID    uuid.UUID
+	Reason    vo.ReportReason
+	Details   string
+	CreatedAt time.Time
+}
+
+type reportPostUseCaseImpl struct {
+	tracer               trace.Tracer
+	logger               common.Logger
+	postRepository       repository.PostRepository
+	postReportRepository repository.PostReportRepository
+	txManager            shared.TransactionManager
+}
+
+func (uc *reportPostUseCaseImpl) Execute(ctx context.Context, input ReportPostInput) (*ReportPostOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	report, err := entity.NewPostReport(input.PostID, input.ReporterID, input.Reason, input.Details, time.Now())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		post, txErr := findPost(ctx, uc.postRepository, input.PostID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if post.UserID() == input.ReporterID {
+			return vo.NewValidationError("cannot report your own post", nil, errReportOwnPost)
+		}
+
+		txErr = uc.postReportRepository.Create(ctx, report)
+
+		switch {
+		case errors.Is(txErr, repository.ErrPostNotFound):
+			return vo.NewNotFoundError("post not found", nil, txErr)
+		case errors.Is(txErr, repository.ErrPostAlreadyReported):
+			return vo.NewValidationError("post is already reported", map[string]any{
+				"post_id": input.PostID.String(),
+			}, txErr)
+		case txErr != nil:
+			uc.logger.Error(ctx, "failed to save PostReport", "error", txErr)
+		}
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	uc.logger.Info(ctx, "post reported", "postID", input.PostID, "reporterID", input.ReporterID,
+		"reason", report.Reason())
+
+	return &ReportPostOutput{
+		ID:        report.ID(),
+		PostID:    report.PostID(),
+		Reason:    report.Reason(),
+		Details:   report.Details(),
+		CreatedAt: report.CreatedAt(),
+	}, nil
+}
+
+func NewReportPostUseCase(
+	postRepository repository.PostRepository,
+	postReportRepository repository.PostReportRepository,
+	txManager shared.TransactionManager,
+) ReportPostUseCase {
+	return &reportPostUseCaseImpl{
+		tracer:               otel.Tracer("ReportPostUseCase"),
+		logger:               common.NewLogger(),
+		postRepository:       postRepository,
+		postReportRepository: postReportRepository,
+		txManager:            txManager,
+	}
+}
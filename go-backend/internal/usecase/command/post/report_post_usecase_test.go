@@ -0,0 +1,118 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReportPostUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	reporterID := uuid.New()
+	existing := entity.ReconstructPost(
+		uuid.New(), uuid.New(), "buy now", vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityPublic,
+		vo.PostStatusPublished, nil, nil, nil, nil, time.Now(), time.Now(),
+	)
+
+	postRepository := mock_repository.NewMockPostRepository(ctrl)
+	postRepository.EXPECT().FindByID(gomock.Any(), existing.ID()).Return(existing, nil).Times(1)
+
+	postReportRepository := mock_repository.NewMockPostReportRepository(ctrl)
+	postReportRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r entity.PostReport) error {
+			assert.Equal(t, existing.ID(), r.PostID())
+			assert.Equal(t, &reporterID, r.ReporterID())
+
+			return nil
+		}).Times(1)
+
+	uc := post.NewReportPostUseCase(postRepository, postReportRepository, mock_shared.NewMockTransactionManager(nil))
+	output, err := uc.Execute(context.Background(), post.ReportPostInput{
+		ReporterID: reporterID, PostID: existing.ID(), Reason: "spam", Details: " ads everywhere ",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, existing.ID(), output.PostID)
+	assert.Equal(t, vo.ReportReasonSpam, output.Reason)
+	assert.Equal(t, "ads everywhere", output.Details)
+	assert.WithinDuration(t, time.Now(), output.CreatedAt, time.Minute)
+}
+
+func TestReportPostUseCase_FailureCase(t *testing.T) {
+	reporterID, authorID := uuid.New(), uuid.New()
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name      string
+		authorID  uuid.UUID
+		reason    string
+		findErr   error
+		createErr error
+		wantErr   error
+		wantCode  vo.ErrorCode
+	}{
+		{name: "unknown reason", authorID: authorID, reason: "boring", wantCode: vo.ValidationErrorCode},
+		{
+			name: "post does not exist", authorID: authorID, reason: "spam", findErr: repository.ErrPostNotFound,
+			wantCode: vo.NotFoundErrorCode,
+		},
+		{name: "own post", authorID: reporterID, reason: "spam", wantCode: vo.ValidationErrorCode},
+		{
+			name: "post is not visible to the reporter", authorID: authorID, reason: "spam",
+			createErr: repository.ErrPostNotFound, wantCode: vo.NotFoundErrorCode,
+		},
+		{
+			name: "already reported", authorID: authorID, reason: "spam",
+			createErr: repository.ErrPostAlreadyReported, wantCode: vo.ValidationErrorCode,
+		},
+		{name: "saving fails", authorID: authorID, reason: "spam", createErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			existing := entity.ReconstructPost(
+				uuid.New(), tt.authorID, "content", vo.ContentFormatPlain, vo.PostKindPost, nil, vo.PostVisibilityPublic,
+				vo.PostStatusPublished, nil, nil, nil, nil, time.Now(), time.Now(),
+			)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(existing, tt.findErr).AnyTimes()
+
+			postReportRepository := mock_repository.NewMockPostReportRepository(ctrl)
+			postReportRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(tt.createErr).AnyTimes()
+
+			uc := post.NewReportPostUseCase(
+				postRepository, postReportRepository, mock_shared.NewMockTransactionManager(nil),
+			)
+			output, err := uc.Execute(context.Background(), post.ReportPostInput{
+				ReporterID: reporterID, PostID: existing.ID(), Reason: tt.reason,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var domainErr vo.Error
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tt.wantCode, domainErr.Code())
+		})
+	}
+}
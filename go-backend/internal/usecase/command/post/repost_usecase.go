@@ -0,0 +1,103 @@
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RepostUseCase reposts a post on behalf of the actor, sharing it as it is with the actor's followers.
+// Reposting a post again succeeds without changing anything.
+type RepostUseCase interface {
+	Execute(ctx context.Context, input RepostInput) error
+}
+
+type RepostInput struct {
+	ActorID uuid.UUID
+	// PostID is the post to repost; see entity.NewRepost for the posts that can be reposted.
+	PostID uuid.UUID
+}
+
+type repostUseCaseImpl struct {
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	postRepository         repository.PostRepository
+	timelineRepository     repository.TimelineRepository
+	relationshipRepository aggregaterepository.UserRelationshipRepository
+	txManager              shared.TransactionManager
+}
+
+func (uc *repostUseCaseImpl) Execute(ctx context.Context, input RepostInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	var reposted bool
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		original, txErr := findSharedPost(ctx, uc.postRepository, input.ActorID, input.PostID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = authorizeInteraction(ctx, uc.relationshipRepository, input.ActorID, original); txErr != nil {
+			return txErr
+		}
+
+		repost, txErr := entity.NewRepost(input.ActorID, original, time.Now())
+		if txErr != nil {
+			return txErr
+		}
+
+		reposted, txErr = uc.postRepository.CreateRepost(ctx, repost)
+		if txErr != nil {
+			uc.logger.Error(ctx, "failed to save repost", "error", txErr)
+
+			return txErr
+		}
+
+		if !reposted {
+			return nil
+		}
+
+		if txErr = fanOut(ctx, uc.timelineRepository, repost); txErr != nil {
+			uc.logger.Error(ctx, "failed to fan out repost", "error", txErr)
+		}
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "post reposted", "postID", input.PostID, "actorID", input.ActorID, "changed", reposted)
+
+	return nil
+}
+
+func NewRepostUseCase(
+	postRepository repository.PostRepository,
+	timelineRepository repository.TimelineRepository,
+	relationshipRepository aggregaterepository.UserRelationshipRepository,
+	txManager shared.TransactionManager,
+) RepostUseCase {
+	return &repostUseCaseImpl{
+		tracer:                 otel.Tracer("RepostUseCase"),
+		logger:                 common.NewLogger(),
+		postRepository:         postRepository,
+		timelineRepository:     timelineRepository,
+		relationshipRepository: relationshipRepository,
+		txManager:              txManager,
+	}
+}
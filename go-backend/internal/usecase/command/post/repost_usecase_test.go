@@ -0,0 +1,125 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRepostUseCase_HappyCase(t *testing.T) {
+	// Reposting a post again is not an error, and does not reach the timelines again.
+	for name, reposted := range map[string]bool{"new repost": true, "already reposted": false} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID := uuid.New()
+			original := newSharedPost(uuid.New(), vo.PostKindPost, vo.PostVisibilityPublic)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), original.ID()).Return(original, nil).Times(1)
+			postRepository.EXPECT().CreateRepost(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, p entity.Post) (bool, error) {
+					assert.Equal(t, actorID, p.UserID())
+					assert.Equal(t, vo.PostKindRepost, p.Kind())
+					assert.Equal(t, original.ID(), *p.OriginalID())
+
+					return reposted, nil
+				}).Times(1)
+
+			timelineRepository := mock_repository.NewMockTimelineRepository(ctrl)
+			if reposted {
+				timelineRepository.EXPECT().FanOut(gomock.Any(), gomock.Any(), 1000).Return(0, nil).Times(1)
+			}
+
+			uc := post.NewRepostUseCase(
+				postRepository, timelineRepository, relationshipsBlocking(ctrl), mock_shared.NewMockTransactionManager(nil),
+			)
+			err := uc.Execute(context.Background(), post.RepostInput{ActorID: actorID, PostID: original.ID()})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRepostUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+	actorID := uuid.New()
+	public := newSharedPost(uuid.New(), vo.PostKindPost, vo.PostVisibilityPublic)
+
+	tests := []struct {
+		name      string
+		original  entity.Post
+		findErr   error
+		blocked   bool
+		createErr error
+		txErr     error
+		wantErr   error
+		wantCode  vo.ErrorCode
+	}{
+		{name: "post not found", original: public, findErr: repository.ErrPostNotFound, wantCode: vo.NotFoundErrorCode},
+		{
+			name:     "private post of another user",
+			original: newSharedPost(uuid.New(), vo.PostKindPost, vo.PostVisibilityPrivate),
+			wantCode: vo.NotFoundErrorCode,
+		},
+		{
+			name:     "followers-only post",
+			original: newSharedPost(uuid.New(), vo.PostKindPost, vo.PostVisibilityFollowers),
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:     "repost",
+			original: newSharedPost(uuid.New(), vo.PostKindRepost, vo.PostVisibilityPublic),
+			wantCode: vo.ValidationErrorCode,
+		},
+		{name: "author is blocked", original: public, blocked: true, wantCode: vo.NotFoundErrorCode},
+		{name: "create fails", original: public, createErr: errDB, wantErr: errDB},
+		{name: "transaction fails", original: public, txErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), tt.original.ID()).Return(tt.original, tt.findErr).AnyTimes()
+			postRepository.EXPECT().CreateRepost(gomock.Any(), gomock.Any()).Return(true, tt.createErr).AnyTimes()
+
+			timelineRepository := mock_repository.NewMockTimelineRepository(ctrl)
+			timelineRepository.EXPECT().FanOut(gomock.Any(), gomock.Any(), gomock.Any()).Return(0, nil).AnyTimes()
+
+			var blockedIDs []uuid.UUID
+			if tt.blocked {
+				blockedIDs = append(blockedIDs, tt.original.UserID())
+			}
+
+			uc := post.NewRepostUseCase(
+				postRepository, timelineRepository, relationshipsBlocking(ctrl, blockedIDs...),
+				mock_shared.NewMockTransactionManager(tt.txErr),
+			)
+			err := uc.Execute(context.Background(), post.RepostInput{ActorID: actorID, PostID: tt.original.ID()})
+
+			require.Error(t, err)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RestorePostUseCase takes a post out of the trash before entity.PostTrashRetention runs out. Authors may
+// restore the posts they trashed themselves; a post trashed by a moderator takes a moderator to restore.
+type RestorePostUseCase interface {
+	Execute(ctx context.Context, input RestorePostInput) (*RestorePostOutput, error)
+}
+
+type RestorePostInput struct {
+	ActorID uuid.UUID
+	PostID  uuid.UUID
+}
+
+type RestorePostOutput struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	// Content is the source of the post in Format; ContentHTML is its sanitised rendering.
+	Content       string
+	Format        vo.ContentFormat
+	ContentHTML   string
+	Visibility    vo.PostVisibility
+	Status        vo.PostStatus
+	PublishAt     *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	RevisionCount int
+}
+
+type restorePostUseCaseImpl struct {
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	postRepository         repository.PostRepository
+	postRevisionRepository repository.PostRevisionRepository
+	permissionRepository   aggregaterepository.UserPermissionRepository
+	contentRenderer        service.ContentRenderer
+	txManager              shared.TransactionManager
+}
+
+func (uc *restorePostUseCaseImpl) Execute(ctx context.Context, input RestorePostInput) (*RestorePostOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	var (
+		post          entity.Post
+		revisionCount int
+	)
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var txErr error
+
+		post, txErr = uc.postRepository.FindTrashedByID(ctx, input.PostID)
+		if errors.Is(txErr, repository.ErrPostNotFound) {
+			return vo.NewNotFoundError("post not found in the trash", nil, txErr)
+		}
+
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = authorizeRestore(ctx, uc.permissionRepository, input.ActorID, post); txErr != nil {
+			return txErr
+		}
+
+		if txErr = post.Restore(time.Now()); txErr != nil {
+			return txErr
+		}
+
+		if txErr = uc.postRepository.Restore(ctx, post); txErr != nil {
+			if errors.Is(txErr, repository.ErrPostNotFound) {
+				return vo.NewNotFoundError("post not found in the trash", nil, txErr)
+			}
+
+			if errors.Is(txErr, repository.ErrDuplicateRepost) {
+				return vo.NewValidationError("the original post has been reposted again since", map[string]any{
+					"post_id": post.ID().String(),
+				}, txErr)
+			}
+
+			uc.logger.Error(ctx, "failed to restore Post", "error", txErr)
+
+			return txErr
+		}
+
+		revisionCount, txErr = uc.postRevisionRepository.CountByPostID(ctx, post.ID())
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	uc.logger.Info(ctx, "post restored from the trash", "postID", post.ID(), "actorID", input.ActorID)
+
+	return &RestorePostOutput{
+		ID:            post.ID(),
+		UserID:        post.UserID(),
+		Content:       post.Content(),
+		Format:        post.Format(),
+		ContentHTML:   uc.contentRenderer.Render(post.ID(), revisionCount, post.Format(), post.Content()),
+		Visibility:    post.Visibility(),
+		Status:        post.Status(),
+		PublishAt:     post.PublishAt(),
+		CreatedAt:     post.CreatedAt(),
+		UpdatedAt:     post.UpdatedAt(),
+		RevisionCount: revisionCount,
+	}, nil
+}
+
+func NewRestorePostUseCase(
+	postRepository repository.PostRepository,
+	postRevisionRepository repository.PostRevisionRepository,
+	permissionRepository aggregaterepository.UserPermissionRepository,
+	contentRenderer service.ContentRenderer,
+	txManager shared.TransactionManager,
+) RestorePostUseCase {
+	return &restorePostUseCaseImpl{
+		tracer:                 otel.Tracer("RestorePostUseCase"),
+		logger:                 common.NewLogger(),
+		postRepository:         postRepository,
+		postRevisionRepository: postRevisionRepository,
+		permissionRepository:   permissionRepository,
+		contentRenderer:        contentRenderer,
+		txManager:              txManager,
+	}
+}
@@ -0,0 +1,188 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_aggregate_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/aggregate/repository"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTrashedPost(authorID, deletedBy uuid.UUID, deletedAt time.Time) entity.Post {
+	return entity.ReconstructPost(
+		uuid.New(),
+		authorID,
+		"content",
+		vo.ContentFormatPlain,
+		vo.PostKindPost,
+		nil,
+		vo.PostVisibilityPublic,
+		vo.PostStatusPublished,
+		nil,
+		nil,
+		&deletedAt,
+		&deletedBy,
+		deletedAt.Add(-time.Hour),
+		deletedAt,
+	)
+}
+
+func TestRestorePostUseCase_HappyCase(t *testing.T) {
+	authorID, moderatorID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name      string
+		actorID   uuid.UUID
+		deletedBy uuid.UUID
+	}{
+		{name: "author restores a post they trashed", actorID: authorID, deletedBy: authorID},
+		{name: "moderator restores a post a moderator trashed", actorID: moderatorID, deletedBy: moderatorID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			trashed := newTrashedPost(authorID, tt.deletedBy, time.Now().Add(-24*time.Hour))
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindTrashedByID(gomock.Any(), trashed.ID()).Return(trashed, nil).Times(1)
+			postRepository.EXPECT().Restore(gomock.Any(), trashed).DoAndReturn(
+				func(_ context.Context, p entity.Post) error {
+					assert.Nil(t, p.DeletedAt())
+					assert.Nil(t, p.DeletedBy())
+
+					return nil
+				}).Times(1)
+
+			postRevisionRepository := mock_repository.NewMockPostRevisionRepository(ctrl)
+			postRevisionRepository.EXPECT().CountByPostID(gomock.Any(), trashed.ID()).Return(1, nil).Times(1)
+
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			if tt.actorID != authorID {
+				permRepo.EXPECT().FindByUserID(gomock.Any(), tt.actorID).
+					Return(newPermissions(tt.actorID, vo.PermissionPostsModerate), nil).Times(1)
+			}
+
+			uc := post.NewRestorePostUseCase(
+				postRepository, postRevisionRepository, permRepo, renderContent(ctrl), mock_shared.NewMockTransactionManager(nil),
+			)
+			output, err := uc.Execute(context.Background(), post.RestorePostInput{
+				ActorID: tt.actorID,
+				PostID:  trashed.ID(),
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, trashed.ID(), output.ID)
+			assert.Equal(t, authorID, output.UserID)
+			assert.Equal(t, 1, output.RevisionCount)
+		})
+	}
+}
+
+func TestRestorePostUseCase_FailureCase(t *testing.T) {
+	authorID, moderatorID := uuid.New(), uuid.New()
+	errDB := errors.New("db error")
+	recently := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name       string
+		actorID    uuid.UUID
+		deletedBy  uuid.UUID
+		deletedAt  time.Time
+		findErr    error
+		restoreErr error
+		txErr      error
+		wantErr    error
+		wantCode   vo.ErrorCode
+	}{
+		{
+			name: "post is not in the trash", actorID: authorID, deletedBy: authorID, deletedAt: recently,
+			findErr: repository.ErrPostNotFound, wantCode: vo.NotFoundErrorCode,
+		},
+		{
+			name: "author restores a post a moderator trashed", actorID: authorID, deletedBy: moderatorID,
+			deletedAt: recently, wantCode: vo.ForbiddenErrorCode,
+		},
+		{
+			name: "another user", actorID: uuid.New(), deletedBy: authorID, deletedAt: recently,
+			wantCode: vo.ForbiddenErrorCode,
+		},
+		{
+			name: "retention has run out", actorID: authorID, deletedBy: authorID,
+			deletedAt: time.Now().Add(-entity.PostTrashRetention - time.Hour), wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name: "post purged concurrently", actorID: authorID, deletedBy: authorID, deletedAt: recently,
+			restoreErr: repository.ErrPostNotFound, wantCode: vo.NotFoundErrorCode,
+		},
+		{
+			name: "repost reposted again since", actorID: authorID, deletedBy: authorID, deletedAt: recently,
+			restoreErr: repository.ErrDuplicateRepost, wantCode: vo.ValidationErrorCode,
+		},
+		{name: "find fails", actorID: authorID, deletedBy: authorID, deletedAt: recently, findErr: errDB, wantErr: errDB},
+		{
+			name: "restore fails", actorID: authorID, deletedBy: authorID, deletedAt: recently,
+			restoreErr: errDB, wantErr: errDB,
+		},
+		{
+			name: "transaction fails", actorID: authorID, deletedBy: authorID, deletedAt: recently,
+			txErr: errDB, wantErr: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			trashed := newTrashedPost(authorID, tt.deletedBy, tt.deletedAt)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			if tt.findErr != nil {
+				postRepository.EXPECT().FindTrashedByID(gomock.Any(), gomock.Any()).Return(nil, tt.findErr).AnyTimes()
+			} else {
+				postRepository.EXPECT().FindTrashedByID(gomock.Any(), gomock.Any()).Return(trashed, nil).AnyTimes()
+			}
+
+			postRepository.EXPECT().Restore(gomock.Any(), gomock.Any()).Return(tt.restoreErr).AnyTimes()
+
+			postRevisionRepository := mock_repository.NewMockPostRevisionRepository(ctrl)
+			postRevisionRepository.EXPECT().CountByPostID(gomock.Any(), gomock.Any()).Return(1, nil).AnyTimes()
+
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			permRepo.EXPECT().FindByUserID(gomock.Any(), gomock.Any()).
+				Return(newPermissions(tt.actorID, vo.PermissionUsersList), nil).AnyTimes()
+
+			uc := post.NewRestorePostUseCase(
+				postRepository, postRevisionRepository, permRepo, renderContent(ctrl),
+				mock_shared.NewMockTransactionManager(tt.txErr),
+			)
+			output, err := uc.Execute(context.Background(), post.RestorePostInput{
+				ActorID: tt.actorID,
+				PostID:  trashed.ID(),
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// orphanedAttachmentMinAge leaves recent uploads alone so that clients can still attach them.
+	orphanedAttachmentMinAge = 24 * time.Hour
+	orphanedAttachmentBatch  = 100
+)
+
+// SweepOrphanedAttachmentsUseCase deletes attachments that have no post, together with their blobs, once
+// they are older than a day: uploads that were never attached and attachments of deleted posts. It visits
+// every organization in turn.
+type SweepOrphanedAttachmentsUseCase interface {
+	Execute(ctx context.Context) (*SweepOrphanedAttachmentsOutput, error)
+}
+
+type SweepOrphanedAttachmentsOutput struct {
+	Removed int
+}
+
+type sweepOrphanedAttachmentsUseCaseImpl struct {
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	organizationRepository repository.OrganizationRepository
+	attachmentRepository   repository.AttachmentRepository
+	blobStorage            service.BlobStorage
+	txManager              shared.TransactionManager
+}
+
+func (uc *sweepOrphanedAttachmentsUseCaseImpl) Execute(ctx context.Context) (*SweepOrphanedAttachmentsOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	organizationIDs, err := uc.organizationRepository.FindAllIDs(ctx)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to list organizations", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	removed := 0
+
+	// One failing organization does not keep the others from being swept.
+	var errs []error
+
+	for _, organizationID := range organizationIDs {
+		n, sweepErr := uc.sweepTenant(common.WithTenantID(ctx, organizationID.String()), time.Now().Add(-orphanedAttachmentMinAge))
+		removed += n
+
+		if sweepErr != nil {
+			uc.logger.Error(ctx, "failed to sweep orphaned attachments", "organizationID", organizationID,
+				"error", sweepErr)
+			errs = append(errs, sweepErr)
+		}
+	}
+
+	if removed > 0 {
+		uc.logger.Info(ctx, "orphaned attachments removed", "count", removed)
+	}
+
+	if err = errors.Join(errs...); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return &SweepOrphanedAttachmentsOutput{Removed: removed}, nil
+}
+
+// sweepTenant removes the orphans of the organization active in ctx batch by batch. An attachment whose
+// blobs cannot be deleted is kept, so that the next sweep tries again.
+func (uc *sweepOrphanedAttachmentsUseCaseImpl) sweepTenant(ctx context.Context, uploadedBefore time.Time) (int, error) {
+	removed := 0
+
+	for {
+		orphans, err := uc.attachmentRepository.FindOrphans(ctx, uploadedBefore, orphanedAttachmentBatch)
+		if err != nil {
+			return removed, err
+		}
+
+		ids := make([]uuid.UUID, 0, len(orphans))
+
+		var errs []error
+
+		for _, orphan := range orphans {
+			if err = deleteAttachmentBlobs(ctx, uc.blobStorage, orphan); err != nil {
+				errs = append(errs, err)
+
+				continue
+			}
+
+			ids = append(ids, orphan.ID())
+		}
+
+		if len(ids) > 0 {
+			err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+				return uc.attachmentRepository.Delete(ctx, ids)
+			})
+			if err != nil {
+				return removed, err
+			}
+
+			removed += len(ids)
+		}
+
+		// Stop at the last batch, or when nothing could be deleted and the next batch would be the same.
+		if len(orphans) < orphanedAttachmentBatch || len(errs) > 0 {
+			return removed, errors.Join(errs...)
+		}
+	}
+}
+
+func NewSweepOrphanedAttachmentsUseCase(
+	organizationRepository repository.OrganizationRepository,
+	attachmentRepository repository.AttachmentRepository,
+	blobStorage service.BlobStorage,
+	txManager shared.TransactionManager,
+) SweepOrphanedAttachmentsUseCase {
+	return &sweepOrphanedAttachmentsUseCaseImpl{
+		tracer:                 otel.Tracer("SweepOrphanedAttachmentsUseCase"),
+		logger:                 common.NewLogger(),
+		organizationRepository: organizationRepository,
+		attachmentRepository:   attachmentRepository,
+		blobStorage:            blobStorage,
+		txManager:              txManager,
+	}
+}
@@ -0,0 +1,124 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_service "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/service"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSweepOrphanedAttachmentsUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	orgA, orgB := uuid.New(), uuid.New()
+	orphanA := newTestAttachment(uuid.New(), nil)
+	orphanB := newTestAttachment(uuid.New(), nil)
+
+	organizationRepository := mock_repository.NewMockOrganizationRepository(ctrl)
+	organizationRepository.EXPECT().FindAllIDs(gomock.Any()).Return([]uuid.UUID{orgA, orgB}, nil)
+
+	// Each organization is swept in its own tenant context, leaving the last day's uploads alone.
+	orphans := map[string][]entity.Attachment{orgA.String(): {orphanA}, orgB.String(): {orphanB}}
+	attachmentRepository := mock_repository.NewMockAttachmentRepository(ctrl)
+	attachmentRepository.EXPECT().FindOrphans(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, uploadedBefore time.Time, _ int) ([]entity.Attachment, error) {
+			assert.WithinDuration(t, time.Now().Add(-24*time.Hour), uploadedBefore, time.Minute)
+
+			return orphans[common.TenantIDFromContext(ctx)], nil
+		}).Times(2)
+	attachmentRepository.EXPECT().Delete(gomock.Any(), []uuid.UUID{orphanA.ID()}).Return(nil)
+	attachmentRepository.EXPECT().Delete(gomock.Any(), []uuid.UUID{orphanB.ID()}).Return(nil)
+
+	blobStorage := mock_service.NewMockBlobStorage(ctrl)
+	for _, orphan := range []entity.Attachment{orphanA, orphanB} {
+		blobStorage.EXPECT().Delete(gomock.Any(), orphan.StorageKey()).Return(nil)
+		blobStorage.EXPECT().Delete(gomock.Any(), orphan.ThumbnailKey()).Return(nil)
+	}
+
+	uc := post.NewSweepOrphanedAttachmentsUseCase(
+		organizationRepository, attachmentRepository, blobStorage, mock_shared.NewMockTransactionManager(nil),
+	)
+	output, err := uc.Execute(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, output.Removed)
+}
+
+func TestSweepOrphanedAttachmentsUseCase_KeepsAttachmentsWhoseBlobsRemain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	errStorage := errors.New("storage error")
+	kept := newTestAttachment(uuid.New(), nil)
+	removed := newTestAttachment(uuid.New(), nil)
+
+	organizationRepository := mock_repository.NewMockOrganizationRepository(ctrl)
+	organizationRepository.EXPECT().FindAllIDs(gomock.Any()).Return([]uuid.UUID{uuid.New()}, nil)
+
+	attachmentRepository := mock_repository.NewMockAttachmentRepository(ctrl)
+	attachmentRepository.EXPECT().FindOrphans(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]entity.Attachment{kept, removed}, nil)
+	attachmentRepository.EXPECT().Delete(gomock.Any(), []uuid.UUID{removed.ID()}).Return(nil)
+
+	blobStorage := mock_service.NewMockBlobStorage(ctrl)
+	blobStorage.EXPECT().Delete(gomock.Any(), kept.StorageKey()).Return(errStorage)
+	blobStorage.EXPECT().Delete(gomock.Any(), kept.ThumbnailKey()).Return(nil)
+	blobStorage.EXPECT().Delete(gomock.Any(), removed.StorageKey()).Return(nil)
+	blobStorage.EXPECT().Delete(gomock.Any(), removed.ThumbnailKey()).Return(nil)
+
+	uc := post.NewSweepOrphanedAttachmentsUseCase(
+		organizationRepository, attachmentRepository, blobStorage, mock_shared.NewMockTransactionManager(nil),
+	)
+	output, err := uc.Execute(context.Background())
+
+	require.ErrorIs(t, err, errStorage)
+	assert.Nil(t, output)
+}
+
+func TestSweepOrphanedAttachmentsUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name      string
+		listErr   error
+		findErr   error
+		deleteErr error
+	}{
+		{name: "listing organizations fails", listErr: errDB},
+		{name: "finding orphans fails", findErr: errDB},
+		{name: "deleting orphans fails", deleteErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			organizationRepository := mock_repository.NewMockOrganizationRepository(ctrl)
+			organizationRepository.EXPECT().FindAllIDs(gomock.Any()).Return([]uuid.UUID{uuid.New()}, tt.listErr)
+
+			attachmentRepository := mock_repository.NewMockAttachmentRepository(ctrl)
+			attachmentRepository.EXPECT().FindOrphans(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]entity.Attachment{newTestAttachment(uuid.New(), nil)}, tt.findErr).AnyTimes()
+			attachmentRepository.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(tt.deleteErr).AnyTimes()
+
+			blobStorage := mock_service.NewMockBlobStorage(ctrl)
+			blobStorage.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			uc := post.NewSweepOrphanedAttachmentsUseCase(
+				organizationRepository, attachmentRepository, blobStorage, mock_shared.NewMockTransactionManager(nil),
+			)
+			output, err := uc.Execute(context.Background())
+
+			require.ErrorIs(t, err, errDB)
+			assert.Nil(t, output)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// UnpinPostUseCase removes a post from the actor's profile, also when the post was trashed or hidden since.
+// Unpinning a post that is not pinned succeeds without changing anything.
+type UnpinPostUseCase interface {
+	Execute(ctx context.Context, input UnpinPostInput) error
+}
+
+type UnpinPostInput struct {
+	ActorID uuid.UUID
+	PostID  uuid.UUID
+}
+
+type unpinPostUseCaseImpl struct {
+	tracer               trace.Tracer
+	logger               common.Logger
+	pinnedPostRepository repository.PinnedPostRepository
+	txManager            shared.TransactionManager
+}
+
+func (uc *unpinPostUseCaseImpl) Execute(ctx context.Context, input UnpinPostInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	var unpinned bool
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var txErr error
+
+		unpinned, txErr = uc.pinnedPostRepository.Unpin(ctx, input.ActorID, input.PostID)
+		if txErr != nil {
+			uc.logger.Error(ctx, "failed to delete pinned post", "error", txErr)
+		}
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "post unpinned", "postID", input.PostID, "actorID", input.ActorID, "changed", unpinned)
+
+	return nil
+}
+
+func NewUnpinPostUseCase(
+	pinnedPostRepository repository.PinnedPostRepository,
+	txManager shared.TransactionManager,
+) UnpinPostUseCase {
+	return &unpinPostUseCaseImpl{
+		tracer:               otel.Tracer("UnpinPostUseCase"),
+		logger:               common.NewLogger(),
+		pinnedPostRepository: pinnedPostRepository,
+		txManager:            txManager,
+	}
+}
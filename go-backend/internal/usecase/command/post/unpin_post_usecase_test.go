@@ -0,0 +1,61 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnpinPostUseCase_HappyCase(t *testing.T) {
+	// Unpinning a post that is not pinned is not an error.
+	for name, unpinned := range map[string]bool{"pinned post": true, "post not pinned": false} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID, postID := uuid.New(), uuid.New()
+
+			pinnedPostRepository := mock_repository.NewMockPinnedPostRepository(ctrl)
+			pinnedPostRepository.EXPECT().Unpin(gomock.Any(), actorID, postID).Return(unpinned, nil).Times(1)
+
+			uc := post.NewUnpinPostUseCase(pinnedPostRepository, mock_shared.NewMockTransactionManager(nil))
+			err := uc.Execute(context.Background(), post.UnpinPostInput{ActorID: actorID, PostID: postID})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUnpinPostUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name      string
+		deleteErr error
+		txErr     error
+	}{
+		{name: "delete fails", deleteErr: errDB},
+		{name: "transaction fails", txErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			pinnedPostRepository := mock_repository.NewMockPinnedPostRepository(ctrl)
+			pinnedPostRepository.EXPECT().Unpin(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(false, tt.deleteErr).AnyTimes()
+
+			uc := post.NewUnpinPostUseCase(pinnedPostRepository, mock_shared.NewMockTransactionManager(tt.txErr))
+			err := uc.Execute(context.Background(), post.UnpinPostInput{ActorID: uuid.New(), PostID: uuid.New()})
+
+			assert.ErrorIs(t, err, errDB)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// UpdatePostUseCase replaces the content of a post; only its author or a moderator may do so.
+// Every change of content or format is recorded as a new revision in the same transaction. Edited content
+// goes through the content filters like a new post: rejected content is a validation problem and flagged
+// content is saved and reported to the moderators.
+type UpdatePostUseCase interface {
+	Execute(ctx context.Context, input UpdatePostInput) (*UpdatePostOutput, error)
+}
+
+type UpdatePostInput struct {
+	ActorID uuid.UUID
+	PostID  uuid.UUID
+	Content string
+	// Format is "plain" or "markdown"; empty keeps the current format.
+	Format string
+}
+
+type UpdatePostOutput struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	// Content is the source of the post in Format; ContentHTML is its sanitised rendering.
+	Content     string
+	Format      vo.ContentFormat
+	ContentHTML string
+	Visibility  vo.PostVisibility
+	Status      vo.PostStatus
+	PublishAt   *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	// RevisionCount includes the original content, so an edited post has more than one revision.
+	RevisionCount int
+}
+
+type updatePostUseCaseImpl struct {
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	postRepository         repository.PostRepository
+	postRevisionRepository repository.PostRevisionRepository
+	postEntityRepository   repository.PostEntityRepository
+	permissionRepository   aggregaterepository.UserPermissionRepository
+	postReportRepository   repository.PostReportRepository
+	contentFilter          service.ContentFilter
+	contentRenderer        service.ContentRenderer
+	txManager              shared.TransactionManager
+}
+
+func (uc *updatePostUseCaseImpl) Execute(ctx context.Context, input UpdatePostInput) (*UpdatePostOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	var (
+		post          entity.Post
+		revisionCount int
+	)
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var txErr error
+
+		post, txErr = findPost(ctx, uc.postRepository, input.PostID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = authorizeModification(ctx, uc.permissionRepository, input.ActorID, post); txErr != nil {
+			return txErr
+		}
+
+		previousContent, previousFormat := post.Content(), post.Format()
+		if txErr = post.Edit(input.Content, input.Format, time.Now()); txErr != nil {
+			return txErr
+		}
+
+		// Saving identical content in the same format is a no-op and does not produce a revision.
+		if post.Content() == previousContent && post.Format() == previousFormat {
+			revisionCount, txErr = uc.postRevisionRepository.CountByPostID(ctx, post.ID())
+
+			return txErr
+		}
+
+		verdict, txErr := filterContent(ctx, uc.logger, uc.contentFilter, post)
+		if txErr != nil {
+			return txErr
+		}
+
+		revisionCount, txErr = uc.saveEdit(ctx, post, input.ActorID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if verdict.Action == service.ContentFlag {
+			txErr = flagForReview(ctx, uc.logger, uc.postReportRepository, post, verdict, post.UpdatedAt())
+			if txErr != nil {
+				uc.logger.Error(ctx, "failed to report flagged Post", "error", txErr)
+
+				return txErr
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	uc.logger.Info(ctx, "post updated", "postID", post.ID(), "actorID", input.ActorID)
+
+	return &UpdatePostOutput{
+		ID:            post.ID(),
+		UserID:        post.UserID(),
+		Content:       post.Content(),
+		Format:        post.Format(),
+		ContentHTML:   uc.contentRenderer.Render(post.ID(), revisionCount, post.Format(), post.Content()),
+		Visibility:    post.Visibility(),
+		Status:        post.Status(),
+		PublishAt:     post.PublishAt(),
+		CreatedAt:     post.CreatedAt(),
+		UpdatedAt:     post.UpdatedAt(),
+		RevisionCount: revisionCount,
+	}, nil
+}
+
+// saveEdit stores the edited post together with its new revision and content entities, and returns the
+// number of the revision.
+func (uc *updatePostUseCaseImpl) saveEdit(ctx context.Context, post entity.Post, actorID uuid.UUID) (int, error) {
+	if err := uc.postRepository.Update(ctx, post); err != nil {
+		if errors.Is(err, repository.ErrPostNotFound) {
+			return 0, vo.NewNotFoundError("post not found", nil, err)
+		}
+
+		uc.logger.Error(ctx, "failed to update Post", "error", err)
+
+		return 0, err
+	}
+
+	revision, err := entity.NewPostRevision(post, actorID)
+	if err != nil {
+		return 0, err
+	}
+
+	revision, err = uc.postRevisionRepository.Create(ctx, revision)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to save PostRevision", "error", err)
+
+		return 0, err
+	}
+
+	if err = uc.postEntityRepository.Replace(ctx, post, vo.ParseContentEntities(post.Content())); err != nil {
+		uc.logger.Error(ctx, "failed to save Post entities", "error", err)
+
+		return 0, err
+	}
+
+	return revision.Number(), nil
+}
+
+func NewUpdatePostUseCase(
+	postRepository repository.PostRepository,
+	postRevisionRepository repository.PostRevisionRepository,
+	postEntityRepository repository.PostEntityRepository,
+	permissionRepository aggregaterepository.UserPermissionRepository,
+	postReportRepository repository.PostReportRepository,
+	contentFilter service.ContentFilter,
+	contentRenderer service.ContentRenderer,
+	txManager shared.TransactionManager,
+) UpdatePostUseCase {
+	return &updatePostUseCaseImpl{
+		tracer:                 otel.Tracer("UpdatePostUseCase"),
+		logger:                 common.NewLogger(),
+		postRepository:         postRepository,
+		postRevisionRepository: postRevisionRepository,
+		postEntityRepository:   postEntityRepository,
+		permissionRepository:   permissionRepository,
+		postReportRepository:   postReportRepository,
+		contentFilter:          contentFilter,
+		contentRenderer:        contentRenderer,
+		txManager:              txManager,
+	}
+}
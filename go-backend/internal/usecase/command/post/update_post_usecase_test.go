@@ -0,0 +1,576 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	mock_aggregate_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/aggregate/repository"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_service "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/service"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newPermissions(userID uuid.UUID, perms ...vo.Permission) *aggregate.UserPermissionAggregate {
+	return &aggregate.UserPermissionAggregate{UserID: userID, Permissions: perms}
+}
+
+func TestUpdatePostUseCase_HappyCase(t *testing.T) {
+	authorID, moderatorID := uuid.New(), uuid.New()
+	createdAt := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		actorID uuid.UUID
+		perms   []vo.Permission
+	}{
+		{name: "author edits own post", actorID: authorID},
+		{
+			name:    "moderator edits another user's post",
+			actorID: moderatorID,
+			perms:   []vo.Permission{vo.PermissionPostsModerate},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			existing := entity.ReconstructPost(
+				uuid.New(),
+				authorID,
+				"typo",
+				vo.ContentFormatPlain,
+				vo.PostKindPost,
+				nil,
+				vo.PostVisibilityPublic,
+				vo.PostStatusPublished,
+				nil,
+				nil,
+				nil,
+				nil,
+				createdAt,
+				createdAt,
+			)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), existing.ID()).Return(existing, nil).Times(1)
+			postRepository.EXPECT().Update(gomock.Any(), existing).Return(nil).Times(1)
+
+			// The edit is recorded as revision 2, attributed to whoever made it.
+			revisionRepository := mock_repository.NewMockPostRevisionRepository(ctrl)
+			revisionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, r entity.PostRevision) (entity.PostRevision, error) {
+					assert.Equal(t, existing.ID(), r.PostID())
+					assert.Equal(t, "fixed #typo", r.Content())
+					assert.Equal(t, tt.actorID, r.EditorID())
+
+					return entity.ReconstructPostRevision(
+						r.ID(), r.PostID(), 2, r.Content(), r.Format(), r.EditorID(), r.CreatedAt(),
+					), nil
+				}).Times(1)
+
+			// The hashtags and mentions of the new content replace those of the old.
+			postEntityRepository := mock_repository.NewMockPostEntityRepository(ctrl)
+			postEntityRepository.EXPECT().Replace(gomock.Any(), existing, vo.ParseContentEntities("fixed #typo")).
+				Return(nil).Times(1)
+
+			// The author's own edits need no permission lookup.
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			if tt.actorID != authorID {
+				permRepo.EXPECT().FindByUserID(gomock.Any(), tt.actorID).
+					Return(newPermissions(tt.actorID, tt.perms...), nil).Times(1)
+			}
+
+			uc := post.NewUpdatePostUseCase(
+				postRepository, revisionRepository, postEntityRepository, permRepo,
+				mock_repository.NewMockPostReportRepository(ctrl), acceptContent(ctrl),
+				renderContent(ctrl), mock_shared.NewMockTransactionManager(nil),
+			)
+			output, err := uc.Execute(context.Background(), post.UpdatePostInput{
+				ActorID: tt.actorID,
+				PostID:  existing.ID(),
+				Content: " fixed #typo ",
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, existing.ID(), output.ID)
+			assert.Equal(t, authorID, output.UserID)
+			assert.Equal(t, "fixed #typo", output.Content)
+			assert.Equal(t, "plain@2:fixed #typo", output.ContentHTML)
+			assert.Equal(t, createdAt, output.CreatedAt)
+			assert.True(t, output.UpdatedAt.After(createdAt))
+			assert.Equal(t, 2, output.RevisionCount)
+		})
+	}
+}
+
+func TestUpdatePostUseCase_UnchangedContent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authorID := uuid.New()
+	createdAt := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+	existing := entity.ReconstructPost(
+		uuid.New(),
+		authorID,
+		"same",
+		vo.ContentFormatPlain,
+		vo.PostKindPost,
+		nil,
+		vo.PostVisibilityPublic,
+		vo.PostStatusPublished,
+		nil,
+		nil,
+		nil,
+		nil,
+		createdAt,
+		createdAt,
+	)
+
+	// Neither the post nor its history is written when the content does not change, and the unchanged
+	// content is not filtered again.
+	postRepository := mock_repository.NewMockPostRepository(ctrl)
+	postRepository.EXPECT().FindByID(gomock.Any(), existing.ID()).Return(existing, nil).Times(1)
+
+	revisionRepository := mock_repository.NewMockPostRevisionRepository(ctrl)
+	revisionRepository.EXPECT().CountByPostID(gomock.Any(), existing.ID()).Return(3, nil).Times(1)
+
+	uc := post.NewUpdatePostUseCase(
+		postRepository,
+		revisionRepository,
+		mock_repository.NewMockPostEntityRepository(ctrl),
+		mock_aggregate_repository.NewMockUserPermissionRepository(ctrl),
+		mock_repository.NewMockPostReportRepository(ctrl),
+		mock_service.NewMockContentFilter(ctrl),
+		renderContent(ctrl),
+		mock_shared.NewMockTransactionManager(nil),
+	)
+	output, err := uc.Execute(context.Background(), post.UpdatePostInput{
+		ActorID: authorID,
+		PostID:  existing.ID(),
+		Content: "same ",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "same", output.Content)
+	assert.Equal(t, createdAt, output.UpdatedAt)
+	assert.Equal(t, 3, output.RevisionCount)
+	assert.Equal(t, "plain@3:same", output.ContentHTML)
+}
+
+func TestUpdatePostUseCase_FormatChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authorID := uuid.New()
+	createdAt := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+	existing := entity.ReconstructPost(
+		uuid.New(),
+		authorID,
+		"**same**",
+		vo.ContentFormatPlain,
+		vo.PostKindPost,
+		nil,
+		vo.PostVisibilityPublic,
+		vo.PostStatusPublished,
+		nil,
+		nil,
+		nil,
+		nil,
+		createdAt,
+		createdAt,
+	)
+
+	// The same content in another format renders differently, so it is a new revision.
+	postRepository := mock_repository.NewMockPostRepository(ctrl)
+	postRepository.EXPECT().FindByID(gomock.Any(), existing.ID()).Return(existing, nil).Times(1)
+	postRepository.EXPECT().Update(gomock.Any(), existing).Return(nil).Times(1)
+
+	revisionRepository := mock_repository.NewMockPostRevisionRepository(ctrl)
+	revisionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r entity.PostRevision) (entity.PostRevision, error) {
+			assert.Equal(t, vo.ContentFormatMarkdown, r.Format())
+
+			return entity.ReconstructPostRevision(
+				r.ID(), r.PostID(), 2, r.Content(), r.Format(), r.EditorID(), r.CreatedAt(),
+			), nil
+		}).Times(1)
+
+	postEntityRepository := mock_repository.NewMockPostEntityRepository(ctrl)
+	postEntityRepository.EXPECT().Replace(gomock.Any(), existing, gomock.Any()).Return(nil).Times(1)
+
+	uc := post.NewUpdatePostUseCase(
+		postRepository,
+		revisionRepository,
+		postEntityRepository,
+		mock_aggregate_repository.NewMockUserPermissionRepository(ctrl),
+		mock_repository.NewMockPostReportRepository(ctrl),
+		acceptContent(ctrl),
+		renderContent(ctrl),
+		mock_shared.NewMockTransactionManager(nil),
+	)
+	output, err := uc.Execute(context.Background(), post.UpdatePostInput{
+		ActorID: authorID,
+		PostID:  existing.ID(),
+		Content: "**same**",
+		Format:  "markdown",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, vo.ContentFormatMarkdown, output.Format)
+	assert.Equal(t, "markdown@2:**same**", output.ContentHTML)
+	assert.Equal(t, 2, output.RevisionCount)
+}
+
+func TestUpdatePostUseCase_FailureCase(t *testing.T) {
+	authorID, otherID := uuid.New(), uuid.New()
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name        string
+		actorID     uuid.UUID
+		content     string
+		findErr     error
+		permErr     error
+		updateErr   error
+		revisionErr error
+		entityErr   error
+		txErr       error
+		wantErr     error
+		wantCode    vo.ErrorCode
+	}{
+		{
+			name:     "post does not exist",
+			actorID:  authorID,
+			content:  "fixed",
+			findErr:  repository.ErrPostNotFound,
+			wantCode: vo.NotFoundErrorCode,
+		},
+		{
+			name:     "neither author nor moderator",
+			actorID:  otherID,
+			content:  "fixed",
+			wantCode: vo.ForbiddenErrorCode,
+		},
+		{
+			name:     "empty content",
+			actorID:  authorID,
+			content:  " ",
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:      "post deleted concurrently",
+			actorID:   authorID,
+			content:   "fixed",
+			updateErr: repository.ErrPostNotFound,
+			wantCode:  vo.NotFoundErrorCode,
+		},
+		{
+			name:    "permission lookup fails",
+			actorID: otherID,
+			content: "fixed",
+			permErr: errDB,
+			wantErr: errDB,
+		},
+		{
+			name:      "update fails",
+			actorID:   authorID,
+			content:   "fixed",
+			updateErr: errDB,
+			wantErr:   errDB,
+		},
+		{
+			name:        "revision cannot be stored",
+			actorID:     authorID,
+			content:     "fixed",
+			revisionErr: errDB,
+			wantErr:     errDB,
+		},
+		{
+			name:      "entities cannot be stored",
+			actorID:   authorID,
+			content:   "fixed",
+			entityErr: errDB,
+			wantErr:   errDB,
+		},
+		{
+			name:    "transaction fails",
+			actorID: authorID,
+			content: "fixed",
+			txErr:   errDB,
+			wantErr: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			createdAt := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+			existing := entity.ReconstructPost(
+				uuid.New(),
+				authorID,
+				"typo",
+				vo.ContentFormatPlain,
+				vo.PostKindPost,
+				nil,
+				vo.PostVisibilityPublic,
+				vo.PostStatusPublished,
+				nil,
+				nil,
+				nil,
+				nil,
+				createdAt,
+				createdAt,
+			)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			if tt.findErr != nil {
+				postRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(nil, tt.findErr).AnyTimes()
+			} else {
+				postRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(existing, nil).AnyTimes()
+			}
+
+			postRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(tt.updateErr).AnyTimes()
+
+			revisionRepository := mock_repository.NewMockPostRevisionRepository(ctrl)
+			revisionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, tt.revisionErr).AnyTimes()
+
+			postEntityRepository := mock_repository.NewMockPostEntityRepository(ctrl)
+			postEntityRepository.EXPECT().Replace(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.entityErr).AnyTimes()
+
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			if tt.permErr != nil {
+				permRepo.EXPECT().FindByUserID(gomock.Any(), gomock.Any()).Return(nil, tt.permErr).AnyTimes()
+			} else {
+				permRepo.EXPECT().FindByUserID(gomock.Any(), gomock.Any()).
+					Return(newPermissions(tt.actorID, vo.PermissionUsersList), nil).AnyTimes()
+			}
+
+			uc := post.NewUpdatePostUseCase(
+				postRepository, revisionRepository, postEntityRepository, permRepo,
+				mock_repository.NewMockPostReportRepository(ctrl), acceptContent(ctrl),
+				renderContent(ctrl), mock_shared.NewMockTransactionManager(tt.txErr),
+			)
+			output, err := uc.Execute(context.Background(), post.UpdatePostInput{
+				ActorID: tt.actorID,
+				PostID:  existing.ID(),
+				Content: tt.content,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
+
+func TestUpdatePostUseCase_ContentFilter(t *testing.T) {
+	authorID, moderatorID := uuid.New(), uuid.New()
+	filterErr := errors.New("filter error")
+
+	tests := []struct {
+		name     string
+		actorID  uuid.UUID
+		verdict  service.ContentVerdict
+		checkErr error
+		wantCode vo.ErrorCode
+		wantErr  error
+	}{
+		{
+			name:    "rejected content is a validation problem",
+			actorID: authorID,
+			verdict: service.ContentVerdict{
+				Action: service.ContentReject, Filter: "banned_words", Reason: "content contains a banned word",
+				Match: "spam",
+			},
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:    "moderators' edits are filtered as the author's content",
+			actorID: moderatorID,
+			verdict: service.ContentVerdict{
+				Action: service.ContentReject, Filter: "banned_words", Reason: "content contains a banned word",
+				Match: "spam",
+			},
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:     "filter failure",
+			actorID:  authorID,
+			checkErr: filterErr,
+			wantErr:  filterErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			createdAt := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+			existing := entity.ReconstructPost(
+				uuid.New(),
+				authorID,
+				"clean",
+				vo.ContentFormatPlain,
+				vo.PostKindPost,
+				nil,
+				vo.PostVisibilityPublic,
+				vo.PostStatusPublished,
+				nil,
+				nil,
+				nil,
+				nil,
+				createdAt,
+				createdAt,
+			)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), existing.ID()).Return(existing, nil).Times(1)
+
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			permRepo.EXPECT().FindByUserID(gomock.Any(), tt.actorID).
+				Return(newPermissions(tt.actorID, vo.PermissionPostsModerate), nil).AnyTimes()
+
+			contentFilter := mock_service.NewMockContentFilter(ctrl)
+			contentFilter.EXPECT().Check(gomock.Any(), authorID, "buy spam now").
+				Return(tt.verdict, tt.checkErr).Times(1)
+
+			// Nothing is written for content that is not accepted.
+			uc := post.NewUpdatePostUseCase(
+				postRepository, mock_repository.NewMockPostRevisionRepository(ctrl),
+				mock_repository.NewMockPostEntityRepository(ctrl), permRepo,
+				mock_repository.NewMockPostReportRepository(ctrl), contentFilter,
+				renderContent(ctrl), mock_shared.NewMockTransactionManager(nil),
+			)
+			output, err := uc.Execute(context.Background(), post.UpdatePostInput{
+				ActorID: tt.actorID,
+				PostID:  existing.ID(),
+				Content: "buy spam now",
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var domainErr vo.Error
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tt.wantCode, domainErr.Code())
+			assert.Equal(t, tt.verdict.Reason, domainErr.Message())
+			assert.Equal(t, map[string]any{"filter": "banned_words"}, domainErr.Details())
+		})
+	}
+}
+
+func TestUpdatePostUseCase_FlaggedContent(t *testing.T) {
+	reportErr := errors.New("report error")
+
+	tests := []struct {
+		name      string
+		reportErr error
+		wantErr   error
+	}{
+		{name: "flagged edit is saved and reported"},
+		{name: "report cannot be stored", reportErr: reportErr, wantErr: reportErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			authorID := uuid.New()
+			createdAt := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+			content := "see http://spam.example"
+			existing := entity.ReconstructPost(
+				uuid.New(),
+				authorID,
+				"clean",
+				vo.ContentFormatPlain,
+				vo.PostKindPost,
+				nil,
+				vo.PostVisibilityPublic,
+				vo.PostStatusPublished,
+				nil,
+				nil,
+				nil,
+				nil,
+				createdAt,
+				createdAt,
+			)
+
+			contentFilter := mock_service.NewMockContentFilter(ctrl)
+			contentFilter.EXPECT().Check(gomock.Any(), authorID, content).Return(service.ContentVerdict{
+				Action: service.ContentFlag, Filter: "blocked_domains", Reason: "content links to a blocked domain",
+				Match: "spam.example", ReportReason: vo.ReportReasonSpam,
+			}, nil).Times(1)
+
+			postRepository := mock_repository.NewMockPostRepository(ctrl)
+			postRepository.EXPECT().FindByID(gomock.Any(), existing.ID()).Return(existing, nil).Times(1)
+			postRepository.EXPECT().Update(gomock.Any(), existing).Return(nil).Times(1)
+
+			revisionRepository := mock_repository.NewMockPostRevisionRepository(ctrl)
+			revisionRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, r entity.PostRevision) (entity.PostRevision, error) {
+					return entity.ReconstructPostRevision(
+						r.ID(), r.PostID(), 2, r.Content(), r.Format(), r.EditorID(), r.CreatedAt(),
+					), nil
+				}).Times(1)
+
+			postEntityRepository := mock_repository.NewMockPostEntityRepository(ctrl)
+			postEntityRepository.EXPECT().Replace(gomock.Any(), existing, gomock.Any()).Return(nil).Times(1)
+
+			// The edited post is saved and reported to the moderators, without a reporter, as of the edit.
+			postReportRepository := mock_repository.NewMockPostReportRepository(ctrl)
+			postReportRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, r entity.PostReport) error {
+					assert.Equal(t, existing.ID(), r.PostID())
+					assert.Nil(t, r.ReporterID())
+					assert.Equal(t, vo.ReportReasonSpam, r.Reason())
+					assert.Equal(t, "blocked_domains: content links to a blocked domain (spam.example)", r.Details())
+					assert.Equal(t, existing.UpdatedAt(), r.CreatedAt())
+
+					return tt.reportErr
+				}).Times(1)
+
+			uc := post.NewUpdatePostUseCase(
+				postRepository, revisionRepository, postEntityRepository,
+				mock_aggregate_repository.NewMockUserPermissionRepository(ctrl), postReportRepository, contentFilter,
+				renderContent(ctrl), mock_shared.NewMockTransactionManager(nil),
+			)
+			output, err := uc.Execute(context.Background(), post.UpdatePostInput{
+				ActorID: authorID,
+				PostID:  existing.ID(),
+				Content: content,
+			})
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, output)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, content, output.Content)
+			assert.Equal(t, 2, output.RevisionCount)
+		})
+	}
+}
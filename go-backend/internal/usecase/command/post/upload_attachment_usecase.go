@@ -0,0 +1,201 @@
+package post
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// UploadAttachmentUseCase stores a file that the uploader can then attach to a post of theirs. The type of
+// the file is sniffed from its content; images get a thumbnail. Uploads that are never attached are
+// removed by SweepOrphanedAttachmentsUseCase.
+type UploadAttachmentUseCase interface {
+	Execute(ctx context.Context, input UploadAttachmentInput) (*AttachmentOutput, error)
+}
+
+type UploadAttachmentInput struct {
+	UploaderID uuid.UUID
+	FileName   string
+	// Content is read up to one byte past entity.MaxAttachmentSize.
+	Content io.Reader
+}
+
+// AttachmentOutput describes an uploaded file.
+type AttachmentOutput struct {
+	ID          uuid.UUID
+	FileName    string
+	ContentType string
+	Size        int64
+	// Width and Height are 0 unless HasThumbnail.
+	Width        int
+	Height       int
+	HasThumbnail bool
+	CreatedAt    time.Time
+}
+
+type uploadAttachmentUseCaseImpl struct {
+	tracer               trace.Tracer
+	logger               common.Logger
+	attachmentRepository repository.AttachmentRepository
+	blobStorage          service.BlobStorage
+	thumbnailer          service.ImageThumbnailer
+	txManager            shared.TransactionManager
+}
+
+func (uc *uploadAttachmentUseCaseImpl) Execute(
+	ctx context.Context, input UploadAttachmentInput,
+) (*AttachmentOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	attachment, content, thumbnail, err := uc.prepare(input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	err = uc.storeBlobs(ctx, attachment, content, thumbnail)
+	if err == nil {
+		err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+			return uc.attachmentRepository.Create(ctx, attachment)
+		})
+	}
+
+	if err != nil {
+		uc.logger.Error(ctx, "failed to save Attachment", "error", err)
+
+		// A blob left behind only costs storage, so a failed clean-up is logged and otherwise ignored.
+		if deleteErr := deleteAttachmentBlobs(ctx, uc.blobStorage, attachment); deleteErr != nil {
+			uc.logger.Error(ctx, "failed to delete Attachment blobs", "error", deleteErr)
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	uc.logger.Info(ctx, "attachment uploaded", "attachmentID", attachment.ID(), "uploaderID", input.UploaderID,
+		"contentType", attachment.ContentType(), "size", attachment.Size())
+
+	output := toAttachmentOutput(attachment)
+
+	return &output, nil
+}
+
+// prepare reads and validates the upload and renders the thumbnail of images; thumbnail is nil otherwise.
+func (uc *uploadAttachmentUseCaseImpl) prepare(
+	input UploadAttachmentInput,
+) (entity.Attachment, []byte, *service.ImageThumbnail, error) {
+	content, err := io.ReadAll(io.LimitReader(input.Content, entity.MaxAttachmentSize+1))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	contentType, err := vo.SniffAttachmentContentType(content)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	attachment, err := entity.NewAttachment(
+		input.UploaderID, input.FileName, contentType, int64(len(content)), time.Now(),
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if !contentType.IsImage() {
+		return attachment, content, nil, nil
+	}
+
+	thumbnail, err := uc.thumbnailer.Thumbnail(content)
+	if errors.Is(err, service.ErrUndecodableImage) {
+		return nil, nil, nil, vo.NewValidationError("image cannot be decoded", map[string]any{
+			"content_type": contentType.String(),
+		}, err)
+	}
+
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err = attachment.AddThumbnail(thumbnail.Width, thumbnail.Height); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return attachment, content, thumbnail, nil
+}
+
+func (uc *uploadAttachmentUseCaseImpl) storeBlobs(
+	ctx context.Context, attachment entity.Attachment, content []byte, thumbnail *service.ImageThumbnail,
+) error {
+	err := uc.blobStorage.Put(ctx, attachment.StorageKey(), bytes.NewReader(content), int64(len(content)),
+		attachment.ContentType().String())
+	if err != nil || thumbnail == nil {
+		return err
+	}
+
+	return uc.blobStorage.Put(ctx, attachment.ThumbnailKey(), bytes.NewReader(thumbnail.PNG),
+		int64(len(thumbnail.PNG)), vo.AttachmentContentTypePNG.String())
+}
+
+func toAttachmentOutput(attachment entity.Attachment) AttachmentOutput {
+	return AttachmentOutput{
+		ID:           attachment.ID(),
+		FileName:     attachment.FileName(),
+		ContentType:  attachment.ContentType().String(),
+		Size:         attachment.Size(),
+		Width:        attachment.Width(),
+		Height:       attachment.Height(),
+		HasThumbnail: attachment.ThumbnailKey() != "",
+		CreatedAt:    attachment.CreatedAt(),
+	}
+}
+
+// deleteAttachmentBlobs deletes the content and the thumbnail of attachment.
+func deleteAttachmentBlobs(ctx context.Context, blobStorage service.BlobStorage, attachment entity.Attachment) error {
+	var errs []error
+
+	for _, key := range []string{attachment.StorageKey(), attachment.ThumbnailKey()} {
+		if key == "" {
+			continue
+		}
+
+		if err := blobStorage.Delete(ctx, key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func NewUploadAttachmentUseCase(
+	attachmentRepository repository.AttachmentRepository,
+	blobStorage service.BlobStorage,
+	thumbnailer service.ImageThumbnailer,
+	txManager shared.TransactionManager,
+) UploadAttachmentUseCase {
+	return &uploadAttachmentUseCaseImpl{
+		tracer:               otel.Tracer("UploadAttachmentUseCase"),
+		logger:               common.NewLogger(),
+		attachmentRepository: attachmentRepository,
+		blobStorage:          blobStorage,
+		thumbnailer:          thumbnailer,
+		txManager:            txManager,
+	}
+}
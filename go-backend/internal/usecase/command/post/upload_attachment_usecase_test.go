@@ -0,0 +1,191 @@
+package post_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_service "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/service"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// pngHeader is enough of a PNG file for content sniffing; decoding is up to the thumbnailer.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestUploadAttachmentUseCase_Image(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	uploaderID := uuid.New()
+
+	thumbnailer := mock_service.NewMockImageThumbnailer(ctrl)
+	thumbnailer.EXPECT().Thumbnail(pngHeader).
+		Return(&service.ImageThumbnail{Width: 640, Height: 480, PNG: []byte("thumb")}, nil)
+
+	var stored entity.Attachment
+
+	attachmentRepository := mock_repository.NewMockAttachmentRepository(ctrl)
+	attachmentRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, a entity.Attachment) error {
+			stored = a
+
+			return nil
+		})
+
+	blobs := map[string]string{}
+	blobStorage := mock_service.NewMockBlobStorage(ctrl)
+	blobStorage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, key string, content io.Reader, size int64, contentType string) error {
+			data, err := io.ReadAll(content)
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(data)), size)
+			assert.Equal(t, "image/png", contentType)
+
+			blobs[key] = string(data)
+
+			return nil
+		}).Times(2)
+
+	uc := post.NewUploadAttachmentUseCase(
+		attachmentRepository, blobStorage, thumbnailer, mock_shared.NewMockTransactionManager(nil),
+	)
+	output, err := uc.Execute(context.Background(), post.UploadAttachmentInput{
+		UploaderID: uploaderID,
+		FileName:   "photo.png",
+		Content:    bytes.NewReader(pngHeader),
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, stored.ID(), output.ID)
+	assert.Equal(t, "photo.png", output.FileName)
+	assert.Equal(t, "image/png", output.ContentType)
+	assert.Equal(t, int64(len(pngHeader)), output.Size)
+	assert.Equal(t, 640, output.Width)
+	assert.Equal(t, 480, output.Height)
+	assert.True(t, output.HasThumbnail)
+	assert.Equal(t, uploaderID, stored.UploaderID())
+	assert.Nil(t, stored.PostID())
+	assert.Equal(t, map[string]string{
+		stored.StorageKey():   string(pngHeader),
+		stored.ThumbnailKey(): "thumb",
+	}, blobs)
+}
+
+func TestUploadAttachmentUseCase_File(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	attachmentRepository := mock_repository.NewMockAttachmentRepository(ctrl)
+	attachmentRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	blobStorage := mock_service.NewMockBlobStorage(ctrl)
+	blobStorage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), int64(5), "text/plain").Return(nil)
+
+	// Files other than images get no thumbnail.
+	uc := post.NewUploadAttachmentUseCase(
+		attachmentRepository, blobStorage, mock_service.NewMockImageThumbnailer(ctrl),
+		mock_shared.NewMockTransactionManager(nil),
+	)
+	output, err := uc.Execute(context.Background(), post.UploadAttachmentInput{
+		UploaderID: uuid.New(),
+		FileName:   "notes.txt",
+		Content:    strings.NewReader("notes"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "text/plain", output.ContentType)
+	assert.False(t, output.HasThumbnail)
+	assert.Zero(t, output.Width)
+}
+
+func TestUploadAttachmentUseCase_InvalidUpload(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		content  []byte
+		thumbErr error
+	}{
+		{name: "empty file", fileName: "empty.txt", content: []byte{}},
+		{name: "file too large", fileName: "big.txt", content: bytes.Repeat([]byte("a"), entity.MaxAttachmentSize+1)},
+		{name: "unsupported type", fileName: "archive.zip", content: []byte("PK\x03\x04\x14\x00")},
+		{name: "invalid file name", fileName: "", content: []byte("notes")},
+		{name: "undecodable image", fileName: "broken.png", content: pngHeader, thumbErr: service.ErrUndecodableImage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			thumbnailer := mock_service.NewMockImageThumbnailer(ctrl)
+			thumbnailer.EXPECT().Thumbnail(gomock.Any()).Return(nil, tt.thumbErr).AnyTimes()
+
+			// Nothing is stored for a rejected upload.
+			uc := post.NewUploadAttachmentUseCase(
+				mock_repository.NewMockAttachmentRepository(ctrl), mock_service.NewMockBlobStorage(ctrl),
+				thumbnailer, mock_shared.NewMockTransactionManager(nil),
+			)
+			output, err := uc.Execute(context.Background(), post.UploadAttachmentInput{
+				UploaderID: uuid.New(),
+				FileName:   tt.fileName,
+				Content:    bytes.NewReader(tt.content),
+			})
+
+			var domainErr vo.Error
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, vo.ValidationErrorCode, domainErr.Code())
+			assert.Nil(t, output)
+		})
+	}
+}
+
+func TestUploadAttachmentUseCase_FailureDeletesBlobs(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name    string
+		putErr  error
+		txErr   error
+		repoErr error
+	}{
+		{name: "blob storage error propagates", putErr: errors.New("storage error")},
+		{name: "repository error propagates", repoErr: errDB},
+		{name: "transaction error propagates", txErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			attachmentRepository := mock_repository.NewMockAttachmentRepository(ctrl)
+			attachmentRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(tt.repoErr).AnyTimes()
+
+			blobStorage := mock_service.NewMockBlobStorage(ctrl)
+			blobStorage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(tt.putErr)
+			blobStorage.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+			uc := post.NewUploadAttachmentUseCase(
+				attachmentRepository, blobStorage, mock_service.NewMockImageThumbnailer(ctrl),
+				mock_shared.NewMockTransactionManager(tt.txErr),
+			)
+			output, err := uc.Execute(context.Background(), post.UploadAttachmentInput{
+				UploaderID: uuid.New(),
+				FileName:   "notes.txt",
+				Content:    strings.NewReader("notes"),
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+		})
+	}
+}
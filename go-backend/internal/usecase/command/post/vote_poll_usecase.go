@@ -0,0 +1,96 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errAlreadyVoted = errors.New("user already voted in the poll")
+
+// VotePollUseCase casts the actor's vote in the poll of a post. Votes are final: each user votes once per
+// poll, and voting again is rejected.
+type VotePollUseCase interface {
+	Execute(ctx context.Context, input VotePollInput) error
+}
+
+type VotePollInput struct {
+	ActorID uuid.UUID
+	PostID  uuid.UUID
+	// OptionIDs are the chosen options; a single-choice poll takes exactly one.
+	OptionIDs []uuid.UUID
+}
+
+type votePollUseCaseImpl struct {
+	tracer         trace.Tracer
+	logger         common.Logger
+	pollRepository repository.PollRepository
+	txManager      shared.TransactionManager
+}
+
+func (uc *votePollUseCaseImpl) Execute(ctx context.Context, input VotePollInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		poll, txErr := uc.pollRepository.FindByPostID(ctx, input.PostID, input.ActorID)
+		if errors.Is(txErr, repository.ErrPostNotFound) || errors.Is(txErr, repository.ErrPollNotFound) {
+			return vo.NewNotFoundError("poll not found", nil, txErr)
+		}
+
+		if txErr != nil {
+			return txErr
+		}
+
+		vote, txErr := poll.Vote(input.ActorID, input.OptionIDs, time.Now())
+		if txErr != nil {
+			return txErr
+		}
+
+		voted, txErr := uc.pollRepository.Vote(ctx, vote)
+		if txErr != nil {
+			uc.logger.Error(ctx, "failed to save poll vote", "error", txErr)
+
+			return txErr
+		}
+
+		if !voted {
+			return vo.NewValidationError("already voted in this poll", map[string]any{
+				"post_id": input.PostID.String(),
+			}, errAlreadyVoted)
+		}
+
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "poll voted", "postID", input.PostID, "actorID", input.ActorID)
+
+	return nil
+}
+
+func NewVotePollUseCase(
+	pollRepository repository.PollRepository,
+	txManager shared.TransactionManager,
+) VotePollUseCase {
+	return &votePollUseCaseImpl{
+		tracer:         otel.Tracer("VotePollUseCase"),
+		logger:         common.NewLogger(),
+		pollRepository: pollRepository,
+		txManager:      txManager,
+	}
+}
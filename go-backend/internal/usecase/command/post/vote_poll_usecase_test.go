@@ -0,0 +1,125 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/post"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newOpenPoll(closesAt time.Time) entity.Poll {
+	return entity.ReconstructPoll(uuid.New(), []entity.PollOption{
+		entity.ReconstructPollOption(uuid.New(), "Tue"),
+		entity.ReconstructPollOption(uuid.New(), "Wed"),
+	}, false, closesAt, time.Now().Add(-time.Hour))
+}
+
+func TestVotePollUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	actorID := uuid.New()
+	poll := newOpenPoll(time.Now().Add(time.Hour))
+	optionID := poll.Options()[1].ID()
+
+	pollRepository := mock_repository.NewMockPollRepository(ctrl)
+	pollRepository.EXPECT().FindByPostID(gomock.Any(), poll.PostID(), actorID).Return(poll, nil).Times(1)
+	pollRepository.EXPECT().Vote(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, vote entity.PollVote) (bool, error) {
+			assert.Equal(t, poll.PostID(), vote.PostID())
+			assert.Equal(t, actorID, vote.UserID())
+			assert.Equal(t, []uuid.UUID{optionID}, vote.OptionIDs())
+
+			return true, nil
+		}).Times(1)
+
+	uc := post.NewVotePollUseCase(pollRepository, mock_shared.NewMockTransactionManager(nil))
+	err := uc.Execute(context.Background(), post.VotePollInput{
+		ActorID:   actorID,
+		PostID:    poll.PostID(),
+		OptionIDs: []uuid.UUID{optionID},
+	})
+
+	require.NoError(t, err)
+}
+
+func TestVotePollUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+	open := newOpenPoll(time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name      string
+		poll      entity.Poll
+		findErr   error
+		voted     bool
+		voteErr   error
+		txErr     error
+		optionIDs []uuid.UUID
+		wantErr   error
+		wantCode  vo.ErrorCode
+	}{
+		{name: "post not visible", poll: open, findErr: repository.ErrPostNotFound, wantCode: vo.NotFoundErrorCode},
+		{name: "no poll", poll: open, findErr: repository.ErrPollNotFound, wantCode: vo.NotFoundErrorCode},
+		{name: "find fails", poll: open, findErr: errDB, wantErr: errDB},
+		{
+			name:     "poll closed",
+			poll:     newOpenPoll(time.Now().Add(-time.Minute)),
+			voted:    true,
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:      "unknown option",
+			poll:      open,
+			optionIDs: []uuid.UUID{uuid.New()},
+			voted:     true,
+			wantCode:  vo.ValidationErrorCode,
+		},
+		{name: "already voted", poll: open, voted: false, wantCode: vo.ValidationErrorCode},
+		{name: "vote fails", poll: open, voteErr: errDB, wantErr: errDB},
+		{name: "transaction fails", poll: open, voted: true, txErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			optionIDs := tt.optionIDs
+			if optionIDs == nil {
+				optionIDs = []uuid.UUID{tt.poll.Options()[0].ID()}
+			}
+
+			pollRepository := mock_repository.NewMockPollRepository(ctrl)
+			pollRepository.EXPECT().FindByPostID(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(tt.poll, tt.findErr).AnyTimes()
+			pollRepository.EXPECT().Vote(gomock.Any(), gomock.Any()).Return(tt.voted, tt.voteErr).AnyTimes()
+
+			uc := post.NewVotePollUseCase(pollRepository, mock_shared.NewMockTransactionManager(tt.txErr))
+			err := uc.Execute(context.Background(), post.VotePollInput{
+				ActorID:   uuid.New(),
+				PostID:    tt.poll.PostID(),
+				OptionIDs: optionIDs,
+			})
+
+			require.Error(t, err)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// BlockUserUseCase makes the actor block another member of the active organization. Blocking ends the
+// follows between the two users, in both directions. Blocking a user again succeeds without changing
+// anything.
+type BlockUserUseCase interface {
+	Execute(ctx context.Context, input BlockUserInput) error
+}
+
+type BlockUserInput struct {
+	ActorID uuid.UUID
+	UserID  uuid.UUID
+}
+
+type blockUserUseCaseImpl struct {
+	tracer             trace.Tracer
+	logger             common.Logger
+	blockRepository    repository.BlockRepository
+	followRepository   repository.FollowRepository
+	timelineRepository repository.TimelineRepository
+	txManager          shared.TransactionManager
+}
+
+func (uc *blockUserUseCaseImpl) Execute(ctx context.Context, input BlockUserInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	block, err := entity.NewBlock(input.ActorID, input.UserID, time.Now())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	var added bool
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var txErr error
+
+		added, txErr = uc.blockRepository.Add(ctx, block)
+		if errors.Is(txErr, repository.ErrBlockedUserNotFound) {
+			return vo.NewNotFoundError("user not found", nil, txErr)
+		}
+
+		if txErr != nil {
+			uc.logger.Error(ctx, "failed to save Block", "error", txErr)
+
+			return txErr
+		}
+
+		if !added {
+			return nil
+		}
+
+		if txErr = uc.unfollow(ctx, input.ActorID, input.UserID); txErr != nil {
+			return txErr
+		}
+
+		return uc.unfollow(ctx, input.UserID, input.ActorID)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "user blocked", "actorID", input.ActorID, "userID", input.UserID, "changed", added)
+
+	return nil
+}
+
+// unfollow removes the follow of followeeID by followerID, if any, together with the followee's posts on
+// the follower's timeline.
+func (uc *blockUserUseCaseImpl) unfollow(ctx context.Context, followerID, followeeID uuid.UUID) error {
+	removed, err := uc.followRepository.Remove(ctx, followerID, followeeID)
+	if err != nil || !removed {
+		return err
+	}
+
+	if err = uc.timelineRepository.Prune(ctx, followerID, followeeID); err != nil {
+		uc.logger.Error(ctx, "failed to prune timeline", "error", err)
+	}
+
+	return err
+}
+
+func NewBlockUserUseCase(
+	blockRepository repository.BlockRepository,
+	followRepository repository.FollowRepository,
+	timelineRepository repository.TimelineRepository,
+	txManager shared.TransactionManager,
+) BlockUserUseCase {
+	return &blockUserUseCaseImpl{
+		tracer:             otel.Tracer("BlockUserUseCase"),
+		logger:             common.NewLogger(),
+		blockRepository:    blockRepository,
+		followRepository:   followRepository,
+		timelineRepository: timelineRepository,
+		txManager:          txManager,
+	}
+}
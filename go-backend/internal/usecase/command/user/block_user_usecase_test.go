@@ -0,0 +1,134 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBlockUserUseCase_HappyCase(t *testing.T) {
+	// A new block ends the follows in both directions; blocking twice changes nothing.
+	tests := []struct {
+		name       string
+		added      bool
+		follows    bool
+		followedBy bool
+	}{
+		{name: "new block of a followed user", added: true, follows: true},
+		{name: "new block of a follower", added: true, followedBy: true},
+		{name: "new block of a mutual follow", added: true, follows: true, followedBy: true},
+		{name: "existing block", added: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID, userID := uuid.New(), uuid.New()
+
+			blockRepository := mock_repository.NewMockBlockRepository(ctrl)
+			blockRepository.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, b entity.Block) (bool, error) {
+					assert.Equal(t, actorID, b.BlockerID())
+					assert.Equal(t, userID, b.BlockedID())
+
+					return tt.added, nil
+				}).Times(1)
+
+			removes := 0
+			if tt.added {
+				removes = 1
+			}
+
+			followRepository := mock_repository.NewMockFollowRepository(ctrl)
+			followRepository.EXPECT().Remove(gomock.Any(), actorID, userID).Return(tt.follows, nil).Times(removes)
+			followRepository.EXPECT().Remove(gomock.Any(), userID, actorID).Return(tt.followedBy, nil).Times(removes)
+
+			timelineRepository := mock_repository.NewMockTimelineRepository(ctrl)
+
+			if tt.follows {
+				timelineRepository.EXPECT().Prune(gomock.Any(), actorID, userID).Return(nil).Times(1)
+			}
+
+			if tt.followedBy {
+				timelineRepository.EXPECT().Prune(gomock.Any(), userID, actorID).Return(nil).Times(1)
+			}
+
+			uc := user.NewBlockUserUseCase(
+				blockRepository, followRepository, timelineRepository, mock_shared.NewMockTransactionManager(nil),
+			)
+			err := uc.Execute(context.Background(), user.BlockUserInput{ActorID: actorID, UserID: userID})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBlockUserUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name      string
+		self      bool
+		addErr    error
+		removeErr error
+		pruneErr  error
+		txErr     error
+		wantErr   error
+		wantCode  vo.ErrorCode
+	}{
+		{name: "block self", self: true, wantCode: vo.ValidationErrorCode},
+		{name: "user is not a member", addErr: repository.ErrBlockedUserNotFound, wantCode: vo.NotFoundErrorCode},
+		{name: "add fails", addErr: errDB, wantErr: errDB},
+		{name: "unfollow fails", removeErr: errDB, wantErr: errDB},
+		{name: "prune fails", pruneErr: errDB, wantErr: errDB},
+		{name: "transaction fails", txErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID, userID := uuid.New(), uuid.New()
+
+			if tt.self {
+				userID = actorID
+			}
+
+			blockRepository := mock_repository.NewMockBlockRepository(ctrl)
+			blockRepository.EXPECT().Add(gomock.Any(), gomock.Any()).Return(tt.addErr == nil, tt.addErr).AnyTimes()
+
+			followRepository := mock_repository.NewMockFollowRepository(ctrl)
+			followRepository.EXPECT().Remove(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(tt.removeErr == nil, tt.removeErr).AnyTimes()
+
+			timelineRepository := mock_repository.NewMockTimelineRepository(ctrl)
+			timelineRepository.EXPECT().Prune(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.pruneErr).AnyTimes()
+
+			txManager := mock_shared.NewMockTransactionManager(tt.txErr)
+			uc := user.NewBlockUserUseCase(blockRepository, followRepository, timelineRepository, txManager)
+			err := uc.Execute(context.Background(), user.BlockUserInput{ActorID: actorID, UserID: userID})
+
+			require.Error(t, err)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// FollowUserUseCase makes the actor follow another member of the active organization. Following a user
+// again succeeds without changing anything. Users who block one another cannot follow each other.
+type FollowUserUseCase interface {
+	Execute(ctx context.Context, input FollowUserInput) error
+}
+
+type FollowUserInput struct {
+	ActorID uuid.UUID
+	UserID  uuid.UUID
+}
+
+type followUserUseCaseImpl struct {
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	followRepository       repository.FollowRepository
+	timelineRepository     repository.TimelineRepository
+	relationshipRepository aggregaterepository.UserRelationshipRepository
+	txManager              shared.TransactionManager
+}
+
+func (uc *followUserUseCaseImpl) Execute(ctx context.Context, input FollowUserInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	follow, err := entity.NewFollow(input.ActorID, input.UserID, time.Now())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	var added bool
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		relationships, txErr := uc.relationshipRepository.FindByUserID(ctx, input.ActorID)
+		if txErr != nil {
+			return txErr
+		}
+
+		// A user blocked either way is hidden from the actor, so they are reported as missing.
+		if relationships.Blocks(input.UserID) {
+			return vo.NewNotFoundError("user not found", nil, repository.ErrFolloweeNotFound)
+		}
+
+		added, txErr = uc.followRepository.Add(ctx, follow)
+		if errors.Is(txErr, repository.ErrFolloweeNotFound) {
+			return vo.NewNotFoundError("user not found", nil, txErr)
+		}
+
+		if txErr != nil {
+			uc.logger.Error(ctx, "failed to save Follow", "error", txErr)
+
+			return txErr
+		}
+
+		if !added {
+			return nil
+		}
+
+		// The followee's fanned-out posts are not read through the follow graph, so the new follower
+		// needs their own entries for them.
+		if txErr = uc.timelineRepository.Backfill(ctx, input.ActorID, input.UserID); txErr != nil {
+			uc.logger.Error(ctx, "failed to backfill timeline", "error", txErr)
+		}
+
+		return txErr
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "user followed", "actorID", input.ActorID, "userID", input.UserID, "changed", added)
+
+	return nil
+}
+
+func NewFollowUserUseCase(
+	followRepository repository.FollowRepository,
+	timelineRepository repository.TimelineRepository,
+	relationshipRepository aggregaterepository.UserRelationshipRepository,
+	txManager shared.TransactionManager,
+) FollowUserUseCase {
+	return &followUserUseCaseImpl{
+		tracer:                 otel.Tracer("FollowUserUseCase"),
+		logger:                 common.NewLogger(),
+		followRepository:       followRepository,
+		timelineRepository:     timelineRepository,
+		relationshipRepository: relationshipRepository,
+		txManager:              txManager,
+	}
+}
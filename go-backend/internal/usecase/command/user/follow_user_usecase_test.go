@@ -0,0 +1,127 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
+	mock_aggregate_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/aggregate/repository"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFollowUserUseCase_HappyCase(t *testing.T) {
+	// Following twice is not an error; only a new follow backfills the timeline.
+	tests := []struct {
+		name      string
+		added     bool
+		backfills int
+	}{
+		{name: "new follow", added: true, backfills: 1},
+		{name: "existing follow", added: false, backfills: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID, userID := uuid.New(), uuid.New()
+
+			followRepository := mock_repository.NewMockFollowRepository(ctrl)
+			followRepository.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, f entity.Follow) (bool, error) {
+					assert.Equal(t, actorID, f.FollowerID())
+					assert.Equal(t, userID, f.FolloweeID())
+
+					return tt.added, nil
+				}).Times(1)
+
+			timelineRepository := mock_repository.NewMockTimelineRepository(ctrl)
+			timelineRepository.EXPECT().Backfill(gomock.Any(), actorID, userID).Return(nil).Times(tt.backfills)
+
+			relationshipRepository := mock_aggregate_repository.NewMockUserRelationshipRepository(ctrl)
+			relationshipRepository.EXPECT().FindByUserID(gomock.Any(), actorID).
+				Return(&aggregate.UserRelationshipAggregate{UserID: actorID}, nil).Times(1)
+
+			uc := user.NewFollowUserUseCase(
+				followRepository, timelineRepository, relationshipRepository, mock_shared.NewMockTransactionManager(nil),
+			)
+			err := uc.Execute(context.Background(), user.FollowUserInput{ActorID: actorID, UserID: userID})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFollowUserUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name        string
+		self        bool
+		blocked     bool
+		findErr     error
+		addErr      error
+		backfillErr error
+		txErr       error
+		wantErr     error
+		wantCode    vo.ErrorCode
+	}{
+		{name: "follow self", self: true, wantCode: vo.ValidationErrorCode},
+		{name: "user is blocked", blocked: true, wantCode: vo.NotFoundErrorCode},
+		{name: "find relationships fails", findErr: errDB, wantErr: errDB},
+		{name: "user is not a member", addErr: repository.ErrFolloweeNotFound, wantCode: vo.NotFoundErrorCode},
+		{name: "add fails", addErr: errDB, wantErr: errDB},
+		{name: "backfill fails", backfillErr: errDB, wantErr: errDB},
+		{name: "transaction fails", txErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID, userID := uuid.New(), uuid.New()
+
+			if tt.self {
+				userID = actorID
+			}
+
+			followRepository := mock_repository.NewMockFollowRepository(ctrl)
+			followRepository.EXPECT().Add(gomock.Any(), gomock.Any()).Return(tt.addErr == nil, tt.addErr).AnyTimes()
+
+			timelineRepository := mock_repository.NewMockTimelineRepository(ctrl)
+			timelineRepository.EXPECT().Backfill(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.backfillErr).AnyTimes()
+
+			relationships := &aggregate.UserRelationshipAggregate{UserID: actorID}
+			if tt.blocked {
+				relationships.BlockedIDs = []uuid.UUID{userID}
+			}
+
+			relationshipRepository := mock_aggregate_repository.NewMockUserRelationshipRepository(ctrl)
+			relationshipRepository.EXPECT().FindByUserID(gomock.Any(), actorID).Return(relationships, tt.findErr).AnyTimes()
+
+			txManager := mock_shared.NewMockTransactionManager(tt.txErr)
+			uc := user.NewFollowUserUseCase(followRepository, timelineRepository, relationshipRepository, txManager)
+			err := uc.Execute(context.Background(), user.FollowUserInput{ActorID: actorID, UserID: userID})
+
+			require.Error(t, err)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errLacksRolesAssignPerm = errors.New("user lacks roles:assign permission")
+
+// GrantRoleUseCase grants a role to a user on behalf of an actor, optionally until a given time.
+type GrantRoleUseCase interface {
+	Execute(ctx context.Context, input GrantRoleInput) (*GrantRoleOutput, error)
+}
+
+type GrantRoleInput struct {
+	ActorID   uuid.UUID
+	UserID    uuid.UUID
+	RoleID    uuid.UUID
+	ExpiresAt *time.Time
+	Reason    string
+}
+
+type GrantRoleOutput struct {
+	UserID    uuid.UUID
+	RoleID    uuid.UUID
+	GrantedBy uuid.UUID
+	GrantedAt time.Time
+	ExpiresAt *time.Time
+	Reason    string
+}
+
+type grantRoleUseCaseImpl struct {
+	tracer                   trace.Tracer
+	logger                   common.Logger
+	permissionRepository     aggregaterepository.UserPermissionRepository
+	roleAssignmentRepository repository.RoleAssignmentRepository
+	txManager                shared.TransactionManager
+}
+
+func (uc *grantRoleUseCaseImpl) Execute(ctx context.Context, input GrantRoleInput) (*GrantRoleOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	agg, err := uc.permissionRepository.FindByUserID(ctx, input.ActorID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	if !agg.HasPermission(vo.PermissionRolesAssign) {
+		err = vo.NewForbiddenError("insufficient permissions", nil, errLacksRolesAssignPerm)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	assignment, err := entity.NewRoleAssignment(
+		input.UserID, input.RoleID, input.ActorID, time.Now(), input.ExpiresAt, input.Reason,
+	)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to create RoleAssignment", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	var granted entity.RoleAssignment
+
+	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var repoErr error
+
+		granted, repoErr = uc.roleAssignmentRepository.Grant(ctx, assignment)
+		if repoErr != nil {
+			uc.logger.Error(ctx, "failed to save RoleAssignment", "error", repoErr)
+
+			return repoErr
+		}
+
+		return nil
+	})
+	if err != nil {
+		uc.logger.Error(ctx, "transaction error", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	uc.logger.Info(ctx, "role granted",
+		"targetUserID", granted.UserID(), "roleID", granted.RoleID(), "expiresAt", granted.ExpiresAt())
+
+	return &GrantRoleOutput{
+		UserID:    granted.UserID(),
+		RoleID:    granted.RoleID(),
+		GrantedBy: granted.GrantedBy(),
+		GrantedAt: granted.GrantedAt(),
+		ExpiresAt: granted.ExpiresAt(),
+		Reason:    granted.Reason(),
+	}, nil
+}
+
+func NewGrantRoleUseCase(
+	permissionRepository aggregaterepository.UserPermissionRepository,
+	roleAssignmentRepository repository.RoleAssignmentRepository,
+	txManager shared.TransactionManager,
+) GrantRoleUseCase {
+	return &grantRoleUseCaseImpl{
+		tracer:                   otel.Tracer("GrantRoleUseCase"),
+		logger:                   common.NewLogger(),
+		permissionRepository:     permissionRepository,
+		roleAssignmentRepository: roleAssignmentRepository,
+		txManager:                txManager,
+	}
+}
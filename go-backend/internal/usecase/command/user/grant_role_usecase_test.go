@@ -0,0 +1,147 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
+	mock_aggregate_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/aggregate/repository"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGrantRoleUseCase_HappyCase(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		expiresAt *time.Time
+	}{
+		{name: "permanent grant", expiresAt: nil},
+		{name: "time-bound grant", expiresAt: &expiresAt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID, userID, roleID := uuid.New(), uuid.New(), uuid.New()
+
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).Return(&aggregate.UserPermissionAggregate{
+				UserID:      actorID,
+				Permissions: []vo.Permission{vo.PermissionRolesAssign},
+			}, nil).Times(1)
+
+			roleRepo := mock_repository.NewMockRoleAssignmentRepository(ctrl)
+			roleRepo.EXPECT().Grant(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, a entity.RoleAssignment) (entity.RoleAssignment, error) {
+					return a, nil
+				}).Times(1)
+
+			uc := user.NewGrantRoleUseCase(permRepo, roleRepo, mock_shared.NewMockTransactionManager(nil))
+			output, err := uc.Execute(context.Background(), user.GrantRoleInput{
+				ActorID:   actorID,
+				UserID:    userID,
+				RoleID:    roleID,
+				ExpiresAt: tt.expiresAt,
+				Reason:    "on-call",
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, userID, output.UserID)
+			assert.Equal(t, roleID, output.RoleID)
+			assert.Equal(t, actorID, output.GrantedBy)
+			assert.Equal(t, tt.expiresAt, output.ExpiresAt)
+			assert.Equal(t, "on-call", output.Reason)
+		})
+	}
+}
+
+func TestGrantRoleUseCase_FailureCase(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name      string
+		perms     []vo.Permission
+		expiresAt *time.Time
+		reason    string
+		grantErr  error
+		wantCode  vo.ErrorCode
+	}{
+		{
+			name:     "actor lacks roles:assign",
+			perms:    []vo.Permission{vo.PermissionUsersList},
+			reason:   "on-call",
+			wantCode: vo.ForbiddenErrorCode,
+		},
+		{
+			name:     "empty reason",
+			perms:    []vo.Permission{vo.PermissionRolesAssign},
+			reason:   "",
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:      "expiresAt in the past",
+			perms:     []vo.Permission{vo.PermissionRolesAssign},
+			expiresAt: &past,
+			reason:    "on-call",
+			wantCode:  vo.ValidationErrorCode,
+		},
+		{
+			name:     "repository error",
+			perms:    []vo.Permission{vo.PermissionRolesAssign},
+			reason:   "on-call",
+			grantErr: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID := uuid.New()
+
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).Return(&aggregate.UserPermissionAggregate{
+				UserID:      actorID,
+				Permissions: tt.perms,
+			}, nil).Times(1)
+
+			roleRepo := mock_repository.NewMockRoleAssignmentRepository(ctrl)
+			if tt.grantErr != nil {
+				roleRepo.EXPECT().Grant(gomock.Any(), gomock.Any()).Return(nil, tt.grantErr).Times(1)
+			}
+
+			uc := user.NewGrantRoleUseCase(permRepo, roleRepo, mock_shared.NewMockTransactionManager(nil))
+			output, err := uc.Execute(context.Background(), user.GrantRoleInput{
+				ActorID:   actorID,
+				UserID:    uuid.New(),
+				RoleID:    uuid.New(),
+				ExpiresAt: tt.expiresAt,
+				Reason:    tt.reason,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.grantErr != nil {
+				assert.ErrorIs(t, err, tt.grantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
 	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
@@ -21,6 +22,8 @@ type LoginUseCase interface {
 type LoginInput struct {
 	Email    string
 	Password string
+	// OrganizationID selects the organization the token acts in; nil picks the user's oldest membership.
+	OrganizationID *uuid.UUID
 }
 
 type LoginOutput struct {
@@ -29,18 +32,22 @@ type LoginOutput struct {
 	UserID    string
 	UserName  string
 	UserEmail string
+	// OrganizationID is the organization the token acts in; nil when the user belongs to none.
+	OrganizationID *uuid.UUID
 }
 
 type loginUseCaseImpl struct {
 	tracer         trace.Tracer
 	logger         common.Logger
 	userRepository repository.UserRepository
+	orgRepository  repository.OrganizationRepository
 	jwtService     service.JwtService
 }
 
 var (
-	errUserNotActive    = errors.New("user is not active")
-	errPasswordMismatch = errors.New("password mismatch")
+	errUserNotActive         = errors.New("user is not active")
+	errPasswordMismatch      = errors.New("password mismatch")
+	errNotOrganizationMember = errors.New("user is not a member of the requested organization")
 )
 
 func (uc *loginUseCaseImpl) Execute(ctx context.Context, input LoginInput) (*LoginOutput, error) {
@@ -77,7 +84,19 @@ func (uc *loginUseCaseImpl) Execute(ctx context.Context, input LoginInput) (*Log
 		return nil, vo.NewUnauthorizedError("invalid credential", nil, errPasswordMismatch)
 	}
 
-	token, err := uc.jwtService.GenerateUserAccessToken(ctx, user)
+	// The user is authenticated from here on; membership rows are only visible to their own user.
+	ctx = common.WithUserID(ctx, user.ID().String())
+
+	tenantID, err := uc.selectTenant(ctx, user.ID(), input.OrganizationID)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to select organization", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	token, err := uc.jwtService.GenerateUserAccessToken(ctx, user, tenantID)
 	if err != nil {
 		uc.logger.Error(ctx, "failed to generate token", "error", err)
 		span.RecordError(err)
@@ -86,23 +105,57 @@ func (uc *loginUseCaseImpl) Execute(ctx context.Context, input LoginInput) (*Log
 		return nil, err
 	}
 
-	return &LoginOutput{
+	output := &LoginOutput{
 		Token:     token.Value,
 		ExpiresAt: token.ExpiresAt,
 		UserID:    user.ID().String(),
 		UserName:  user.Name(),
 		UserEmail: user.Email(),
-	}, nil
+	}
+	if tenantID != uuid.Nil {
+		output.OrganizationID = &tenantID
+	}
+
+	return output, nil
+}
+
+// selectTenant picks the organization the token acts in: the requested one, which the user must belong to,
+// or otherwise the user's oldest membership. Users without any membership get uuid.Nil (no tenant).
+func (uc *loginUseCaseImpl) selectTenant(
+	ctx context.Context, userID uuid.UUID, requested *uuid.UUID,
+) (uuid.UUID, error) {
+	memberships, err := uc.orgRepository.FindMembershipsByUserID(ctx, userID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if requested == nil {
+		if len(memberships) == 0 {
+			return uuid.Nil, nil
+		}
+
+		return memberships[0].OrganizationID(), nil
+	}
+
+	for _, m := range memberships {
+		if m.OrganizationID() == *requested {
+			return *requested, nil
+		}
+	}
+
+	return uuid.Nil, vo.NewForbiddenError("not a member of the organization", nil, errNotOrganizationMember)
 }
 
 func NewLoginUseCase(
 	userRepository repository.UserRepository,
+	orgRepository repository.OrganizationRepository,
 	jwtService service.JwtService,
 ) LoginUseCase {
 	return &loginUseCaseImpl{
 		tracer:         otel.Tracer("LoginUseCase"),
 		logger:         common.NewLogger(),
 		userRepository: userRepository,
+		orgRepository:  orgRepository,
 		jwtService:     jwtService,
 	}
 }
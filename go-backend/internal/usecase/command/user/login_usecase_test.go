@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
 	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
@@ -31,20 +32,29 @@ func TestLoginUseCase_HappyCase(t *testing.T) {
 	userRepository.EXPECT().FindByEmail(gomock.Any(), "test@example.com").Return(mockUser, nil).Times(1)
 	mockUser.EXPECT().Status().Return(vo.UserStatusActive).Times(1)
 	mockUser.EXPECT().ComparePassword("password").Return(true, nil).Times(1)
-	mockUser.EXPECT().ID().Return(userID).Times(1)
+	mockUser.EXPECT().ID().Return(userID).AnyTimes()
 	mockUser.EXPECT().Name().Return("Test").Times(1)
 	mockUser.EXPECT().Email().Return("test@example.com").Times(1)
 
+	oldest, newest := uuid.New(), uuid.New()
+	orgRepository := mock_repository.NewMockOrganizationRepository(ctrl)
+	orgRepository.EXPECT().FindMembershipsByUserID(gomock.Any(), userID).
+		Return([]entity.OrganizationMembership{
+			entity.ReconstructOrganizationMembership(oldest, userID, entity.OrganizationOwnerRoleID, expiresAt),
+			entity.ReconstructOrganizationMembership(newest, userID, entity.OrganizationOwnerRoleID, expiresAt),
+		}, nil).
+		Times(1)
+
 	tokenGenerator := mock_service.NewMockJwtService(ctrl)
 	tokenGenerator.EXPECT().
-		GenerateUserAccessToken(gomock.Any(), mockUser).
+		GenerateUserAccessToken(gomock.Any(), mockUser, oldest).
 		Return(&service.UserAccessToken{
 			Value:     "token",
 			ExpiresAt: expiresAt,
 		}, nil).
 		Times(1)
 
-	usecase := user.NewLoginUseCase(userRepository, tokenGenerator)
+	usecase := user.NewLoginUseCase(userRepository, orgRepository, tokenGenerator)
 
 	output, err := usecase.Execute(ctx, user.LoginInput{
 		Email:    "test@example.com",
@@ -57,6 +67,95 @@ func TestLoginUseCase_HappyCase(t *testing.T) {
 	assert.Equal(t, userID.String(), output.UserID)
 	assert.Equal(t, "Test", output.UserName)
 	assert.Equal(t, "test@example.com", output.UserEmail)
+	require.NotNil(t, output.OrganizationID)
+	assert.Equal(t, oldest, *output.OrganizationID)
+}
+
+func TestLoginUseCase_OrganizationSelection(t *testing.T) {
+	member, other := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name        string
+		memberships []uuid.UUID
+		requested   *uuid.UUID
+		wantTenant  uuid.UUID
+		wantCode    vo.ErrorCode
+	}{
+		{
+			name:        "requested organization the user belongs to",
+			memberships: []uuid.UUID{other, member},
+			requested:   &member,
+			wantTenant:  member,
+		},
+		{
+			name:       "no memberships issues a token without tenant",
+			wantTenant: uuid.Nil,
+		},
+		{
+			name:        "requested organization the user does not belong to",
+			memberships: []uuid.UUID{member},
+			requested:   &other,
+			wantCode:    vo.ForbiddenErrorCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			userID := uuid.New()
+
+			userRepository := mock_repository.NewMockUserRepository(ctrl)
+			mockUser := mock_entity.NewMockUser(ctrl)
+			userRepository.EXPECT().FindByEmail(gomock.Any(), "test@example.com").Return(mockUser, nil)
+			mockUser.EXPECT().Status().Return(vo.UserStatusActive)
+			mockUser.EXPECT().ComparePassword("password").Return(true, nil)
+			mockUser.EXPECT().ID().Return(userID).AnyTimes()
+			mockUser.EXPECT().Name().Return("Test").AnyTimes()
+			mockUser.EXPECT().Email().Return("test@example.com").AnyTimes()
+
+			memberships := make([]entity.OrganizationMembership, 0, len(tt.memberships))
+			for _, orgID := range tt.memberships {
+				memberships = append(memberships,
+					entity.ReconstructOrganizationMembership(orgID, userID, entity.OrganizationOwnerRoleID, time.Now()))
+			}
+
+			orgRepository := mock_repository.NewMockOrganizationRepository(ctrl)
+			orgRepository.EXPECT().FindMembershipsByUserID(gomock.Any(), userID).Return(memberships, nil)
+
+			tokenGenerator := mock_service.NewMockJwtService(ctrl)
+			if tt.wantCode == "" {
+				tokenGenerator.EXPECT().GenerateUserAccessToken(gomock.Any(), mockUser, tt.wantTenant).
+					Return(&service.UserAccessToken{Value: "token"}, nil)
+			}
+
+			output, err := user.NewLoginUseCase(userRepository, orgRepository, tokenGenerator).
+				Execute(context.Background(), user.LoginInput{
+					Email:          "test@example.com",
+					Password:       "password",
+					OrganizationID: tt.requested,
+				})
+
+			if tt.wantCode != "" {
+				require.Error(t, err)
+				assert.Nil(t, output)
+
+				var voErr vo.Error
+				require.ErrorAs(t, err, &voErr)
+				assert.Equal(t, tt.wantCode, voErr.Code())
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			if tt.wantTenant == uuid.Nil {
+				assert.Nil(t, output.OrganizationID)
+			} else {
+				require.NotNil(t, output.OrganizationID)
+				assert.Equal(t, tt.wantTenant, *output.OrganizationID)
+			}
+		})
+	}
 }
 
 func TestLoginUseCase_FailureCase(t *testing.T) {
@@ -167,10 +266,11 @@ func TestLoginUseCase_FailureCase(t *testing.T) {
 				userRepository.EXPECT().FindByEmail(gomock.Any(), "test@example.com").Return(mockUser, nil).Times(1)
 				mockUser.EXPECT().Status().Return(vo.UserStatusActive).Times(1)
 				mockUser.EXPECT().ComparePassword("password").Return(true, nil).Times(1)
+				mockUser.EXPECT().ID().Return(uuid.New()).AnyTimes()
 
 				jwtService := mock_service.NewMockJwtService(ctrl)
 				jwtService.EXPECT().
-					GenerateUserAccessToken(gomock.Any(), mockUser).
+					GenerateUserAccessToken(gomock.Any(), mockUser, uuid.Nil).
 					Return(nil, errors.New("token error")).
 					Times(1)
 
@@ -196,7 +296,11 @@ func TestLoginUseCase_FailureCase(t *testing.T) {
 			ctx := context.Background()
 
 			userRepository, tokenGenerator := tt.setupMocks(ctrl)
-			usecase := user.NewLoginUseCase(userRepository, tokenGenerator)
+
+			orgRepository := mock_repository.NewMockOrganizationRepository(ctrl)
+			orgRepository.EXPECT().FindMembershipsByUserID(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+			usecase := user.NewLoginUseCase(userRepository, orgRepository, tokenGenerator)
 
 			output, err := usecase.Execute(ctx, tt.input)
 
@@ -0,0 +1,72 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// MuteUserUseCase makes the actor mute another member of the active organization. The muted user is not
+// told and can still see and interact with the actor's posts. Muting a user again succeeds without
+// changing anything.
+type MuteUserUseCase interface {
+	Execute(ctx context.Context, input MuteUserInput) error
+}
+
+type MuteUserInput struct {
+	ActorID uuid.UUID
+	UserID  uuid.UUID
+}
+
+type muteUserUseCaseImpl struct {
+	tracer         trace.Tracer
+	logger         common.Logger
+	muteRepository repository.MuteRepository
+}
+
+func (uc *muteUserUseCaseImpl) Execute(ctx context.Context, input MuteUserInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	mute, err := entity.NewMute(input.ActorID, input.UserID, time.Now())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	added, err := uc.muteRepository.Add(ctx, mute)
+	if errors.Is(err, repository.ErrMutedUserNotFound) {
+		return vo.NewNotFoundError("user not found", nil, err)
+	}
+
+	if err != nil {
+		uc.logger.Error(ctx, "failed to save Mute", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "user muted", "actorID", input.ActorID, "userID", input.UserID, "changed", added)
+
+	return nil
+}
+
+func NewMuteUserUseCase(muteRepository repository.MuteRepository) MuteUserUseCase {
+	return &muteUserUseCaseImpl{
+		tracer:         otel.Tracer("MuteUserUseCase"),
+		logger:         common.NewLogger(),
+		muteRepository: muteRepository,
+	}
+}
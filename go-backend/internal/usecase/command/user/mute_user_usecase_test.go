@@ -0,0 +1,84 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMuteUserUseCase_HappyCase(t *testing.T) {
+	// Muting twice is not an error.
+	for _, added := range []bool{true, false} {
+		ctrl := gomock.NewController(t)
+		actorID, userID := uuid.New(), uuid.New()
+
+		muteRepository := mock_repository.NewMockMuteRepository(ctrl)
+		muteRepository.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, m entity.Mute) (bool, error) {
+				assert.Equal(t, actorID, m.MuterID())
+				assert.Equal(t, userID, m.MutedID())
+
+				return added, nil
+			}).Times(1)
+
+		uc := user.NewMuteUserUseCase(muteRepository)
+		err := uc.Execute(context.Background(), user.MuteUserInput{ActorID: actorID, UserID: userID})
+
+		require.NoError(t, err)
+	}
+}
+
+func TestMuteUserUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name     string
+		self     bool
+		addErr   error
+		wantErr  error
+		wantCode vo.ErrorCode
+	}{
+		{name: "mute self", self: true, wantCode: vo.ValidationErrorCode},
+		{name: "user is not a member", addErr: repository.ErrMutedUserNotFound, wantCode: vo.NotFoundErrorCode},
+		{name: "add fails", addErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID, userID := uuid.New(), uuid.New()
+
+			if tt.self {
+				userID = actorID
+			}
+
+			muteRepository := mock_repository.NewMockMuteRepository(ctrl)
+			muteRepository.EXPECT().Add(gomock.Any(), gomock.Any()).Return(false, tt.addErr).AnyTimes()
+
+			uc := user.NewMuteUserUseCase(muteRepository)
+			err := uc.Execute(context.Background(), user.MuteUserInput{ActorID: actorID, UserID: userID})
+
+			require.Error(t, err)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/Haya372/web-app-template/go-backend/internal/common"
@@ -15,6 +16,15 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+// invitationGrantReason is recorded in the role history for roles granted by accepting an invitation.
+const invitationGrantReason = "granted by invitation"
+
+var (
+	errSignupNotAdmitted      = errors.New("signup policy does not admit the email without an invitation")
+	errInvitationInvalid      = errors.New("invitation token is unknown")
+	errInvitationRaceAccepted = errors.New("invitation was accepted concurrently")
+)
+
 type SingupUseCase interface {
 	Execute(ctx context.Context, input SignupInput) (*SignupOutput, error)
 }
@@ -23,21 +33,29 @@ type SignupInput struct {
 	Name     string
 	Email    string
 	Password string
+	// InvitationToken is optional; when set, the email must match the invitation and its roles are granted.
+	InvitationToken string
 }
 
 type SignupOutput struct {
-	ID        uuid.UUID
-	Name      string
-	Email     string
-	CreatedAt time.Time
-	Status    vo.UserStatus
+	ID uuid.UUID
+	// OrganizationID is the personal organization created for the new user.
+	OrganizationID uuid.UUID
+	Name           string
+	Email          string
+	CreatedAt      time.Time
+	Status         vo.UserStatus
 }
 
 type signupUseCaseImpl struct {
-	tracer         trace.Tracer
-	logger         common.Logger
-	userRepository repository.UserRepository
-	txManager      shared.TransactionManager
+	tracer                   trace.Tracer
+	logger                   common.Logger
+	userRepository           repository.UserRepository
+	orgRepository            repository.OrganizationRepository
+	invitationRepository     repository.InvitationRepository
+	roleAssignmentRepository repository.RoleAssignmentRepository
+	policy                   vo.SignupPolicy
+	txManager                shared.TransactionManager
 }
 
 func (uc *signupUseCaseImpl) Execute(ctx context.Context, input SignupInput) (*SignupOutput, error) {
@@ -53,6 +71,26 @@ func (uc *signupUseCaseImpl) Execute(ctx context.Context, input SignupInput) (*S
 		return nil, err
 	}
 
+	invited := input.InvitationToken != ""
+
+	if !invited && !uc.policy.AdmitsUninvited(vo.Email(user.Email())) {
+		err = vo.NewForbiddenError(signupRejectedMessage(uc.policy.Mode()), nil, errSignupNotAdmitted)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	// Every user starts with a personal organization so tenant-scoped features work right after signup.
+	org, err := entity.NewOrganization(user.Name(), user.CreatedAt())
+	if err != nil {
+		uc.logger.Error(ctx, "failed to create Organization", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
 	err = uc.txManager.Do(ctx, func(ctx context.Context) error {
 		_, err := uc.userRepository.Create(ctx, user)
 		if err != nil {
@@ -61,6 +99,25 @@ func (uc *signupUseCaseImpl) Execute(ctx context.Context, input SignupInput) (*S
 			return err
 		}
 
+		founder, err := entity.NewOrganizationMembership(
+			org.ID(), user.ID(), entity.OrganizationOwnerRoleID, user.CreatedAt(),
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.orgRepository.Create(ctx, org, founder); err != nil {
+			uc.logger.Error(ctx, "failed to save Organization", "error", err)
+
+			return err
+		}
+
+		// Accepting inside the transaction means the user, the accepted invitation and the invited
+		// roles are committed together or not at all.
+		if invited {
+			return uc.acceptInvitation(ctx, input.InvitationToken, user)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -72,19 +129,87 @@ func (uc *signupUseCaseImpl) Execute(ctx context.Context, input SignupInput) (*S
 	}
 
 	return &SignupOutput{
-		ID:        user.ID(),
-		Name:      user.Name(),
-		Email:     user.Email(),
-		CreatedAt: user.CreatedAt(),
-		Status:    user.Status(),
+		ID:             user.ID(),
+		OrganizationID: org.ID(),
+		Name:           user.Name(),
+		Email:          user.Email(),
+		CreatedAt:      user.CreatedAt(),
+		Status:         user.Status(),
 	}, nil
 }
 
-func NewSignupUseCase(userRepository repository.UserRepository, txManager shared.TransactionManager) SingupUseCase {
+// acceptInvitation marks the invitation as used by user and grants the invited roles on behalf of the inviter.
+func (uc *signupUseCaseImpl) acceptInvitation(ctx context.Context, rawToken string, user entity.User) error {
+	token, err := vo.NewInvitationToken(rawToken)
+	if err != nil {
+		return err
+	}
+
+	invitation, err := uc.invitationRepository.FindByTokenHash(ctx, token.Hash())
+	if err != nil {
+		if errors.Is(err, repository.ErrInvitationNotFound) {
+			return vo.NewValidationError("invitation is invalid", nil, errInvitationInvalid)
+		}
+
+		return err
+	}
+
+	if err := invitation.Accept(user.ID(), user.Email(), user.CreatedAt()); err != nil {
+		return err
+	}
+
+	if err := uc.invitationRepository.MarkAccepted(ctx, invitation); err != nil {
+		if errors.Is(err, repository.ErrInvitationAlreadyAccepted) {
+			return vo.NewValidationError("invitation has already been accepted", nil, errInvitationRaceAccepted)
+		}
+
+		uc.logger.Error(ctx, "failed to accept Invitation", "error", err)
+
+		return err
+	}
+
+	for _, roleID := range invitation.RoleIDs() {
+		assignment, err := entity.NewRoleAssignment(
+			user.ID(), roleID, invitation.InvitedBy(), user.CreatedAt(), nil, invitationGrantReason,
+		)
+		if err != nil {
+			return err
+		}
+
+		if _, err := uc.roleAssignmentRepository.Grant(ctx, assignment); err != nil {
+			uc.logger.Error(ctx, "failed to grant invited role", "error", err, "roleID", roleID)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func signupRejectedMessage(mode vo.SignupMode) string {
+	if mode == vo.SignupModeAllowedDomains {
+		return "email domain is not allowed to sign up without an invitation"
+	}
+
+	return "signup requires an invitation"
+}
+
+func NewSignupUseCase(
+	userRepository repository.UserRepository,
+	orgRepository repository.OrganizationRepository,
+	invitationRepository repository.InvitationRepository,
+	roleAssignmentRepository repository.RoleAssignmentRepository,
+	policy vo.SignupPolicy,
+	txManager shared.TransactionManager,
+) SingupUseCase {
 	return &signupUseCaseImpl{
-		tracer:         otel.Tracer("SignupUseCase"),
-		logger:         common.NewLogger(),
-		userRepository: userRepository,
-		txManager:      txManager,
+		tracer:                   otel.Tracer("SignupUseCase"),
+		logger:                   common.NewLogger(),
+		userRepository:           userRepository,
+		orgRepository:            orgRepository,
+		invitationRepository:     invitationRepository,
+		roleAssignmentRepository: roleAssignmentRepository,
+		policy:                   policy,
+		txManager:                txManager,
 	}
 }
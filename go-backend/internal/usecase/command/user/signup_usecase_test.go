@@ -4,17 +4,30 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
 	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
 	mock_entity "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity"
 	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
 	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
+func openSignupPolicy(t *testing.T) vo.SignupPolicy {
+	t.Helper()
+
+	policy, err := vo.NewSignupPolicy(vo.SignupModeOpen, nil)
+	require.NoError(t, err)
+
+	return policy
+}
+
 func TestSignupUseCase_HappyCase(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -41,11 +54,27 @@ func TestSignupUseCase_HappyCase(t *testing.T) {
 			mockUser := mock_entity.NewMockUser(ctrl)
 			userRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(mockUser, nil).Times(1)
 
-			usecase := user.NewSignupUseCase(userRepository, txManager)
+			orgRepository := mock_repository.NewMockOrganizationRepository(ctrl)
+			orgRepository.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, org entity.Organization, founder entity.OrganizationMembership) error {
+					assert.Equal(t, tt.input.Name, org.Name())
+					assert.Equal(t, org.ID(), founder.OrganizationID())
+					assert.Equal(t, entity.OrganizationOwnerRoleID, founder.RoleID())
+
+					return nil
+				},
+			).Times(1)
+
+			usecase := user.NewSignupUseCase(
+				userRepository, orgRepository,
+				mock_repository.NewMockInvitationRepository(ctrl), mock_repository.NewMockRoleAssignmentRepository(ctrl),
+				openSignupPolicy(t), txManager,
+			)
 
 			output, err := usecase.Execute(ctx, tt.input)
 
 			require.NoError(t, err)
+			assert.NotEqual(t, uuid.Nil, output.OrganizationID)
 			assert.Equal(t, output.Name, tt.input.Name)
 			assert.Equal(t, output.Email, tt.input.Email)
 			assert.Equal(t, vo.UserStatusActive, output.Status)
@@ -58,6 +87,7 @@ func TestSignupUseCase_FailureCase(t *testing.T) {
 		name      string
 		input     user.SignupInput
 		createErr error
+		orgErr    error
 		txError   error
 	}{
 		{
@@ -80,6 +110,15 @@ func TestSignupUseCase_FailureCase(t *testing.T) {
 			createErr: errors.New("test"),
 			txError:   nil,
 		},
+		{
+			name: "failed to save organization",
+			input: user.SignupInput{
+				Name:     "test",
+				Email:    "test@example.com",
+				Password: "password",
+			},
+			orgErr: errors.New("test"),
+		},
 		{
 			name: "transaction error",
 			input: user.SignupInput{
@@ -103,7 +142,14 @@ func TestSignupUseCase_FailureCase(t *testing.T) {
 			mockUser := mock_entity.NewMockUser(ctrl)
 			userRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(mockUser, tt.createErr).AnyTimes()
 
-			usecase := user.NewSignupUseCase(userRepository, txManager)
+			orgRepository := mock_repository.NewMockOrganizationRepository(ctrl)
+			orgRepository.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.orgErr).AnyTimes()
+
+			usecase := user.NewSignupUseCase(
+				userRepository, orgRepository,
+				mock_repository.NewMockInvitationRepository(ctrl), mock_repository.NewMockRoleAssignmentRepository(ctrl),
+				openSignupPolicy(t), txManager,
+			)
 
 			output, err := usecase.Execute(ctx, tt.input)
 
@@ -112,3 +158,238 @@ func TestSignupUseCase_FailureCase(t *testing.T) {
 		})
 	}
 }
+
+func TestSignupUseCase_SignupPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      vo.SignupMode
+		domains   []string
+		email     string
+		wantAdmit bool
+	}{
+		{name: "open admits anyone", mode: vo.SignupModeOpen, email: "a@example.com", wantAdmit: true},
+		{name: "invite only rejects uninvited", mode: vo.SignupModeInviteOnly, email: "a@example.com"},
+		{
+			name:      "allowed domain is admitted",
+			mode:      vo.SignupModeAllowedDomains,
+			domains:   []string{"example.com"},
+			email:     "a@Example.COM",
+			wantAdmit: true,
+		},
+		{
+			name:    "other domain is rejected",
+			mode:    vo.SignupModeAllowedDomains,
+			domains: []string{"example.com"},
+			email:   "a@other.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			policy, err := vo.NewSignupPolicy(tt.mode, tt.domains)
+			require.NoError(t, err)
+
+			userRepository := mock_repository.NewMockUserRepository(ctrl)
+			orgRepository := mock_repository.NewMockOrganizationRepository(ctrl)
+
+			if tt.wantAdmit {
+				userRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(mock_entity.NewMockUser(ctrl), nil)
+				orgRepository.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			usecase := user.NewSignupUseCase(
+				userRepository, orgRepository,
+				mock_repository.NewMockInvitationRepository(ctrl), mock_repository.NewMockRoleAssignmentRepository(ctrl),
+				policy, mock_shared.NewMockTransactionManager(nil),
+			)
+
+			output, err := usecase.Execute(context.Background(), user.SignupInput{
+				Name:     "test",
+				Email:    tt.email,
+				Password: "password",
+			})
+
+			if tt.wantAdmit {
+				require.NoError(t, err)
+				assert.NotNil(t, output)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ForbiddenErrorCode, voErr.Code())
+			assert.Nil(t, output)
+		})
+	}
+}
+
+func TestSignupUseCase_AcceptsInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	inviterID, roleA, roleB := uuid.New(), uuid.New(), uuid.New()
+	token := vo.InvitationToken("invitation-token")
+
+	// Invite-only mode still admits invited signups.
+	policy, err := vo.NewSignupPolicy(vo.SignupModeInviteOnly, nil)
+	require.NoError(t, err)
+
+	invitation := entity.ReconstructInvitation(
+		uuid.New(), "invitee@example.com", []uuid.UUID{roleA, roleB}, inviterID, token.Hash(),
+		time.Now().Add(time.Hour), nil, uuid.Nil, time.Now(),
+	)
+
+	userRepository := mock_repository.NewMockUserRepository(ctrl)
+	userRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(mock_entity.NewMockUser(ctrl), nil).Times(1)
+
+	orgRepository := mock_repository.NewMockOrganizationRepository(ctrl)
+	orgRepository.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	invitationRepository := mock_repository.NewMockInvitationRepository(ctrl)
+	invitationRepository.EXPECT().FindByTokenHash(gomock.Any(), token.Hash()).Return(invitation, nil).Times(1)
+	invitationRepository.EXPECT().MarkAccepted(gomock.Any(), invitation).Return(nil).Times(1)
+
+	var granted []uuid.UUID
+
+	roleRepository := mock_repository.NewMockRoleAssignmentRepository(ctrl)
+	roleRepository.EXPECT().Grant(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, a entity.RoleAssignment) (entity.RoleAssignment, error) {
+			assert.Equal(t, inviterID, a.GrantedBy())
+			assert.Nil(t, a.ExpiresAt())
+
+			granted = append(granted, a.RoleID())
+
+			return a, nil
+		},
+	).Times(2)
+
+	usecase := user.NewSignupUseCase(
+		userRepository, orgRepository, invitationRepository, roleRepository,
+		policy, mock_shared.NewMockTransactionManager(nil),
+	)
+
+	output, err := usecase.Execute(context.Background(), user.SignupInput{
+		Name:            "invitee",
+		Email:           "Invitee@example.com",
+		Password:        "password",
+		InvitationToken: token.String(),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{roleA, roleB}, granted)
+	assert.Equal(t, output.ID, invitation.AcceptedBy())
+	assert.NotNil(t, invitation.AcceptedAt())
+}
+
+func TestSignupUseCase_InvitationFailure(t *testing.T) {
+	token := vo.InvitationToken("invitation-token")
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name       string
+		email      string
+		expiresIn  time.Duration
+		findErr    error
+		acceptErr  error
+		grantErr   error
+		wantErr    error
+		wantCode   vo.ErrorCode
+		wantAccept bool
+		wantGrant  bool
+	}{
+		{
+			name:     "unknown token",
+			email:    "invitee@example.com",
+			findErr:  repository.ErrInvitationNotFound,
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:      "email does not match the invitation",
+			email:     "someone-else@example.com",
+			expiresIn: time.Hour,
+			wantCode:  vo.ValidationErrorCode,
+		},
+		{
+			name:      "invitation has expired",
+			email:     "invitee@example.com",
+			expiresIn: -time.Hour,
+			wantCode:  vo.ValidationErrorCode,
+		},
+		{
+			name:       "accepted concurrently",
+			email:      "invitee@example.com",
+			expiresIn:  time.Hour,
+			acceptErr:  repository.ErrInvitationAlreadyAccepted,
+			wantCode:   vo.ValidationErrorCode,
+			wantAccept: true,
+		},
+		{
+			name:       "failed to grant invited role",
+			email:      "invitee@example.com",
+			expiresIn:  time.Hour,
+			grantErr:   errDB,
+			wantErr:    errDB,
+			wantAccept: true,
+			wantGrant:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			invitation := entity.ReconstructInvitation(
+				uuid.New(), "invitee@example.com", []uuid.UUID{uuid.New()}, uuid.New(), token.Hash(),
+				time.Now().Add(tt.expiresIn), nil, uuid.Nil, time.Now().Add(-2*time.Hour),
+			)
+
+			userRepository := mock_repository.NewMockUserRepository(ctrl)
+			userRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(mock_entity.NewMockUser(ctrl), nil)
+
+			orgRepository := mock_repository.NewMockOrganizationRepository(ctrl)
+			orgRepository.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+			invitationRepository := mock_repository.NewMockInvitationRepository(ctrl)
+			if tt.findErr != nil {
+				invitationRepository.EXPECT().FindByTokenHash(gomock.Any(), gomock.Any()).Return(nil, tt.findErr)
+			} else {
+				invitationRepository.EXPECT().FindByTokenHash(gomock.Any(), gomock.Any()).Return(invitation, nil)
+			}
+
+			if tt.wantAccept {
+				invitationRepository.EXPECT().MarkAccepted(gomock.Any(), gomock.Any()).Return(tt.acceptErr)
+			}
+
+			roleRepository := mock_repository.NewMockRoleAssignmentRepository(ctrl)
+			if tt.wantGrant {
+				roleRepository.EXPECT().Grant(gomock.Any(), gomock.Any()).Return(nil, tt.grantErr)
+			}
+
+			usecase := user.NewSignupUseCase(
+				userRepository, orgRepository, invitationRepository, roleRepository,
+				openSignupPolicy(t), mock_shared.NewMockTransactionManager(nil),
+			)
+
+			output, err := usecase.Execute(context.Background(), user.SignupInput{
+				Name:            "invitee",
+				Email:           tt.email,
+				Password:        "password",
+				InvitationToken: token.String(),
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
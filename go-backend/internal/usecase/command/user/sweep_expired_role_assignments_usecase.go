@@ -0,0 +1,77 @@
+package user
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SweepExpiredRoleAssignmentsUseCase removes role assignments whose expiry has passed.
+// Permission checks already ignore expired rows; the sweep keeps user_roles small and records
+// the expiry in the assignment history.
+type SweepExpiredRoleAssignmentsUseCase interface {
+	Execute(ctx context.Context) (*SweepExpiredRoleAssignmentsOutput, error)
+}
+
+type SweepExpiredRoleAssignmentsOutput struct {
+	Expired int
+}
+
+type sweepExpiredRoleAssignmentsUseCaseImpl struct {
+	tracer                   trace.Tracer
+	logger                   common.Logger
+	roleAssignmentRepository repository.RoleAssignmentRepository
+	txManager                shared.TransactionManager
+}
+
+func (uc *sweepExpiredRoleAssignmentsUseCaseImpl) Execute(
+	ctx context.Context,
+) (*SweepExpiredRoleAssignmentsOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	var expired int
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		removed, repoErr := uc.roleAssignmentRepository.DeleteExpired(ctx)
+		if repoErr != nil {
+			uc.logger.Error(ctx, "failed to delete expired RoleAssignments", "error", repoErr)
+
+			return repoErr
+		}
+
+		expired = len(removed)
+
+		return nil
+	})
+	if err != nil {
+		uc.logger.Error(ctx, "transaction error", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	if expired > 0 {
+		uc.logger.Info(ctx, "expired role assignments removed", "count", expired)
+	}
+
+	return &SweepExpiredRoleAssignmentsOutput{Expired: expired}, nil
+}
+
+func NewSweepExpiredRoleAssignmentsUseCase(
+	roleAssignmentRepository repository.RoleAssignmentRepository,
+	txManager shared.TransactionManager,
+) SweepExpiredRoleAssignmentsUseCase {
+	return &sweepExpiredRoleAssignmentsUseCaseImpl{
+		tracer:                   otel.Tracer("SweepExpiredRoleAssignmentsUseCase"),
+		logger:                   common.NewLogger(),
+		roleAssignmentRepository: roleAssignmentRepository,
+		txManager:                txManager,
+	}
+}
@@ -0,0 +1,48 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSweepExpiredRoleAssignmentsUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	expiredAt := time.Now().Add(-time.Minute)
+
+	roleRepo := mock_repository.NewMockRoleAssignmentRepository(ctrl)
+	roleRepo.EXPECT().DeleteExpired(gomock.Any()).Return([]entity.RoleAssignment{
+		entity.ReconstructRoleAssignment(uuid.New(), uuid.New(), uuid.New(), expiredAt.Add(-time.Hour), &expiredAt, "a"),
+		entity.ReconstructRoleAssignment(uuid.New(), uuid.New(), uuid.New(), expiredAt.Add(-time.Hour), &expiredAt, "b"),
+	}, nil).Times(1)
+
+	uc := user.NewSweepExpiredRoleAssignmentsUseCase(roleRepo, mock_shared.NewMockTransactionManager(nil))
+	output, err := uc.Execute(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, output.Expired)
+}
+
+func TestSweepExpiredRoleAssignmentsUseCase_FailureCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	errDB := errors.New("db error")
+
+	roleRepo := mock_repository.NewMockRoleAssignmentRepository(ctrl)
+	roleRepo.EXPECT().DeleteExpired(gomock.Any()).Return(nil, errDB).Times(1)
+
+	uc := user.NewSweepExpiredRoleAssignmentsUseCase(roleRepo, mock_shared.NewMockTransactionManager(nil))
+	output, err := uc.Execute(context.Background())
+
+	require.ErrorIs(t, err, errDB)
+	assert.Nil(t, output)
+}
@@ -0,0 +1,55 @@
+package user
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// UnblockUserUseCase removes the actor's block of a user. Follows ended by the block are not restored.
+// Unblocking a user the actor does not block succeeds without changing anything.
+type UnblockUserUseCase interface {
+	Execute(ctx context.Context, input UnblockUserInput) error
+}
+
+type UnblockUserInput struct {
+	ActorID uuid.UUID
+	UserID  uuid.UUID
+}
+
+type unblockUserUseCaseImpl struct {
+	tracer          trace.Tracer
+	logger          common.Logger
+	blockRepository repository.BlockRepository
+}
+
+func (uc *unblockUserUseCaseImpl) Execute(ctx context.Context, input UnblockUserInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	removed, err := uc.blockRepository.Remove(ctx, input.ActorID, input.UserID)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to remove Block", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "user unblocked", "actorID", input.ActorID, "userID", input.UserID, "changed", removed)
+
+	return nil
+}
+
+func NewUnblockUserUseCase(blockRepository repository.BlockRepository) UnblockUserUseCase {
+	return &unblockUserUseCaseImpl{
+		tracer:          otel.Tracer("UnblockUserUseCase"),
+		logger:          common.NewLogger(),
+		blockRepository: blockRepository,
+	}
+}
@@ -0,0 +1,43 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnblockUserUseCase_HappyCase(t *testing.T) {
+	// Unblocking a user who is not blocked is not an error.
+	for _, removed := range []bool{true, false} {
+		ctrl := gomock.NewController(t)
+		actorID, userID := uuid.New(), uuid.New()
+
+		blockRepository := mock_repository.NewMockBlockRepository(ctrl)
+		blockRepository.EXPECT().Remove(gomock.Any(), actorID, userID).Return(removed, nil).Times(1)
+
+		uc := user.NewUnblockUserUseCase(blockRepository)
+		err := uc.Execute(context.Background(), user.UnblockUserInput{ActorID: actorID, UserID: userID})
+
+		require.NoError(t, err)
+	}
+}
+
+func TestUnblockUserUseCase_FailureCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	errDB := errors.New("db error")
+
+	blockRepository := mock_repository.NewMockBlockRepository(ctrl)
+	blockRepository.EXPECT().Remove(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, errDB).Times(1)
+
+	uc := user.NewUnblockUserUseCase(blockRepository)
+	err := uc.Execute(context.Background(), user.UnblockUserInput{ActorID: uuid.New(), UserID: uuid.New()})
+
+	assert.ErrorIs(t, err, errDB)
+}
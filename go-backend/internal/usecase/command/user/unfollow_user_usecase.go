@@ -0,0 +1,75 @@
+package user
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/shared"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// UnfollowUserUseCase makes the actor stop following a user and removes the user's posts from the actor's
+// timeline. Unfollowing a user the actor does not follow succeeds without changing anything.
+type UnfollowUserUseCase interface {
+	Execute(ctx context.Context, input UnfollowUserInput) error
+}
+
+type UnfollowUserInput struct {
+	ActorID uuid.UUID
+	UserID  uuid.UUID
+}
+
+type unfollowUserUseCaseImpl struct {
+	tracer             trace.Tracer
+	logger             common.Logger
+	followRepository   repository.FollowRepository
+	timelineRepository repository.TimelineRepository
+	txManager          shared.TransactionManager
+}
+
+func (uc *unfollowUserUseCaseImpl) Execute(ctx context.Context, input UnfollowUserInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	var removed bool
+
+	err := uc.txManager.Do(ctx, func(ctx context.Context) error {
+		var txErr error
+
+		removed, txErr = uc.followRepository.Remove(ctx, input.ActorID, input.UserID)
+		if txErr != nil || !removed {
+			return txErr
+		}
+
+		return uc.timelineRepository.Prune(ctx, input.ActorID, input.UserID)
+	})
+	if err != nil {
+		uc.logger.Error(ctx, "failed to remove Follow", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "user unfollowed", "actorID", input.ActorID, "userID", input.UserID, "changed", removed)
+
+	return nil
+}
+
+func NewUnfollowUserUseCase(
+	followRepository repository.FollowRepository,
+	timelineRepository repository.TimelineRepository,
+	txManager shared.TransactionManager,
+) UnfollowUserUseCase {
+	return &unfollowUserUseCaseImpl{
+		tracer:             otel.Tracer("UnfollowUserUseCase"),
+		logger:             common.NewLogger(),
+		followRepository:   followRepository,
+		timelineRepository: timelineRepository,
+		txManager:          txManager,
+	}
+}
@@ -0,0 +1,79 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	mock_shared "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnfollowUserUseCase_HappyCase(t *testing.T) {
+	// Unfollowing a user who is not followed is not an error; only a removed follow prunes the timeline.
+	tests := []struct {
+		name    string
+		removed bool
+		prunes  int
+	}{
+		{name: "existing follow", removed: true, prunes: 1},
+		{name: "no follow", removed: false, prunes: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID, userID := uuid.New(), uuid.New()
+
+			followRepository := mock_repository.NewMockFollowRepository(ctrl)
+			followRepository.EXPECT().Remove(gomock.Any(), actorID, userID).Return(tt.removed, nil).Times(1)
+
+			timelineRepository := mock_repository.NewMockTimelineRepository(ctrl)
+			timelineRepository.EXPECT().Prune(gomock.Any(), actorID, userID).Return(nil).Times(tt.prunes)
+
+			uc := user.NewUnfollowUserUseCase(followRepository, timelineRepository, mock_shared.NewMockTransactionManager(nil))
+			err := uc.Execute(context.Background(), user.UnfollowUserInput{ActorID: actorID, UserID: userID})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUnfollowUserUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name      string
+		removeErr error
+		pruneErr  error
+		txErr     error
+	}{
+		{name: "remove fails", removeErr: errDB},
+		{name: "prune fails", pruneErr: errDB},
+		{name: "transaction fails", txErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			followRepository := mock_repository.NewMockFollowRepository(ctrl)
+			followRepository.EXPECT().Remove(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(tt.removeErr == nil, tt.removeErr).AnyTimes()
+
+			timelineRepository := mock_repository.NewMockTimelineRepository(ctrl)
+			timelineRepository.EXPECT().Prune(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.pruneErr).AnyTimes()
+
+			txManager := mock_shared.NewMockTransactionManager(tt.txErr)
+			uc := user.NewUnfollowUserUseCase(followRepository, timelineRepository, txManager)
+			err := uc.Execute(context.Background(), user.UnfollowUserInput{ActorID: uuid.New(), UserID: uuid.New()})
+
+			assert.ErrorIs(t, err, errDB)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package user
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// UnmuteUserUseCase removes the actor's mute of a user. Unmuting a user the actor does not mute succeeds
+// without changing anything.
+type UnmuteUserUseCase interface {
+	Execute(ctx context.Context, input UnmuteUserInput) error
+}
+
+type UnmuteUserInput struct {
+	ActorID uuid.UUID
+	UserID  uuid.UUID
+}
+
+type unmuteUserUseCaseImpl struct {
+	tracer         trace.Tracer
+	logger         common.Logger
+	muteRepository repository.MuteRepository
+}
+
+func (uc *unmuteUserUseCaseImpl) Execute(ctx context.Context, input UnmuteUserInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	removed, err := uc.muteRepository.Remove(ctx, input.ActorID, input.UserID)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to remove Mute", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "user unmuted", "actorID", input.ActorID, "userID", input.UserID, "changed", removed)
+
+	return nil
+}
+
+func NewUnmuteUserUseCase(muteRepository repository.MuteRepository) UnmuteUserUseCase {
+	return &unmuteUserUseCaseImpl{
+		tracer:         otel.Tracer("UnmuteUserUseCase"),
+		logger:         common.NewLogger(),
+		muteRepository: muteRepository,
+	}
+}
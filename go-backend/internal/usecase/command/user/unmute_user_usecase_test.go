@@ -0,0 +1,43 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUnmuteUserUseCase_HappyCase(t *testing.T) {
+	// Unmuting a user who is not muted is not an error.
+	for _, removed := range []bool{true, false} {
+		ctrl := gomock.NewController(t)
+		actorID, userID := uuid.New(), uuid.New()
+
+		muteRepository := mock_repository.NewMockMuteRepository(ctrl)
+		muteRepository.EXPECT().Remove(gomock.Any(), actorID, userID).Return(removed, nil).Times(1)
+
+		uc := user.NewUnmuteUserUseCase(muteRepository)
+		err := uc.Execute(context.Background(), user.UnmuteUserInput{ActorID: actorID, UserID: userID})
+
+		require.NoError(t, err)
+	}
+}
+
+func TestUnmuteUserUseCase_FailureCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	errDB := errors.New("db error")
+
+	muteRepository := mock_repository.NewMockMuteRepository(ctrl)
+	muteRepository.EXPECT().Remove(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, errDB).Times(1)
+
+	uc := user.NewUnmuteUserUseCase(muteRepository)
+	err := uc.Execute(context.Background(), user.UnmuteUserInput{ActorID: uuid.New(), UserID: uuid.New()})
+
+	assert.ErrorIs(t, err, errDB)
+}
@@ -0,0 +1,78 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errOthersProfile = errors.New("profile of another user")
+
+// UpdateProfileUseCase replaces the public profile of the actor. Users can only update their own profile.
+type UpdateProfileUseCase interface {
+	Execute(ctx context.Context, input UpdateProfileInput) error
+}
+
+type UpdateProfileInput struct {
+	ActorID uuid.UUID
+	UserID  uuid.UUID
+	// Bio and AvatarURL replace the stored values; an empty one clears its field.
+	Bio       string
+	AvatarURL string
+}
+
+type updateProfileUseCaseImpl struct {
+	tracer                trace.Tracer
+	logger                common.Logger
+	userProfileRepository repository.UserProfileRepository
+}
+
+func (uc *updateProfileUseCaseImpl) Execute(ctx context.Context, input UpdateProfileInput) error {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	if input.ActorID != input.UserID {
+		err := vo.NewForbiddenError("only your own profile can be updated", nil, errOthersProfile)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	profile, err := entity.NewUserProfile(input.UserID, input.Bio, input.AvatarURL, time.Now())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	if err = uc.userProfileRepository.Save(ctx, profile); err != nil {
+		uc.logger.Error(ctx, "failed to save UserProfile", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return err
+	}
+
+	uc.logger.Info(ctx, "profile updated", "userID", input.UserID)
+
+	return nil
+}
+
+func NewUpdateProfileUseCase(userProfileRepository repository.UserProfileRepository) UpdateProfileUseCase {
+	return &updateProfileUseCaseImpl{
+		tracer:                otel.Tracer("UpdateProfileUseCase"),
+		logger:                common.NewLogger(),
+		userProfileRepository: userProfileRepository,
+	}
+}
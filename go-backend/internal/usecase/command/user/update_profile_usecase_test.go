@@ -0,0 +1,93 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/command/user"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/entity/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpdateProfileUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userID := uuid.New()
+
+	userProfileRepository := mock_repository.NewMockUserProfileRepository(ctrl)
+	userProfileRepository.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, p entity.UserProfile) error {
+			assert.Equal(t, userID, p.UserID())
+			assert.Equal(t, "Gopher", p.Bio().String())
+			require.NotNil(t, p.AvatarURL())
+			assert.Equal(t, "https://example.com/a.png", p.AvatarURL().String())
+
+			return nil
+		}).Times(1)
+
+	uc := user.NewUpdateProfileUseCase(userProfileRepository)
+	err := uc.Execute(context.Background(), user.UpdateProfileInput{
+		ActorID:   userID,
+		UserID:    userID,
+		Bio:       " Gopher ",
+		AvatarURL: "https://example.com/a.png",
+	})
+
+	require.NoError(t, err)
+}
+
+func TestUpdateProfileUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+	actorID := uuid.New()
+
+	tests := []struct {
+		name      string
+		userID    uuid.UUID
+		avatarURL string
+		saveErr   error
+		wantErr   error
+		wantCode  vo.ErrorCode
+	}{
+		{name: "profile of another user", userID: uuid.New(), wantCode: vo.ForbiddenErrorCode},
+		{
+			name:      "invalid avatar URL",
+			userID:    actorID,
+			avatarURL: "http://example.com/a.png",
+			wantCode:  vo.ValidationErrorCode,
+		},
+		{name: "save fails", userID: actorID, saveErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			userProfileRepository := mock_repository.NewMockUserProfileRepository(ctrl)
+			userProfileRepository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(tt.saveErr).AnyTimes()
+
+			uc := user.NewUpdateProfileUseCase(userProfileRepository)
+			err := uc.Execute(context.Background(), user.UpdateProfileInput{
+				ActorID:   actorID,
+				UserID:    tt.userID,
+				AvatarURL: tt.avatarURL,
+			})
+
+			require.Error(t, err)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
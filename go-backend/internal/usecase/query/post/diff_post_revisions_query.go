@@ -0,0 +1,30 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// DiffPostRevisionsInput selects two revisions of a post by number. From may be newer than To,
+// in which case the diff describes reverting the change.
+type DiffPostRevisionsInput struct {
+	PostID uuid.UUID
+	From   int
+	To     int
+	// ViewerID is the requesting user; posts it may not see are reported as missing.
+	ViewerID uuid.UUID
+}
+
+// PostRevisionDiffDto is a line-based diff that turns From's content into To's.
+type PostRevisionDiffDto struct {
+	From  PostRevisionDto
+	To    PostRevisionDto
+	Lines []vo.DiffLine
+}
+
+// DiffPostRevisionsUseCase is the application use case for comparing two revisions of a post.
+type DiffPostRevisionsUseCase interface {
+	Execute(ctx context.Context, input DiffPostRevisionsInput) (*PostRevisionDiffDto, error)
+}
@@ -0,0 +1,110 @@
+package post
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const minRevisionNumber = 1
+
+var (
+	errInvalidRevisionNumber = errors.New("revision number must be positive")
+	errPostRevisionNotFound  = errors.New("post revision not found")
+)
+
+type diffPostRevisionsUseCaseImpl struct {
+	tracer           trace.Tracer
+	logger           common.Logger
+	postQueryService PostQueryService
+	contentRenderer  service.ContentRenderer
+}
+
+func (uc *diffPostRevisionsUseCaseImpl) Execute(
+	ctx context.Context, input DiffPostRevisionsInput,
+) (*PostRevisionDiffDto, error) {
+	ctx, span := uc.tracer.Start(ctx, "diff_post_revisions")
+	defer span.End()
+
+	if input.From < minRevisionNumber || input.To < minRevisionNumber {
+		err := vo.NewValidationError("from and to must be 1 or greater", nil, errInvalidRevisionNumber)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	post, err := uc.postQueryService.FindByID(ctx, input.PostID, input.ViewerID)
+	if err == nil && post == nil {
+		err = vo.NewNotFoundError("post not found", nil, errPostNotFound)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	from, err := uc.findRevision(ctx, input, input.From)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	to, err := uc.findRevision(ctx, input, input.To)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return &PostRevisionDiffDto{
+		From:  *from,
+		To:    *to,
+		Lines: vo.DiffLines(from.Content, to.Content),
+	}, nil
+}
+
+// findRevision reports a missing revision, including one of a post in another tenant, as not found.
+func (uc *diffPostRevisionsUseCaseImpl) findRevision(
+	ctx context.Context, input DiffPostRevisionsInput, number int,
+) (*PostRevisionDto, error) {
+	revision, err := uc.postQueryService.FindRevision(ctx, input.PostID, number)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find post revision", "error", err)
+
+		return nil, err
+	}
+
+	if revision == nil {
+		return nil, vo.NewNotFoundError(
+			"post revision not found", map[string]any{"number": number}, errPostRevisionNotFound,
+		)
+	}
+
+	renderRevision(uc.contentRenderer, input.PostID, revision)
+
+	return revision, nil
+}
+
+// NewDiffPostRevisionsUseCase creates a new DiffPostRevisionsUseCase.
+func NewDiffPostRevisionsUseCase(
+	postQueryService PostQueryService, contentRenderer service.ContentRenderer,
+) DiffPostRevisionsUseCase {
+	return &diffPostRevisionsUseCaseImpl{
+		tracer:           otel.Tracer("DiffPostRevisionsUseCase"),
+		logger:           common.NewLogger(),
+		postQueryService: postQueryService,
+		contentRenderer:  contentRenderer,
+	}
+}
@@ -0,0 +1,134 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDiffPostRevisionsUseCase_HappyCase(t *testing.T) {
+	now := time.Now().UTC()
+	first := &post.PostRevisionDto{ID: uuid.New(), Number: 1, Content: "hello\ntypo", CreatedAt: now}
+	second := &post.PostRevisionDto{ID: uuid.New(), Number: 2, Content: "hello\nfixed", CreatedAt: now}
+
+	tests := []struct {
+		name      string
+		from, to  *post.PostRevisionDto
+		wantLines []vo.DiffLine
+	}{
+		{
+			name: "diff forwards",
+			from: first,
+			to:   second,
+			wantLines: []vo.DiffLine{
+				{Operation: vo.DiffOperationEqual, Text: "hello"},
+				{Operation: vo.DiffOperationDelete, Text: "typo"},
+				{Operation: vo.DiffOperationInsert, Text: "fixed"},
+			},
+		},
+		{
+			name: "diff backwards describes the revert",
+			from: second,
+			to:   first,
+			wantLines: []vo.DiffLine{
+				{Operation: vo.DiffOperationEqual, Text: "hello"},
+				{Operation: vo.DiffOperationDelete, Text: "fixed"},
+				{Operation: vo.DiffOperationInsert, Text: "typo"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			postID := uuid.New()
+
+			viewerID := uuid.New()
+
+			queryService := mock_query.NewMockPostQueryService(ctrl)
+			queryService.EXPECT().FindByID(gomock.Any(), postID, viewerID).Return(&post.PostDto{ID: postID}, nil).Times(1)
+			queryService.EXPECT().FindRevision(gomock.Any(), postID, tt.from.Number).Return(tt.from, nil).Times(1)
+			queryService.EXPECT().FindRevision(gomock.Any(), postID, tt.to.Number).Return(tt.to, nil).Times(1)
+
+			uc := post.NewDiffPostRevisionsUseCase(queryService, renderContent(ctrl))
+			output, err := uc.Execute(context.Background(), post.DiffPostRevisionsInput{
+				PostID:   postID,
+				ViewerID: viewerID,
+				From:     tt.from.Number,
+				To:       tt.to.Number,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, *tt.from, output.From)
+			assert.Equal(t, *tt.to, output.To)
+			assert.Equal(t, tt.wantLines, output.Lines)
+		})
+	}
+}
+
+func TestDiffPostRevisionsUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+	revision := &post.PostRevisionDto{ID: uuid.New(), Number: 1, Content: "hello"}
+
+	tests := []struct {
+		name     string
+		from, to int
+		hidden   bool
+		found    *post.PostRevisionDto
+		queryErr error
+		wantErr  error
+		wantCode vo.ErrorCode
+	}{
+		{name: "from below 1", from: 0, to: 1, wantCode: vo.ValidationErrorCode},
+		{name: "to below 1", from: 1, to: -1, wantCode: vo.ValidationErrorCode},
+		{name: "post is not visible to the viewer", from: 1, to: 2, hidden: true, wantCode: vo.NotFoundErrorCode},
+		{name: "revision does not exist", from: 1, to: 2, wantCode: vo.NotFoundErrorCode},
+		{name: "revision query fails", from: 1, to: 2, queryErr: errDB, wantErr: errDB},
+		{name: "second revision does not exist", from: 1, to: 9, found: revision, wantCode: vo.NotFoundErrorCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			var visible *post.PostDto
+			if !tt.hidden {
+				visible = &post.PostDto{}
+			}
+
+			queryService := mock_query.NewMockPostQueryService(ctrl)
+			queryService.EXPECT().FindByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(visible, nil).AnyTimes()
+			queryService.EXPECT().FindRevision(gomock.Any(), gomock.Any(), 1).Return(tt.found, tt.queryErr).AnyTimes()
+			queryService.EXPECT().FindRevision(gomock.Any(), gomock.Any(), gomock.Not(1)).Return(nil, tt.queryErr).AnyTimes()
+
+			uc := post.NewDiffPostRevisionsUseCase(queryService, renderContent(ctrl))
+			output, err := uc.Execute(context.Background(), post.DiffPostRevisionsInput{
+				PostID: uuid.New(),
+				From:   tt.from,
+				To:     tt.to,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
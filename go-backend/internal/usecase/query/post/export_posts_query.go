@@ -0,0 +1,46 @@
+//go:generate mockgen -source=export_posts_query.go -destination=../../../../test/mock/usecase/query/mock_post_export_query_service.go -package mock_query
+
+package post
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExportedPostDto is a post as written to an export: the fields an import needs to recreate it.
+type ExportedPostDto struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Content    string
+	Format     string
+	Visibility string
+	CreatedAt  time.Time
+}
+
+// PostExportQueryService is the port for reading every post of the active tenant (organization) in ctx
+// a page at a time.
+type PostExportQueryService interface {
+	// FindPage returns up to limit published posts that are neither hidden nor in the trash, oldest first,
+	// starting after the cursor (nil for the first page). The returned slice is never nil.
+	FindPage(ctx context.Context, after *PostCursor, limit int) ([]ExportedPostDto, error)
+}
+
+// ExportPostsInput holds the parameters for exporting the posts of the active tenant.
+type ExportPostsInput struct {
+	ActorID uuid.UUID
+}
+
+// ExportPostsOutput is the result returned by ExportPostsUseCase.
+type ExportPostsOutput struct {
+	// Posts reads the posts lazily, a page at a time, as it is iterated. It stops after yielding an error.
+	Posts iter.Seq2[ExportedPostDto, error]
+}
+
+// ExportPostsUseCase exports the posts of the active tenant for a backup or a migration. Drafts, hidden and
+// trashed posts are left out, since an import cannot recreate them.
+type ExportPostsUseCase interface {
+	Execute(ctx context.Context, input ExportPostsInput) (*ExportPostsOutput, error)
+}
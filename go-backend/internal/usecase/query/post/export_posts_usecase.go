@@ -0,0 +1,93 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"iter"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// exportPageSize is how many posts an export reads from the database at a time.
+const exportPageSize = 500
+
+var errLacksPostsExportPerm = errors.New("user lacks posts:export permission")
+
+type exportPostsUseCaseImpl struct {
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	permissionRepository   aggregaterepository.UserPermissionRepository
+	postExportQueryService PostExportQueryService
+}
+
+func (uc *exportPostsUseCaseImpl) Execute(ctx context.Context, input ExportPostsInput) (*ExportPostsOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	agg, err := uc.permissionRepository.FindByUserID(ctx, input.ActorID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	if !agg.HasPermission(vo.PermissionPostsExport) {
+		span.RecordError(errLacksPostsExportPerm)
+		span.SetStatus(codes.Error, errLacksPostsExportPerm.Error())
+
+		return nil, vo.NewForbiddenError("insufficient permissions", nil, errLacksPostsExportPerm)
+	}
+
+	uc.logger.Info(ctx, "posts export requested", "actorID", input.ActorID)
+
+	return &ExportPostsOutput{Posts: uc.posts(ctx)}, nil
+}
+
+// posts pages through the posts with a keyset cursor, so that only one page is held in memory at a time.
+// Every page is read on its own, so posts created during the export may or may not be included.
+func (uc *exportPostsUseCaseImpl) posts(ctx context.Context) iter.Seq2[ExportedPostDto, error] {
+	return func(yield func(ExportedPostDto, error) bool) {
+		var after *PostCursor
+
+		for {
+			page, err := uc.postExportQueryService.FindPage(ctx, after, exportPageSize)
+			if err != nil {
+				uc.logger.Error(ctx, "failed to read posts to export", "error", err)
+				yield(ExportedPostDto{}, err)
+
+				return
+			}
+
+			for _, post := range page {
+				if !yield(post, nil) {
+					return
+				}
+			}
+
+			if len(page) < exportPageSize {
+				return
+			}
+
+			last := page[len(page)-1]
+			after = &PostCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+		}
+	}
+}
+
+func NewExportPostsUseCase(
+	permissionRepository aggregaterepository.UserPermissionRepository,
+	postExportQueryService PostExportQueryService,
+) ExportPostsUseCase {
+	return &exportPostsUseCaseImpl{
+		tracer:                 otel.Tracer("ExportPostsUseCase"),
+		logger:                 common.NewLogger(),
+		permissionRepository:   permissionRepository,
+		postExportQueryService: postExportQueryService,
+	}
+}
@@ -0,0 +1,143 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	mock_aggregate_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/aggregate/repository"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func exportedPosts(n int) []post.ExportedPostDto {
+	start := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+
+	posts := make([]post.ExportedPostDto, 0, n)
+	for i := range n {
+		posts = append(posts, post.ExportedPostDto{ID: uuid.New(), CreatedAt: start.Add(time.Duration(i) * time.Second)})
+	}
+
+	return posts
+}
+
+func TestExportPostsUseCase_PagesThroughPosts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	actorID := uuid.New()
+	posts := exportedPosts(501)
+
+	permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+	permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).
+		Return(moderatorPermissions(actorID, vo.PermissionPostsExport), nil)
+
+	// The second page starts after the last post of the first one.
+	after := &post.PostCursor{CreatedAt: posts[499].CreatedAt, ID: posts[499].ID}
+	exportQS := mock_query.NewMockPostExportQueryService(ctrl)
+	gomock.InOrder(
+		exportQS.EXPECT().FindPage(gomock.Any(), nil, 500).Return(posts[:500], nil),
+		exportQS.EXPECT().FindPage(gomock.Any(), after, 500).Return(posts[500:], nil),
+	)
+
+	uc := post.NewExportPostsUseCase(permRepo, exportQS)
+	output, err := uc.Execute(context.Background(), post.ExportPostsInput{ActorID: actorID})
+	require.NoError(t, err)
+
+	var got []post.ExportedPostDto
+
+	for p, err := range output.Posts {
+		require.NoError(t, err)
+
+		got = append(got, p)
+	}
+
+	assert.Equal(t, posts, got)
+}
+
+func TestExportPostsUseCase_StopsEarly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	actorID := uuid.New()
+
+	permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+	permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).
+		Return(moderatorPermissions(actorID, vo.PermissionPostsExport), nil)
+
+	// Nothing is read until the posts are iterated, and no further page once the caller stops.
+	exportQS := mock_query.NewMockPostExportQueryService(ctrl)
+	exportQS.EXPECT().FindPage(gomock.Any(), nil, 500).Return(exportedPosts(500), nil).Times(1)
+
+	uc := post.NewExportPostsUseCase(permRepo, exportQS)
+	output, err := uc.Execute(context.Background(), post.ExportPostsInput{ActorID: actorID})
+	require.NoError(t, err)
+
+	for _, err := range output.Posts {
+		require.NoError(t, err)
+
+		break
+	}
+}
+
+func TestExportPostsUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	t.Run("lacks permission", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		actorID := uuid.New()
+
+		permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+		permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).
+			Return(moderatorPermissions(actorID, vo.PermissionPostsModerate), nil)
+
+		uc := post.NewExportPostsUseCase(permRepo, mock_query.NewMockPostExportQueryService(ctrl))
+		output, err := uc.Execute(context.Background(), post.ExportPostsInput{ActorID: actorID})
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+
+		var voErr vo.Error
+		require.ErrorAs(t, err, &voErr)
+		assert.Equal(t, vo.ForbiddenErrorCode, voErr.Code())
+	})
+
+	t.Run("permission lookup fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+		permRepo.EXPECT().FindByUserID(gomock.Any(), gomock.Any()).Return(nil, errDB)
+
+		uc := post.NewExportPostsUseCase(permRepo, mock_query.NewMockPostExportQueryService(ctrl))
+		output, err := uc.Execute(context.Background(), post.ExportPostsInput{ActorID: uuid.New()})
+
+		require.ErrorIs(t, err, errDB)
+		assert.Nil(t, output)
+	})
+
+	t.Run("reading a page fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		actorID := uuid.New()
+
+		permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+		permRepo.EXPECT().FindByUserID(gomock.Any(), actorID).
+			Return(moderatorPermissions(actorID, vo.PermissionPostsExport), nil)
+
+		exportQS := mock_query.NewMockPostExportQueryService(ctrl)
+		exportQS.EXPECT().FindPage(gomock.Any(), nil, 500).Return(nil, errDB).Times(1)
+
+		uc := post.NewExportPostsUseCase(permRepo, exportQS)
+		output, err := uc.Execute(context.Background(), post.ExportPostsInput{ActorID: actorID})
+		require.NoError(t, err)
+
+		var errs []error
+		for _, err := range output.Posts {
+			errs = append(errs, err)
+		}
+
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], errDB)
+	})
+}
@@ -0,0 +1,133 @@
+package post
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errAttachmentNotFound = errors.New("attachment not found")
+
+type getAttachmentContentUseCaseImpl struct {
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	attachmentQueryService AttachmentQueryService
+	postQueryService       PostQueryService
+	blobStorage            service.BlobStorage
+}
+
+func (uc *getAttachmentContentUseCaseImpl) Execute(
+	ctx context.Context, input GetAttachmentContentInput,
+) (*GetAttachmentContentOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "get_attachment_content")
+	defer span.End()
+
+	attachment, err := uc.findVisible(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	if attachment == nil {
+		err = vo.NewNotFoundError("attachment not found", nil, errAttachmentNotFound)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	output := &GetAttachmentContentOutput{
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+	}
+	key := attachment.StorageKey
+
+	if input.Thumbnail {
+		if attachment.ThumbnailKey == "" {
+			err = vo.NewNotFoundError("attachment has no thumbnail", nil, errAttachmentNotFound)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+
+			return nil, err
+		}
+
+		output.ContentType = vo.AttachmentContentTypePNG.String()
+		output.Size = 0
+		key = attachment.ThumbnailKey
+	}
+
+	output.Content, err = uc.blobStorage.Open(ctx, key)
+	if err != nil {
+		// A missing blob is a broken attachment rather than a missing one, so it is reported as an error.
+		uc.logger.Error(ctx, "failed to open attachment blob", "attachmentID", attachment.ID, "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findVisible returns nil unless the attachment exists and the user may see it. Uploads of other users that
+// are not attached yet, and attachments of posts the user may not see, are reported exactly like missing
+// ones.
+func (uc *getAttachmentContentUseCaseImpl) findVisible(
+	ctx context.Context, input GetAttachmentContentInput,
+) (*AttachmentDto, error) {
+	attachment, err := uc.attachmentQueryService.FindByID(ctx, input.AttachmentID)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find attachment", "error", err)
+
+		return nil, err
+	}
+
+	if attachment == nil {
+		return nil, nil
+	}
+
+	if attachment.PostID == nil {
+		if attachment.UploaderID != input.UserID {
+			return nil, nil
+		}
+
+		return attachment, nil
+	}
+
+	post, err := uc.postQueryService.FindByID(ctx, *attachment.PostID, input.UserID)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find post", "error", err)
+
+		return nil, err
+	}
+
+	if post == nil {
+		return nil, nil
+	}
+
+	return attachment, nil
+}
+
+// NewGetAttachmentContentUseCase creates a new GetAttachmentContentUseCase.
+func NewGetAttachmentContentUseCase(
+	attachmentQueryService AttachmentQueryService,
+	postQueryService PostQueryService,
+	blobStorage service.BlobStorage,
+) GetAttachmentContentUseCase {
+	return &getAttachmentContentUseCaseImpl{
+		tracer:                 otel.Tracer("GetAttachmentContentUseCase"),
+		logger:                 common.NewLogger(),
+		attachmentQueryService: attachmentQueryService,
+		postQueryService:       postQueryService,
+		blobStorage:            blobStorage,
+	}
+}
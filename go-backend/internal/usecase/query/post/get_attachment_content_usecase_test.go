@@ -0,0 +1,174 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	mock_service "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestAttachmentDto(uploaderID uuid.UUID, postID *uuid.UUID) *post.AttachmentDto {
+	return &post.AttachmentDto{
+		ID:           uuid.New(),
+		UploaderID:   uploaderID,
+		PostID:       postID,
+		FileName:     "photo.jpg",
+		ContentType:  "image/jpeg",
+		Size:         2048,
+		StorageKey:   "attachments/original",
+		ThumbnailKey: "attachments/thumbnail",
+	}
+}
+
+func TestGetAttachmentContentUseCase_HappyCase(t *testing.T) {
+	uploaderID := uuid.New()
+	postID := uuid.New()
+
+	tests := []struct {
+		name            string
+		attachment      *post.AttachmentDto
+		userID          uuid.UUID
+		thumbnail       bool
+		wantKey         string
+		wantContentType string
+		wantSize        int64
+	}{
+		{
+			name:       "attached file is visible to other members",
+			attachment: newTestAttachmentDto(uploaderID, &postID), userID: uuid.New(),
+			wantKey: "attachments/original", wantContentType: "image/jpeg", wantSize: 2048,
+		},
+		{
+			name:       "unattached upload is visible to its uploader",
+			attachment: newTestAttachmentDto(uploaderID, nil), userID: uploaderID,
+			wantKey: "attachments/original", wantContentType: "image/jpeg", wantSize: 2048,
+		},
+		{
+			name:       "thumbnail is a PNG of unknown size",
+			attachment: newTestAttachmentDto(uploaderID, &postID), userID: uuid.New(), thumbnail: true,
+			wantKey: "attachments/thumbnail", wantContentType: "image/png",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			queryService := mock_query.NewMockAttachmentQueryService(ctrl)
+			queryService.EXPECT().FindByID(gomock.Any(), tt.attachment.ID).Return(tt.attachment, nil)
+
+			postQueryService := mock_query.NewMockPostQueryService(ctrl)
+			postQueryService.EXPECT().FindByID(gomock.Any(), postID, tt.userID).
+				Return(&post.PostDto{ID: postID}, nil).AnyTimes()
+
+			blobStorage := mock_service.NewMockBlobStorage(ctrl)
+			blobStorage.EXPECT().Open(gomock.Any(), tt.wantKey).Return(io.NopCloser(strings.NewReader("data")), nil)
+
+			uc := post.NewGetAttachmentContentUseCase(queryService, postQueryService, blobStorage)
+			output, err := uc.Execute(context.Background(), post.GetAttachmentContentInput{
+				AttachmentID: tt.attachment.ID,
+				UserID:       tt.userID,
+				Thumbnail:    tt.thumbnail,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, "photo.jpg", output.FileName)
+			assert.Equal(t, tt.wantContentType, output.ContentType)
+			assert.Equal(t, tt.wantSize, output.Size)
+
+			content, err := io.ReadAll(output.Content)
+			require.NoError(t, err)
+			assert.Equal(t, "data", string(content))
+		})
+	}
+}
+
+func TestGetAttachmentContentUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+	postID := uuid.New()
+	withoutThumbnail := newTestAttachmentDto(uuid.New(), &postID)
+	withoutThumbnail.ThumbnailKey = ""
+
+	tests := []struct {
+		name       string
+		attachment *post.AttachmentDto
+		queryErr   error
+		openErr    error
+		thumbnail  bool
+		// hidden makes the post of the attachment invisible to the user.
+		hidden   bool
+		wantErr  error
+		wantCode vo.ErrorCode
+	}{
+		{name: "attachment does not exist in the tenant", wantCode: vo.NotFoundErrorCode},
+		{
+			name:       "unattached upload of another user",
+			attachment: newTestAttachmentDto(uuid.New(), nil), wantCode: vo.NotFoundErrorCode,
+		},
+		{
+			name:       "attachment of a post the user may not see",
+			attachment: newTestAttachmentDto(uuid.New(), &postID), hidden: true, wantCode: vo.NotFoundErrorCode,
+		},
+		{
+			name:       "attachment without thumbnail",
+			attachment: withoutThumbnail, thumbnail: true, wantCode: vo.NotFoundErrorCode,
+		},
+		{name: "query fails", queryErr: errDB, wantErr: errDB},
+		{
+			name:       "blob is missing",
+			attachment: newTestAttachmentDto(uuid.New(), &postID), openErr: service.ErrBlobNotFound,
+			wantErr: service.ErrBlobNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			queryService := mock_query.NewMockAttachmentQueryService(ctrl)
+			queryService.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(tt.attachment, tt.queryErr)
+
+			var visible *post.PostDto
+			if !tt.hidden {
+				visible = &post.PostDto{ID: postID}
+			}
+
+			postQueryService := mock_query.NewMockPostQueryService(ctrl)
+			postQueryService.EXPECT().FindByID(gomock.Any(), postID, gomock.Any()).Return(visible, nil).AnyTimes()
+
+			blobStorage := mock_service.NewMockBlobStorage(ctrl)
+			blobStorage.EXPECT().Open(gomock.Any(), gomock.Any()).Return(nil, tt.openErr).AnyTimes()
+
+			uc := post.NewGetAttachmentContentUseCase(queryService, postQueryService, blobStorage)
+			output, err := uc.Execute(context.Background(), post.GetAttachmentContentInput{
+				AttachmentID: uuid.New(),
+				UserID:       uuid.New(),
+				Thumbnail:    tt.thumbnail,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
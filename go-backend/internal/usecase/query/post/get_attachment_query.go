@@ -0,0 +1,54 @@
+//go:generate mockgen -source=get_attachment_query.go -destination=../../../../test/mock/usecase/query/mock_attachment_query_service.go -package mock_query
+
+package post
+
+import (
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+)
+
+// AttachmentDto is a read-only projection of an uploaded file and where its blobs are stored.
+type AttachmentDto struct {
+	ID         uuid.UUID
+	UploaderID uuid.UUID
+	// PostID is nil until the upload is attached to a post.
+	PostID      *uuid.UUID
+	FileName    string
+	ContentType string
+	Size        int64
+	StorageKey  string
+	// ThumbnailKey is empty unless the attachment is an image.
+	ThumbnailKey string
+}
+
+// AttachmentQueryService is the port for fetching attachment projections from the data store.
+// Every method only sees attachments of the active tenant (organization) in ctx.
+type AttachmentQueryService interface {
+	// FindByID returns nil when the attachment does not exist.
+	FindByID(ctx context.Context, id uuid.UUID) (*AttachmentDto, error)
+}
+
+// GetAttachmentContentInput identifies the file to download and who downloads it.
+type GetAttachmentContentInput struct {
+	AttachmentID uuid.UUID
+	UserID       uuid.UUID
+	// Thumbnail selects the PNG thumbnail of an image instead of the file itself.
+	Thumbnail bool
+}
+
+// GetAttachmentContentOutput streams the content of a file; the caller must close Content.
+type GetAttachmentContentOutput struct {
+	FileName    string
+	ContentType string
+	// Size is 0 when unknown, as for thumbnails.
+	Size    int64
+	Content io.ReadCloser
+}
+
+// GetAttachmentContentUseCase is the application use case for downloading an attachment. Attached files
+// are visible to the whole tenant, unattached uploads only to their uploader.
+type GetAttachmentContentUseCase interface {
+	Execute(ctx context.Context, input GetAttachmentContentInput) (*GetAttachmentContentOutput, error)
+}
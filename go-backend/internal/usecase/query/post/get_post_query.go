@@ -0,0 +1,19 @@
+package post
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// GetPostInput identifies the post to read.
+type GetPostInput struct {
+	PostID uuid.UUID
+	// ViewerID is the requesting user; posts it may not see are reported as missing.
+	ViewerID uuid.UUID
+}
+
+// GetPostUseCase is the application use case for reading a single post of the active tenant.
+type GetPostUseCase interface {
+	Execute(ctx context.Context, input GetPostInput) (*PostDto, error)
+}
@@ -0,0 +1,61 @@
+package post
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errPostNotFound = errors.New("post not found")
+
+type getPostUseCaseImpl struct {
+	tracer           trace.Tracer
+	logger           common.Logger
+	postQueryService PostQueryService
+	contentRenderer  service.ContentRenderer
+}
+
+func (uc *getPostUseCaseImpl) Execute(ctx context.Context, input GetPostInput) (*PostDto, error) {
+	ctx, span := uc.tracer.Start(ctx, "get_post")
+	defer span.End()
+
+	post, err := uc.postQueryService.FindByID(ctx, input.PostID, input.ViewerID)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find post", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	// Posts of other tenants, and posts the viewer may not see, are reported exactly like missing ones.
+	if post == nil {
+		err = vo.NewNotFoundError("post not found", nil, errPostNotFound)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	renderPost(uc.contentRenderer, post)
+
+	return post, nil
+}
+
+// NewGetPostUseCase creates a new GetPostUseCase.
+func NewGetPostUseCase(
+	postQueryService PostQueryService, contentRenderer service.ContentRenderer,
+) GetPostUseCase {
+	return &getPostUseCaseImpl{
+		tracer:           otel.Tracer("GetPostUseCase"),
+		logger:           common.NewLogger(),
+		postQueryService: postQueryService,
+		contentRenderer:  contentRenderer,
+	}
+}
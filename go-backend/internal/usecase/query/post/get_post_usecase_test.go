@@ -0,0 +1,76 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetPostUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	now := time.Now().UTC()
+	expected := &post.PostDto{
+		ID: uuid.New(), UserID: uuid.New(), Content: "**Hello**", Format: "markdown", CreatedAt: now, UpdatedAt: now,
+		RevisionCount: 3,
+	}
+
+	viewerID := uuid.New()
+
+	queryService := mock_query.NewMockPostQueryService(ctrl)
+	queryService.EXPECT().FindByID(gomock.Any(), expected.ID, viewerID).Return(expected, nil).Times(1)
+
+	uc := post.NewGetPostUseCase(queryService, renderContent(ctrl))
+	output, err := uc.Execute(context.Background(), post.GetPostInput{PostID: expected.ID, ViewerID: viewerID})
+
+	require.NoError(t, err)
+	assert.Equal(t, expected, output)
+	assert.Equal(t, "markdown@3:**Hello**", output.ContentHTML)
+}
+
+func TestGetPostUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name     string
+		queryErr error
+		wantErr  error
+		wantCode vo.ErrorCode
+	}{
+		{name: "post does not exist in the tenant", wantCode: vo.NotFoundErrorCode},
+		{name: "query fails", queryErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			queryService := mock_query.NewMockPostQueryService(ctrl)
+			queryService.EXPECT().FindByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, tt.queryErr).Times(1)
+
+			uc := post.NewGetPostUseCase(queryService, renderContent(ctrl))
+			output, err := uc.Execute(context.Background(), post.GetPostInput{PostID: uuid.New()})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
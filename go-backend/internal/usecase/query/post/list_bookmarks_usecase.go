@@ -0,0 +1,148 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type listBookmarksUseCaseImpl struct {
+	tracer                trace.Tracer
+	logger                common.Logger
+	savedPostQueryService SavedPostQueryService
+	reactionQueryService  ReactionQueryService
+	cursorCodec           service.CursorCodec
+	contentRenderer       service.ContentRenderer
+}
+
+func (uc *listBookmarksUseCaseImpl) Execute(
+	ctx context.Context, input ListBookmarksInput,
+) (*ListSavedPostsOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "list_bookmarks")
+	defer span.End()
+
+	output, err := uc.listBookmarks(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (uc *listBookmarksUseCaseImpl) listBookmarks(
+	ctx context.Context, input ListBookmarksInput,
+) (*ListSavedPostsOutput, error) {
+	after, err := validateSavedPostPage(uc.cursorCodec, input.Limit, input.After)
+	if err != nil {
+		return nil, err
+	}
+
+	// One bookmark more than requested tells whether another page follows.
+	saved, err := uc.savedPostQueryService.FindBookmarks(ctx, input.UserID, after, input.Limit+1)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find bookmarks", "error", err)
+
+		return nil, err
+	}
+
+	output := savedPostsPage(uc.cursorCodec, saved, input.Limit)
+
+	if err = fillSavedPosts(ctx, uc.reactionQueryService, uc.contentRenderer, output.Posts, input.UserID); err != nil {
+		uc.logger.Error(ctx, "failed to find post reactions", "error", err)
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// validateSavedPostPage checks the paging parameters of a saved post listing and decodes its cursor.
+func validateSavedPostPage(cursorCodec service.CursorCodec, limit int, token string) (*SavedPostCursor, error) {
+	if limit < minLimit || limit > maxLimit {
+		return nil, vo.NewValidationError("limit must be between 1 and 100", nil, errInvalidLimit)
+	}
+
+	if token == "" {
+		return nil, nil //nolint:nilnil // no cursor means the first page
+	}
+
+	payload, err := cursorCodec.Decode(token)
+	if err != nil {
+		return nil, vo.NewValidationError("cursor is invalid", nil, err)
+	}
+
+	cursor, err := unmarshalSavedPostCursor(payload)
+	if err != nil {
+		return nil, vo.NewValidationError("cursor is invalid", nil, err)
+	}
+
+	return &cursor, nil
+}
+
+// savedPostsPage cuts saved, fetched with one entry more than limit, down to a page.
+func savedPostsPage(cursorCodec service.CursorCodec, saved []SavedPostDto, limit int) *ListSavedPostsOutput {
+	output := &ListSavedPostsOutput{Posts: saved[:min(len(saved), limit)]}
+	if len(saved) > limit {
+		output.NextCursor = cursorCodec.Encode(savedPostCursorOf(output.Posts[len(output.Posts)-1]).marshal())
+	}
+
+	return output
+}
+
+// fillSavedPosts attaches reactions to and renders the posts of saved that are not tombstones.
+func fillSavedPosts(
+	ctx context.Context,
+	reactionQueryService ReactionQueryService,
+	contentRenderer service.ContentRenderer,
+	saved []SavedPostDto,
+	viewerID uuid.UUID,
+) error {
+	var posts []PostDto
+
+	for _, s := range saved {
+		if s.Post != nil {
+			posts = append(posts, *s.Post)
+		}
+	}
+
+	if err := attachReactions(ctx, reactionQueryService, posts, viewerID); err != nil {
+		return err
+	}
+
+	renderPosts(contentRenderer, posts)
+
+	for i := range saved {
+		if saved[i].Post != nil {
+			saved[i].Post = &posts[0]
+			posts = posts[1:]
+		}
+	}
+
+	return nil
+}
+
+// NewListBookmarksUseCase creates a new ListBookmarksUseCase.
+func NewListBookmarksUseCase(
+	savedPostQueryService SavedPostQueryService,
+	reactionQueryService ReactionQueryService,
+	cursorCodec service.CursorCodec,
+	contentRenderer service.ContentRenderer,
+) ListBookmarksUseCase {
+	return &listBookmarksUseCaseImpl{
+		tracer:                otel.Tracer("ListBookmarksUseCase"),
+		logger:                common.NewLogger(),
+		savedPostQueryService: savedPostQueryService,
+		reactionQueryService:  reactionQueryService,
+		cursorCodec:           cursorCodec,
+		contentRenderer:       contentRenderer,
+	}
+}
@@ -0,0 +1,116 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// savedPosts returns three saved posts, newest first; the middle one is a tombstone.
+func savedPosts() []post.SavedPostDto {
+	now := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	first, last := uuid.New(), uuid.New()
+
+	return []post.SavedPostDto{
+		{PostID: first, SavedAt: now.Add(2 * time.Minute), Post: &post.PostDto{ID: first, Content: "a", Format: "plain"}},
+		{PostID: uuid.New(), SavedAt: now.Add(time.Minute)},
+		{PostID: last, SavedAt: now, Post: &post.PostDto{ID: last, Content: "b", Format: "plain"}},
+	}
+}
+
+func TestListBookmarksUseCase_CursorPaging(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userID := uuid.New()
+	saved := savedPosts()
+
+	savedPostQueryService := mock_query.NewMockSavedPostQueryService(ctrl)
+	savedPostQueryService.EXPECT().FindBookmarks(gomock.Any(), userID, nil, 3).Return(saved, nil).Times(1)
+
+	uc := post.NewListBookmarksUseCase(savedPostQueryService, noReactions(ctrl), hexCursorCodec{}, renderContent(ctrl))
+	first, err := uc.Execute(context.Background(), post.ListBookmarksInput{UserID: userID, Limit: 2})
+
+	require.NoError(t, err)
+	require.Len(t, first.Posts, 2)
+	assert.NotNil(t, first.Posts[0].Post.Reactions)
+	assert.Equal(t, "plain@0:a", first.Posts[0].Post.ContentHTML)
+	assert.Nil(t, first.Posts[1].Post, "a tombstone stays a tombstone")
+	require.NotEmpty(t, first.NextCursor)
+
+	// The cursor points at the last entry of the page, tombstone or not.
+	after := &post.SavedPostCursor{CreatedAt: saved[1].SavedAt, ID: saved[1].PostID}
+	savedPostQueryService.EXPECT().FindBookmarks(gomock.Any(), userID, after, 3).Return(saved[2:], nil).Times(1)
+
+	second, err := uc.Execute(context.Background(), post.ListBookmarksInput{
+		UserID: userID, Limit: 2, After: first.NextCursor,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, second.Posts, 1)
+	assert.Equal(t, saved[2].PostID, second.Posts[0].Post.ID)
+	assert.Equal(t, "plain@0:b", second.Posts[0].Post.ContentHTML)
+	assert.Empty(t, second.NextCursor)
+}
+
+func TestListBookmarksUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name        string
+		input       post.ListBookmarksInput
+		findErr     error
+		reactionErr error
+		wantErr     error
+		wantCode    vo.ErrorCode
+	}{
+		{name: "limit too small", input: post.ListBookmarksInput{Limit: 0}, wantCode: vo.ValidationErrorCode},
+		{name: "limit too large", input: post.ListBookmarksInput{Limit: 101}, wantCode: vo.ValidationErrorCode},
+		{
+			name:     "malformed cursor",
+			input:    post.ListBookmarksInput{Limit: 20, After: "abcd"},
+			wantCode: vo.ValidationErrorCode,
+		},
+		{name: "query fails", input: post.ListBookmarksInput{Limit: 20}, findErr: errDB, wantErr: errDB},
+		{name: "reactions fail", input: post.ListBookmarksInput{Limit: 20}, reactionErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			savedPostQueryService := mock_query.NewMockSavedPostQueryService(ctrl)
+			savedPostQueryService.EXPECT().FindBookmarks(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(savedPosts(), tt.findErr).AnyTimes()
+
+			reactionQueryService := mock_query.NewMockReactionQueryService(ctrl)
+			reactionQueryService.EXPECT().FindByPosts(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, tt.reactionErr).AnyTimes()
+
+			uc := post.NewListBookmarksUseCase(
+				savedPostQueryService, reactionQueryService, hexCursorCodec{}, renderContent(ctrl),
+			)
+			output, err := uc.Execute(context.Background(), tt.input)
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package post
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errCollectionNotFound = errors.New("collection not found")
+
+type listCollectionPostsUseCaseImpl struct {
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	collectionQueryService CollectionQueryService
+	savedPostQueryService  SavedPostQueryService
+	reactionQueryService   ReactionQueryService
+	cursorCodec            service.CursorCodec
+	contentRenderer        service.ContentRenderer
+}
+
+func (uc *listCollectionPostsUseCaseImpl) Execute(
+	ctx context.Context, input ListCollectionPostsInput,
+) (*ListSavedPostsOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "list_collection_posts")
+	defer span.End()
+
+	output, err := uc.listCollectionPosts(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (uc *listCollectionPostsUseCaseImpl) listCollectionPosts(
+	ctx context.Context, input ListCollectionPostsInput,
+) (*ListSavedPostsOutput, error) {
+	after, err := validateSavedPostPage(uc.cursorCodec, input.Limit, input.After)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := uc.collectionQueryService.FindByID(ctx, input.CollectionID, input.UserID)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find collection", "error", err)
+
+		return nil, err
+	}
+
+	// Collections are private; someone else's is reported as missing.
+	if collection == nil {
+		return nil, vo.NewNotFoundError("collection not found", nil, errCollectionNotFound)
+	}
+
+	// One post more than requested tells whether another page follows.
+	saved, err := uc.savedPostQueryService.FindCollectionPosts(
+		ctx, input.CollectionID, input.UserID, after, input.Limit+1,
+	)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find collection posts", "error", err)
+
+		return nil, err
+	}
+
+	output := savedPostsPage(uc.cursorCodec, saved, input.Limit)
+
+	if err = fillSavedPosts(ctx, uc.reactionQueryService, uc.contentRenderer, output.Posts, input.UserID); err != nil {
+		uc.logger.Error(ctx, "failed to find post reactions", "error", err)
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// NewListCollectionPostsUseCase creates a new ListCollectionPostsUseCase.
+func NewListCollectionPostsUseCase(
+	collectionQueryService CollectionQueryService,
+	savedPostQueryService SavedPostQueryService,
+	reactionQueryService ReactionQueryService,
+	cursorCodec service.CursorCodec,
+	contentRenderer service.ContentRenderer,
+) ListCollectionPostsUseCase {
+	return &listCollectionPostsUseCaseImpl{
+		tracer:                 otel.Tracer("ListCollectionPostsUseCase"),
+		logger:                 common.NewLogger(),
+		collectionQueryService: collectionQueryService,
+		savedPostQueryService:  savedPostQueryService,
+		reactionQueryService:   reactionQueryService,
+		cursorCodec:            cursorCodec,
+		contentRenderer:        contentRenderer,
+	}
+}
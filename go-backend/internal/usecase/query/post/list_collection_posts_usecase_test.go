@@ -0,0 +1,97 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListCollectionPostsUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userID, collectionID := uuid.New(), uuid.New()
+	saved := savedPosts()
+
+	collectionQueryService := mock_query.NewMockCollectionQueryService(ctrl)
+	collectionQueryService.EXPECT().FindByID(gomock.Any(), collectionID, userID).
+		Return(&post.CollectionDto{ID: collectionID}, nil).Times(1)
+
+	savedPostQueryService := mock_query.NewMockSavedPostQueryService(ctrl)
+	savedPostQueryService.EXPECT().FindCollectionPosts(gomock.Any(), collectionID, userID, nil, 21).
+		Return(saved, nil).Times(1)
+
+	uc := post.NewListCollectionPostsUseCase(
+		collectionQueryService, savedPostQueryService, noReactions(ctrl), hexCursorCodec{}, renderContent(ctrl),
+	)
+	output, err := uc.Execute(context.Background(), post.ListCollectionPostsInput{
+		UserID: userID, CollectionID: collectionID, Limit: 20,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Posts, 3)
+	assert.Equal(t, "plain@0:a", output.Posts[0].Post.ContentHTML)
+	assert.Nil(t, output.Posts[1].Post)
+	assert.NotNil(t, output.Posts[2].Post.Reactions)
+	assert.Empty(t, output.NextCursor)
+}
+
+func TestListCollectionPostsUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name          string
+		limit         int
+		collection    *post.CollectionDto
+		collectionErr error
+		findErr       error
+		wantErr       error
+		wantCode      vo.ErrorCode
+	}{
+		{name: "limit out of range", limit: 0, collection: &post.CollectionDto{}, wantCode: vo.ValidationErrorCode},
+		{name: "collection missing or not owned", limit: 20, wantCode: vo.NotFoundErrorCode},
+		{name: "collection query fails", limit: 20, collectionErr: errDB, wantErr: errDB},
+		{name: "post query fails", limit: 20, collection: &post.CollectionDto{}, findErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			collectionQueryService := mock_query.NewMockCollectionQueryService(ctrl)
+			collectionQueryService.EXPECT().FindByID(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(tt.collection, tt.collectionErr).AnyTimes()
+
+			savedPostQueryService := mock_query.NewMockSavedPostQueryService(ctrl)
+			savedPostQueryService.EXPECT().
+				FindCollectionPosts(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, tt.findErr).AnyTimes()
+
+			uc := post.NewListCollectionPostsUseCase(
+				collectionQueryService, savedPostQueryService, noReactions(ctrl), hexCursorCodec{}, renderContent(ctrl),
+			)
+			output, err := uc.Execute(context.Background(), post.ListCollectionPostsInput{
+				UserID: uuid.New(), CollectionID: uuid.New(), Limit: tt.limit,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
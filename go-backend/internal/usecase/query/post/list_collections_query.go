@@ -0,0 +1,42 @@
+//go:generate mockgen -source=list_collections_query.go -destination=../../../../test/mock/usecase/query/mock_collection_query_service.go -package mock_query
+
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CollectionDto is a read-only projection of a user's post collection.
+type CollectionDto struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CollectionQueryService is the port for fetching collections from the data store.
+// Every method only sees collections of the active tenant (organization) in ctx.
+type CollectionQueryService interface {
+	// FindByUser returns the user's collections, oldest first. The returned slice is never nil.
+	FindByUser(ctx context.Context, userID uuid.UUID) ([]CollectionDto, error)
+	// FindByID returns the collection, or nil when it does not exist or belongs to another user than userID.
+	FindByID(ctx context.Context, id, userID uuid.UUID) (*CollectionDto, error)
+}
+
+// ListCollectionsInput holds the parameters for listing the caller's collections.
+type ListCollectionsInput struct {
+	UserID uuid.UUID
+}
+
+// ListCollectionsOutput is the result returned by ListCollectionsUseCase.
+type ListCollectionsOutput struct {
+	Collections []CollectionDto
+}
+
+// ListCollectionsUseCase lists the caller's own collections; a user has few enough not to need paging.
+type ListCollectionsUseCase interface {
+	Execute(ctx context.Context, input ListCollectionsInput) (*ListCollectionsOutput, error)
+}
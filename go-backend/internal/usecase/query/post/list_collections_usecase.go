@@ -0,0 +1,43 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type listCollectionsUseCaseImpl struct {
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	collectionQueryService CollectionQueryService
+}
+
+func (uc *listCollectionsUseCaseImpl) Execute(
+	ctx context.Context, input ListCollectionsInput,
+) (*ListCollectionsOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "list_collections")
+	defer span.End()
+
+	collections, err := uc.collectionQueryService.FindByUser(ctx, input.UserID)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find collections", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return &ListCollectionsOutput{Collections: collections}, nil
+}
+
+// NewListCollectionsUseCase creates a new ListCollectionsUseCase.
+func NewListCollectionsUseCase(collectionQueryService CollectionQueryService) ListCollectionsUseCase {
+	return &listCollectionsUseCaseImpl{
+		tracer:                 otel.Tracer("ListCollectionsUseCase"),
+		logger:                 common.NewLogger(),
+		collectionQueryService: collectionQueryService,
+	}
+}
@@ -0,0 +1,43 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListCollectionsUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userID := uuid.New()
+	collections := []post.CollectionDto{{ID: uuid.New(), Name: "Recipes"}}
+
+	collectionQueryService := mock_query.NewMockCollectionQueryService(ctrl)
+	collectionQueryService.EXPECT().FindByUser(gomock.Any(), userID).Return(collections, nil).Times(1)
+
+	output, err := post.NewListCollectionsUseCase(collectionQueryService).
+		Execute(context.Background(), post.ListCollectionsInput{UserID: userID})
+
+	require.NoError(t, err)
+	assert.Equal(t, collections, output.Collections)
+}
+
+func TestListCollectionsUseCase_FailureCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	errDB := errors.New("db error")
+
+	collectionQueryService := mock_query.NewMockCollectionQueryService(ctrl)
+	collectionQueryService.EXPECT().FindByUser(gomock.Any(), gomock.Any()).Return(nil, errDB).Times(1)
+
+	output, err := post.NewListCollectionsUseCase(collectionQueryService).
+		Execute(context.Background(), post.ListCollectionsInput{UserID: uuid.New()})
+
+	require.ErrorIs(t, err, errDB)
+	assert.Nil(t, output)
+}
@@ -0,0 +1,73 @@
+//go:generate mockgen -source=list_comments_query.go -destination=../../../../test/mock/usecase/query/mock_comment_query_service.go -package mock_query
+
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CommentDto is a read-only projection of a comment, optionally with the first replies below it.
+type CommentDto struct {
+	ID     uuid.UUID
+	PostID uuid.UUID
+	// ParentID is nil for a top-level comment.
+	ParentID *uuid.UUID
+	UserID   uuid.UUID
+	// AuthorName is the current name of the user identified by UserID.
+	AuthorName string
+	Content    string
+	Depth      int
+	CreatedAt  time.Time
+	// ReplyCount counts the direct replies the viewer may see, including those not embedded in Replies.
+	ReplyCount int
+	// Replies holds the oldest direct replies, oldest first, when they were requested; see
+	// ListCommentsInput.Depth.
+	Replies []CommentDto
+}
+
+// CommentQueryService is the port for fetching comment projections from the data store.
+// Every method only sees comments of the active tenant (organization) in ctx.
+type CommentQueryService interface {
+	// FindByParent returns up to limit comments of a post in thread order (oldest first) that follow
+	// after, or start at the beginning when after is nil: the top-level comments when parentID is nil,
+	// otherwise the replies to parentID. Comments of users viewerID blocked, muted or is blocked by are
+	// left out. Replies are not filled in. The returned slice is never nil.
+	FindByParent(
+		ctx context.Context, postID, viewerID uuid.UUID, parentID *uuid.UUID, after *CommentCursor, limit int,
+	) ([]CommentDto, error)
+	// FindReplies returns the oldest perParent replies to each of parentIDs that viewerID may see, as in
+	// FindByParent, oldest first. Replies of the replies are not filled in. The returned slice is never nil.
+	FindReplies(ctx context.Context, parentIDs []uuid.UUID, viewerID uuid.UUID, perParent int) ([]CommentDto, error)
+}
+
+// ListCommentsInput holds the parameters for listing one level of a post's comment threads.
+type ListCommentsInput struct {
+	PostID uuid.UUID
+	// ViewerID is the requesting user; posts it may not see are reported as missing.
+	ViewerID uuid.UUID
+	// ParentID lists the replies to that comment instead of the top-level comments. A comment that does
+	// not belong to the post has no replies on it.
+	ParentID *uuid.UUID
+	Limit    int
+	// After is an opaque cursor from a previous ListCommentsOutput.
+	After string
+	// Depth is how many levels of replies to embed below every listed comment (0 embeds none). Each
+	// comment embeds at most its oldest few replies; ReplyCount tells whether there are more, which are
+	// listed by passing the comment as ParentID.
+	Depth int
+}
+
+// ListCommentsOutput is the result returned by ListCommentsUseCase.
+type ListCommentsOutput struct {
+	Comments []CommentDto
+	// NextCursor pages towards newer comments; it is empty on the last page.
+	NextCursor string
+}
+
+// ListCommentsUseCase is the application use case for reading the comment threads of a post.
+type ListCommentsUseCase interface {
+	Execute(ctx context.Context, input ListCommentsInput) (*ListCommentsOutput, error)
+}
@@ -0,0 +1,178 @@
+package post
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// maxEmbeddedReplyDepth bounds ListCommentsInput.Depth; every level costs one query.
+	maxEmbeddedReplyDepth = 5
+	// embeddedRepliesPerComment is how many replies a listed comment embeds at most per level.
+	embeddedRepliesPerComment = 3
+)
+
+var errInvalidReplyDepth = errors.New("reply depth out of range")
+
+type listCommentsUseCaseImpl struct {
+	tracer              trace.Tracer
+	logger              common.Logger
+	postQueryService    PostQueryService
+	commentQueryService CommentQueryService
+	cursorCodec         service.CursorCodec
+}
+
+func (uc *listCommentsUseCaseImpl) Execute(
+	ctx context.Context, input ListCommentsInput,
+) (*ListCommentsOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "list_comments")
+	defer span.End()
+
+	output, err := uc.listComments(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (uc *listCommentsUseCaseImpl) listComments(
+	ctx context.Context, input ListCommentsInput,
+) (*ListCommentsOutput, error) {
+	after, err := uc.validate(input)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := uc.postQueryService.FindByID(ctx, input.PostID, input.ViewerID)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find post", "error", err)
+
+		return nil, err
+	}
+
+	if post == nil {
+		return nil, vo.NewNotFoundError("post not found", nil, errPostNotFound)
+	}
+
+	// One comment more than requested tells whether another page follows.
+	comments, err := uc.commentQueryService.FindByParent(
+		ctx, input.PostID, input.ViewerID, input.ParentID, after, input.Limit+1,
+	)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find comments", "error", err)
+
+		return nil, err
+	}
+
+	output := &ListCommentsOutput{Comments: comments[:min(len(comments), input.Limit)]}
+	if len(comments) > input.Limit {
+		output.NextCursor = uc.cursorCodec.Encode(commentCursorOf(output.Comments[len(output.Comments)-1]).marshal())
+	}
+
+	if output.Comments, err = uc.embedReplies(ctx, output.Comments, input.ViewerID, input.Depth); err != nil {
+		uc.logger.Error(ctx, "failed to find replies", "error", err)
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// embedReplies fills in depth levels of replies below comments, fetching one level per query, and
+// returns the comments with their replies attached.
+func (uc *listCommentsUseCaseImpl) embedReplies(
+	ctx context.Context, comments []CommentDto, viewerID uuid.UUID, depth int,
+) ([]CommentDto, error) {
+	levels := [][]CommentDto{comments}
+
+	for len(levels) <= depth {
+		var parentIDs []uuid.UUID
+
+		for _, comment := range levels[len(levels)-1] {
+			if comment.ReplyCount > 0 {
+				parentIDs = append(parentIDs, comment.ID)
+			}
+		}
+
+		if len(parentIDs) == 0 {
+			break
+		}
+
+		replies, err := uc.commentQueryService.FindReplies(ctx, parentIDs, viewerID, embeddedRepliesPerComment)
+		if err != nil {
+			return nil, err
+		}
+
+		levels = append(levels, replies)
+	}
+
+	// Attach bottom-up so that every level is complete before it is copied into its parents.
+	for i := len(levels) - 1; i > 0; i-- {
+		byParent := make(map[uuid.UUID][]CommentDto)
+
+		for _, reply := range levels[i] {
+			if reply.ParentID != nil {
+				byParent[*reply.ParentID] = append(byParent[*reply.ParentID], reply)
+			}
+		}
+
+		for j := range levels[i-1] {
+			levels[i-1][j].Replies = byParent[levels[i-1][j].ID]
+		}
+	}
+
+	return levels[0], nil
+}
+
+func (uc *listCommentsUseCaseImpl) validate(input ListCommentsInput) (*CommentCursor, error) {
+	if input.Limit < minLimit || input.Limit > maxLimit {
+		return nil, vo.NewValidationError("limit must be between 1 and 100", nil, errInvalidLimit)
+	}
+
+	if input.Depth < 0 || input.Depth > maxEmbeddedReplyDepth {
+		return nil, vo.NewValidationError("depth must be between 0 and 5", nil, errInvalidReplyDepth)
+	}
+
+	if input.After == "" {
+		return nil, nil //nolint:nilnil // no cursor means the first page
+	}
+
+	payload, err := uc.cursorCodec.Decode(input.After)
+	if err != nil {
+		return nil, vo.NewValidationError("cursor is invalid", nil, err)
+	}
+
+	cursor, err := unmarshalCommentCursor(payload)
+	if err != nil {
+		return nil, vo.NewValidationError("cursor is invalid", nil, err)
+	}
+
+	return &cursor, nil
+}
+
+// NewListCommentsUseCase creates a new ListCommentsUseCase.
+func NewListCommentsUseCase(
+	postQueryService PostQueryService,
+	commentQueryService CommentQueryService,
+	cursorCodec service.CursorCodec,
+) ListCommentsUseCase {
+	return &listCommentsUseCaseImpl{
+		tracer:              otel.Tracer("ListCommentsUseCase"),
+		logger:              common.NewLogger(),
+		postQueryService:    postQueryService,
+		commentQueryService: commentQueryService,
+		cursorCodec:         cursorCodec,
+	}
+}
@@ -0,0 +1,184 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newComment(parent *post.CommentDto, replyCount int, createdAt time.Time) post.CommentDto {
+	comment := post.CommentDto{ID: uuid.New(), Content: "comment", CreatedAt: createdAt, ReplyCount: replyCount}
+	if parent != nil {
+		comment.ParentID = &parent.ID
+		comment.Depth = parent.Depth + 1
+	}
+
+	return comment
+}
+
+func TestListCommentsUseCase_EmbedsReplies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	postID, viewerID := uuid.New(), uuid.New()
+	now := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+
+	rootA := newComment(nil, 2, now)
+	rootB := newComment(nil, 0, now.Add(time.Minute))
+	replyA1 := newComment(&rootA, 1, now.Add(2*time.Minute))
+	replyA2 := newComment(&rootA, 0, now.Add(3*time.Minute))
+	replyA1x := newComment(&replyA1, 4, now.Add(4*time.Minute))
+
+	postQueryService := mock_query.NewMockPostQueryService(ctrl)
+	postQueryService.EXPECT().FindByID(gomock.Any(), postID, gomock.Any()).Return(&post.PostDto{ID: postID}, nil).Times(1)
+
+	commentQueryService := mock_query.NewMockCommentQueryService(ctrl)
+	commentQueryService.EXPECT().FindByParent(gomock.Any(), postID, viewerID, nil, nil, 3).
+		Return([]post.CommentDto{rootA, rootB}, nil).Times(1)
+	// Only comments with replies are asked for theirs, one level per call, and no deeper than requested.
+	commentQueryService.EXPECT().FindReplies(gomock.Any(), []uuid.UUID{rootA.ID}, viewerID, 3).
+		Return([]post.CommentDto{replyA1, replyA2}, nil).Times(1)
+	commentQueryService.EXPECT().FindReplies(gomock.Any(), []uuid.UUID{replyA1.ID}, viewerID, 3).
+		Return([]post.CommentDto{replyA1x}, nil).Times(1)
+
+	uc := post.NewListCommentsUseCase(postQueryService, commentQueryService, hexCursorCodec{})
+	output, err := uc.Execute(context.Background(), post.ListCommentsInput{
+		PostID: postID, ViewerID: viewerID, Limit: 2, Depth: 2,
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.NextCursor)
+	require.Len(t, output.Comments, 2)
+	assert.Equal(t, rootA.ID, output.Comments[0].ID)
+	assert.Nil(t, output.Comments[1].Replies)
+
+	replies := output.Comments[0].Replies
+	require.Len(t, replies, 2)
+	assert.Equal(t, replyA1.ID, replies[0].ID)
+	assert.Equal(t, replyA2.ID, replies[1].ID)
+	require.Len(t, replies[0].Replies, 1)
+	assert.Equal(t, replyA1x.ID, replies[0].Replies[0].ID)
+	// The third level was not requested, so its replies stay counted but not embedded.
+	assert.Equal(t, 4, replies[0].Replies[0].ReplyCount)
+	assert.Nil(t, replies[0].Replies[0].Replies)
+}
+
+func TestListCommentsUseCase_CursorPaging(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	postID, parentID := uuid.New(), uuid.New()
+	now := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	comments := []post.CommentDto{newComment(nil, 0, now), newComment(nil, 0, now), newComment(nil, 0, now)}
+
+	postQueryService := mock_query.NewMockPostQueryService(ctrl)
+	postQueryService.EXPECT().FindByID(gomock.Any(), postID, gomock.Any()).Return(&post.PostDto{ID: postID}, nil).Times(2)
+
+	commentQueryService := mock_query.NewMockCommentQueryService(ctrl)
+	commentQueryService.EXPECT().FindByParent(gomock.Any(), postID, uuid.Nil, &parentID, nil, 3).
+		Return(comments, nil).Times(1)
+
+	uc := post.NewListCommentsUseCase(postQueryService, commentQueryService, hexCursorCodec{})
+	first, err := uc.Execute(context.Background(), post.ListCommentsInput{PostID: postID, ParentID: &parentID, Limit: 2})
+
+	require.NoError(t, err)
+	require.Len(t, first.Comments, 2)
+	require.NotEmpty(t, first.NextCursor)
+
+	// The cursor points at the last comment of the page.
+	after := &post.CommentCursor{CreatedAt: comments[1].CreatedAt, ID: comments[1].ID}
+	commentQueryService.EXPECT().FindByParent(gomock.Any(), postID, uuid.Nil, &parentID, after, 3).
+		Return(comments[2:], nil).Times(1)
+
+	second, err := uc.Execute(context.Background(), post.ListCommentsInput{
+		PostID: postID, ParentID: &parentID, Limit: 2, After: first.NextCursor,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, second.Comments, 1)
+	assert.Equal(t, comments[2].ID, second.Comments[0].ID)
+	assert.Empty(t, second.NextCursor)
+}
+
+func TestListCommentsUseCase_FailureCase(t *testing.T) {
+	postID := uuid.New()
+	errDB := errors.New("db error")
+	root := newComment(nil, 1, time.Now())
+
+	tests := []struct {
+		name       string
+		input      post.ListCommentsInput
+		post       *post.PostDto
+		findPost   error
+		findParent error
+		findReply  error
+		wantErr    error
+		wantCode   vo.ErrorCode
+	}{
+		{name: "limit too small", input: post.ListCommentsInput{Limit: 0}, wantCode: vo.ValidationErrorCode},
+		{name: "limit too large", input: post.ListCommentsInput{Limit: 101}, wantCode: vo.ValidationErrorCode},
+		{name: "negative depth", input: post.ListCommentsInput{Limit: 10, Depth: -1}, wantCode: vo.ValidationErrorCode},
+		{name: "depth too large", input: post.ListCommentsInput{Limit: 10, Depth: 6}, wantCode: vo.ValidationErrorCode},
+		{
+			name:     "malformed cursor",
+			input:    post.ListCommentsInput{Limit: 10, After: "zz"},
+			wantCode: vo.ValidationErrorCode,
+		},
+		{name: "post does not exist", input: post.ListCommentsInput{Limit: 10}, wantCode: vo.NotFoundErrorCode},
+		{name: "find post fails", input: post.ListCommentsInput{Limit: 10}, findPost: errDB, wantErr: errDB},
+		{
+			name:       "find comments fails",
+			input:      post.ListCommentsInput{Limit: 10},
+			post:       &post.PostDto{ID: postID},
+			findParent: errDB,
+			wantErr:    errDB,
+		},
+		{
+			name:      "find replies fails",
+			input:     post.ListCommentsInput{Limit: 10, Depth: 1},
+			post:      &post.PostDto{ID: postID},
+			findReply: errDB,
+			wantErr:   errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			postQueryService := mock_query.NewMockPostQueryService(ctrl)
+			postQueryService.EXPECT().FindByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.post, tt.findPost).AnyTimes()
+
+			commentQueryService := mock_query.NewMockCommentQueryService(ctrl)
+			commentQueryService.EXPECT().
+				FindByParent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]post.CommentDto{root}, tt.findParent).AnyTimes()
+			commentQueryService.EXPECT().FindReplies(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, tt.findReply).AnyTimes()
+
+			input := tt.input
+			input.PostID = postID
+
+			uc := post.NewListCommentsUseCase(postQueryService, commentQueryService, hexCursorCodec{})
+			output, err := uc.Execute(context.Background(), input)
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
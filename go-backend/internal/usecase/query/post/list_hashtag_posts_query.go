@@ -0,0 +1,19 @@
+package post
+
+import (
+	"context"
+)
+
+// ListHashtagPostsInput holds the parameters for listing the posts tagged with one hashtag.
+type ListHashtagPostsInput struct {
+	// Hashtag is the tag as requested, with or without the leading #; it is normalised like tags in posts.
+	Hashtag string
+	// Page addresses the page and the date range as for ListPostsUseCase; its hashtag filter is replaced by
+	// Hashtag.
+	Page ListPostsInput
+}
+
+// ListHashtagPostsUseCase is the application use case for the posts tagged with a hashtag.
+type ListHashtagPostsUseCase interface {
+	Execute(ctx context.Context, input ListHashtagPostsInput) (*ListPostsOutput, error)
+}
@@ -0,0 +1,61 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type listHashtagPostsUseCaseImpl struct {
+	tracer    trace.Tracer
+	logger    common.Logger
+	listPosts ListPostsUseCase
+}
+
+func (uc *listHashtagPostsUseCaseImpl) Execute(
+	ctx context.Context, input ListHashtagPostsInput,
+) (*ListPostsOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "list_hashtag_posts")
+	defer span.End()
+
+	hashtag, err := vo.NewHashtag(input.Hashtag)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	page := input.Page
+	page.Filter.Hashtag = hashtag.String()
+
+	output, err := uc.listPosts.Execute(ctx, page)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// NewListHashtagPostsUseCase creates a new ListHashtagPostsUseCase. Paging follows the same rules, and
+// accepts the same cursors, as the ListPostsUseCase built from the same dependencies.
+func NewListHashtagPostsUseCase(
+	postQueryService PostQueryService,
+	reactionQueryService ReactionQueryService,
+	cursorCodec service.CursorCodec,
+	contentRenderer service.ContentRenderer,
+) ListHashtagPostsUseCase {
+	return &listHashtagPostsUseCaseImpl{
+		tracer:    otel.Tracer("ListHashtagPostsUseCase"),
+		logger:    common.NewLogger(),
+		listPosts: NewListPostsUseCase(postQueryService, reactionQueryService, cursorCodec, contentRenderer),
+	}
+}
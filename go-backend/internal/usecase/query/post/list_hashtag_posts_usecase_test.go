@@ -0,0 +1,67 @@
+package post_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListHashtagPostsUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	posts := []post.PostDto{{ID: uuid.New(), UserID: uuid.New(), Content: "#Go", CreatedAt: from}}
+
+	// The tag is normalised and replaces any hashtag filter in the page; the date range is kept.
+	page := post.ListPostsInput{Limit: 20, Filter: post.PostFilter{Hashtag: "other", CreatedAfter: &from}}
+	want := post.PostFilter{Hashtag: "go", CreatedAfter: &from}
+
+	queryService := mock_query.NewMockPostQueryService(ctrl)
+	queryService.EXPECT().FindAll(gomock.Any(), want, 21, 0).Return(posts, nil).Times(1)
+	queryService.EXPECT().Count(gomock.Any(), want).Return(1, nil).Times(1)
+
+	uc := post.NewListHashtagPostsUseCase(queryService, noReactions(ctrl), hexCursorCodec{}, renderContent(ctrl))
+	output, err := uc.Execute(context.Background(), post.ListHashtagPostsInput{Hashtag: "#Ｇｏ", Page: page})
+
+	require.NoError(t, err)
+	assert.Equal(t, posts, output.Posts)
+	assert.Equal(t, 1, *output.Total)
+}
+
+func TestListHashtagPostsUseCase_FailureCase(t *testing.T) {
+	tests := []struct {
+		name    string
+		hashtag string
+		page    post.ListPostsInput
+	}{
+		{name: "invalid hashtag", hashtag: "go-lang", page: post.ListPostsInput{Limit: 20}},
+		{name: "digits only", hashtag: "2026", page: post.ListPostsInput{Limit: 20}},
+		{name: "invalid page", hashtag: "go", page: post.ListPostsInput{Limit: 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			// No case reaches the post list.
+			queryService := mock_query.NewMockPostQueryService(ctrl)
+
+			uc := post.NewListHashtagPostsUseCase(queryService, noReactions(ctrl), hexCursorCodec{}, renderContent(ctrl))
+			output, err := uc.Execute(context.Background(), post.ListHashtagPostsInput{Hashtag: tt.hashtag, Page: tt.page})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
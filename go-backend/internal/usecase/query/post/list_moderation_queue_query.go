@@ -0,0 +1,64 @@
+//go:generate mockgen -source=list_moderation_queue_query.go -destination=../../../../test/mock/usecase/query/mock_moderation_query_service.go -package mock_query
+
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ModerationQueueItemDto is a reported post awaiting a moderator, with its open reports.
+type ModerationQueueItemDto struct {
+	PostID   uuid.UUID
+	AuthorID uuid.UUID
+	// AuthorName is the current name of the user identified by AuthorID.
+	AuthorName string
+	Content    string
+	Hidden     bool
+	CreatedAt  time.Time
+	// ReportCount counts the open reports; FirstReportedAt and LastReportedAt bound their creation times.
+	ReportCount     int
+	FirstReportedAt time.Time
+	LastReportedAt  time.Time
+	// Reports are the open reports of the post, oldest first; never nil.
+	Reports []PostReportDto
+}
+
+// PostReportDto is a read-only projection of a report of a post.
+type PostReportDto struct {
+	ID uuid.UUID
+	// ReporterID is nil for reports filed by the content filters.
+	ReporterID *uuid.UUID
+	Reason     string
+	Details    string
+	CreatedAt  time.Time
+}
+
+// ModerationQueryService is the port for fetching the moderation queue from the data store.
+// Every method only sees reports of the active tenant (organization) in ctx.
+type ModerationQueryService interface {
+	// FindQueue returns a page of the posts with open reports, the longest waiting first, and how many
+	// such posts there are. The returned slice is never nil.
+	FindQueue(ctx context.Context, limit, offset int) ([]ModerationQueueItemDto, int, error)
+}
+
+// ListModerationQueueInput holds the parameters for the moderation queue query.
+type ListModerationQueueInput struct {
+	ModeratorID uuid.UUID
+	Limit       int
+	Offset      int
+}
+
+// ListModerationQueueOutput is the result returned by ListModerationQueueUseCase.
+type ListModerationQueueOutput struct {
+	Items []ModerationQueueItemDto
+	Total int
+}
+
+// ListModerationQueueUseCase lists the reported posts awaiting a moderator. Only holders of posts:moderate
+// may read the queue.
+type ListModerationQueueUseCase interface {
+	Execute(ctx context.Context, input ListModerationQueueInput) (*ListModerationQueueOutput, error)
+}
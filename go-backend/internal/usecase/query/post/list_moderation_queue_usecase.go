@@ -0,0 +1,88 @@
+package post
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errLacksPostsModeratePerm = errors.New("user lacks posts:moderate permission")
+
+type listModerationQueueUseCaseImpl struct {
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	moderationQueryService ModerationQueryService
+	permissionRepository   aggregaterepository.UserPermissionRepository
+}
+
+func (uc *listModerationQueueUseCaseImpl) Execute(
+	ctx context.Context, input ListModerationQueueInput,
+) (*ListModerationQueueOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	uc.logger.Info(ctx, "moderation queue requested", "limit", input.Limit, "offset", input.Offset)
+
+	output, err := uc.execute(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (uc *listModerationQueueUseCaseImpl) execute(
+	ctx context.Context, input ListModerationQueueInput,
+) (*ListModerationQueueOutput, error) {
+	agg, err := uc.permissionRepository.FindByUserID(ctx, input.ModeratorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !agg.HasPermission(vo.PermissionPostsModerate) {
+		return nil, vo.NewForbiddenError("insufficient permissions", nil, errLacksPostsModeratePerm)
+	}
+
+	if input.Limit < minLimit || input.Limit > maxLimit {
+		return nil, vo.NewValidationError("limit must be between 1 and 100", nil, errInvalidLimit)
+	}
+
+	if input.Offset < minOffset {
+		return nil, vo.NewValidationError("offset must be 0 or greater", nil, errInvalidOffset)
+	}
+
+	items, total, err := uc.moderationQueryService.FindQueue(ctx, input.Limit, input.Offset)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find moderation queue", "error", err)
+
+		return nil, err
+	}
+
+	if items == nil {
+		items = []ModerationQueueItemDto{}
+	}
+
+	return &ListModerationQueueOutput{Items: items, Total: total}, nil
+}
+
+// NewListModerationQueueUseCase creates a new ListModerationQueueUseCase.
+func NewListModerationQueueUseCase(
+	moderationQueryService ModerationQueryService,
+	permissionRepository aggregaterepository.UserPermissionRepository,
+) ListModerationQueueUseCase {
+	return &listModerationQueueUseCaseImpl{
+		tracer:                 otel.Tracer("ListModerationQueueUseCase"),
+		logger:                 common.NewLogger(),
+		moderationQueryService: moderationQueryService,
+		permissionRepository:   permissionRepository,
+	}
+}
@@ -0,0 +1,134 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	mock_aggregate_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/aggregate/repository"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func moderatorPermissions(userID uuid.UUID, perms ...vo.Permission) *aggregate.UserPermissionAggregate {
+	return &aggregate.UserPermissionAggregate{UserID: userID, Permissions: perms}
+}
+
+func TestListModerationQueueUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	moderatorID := uuid.New()
+	now := time.Now().UTC()
+
+	reporterID := uuid.New()
+	items := []post.ModerationQueueItemDto{{
+		PostID: uuid.New(), AuthorID: uuid.New(), AuthorName: "spammer", Content: "buy now", ReportCount: 1,
+		FirstReportedAt: now, LastReportedAt: now,
+		Reports: []post.PostReportDto{{ID: uuid.New(), ReporterID: &reporterID, Reason: "spam", CreatedAt: now}},
+	}}
+
+	permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+	permRepo.EXPECT().FindByUserID(gomock.Any(), moderatorID).
+		Return(moderatorPermissions(moderatorID, vo.PermissionPostsModerate), nil).Times(1)
+
+	queryService := mock_query.NewMockModerationQueryService(ctrl)
+	queryService.EXPECT().FindQueue(gomock.Any(), 20, 0).Return(items, 1, nil).Times(1)
+
+	uc := post.NewListModerationQueueUseCase(queryService, permRepo)
+	output, err := uc.Execute(context.Background(), post.ListModerationQueueInput{
+		ModeratorID: moderatorID, Limit: 20, Offset: 0,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, output.Total)
+	assert.Equal(t, items, output.Items)
+}
+
+func TestListModerationQueueUseCase_EmptyQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	moderatorID := uuid.New()
+
+	permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+	permRepo.EXPECT().FindByUserID(gomock.Any(), moderatorID).
+		Return(moderatorPermissions(moderatorID, vo.PermissionPostsModerate), nil).Times(1)
+
+	queryService := mock_query.NewMockModerationQueryService(ctrl)
+	queryService.EXPECT().FindQueue(gomock.Any(), 20, 0).Return(nil, 0, nil).Times(1)
+
+	uc := post.NewListModerationQueueUseCase(queryService, permRepo)
+	output, err := uc.Execute(context.Background(), post.ListModerationQueueInput{
+		ModeratorID: moderatorID, Limit: 20, Offset: 0,
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, output.Items)
+	assert.Empty(t, output.Items)
+}
+
+func TestListModerationQueueUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name     string
+		perms    []vo.Permission
+		limit    int
+		offset   int
+		queryErr error
+		wantErr  error
+		wantCode vo.ErrorCode
+	}{
+		{name: "lacks posts:moderate", limit: 20, wantCode: vo.ForbiddenErrorCode},
+		{name: "limit too small", perms: []vo.Permission{vo.PermissionPostsModerate}, wantCode: vo.ValidationErrorCode},
+		{
+			name: "limit too large", perms: []vo.Permission{vo.PermissionPostsModerate}, limit: 101,
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name: "negative offset", perms: []vo.Permission{vo.PermissionPostsModerate}, limit: 20, offset: -1,
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name: "query fails", perms: []vo.Permission{vo.PermissionPostsModerate}, limit: 20, queryErr: errDB,
+			wantErr: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			moderatorID := uuid.New()
+
+			permRepo := mock_aggregate_repository.NewMockUserPermissionRepository(ctrl)
+			permRepo.EXPECT().FindByUserID(gomock.Any(), moderatorID).
+				Return(moderatorPermissions(moderatorID, tt.perms...), nil).Times(1)
+
+			queryService := mock_query.NewMockModerationQueryService(ctrl)
+			queryService.EXPECT().FindQueue(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, 0, tt.queryErr).AnyTimes()
+
+			uc := post.NewListModerationQueueUseCase(queryService, permRepo)
+			output, err := uc.Execute(context.Background(), post.ListModerationQueueInput{
+				ModeratorID: moderatorID, Limit: tt.limit, Offset: tt.offset,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var domainErr vo.Error
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tt.wantCode, domainErr.Code())
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package post
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ListPostRevisionsInput identifies the post whose history is read.
+type ListPostRevisionsInput struct {
+	PostID uuid.UUID
+	// ViewerID is the requesting user; posts it may not see are reported as missing.
+	ViewerID uuid.UUID
+}
+
+// ListPostRevisionsUseCase is the application use case for reading the revision history of a post.
+type ListPostRevisionsUseCase interface {
+	Execute(ctx context.Context, input ListPostRevisionsInput) ([]PostRevisionDto, error)
+}
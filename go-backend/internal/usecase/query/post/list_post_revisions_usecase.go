@@ -0,0 +1,74 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type listPostRevisionsUseCaseImpl struct {
+	tracer           trace.Tracer
+	logger           common.Logger
+	postQueryService PostQueryService
+	contentRenderer  service.ContentRenderer
+}
+
+func (uc *listPostRevisionsUseCaseImpl) Execute(
+	ctx context.Context, input ListPostRevisionsInput,
+) ([]PostRevisionDto, error) {
+	ctx, span := uc.tracer.Start(ctx, "list_post_revisions")
+	defer span.End()
+
+	post, err := uc.postQueryService.FindByID(ctx, input.PostID, input.ViewerID)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find post", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	if post == nil {
+		err = vo.NewNotFoundError("post not found", nil, errPostNotFound)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	revisions, err := uc.postQueryService.FindRevisions(ctx, input.PostID)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find post revisions", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	if revisions == nil {
+		revisions = []PostRevisionDto{}
+	}
+
+	for i := range revisions {
+		renderRevision(uc.contentRenderer, input.PostID, &revisions[i])
+	}
+
+	return revisions, nil
+}
+
+// NewListPostRevisionsUseCase creates a new ListPostRevisionsUseCase.
+func NewListPostRevisionsUseCase(
+	postQueryService PostQueryService, contentRenderer service.ContentRenderer,
+) ListPostRevisionsUseCase {
+	return &listPostRevisionsUseCaseImpl{
+		tracer:           otel.Tracer("ListPostRevisionsUseCase"),
+		logger:           common.NewLogger(),
+		postQueryService: postQueryService,
+		contentRenderer:  contentRenderer,
+	}
+}
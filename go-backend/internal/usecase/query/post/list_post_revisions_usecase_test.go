@@ -0,0 +1,100 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListPostRevisionsUseCase_HappyCase(t *testing.T) {
+	now := time.Now().UTC()
+	editorID := uuid.New()
+	revisions := []post.PostRevisionDto{
+		{ID: uuid.New(), Number: 1, Content: "typo", Format: "plain", EditorID: &editorID, CreatedAt: now},
+		{ID: uuid.New(), Number: 2, Content: "*fixed*", Format: "markdown", CreatedAt: now.Add(time.Minute)},
+	}
+
+	tests := []struct {
+		name  string
+		found []post.PostRevisionDto
+		want  []post.PostRevisionDto
+	}{
+		{name: "revisions are returned in order", found: revisions, want: revisions},
+		{name: "nil from the query service becomes an empty slice", found: nil, want: []post.PostRevisionDto{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			postID := uuid.New()
+
+			queryService := mock_query.NewMockPostQueryService(ctrl)
+			queryService.EXPECT().FindByID(gomock.Any(), postID, gomock.Any()).Return(&post.PostDto{ID: postID}, nil).Times(1)
+			queryService.EXPECT().FindRevisions(gomock.Any(), postID).Return(tt.found, nil).Times(1)
+
+			uc := post.NewListPostRevisionsUseCase(queryService, renderContent(ctrl))
+			output, err := uc.Execute(context.Background(), post.ListPostRevisionsInput{PostID: postID})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, output)
+
+			for _, revision := range output {
+				assert.Equal(t, fmt.Sprintf("%s@%d:%s", revision.Format, revision.Number, revision.Content),
+					revision.ContentHTML)
+			}
+		})
+	}
+}
+
+func TestListPostRevisionsUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name        string
+		post        *post.PostDto
+		findErr     error
+		revisionErr error
+		wantErr     error
+		wantCode    vo.ErrorCode
+	}{
+		{name: "post does not exist in the tenant", wantCode: vo.NotFoundErrorCode},
+		{name: "post query fails", findErr: errDB, wantErr: errDB},
+		{name: "revision query fails", post: &post.PostDto{}, revisionErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			queryService := mock_query.NewMockPostQueryService(ctrl)
+			queryService.EXPECT().FindByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.post, tt.findErr).Times(1)
+			queryService.EXPECT().FindRevisions(gomock.Any(), gomock.Any()).Return(nil, tt.revisionErr).AnyTimes()
+
+			uc := post.NewListPostRevisionsUseCase(queryService, renderContent(ctrl))
+			output, err := uc.Execute(context.Background(), post.ListPostRevisionsInput{PostID: uuid.New()})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
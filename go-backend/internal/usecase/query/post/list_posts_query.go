@@ -11,29 +11,205 @@ import (
 
 // PostDto is a read-only projection of a post.
 type PostDto struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	// AuthorName is the current name of the user identified by UserID.
+	AuthorName string
+	// Content is the source of the post in Format, "plain" or "markdown". ContentHTML is its sanitised
+	// rendering, filled in by the use cases rather than the query service.
+	Content     string
+	Format      string
+	ContentHTML string
+	// Kind is "post", "repost" or "quote". A repost shares Original as it is and has no content of its own;
+	// a quote shares Original below its content.
+	Kind string
+	// Original is the post a repost or quote shares; nil for other posts.
+	Original *OriginalPostDto
+	// Visibility is "public", "followers" or "private" and Status "draft" or "published".
+	Visibility string
+	Status     string
+	// PublishAt is when a scheduled draft is due to be published; nil for other posts.
+	PublishAt *time.Time
+	// Hidden is true when a moderator hid the post; only its author still sees it.
+	Hidden bool
+	// CreatedAt is the publication time of published posts.
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// Edited is true once the content has changed since the post was created.
+	Edited bool
+	// RevisionCount counts the stored versions of the content, including the original.
+	RevisionCount int
+	// CommentCount counts the comments on the post the viewer may see, replies included; comments of users
+	// blocked either way or muted by the viewer are left out, as they are from the thread.
+	CommentCount int
+	// RepostCount counts the reposts of the post that are not in the trash.
+	RepostCount int
+	// Entities are the hashtags and the resolved mentions in Content in order of appearance; never nil.
+	Entities []PostEntityDto
+	// Attachments are the files attached to the post in the order chosen by the author; never nil.
+	Attachments []PostAttachmentDto
+	// Reactions is nil unless the use case that returned the post reports reactions.
+	Reactions *PostReactionsDto
+	// Poll is the poll attached to the post; nil for posts without one.
+	Poll *PostPollDto
+}
+
+// PostPollDto is the poll of a post with its live tallies. While the poll is open the tallies are hidden
+// from viewers who have not voted yet: the use cases zero them and set ResultsHidden.
+type PostPollDto struct {
+	Multiple bool
+	ClosesAt time.Time
+	// Closed is set by the use cases once ClosesAt has passed.
+	Closed bool
+	// VoterCount counts the users who voted; in a multiple-choice poll it is at most the sum of the votes.
+	VoterCount int
+	// Options are in display order.
+	Options []PostPollOptionDto
+	// MyVotes are the options the viewer voted for; never nil, and empty until the viewer votes.
+	MyVotes       []uuid.UUID
+	ResultsHidden bool
+}
+
+// PostPollOptionDto is one option of a poll with the number of votes it received.
+type PostPollOptionDto struct {
 	ID        uuid.UUID
-	UserID    uuid.UUID
-	Content   string
+	Label     string
+	VoteCount int
+}
+
+// OriginalPostDto is the post shared by a repost or quote. Once the original is trashed, purged or hidden,
+// or when the viewer may not see it, only Available is set, to false, and the share shows a placeholder.
+type OriginalPostDto struct {
+	Available  bool
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	AuthorName string
+	// Content, Format and ContentHTML are like PostDto's; RevisionCount identifies the version of Content.
+	Content       string
+	Format        string
+	ContentHTML   string
+	RevisionCount int
+	CreatedAt     time.Time
+}
+
+// PostAttachmentDto describes a file attached to a post.
+type PostAttachmentDto struct {
+	ID          uuid.UUID
+	FileName    string
+	ContentType string
+	Size        int64
+	// Width and Height are the pixel dimensions of images, and 0 unless HasThumbnail.
+	Width        int
+	Height       int
+	HasThumbnail bool
+}
+
+// PostEntityDto is a hashtag or mention in the content of a post. Start and End are offsets in Unicode code
+// points, End exclusive, and include the leading # or @.
+type PostEntityDto struct {
+	// Type is "hashtag" or "mention".
+	Type  string
+	Start int
+	End   int
+	// Value is the normalised tag or handle, without the # or @.
+	Value string
+	// UserID is the mentioned member; nil for hashtags.
+	UserID *uuid.UUID
+}
+
+// PostRevisionDto is a read-only projection of one stored version of a post's content.
+type PostRevisionDto struct {
+	ID     uuid.UUID
+	Number int
+	// Content is the source of the revision in Format; ContentHTML is its rendering, like PostDto's.
+	Content     string
+	Format      string
+	ContentHTML string
+	// EditorID is nil when the editor's account has been deleted.
+	EditorID  *uuid.UUID
 	CreatedAt time.Time
 }
 
+// PostAuthorDto is the public identity of a post author.
+type PostAuthorDto struct {
+	ID   uuid.UUID
+	Name string
+}
+
+// PostFilter narrows a post list. A list only ever holds the posts ViewerID may see: its own posts, and the
+// published posts of others that are public, or followers-only while the viewer follows their author, and
+// that no moderator hid.
+type PostFilter struct {
+	// ViewerID is the requesting user.
+	ViewerID uuid.UUID
+	// Drafts lists the viewer's own drafts instead of published posts.
+	Drafts bool
+	// AuthorIDs limits the list to posts by these users; empty means every author.
+	AuthorIDs []uuid.UUID
+	// CreatedAfter (inclusive) and CreatedBefore (exclusive) bound the creation time when set.
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	// Hashtag limits the list to posts tagged with this normalised hashtag when not empty.
+	Hashtag string
+}
+
 // PostQueryService is the port for fetching post projections from the data store.
+// Every method only sees posts of the active tenant (organization) in ctx.
 type PostQueryService interface {
-	// FindAll returns a paginated list of posts and the total count.
+	// FindAll returns up to limit posts matching filter in feed order, skipping the first offset.
 	// The returned slice is never nil; an empty table returns a zero-length slice.
-	FindAll(ctx context.Context, limit, offset int) ([]PostDto, int, error)
+	FindAll(ctx context.Context, filter PostFilter, limit, offset int) ([]PostDto, error)
+	// FindAfter returns up to limit posts matching filter that follow cursor in feed order (older posts),
+	// newest first. The returned slice is never nil.
+	FindAfter(ctx context.Context, filter PostFilter, cursor PostCursor, limit int) ([]PostDto, error)
+	// FindBefore returns the up to limit posts matching filter that immediately precede cursor in feed
+	// order (newer posts), newest first. The returned slice is never nil.
+	FindBefore(ctx context.Context, filter PostFilter, cursor PostCursor, limit int) ([]PostDto, error)
+	// FindTimeline returns up to limit posts of the home timeline of viewerID in feed order: the posts by
+	// the users the viewer follows. With a cursor it returns the posts that follow it. The returned slice is
+	// never nil.
+	FindTimeline(ctx context.Context, viewerID uuid.UUID, after *PostCursor, limit int) ([]PostDto, error)
+	// Count returns the number of posts matching filter.
+	Count(ctx context.Context, filter PostFilter) (int, error)
+	// FindAuthor returns nil unless the user is a member of the active tenant or has posts in it.
+	FindAuthor(ctx context.Context, userID uuid.UUID) (*PostAuthorDto, error)
+	// FindByID returns nil when the post does not exist in the active tenant or viewerID may not see it, by
+	// the rule of PostFilter; authors see their own drafts.
+	FindByID(ctx context.Context, id, viewerID uuid.UUID) (*PostDto, error)
+	// FindRevisions returns the revisions of a post ordered by number. The returned slice is never nil.
+	FindRevisions(ctx context.Context, postID uuid.UUID) ([]PostRevisionDto, error)
+	// FindRevision returns nil when the post has no revision with that number in the active tenant.
+	FindRevision(ctx context.Context, postID uuid.UUID, number int) (*PostRevisionDto, error)
 }
 
-// ListPostsInput holds the validated parameters for the list-posts query.
+// ListPostsInput holds the parameters for the list-posts query. Without After or Before the page is
+// addressed by Offset; with one of them (never both) Offset must be 0.
 type ListPostsInput struct {
 	Limit  int
 	Offset int
+	// After and Before are opaque cursors from a previous ListPostsOutput.
+	After  string
+	Before string
+	// IncludeTotal defaults to true for offset pages and to false for cursor pages, which exist to avoid
+	// counting the whole table.
+	IncludeTotal *bool
+	// Filter applies to the posts and to Total. Cursors do not carry it: every page of a listing must
+	// repeat the filter of the first one. Its ViewerID is replaced by ViewerID.
+	Filter PostFilter
+	// ViewerID is the requesting user, whose own reactions are reported with each post.
+	ViewerID uuid.UUID
 }
 
 // ListPostsOutput is the result returned by ListPostsUseCase.
 type ListPostsOutput struct {
+	// Posts carry their Reactions.
 	Posts []PostDto
-	Total int
+	// Total is nil when it was not requested.
+	Total *int
+	// NextCursor pages towards older posts and PrevCursor towards newer ones; each is empty when
+	// there is nothing more in that direction.
+	NextCursor string
+	PrevCursor string
 }
 
 // ListPostsUseCase is the application use case for retrieving a paginated post list.
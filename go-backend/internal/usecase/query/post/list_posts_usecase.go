@@ -3,9 +3,12 @@ package post
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/Haya372/web-app-template/go-backend/internal/common"
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
@@ -15,17 +18,25 @@ const (
 	minLimit  = 1
 	maxLimit  = 100
 	minOffset = 0
+	// maxAuthorFilters keeps the author filter within what an index scan handles well.
+	maxAuthorFilters = 50
 )
 
 var (
-	errInvalidLimit  = errors.New("limit out of range")
-	errInvalidOffset = errors.New("offset must be non-negative")
+	errInvalidLimit     = errors.New("limit out of range")
+	errInvalidOffset    = errors.New("offset must be non-negative")
+	errConflictingPages = errors.New("after, before and offset are mutually exclusive")
+	errTooManyAuthors   = errors.New("too many author filters")
+	errEmptyDateRange   = errors.New("created_after must be before created_before")
 )
 
 type listPostsUseCaseImpl struct {
-	tracer           trace.Tracer
-	logger           common.Logger
-	postQueryService PostQueryService
+	tracer               trace.Tracer
+	logger               common.Logger
+	postQueryService     PostQueryService
+	reactionQueryService ReactionQueryService
+	cursorCodec          service.CursorCodec
+	contentRenderer      service.ContentRenderer
 }
 
 func (uc *listPostsUseCaseImpl) Execute(
@@ -34,48 +45,271 @@ func (uc *listPostsUseCaseImpl) Execute(
 	ctx, span := uc.tracer.Start(ctx, "list_posts")
 	defer span.End()
 
-	uc.logger.Info(ctx, "list posts requested", "limit", input.Limit, "offset", input.Offset)
+	uc.logger.Info(ctx, "list posts requested", "limit", input.Limit, "offset", input.Offset,
+		"after", input.After != "", "before", input.Before != "", "authors", len(input.Filter.AuthorIDs))
 
-	if input.Limit < minLimit || input.Limit > maxLimit {
-		err := vo.NewValidationError("limit must be between 1 and 100", nil, errInvalidLimit)
+	input.Filter.ViewerID = input.ViewerID
+
+	if err := validateListPostsInput(input); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 
 		return nil, err
 	}
 
-	if input.Offset < minOffset {
-		err := vo.NewValidationError("offset must be 0 or greater", nil, errInvalidOffset)
+	output, err := uc.findPage(ctx, input)
+	if err == nil {
+		err = uc.attachReactions(ctx, output.Posts, input.ViewerID)
+	}
+
+	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 
 		return nil, err
 	}
 
-	posts, total, err := uc.postQueryService.FindAll(ctx, input.Limit, input.Offset)
+	renderPosts(uc.contentRenderer, output.Posts)
+
+	cursorPage := input.After != "" || input.Before != ""
+	if input.IncludeTotal != nil && *input.IncludeTotal || input.IncludeTotal == nil && !cursorPage {
+		total, err := uc.postQueryService.Count(ctx, input.Filter)
+		if err != nil {
+			uc.logger.Error(ctx, "failed to count posts", "error", err)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+
+			return nil, err
+		}
+
+		output.Total = &total
+	}
+
+	return output, nil
+}
+
+// findPage fetches one post more than requested to learn whether another page follows.
+func (uc *listPostsUseCaseImpl) findPage(ctx context.Context, input ListPostsInput) (*ListPostsOutput, error) {
+	var (
+		posts            []PostDto
+		hasNext, hasPrev bool
+		err              error
+	)
+
+	switch {
+	case input.After != "":
+		var cursor PostCursor
+		if cursor, err = decodePostCursor(uc.cursorCodec, input.After); err != nil {
+			return nil, err
+		}
+
+		posts, err = uc.postQueryService.FindAfter(ctx, input.Filter, cursor, input.Limit+1)
+		hasNext = len(posts) > input.Limit
+		posts = posts[:min(len(posts), input.Limit)]
+		// The cursor post itself precedes this page.
+		hasPrev = true
+	case input.Before != "":
+		var cursor PostCursor
+		if cursor, err = decodePostCursor(uc.cursorCodec, input.Before); err != nil {
+			return nil, err
+		}
+
+		posts, err = uc.postQueryService.FindBefore(ctx, input.Filter, cursor, input.Limit+1)
+		// The extra post is the newest one, furthest from the cursor.
+		hasPrev = len(posts) > input.Limit
+		posts = posts[max(0, len(posts)-input.Limit):]
+		hasNext = true
+	default:
+		posts, err = uc.postQueryService.FindAll(ctx, input.Filter, input.Limit+1, input.Offset)
+		hasNext = len(posts) > input.Limit
+		posts = posts[:min(len(posts), input.Limit)]
+		hasPrev = input.Offset > 0
+	}
+
 	if err != nil {
 		uc.logger.Error(ctx, "failed to find posts", "error", err)
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
 
 		return nil, err
 	}
 
-	if posts == nil {
-		posts = []PostDto{}
+	return uc.pageOutput(posts, hasNext, hasPrev), nil
+}
+
+// pageOutput attaches cursors pointing past both ends of the page when more posts lie beyond them.
+func (uc *listPostsUseCaseImpl) pageOutput(posts []PostDto, hasNext, hasPrev bool) *ListPostsOutput {
+	output := &ListPostsOutput{Posts: posts}
+	if output.Posts == nil {
+		output.Posts = []PostDto{}
+	}
+
+	if len(posts) == 0 {
+		return output
+	}
+
+	if hasNext {
+		output.NextCursor = uc.cursorCodec.Encode(postCursorOf(posts[len(posts)-1]).marshal())
+	}
+
+	if hasPrev {
+		output.PrevCursor = uc.cursorCodec.Encode(postCursorOf(posts[0]).marshal())
+	}
+
+	return output
+}
+
+func (uc *listPostsUseCaseImpl) attachReactions(ctx context.Context, posts []PostDto, viewerID uuid.UUID) error {
+	err := attachReactions(ctx, uc.reactionQueryService, posts, viewerID)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find post reactions", "error", err)
+	}
+
+	return err
+}
+
+// PreparePosts attaches the viewer's reactions to posts and renders their content, the way every post
+// listing returns them. Use cases outside this package that return posts go through it.
+func PreparePosts(
+	ctx context.Context,
+	reactionQueryService ReactionQueryService,
+	contentRenderer service.ContentRenderer,
+	posts []PostDto,
+	viewerID uuid.UUID,
+) error {
+	if err := attachReactions(ctx, reactionQueryService, posts, viewerID); err != nil {
+		return err
+	}
+
+	renderPosts(contentRenderer, posts)
+
+	return nil
+}
+
+// attachReactions loads the reactions to every post of a page in one round trip.
+func attachReactions(
+	ctx context.Context, reactionQueryService ReactionQueryService, posts []PostDto, viewerID uuid.UUID,
+) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postIDs := make([]uuid.UUID, len(posts))
+	for i, p := range posts {
+		postIDs[i] = p.ID
+	}
+
+	reactions, err := reactionQueryService.FindByPosts(ctx, postIDs, viewerID)
+	if err != nil {
+		return err
+	}
+
+	for i := range posts {
+		summary := reactions[posts[i].ID]
+		posts[i].Reactions = &summary
+	}
+
+	return nil
+}
+
+func renderPosts(contentRenderer service.ContentRenderer, posts []PostDto) {
+	for i := range posts {
+		renderPost(contentRenderer, &posts[i])
+	}
+}
+
+// renderPost fills in the rendered content of post and of the original it shares; the latest revision of a
+// post is its RevisionCount. It also hides the tallies of an open poll the viewer has not voted in.
+func renderPost(contentRenderer service.ContentRenderer, post *PostDto) {
+	post.ContentHTML = contentRenderer.Render(post.ID, post.RevisionCount, vo.ContentFormat(post.Format), post.Content)
+
+	if original := post.Original; original != nil && original.Available {
+		original.ContentHTML = contentRenderer.Render(
+			original.ID, original.RevisionCount, vo.ContentFormat(original.Format), original.Content,
+		)
+	}
+
+	if post.Poll != nil {
+		hidePollResults(post.Poll, time.Now())
+	}
+}
+
+// hidePollResults marks poll closed once it closed by now, and otherwise zeroes its tallies unless the viewer
+// has voted in it, so that early results do not sway the vote.
+func hidePollResults(poll *PostPollDto, now time.Time) {
+	poll.Closed = !now.Before(poll.ClosesAt)
+
+	if !poll.Closed && len(poll.MyVotes) == 0 {
+		poll.VoterCount = 0
+		for i := range poll.Options {
+			poll.Options[i].VoteCount = 0
+		}
+
+		poll.ResultsHidden = true
+	}
+}
+
+// renderRevision fills in the rendered content of a revision of the post identified by postID.
+func renderRevision(contentRenderer service.ContentRenderer, postID uuid.UUID, revision *PostRevisionDto) {
+	revision.ContentHTML = contentRenderer.Render(
+		postID, revision.Number, vo.ContentFormat(revision.Format), revision.Content,
+	)
+}
+
+func decodePostCursor(cursorCodec service.CursorCodec, token string) (PostCursor, error) {
+	payload, err := cursorCodec.Decode(token)
+	if err != nil {
+		return PostCursor{}, vo.NewValidationError("cursor is invalid", nil, err)
+	}
+
+	cursor, err := unmarshalPostCursor(payload)
+	if err != nil {
+		return PostCursor{}, vo.NewValidationError("cursor is invalid", nil, err)
+	}
+
+	return cursor, nil
+}
+
+func validateListPostsInput(input ListPostsInput) error {
+	if input.Limit < minLimit || input.Limit > maxLimit {
+		return vo.NewValidationError("limit must be between 1 and 100", nil, errInvalidLimit)
+	}
+
+	if input.Offset < minOffset {
+		return vo.NewValidationError("offset must be 0 or greater", nil, errInvalidOffset)
+	}
+
+	if input.After != "" && input.Before != "" ||
+		(input.After != "" || input.Before != "") && input.Offset != 0 {
+		return vo.NewValidationError("use only one of after, before and offset", nil, errConflictingPages)
+	}
+
+	return validatePostFilter(input.Filter)
+}
+
+func validatePostFilter(filter PostFilter) error {
+	if len(filter.AuthorIDs) > maxAuthorFilters {
+		return vo.NewValidationError("at most 50 authors can be filtered on", nil, errTooManyAuthors)
+	}
+
+	if filter.CreatedAfter != nil && filter.CreatedBefore != nil && !filter.CreatedAfter.Before(*filter.CreatedBefore) {
+		return vo.NewValidationError("createdAfter must be earlier than createdBefore", nil, errEmptyDateRange)
 	}
 
-	return &ListPostsOutput{
-		Posts: posts,
-		Total: total,
-	}, nil
+	return nil
 }
 
 // NewListPostsUseCase creates a new ListPostsUseCase.
-func NewListPostsUseCase(postQueryService PostQueryService) ListPostsUseCase {
+func NewListPostsUseCase(
+	postQueryService PostQueryService,
+	reactionQueryService ReactionQueryService,
+	cursorCodec service.CursorCodec,
+	contentRenderer service.ContentRenderer,
+) ListPostsUseCase {
 	return &listPostsUseCaseImpl{
-		tracer:           otel.Tracer("ListPostsUseCase"),
-		logger:           common.NewLogger(),
-		postQueryService: postQueryService,
+		tracer:               otel.Tracer("ListPostsUseCase"),
+		logger:               common.NewLogger(),
+		postQueryService:     postQueryService,
+		reactionQueryService: reactionQueryService,
+		cursorCodec:          cursorCodec,
+		contentRenderer:      contentRenderer,
 	}
 }
@@ -2,23 +2,70 @@ package post_test
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
 	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
 	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	mock_service "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/service"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
+// hexCursorCodec is an unsigned stand-in for the real codec so that tests can build cursors.
+type hexCursorCodec struct{}
+
+func (hexCursorCodec) Encode(payload []byte) string { return hex.EncodeToString(payload) }
+
+func (hexCursorCodec) Decode(token string) ([]byte, error) {
+	payload, err := hex.DecodeString(token)
+	if err != nil {
+		return nil, service.ErrInvalidCursor
+	}
+
+	return payload, nil
+}
+
+// noReactions answers every reaction lookup as if nobody had reacted to any post.
+func noReactions(ctrl *gomock.Controller) post.ReactionQueryService {
+	reactionQueryService := mock_query.NewMockReactionQueryService(ctrl)
+	reactionQueryService.EXPECT().FindByPosts(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, postIDs []uuid.UUID, _ uuid.UUID) (map[uuid.UUID]post.PostReactionsDto, error) {
+			reactions := make(map[uuid.UUID]post.PostReactionsDto, len(postIDs))
+			for _, id := range postIDs {
+				reactions[id] = post.PostReactionsDto{Counts: map[vo.ReactionType]int{}, Mine: []vo.ReactionType{}}
+			}
+
+			return reactions, nil
+		}).AnyTimes()
+
+	return reactionQueryService
+}
+
+// renderContent is a content renderer whose output shows what it was asked to render.
+func renderContent(ctrl *gomock.Controller) service.ContentRenderer {
+	contentRenderer := mock_service.NewMockContentRenderer(ctrl)
+	contentRenderer.EXPECT().Render(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ uuid.UUID, revision int, format vo.ContentFormat, source string) string {
+			return fmt.Sprintf("%s@%d:%s", format, revision, source)
+		}).AnyTimes()
+
+	return contentRenderer
+}
+
 func newTestUseCase(t *testing.T, queryService post.PostQueryService) post.ListPostsUseCase {
 	t.Helper()
 
-	return post.NewListPostsUseCase(queryService)
+	ctrl := gomock.NewController(t)
+
+	return post.NewListPostsUseCase(queryService, noReactions(ctrl), hexCursorCodec{}, renderContent(ctrl))
 }
 
 func TestListPostsUseCase_HappyCase(t *testing.T) {
@@ -26,21 +73,148 @@ func TestListPostsUseCase_HappyCase(t *testing.T) {
 	now := time.Now().UTC()
 
 	expectedPosts := []post.PostDto{
-		{ID: uuid.New(), UserID: uuid.New(), Content: "Hello World", CreatedAt: now},
-		{ID: uuid.New(), UserID: uuid.New(), Content: "Second post", CreatedAt: now},
+		{ID: uuid.New(), UserID: uuid.New(), Content: "Hello World", Format: "plain", CreatedAt: now, RevisionCount: 1},
+		{ID: uuid.New(), UserID: uuid.New(), Content: "*Second*", Format: "markdown", CreatedAt: now, RevisionCount: 2},
 	}
 
 	queryService := mock_query.NewMockPostQueryService(ctrl)
-	queryService.EXPECT().FindAll(gomock.Any(), 20, 0).Return(expectedPosts, 2, nil).Times(1)
+	queryService.EXPECT().FindAll(gomock.Any(), post.PostFilter{}, 21, 0).Return(expectedPosts, nil).Times(1)
+	queryService.EXPECT().Count(gomock.Any(), post.PostFilter{}).Return(2, nil).Times(1)
 
 	uc := newTestUseCase(t, queryService)
 	output, err := uc.Execute(context.Background(), post.ListPostsInput{Limit: 20, Offset: 0})
 
 	require.NoError(t, err)
 	require.NotNil(t, output)
-	assert.Equal(t, 2, output.Total)
+	assert.Equal(t, 2, *output.Total)
+	assert.Empty(t, output.NextCursor)
+	assert.Empty(t, output.PrevCursor)
 	assert.Len(t, output.Posts, 2)
 	assert.Equal(t, "Hello World", output.Posts[0].Content)
+	// Each post is rendered at its latest revision.
+	assert.Equal(t, "plain@1:Hello World", output.Posts[0].ContentHTML)
+	assert.Equal(t, "markdown@2:*Second*", output.Posts[1].ContentHTML)
+}
+
+func TestListPostsUseCase_Originals(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	now := time.Now().UTC()
+
+	expectedPosts := []post.PostDto{
+		{
+			ID: uuid.New(), Format: "plain", Kind: "repost", CreatedAt: now,
+			Original: &post.OriginalPostDto{
+				Available: true, ID: uuid.New(), Content: "*Shared*", Format: "markdown", RevisionCount: 3,
+			},
+		},
+		{
+			ID: uuid.New(), Content: "Look", Format: "plain", Kind: "quote", CreatedAt: now, RevisionCount: 1,
+			Original: &post.OriginalPostDto{},
+		},
+	}
+
+	queryService := mock_query.NewMockPostQueryService(ctrl)
+	queryService.EXPECT().FindAll(gomock.Any(), post.PostFilter{}, 21, 0).Return(expectedPosts, nil).Times(1)
+	queryService.EXPECT().Count(gomock.Any(), post.PostFilter{}).Return(2, nil).Times(1)
+
+	uc := newTestUseCase(t, queryService)
+	output, err := uc.Execute(context.Background(), post.ListPostsInput{Limit: 20, Offset: 0})
+
+	require.NoError(t, err)
+	require.Len(t, output.Posts, 2)
+	// An available original is rendered at its own revision; a placeholder is left empty.
+	assert.Equal(t, "markdown@3:*Shared*", output.Posts[0].Original.ContentHTML)
+	assert.Equal(t, "plain@1:Look", output.Posts[1].ContentHTML)
+	assert.False(t, output.Posts[1].Original.Available)
+	assert.Empty(t, output.Posts[1].Original.ContentHTML)
+}
+
+func TestListPostsUseCase_Polls(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	now := time.Now().UTC()
+	optionID := uuid.New()
+	newPoll := func(closesAt time.Time, myVotes []uuid.UUID) *post.PostPollDto {
+		return &post.PostPollDto{
+			ClosesAt: closesAt, VoterCount: 4, MyVotes: myVotes,
+			Options: []post.PostPollOptionDto{{ID: optionID, Label: "Tue", VoteCount: 4}},
+		}
+	}
+
+	expectedPosts := []post.PostDto{
+		{ID: uuid.New(), CreatedAt: now, Poll: newPoll(now.Add(time.Hour), []uuid.UUID{})},
+		{ID: uuid.New(), CreatedAt: now, Poll: newPoll(now.Add(time.Hour), []uuid.UUID{optionID})},
+		{ID: uuid.New(), CreatedAt: now, Poll: newPoll(now.Add(-time.Hour), []uuid.UUID{})},
+	}
+
+	queryService := mock_query.NewMockPostQueryService(ctrl)
+	queryService.EXPECT().FindAll(gomock.Any(), post.PostFilter{}, 21, 0).Return(expectedPosts, nil).Times(1)
+	queryService.EXPECT().Count(gomock.Any(), post.PostFilter{}).Return(3, nil).Times(1)
+
+	uc := newTestUseCase(t, queryService)
+	output, err := uc.Execute(context.Background(), post.ListPostsInput{Limit: 20, Offset: 0})
+
+	require.NoError(t, err)
+	require.Len(t, output.Posts, 3)
+	// The tallies of an open poll stay hidden until the viewer votes; once it closes everyone sees them.
+	assert.True(t, output.Posts[0].Poll.ResultsHidden)
+	assert.False(t, output.Posts[0].Poll.Closed)
+	assert.True(t, output.Posts[2].Poll.Closed)
+	assert.Zero(t, output.Posts[0].Poll.VoterCount)
+	assert.Zero(t, output.Posts[0].Poll.Options[0].VoteCount)
+
+	for _, p := range output.Posts[1:] {
+		assert.False(t, p.Poll.ResultsHidden)
+		assert.Equal(t, 4, p.Poll.VoterCount)
+		assert.Equal(t, 4, p.Poll.Options[0].VoteCount)
+	}
+}
+
+func TestListPostsUseCase_Reactions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	viewerID := uuid.New()
+	posts := []post.PostDto{{ID: uuid.New()}, {ID: uuid.New()}}
+	reacted := post.PostReactionsDto{
+		Counts: map[vo.ReactionType]int{vo.ReactionTypeLike: 3, vo.ReactionTypeWow: 1},
+		Mine:   []vo.ReactionType{vo.ReactionTypeLike},
+	}
+	untouched := post.PostReactionsDto{Counts: map[vo.ReactionType]int{}, Mine: []vo.ReactionType{}}
+
+	queryService := mock_query.NewMockPostQueryService(ctrl)
+	queryService.EXPECT().FindAll(gomock.Any(), post.PostFilter{ViewerID: viewerID}, 21, 0).Return(posts, nil).Times(1)
+
+	// The whole page is looked up at once, for the requesting user.
+	reactionQueryService := mock_query.NewMockReactionQueryService(ctrl)
+	reactionQueryService.EXPECT().FindByPosts(gomock.Any(), []uuid.UUID{posts[0].ID, posts[1].ID}, viewerID).
+		Return(map[uuid.UUID]post.PostReactionsDto{posts[0].ID: reacted, posts[1].ID: untouched}, nil).Times(1)
+
+	uc := post.NewListPostsUseCase(queryService, reactionQueryService, hexCursorCodec{}, renderContent(ctrl))
+	includeTotal := false
+	output, err := uc.Execute(context.Background(), post.ListPostsInput{
+		Limit: 20, IncludeTotal: &includeTotal, ViewerID: viewerID,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Posts, 2)
+	assert.Equal(t, &reacted, output.Posts[0].Reactions)
+	assert.Equal(t, &untouched, output.Posts[1].Reactions)
+}
+
+func TestListPostsUseCase_ReactionsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	errDB := errors.New("db error")
+
+	queryService := mock_query.NewMockPostQueryService(ctrl)
+	queryService.EXPECT().FindAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]post.PostDto{{ID: uuid.New()}}, nil).Times(1)
+
+	reactionQueryService := mock_query.NewMockReactionQueryService(ctrl)
+	reactionQueryService.EXPECT().FindByPosts(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errDB).Times(1)
+
+	uc := post.NewListPostsUseCase(queryService, reactionQueryService, hexCursorCodec{}, renderContent(ctrl))
+	output, err := uc.Execute(context.Background(), post.ListPostsInput{Limit: 20})
+
+	require.ErrorIs(t, err, errDB)
+	assert.Nil(t, output)
 }
 
 func TestListPostsUseCase_Pagination(t *testing.T) {
@@ -52,28 +226,32 @@ func TestListPostsUseCase_Pagination(t *testing.T) {
 	}
 
 	queryService := mock_query.NewMockPostQueryService(ctrl)
-	queryService.EXPECT().FindAll(gomock.Any(), 5, 10).Return(expectedPosts, 42, nil).Times(1)
+	queryService.EXPECT().FindAll(gomock.Any(), post.PostFilter{}, 6, 10).Return(expectedPosts, nil).Times(1)
+	queryService.EXPECT().Count(gomock.Any(), post.PostFilter{}).Return(42, nil).Times(1)
 
 	uc := newTestUseCase(t, queryService)
 	output, err := uc.Execute(context.Background(), post.ListPostsInput{Limit: 5, Offset: 10})
 
 	require.NoError(t, err)
-	assert.Equal(t, 42, output.Total)
+	assert.Equal(t, 42, *output.Total)
 	assert.Len(t, output.Posts, 1)
+	// Later offset pages can switch to cursors towards newer posts.
+	assert.NotEmpty(t, output.PrevCursor)
 }
 
 func TestListPostsUseCase_EmptyResult(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	queryService := mock_query.NewMockPostQueryService(ctrl)
-	queryService.EXPECT().FindAll(gomock.Any(), 20, 0).Return(nil, 0, nil).Times(1)
+	queryService.EXPECT().FindAll(gomock.Any(), post.PostFilter{}, 21, 0).Return(nil, nil).Times(1)
+	queryService.EXPECT().Count(gomock.Any(), post.PostFilter{}).Return(0, nil).Times(1)
 
 	uc := newTestUseCase(t, queryService)
 	output, err := uc.Execute(context.Background(), post.ListPostsInput{Limit: 20, Offset: 0})
 
 	require.NoError(t, err)
 	require.NotNil(t, output)
-	assert.Equal(t, 0, output.Total)
+	assert.Equal(t, 0, *output.Total)
 	assert.Empty(t, output.Posts)
 }
 
@@ -96,9 +274,10 @@ func TestListPostsUseCase_BoundaryLimit(t *testing.T) {
 			queryService := mock_query.NewMockPostQueryService(ctrl)
 
 			if tt.valid {
-				queryService.EXPECT().FindAll(gomock.Any(), tt.limit, 0).Return([]post.PostDto{}, 0, nil).Times(1)
+				queryService.EXPECT().FindAll(gomock.Any(), post.PostFilter{}, tt.limit+1, 0).Return([]post.PostDto{}, nil).Times(1)
+				queryService.EXPECT().Count(gomock.Any(), post.PostFilter{}).Return(0, nil).Times(1)
 			} else {
-				queryService.EXPECT().FindAll(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				queryService.EXPECT().FindAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 			}
 
 			uc := newTestUseCase(t, queryService)
@@ -123,7 +302,8 @@ func TestListPostsUseCase_OffsetZeroIsValid(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	queryService := mock_query.NewMockPostQueryService(ctrl)
-	queryService.EXPECT().FindAll(gomock.Any(), 20, 0).Return([]post.PostDto{}, 0, nil).Times(1)
+	queryService.EXPECT().FindAll(gomock.Any(), post.PostFilter{}, 21, 0).Return([]post.PostDto{}, nil).Times(1)
+	queryService.EXPECT().Count(gomock.Any(), post.PostFilter{}).Return(0, nil).Times(1)
 
 	uc := newTestUseCase(t, queryService)
 	output, err := uc.Execute(context.Background(), post.ListPostsInput{Limit: 20, Offset: 0})
@@ -136,7 +316,7 @@ func TestListPostsUseCase_InvalidOffset(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	queryService := mock_query.NewMockPostQueryService(ctrl)
-	queryService.EXPECT().FindAll(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	queryService.EXPECT().FindAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 	uc := newTestUseCase(t, queryService)
 	output, err := uc.Execute(context.Background(), post.ListPostsInput{Limit: 20, Offset: -1})
@@ -153,14 +333,15 @@ func TestListPostsUseCase_LargeOffset(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	queryService := mock_query.NewMockPostQueryService(ctrl)
-	queryService.EXPECT().FindAll(gomock.Any(), 20, 999999).Return([]post.PostDto{}, 3, nil).Times(1)
+	queryService.EXPECT().FindAll(gomock.Any(), post.PostFilter{}, 21, 999999).Return([]post.PostDto{}, nil).Times(1)
+	queryService.EXPECT().Count(gomock.Any(), post.PostFilter{}).Return(3, nil).Times(1)
 
 	uc := newTestUseCase(t, queryService)
 	output, err := uc.Execute(context.Background(), post.ListPostsInput{Limit: 20, Offset: 999999})
 
 	require.NoError(t, err)
 	require.NotNil(t, output)
-	assert.Equal(t, 3, output.Total)
+	assert.Equal(t, 3, *output.Total)
 	assert.Empty(t, output.Posts)
 }
 
@@ -168,7 +349,7 @@ func TestListPostsUseCase_QueryServiceError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	queryService := mock_query.NewMockPostQueryService(ctrl)
-	queryService.EXPECT().FindAll(gomock.Any(), 20, 0).Return(nil, 0, errors.New("db error")).Times(1)
+	queryService.EXPECT().FindAll(gomock.Any(), post.PostFilter{}, 21, 0).Return(nil, errors.New("db error")).Times(1)
 
 	uc := newTestUseCase(t, queryService)
 	output, err := uc.Execute(context.Background(), post.ListPostsInput{Limit: 20, Offset: 0})
@@ -176,3 +357,193 @@ func TestListPostsUseCase_QueryServiceError(t *testing.T) {
 	require.Error(t, err)
 	assert.Nil(t, output)
 }
+
+func TestListPostsUseCase_CountError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	errDB := errors.New("db error")
+
+	queryService := mock_query.NewMockPostQueryService(ctrl)
+	queryService.EXPECT().FindAll(gomock.Any(), post.PostFilter{}, 21, 0).Return([]post.PostDto{}, nil).Times(1)
+	queryService.EXPECT().Count(gomock.Any(), post.PostFilter{}).Return(0, errDB).Times(1)
+
+	uc := newTestUseCase(t, queryService)
+	output, err := uc.Execute(context.Background(), post.ListPostsInput{Limit: 20})
+
+	require.ErrorIs(t, err, errDB)
+	assert.Nil(t, output)
+}
+
+// feedPosts returns n posts in feed order (newest first), one second apart.
+func feedPosts(n int) []post.PostDto {
+	start := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	posts := make([]post.PostDto, n)
+
+	for i := range posts {
+		posts[i] = post.PostDto{ID: uuid.New(), CreatedAt: start.Add(-time.Duration(i) * time.Second)}
+	}
+
+	return posts
+}
+
+func TestListPostsUseCase_CursorPaging(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	posts := feedPosts(5)
+	noFilter := post.PostFilter{}
+	cursorOf := func(p post.PostDto) post.PostCursor { return post.PostCursor{CreatedAt: p.CreatedAt, ID: p.ID} }
+
+	queryService := mock_query.NewMockPostQueryService(ctrl)
+	uc := newTestUseCase(t, queryService)
+	ctx := context.Background()
+
+	// First page: a limit+1 probe reveals a next page; skipping the count was requested.
+	noTotal := false
+
+	queryService.EXPECT().FindAll(gomock.Any(), post.PostFilter{}, 3, 0).Return(posts[:3], nil).Times(1)
+
+	first, err := uc.Execute(ctx, post.ListPostsInput{Limit: 2, IncludeTotal: &noTotal})
+	require.NoError(t, err)
+	assert.Equal(t, posts[:2], first.Posts)
+	assert.Nil(t, first.Total)
+	assert.Empty(t, first.PrevCursor)
+	require.NotEmpty(t, first.NextCursor)
+
+	// Following the next cursor seeks after the last post of the first page; cursor pages skip the count.
+	queryService.EXPECT().FindAfter(gomock.Any(), noFilter, cursorOf(posts[1]), 3).Return(posts[2:5], nil).Times(1)
+
+	second, err := uc.Execute(ctx, post.ListPostsInput{Limit: 2, After: first.NextCursor})
+	require.NoError(t, err)
+	assert.Equal(t, posts[2:4], second.Posts)
+	assert.Nil(t, second.Total)
+	require.NotEmpty(t, second.NextCursor)
+	require.NotEmpty(t, second.PrevCursor)
+
+	// The last page has no next cursor.
+	queryService.EXPECT().FindAfter(gomock.Any(), noFilter, cursorOf(posts[3]), 3).Return(posts[4:], nil).Times(1)
+
+	last, err := uc.Execute(ctx, post.ListPostsInput{Limit: 2, After: second.NextCursor})
+	require.NoError(t, err)
+	assert.Equal(t, posts[4:], last.Posts)
+	assert.Empty(t, last.NextCursor)
+
+	// Going back from the second page drops the newest extra post and finds no earlier page.
+	queryService.EXPECT().FindBefore(gomock.Any(), noFilter, cursorOf(posts[2]), 3).Return(posts[:2], nil).Times(1)
+
+	back, err := uc.Execute(ctx, post.ListPostsInput{Limit: 2, Before: second.PrevCursor})
+	require.NoError(t, err)
+	assert.Equal(t, posts[:2], back.Posts)
+	assert.Empty(t, back.PrevCursor)
+	assert.NotEmpty(t, back.NextCursor)
+
+	// From the last page, going back with a full probe keeps the posts closest to the cursor.
+	withTotal := true
+
+	queryService.EXPECT().FindBefore(gomock.Any(), noFilter, cursorOf(posts[4]), 3).Return(posts[1:4], nil).Times(1)
+	queryService.EXPECT().Count(gomock.Any(), post.PostFilter{}).Return(5, nil).Times(1)
+
+	middle, err := uc.Execute(ctx, post.ListPostsInput{Limit: 2, Before: last.PrevCursor, IncludeTotal: &withTotal})
+	require.NoError(t, err)
+	assert.Equal(t, posts[2:4], middle.Posts)
+	assert.NotEmpty(t, middle.PrevCursor)
+	assert.Equal(t, 5, *middle.Total)
+}
+
+func TestListPostsUseCase_InvalidPaging(t *testing.T) {
+	valid := hexCursorCodec{}.Encode(make([]byte, 24))
+
+	tests := []struct {
+		name  string
+		input post.ListPostsInput
+	}{
+		{name: "after and before together", input: post.ListPostsInput{Limit: 20, After: valid, Before: valid}},
+		{name: "cursor with offset", input: post.ListPostsInput{Limit: 20, Offset: 5, After: valid}},
+		{name: "cursor not issued by the codec", input: post.ListPostsInput{Limit: 20, After: "not-a-cursor"}},
+		{name: "cursor with a malformed payload", input: post.ListPostsInput{Limit: 20, Before: "abcd"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			// Invalid requests never reach the data store.
+			uc := newTestUseCase(t, mock_query.NewMockPostQueryService(ctrl))
+			output, err := uc.Execute(context.Background(), tt.input)
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
+
+func TestListPostsUseCase_Filter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	filter := post.PostFilter{AuthorIDs: []uuid.UUID{uuid.New()}, CreatedAfter: &from, CreatedBefore: &to}
+
+	// The filter reaches both the page and the count.
+	queryService := mock_query.NewMockPostQueryService(ctrl)
+	queryService.EXPECT().FindAll(gomock.Any(), filter, 21, 0).Return([]post.PostDto{}, nil).Times(1)
+	queryService.EXPECT().Count(gomock.Any(), filter).Return(0, nil).Times(1)
+
+	uc := newTestUseCase(t, queryService)
+	output, err := uc.Execute(context.Background(), post.ListPostsInput{Limit: 20, Filter: filter})
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, *output.Total)
+}
+
+func TestListPostsUseCase_DraftsOfTheViewer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	viewerID := uuid.New()
+
+	// A viewer set by the caller is replaced, so that nobody lists the drafts of another user.
+	want := post.PostFilter{ViewerID: viewerID, Drafts: true}
+
+	queryService := mock_query.NewMockPostQueryService(ctrl)
+	queryService.EXPECT().FindAll(gomock.Any(), want, 21, 0).Return([]post.PostDto{}, nil).Times(1)
+	queryService.EXPECT().Count(gomock.Any(), want).Return(0, nil).Times(1)
+
+	uc := newTestUseCase(t, queryService)
+	_, err := uc.Execute(context.Background(), post.ListPostsInput{
+		Limit: 20, ViewerID: viewerID, Filter: post.PostFilter{ViewerID: uuid.New(), Drafts: true},
+	})
+
+	require.NoError(t, err)
+}
+
+func TestListPostsUseCase_InvalidFilter(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	authors := make([]uuid.UUID, 51)
+
+	for i := range authors {
+		authors[i] = uuid.New()
+	}
+
+	tests := []struct {
+		name   string
+		filter post.PostFilter
+	}{
+		{name: "too many authors", filter: post.PostFilter{AuthorIDs: authors}},
+		{name: "empty date range", filter: post.PostFilter{CreatedAfter: &from, CreatedBefore: &from}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			uc := newTestUseCase(t, mock_query.NewMockPostQueryService(ctrl))
+			output, err := uc.Execute(context.Background(), post.ListPostsInput{Limit: 20, Filter: tt.filter})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,68 @@
+//go:generate mockgen -source=list_saved_posts_query.go -destination=../../../../test/mock/usecase/query/mock_saved_post_query_service.go -package mock_query
+
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SavedPostDto is a post a user bookmarked or added to one of their collections.
+type SavedPostDto struct {
+	PostID uuid.UUID
+	// SavedAt is when the post was bookmarked or added to the collection.
+	SavedAt time.Time
+	// Post is nil for a tombstone: the post was deleted or the saving user may no longer see it.
+	Post *PostDto
+}
+
+// SavedPostQueryService is the port for fetching saved posts from the data store.
+// Every method only sees posts of the active tenant (organization) in ctx.
+type SavedPostQueryService interface {
+	// FindBookmarks returns up to limit of the user's bookmarks, the most recently saved first, that follow
+	// after, or start at the beginning when after is nil. The returned slice is never nil.
+	FindBookmarks(ctx context.Context, userID uuid.UUID, after *SavedPostCursor, limit int) ([]SavedPostDto, error)
+	// FindCollectionPosts returns up to limit posts of a collection in the order they were added, that
+	// follow after, or start at the beginning when after is nil. Posts are loaded as viewerID sees them.
+	// The returned slice is never nil.
+	FindCollectionPosts(
+		ctx context.Context, collectionID, viewerID uuid.UUID, after *SavedPostCursor, limit int,
+	) ([]SavedPostDto, error)
+}
+
+// ListBookmarksInput holds the parameters for listing the caller's bookmarks.
+type ListBookmarksInput struct {
+	UserID uuid.UUID
+	Limit  int
+	// After is an opaque cursor from a previous ListSavedPostsOutput.
+	After string
+}
+
+// ListCollectionPostsInput holds the parameters for listing the posts of one of the caller's collections.
+type ListCollectionPostsInput struct {
+	UserID       uuid.UUID
+	CollectionID uuid.UUID
+	Limit        int
+	// After is an opaque cursor from a previous ListSavedPostsOutput.
+	After string
+}
+
+// ListSavedPostsOutput is the result returned by ListBookmarksUseCase and ListCollectionPostsUseCase.
+type ListSavedPostsOutput struct {
+	Posts []SavedPostDto
+	// NextCursor is empty on the last page.
+	NextCursor string
+}
+
+// ListBookmarksUseCase lists the caller's bookmarks, newest first.
+type ListBookmarksUseCase interface {
+	Execute(ctx context.Context, input ListBookmarksInput) (*ListSavedPostsOutput, error)
+}
+
+// ListCollectionPostsUseCase lists the posts of one of the caller's collections in the order they were
+// added.
+type ListCollectionPostsUseCase interface {
+	Execute(ctx context.Context, input ListCollectionPostsInput) (*ListSavedPostsOutput, error)
+}
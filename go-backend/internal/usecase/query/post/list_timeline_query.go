@@ -0,0 +1,30 @@
+package post
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ListTimelineInput holds the parameters for the home-timeline query.
+type ListTimelineInput struct {
+	// ViewerID is the user whose timeline is listed.
+	ViewerID uuid.UUID
+	Limit    int
+	// After is an opaque cursor from a previous ListTimelineOutput.
+	After string
+}
+
+// ListTimelineOutput is the result returned by ListTimelineUseCase.
+type ListTimelineOutput struct {
+	// Posts carry their Reactions.
+	Posts []PostDto
+	// NextCursor pages towards older posts; it is empty on the last page.
+	NextCursor string
+}
+
+// ListTimelineUseCase is the application use case for reading the home timeline: the posts of the users
+// the viewer follows, newest first.
+type ListTimelineUseCase interface {
+	Execute(ctx context.Context, input ListTimelineInput) (*ListTimelineOutput, error)
+}
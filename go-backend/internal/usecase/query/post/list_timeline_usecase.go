@@ -0,0 +1,97 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type listTimelineUseCaseImpl struct {
+	tracer               trace.Tracer
+	logger               common.Logger
+	postQueryService     PostQueryService
+	reactionQueryService ReactionQueryService
+	cursorCodec          service.CursorCodec
+	contentRenderer      service.ContentRenderer
+}
+
+func (uc *listTimelineUseCaseImpl) Execute(
+	ctx context.Context, input ListTimelineInput,
+) (*ListTimelineOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "list_timeline")
+	defer span.End()
+
+	output, err := uc.listTimeline(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (uc *listTimelineUseCaseImpl) listTimeline(
+	ctx context.Context, input ListTimelineInput,
+) (*ListTimelineOutput, error) {
+	if input.Limit < minLimit || input.Limit > maxLimit {
+		return nil, vo.NewValidationError("limit must be between 1 and 100", nil, errInvalidLimit)
+	}
+
+	var after *PostCursor
+
+	if input.After != "" {
+		cursor, err := decodePostCursor(uc.cursorCodec, input.After)
+		if err != nil {
+			return nil, err
+		}
+
+		after = &cursor
+	}
+
+	// One post more than requested tells whether another page follows.
+	posts, err := uc.postQueryService.FindTimeline(ctx, input.ViewerID, after, input.Limit+1)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find timeline posts", "error", err)
+
+		return nil, err
+	}
+
+	output := &ListTimelineOutput{Posts: posts[:min(len(posts), input.Limit)]}
+	if len(posts) > input.Limit {
+		output.NextCursor = uc.cursorCodec.Encode(postCursorOf(output.Posts[len(output.Posts)-1]).marshal())
+	}
+
+	if err = attachReactions(ctx, uc.reactionQueryService, output.Posts, input.ViewerID); err != nil {
+		uc.logger.Error(ctx, "failed to find post reactions", "error", err)
+
+		return nil, err
+	}
+
+	renderPosts(uc.contentRenderer, output.Posts)
+
+	return output, nil
+}
+
+// NewListTimelineUseCase creates a new ListTimelineUseCase.
+func NewListTimelineUseCase(
+	postQueryService PostQueryService,
+	reactionQueryService ReactionQueryService,
+	cursorCodec service.CursorCodec,
+	contentRenderer service.ContentRenderer,
+) ListTimelineUseCase {
+	return &listTimelineUseCaseImpl{
+		tracer:               otel.Tracer("ListTimelineUseCase"),
+		logger:               common.NewLogger(),
+		postQueryService:     postQueryService,
+		reactionQueryService: reactionQueryService,
+		cursorCodec:          cursorCodec,
+		contentRenderer:      contentRenderer,
+	}
+}
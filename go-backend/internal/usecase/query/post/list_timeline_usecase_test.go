@@ -0,0 +1,104 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListTimelineUseCase_CursorPaging(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	viewerID := uuid.New()
+	now := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	posts := []post.PostDto{
+		{ID: uuid.New(), CreatedAt: now.Add(2 * time.Minute)},
+		{ID: uuid.New(), CreatedAt: now.Add(time.Minute)},
+		{ID: uuid.New(), CreatedAt: now},
+	}
+
+	postQueryService := mock_query.NewMockPostQueryService(ctrl)
+	postQueryService.EXPECT().FindTimeline(gomock.Any(), viewerID, nil, 3).Return(posts, nil).Times(1)
+
+	uc := post.NewListTimelineUseCase(postQueryService, noReactions(ctrl), hexCursorCodec{}, renderContent(ctrl))
+	first, err := uc.Execute(context.Background(), post.ListTimelineInput{ViewerID: viewerID, Limit: 2})
+
+	require.NoError(t, err)
+	require.Len(t, first.Posts, 2)
+	assert.NotNil(t, first.Posts[0].Reactions)
+	require.NotEmpty(t, first.NextCursor)
+
+	// The cursor points at the last post of the page.
+	after := &post.PostCursor{CreatedAt: posts[1].CreatedAt, ID: posts[1].ID}
+	postQueryService.EXPECT().FindTimeline(gomock.Any(), viewerID, after, 3).Return(posts[2:], nil).Times(1)
+
+	second, err := uc.Execute(context.Background(), post.ListTimelineInput{
+		ViewerID: viewerID, Limit: 2, After: first.NextCursor,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, second.Posts, 1)
+	assert.Equal(t, posts[2].ID, second.Posts[0].ID)
+	assert.Empty(t, second.NextCursor)
+}
+
+func TestListTimelineUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name        string
+		input       post.ListTimelineInput
+		findErr     error
+		reactionErr error
+		wantErr     error
+		wantCode    vo.ErrorCode
+	}{
+		{name: "limit too small", input: post.ListTimelineInput{Limit: 0}, wantCode: vo.ValidationErrorCode},
+		{name: "limit too large", input: post.ListTimelineInput{Limit: 101}, wantCode: vo.ValidationErrorCode},
+		{
+			name:     "malformed cursor",
+			input:    post.ListTimelineInput{Limit: 20, After: "not-hex"},
+			wantCode: vo.ValidationErrorCode,
+		},
+		{name: "query fails", input: post.ListTimelineInput{Limit: 20}, findErr: errDB, wantErr: errDB},
+		{name: "reactions fail", input: post.ListTimelineInput{Limit: 20}, reactionErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			postQueryService := mock_query.NewMockPostQueryService(ctrl)
+			postQueryService.EXPECT().FindTimeline(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return([]post.PostDto{{ID: uuid.New()}}, tt.findErr).AnyTimes()
+
+			reactionQueryService := mock_query.NewMockReactionQueryService(ctrl)
+			reactionQueryService.EXPECT().FindByPosts(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, tt.reactionErr).AnyTimes()
+
+			uc := post.NewListTimelineUseCase(postQueryService, reactionQueryService, hexCursorCodec{}, renderContent(ctrl))
+			output, err := uc.Execute(context.Background(), tt.input)
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,52 @@
+//go:generate mockgen -source=list_trash_query.go -destination=../../../../test/mock/usecase/query/mock_trash_query_service.go -package mock_query
+
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrashedPostDto is a read-only projection of a post in its author's trash.
+type TrashedPostDto struct {
+	ID         uuid.UUID
+	Content    string
+	Visibility string
+	Status     string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	DeletedAt  time.Time
+	// DeletedBy is who moved the post to the trash, the author or a moderator; nil when that user no longer
+	// exists.
+	DeletedBy *uuid.UUID
+	// PurgeAt is when the post leaves the trash for good; it can be restored until then. Set by the use case.
+	PurgeAt time.Time
+}
+
+// TrashQueryService is the port for fetching trashed posts from the data store.
+// Every method only sees posts of the active tenant (organization) in ctx.
+type TrashQueryService interface {
+	// FindTrash returns a page of the user's trashed posts, the most recently trashed first, and how many
+	// there are. The returned slice is never nil.
+	FindTrash(ctx context.Context, userID uuid.UUID, limit, offset int) ([]TrashedPostDto, int, error)
+}
+
+// ListTrashInput holds the parameters for the trash query.
+type ListTrashInput struct {
+	UserID uuid.UUID
+	Limit  int
+	Offset int
+}
+
+// ListTrashOutput is the result returned by ListTrashUseCase.
+type ListTrashOutput struct {
+	Items []TrashedPostDto
+	Total int
+}
+
+// ListTrashUseCase lists the caller's own trashed posts, whoever trashed them.
+type ListTrashUseCase interface {
+	Execute(ctx context.Context, input ListTrashInput) (*ListTrashOutput, error)
+}
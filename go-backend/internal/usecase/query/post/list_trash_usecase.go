@@ -0,0 +1,71 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type listTrashUseCaseImpl struct {
+	tracer            trace.Tracer
+	logger            common.Logger
+	trashQueryService TrashQueryService
+}
+
+func (uc *listTrashUseCaseImpl) Execute(ctx context.Context, input ListTrashInput) (*ListTrashOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	uc.logger.Info(ctx, "trash requested", "limit", input.Limit, "offset", input.Offset)
+
+	output, err := uc.execute(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (uc *listTrashUseCaseImpl) execute(ctx context.Context, input ListTrashInput) (*ListTrashOutput, error) {
+	if input.Limit < minLimit || input.Limit > maxLimit {
+		return nil, vo.NewValidationError("limit must be between 1 and 100", nil, errInvalidLimit)
+	}
+
+	if input.Offset < minOffset {
+		return nil, vo.NewValidationError("offset must be 0 or greater", nil, errInvalidOffset)
+	}
+
+	items, total, err := uc.trashQueryService.FindTrash(ctx, input.UserID, input.Limit, input.Offset)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find trash", "error", err)
+
+		return nil, err
+	}
+
+	if items == nil {
+		items = []TrashedPostDto{}
+	}
+
+	for i := range items {
+		items[i].PurgeAt = items[i].DeletedAt.Add(entity.PostTrashRetention)
+	}
+
+	return &ListTrashOutput{Items: items, Total: total}, nil
+}
+
+// NewListTrashUseCase creates a new ListTrashUseCase.
+func NewListTrashUseCase(trashQueryService TrashQueryService) ListTrashUseCase {
+	return &listTrashUseCaseImpl{
+		tracer:            otel.Tracer("ListTrashUseCase"),
+		logger:            common.NewLogger(),
+		trashQueryService: trashQueryService,
+	}
+}
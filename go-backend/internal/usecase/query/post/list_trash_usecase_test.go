@@ -0,0 +1,100 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListTrashUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userID := uuid.New()
+	deletedAt := time.Now().UTC().Add(-time.Hour)
+
+	items := []post.TrashedPostDto{{
+		ID: uuid.New(), Content: "oops", Visibility: "public", Status: "published",
+		CreatedAt: deletedAt.Add(-time.Hour), UpdatedAt: deletedAt, DeletedAt: deletedAt, DeletedBy: &userID,
+	}}
+
+	queryService := mock_query.NewMockTrashQueryService(ctrl)
+	queryService.EXPECT().FindTrash(gomock.Any(), userID, 20, 0).Return(items, 1, nil).Times(1)
+
+	uc := post.NewListTrashUseCase(queryService)
+	output, err := uc.Execute(context.Background(), post.ListTrashInput{UserID: userID, Limit: 20, Offset: 0})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, output.Total)
+	require.Len(t, output.Items, 1)
+	assert.Equal(t, deletedAt.Add(entity.PostTrashRetention), output.Items[0].PurgeAt)
+}
+
+func TestListTrashUseCase_EmptyTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userID := uuid.New()
+
+	queryService := mock_query.NewMockTrashQueryService(ctrl)
+	queryService.EXPECT().FindTrash(gomock.Any(), userID, 20, 0).Return(nil, 0, nil).Times(1)
+
+	uc := post.NewListTrashUseCase(queryService)
+	output, err := uc.Execute(context.Background(), post.ListTrashInput{UserID: userID, Limit: 20, Offset: 0})
+
+	require.NoError(t, err)
+	assert.NotNil(t, output.Items)
+	assert.Empty(t, output.Items)
+}
+
+func TestListTrashUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name     string
+		limit    int
+		offset   int
+		queryErr error
+		wantErr  error
+		wantCode vo.ErrorCode
+	}{
+		{name: "limit too small", wantCode: vo.ValidationErrorCode},
+		{name: "limit too large", limit: 101, wantCode: vo.ValidationErrorCode},
+		{name: "negative offset", limit: 20, offset: -1, wantCode: vo.ValidationErrorCode},
+		{name: "query fails", limit: 20, queryErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			queryService := mock_query.NewMockTrashQueryService(ctrl)
+			queryService.EXPECT().FindTrash(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, 0, tt.queryErr).AnyTimes()
+
+			uc := post.NewListTrashUseCase(queryService)
+			output, err := uc.Execute(context.Background(), post.ListTrashInput{
+				UserID: uuid.New(), Limit: tt.limit, Offset: tt.offset,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var domainErr vo.Error
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tt.wantCode, domainErr.Code())
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package post
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ListUserPostsInput holds the parameters for listing the posts of one author.
+type ListUserPostsInput struct {
+	AuthorID uuid.UUID
+	// Page addresses the page and the date range as for ListPostsUseCase; its author filter is replaced by
+	// AuthorID.
+	Page ListPostsInput
+}
+
+// ListUserPostsUseCase is the application use case for a user's post timeline.
+type ListUserPostsUseCase interface {
+	Execute(ctx context.Context, input ListUserPostsInput) (*ListPostsOutput, error)
+}
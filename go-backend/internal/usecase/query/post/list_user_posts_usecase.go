@@ -0,0 +1,76 @@
+package post
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errAuthorNotFound = errors.New("author not found")
+
+type listUserPostsUseCaseImpl struct {
+	tracer           trace.Tracer
+	logger           common.Logger
+	postQueryService PostQueryService
+	listPosts        ListPostsUseCase
+}
+
+func (uc *listUserPostsUseCaseImpl) Execute(
+	ctx context.Context, input ListUserPostsInput,
+) (*ListPostsOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "list_user_posts")
+	defer span.End()
+
+	author, err := uc.postQueryService.FindAuthor(ctx, input.AuthorID)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find post author", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	if author == nil {
+		err = vo.NewNotFoundError("user not found", nil, errAuthorNotFound)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	page := input.Page
+	page.Filter.AuthorIDs = []uuid.UUID{author.ID}
+
+	output, err := uc.listPosts.Execute(ctx, page)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// NewListUserPostsUseCase creates a new ListUserPostsUseCase. Paging follows the same rules, and accepts
+// the same cursors, as the ListPostsUseCase built from the same dependencies.
+func NewListUserPostsUseCase(
+	postQueryService PostQueryService,
+	reactionQueryService ReactionQueryService,
+	cursorCodec service.CursorCodec,
+	contentRenderer service.ContentRenderer,
+) ListUserPostsUseCase {
+	return &listUserPostsUseCaseImpl{
+		tracer:           otel.Tracer("ListUserPostsUseCase"),
+		logger:           common.NewLogger(),
+		postQueryService: postQueryService,
+		listPosts:        NewListPostsUseCase(postQueryService, reactionQueryService, cursorCodec, contentRenderer),
+	}
+}
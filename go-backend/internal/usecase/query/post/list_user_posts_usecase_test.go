@@ -0,0 +1,88 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListUserPostsUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authorID := uuid.New()
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	posts := []post.PostDto{{ID: uuid.New(), UserID: authorID, AuthorName: "Author", CreatedAt: from}}
+
+	// Any author filter in the page is replaced by the requested user; the date range is kept.
+	page := post.ListPostsInput{
+		Limit:  20,
+		Filter: post.PostFilter{AuthorIDs: []uuid.UUID{uuid.New()}, CreatedAfter: &from},
+	}
+	want := post.PostFilter{AuthorIDs: []uuid.UUID{authorID}, CreatedAfter: &from}
+
+	queryService := mock_query.NewMockPostQueryService(ctrl)
+	queryService.EXPECT().FindAuthor(gomock.Any(), authorID).
+		Return(&post.PostAuthorDto{ID: authorID, Name: "Author"}, nil).Times(1)
+	queryService.EXPECT().FindAll(gomock.Any(), want, 21, 0).Return(posts, nil).Times(1)
+	queryService.EXPECT().Count(gomock.Any(), want).Return(1, nil).Times(1)
+
+	uc := post.NewListUserPostsUseCase(queryService, noReactions(ctrl), hexCursorCodec{}, renderContent(ctrl))
+	output, err := uc.Execute(context.Background(), post.ListUserPostsInput{AuthorID: authorID, Page: page})
+
+	require.NoError(t, err)
+	assert.Equal(t, posts, output.Posts)
+	assert.NotNil(t, output.Posts[0].Reactions)
+	assert.Equal(t, 1, *output.Total)
+}
+
+func TestListUserPostsUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+	author := &post.PostAuthorDto{ID: uuid.New(), Name: "Author"}
+
+	tests := []struct {
+		name     string
+		author   *post.PostAuthorDto
+		findErr  error
+		page     post.ListPostsInput
+		wantErr  error
+		wantCode vo.ErrorCode
+	}{
+		{name: "user is not an author in the tenant", page: post.ListPostsInput{Limit: 20}, wantCode: vo.NotFoundErrorCode},
+		{name: "author query fails", findErr: errDB, page: post.ListPostsInput{Limit: 20}, wantErr: errDB},
+		{name: "invalid page", author: author, page: post.ListPostsInput{Limit: 0}, wantCode: vo.ValidationErrorCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			// No case reaches the post list.
+			queryService := mock_query.NewMockPostQueryService(ctrl)
+			queryService.EXPECT().FindAuthor(gomock.Any(), gomock.Any()).Return(tt.author, tt.findErr).Times(1)
+
+			uc := post.NewListUserPostsUseCase(queryService, noReactions(ctrl), hexCursorCodec{}, renderContent(ctrl))
+			output, err := uc.Execute(context.Background(), post.ListUserPostsInput{AuthorID: uuid.New(), Page: tt.page})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package post
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// postCursorSize is 8 bytes of Unix microseconds followed by the 16-byte post ID.
+const postCursorSize = 8 + 16
+
+var errMalformedPostCursor = errors.New("malformed post cursor")
+
+// PostCursor is a position in feed order: newest first, ties broken by ID. Post IDs are UUIDv7, so the
+// ID order agrees with creation order.
+type PostCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+func postCursorOf(post PostDto) PostCursor {
+	return PostCursor{CreatedAt: post.CreatedAt, ID: post.ID}
+}
+
+// marshal keeps microsecond precision, which is what the database stores.
+func (c PostCursor) marshal() []byte {
+	b := make([]byte, 0, postCursorSize)
+	b = binary.BigEndian.AppendUint64(b, uint64(c.CreatedAt.UnixMicro())) //nolint:gosec // round-trips in unmarshal
+
+	return append(b, c.ID[:]...)
+}
+
+func unmarshalPostCursor(b []byte) (PostCursor, error) {
+	if len(b) != postCursorSize {
+		return PostCursor{}, errMalformedPostCursor
+	}
+
+	micros := int64(binary.BigEndian.Uint64(b[:8])) //nolint:gosec // written by marshal from an int64
+
+	id, err := uuid.FromBytes(b[8:])
+	if err != nil {
+		return PostCursor{}, errMalformedPostCursor
+	}
+
+	return PostCursor{CreatedAt: time.UnixMicro(micros).UTC(), ID: id}, nil
+}
+
+// CommentCursor is a position in thread order: oldest first, ties broken by ID. It is encoded like a
+// PostCursor.
+type CommentCursor PostCursor
+
+func commentCursorOf(comment CommentDto) CommentCursor {
+	return CommentCursor{CreatedAt: comment.CreatedAt, ID: comment.ID}
+}
+
+func (c CommentCursor) marshal() []byte {
+	return PostCursor(c).marshal()
+}
+
+func unmarshalCommentCursor(b []byte) (CommentCursor, error) {
+	cursor, err := unmarshalPostCursor(b)
+
+	return CommentCursor(cursor), err
+}
+
+// SavedPostCursor is a position in a bookmark list or collection: by when the post was saved, ties broken by
+// post ID. It is encoded like a PostCursor, with CreatedAt holding the time the post was saved.
+type SavedPostCursor PostCursor
+
+func savedPostCursorOf(saved SavedPostDto) SavedPostCursor {
+	return SavedPostCursor{CreatedAt: saved.SavedAt, ID: saved.PostID}
+}
+
+func (c SavedPostCursor) marshal() []byte {
+	return PostCursor(c).marshal()
+}
+
+func unmarshalSavedPostCursor(b []byte) (SavedPostCursor, error) {
+	cursor, err := unmarshalPostCursor(b)
+
+	return SavedPostCursor(cursor), err
+}
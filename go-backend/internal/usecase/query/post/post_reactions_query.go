@@ -0,0 +1,26 @@
+//go:generate mockgen -source=post_reactions_query.go -destination=../../../../test/mock/usecase/query/mock_reaction_query_service.go -package mock_query
+
+package post
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// PostReactionsDto summarizes the reactions to one post as seen by one user.
+type PostReactionsDto struct {
+	// Counts holds the number of reactions of each type that has at least one.
+	Counts map[vo.ReactionType]int
+	// Mine lists the viewer's own reactions in vo.ReactionTypes order.
+	Mine []vo.ReactionType
+}
+
+// ReactionQueryService is the port for reading reaction counts. Every method only sees reactions of the
+// active tenant (organization) in ctx.
+type ReactionQueryService interface {
+	// FindByPosts returns the reactions to each of postIDs together with those viewerID made. Every post
+	// in postIDs has an entry; its Counts and Mine are empty, never nil, when nobody reacted.
+	FindByPosts(ctx context.Context, postIDs []uuid.UUID, viewerID uuid.UUID) (map[uuid.UUID]PostReactionsDto, error)
+}
@@ -0,0 +1,57 @@
+//go:generate mockgen -source=search_posts_query.go -destination=../../../../test/mock/usecase/query/mock_post_search_service.go -package mock_query
+
+package post
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// PostSearchHitDto is a post matching a search together with its relevance.
+type PostSearchHitDto struct {
+	Post PostDto
+	// Rank is between 0 and 1; higher is more relevant.
+	Rank float64
+}
+
+// PostSearchService is the port for full-text search over posts. It only sees posts of the active
+// tenant (organization) in ctx.
+type PostSearchService interface {
+	// Search returns up to limit published posts matching every term of query that viewerID may see, by the
+	// rule of PostFilter, most relevant first and newest first among equals, skipping the first offset. The
+	// returned slice is never nil.
+	Search(
+		ctx context.Context, query vo.SearchQuery, viewerID uuid.UUID, limit, offset int,
+	) ([]PostSearchHitDto, error)
+}
+
+// SearchPostsInput holds the parameters for the search-posts query.
+type SearchPostsInput struct {
+	// Query is parsed by vo.NewSearchQuery.
+	Query  string
+	Limit  int
+	Offset int
+	// ViewerID is the requesting user.
+	ViewerID uuid.UUID
+}
+
+// PostSearchResultDto is one search result with an excerpt of the content around the matches.
+type PostSearchResultDto struct {
+	Post    PostDto
+	Rank    float64
+	Snippet []vo.TextSegment
+}
+
+// SearchPostsOutput is the result returned by SearchPostsUseCase.
+type SearchPostsOutput struct {
+	Results []PostSearchResultDto
+	// HasMore reports whether the next offset has further results.
+	HasMore bool
+}
+
+// SearchPostsUseCase is the application use case for searching posts.
+type SearchPostsUseCase interface {
+	Execute(ctx context.Context, input SearchPostsInput) (*SearchPostsOutput, error)
+}
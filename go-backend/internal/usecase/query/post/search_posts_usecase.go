@@ -0,0 +1,84 @@
+package post
+
+import (
+	"context"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type searchPostsUseCaseImpl struct {
+	tracer            trace.Tracer
+	logger            common.Logger
+	postSearchService PostSearchService
+	contentRenderer   service.ContentRenderer
+}
+
+func (uc *searchPostsUseCaseImpl) Execute(
+	ctx context.Context, input SearchPostsInput,
+) (*SearchPostsOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "search_posts")
+	defer span.End()
+
+	query, err := validateSearchPostsInput(input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	// One extra hit tells whether another page follows.
+	hits, err := uc.postSearchService.Search(ctx, *query, input.ViewerID, input.Limit+1, input.Offset)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to search posts", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	output := &SearchPostsOutput{
+		Results: make([]PostSearchResultDto, 0, min(len(hits), input.Limit)),
+		HasMore: len(hits) > input.Limit,
+	}
+
+	for _, hit := range hits[:min(len(hits), input.Limit)] {
+		renderPost(uc.contentRenderer, &hit.Post)
+		output.Results = append(output.Results, PostSearchResultDto{
+			Post:    hit.Post,
+			Rank:    hit.Rank,
+			Snippet: vo.Snippet(hit.Post.Content, query.Terms()),
+		})
+	}
+
+	return output, nil
+}
+
+func validateSearchPostsInput(input SearchPostsInput) (*vo.SearchQuery, error) {
+	if input.Limit < minLimit || input.Limit > maxLimit {
+		return nil, vo.NewValidationError("limit must be between 1 and 100", nil, errInvalidLimit)
+	}
+
+	if input.Offset < minOffset {
+		return nil, vo.NewValidationError("offset must be 0 or greater", nil, errInvalidOffset)
+	}
+
+	return vo.NewSearchQuery(input.Query)
+}
+
+// NewSearchPostsUseCase creates a new SearchPostsUseCase.
+func NewSearchPostsUseCase(
+	postSearchService PostSearchService, contentRenderer service.ContentRenderer,
+) SearchPostsUseCase {
+	return &searchPostsUseCaseImpl{
+		tracer:            otel.Tracer("SearchPostsUseCase"),
+		logger:            common.NewLogger(),
+		postSearchService: postSearchService,
+		contentRenderer:   contentRenderer,
+	}
+}
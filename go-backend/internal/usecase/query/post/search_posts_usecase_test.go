@@ -0,0 +1,109 @@
+package post_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSearchPostsUseCase_HappyCase(t *testing.T) {
+	hits := []post.PostSearchHitDto{
+		{Post: post.PostDto{ID: uuid.New(), Content: "とうきょうでかいぎ", Format: "plain", RevisionCount: 1}, Rank: 0.5},
+		{Post: post.PostDto{ID: uuid.New(), Content: "とうきょうタワー", Format: "markdown", RevisionCount: 2}, Rank: 0},
+	}
+
+	tests := []struct {
+		name        string
+		limit       int
+		found       []post.PostSearchHitDto
+		wantResults int
+		wantHasMore bool
+	}{
+		{name: "a full probe means more results follow", limit: 1, found: hits, wantResults: 1, wantHasMore: true},
+		{name: "a short page is the last one", limit: 5, found: hits, wantResults: 2},
+		{name: "no hits", limit: 5, found: []post.PostSearchHitDto{}, wantResults: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			viewerID := uuid.New()
+			query, err := vo.NewSearchQuery("とうきょう")
+			require.NoError(t, err)
+
+			searchService := mock_query.NewMockPostSearchService(ctrl)
+			searchService.EXPECT().Search(gomock.Any(), *query, viewerID, tt.limit+1, 3).Return(tt.found, nil).Times(1)
+
+			uc := post.NewSearchPostsUseCase(searchService, renderContent(ctrl))
+			output, err := uc.Execute(context.Background(), post.SearchPostsInput{
+				Query: " とうきょう ", ViewerID: viewerID, Limit: tt.limit, Offset: 3,
+			})
+
+			require.NoError(t, err)
+			require.Len(t, output.Results, tt.wantResults)
+			assert.Equal(t, tt.wantHasMore, output.HasMore)
+
+			for i, result := range output.Results {
+				assert.Equal(t, hits[i].Post.ID, result.Post.ID)
+				assert.Equal(t, fmt.Sprintf("%s@%d:%s", hits[i].Post.Format, hits[i].Post.RevisionCount,
+					hits[i].Post.Content), result.Post.ContentHTML)
+				assert.InDelta(t, hits[i].Rank, result.Rank, 0)
+				assert.Contains(t, result.Snippet, vo.TextSegment{Text: "とうきょう", Highlighted: true})
+			}
+		})
+	}
+}
+
+func TestSearchPostsUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name      string
+		input     post.SearchPostsInput
+		searchErr error
+		wantErr   error
+	}{
+		{name: "empty query", input: post.SearchPostsInput{Query: "  ", Limit: 20}},
+		{name: "limit out of range", input: post.SearchPostsInput{Query: "go", Limit: 101}},
+		{name: "negative offset", input: post.SearchPostsInput{Query: "go", Limit: 20, Offset: -1}},
+		{name: "search fails", input: post.SearchPostsInput{Query: "go", Limit: 20}, searchErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			// Only valid input reaches the search service.
+			searchService := mock_query.NewMockPostSearchService(ctrl)
+			if tt.searchErr != nil {
+				searchService.EXPECT().Search(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, tt.searchErr).Times(1)
+			}
+
+			uc := post.NewSearchPostsUseCase(searchService, renderContent(ctrl))
+			output, err := uc.Execute(context.Background(), tt.input)
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, vo.ValidationErrorCode, voErr.Code())
+		})
+	}
+}
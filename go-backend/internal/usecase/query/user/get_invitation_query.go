@@ -0,0 +1,31 @@
+//go:generate mockgen -source=get_invitation_query.go -destination=../../../../test/mock/usecase/query/mock_invitation_query_service.go -package mock_query
+
+package user
+
+import (
+	"context"
+	"time"
+)
+
+// InvitationPreviewDto is the part of a pending invitation shown to the invitee before signup.
+type InvitationPreviewDto struct {
+	Email     string
+	ExpiresAt time.Time
+}
+
+// InvitationQueryService is the port for looking up invitations in the data store.
+type InvitationQueryService interface {
+	// FindPendingByTokenHash returns nil when no unexpired, unaccepted invitation matches the hash.
+	FindPendingByTokenHash(ctx context.Context, tokenHash []byte) (*InvitationPreviewDto, error)
+}
+
+// GetInvitationInput holds the token presented by the invitee.
+type GetInvitationInput struct {
+	Token string
+}
+
+// GetInvitationUseCase lets an invitee see which email an invitation is for, so the signup form
+// can be pre-filled with it.
+type GetInvitationUseCase interface {
+	Execute(ctx context.Context, input GetInvitationInput) (*InvitationPreviewDto, error)
+}
@@ -0,0 +1,63 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errInvitationNotPending = errors.New("invitation is unknown, expired, or already accepted")
+
+type getInvitationUseCaseImpl struct {
+	tracer                 trace.Tracer
+	logger                 common.Logger
+	invitationQueryService InvitationQueryService
+}
+
+func (uc *getInvitationUseCaseImpl) Execute(
+	ctx context.Context, input GetInvitationInput,
+) (*InvitationPreviewDto, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	token, err := vo.NewInvitationToken(input.Token)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	preview, err := uc.invitationQueryService.FindPendingByTokenHash(ctx, token.Hash())
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find invitation", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	// Unknown, expired, and used tokens are indistinguishable to the caller.
+	if preview == nil {
+		err = vo.NewValidationError("invitation is invalid or has expired", nil, errInvitationNotPending)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return preview, nil
+}
+
+func NewGetInvitationUseCase(invitationQueryService InvitationQueryService) GetInvitationUseCase {
+	return &getInvitationUseCaseImpl{
+		tracer:                 otel.Tracer("GetInvitationUseCase"),
+		logger:                 common.NewLogger(),
+		invitationQueryService: invitationQueryService,
+	}
+}
@@ -0,0 +1,74 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetInvitationUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	token := vo.InvitationToken("invitation-token")
+	preview := &user.InvitationPreviewDto{Email: "invitee@example.com", ExpiresAt: time.Now().Add(time.Hour)}
+
+	queryService := mock_query.NewMockInvitationQueryService(ctrl)
+	queryService.EXPECT().FindPendingByTokenHash(gomock.Any(), token.Hash()).Return(preview, nil).Times(1)
+
+	uc := user.NewGetInvitationUseCase(queryService)
+	output, err := uc.Execute(context.Background(), user.GetInvitationInput{Token: token.String()})
+
+	require.NoError(t, err)
+	assert.Equal(t, preview, output)
+}
+
+func TestGetInvitationUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name     string
+		token    string
+		queried  bool
+		queryErr error
+		wantErr  error
+		wantCode vo.ErrorCode
+	}{
+		{name: "empty token", token: " ", wantCode: vo.ValidationErrorCode},
+		{name: "unknown, expired, or used token", token: "token", queried: true, wantCode: vo.ValidationErrorCode},
+		{name: "query fails", token: "token", queried: true, queryErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			queryService := mock_query.NewMockInvitationQueryService(ctrl)
+			if tt.queried {
+				queryService.EXPECT().FindPendingByTokenHash(gomock.Any(), gomock.Any()).Return(nil, tt.queryErr).Times(1)
+			}
+
+			uc := user.NewGetInvitationUseCase(queryService)
+			output, err := uc.Execute(context.Background(), user.GetInvitationInput{Token: tt.token})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,48 @@
+//go:generate mockgen -source=get_user_profile_query.go -destination=../../../../test/mock/usecase/query/mock_profile_query_service.go -package mock_query
+
+package user
+
+import (
+	"context"
+	"time"
+
+	querypost "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/google/uuid"
+)
+
+// UserProfileDto is the public profile of a member of the active tenant. It deliberately holds nothing
+// private about the user, such as their email or account status.
+type UserProfileDto struct {
+	ID   uuid.UUID
+	Name string
+	Bio  string
+	// AvatarURL is empty when the user has no avatar.
+	AvatarURL string
+	// JoinedAt is when the user joined the active tenant.
+	JoinedAt      time.Time
+	FollowerCount int
+	// PostCount is the number of the user's posts the viewer may see.
+	PostCount int
+	// PinnedPosts are the user's pinned posts the viewer may see, most recently pinned first.
+	PinnedPosts []querypost.PostDto
+}
+
+// ProfileQueryService is the port for fetching user profiles from the data store.
+// Every method only sees members and posts of the active tenant (organization) in ctx.
+type ProfileQueryService interface {
+	// FindProfile returns nil unless the user is a member of the active tenant, or when the user and
+	// viewerID block one another. Pinned posts are loaded as viewerID sees them; PostCount is left zero.
+	FindProfile(ctx context.Context, userID, viewerID uuid.UUID) (*UserProfileDto, error)
+}
+
+// GetUserProfileInput identifies the profile to read.
+type GetUserProfileInput struct {
+	UserID uuid.UUID
+	// ViewerID is the requesting user.
+	ViewerID uuid.UUID
+}
+
+// GetUserProfileUseCase is the application use case for reading the public profile of a member.
+type GetUserProfileUseCase interface {
+	Execute(ctx context.Context, input GetUserProfileInput) (*UserProfileDto, error)
+}
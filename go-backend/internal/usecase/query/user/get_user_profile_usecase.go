@@ -0,0 +1,98 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	querypost "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errProfileNotFound = errors.New("profile not found")
+
+type getUserProfileUseCaseImpl struct {
+	tracer               trace.Tracer
+	logger               common.Logger
+	profileQueryService  ProfileQueryService
+	postQueryService     querypost.PostQueryService
+	reactionQueryService querypost.ReactionQueryService
+	contentRenderer      service.ContentRenderer
+}
+
+func (uc *getUserProfileUseCaseImpl) Execute(
+	ctx context.Context, input GetUserProfileInput,
+) (*UserProfileDto, error) {
+	ctx, span := uc.tracer.Start(ctx, "get_user_profile")
+	defer span.End()
+
+	profile, err := uc.getUserProfile(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+func (uc *getUserProfileUseCaseImpl) getUserProfile(
+	ctx context.Context, input GetUserProfileInput,
+) (*UserProfileDto, error) {
+	profile, err := uc.profileQueryService.FindProfile(ctx, input.UserID, input.ViewerID)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find profile", "error", err)
+
+		return nil, err
+	}
+
+	// Users of other tenants are reported exactly like missing ones.
+	if profile == nil {
+		return nil, vo.NewNotFoundError("user not found", nil, errProfileNotFound)
+	}
+
+	// Counted like the user's post list, so that the count matches what the viewer can page through.
+	profile.PostCount, err = uc.postQueryService.Count(ctx, querypost.PostFilter{
+		ViewerID:  input.ViewerID,
+		AuthorIDs: []uuid.UUID{input.UserID},
+	})
+	if err != nil {
+		uc.logger.Error(ctx, "failed to count posts", "error", err)
+
+		return nil, err
+	}
+
+	err = querypost.PreparePosts(
+		ctx, uc.reactionQueryService, uc.contentRenderer, profile.PinnedPosts, input.ViewerID,
+	)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find post reactions", "error", err)
+
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// NewGetUserProfileUseCase creates a new GetUserProfileUseCase.
+func NewGetUserProfileUseCase(
+	profileQueryService ProfileQueryService,
+	postQueryService querypost.PostQueryService,
+	reactionQueryService querypost.ReactionQueryService,
+	contentRenderer service.ContentRenderer,
+) GetUserProfileUseCase {
+	return &getUserProfileUseCaseImpl{
+		tracer:               otel.Tracer("GetUserProfileUseCase"),
+		logger:               common.NewLogger(),
+		profileQueryService:  profileQueryService,
+		postQueryService:     postQueryService,
+		reactionQueryService: reactionQueryService,
+		contentRenderer:      contentRenderer,
+	}
+}
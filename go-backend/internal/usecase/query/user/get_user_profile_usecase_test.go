@@ -0,0 +1,132 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	querypost "github.com/Haya372/web-app-template/go-backend/internal/usecase/query/post"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	mock_service "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// noReactions answers every reaction lookup as if nobody had reacted to any post.
+func noReactions(ctrl *gomock.Controller) querypost.ReactionQueryService {
+	reactionQueryService := mock_query.NewMockReactionQueryService(ctrl)
+	reactionQueryService.EXPECT().FindByPosts(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, postIDs []uuid.UUID, _ uuid.UUID) (map[uuid.UUID]querypost.PostReactionsDto, error) {
+			reactions := make(map[uuid.UUID]querypost.PostReactionsDto, len(postIDs))
+			for _, id := range postIDs {
+				reactions[id] = querypost.PostReactionsDto{Counts: map[vo.ReactionType]int{}, Mine: []vo.ReactionType{}}
+			}
+
+			return reactions, nil
+		}).AnyTimes()
+
+	return reactionQueryService
+}
+
+// renderContent is a content renderer whose output shows what it was asked to render.
+func renderContent(ctrl *gomock.Controller) service.ContentRenderer {
+	contentRenderer := mock_service.NewMockContentRenderer(ctrl)
+	contentRenderer.EXPECT().Render(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ uuid.UUID, revision int, format vo.ContentFormat, source string) string {
+			return fmt.Sprintf("%s@%d:%s", format, revision, source)
+		}).AnyTimes()
+
+	return contentRenderer
+}
+
+func TestGetUserProfileUseCase_HappyCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userID, viewerID, pinnedID := uuid.New(), uuid.New(), uuid.New()
+
+	profileQueryService := mock_query.NewMockProfileQueryService(ctrl)
+	profileQueryService.EXPECT().FindProfile(gomock.Any(), userID, viewerID).Return(&user.UserProfileDto{
+		ID:            userID,
+		Name:          "Alice",
+		FollowerCount: 2,
+		PinnedPosts:   []querypost.PostDto{{ID: pinnedID, Content: "a", Format: "plain"}},
+	}, nil).Times(1)
+
+	postQueryService := mock_query.NewMockPostQueryService(ctrl)
+	postQueryService.EXPECT().
+		Count(gomock.Any(), querypost.PostFilter{ViewerID: viewerID, AuthorIDs: []uuid.UUID{userID}}).
+		Return(5, nil).Times(1)
+
+	uc := user.NewGetUserProfileUseCase(profileQueryService, postQueryService, noReactions(ctrl), renderContent(ctrl))
+	profile, err := uc.Execute(context.Background(), user.GetUserProfileInput{UserID: userID, ViewerID: viewerID})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Alice", profile.Name)
+	assert.Equal(t, 2, profile.FollowerCount)
+	assert.Equal(t, 5, profile.PostCount)
+	require.Len(t, profile.PinnedPosts, 1)
+	assert.NotNil(t, profile.PinnedPosts[0].Reactions)
+	assert.Equal(t, "plain@0:a", profile.PinnedPosts[0].ContentHTML)
+}
+
+func TestGetUserProfileUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+	found := func() *user.UserProfileDto {
+		return &user.UserProfileDto{PinnedPosts: []querypost.PostDto{{ID: uuid.New(), Content: "a", Format: "plain"}}}
+	}
+
+	tests := []struct {
+		name        string
+		profile     *user.UserProfileDto
+		findErr     error
+		countErr    error
+		reactionErr error
+		wantErr     error
+		wantCode    vo.ErrorCode
+	}{
+		{name: "user not found", wantCode: vo.NotFoundErrorCode},
+		{name: "query fails", findErr: errDB, wantErr: errDB},
+		{name: "count fails", profile: found(), countErr: errDB, wantErr: errDB},
+		{name: "reactions fail", profile: found(), reactionErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			profileQueryService := mock_query.NewMockProfileQueryService(ctrl)
+			profileQueryService.EXPECT().FindProfile(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(tt.profile, tt.findErr).AnyTimes()
+
+			postQueryService := mock_query.NewMockPostQueryService(ctrl)
+			postQueryService.EXPECT().Count(gomock.Any(), gomock.Any()).Return(0, tt.countErr).AnyTimes()
+
+			reactionQueryService := mock_query.NewMockReactionQueryService(ctrl)
+			reactionQueryService.EXPECT().FindByPosts(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, tt.reactionErr).AnyTimes()
+
+			uc := user.NewGetUserProfileUseCase(
+				profileQueryService, postQueryService, reactionQueryService, renderContent(ctrl),
+			)
+			output, err := uc.Execute(context.Background(), user.GetUserProfileInput{UserID: uuid.New()})
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
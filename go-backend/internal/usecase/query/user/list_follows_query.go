@@ -0,0 +1,62 @@
+//go:generate mockgen -source=list_follows_query.go -destination=../../../../test/mock/usecase/query/mock_follow_query_service.go -package mock_query
+
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FollowDirection selects which side of a user's follows is listed.
+type FollowDirection string
+
+const (
+	// FollowDirectionFollowers lists the users who follow the user.
+	FollowDirectionFollowers FollowDirection = "followers"
+	// FollowDirectionFollowing lists the users the user follows.
+	FollowDirectionFollowing FollowDirection = "following"
+)
+
+// FollowDto is a read-only projection of one entry of a follow list: the user on the other side of the
+// follow and when the follow began.
+type FollowDto struct {
+	UserID     uuid.UUID
+	Name       string
+	FollowedAt time.Time
+}
+
+// FollowQueryService is the port for fetching follow lists from the data store.
+// Every method only sees follows and members of the active tenant (organization) in ctx.
+type FollowQueryService interface {
+	// IsMember reports whether the user is a member of the active tenant.
+	IsMember(ctx context.Context, userID uuid.UUID) (bool, error)
+	// FindFollowers returns up to limit followers of the user in follow-list order, starting after the
+	// cursor when one is given. The returned slice is never nil.
+	FindFollowers(ctx context.Context, userID uuid.UUID, after *FollowCursor, limit int) ([]FollowDto, error)
+	// FindFollowing returns up to limit users the user follows, ordered and paged like FindFollowers.
+	FindFollowing(ctx context.Context, userID uuid.UUID, after *FollowCursor, limit int) ([]FollowDto, error)
+}
+
+// ListFollowsInput holds the parameters for the list-follows query.
+type ListFollowsInput struct {
+	UserID    uuid.UUID
+	Direction FollowDirection
+	Limit     int
+	// After is an opaque cursor from a previous ListFollowsOutput of the same user and direction.
+	After string
+}
+
+// ListFollowsOutput is the result returned by ListFollowsUseCase.
+type ListFollowsOutput struct {
+	Follows []FollowDto
+	// NextCursor is empty on the last page.
+	NextCursor string
+}
+
+// ListFollowsUseCase is the application use case for listing a user's followers or the users they follow,
+// most recent follow first.
+type ListFollowsUseCase interface {
+	Execute(ctx context.Context, input ListFollowsInput) (*ListFollowsOutput, error)
+}
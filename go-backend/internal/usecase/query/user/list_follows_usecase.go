@@ -0,0 +1,118 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	errFollowUserNotFound = errors.New("user not found")
+	errInvalidDirection   = errors.New("unknown follow direction")
+)
+
+type listFollowsUseCaseImpl struct {
+	tracer             trace.Tracer
+	logger             common.Logger
+	followQueryService FollowQueryService
+	cursorCodec        service.CursorCodec
+}
+
+func (uc *listFollowsUseCaseImpl) Execute(
+	ctx context.Context, input ListFollowsInput,
+) (*ListFollowsOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "list_follows")
+	defer span.End()
+
+	output, err := uc.listFollows(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (uc *listFollowsUseCaseImpl) listFollows(ctx context.Context, input ListFollowsInput) (*ListFollowsOutput, error) {
+	after, err := uc.validate(input)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := uc.followQueryService.IsMember(ctx, input.UserID)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find user", "error", err)
+
+		return nil, err
+	}
+
+	if !member {
+		return nil, vo.NewNotFoundError("user not found", nil, errFollowUserNotFound)
+	}
+
+	find := uc.followQueryService.FindFollowers
+	if input.Direction == FollowDirectionFollowing {
+		find = uc.followQueryService.FindFollowing
+	}
+
+	// One entry more than requested tells whether another page follows.
+	follows, err := find(ctx, input.UserID, after, input.Limit+1)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find follows", "error", err, "direction", input.Direction)
+
+		return nil, err
+	}
+
+	output := &ListFollowsOutput{Follows: follows[:min(len(follows), input.Limit)]}
+	if len(follows) > input.Limit {
+		output.NextCursor = uc.cursorCodec.Encode(followCursorOf(output.Follows[len(output.Follows)-1]).marshal())
+	}
+
+	return output, nil
+}
+
+func (uc *listFollowsUseCaseImpl) validate(input ListFollowsInput) (*FollowCursor, error) {
+	if input.Direction != FollowDirectionFollowers && input.Direction != FollowDirectionFollowing {
+		return nil, vo.NewValidationError("direction must be followers or following", nil, errInvalidDirection)
+	}
+
+	if input.Limit < minLimit || input.Limit > maxLimit {
+		return nil, vo.NewValidationError("limit must be between 1 and 100", nil, errInvalidLimit)
+	}
+
+	if input.After == "" {
+		return nil, nil //nolint:nilnil // no cursor means the first page
+	}
+
+	payload, err := uc.cursorCodec.Decode(input.After)
+	if err != nil {
+		return nil, vo.NewValidationError("cursor is invalid", nil, err)
+	}
+
+	cursor, err := unmarshalFollowCursor(payload)
+	if err != nil {
+		return nil, vo.NewValidationError("cursor is invalid", nil, err)
+	}
+
+	return &cursor, nil
+}
+
+// NewListFollowsUseCase creates a new ListFollowsUseCase.
+func NewListFollowsUseCase(
+	followQueryService FollowQueryService, cursorCodec service.CursorCodec,
+) ListFollowsUseCase {
+	return &listFollowsUseCaseImpl{
+		tracer:             otel.Tracer("ListFollowsUseCase"),
+		logger:             common.NewLogger(),
+		followQueryService: followQueryService,
+		cursorCodec:        cursorCodec,
+	}
+}
@@ -0,0 +1,148 @@
+package user_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// hexCursorCodec is an unsigned stand-in for the real codec so that tests can build cursors.
+type hexCursorCodec struct{}
+
+func (hexCursorCodec) Encode(payload []byte) string { return hex.EncodeToString(payload) }
+
+func (hexCursorCodec) Decode(token string) ([]byte, error) {
+	payload, err := hex.DecodeString(token)
+	if err != nil {
+		return nil, service.ErrInvalidCursor
+	}
+
+	return payload, nil
+}
+
+func TestListFollowsUseCase_CursorPaging(t *testing.T) {
+	now := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	follows := []user.FollowDto{
+		{UserID: uuid.New(), Name: "c", FollowedAt: now.Add(2 * time.Minute)},
+		{UserID: uuid.New(), Name: "b", FollowedAt: now.Add(time.Minute)},
+		{UserID: uuid.New(), Name: "a", FollowedAt: now},
+	}
+
+	tests := []struct {
+		name      string
+		direction user.FollowDirection
+	}{
+		{name: "followers", direction: user.FollowDirectionFollowers},
+		{name: "following", direction: user.FollowDirectionFollowing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			userID := uuid.New()
+			// The cursor points at the last entry of the first page.
+			after := &user.FollowCursor{CreatedAt: follows[1].FollowedAt, UserID: follows[1].UserID}
+
+			queryService := mock_query.NewMockFollowQueryService(ctrl)
+			queryService.EXPECT().IsMember(gomock.Any(), userID).Return(true, nil).Times(2)
+
+			find := queryService.EXPECT().FindFollowers
+			if tt.direction == user.FollowDirectionFollowing {
+				find = queryService.EXPECT().FindFollowing
+			}
+
+			find(gomock.Any(), userID, nil, 3).Return(follows, nil).Times(1)
+			find(gomock.Any(), userID, after, 3).Return(follows[2:], nil).Times(1)
+
+			uc := user.NewListFollowsUseCase(queryService, hexCursorCodec{})
+			first, err := uc.Execute(context.Background(), user.ListFollowsInput{
+				UserID: userID, Direction: tt.direction, Limit: 2,
+			})
+
+			require.NoError(t, err)
+			require.Len(t, first.Follows, 2)
+			require.NotEmpty(t, first.NextCursor)
+
+			second, err := uc.Execute(context.Background(), user.ListFollowsInput{
+				UserID: userID, Direction: tt.direction, Limit: 2, After: first.NextCursor,
+			})
+
+			require.NoError(t, err)
+			require.Len(t, second.Follows, 1)
+			assert.Equal(t, follows[2].UserID, second.Follows[0].UserID)
+			assert.Empty(t, second.NextCursor)
+		})
+	}
+}
+
+func TestListFollowsUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+	valid := user.ListFollowsInput{Direction: user.FollowDirectionFollowers, Limit: 20}
+
+	tests := []struct {
+		name      string
+		input     user.ListFollowsInput
+		notMember bool
+		memberErr error
+		findErr   error
+		wantErr   error
+		wantCode  vo.ErrorCode
+	}{
+		{
+			name:     "unknown direction",
+			input:    user.ListFollowsInput{Direction: "friends", Limit: 20},
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:     "limit out of range",
+			input:    user.ListFollowsInput{Direction: user.FollowDirectionFollowing, Limit: 101},
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:     "malformed cursor",
+			input:    user.ListFollowsInput{Direction: user.FollowDirectionFollowers, Limit: 20, After: "00"},
+			wantCode: vo.ValidationErrorCode,
+		},
+		{name: "user is not a member", input: valid, notMember: true, wantCode: vo.NotFoundErrorCode},
+		{name: "membership lookup fails", input: valid, memberErr: errDB, wantErr: errDB},
+		{name: "query fails", input: valid, findErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			queryService := mock_query.NewMockFollowQueryService(ctrl)
+			queryService.EXPECT().IsMember(gomock.Any(), gomock.Any()).Return(!tt.notMember, tt.memberErr).AnyTimes()
+			queryService.EXPECT().FindFollowers(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, tt.findErr).AnyTimes()
+
+			uc := user.NewListFollowsUseCase(queryService, hexCursorCodec{})
+			output, err := uc.Execute(context.Background(), tt.input)
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,64 @@
+//go:generate mockgen -source=list_relationships_query.go -destination=../../../../test/mock/usecase/query/mock_relationship_query_service.go -package mock_query
+
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RelationshipKind selects which of the actor's relationships is listed.
+type RelationshipKind string
+
+const (
+	// RelationshipKindBlocks lists the users the actor blocks.
+	RelationshipKindBlocks RelationshipKind = "blocks"
+	// RelationshipKindMutes lists the users the actor mutes.
+	RelationshipKindMutes RelationshipKind = "mutes"
+)
+
+// RelationshipDto is a read-only projection of one entry of a block or mute list: the blocked or muted
+// user and when the relationship began.
+type RelationshipDto struct {
+	UserID    uuid.UUID
+	Name      string
+	CreatedAt time.Time
+}
+
+// RelationshipQueryService is the port for fetching a user's blocks and mutes from the data store.
+// Every method only sees relationships of the active tenant (organization) in ctx.
+type RelationshipQueryService interface {
+	// FindBlocks returns up to limit users the user blocks, most recent block first, starting after the
+	// cursor when one is given. The returned slice is never nil.
+	FindBlocks(
+		ctx context.Context, userID uuid.UUID, after *RelationshipCursor, limit int,
+	) ([]RelationshipDto, error)
+	// FindMutes returns up to limit users the user mutes, ordered and paged like FindBlocks.
+	FindMutes(
+		ctx context.Context, userID uuid.UUID, after *RelationshipCursor, limit int,
+	) ([]RelationshipDto, error)
+}
+
+// ListRelationshipsInput holds the parameters for the list-relationships query.
+type ListRelationshipsInput struct {
+	ActorID uuid.UUID
+	Kind    RelationshipKind
+	Limit   int
+	// After is an opaque cursor from a previous ListRelationshipsOutput of the same actor and kind.
+	After string
+}
+
+// ListRelationshipsOutput is the result returned by ListRelationshipsUseCase.
+type ListRelationshipsOutput struct {
+	Relationships []RelationshipDto
+	// NextCursor is empty on the last page.
+	NextCursor string
+}
+
+// ListRelationshipsUseCase is the application use case for listing the users the actor blocks or mutes.
+// The lists are private: only the actor's own can be read.
+type ListRelationshipsUseCase interface {
+	Execute(ctx context.Context, input ListRelationshipsInput) (*ListRelationshipsOutput, error)
+}
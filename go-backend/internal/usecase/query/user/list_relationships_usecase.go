@@ -0,0 +1,107 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/service"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errInvalidRelationshipKind = errors.New("unknown relationship kind")
+
+type listRelationshipsUseCaseImpl struct {
+	tracer                   trace.Tracer
+	logger                   common.Logger
+	relationshipQueryService RelationshipQueryService
+	cursorCodec              service.CursorCodec
+}
+
+func (uc *listRelationshipsUseCaseImpl) Execute(
+	ctx context.Context, input ListRelationshipsInput,
+) (*ListRelationshipsOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "list_relationships")
+	defer span.End()
+
+	output, err := uc.listRelationships(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (uc *listRelationshipsUseCaseImpl) listRelationships(
+	ctx context.Context, input ListRelationshipsInput,
+) (*ListRelationshipsOutput, error) {
+	after, err := uc.validate(input)
+	if err != nil {
+		return nil, err
+	}
+
+	find := uc.relationshipQueryService.FindBlocks
+	if input.Kind == RelationshipKindMutes {
+		find = uc.relationshipQueryService.FindMutes
+	}
+
+	// One entry more than requested tells whether another page follows.
+	relationships, err := find(ctx, input.ActorID, after, input.Limit+1)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find relationships", "error", err, "kind", input.Kind)
+
+		return nil, err
+	}
+
+	output := &ListRelationshipsOutput{Relationships: relationships[:min(len(relationships), input.Limit)]}
+	if len(relationships) > input.Limit {
+		last := output.Relationships[len(output.Relationships)-1]
+		output.NextCursor = uc.cursorCodec.Encode(relationshipCursorOf(last).marshal())
+	}
+
+	return output, nil
+}
+
+func (uc *listRelationshipsUseCaseImpl) validate(input ListRelationshipsInput) (*RelationshipCursor, error) {
+	if input.Kind != RelationshipKindBlocks && input.Kind != RelationshipKindMutes {
+		return nil, vo.NewValidationError("kind must be blocks or mutes", nil, errInvalidRelationshipKind)
+	}
+
+	if input.Limit < minLimit || input.Limit > maxLimit {
+		return nil, vo.NewValidationError("limit must be between 1 and 100", nil, errInvalidLimit)
+	}
+
+	if input.After == "" {
+		return nil, nil //nolint:nilnil // no cursor means the first page
+	}
+
+	payload, err := uc.cursorCodec.Decode(input.After)
+	if err != nil {
+		return nil, vo.NewValidationError("cursor is invalid", nil, err)
+	}
+
+	cursor, err := unmarshalRelationshipCursor(payload)
+	if err != nil {
+		return nil, vo.NewValidationError("cursor is invalid", nil, err)
+	}
+
+	return &cursor, nil
+}
+
+// NewListRelationshipsUseCase creates a new ListRelationshipsUseCase.
+func NewListRelationshipsUseCase(
+	relationshipQueryService RelationshipQueryService, cursorCodec service.CursorCodec,
+) ListRelationshipsUseCase {
+	return &listRelationshipsUseCaseImpl{
+		tracer:                   otel.Tracer("ListRelationshipsUseCase"),
+		logger:                   common.NewLogger(),
+		relationshipQueryService: relationshipQueryService,
+		cursorCodec:              cursorCodec,
+	}
+}
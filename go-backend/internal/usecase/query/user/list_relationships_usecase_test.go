@@ -0,0 +1,130 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListRelationshipsUseCase_CursorPaging(t *testing.T) {
+	now := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	relationships := []user.RelationshipDto{
+		{UserID: uuid.New(), Name: "c", CreatedAt: now.Add(2 * time.Minute)},
+		{UserID: uuid.New(), Name: "b", CreatedAt: now.Add(time.Minute)},
+		{UserID: uuid.New(), Name: "a", CreatedAt: now},
+	}
+
+	tests := []struct {
+		name string
+		kind user.RelationshipKind
+	}{
+		{name: "blocks", kind: user.RelationshipKindBlocks},
+		{name: "mutes", kind: user.RelationshipKindMutes},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			actorID := uuid.New()
+			// The cursor points at the last entry of the first page.
+			after := &user.RelationshipCursor{CreatedAt: relationships[1].CreatedAt, UserID: relationships[1].UserID}
+
+			queryService := mock_query.NewMockRelationshipQueryService(ctrl)
+
+			find := queryService.EXPECT().FindBlocks
+			if tt.kind == user.RelationshipKindMutes {
+				find = queryService.EXPECT().FindMutes
+			}
+
+			find(gomock.Any(), actorID, nil, 3).Return(relationships, nil).Times(1)
+			find(gomock.Any(), actorID, after, 3).Return(relationships[2:], nil).Times(1)
+
+			uc := user.NewListRelationshipsUseCase(queryService, hexCursorCodec{})
+			first, err := uc.Execute(context.Background(), user.ListRelationshipsInput{
+				ActorID: actorID, Kind: tt.kind, Limit: 2,
+			})
+
+			require.NoError(t, err)
+			require.Len(t, first.Relationships, 2)
+			require.NotEmpty(t, first.NextCursor)
+
+			second, err := uc.Execute(context.Background(), user.ListRelationshipsInput{
+				ActorID: actorID, Kind: tt.kind, Limit: 2, After: first.NextCursor,
+			})
+
+			require.NoError(t, err)
+			require.Len(t, second.Relationships, 1)
+			assert.Equal(t, relationships[2].UserID, second.Relationships[0].UserID)
+			assert.Empty(t, second.NextCursor)
+		})
+	}
+}
+
+func TestListRelationshipsUseCase_FailureCase(t *testing.T) {
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		name     string
+		input    user.ListRelationshipsInput
+		findErr  error
+		wantErr  error
+		wantCode vo.ErrorCode
+	}{
+		{
+			name:     "unknown kind",
+			input:    user.ListRelationshipsInput{Kind: "friends", Limit: 20},
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:     "limit out of range",
+			input:    user.ListRelationshipsInput{Kind: user.RelationshipKindMutes, Limit: 0},
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:     "malformed cursor",
+			input:    user.ListRelationshipsInput{Kind: user.RelationshipKindBlocks, Limit: 20, After: "00"},
+			wantCode: vo.ValidationErrorCode,
+		},
+		{
+			name:    "query fails",
+			input:   user.ListRelationshipsInput{Kind: user.RelationshipKindBlocks, Limit: 20},
+			findErr: errDB,
+			wantErr: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			queryService := mock_query.NewMockRelationshipQueryService(ctrl)
+			queryService.EXPECT().FindBlocks(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, tt.findErr).AnyTimes()
+
+			uc := user.NewListRelationshipsUseCase(queryService, hexCursorCodec{})
+			output, err := uc.Execute(context.Background(), tt.input)
+
+			require.Error(t, err)
+			assert.Nil(t, output)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			var voErr vo.Error
+			require.ErrorAs(t, err, &voErr)
+			assert.Equal(t, tt.wantCode, voErr.Code())
+		})
+	}
+}
@@ -0,0 +1,59 @@
+//go:generate mockgen -source=list_role_assignments_query.go -destination=../../../../test/mock/usecase/query/mock_role_assignment_query_service.go -package mock_query
+
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RoleAssignmentDto is a read-only projection of a role a user currently holds.
+// GrantedBy is nil for system-managed grants; ExpiresAt is nil for permanent grants.
+type RoleAssignmentDto struct {
+	RoleID    uuid.UUID
+	RoleName  string
+	GrantedBy *uuid.UUID
+	GrantedAt time.Time
+	ExpiresAt *time.Time
+	Reason    *string
+}
+
+// RoleAssignmentHistoryDto is a read-only projection of one entry in a user's role assignment audit trail.
+// ActorID is nil for system actions such as expiry.
+type RoleAssignmentHistoryDto struct {
+	ID        uuid.UUID
+	RoleID    uuid.UUID
+	RoleName  string
+	Action    string
+	ActorID   *uuid.UUID
+	Reason    *string
+	ExpiresAt *time.Time
+	CreatedAt time.Time
+}
+
+// RoleAssignmentQueryService is the port for fetching role assignment projections from the data store.
+type RoleAssignmentQueryService interface {
+	// FindActiveByUserID returns the non-expired assignments of the user, newest grant first.
+	FindActiveByUserID(ctx context.Context, userID uuid.UUID) ([]RoleAssignmentDto, error)
+	// FindHistoryByUserID returns the audit trail of the user, newest entry first.
+	FindHistoryByUserID(ctx context.Context, userID uuid.UUID) ([]RoleAssignmentHistoryDto, error)
+}
+
+// ListRoleAssignmentsInput holds the parameters for the list-role-assignments query.
+type ListRoleAssignmentsInput struct {
+	RequesterID  uuid.UUID
+	TargetUserID uuid.UUID
+}
+
+// ListRoleAssignmentsOutput is the result returned by ListRoleAssignmentsUseCase.
+type ListRoleAssignmentsOutput struct {
+	Assignments []RoleAssignmentDto
+	History     []RoleAssignmentHistoryDto
+}
+
+// ListRoleAssignmentsUseCase is the application use case for reviewing a user's roles and their history.
+type ListRoleAssignmentsUseCase interface {
+	Execute(ctx context.Context, input ListRoleAssignmentsInput) (*ListRoleAssignmentsOutput, error)
+}
@@ -0,0 +1,93 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/common"
+	aggregaterepository "github.com/Haya372/web-app-template/go-backend/internal/domain/aggregate/repository"
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errLacksRolesListPerm = errors.New("user lacks roles:list permission")
+
+type listRoleAssignmentsUseCaseImpl struct {
+	tracer                     trace.Tracer
+	logger                     common.Logger
+	roleAssignmentQueryService RoleAssignmentQueryService
+	permissionRepository       aggregaterepository.UserPermissionRepository
+}
+
+func (uc *listRoleAssignmentsUseCaseImpl) Execute(
+	ctx context.Context, input ListRoleAssignmentsInput,
+) (*ListRoleAssignmentsOutput, error) {
+	ctx, span := uc.tracer.Start(ctx, "execute")
+	defer span.End()
+
+	uc.logger.Info(ctx, "list role assignments requested", "targetUserID", input.TargetUserID)
+
+	// Users may always review their own roles; reviewing someone else's requires roles:list.
+	if input.RequesterID != input.TargetUserID {
+		agg, err := uc.permissionRepository.FindByUserID(ctx, input.RequesterID)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+
+			return nil, err
+		}
+
+		if !agg.HasPermission(vo.PermissionRolesList) {
+			err = vo.NewForbiddenError("insufficient permissions", nil, errLacksRolesListPerm)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+
+			return nil, err
+		}
+	}
+
+	assignments, err := uc.roleAssignmentQueryService.FindActiveByUserID(ctx, input.TargetUserID)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find role assignments", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	history, err := uc.roleAssignmentQueryService.FindHistoryByUserID(ctx, input.TargetUserID)
+	if err != nil {
+		uc.logger.Error(ctx, "failed to find role assignment history", "error", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	if assignments == nil {
+		assignments = []RoleAssignmentDto{}
+	}
+
+	if history == nil {
+		history = []RoleAssignmentHistoryDto{}
+	}
+
+	return &ListRoleAssignmentsOutput{
+		Assignments: assignments,
+		History:     history,
+	}, nil
+}
+
+func NewListRoleAssignmentsUseCase(
+	roleAssignmentQueryService RoleAssignmentQueryService,
+	permissionRepository aggregaterepository.UserPermissionRepository,
+) ListRoleAssignmentsUseCase {
+	return &listRoleAssignmentsUseCaseImpl{
+		tracer:                     otel.Tracer("ListRoleAssignmentsUseCase"),
+		logger:                     common.NewLogger(),
+		roleAssignmentQueryService: roleAssignmentQueryService,
+		permissionRepository:       permissionRepository,
+	}
+}
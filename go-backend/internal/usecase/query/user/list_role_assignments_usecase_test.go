@@ -0,0 +1,114 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/Haya372/web-app-template/go-backend/internal/usecase/query/user"
+	mock_repository "github.com/Haya372/web-app-template/go-backend/test/mock/domain/aggregate/repository"
+	mock_query "github.com/Haya372/web-app-template/go-backend/test/mock/usecase/query"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListRoleAssignmentsUseCase_OwnRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userID := uuid.New()
+	now := time.Now().UTC()
+
+	assignments := []user.RoleAssignmentDto{{RoleID: uuid.New(), RoleName: "viewer", GrantedAt: now}}
+	history := []user.RoleAssignmentHistoryDto{
+		{ID: uuid.New(), RoleID: assignments[0].RoleID, RoleName: "viewer", Action: "GRANTED", CreatedAt: now},
+	}
+
+	// No permission lookup is expected: reading one's own roles is always allowed.
+	permRepo := mock_repository.NewMockUserPermissionRepository(ctrl)
+
+	queryService := mock_query.NewMockRoleAssignmentQueryService(ctrl)
+	queryService.EXPECT().FindActiveByUserID(gomock.Any(), userID).Return(assignments, nil).Times(1)
+	queryService.EXPECT().FindHistoryByUserID(gomock.Any(), userID).Return(history, nil).Times(1)
+
+	uc := user.NewListRoleAssignmentsUseCase(queryService, permRepo)
+	output, err := uc.Execute(context.Background(), user.ListRoleAssignmentsInput{
+		RequesterID:  userID,
+		TargetUserID: userID,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, assignments, output.Assignments)
+	assert.Equal(t, history, output.History)
+}
+
+func TestListRoleAssignmentsUseCase_OtherUserWithPermission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	requesterID, targetID := uuid.New(), uuid.New()
+
+	permRepo := mock_repository.NewMockUserPermissionRepository(ctrl)
+	permRepo.EXPECT().FindByUserID(gomock.Any(), requesterID).
+		Return(withPermission(requesterID, vo.PermissionRolesList), nil).Times(1)
+
+	queryService := mock_query.NewMockRoleAssignmentQueryService(ctrl)
+	queryService.EXPECT().FindActiveByUserID(gomock.Any(), targetID).Return(nil, nil).Times(1)
+	queryService.EXPECT().FindHistoryByUserID(gomock.Any(), targetID).Return(nil, nil).Times(1)
+
+	uc := user.NewListRoleAssignmentsUseCase(queryService, permRepo)
+	output, err := uc.Execute(context.Background(), user.ListRoleAssignmentsInput{
+		RequesterID:  requesterID,
+		TargetUserID: targetID,
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.Assignments)
+	assert.NotNil(t, output.Assignments)
+	assert.Empty(t, output.History)
+	assert.NotNil(t, output.History)
+}
+
+func TestListRoleAssignmentsUseCase_Forbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	requesterID := uuid.New()
+
+	permRepo := mock_repository.NewMockUserPermissionRepository(ctrl)
+	permRepo.EXPECT().FindByUserID(gomock.Any(), requesterID).
+		Return(withPermission(requesterID, vo.PermissionUsersList), nil).Times(1)
+
+	queryService := mock_query.NewMockRoleAssignmentQueryService(ctrl)
+
+	uc := user.NewListRoleAssignmentsUseCase(queryService, permRepo)
+	output, err := uc.Execute(context.Background(), user.ListRoleAssignmentsInput{
+		RequesterID:  requesterID,
+		TargetUserID: uuid.New(),
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, output)
+
+	var voErr vo.Error
+	require.ErrorAs(t, err, &voErr)
+	assert.Equal(t, vo.ForbiddenErrorCode, voErr.Code())
+}
+
+func TestListRoleAssignmentsUseCase_QueryServiceError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userID := uuid.New()
+	errDB := errors.New("db error")
+
+	permRepo := mock_repository.NewMockUserPermissionRepository(ctrl)
+
+	queryService := mock_query.NewMockRoleAssignmentQueryService(ctrl)
+	queryService.EXPECT().FindActiveByUserID(gomock.Any(), userID).Return(nil, errDB).Times(1)
+
+	uc := user.NewListRoleAssignmentsUseCase(queryService, permRepo)
+	output, err := uc.Execute(context.Background(), user.ListRoleAssignmentsInput{
+		RequesterID:  userID,
+		TargetUserID: userID,
+	})
+
+	require.ErrorIs(t, err, errDB)
+	assert.Nil(t, output)
+}
@@ -19,7 +19,9 @@ type UserDto struct {
 }
 
 // UserQueryService is the port for fetching user projections from the data store.
+// Every method only sees members of the active tenant (organization) in ctx.
 type UserQueryService interface {
+	// FindAll returns a paginated list of the active tenant's members and their total count.
 	FindAll(ctx context.Context, limit, offset int) ([]UserDto, int, error)
 }
 
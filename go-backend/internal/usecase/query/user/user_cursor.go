@@ -0,0 +1,79 @@
+package user
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// userCursorSize is 8 bytes of Unix microseconds followed by the 16-byte user ID.
+const userCursorSize = 8 + 16
+
+var errMalformedUserCursor = errors.New("malformed user cursor")
+
+// userCursor is a position in a list of users: most recent first, ties broken by the listed user's ID.
+type userCursor struct {
+	CreatedAt time.Time
+	UserID    uuid.UUID
+}
+
+// marshal keeps microsecond precision, which is what the database stores.
+func (c userCursor) marshal() []byte {
+	b := make([]byte, 0, userCursorSize)
+	b = binary.BigEndian.AppendUint64(b, uint64(c.CreatedAt.UnixMicro())) //nolint:gosec // round-trips in unmarshal
+
+	return append(b, c.UserID[:]...)
+}
+
+func unmarshalUserCursor(b []byte) (userCursor, error) {
+	if len(b) != userCursorSize {
+		return userCursor{}, errMalformedUserCursor
+	}
+
+	micros := int64(binary.BigEndian.Uint64(b[:8])) //nolint:gosec // written by marshal from an int64
+
+	userID, err := uuid.FromBytes(b[8:])
+	if err != nil {
+		return userCursor{}, errMalformedUserCursor
+	}
+
+	return userCursor{CreatedAt: time.UnixMicro(micros).UTC(), UserID: userID}, nil
+}
+
+// FollowCursor is a position in a follow list: most recent follow first, ties broken by the listed user's
+// ID. CreatedAt holds the time of the follow.
+type FollowCursor userCursor
+
+func followCursorOf(follow FollowDto) FollowCursor {
+	return FollowCursor{CreatedAt: follow.FollowedAt, UserID: follow.UserID}
+}
+
+func (c FollowCursor) marshal() []byte {
+	return userCursor(c).marshal()
+}
+
+func unmarshalFollowCursor(b []byte) (FollowCursor, error) {
+	cursor, err := unmarshalUserCursor(b)
+
+	return FollowCursor(cursor), err
+}
+
+// RelationshipCursor is a position in a block or mute list: most recent first, ties broken by the listed
+// user's ID.
+type RelationshipCursor userCursor
+
+func relationshipCursorOf(relationship RelationshipDto) RelationshipCursor {
+	return RelationshipCursor{CreatedAt: relationship.CreatedAt, UserID: relationship.UserID}
+}
+
+func (c RelationshipCursor) marshal() []byte {
+	return userCursor(c).marshal()
+}
+
+func unmarshalRelationshipCursor(b []byte) (RelationshipCursor, error) {
+	cursor, err := unmarshalUserCursor(b)
+
+	return RelationshipCursor(cursor), err
+}
@@ -0,0 +1,22 @@
+//go:generate mockgen -source=blob_storage.go -destination=../../../test/mock/usecase/service/mock_blob_storage.go
+
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrBlobNotFound is returned when no blob is stored under a key.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStorage stores opaque blobs, such as the contents of attachments, under slash-separated keys.
+type BlobStorage interface {
+	// Put stores size bytes read from content under key, replacing any blob stored there.
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	// Open returns the blob stored under key, or ErrBlobNotFound. The caller closes it.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the blob stored under key. Deleting a missing blob is not an error.
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,47 @@
+//go:generate mockgen -source=content_filter.go -destination=../../../test/mock/usecase/service/mock_content_filter.go
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// ContentFilterAction is what a content filter decides about the content of a new or edited post.
+type ContentFilterAction string
+
+const (
+	// ContentAccept lets the post be saved.
+	ContentAccept ContentFilterAction = "accept"
+	// ContentReject refuses the post with a validation problem.
+	ContentReject ContentFilterAction = "reject"
+	// ContentFlag saves the post and reports it to the moderators for review.
+	ContentFlag ContentFilterAction = "flag"
+)
+
+// ContentVerdict is the outcome of filtering. Filter, Reason and Match are empty when the content is accepted.
+type ContentVerdict struct {
+	Action ContentFilterAction
+	// Filter names the filter that rejected or flagged the content.
+	Filter string
+	// Reason explains the verdict. It is shown to the author of rejected content, so it does not repeat
+	// Match.
+	Reason string
+	// Match is what the filter found, such as the banned word or the blocked host; only for moderators.
+	Match string
+	// ReportReason categorises flagged content in the moderation queue.
+	ReportReason vo.ReportReason
+}
+
+// ReportDetails describes the verdict to the moderators who review the post it flagged.
+func (v ContentVerdict) ReportDetails() string {
+	return fmt.Sprintf("%s: %s (%s)", v.Filter, v.Reason, v.Match)
+}
+
+// ContentFilter checks the content of a new or edited post before it is saved.
+type ContentFilter interface {
+	Check(ctx context.Context, authorID uuid.UUID, content string) (ContentVerdict, error)
+}
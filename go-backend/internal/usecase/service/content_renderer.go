@@ -0,0 +1,16 @@
+//go:generate mockgen -source=content_renderer.go -destination=../../../test/mock/usecase/service/mock_content_renderer.go
+
+package service
+
+import (
+	"github.com/Haya372/web-app-template/go-backend/internal/domain/vo"
+	"github.com/google/uuid"
+)
+
+// ContentRenderer turns the source of a post into sanitised HTML that clients can show as is. Markdown is
+// rendered as a subset without headings, images or raw HTML; plain text is escaped and keeps its line breaks.
+type ContentRenderer interface {
+	// Render returns the HTML of revision of the post. The source of a revision never changes, so the
+	// result may be cached by post and revision.
+	Render(postID uuid.UUID, revision int, format vo.ContentFormat, source string) string
+}
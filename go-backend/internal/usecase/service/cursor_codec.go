@@ -0,0 +1,16 @@
+//go:generate mockgen -source=cursor_codec.go -destination=../../../test/mock/usecase/service/mock_cursor_codec.go
+
+package service
+
+import "errors"
+
+// ErrInvalidCursor is returned for cursors that are malformed or were not issued by this server.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// CursorCodec turns pagination positions into opaque tokens for clients and back. Tokens are signed,
+// so a client can hand one back but cannot forge or alter it.
+type CursorCodec interface {
+	Encode(payload []byte) string
+	// Decode verifies token and returns its payload, or ErrInvalidCursor.
+	Decode(token string) ([]byte, error)
+}
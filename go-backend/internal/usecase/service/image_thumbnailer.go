@@ -0,0 +1,23 @@
+//go:generate mockgen -source=image_thumbnailer.go -destination=../../../test/mock/usecase/service/mock_image_thumbnailer.go
+
+package service
+
+import "errors"
+
+// ErrUndecodableImage is returned for images that cannot be decoded or are too large to decode.
+var ErrUndecodableImage = errors.New("image cannot be decoded")
+
+// ImageThumbnail is a small preview of an image.
+type ImageThumbnail struct {
+	// Width and Height are the dimensions of the original image.
+	Width  int
+	Height int
+	// PNG is the encoded thumbnail.
+	PNG []byte
+}
+
+// ImageThumbnailer renders thumbnails of uploaded images.
+type ImageThumbnailer interface {
+	// Thumbnail decodes image and renders a thumbnail of it, or returns ErrUndecodableImage.
+	Thumbnail(image []byte) (*ImageThumbnail, error)
+}
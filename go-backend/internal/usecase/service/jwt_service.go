@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/Haya372/web-app-template/go-backend/internal/domain/entity"
+	"github.com/google/uuid"
 )
 
 type UserAccessToken struct {
@@ -16,9 +17,19 @@ type UserAccessToken struct {
 
 type TokenClaims struct {
 	UserID string
+	// ActorID is the admin acting as UserID; empty unless the token was issued for impersonation.
+	ActorID string
+	// TenantID is the organization the token acts in; empty when the user belongs to none.
+	TenantID string
 }
 
 type JwtService interface {
-	GenerateUserAccessToken(ctx context.Context, user entity.User) (*UserAccessToken, error)
+	// GenerateUserAccessToken issues a token for user acting in tenantID; pass uuid.Nil for no tenant.
+	GenerateUserAccessToken(ctx context.Context, user entity.User, tenantID uuid.UUID) (*UserAccessToken, error)
+	// GenerateImpersonationToken issues a short-lived token whose subject is user and whose actor is actorID,
+	// acting in tenantID.
+	GenerateImpersonationToken(
+		ctx context.Context, user entity.User, actorID, tenantID uuid.UUID,
+	) (*UserAccessToken, error)
 	ValidateToken(ctx context.Context, token string) (*TokenClaims, error)
 }
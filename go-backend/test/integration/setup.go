@@ -28,6 +28,9 @@ type TestDbProps struct {
 	Schema    string
 }
 
+// TestDb is a migrated database for integration tests. Pool and DbManager connect as the app role
+// from db/roles/app.sql, like the application, so row-level security applies to them: fixtures for
+// tenant-owned tables have to be written inside a tenant, e.g. through DbManager().TxFunc.
 type TestDb interface {
 	DbManager() db.DbManager
 	Cleanup() error
@@ -36,9 +39,11 @@ type TestDb interface {
 }
 
 type baseTestDb struct {
-	pool    *pgxpool.Pool
-	manager db.DbManager
-	schema  string
+	// adminPool connects as the migrating superuser; it is only used to set up and tear down.
+	adminPool *pgxpool.Pool
+	pool      *pgxpool.Pool
+	manager   db.DbManager
+	schema    string
 }
 
 func (b *baseTestDb) DbManager() db.DbManager { return b.manager }
@@ -46,12 +51,16 @@ func (b *baseTestDb) DbManager() db.DbManager { return b.manager }
 func (b *baseTestDb) Pool() *pgxpool.Pool { return b.pool }
 
 func (b *baseTestDb) Cleanup() error {
-	return b.manager.PoolFunc(context.Background(), func(ctx context.Context, conn *pgxpool.Conn) error {
-		// Truncate in dependency order: posts and user_roles reference users.
-		_, err := conn.Exec(ctx, "truncate table posts, user_roles, users")
-
-		return err
-	})
+	// Truncate in dependency order: every listed table references users or organizations.
+	_, err := b.adminPool.Exec(context.Background(), "truncate table attachments, post_mentions, post_hashtags, "+
+		"hashtags, timeline_entries, follows, "+
+		"user_blocks, user_mutes, post_reaction_counts, post_reactions, comments, post_reports, moderation_actions, "+
+		"post_revisions, posts, collection_posts, collections, bookmarks, post_poll_votes, post_poll_ballots, "+
+		"post_poll_options, post_polls, "+
+		"pinned_posts, user_profiles, impersonation_audit_logs, "+
+		"invitation_roles, invitations, user_role_histories, user_roles, organization_memberships, organizations, users")
+
+	return err
 }
 
 type localTestDb struct {
@@ -66,6 +75,12 @@ type ciTestDb struct {
 
 const wailOccurrence = 2
 
+// appRole and appPassword are the login of the role created by db/roles/app.sql.
+const (
+	appRole     = "app"
+	appPassword = "app"
+)
+
 var errEmptySchema = errors.New("TestDbProps.Schema must not be empty")
 
 func NewTestServer(e *echo.Echo) *httptest.Server {
@@ -87,20 +102,12 @@ func NewTestDb(props TestDbProps) (TestDb, error) {
 }
 
 func newCITestDb(ctx context.Context, props TestDbProps) (TestDb, error) {
-	pool, err := createSchemaPool(ctx, os.Getenv("DATABASE_DSN"), props.Schema)
+	base, err := newBaseTestDb(ctx, os.Getenv("DATABASE_DSN"), props)
 	if err != nil {
 		return nil, err
 	}
 
-	manager := db.NewDbManager(pool)
-
-	if err = runMigrations(ctx, manager, props.DbDirPath); err != nil {
-		pool.Close()
-
-		return nil, err
-	}
-
-	return &ciTestDb{baseTestDb: baseTestDb{manager: manager, pool: pool, schema: props.Schema}}, nil
+	return &ciTestDb{baseTestDb: base}, nil
 }
 
 func newLocalTestDb(ctx context.Context, props TestDbProps) (TestDb, error) {
@@ -125,26 +132,41 @@ func newLocalTestDb(ctx context.Context, props TestDbProps) (TestDb, error) {
 		return nil, err
 	}
 
-	pool, err := createSchemaPool(ctx, dsn, props.Schema)
+	base, err := newBaseTestDb(ctx, dsn, props)
 	if err != nil {
 		_ = container.Terminate(ctx)
 
 		return nil, err
 	}
 
-	manager := db.NewDbManager(pool)
+	return &localTestDb{baseTestDb: base, container: container}, nil
+}
 
-	if err = runMigrations(ctx, manager, props.DbDirPath); err != nil {
-		pool.Close()
+// newBaseTestDb migrates the schema as the superuser in dsn, then connects to it as the app role.
+func newBaseTestDb(ctx context.Context, dsn string, props TestDbProps) (baseTestDb, error) {
+	adminPool, err := createSchemaPool(ctx, dsn, props.Schema)
+	if err != nil {
+		return baseTestDb{}, err
+	}
 
-		_ = container.Terminate(ctx)
+	if err = runMigrations(ctx, db.NewDbManager(adminPool), props.DbDirPath); err != nil {
+		adminPool.Close()
 
-		return nil, err
+		return baseTestDb{}, err
+	}
+
+	pool, err := createAppPool(ctx, dsn, props.Schema)
+	if err != nil {
+		adminPool.Close()
+
+		return baseTestDb{}, err
 	}
 
-	return &localTestDb{
-		baseTestDb: baseTestDb{manager: manager, pool: pool, schema: props.Schema},
-		container:  container,
+	return baseTestDb{
+		adminPool: adminPool,
+		pool:      pool,
+		manager:   db.NewDbManager(pool),
+		schema:    props.Schema,
 	}, nil
 }
 
@@ -173,18 +195,37 @@ func createSchemaPool(ctx context.Context, dsn, schema string) (*pgxpool.Pool, e
 	return pgxpool.NewWithConfig(ctx, config)
 }
 
+// createAppPool returns a pool on the same database and schema as dsn that logs in as the app role.
+func createAppPool(ctx context.Context, dsn, schema string) (*pgxpool.Pool, error) {
+	config, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	config.ConnConfig.User = appRole
+	config.ConnConfig.Password = appPassword
+	config.ConnConfig.RuntimeParams["search_path"] = schema
+
+	return pgxpool.NewWithConfig(ctx, config)
+}
+
 func runMigrations(ctx context.Context, manager db.DbManager, dbDirPath string) error {
 	return manager.PoolFunc(ctx, func(ctx context.Context, conn *pgxpool.Conn) error {
 		if err := runSQLDir(ctx, conn, path.Join(dbDirPath, "schema")); err != nil {
 			return err
 		}
 
-		return runSQLDir(ctx, conn, path.Join(dbDirPath, "seeds", "master"))
+		if err := runSQLDir(ctx, conn, path.Join(dbDirPath, "seeds", "master")); err != nil {
+			return err
+		}
+
+		return runSQLDir(ctx, conn, path.Join(dbDirPath, "roles"))
 	})
 }
 
 func (d *localTestDb) Terminate() error {
 	d.pool.Close()
+	d.adminPool.Close()
 
 	return d.container.Terminate(context.Background())
 }
@@ -192,16 +233,14 @@ func (d *localTestDb) Terminate() error {
 func (d *ciTestDb) Terminate() error {
 	ctx := context.Background()
 
-	err := d.manager.PoolFunc(ctx, func(ctx context.Context, conn *pgxpool.Conn) error {
-		_, err := conn.Exec(ctx, "DROP SCHEMA IF EXISTS "+pgx.Identifier{d.schema}.Sanitize()+" CASCADE")
+	d.pool.Close()
 
-		return err
-	})
+	_, err := d.adminPool.Exec(ctx, "DROP SCHEMA IF EXISTS "+pgx.Identifier{d.schema}.Sanitize()+" CASCADE")
 	if err != nil {
 		slog.Warn("failed to drop schema", "schema", d.schema, "error", err)
 	}
 
-	d.pool.Close()
+	d.adminPool.Close()
 
 	return nil
 }